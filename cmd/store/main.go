@@ -5,6 +5,7 @@ import (
 	"net"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 
 	"github.com/Lexv0lk/merch-store/internal/pkg/database"
@@ -31,6 +32,9 @@ func main() {
 
 	grpcAuthPort := ":9090"
 	grpcAuthHost := "localhost"
+	marketplaceFeePercent := "0"
+	eventSink := ""
+	eventSinkTarget := ""
 
 	env.TrySetFromEnv(env.EnvGrpcStorePort, &grpcPort)
 	env.TrySetFromEnv(env.EnvGrpcAuthPort, &grpcAuthPort)
@@ -41,12 +45,25 @@ func main() {
 	env.TrySetFromEnv(env.EnvStoreDatabasePort, &databaseSettings.Port)
 	env.TrySetFromEnv(env.EnvStoreDatabaseName, &databaseSettings.DBName)
 	env.TrySetFromEnv(env.EnvJwtSecret, &secretKey)
+	env.TrySetFromEnv(env.EnvMarketplaceFeePercent, &marketplaceFeePercent)
+	env.TrySetFromEnv(env.EnvEventSink, &eventSink)
+	env.TrySetFromEnv(env.EnvEventSinkTarget, &eventSinkTarget)
+
+	feePercent, err := strconv.ParseUint(marketplaceFeePercent, 10, 32)
+	if err != nil || feePercent > 100 {
+		defaultLogger.Error("invalid marketplace fee percent", "value", marketplaceFeePercent)
+		stop()
+		return
+	}
 
 	cfg := bootstrap.StoreConfig{
-		JwtSecret:    secretKey,
-		DbSettings:   databaseSettings,
-		GrpcAuthPort: grpcAuthPort,
-		GrpcAuthHost: grpcAuthHost,
+		JwtSecret:             secretKey,
+		DbSettings:            databaseSettings,
+		GrpcAuthPort:          grpcAuthPort,
+		GrpcAuthHost:          grpcAuthHost,
+		MarketplaceFeePercent: uint32(feePercent),
+		EventSink:             eventSink,
+		EventSinkTarget:       eventSinkTarget,
 	}
 
 	storeApp := bootstrap.NewStoreApp(cfg, defaultLogger)
@@ -0,0 +1,3237 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.1
+// source: admin.proto
+
+package merchapi
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DeactivateAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	SweepBalance  bool                   `protobuf:"varint,2,opt,name=sweepBalance,proto3" json:"sweepBalance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateAccountRequest) Reset() {
+	*x = DeactivateAccountRequest{}
+	mi := &file_admin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateAccountRequest) ProtoMessage() {}
+
+func (x *DeactivateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeactivateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeactivateAccountRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *DeactivateAccountRequest) GetSweepBalance() bool {
+	if x != nil {
+		return x.SweepBalance
+	}
+	return false
+}
+
+type DeactivateAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	SweptAmount   uint32                 `protobuf:"varint,2,opt,name=sweptAmount,proto3" json:"sweptAmount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateAccountResponse) Reset() {
+	*x = DeactivateAccountResponse{}
+	mi := &file_admin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateAccountResponse) ProtoMessage() {}
+
+func (x *DeactivateAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeactivateAccountResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DeactivateAccountResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeactivateAccountResponse) GetSweptAmount() uint32 {
+	if x != nil {
+		return x.SweptAmount
+	}
+	return 0
+}
+
+type CreateTeamRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ManagerUsername string                 `protobuf:"bytes,2,opt,name=managerUsername,proto3" json:"managerUsername,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateTeamRequest) Reset() {
+	*x = CreateTeamRequest{}
+	mi := &file_admin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTeamRequest) ProtoMessage() {}
+
+func (x *CreateTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTeamRequest.ProtoReflect.Descriptor instead.
+func (*CreateTeamRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateTeamRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTeamRequest) GetManagerUsername() string {
+	if x != nil {
+		return x.ManagerUsername
+	}
+	return ""
+}
+
+type CreateTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamID        int32                  `protobuf:"varint,1,opt,name=teamID,proto3" json:"teamID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTeamResponse) Reset() {
+	*x = CreateTeamResponse{}
+	mi := &file_admin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTeamResponse) ProtoMessage() {}
+
+func (x *CreateTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTeamResponse.ProtoReflect.Descriptor instead.
+func (*CreateTeamResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateTeamResponse) GetTeamID() int32 {
+	if x != nil {
+		return x.TeamID
+	}
+	return 0
+}
+
+type AddTeamMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=teamName,proto3" json:"teamName,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTeamMemberRequest) Reset() {
+	*x = AddTeamMemberRequest{}
+	mi := &file_admin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTeamMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTeamMemberRequest) ProtoMessage() {}
+
+func (x *AddTeamMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTeamMemberRequest.ProtoReflect.Descriptor instead.
+func (*AddTeamMemberRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AddTeamMemberRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *AddTeamMemberRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type AddTeamMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTeamMemberResponse) Reset() {
+	*x = AddTeamMemberResponse{}
+	mi := &file_admin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTeamMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTeamMemberResponse) ProtoMessage() {}
+
+func (x *AddTeamMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTeamMemberResponse.ProtoReflect.Descriptor instead.
+func (*AddTeamMemberResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AddTeamMemberResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type SetTeamBudgetTopUpRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=teamName,proto3" json:"teamName,omitempty"`
+	Amount        uint32                 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	PeriodHours   uint32                 `protobuf:"varint,3,opt,name=periodHours,proto3" json:"periodHours,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTeamBudgetTopUpRequest) Reset() {
+	*x = SetTeamBudgetTopUpRequest{}
+	mi := &file_admin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTeamBudgetTopUpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTeamBudgetTopUpRequest) ProtoMessage() {}
+
+func (x *SetTeamBudgetTopUpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTeamBudgetTopUpRequest.ProtoReflect.Descriptor instead.
+func (*SetTeamBudgetTopUpRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SetTeamBudgetTopUpRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *SetTeamBudgetTopUpRequest) GetAmount() uint32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *SetTeamBudgetTopUpRequest) GetPeriodHours() uint32 {
+	if x != nil {
+		return x.PeriodHours
+	}
+	return 0
+}
+
+type SetTeamBudgetTopUpResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTeamBudgetTopUpResponse) Reset() {
+	*x = SetTeamBudgetTopUpResponse{}
+	mi := &file_admin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTeamBudgetTopUpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTeamBudgetTopUpResponse) ProtoMessage() {}
+
+func (x *SetTeamBudgetTopUpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTeamBudgetTopUpResponse.ProtoReflect.Descriptor instead.
+func (*SetTeamBudgetTopUpResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SetTeamBudgetTopUpResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type SetTransferLimitsRequest struct {
+	state                       protoimpl.MessageState `protogen:"open.v1"`
+	Username                    string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	MaxSingleTransfer           uint32                 `protobuf:"varint,2,opt,name=maxSingleTransfer,proto3" json:"maxSingleTransfer,omitempty"`
+	DailyOutgoingCap            uint32                 `protobuf:"varint,3,opt,name=dailyOutgoingCap,proto3" json:"dailyOutgoingCap,omitempty"`
+	MaxTransfersPerHour         uint32                 `protobuf:"varint,4,opt,name=maxTransfersPerHour,proto3" json:"maxTransfersPerHour,omitempty"`
+	MaxReceivedFromSenderPerDay uint32                 `protobuf:"varint,5,opt,name=maxReceivedFromSenderPerDay,proto3" json:"maxReceivedFromSenderPerDay,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *SetTransferLimitsRequest) Reset() {
+	*x = SetTransferLimitsRequest{}
+	mi := &file_admin_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTransferLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTransferLimitsRequest) ProtoMessage() {}
+
+func (x *SetTransferLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTransferLimitsRequest.ProtoReflect.Descriptor instead.
+func (*SetTransferLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SetTransferLimitsRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *SetTransferLimitsRequest) GetMaxSingleTransfer() uint32 {
+	if x != nil {
+		return x.MaxSingleTransfer
+	}
+	return 0
+}
+
+func (x *SetTransferLimitsRequest) GetDailyOutgoingCap() uint32 {
+	if x != nil {
+		return x.DailyOutgoingCap
+	}
+	return 0
+}
+
+func (x *SetTransferLimitsRequest) GetMaxTransfersPerHour() uint32 {
+	if x != nil {
+		return x.MaxTransfersPerHour
+	}
+	return 0
+}
+
+func (x *SetTransferLimitsRequest) GetMaxReceivedFromSenderPerDay() uint32 {
+	if x != nil {
+		return x.MaxReceivedFromSenderPerDay
+	}
+	return 0
+}
+
+type SetTransferLimitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTransferLimitsResponse) Reset() {
+	*x = SetTransferLimitsResponse{}
+	mi := &file_admin_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTransferLimitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTransferLimitsResponse) ProtoMessage() {}
+
+func (x *SetTransferLimitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTransferLimitsResponse.ProtoReflect.Descriptor instead.
+func (*SetTransferLimitsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SetTransferLimitsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListFraudCasesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFraudCasesRequest) Reset() {
+	*x = ListFraudCasesRequest{}
+	mi := &file_admin_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFraudCasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFraudCasesRequest) ProtoMessage() {}
+
+func (x *ListFraudCasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFraudCasesRequest.ProtoReflect.Descriptor instead.
+func (*ListFraudCasesRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListFraudCasesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListFraudCasesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cases         []*FraudCaseInfo       `protobuf:"bytes,1,rep,name=cases,proto3" json:"cases,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFraudCasesResponse) Reset() {
+	*x = ListFraudCasesResponse{}
+	mi := &file_admin_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFraudCasesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFraudCasesResponse) ProtoMessage() {}
+
+func (x *ListFraudCasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFraudCasesResponse.ProtoReflect.Descriptor instead.
+func (*ListFraudCasesResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListFraudCasesResponse) GetCases() []*FraudCaseInfo {
+	if x != nil {
+		return x.Cases
+	}
+	return nil
+}
+
+type ResolveFraudCaseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CaseID        int32                  `protobuf:"varint,1,opt,name=caseID,proto3" json:"caseID,omitempty"`
+	Resolution    string                 `protobuf:"bytes,2,opt,name=resolution,proto3" json:"resolution,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveFraudCaseRequest) Reset() {
+	*x = ResolveFraudCaseRequest{}
+	mi := &file_admin_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveFraudCaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveFraudCaseRequest) ProtoMessage() {}
+
+func (x *ResolveFraudCaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveFraudCaseRequest.ProtoReflect.Descriptor instead.
+func (*ResolveFraudCaseRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ResolveFraudCaseRequest) GetCaseID() int32 {
+	if x != nil {
+		return x.CaseID
+	}
+	return 0
+}
+
+func (x *ResolveFraudCaseRequest) GetResolution() string {
+	if x != nil {
+		return x.Resolution
+	}
+	return ""
+}
+
+type ResolveFraudCaseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveFraudCaseResponse) Reset() {
+	*x = ResolveFraudCaseResponse{}
+	mi := &file_admin_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveFraudCaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveFraudCaseResponse) ProtoMessage() {}
+
+func (x *ResolveFraudCaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveFraudCaseResponse.ProtoReflect.Descriptor instead.
+func (*ResolveFraudCaseResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ResolveFraudCaseResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type FreezeFraudCaseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CaseID        int32                  `protobuf:"varint,1,opt,name=caseID,proto3" json:"caseID,omitempty"`
+	Resolution    string                 `protobuf:"bytes,2,opt,name=resolution,proto3" json:"resolution,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FreezeFraudCaseRequest) Reset() {
+	*x = FreezeFraudCaseRequest{}
+	mi := &file_admin_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FreezeFraudCaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreezeFraudCaseRequest) ProtoMessage() {}
+
+func (x *FreezeFraudCaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreezeFraudCaseRequest.ProtoReflect.Descriptor instead.
+func (*FreezeFraudCaseRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *FreezeFraudCaseRequest) GetCaseID() int32 {
+	if x != nil {
+		return x.CaseID
+	}
+	return 0
+}
+
+func (x *FreezeFraudCaseRequest) GetResolution() string {
+	if x != nil {
+		return x.Resolution
+	}
+	return ""
+}
+
+type FreezeFraudCaseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FreezeFraudCaseResponse) Reset() {
+	*x = FreezeFraudCaseResponse{}
+	mi := &file_admin_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FreezeFraudCaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreezeFraudCaseResponse) ProtoMessage() {}
+
+func (x *FreezeFraudCaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreezeFraudCaseResponse.ProtoReflect.Descriptor instead.
+func (*FreezeFraudCaseResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *FreezeFraudCaseResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type FreezeAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FreezeAccountRequest) Reset() {
+	*x = FreezeAccountRequest{}
+	mi := &file_admin_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FreezeAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreezeAccountRequest) ProtoMessage() {}
+
+func (x *FreezeAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreezeAccountRequest.ProtoReflect.Descriptor instead.
+func (*FreezeAccountRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *FreezeAccountRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *FreezeAccountRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type FreezeAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FreezeAccountResponse) Reset() {
+	*x = FreezeAccountResponse{}
+	mi := &file_admin_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FreezeAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreezeAccountResponse) ProtoMessage() {}
+
+func (x *FreezeAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreezeAccountResponse.ProtoReflect.Descriptor instead.
+func (*FreezeAccountResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *FreezeAccountResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type UnfreezeAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnfreezeAccountRequest) Reset() {
+	*x = UnfreezeAccountRequest{}
+	mi := &file_admin_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfreezeAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfreezeAccountRequest) ProtoMessage() {}
+
+func (x *UnfreezeAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfreezeAccountRequest.ProtoReflect.Descriptor instead.
+func (*UnfreezeAccountRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UnfreezeAccountRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UnfreezeAccountRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UnfreezeAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnfreezeAccountResponse) Reset() {
+	*x = UnfreezeAccountResponse{}
+	mi := &file_admin_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfreezeAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfreezeAccountResponse) ProtoMessage() {}
+
+func (x *UnfreezeAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfreezeAccountResponse.ProtoReflect.Descriptor instead.
+func (*UnfreezeAccountResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UnfreezeAccountResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type CreateAuctionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemName      string                 `protobuf:"bytes,1,opt,name=itemName,proto3" json:"itemName,omitempty"`
+	ReservePrice  uint32                 `protobuf:"varint,2,opt,name=reservePrice,proto3" json:"reservePrice,omitempty"`
+	StartsAt      string                 `protobuf:"bytes,3,opt,name=startsAt,proto3" json:"startsAt,omitempty"`
+	EndsAt        string                 `protobuf:"bytes,4,opt,name=endsAt,proto3" json:"endsAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAuctionRequest) Reset() {
+	*x = CreateAuctionRequest{}
+	mi := &file_admin_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAuctionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAuctionRequest) ProtoMessage() {}
+
+func (x *CreateAuctionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAuctionRequest.ProtoReflect.Descriptor instead.
+func (*CreateAuctionRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateAuctionRequest) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+func (x *CreateAuctionRequest) GetReservePrice() uint32 {
+	if x != nil {
+		return x.ReservePrice
+	}
+	return 0
+}
+
+func (x *CreateAuctionRequest) GetStartsAt() string {
+	if x != nil {
+		return x.StartsAt
+	}
+	return ""
+}
+
+func (x *CreateAuctionRequest) GetEndsAt() string {
+	if x != nil {
+		return x.EndsAt
+	}
+	return ""
+}
+
+type CreateAuctionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuctionID     int32                  `protobuf:"varint,1,opt,name=auctionID,proto3" json:"auctionID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAuctionResponse) Reset() {
+	*x = CreateAuctionResponse{}
+	mi := &file_admin_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAuctionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAuctionResponse) ProtoMessage() {}
+
+func (x *CreateAuctionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAuctionResponse.ProtoReflect.Descriptor instead.
+func (*CreateAuctionResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateAuctionResponse) GetAuctionID() int32 {
+	if x != nil {
+		return x.AuctionID
+	}
+	return 0
+}
+
+type CreateRaffleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemName      string                 `protobuf:"bytes,1,opt,name=itemName,proto3" json:"itemName,omitempty"`
+	TicketPrice   uint32                 `protobuf:"varint,2,opt,name=ticketPrice,proto3" json:"ticketPrice,omitempty"`
+	DrawAt        string                 `protobuf:"bytes,3,opt,name=drawAt,proto3" json:"drawAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRaffleRequest) Reset() {
+	*x = CreateRaffleRequest{}
+	mi := &file_admin_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRaffleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRaffleRequest) ProtoMessage() {}
+
+func (x *CreateRaffleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRaffleRequest.ProtoReflect.Descriptor instead.
+func (*CreateRaffleRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateRaffleRequest) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+func (x *CreateRaffleRequest) GetTicketPrice() uint32 {
+	if x != nil {
+		return x.TicketPrice
+	}
+	return 0
+}
+
+func (x *CreateRaffleRequest) GetDrawAt() string {
+	if x != nil {
+		return x.DrawAt
+	}
+	return ""
+}
+
+type CreateRaffleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RaffleID      int32                  `protobuf:"varint,1,opt,name=raffleID,proto3" json:"raffleID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRaffleResponse) Reset() {
+	*x = CreateRaffleResponse{}
+	mi := &file_admin_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRaffleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRaffleResponse) ProtoMessage() {}
+
+func (x *CreateRaffleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRaffleResponse.ProtoReflect.Descriptor instead.
+func (*CreateRaffleResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateRaffleResponse) GetRaffleID() int32 {
+	if x != nil {
+		return x.RaffleID
+	}
+	return 0
+}
+
+type CreatePromoCodeRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Code           string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Kind           string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Value          uint32                 `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	ItemName       string                 `protobuf:"bytes,4,opt,name=itemName,proto3" json:"itemName,omitempty"`
+	MaxUses        uint32                 `protobuf:"varint,5,opt,name=maxUses,proto3" json:"maxUses,omitempty"`
+	MaxUsesPerUser uint32                 `protobuf:"varint,6,opt,name=maxUsesPerUser,proto3" json:"maxUsesPerUser,omitempty"`
+	ValidFrom      string                 `protobuf:"bytes,7,opt,name=validFrom,proto3" json:"validFrom,omitempty"`
+	ValidUntil     string                 `protobuf:"bytes,8,opt,name=validUntil,proto3" json:"validUntil,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreatePromoCodeRequest) Reset() {
+	*x = CreatePromoCodeRequest{}
+	mi := &file_admin_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromoCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromoCodeRequest) ProtoMessage() {}
+
+func (x *CreatePromoCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromoCodeRequest.ProtoReflect.Descriptor instead.
+func (*CreatePromoCodeRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreatePromoCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreatePromoCodeRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CreatePromoCodeRequest) GetValue() uint32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *CreatePromoCodeRequest) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+func (x *CreatePromoCodeRequest) GetMaxUses() uint32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *CreatePromoCodeRequest) GetMaxUsesPerUser() uint32 {
+	if x != nil {
+		return x.MaxUsesPerUser
+	}
+	return 0
+}
+
+func (x *CreatePromoCodeRequest) GetValidFrom() string {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return ""
+}
+
+func (x *CreatePromoCodeRequest) GetValidUntil() string {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return ""
+}
+
+type CreatePromoCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromoID       int32                  `protobuf:"varint,1,opt,name=promoID,proto3" json:"promoID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePromoCodeResponse) Reset() {
+	*x = CreatePromoCodeResponse{}
+	mi := &file_admin_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromoCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromoCodeResponse) ProtoMessage() {}
+
+func (x *CreatePromoCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromoCodeResponse.ProtoReflect.Descriptor instead.
+func (*CreatePromoCodeResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CreatePromoCodeResponse) GetPromoID() int32 {
+	if x != nil {
+		return x.PromoID
+	}
+	return 0
+}
+
+type SchedulePriceChangeRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ItemName        string                 `protobuf:"bytes,1,opt,name=itemName,proto3" json:"itemName,omitempty"`
+	Price           uint32                 `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
+	DiscountPercent uint32                 `protobuf:"varint,3,opt,name=discountPercent,proto3" json:"discountPercent,omitempty"`
+	StartsAt        string                 `protobuf:"bytes,4,opt,name=startsAt,proto3" json:"startsAt,omitempty"`
+	EndsAt          string                 `protobuf:"bytes,5,opt,name=endsAt,proto3" json:"endsAt,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SchedulePriceChangeRequest) Reset() {
+	*x = SchedulePriceChangeRequest{}
+	mi := &file_admin_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchedulePriceChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulePriceChangeRequest) ProtoMessage() {}
+
+func (x *SchedulePriceChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulePriceChangeRequest.ProtoReflect.Descriptor instead.
+func (*SchedulePriceChangeRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SchedulePriceChangeRequest) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+func (x *SchedulePriceChangeRequest) GetPrice() uint32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *SchedulePriceChangeRequest) GetDiscountPercent() uint32 {
+	if x != nil {
+		return x.DiscountPercent
+	}
+	return 0
+}
+
+func (x *SchedulePriceChangeRequest) GetStartsAt() string {
+	if x != nil {
+		return x.StartsAt
+	}
+	return ""
+}
+
+func (x *SchedulePriceChangeRequest) GetEndsAt() string {
+	if x != nil {
+		return x.EndsAt
+	}
+	return ""
+}
+
+type SchedulePriceChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleID    int32                  `protobuf:"varint,1,opt,name=scheduleID,proto3" json:"scheduleID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchedulePriceChangeResponse) Reset() {
+	*x = SchedulePriceChangeResponse{}
+	mi := &file_admin_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchedulePriceChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulePriceChangeResponse) ProtoMessage() {}
+
+func (x *SchedulePriceChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulePriceChangeResponse.ProtoReflect.Descriptor instead.
+func (*SchedulePriceChangeResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SchedulePriceChangeResponse) GetScheduleID() int32 {
+	if x != nil {
+		return x.ScheduleID
+	}
+	return 0
+}
+
+type CreateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryRequest) Reset() {
+	*x = CreateCategoryRequest{}
+	mi := &file_admin_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRequest) ProtoMessage() {}
+
+func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CreateCategoryRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryID    int32                  `protobuf:"varint,1,opt,name=categoryID,proto3" json:"categoryID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryResponse) Reset() {
+	*x = CreateCategoryResponse{}
+	mi := &file_admin_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryResponse) ProtoMessage() {}
+
+func (x *CreateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryResponse.ProtoReflect.Descriptor instead.
+func (*CreateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CreateCategoryResponse) GetCategoryID() int32 {
+	if x != nil {
+		return x.CategoryID
+	}
+	return 0
+}
+
+type UpdateGoodDetailsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemName      string                 `protobuf:"bytes,1,opt,name=itemName,proto3" json:"itemName,omitempty"`
+	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Images        []*GoodImage           `protobuf:"bytes,4,rep,name=images,proto3" json:"images,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGoodDetailsRequest) Reset() {
+	*x = UpdateGoodDetailsRequest{}
+	mi := &file_admin_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGoodDetailsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGoodDetailsRequest) ProtoMessage() {}
+
+func (x *UpdateGoodDetailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGoodDetailsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGoodDetailsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UpdateGoodDetailsRequest) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+func (x *UpdateGoodDetailsRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *UpdateGoodDetailsRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateGoodDetailsRequest) GetImages() []*GoodImage {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+type UpdateGoodDetailsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGoodDetailsResponse) Reset() {
+	*x = UpdateGoodDetailsResponse{}
+	mi := &file_admin_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGoodDetailsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGoodDetailsResponse) ProtoMessage() {}
+
+func (x *UpdateGoodDetailsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGoodDetailsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGoodDetailsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{31}
+}
+
+type SetPurchaseLimitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemName      string                 `protobuf:"bytes,1,opt,name=itemName,proto3" json:"itemName,omitempty"`
+	MaxQuantity   uint32                 `protobuf:"varint,2,opt,name=maxQuantity,proto3" json:"maxQuantity,omitempty"`
+	PeriodDays    uint32                 `protobuf:"varint,3,opt,name=periodDays,proto3" json:"periodDays,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPurchaseLimitRequest) Reset() {
+	*x = SetPurchaseLimitRequest{}
+	mi := &file_admin_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPurchaseLimitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPurchaseLimitRequest) ProtoMessage() {}
+
+func (x *SetPurchaseLimitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPurchaseLimitRequest.ProtoReflect.Descriptor instead.
+func (*SetPurchaseLimitRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SetPurchaseLimitRequest) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+func (x *SetPurchaseLimitRequest) GetMaxQuantity() uint32 {
+	if x != nil {
+		return x.MaxQuantity
+	}
+	return 0
+}
+
+func (x *SetPurchaseLimitRequest) GetPeriodDays() uint32 {
+	if x != nil {
+		return x.PeriodDays
+	}
+	return 0
+}
+
+type SetPurchaseLimitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPurchaseLimitResponse) Reset() {
+	*x = SetPurchaseLimitResponse{}
+	mi := &file_admin_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPurchaseLimitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPurchaseLimitResponse) ProtoMessage() {}
+
+func (x *SetPurchaseLimitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPurchaseLimitResponse.ProtoReflect.Descriptor instead.
+func (*SetPurchaseLimitResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{33}
+}
+
+type AnnounceGoodRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemName      string                 `protobuf:"bytes,1,opt,name=itemName,proto3" json:"itemName,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnnounceGoodRequest) Reset() {
+	*x = AnnounceGoodRequest{}
+	mi := &file_admin_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnnounceGoodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnnounceGoodRequest) ProtoMessage() {}
+
+func (x *AnnounceGoodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnnounceGoodRequest.ProtoReflect.Descriptor instead.
+func (*AnnounceGoodRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *AnnounceGoodRequest) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+type AnnounceGoodResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnnounceGoodResponse) Reset() {
+	*x = AnnounceGoodResponse{}
+	mi := &file_admin_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnnounceGoodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnnounceGoodResponse) ProtoMessage() {}
+
+func (x *AnnounceGoodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnnounceGoodResponse.ProtoReflect.Descriptor instead.
+func (*AnnounceGoodResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{35}
+}
+
+type MarkGoodArrivedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemName      string                 `protobuf:"bytes,1,opt,name=itemName,proto3" json:"itemName,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkGoodArrivedRequest) Reset() {
+	*x = MarkGoodArrivedRequest{}
+	mi := &file_admin_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkGoodArrivedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkGoodArrivedRequest) ProtoMessage() {}
+
+func (x *MarkGoodArrivedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkGoodArrivedRequest.ProtoReflect.Descriptor instead.
+func (*MarkGoodArrivedRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *MarkGoodArrivedRequest) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+type MarkGoodArrivedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fulfilled     int32                  `protobuf:"varint,1,opt,name=fulfilled,proto3" json:"fulfilled,omitempty"`
+	Pending       int32                  `protobuf:"varint,2,opt,name=pending,proto3" json:"pending,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkGoodArrivedResponse) Reset() {
+	*x = MarkGoodArrivedResponse{}
+	mi := &file_admin_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkGoodArrivedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkGoodArrivedResponse) ProtoMessage() {}
+
+func (x *MarkGoodArrivedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkGoodArrivedResponse.ProtoReflect.Descriptor instead.
+func (*MarkGoodArrivedResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *MarkGoodArrivedResponse) GetFulfilled() int32 {
+	if x != nil {
+		return x.Fulfilled
+	}
+	return 0
+}
+
+func (x *MarkGoodArrivedResponse) GetPending() int32 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+type CreateWebhookEndpointRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Events        []string               `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookEndpointRequest) Reset() {
+	*x = CreateWebhookEndpointRequest{}
+	mi := &file_admin_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookEndpointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookEndpointRequest) ProtoMessage() {}
+
+func (x *CreateWebhookEndpointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookEndpointRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookEndpointRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CreateWebhookEndpointRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateWebhookEndpointRequest) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type CreateWebhookEndpointResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Secret        string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookEndpointResponse) Reset() {
+	*x = CreateWebhookEndpointResponse{}
+	mi := &file_admin_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookEndpointResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookEndpointResponse) ProtoMessage() {}
+
+func (x *CreateWebhookEndpointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookEndpointResponse.ProtoReflect.Descriptor instead.
+func (*CreateWebhookEndpointResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CreateWebhookEndpointResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CreateWebhookEndpointResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type ListWebhookEndpointsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookEndpointsRequest) Reset() {
+	*x = ListWebhookEndpointsRequest{}
+	mi := &file_admin_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookEndpointsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookEndpointsRequest) ProtoMessage() {}
+
+func (x *ListWebhookEndpointsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookEndpointsRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookEndpointsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{40}
+}
+
+type ListWebhookEndpointsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Endpoints     []*WebhookEndpointInfo `protobuf:"bytes,1,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookEndpointsResponse) Reset() {
+	*x = ListWebhookEndpointsResponse{}
+	mi := &file_admin_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookEndpointsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookEndpointsResponse) ProtoMessage() {}
+
+func (x *ListWebhookEndpointsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookEndpointsResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookEndpointsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListWebhookEndpointsResponse) GetEndpoints() []*WebhookEndpointInfo {
+	if x != nil {
+		return x.Endpoints
+	}
+	return nil
+}
+
+type DeleteWebhookEndpointRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookEndpointRequest) Reset() {
+	*x = DeleteWebhookEndpointRequest{}
+	mi := &file_admin_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookEndpointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookEndpointRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookEndpointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookEndpointRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookEndpointRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DeleteWebhookEndpointRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteWebhookEndpointResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookEndpointResponse) Reset() {
+	*x = DeleteWebhookEndpointResponse{}
+	mi := &file_admin_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookEndpointResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookEndpointResponse) ProtoMessage() {}
+
+func (x *DeleteWebhookEndpointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookEndpointResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookEndpointResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DeleteWebhookEndpointResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListWebhookDeadLettersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeadLettersRequest) Reset() {
+	*x = ListWebhookDeadLettersRequest{}
+	mi := &file_admin_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeadLettersRequest) ProtoMessage() {}
+
+func (x *ListWebhookDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{44}
+}
+
+type ListWebhookDeadLettersResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	DeadLetters   []*WebhookDeadLetterInfo `protobuf:"bytes,1,rep,name=deadLetters,proto3" json:"deadLetters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeadLettersResponse) Reset() {
+	*x = ListWebhookDeadLettersResponse{}
+	mi := &file_admin_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeadLettersResponse) ProtoMessage() {}
+
+func (x *ListWebhookDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListWebhookDeadLettersResponse) GetDeadLetters() []*WebhookDeadLetterInfo {
+	if x != nil {
+		return x.DeadLetters
+	}
+	return nil
+}
+
+type FetchEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Consumer      string                 `protobuf:"bytes,1,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FetchEventsRequest) Reset() {
+	*x = FetchEventsRequest{}
+	mi := &file_admin_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FetchEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchEventsRequest) ProtoMessage() {}
+
+func (x *FetchEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchEventsRequest.ProtoReflect.Descriptor instead.
+func (*FetchEventsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *FetchEventsRequest) GetConsumer() string {
+	if x != nil {
+		return x.Consumer
+	}
+	return ""
+}
+
+func (x *FetchEventsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type FetchEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*DomainEventInfo     `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FetchEventsResponse) Reset() {
+	*x = FetchEventsResponse{}
+	mi := &file_admin_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FetchEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchEventsResponse) ProtoMessage() {}
+
+func (x *FetchEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchEventsResponse.ProtoReflect.Descriptor instead.
+func (*FetchEventsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *FetchEventsResponse) GetEvents() []*DomainEventInfo {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type CommitEventOffsetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Consumer      string                 `protobuf:"bytes,1,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	Offset        int64                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommitEventOffsetRequest) Reset() {
+	*x = CommitEventOffsetRequest{}
+	mi := &file_admin_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitEventOffsetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitEventOffsetRequest) ProtoMessage() {}
+
+func (x *CommitEventOffsetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitEventOffsetRequest.ProtoReflect.Descriptor instead.
+func (*CommitEventOffsetRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CommitEventOffsetRequest) GetConsumer() string {
+	if x != nil {
+		return x.Consumer
+	}
+	return ""
+}
+
+func (x *CommitEventOffsetRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type CommitEventOffsetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommitEventOffsetResponse) Reset() {
+	*x = CommitEventOffsetResponse{}
+	mi := &file_admin_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitEventOffsetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitEventOffsetResponse) ProtoMessage() {}
+
+func (x *CommitEventOffsetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitEventOffsetResponse.ProtoReflect.Descriptor instead.
+func (*CommitEventOffsetResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CommitEventOffsetResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type FraudCaseInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pattern       string                 `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Usernames     []string               `protobuf:"bytes,3,rep,name=usernames,proto3" json:"usernames,omitempty"`
+	Details       string                 `protobuf:"bytes,4,opt,name=details,proto3" json:"details,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Resolution    string                 `protobuf:"bytes,6,opt,name=resolution,proto3" json:"resolution,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FraudCaseInfo) Reset() {
+	*x = FraudCaseInfo{}
+	mi := &file_admin_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FraudCaseInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FraudCaseInfo) ProtoMessage() {}
+
+func (x *FraudCaseInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FraudCaseInfo.ProtoReflect.Descriptor instead.
+func (*FraudCaseInfo) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *FraudCaseInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FraudCaseInfo) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *FraudCaseInfo) GetUsernames() []string {
+	if x != nil {
+		return x.Usernames
+	}
+	return nil
+}
+
+func (x *FraudCaseInfo) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+func (x *FraudCaseInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *FraudCaseInfo) GetResolution() string {
+	if x != nil {
+		return x.Resolution
+	}
+	return ""
+}
+
+func (x *FraudCaseInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type WebhookEndpointInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Events        []string               `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookEndpointInfo) Reset() {
+	*x = WebhookEndpointInfo{}
+	mi := &file_admin_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookEndpointInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookEndpointInfo) ProtoMessage() {}
+
+func (x *WebhookEndpointInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookEndpointInfo.ProtoReflect.Descriptor instead.
+func (*WebhookEndpointInfo) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *WebhookEndpointInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WebhookEndpointInfo) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookEndpointInfo) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *WebhookEndpointInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type WebhookDeadLetterInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EndpointId    int32                  `protobuf:"varint,2,opt,name=endpointId,proto3" json:"endpointId,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Event         string                 `protobuf:"bytes,4,opt,name=event,proto3" json:"event,omitempty"`
+	Data          string                 `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	Attempts      int32                  `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError     string                 `protobuf:"bytes,7,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	FailedAt      string                 `protobuf:"bytes,9,opt,name=failedAt,proto3" json:"failedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookDeadLetterInfo) Reset() {
+	*x = WebhookDeadLetterInfo{}
+	mi := &file_admin_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDeadLetterInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDeadLetterInfo) ProtoMessage() {}
+
+func (x *WebhookDeadLetterInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDeadLetterInfo.ProtoReflect.Descriptor instead.
+func (*WebhookDeadLetterInfo) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *WebhookDeadLetterInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WebhookDeadLetterInfo) GetEndpointId() int32 {
+	if x != nil {
+		return x.EndpointId
+	}
+	return 0
+}
+
+func (x *WebhookDeadLetterInfo) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookDeadLetterInfo) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *WebhookDeadLetterInfo) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *WebhookDeadLetterInfo) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDeadLetterInfo) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *WebhookDeadLetterInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *WebhookDeadLetterInfo) GetFailedAt() string {
+	if x != nil {
+		return x.FailedAt
+	}
+	return ""
+}
+
+type DomainEventInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Offset        int64                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	UserIds       []int32                `protobuf:"varint,4,rep,packed,name=userIds,proto3" json:"userIds,omitempty"`
+	Data          string                 `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	OccurredAt    string                 `protobuf:"bytes,6,opt,name=occurredAt,proto3" json:"occurredAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DomainEventInfo) Reset() {
+	*x = DomainEventInfo{}
+	mi := &file_admin_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DomainEventInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DomainEventInfo) ProtoMessage() {}
+
+func (x *DomainEventInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DomainEventInfo.ProtoReflect.Descriptor instead.
+func (*DomainEventInfo) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DomainEventInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DomainEventInfo) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *DomainEventInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *DomainEventInfo) GetUserIds() []int32 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *DomainEventInfo) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *DomainEventInfo) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+var File_admin_proto protoreflect.FileDescriptor
+
+const file_admin_proto_rawDesc = "" +
+	"\n" +
+	"\vadmin.proto\x12\bmerch.v1\x1a\vstore.proto\"Z\n" +
+	"\x18DeactivateAccountRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\"\n" +
+	"\fsweepBalance\x18\x02 \x01(\bR\fsweepBalance\"W\n" +
+	"\x19DeactivateAccountResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12 \n" +
+	"\vsweptAmount\x18\x02 \x01(\rR\vsweptAmount\"Q\n" +
+	"\x11CreateTeamRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12(\n" +
+	"\x0fmanagerUsername\x18\x02 \x01(\tR\x0fmanagerUsername\",\n" +
+	"\x12CreateTeamResponse\x12\x16\n" +
+	"\x06teamID\x18\x01 \x01(\x05R\x06teamID\"N\n" +
+	"\x14AddTeamMemberRequest\x12\x1a\n" +
+	"\bteamName\x18\x01 \x01(\tR\bteamName\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\"1\n" +
+	"\x15AddTeamMemberResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"q\n" +
+	"\x19SetTeamBudgetTopUpRequest\x12\x1a\n" +
+	"\bteamName\x18\x01 \x01(\tR\bteamName\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\rR\x06amount\x12 \n" +
+	"\vperiodHours\x18\x03 \x01(\rR\vperiodHours\"6\n" +
+	"\x1aSetTeamBudgetTopUpResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x84\x02\n" +
+	"\x18SetTransferLimitsRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12,\n" +
+	"\x11maxSingleTransfer\x18\x02 \x01(\rR\x11maxSingleTransfer\x12*\n" +
+	"\x10dailyOutgoingCap\x18\x03 \x01(\rR\x10dailyOutgoingCap\x120\n" +
+	"\x13maxTransfersPerHour\x18\x04 \x01(\rR\x13maxTransfersPerHour\x12@\n" +
+	"\x1bmaxReceivedFromSenderPerDay\x18\x05 \x01(\rR\x1bmaxReceivedFromSenderPerDay\"5\n" +
+	"\x19SetTransferLimitsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"/\n" +
+	"\x15ListFraudCasesRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"G\n" +
+	"\x16ListFraudCasesResponse\x12-\n" +
+	"\x05cases\x18\x01 \x03(\v2\x17.merch.v1.FraudCaseInfoR\x05cases\"Q\n" +
+	"\x17ResolveFraudCaseRequest\x12\x16\n" +
+	"\x06caseID\x18\x01 \x01(\x05R\x06caseID\x12\x1e\n" +
+	"\n" +
+	"resolution\x18\x02 \x01(\tR\n" +
+	"resolution\"4\n" +
+	"\x18ResolveFraudCaseResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"P\n" +
+	"\x16FreezeFraudCaseRequest\x12\x16\n" +
+	"\x06caseID\x18\x01 \x01(\x05R\x06caseID\x12\x1e\n" +
+	"\n" +
+	"resolution\x18\x02 \x01(\tR\n" +
+	"resolution\"3\n" +
+	"\x17FreezeFraudCaseResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"J\n" +
+	"\x14FreezeAccountRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"1\n" +
+	"\x15FreezeAccountResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"L\n" +
+	"\x16UnfreezeAccountRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"3\n" +
+	"\x17UnfreezeAccountResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x8a\x01\n" +
+	"\x14CreateAuctionRequest\x12\x1a\n" +
+	"\bitemName\x18\x01 \x01(\tR\bitemName\x12\"\n" +
+	"\freservePrice\x18\x02 \x01(\rR\freservePrice\x12\x1a\n" +
+	"\bstartsAt\x18\x03 \x01(\tR\bstartsAt\x12\x16\n" +
+	"\x06endsAt\x18\x04 \x01(\tR\x06endsAt\"5\n" +
+	"\x15CreateAuctionResponse\x12\x1c\n" +
+	"\tauctionID\x18\x01 \x01(\x05R\tauctionID\"k\n" +
+	"\x13CreateRaffleRequest\x12\x1a\n" +
+	"\bitemName\x18\x01 \x01(\tR\bitemName\x12 \n" +
+	"\vticketPrice\x18\x02 \x01(\rR\vticketPrice\x12\x16\n" +
+	"\x06drawAt\x18\x03 \x01(\tR\x06drawAt\"2\n" +
+	"\x14CreateRaffleResponse\x12\x1a\n" +
+	"\braffleID\x18\x01 \x01(\x05R\braffleID\"\xf2\x01\n" +
+	"\x16CreatePromoCodeRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\rR\x05value\x12\x1a\n" +
+	"\bitemName\x18\x04 \x01(\tR\bitemName\x12\x18\n" +
+	"\amaxUses\x18\x05 \x01(\rR\amaxUses\x12&\n" +
+	"\x0emaxUsesPerUser\x18\x06 \x01(\rR\x0emaxUsesPerUser\x12\x1c\n" +
+	"\tvalidFrom\x18\a \x01(\tR\tvalidFrom\x12\x1e\n" +
+	"\n" +
+	"validUntil\x18\b \x01(\tR\n" +
+	"validUntil\"3\n" +
+	"\x17CreatePromoCodeResponse\x12\x18\n" +
+	"\apromoID\x18\x01 \x01(\x05R\apromoID\"\xac\x01\n" +
+	"\x1aSchedulePriceChangeRequest\x12\x1a\n" +
+	"\bitemName\x18\x01 \x01(\tR\bitemName\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\rR\x05price\x12(\n" +
+	"\x0fdiscountPercent\x18\x03 \x01(\rR\x0fdiscountPercent\x12\x1a\n" +
+	"\bstartsAt\x18\x04 \x01(\tR\bstartsAt\x12\x16\n" +
+	"\x06endsAt\x18\x05 \x01(\tR\x06endsAt\"=\n" +
+	"\x1bSchedulePriceChangeResponse\x12\x1e\n" +
+	"\n" +
+	"scheduleID\x18\x01 \x01(\x05R\n" +
+	"scheduleID\"?\n" +
+	"\x15CreateCategoryRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"8\n" +
+	"\x16CreateCategoryResponse\x12\x1e\n" +
+	"\n" +
+	"categoryID\x18\x01 \x01(\x05R\n" +
+	"categoryID\"\xa1\x01\n" +
+	"\x18UpdateGoodDetailsRequest\x12\x1a\n" +
+	"\bitemName\x18\x01 \x01(\tR\bitemName\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12+\n" +
+	"\x06images\x18\x04 \x03(\v2\x13.merch.v1.GoodImageR\x06images\"\x1b\n" +
+	"\x19UpdateGoodDetailsResponse\"w\n" +
+	"\x17SetPurchaseLimitRequest\x12\x1a\n" +
+	"\bitemName\x18\x01 \x01(\tR\bitemName\x12 \n" +
+	"\vmaxQuantity\x18\x02 \x01(\rR\vmaxQuantity\x12\x1e\n" +
+	"\n" +
+	"periodDays\x18\x03 \x01(\rR\n" +
+	"periodDays\"\x1a\n" +
+	"\x18SetPurchaseLimitResponse\"1\n" +
+	"\x13AnnounceGoodRequest\x12\x1a\n" +
+	"\bitemName\x18\x01 \x01(\tR\bitemName\"\x16\n" +
+	"\x14AnnounceGoodResponse\"4\n" +
+	"\x16MarkGoodArrivedRequest\x12\x1a\n" +
+	"\bitemName\x18\x01 \x01(\tR\bitemName\"Q\n" +
+	"\x17MarkGoodArrivedResponse\x12\x1c\n" +
+	"\tfulfilled\x18\x01 \x01(\x05R\tfulfilled\x12\x18\n" +
+	"\apending\x18\x02 \x01(\x05R\apending\"H\n" +
+	"\x1cCreateWebhookEndpointRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x16\n" +
+	"\x06events\x18\x02 \x03(\tR\x06events\"G\n" +
+	"\x1dCreateWebhookEndpointResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\"\x1d\n" +
+	"\x1bListWebhookEndpointsRequest\"[\n" +
+	"\x1cListWebhookEndpointsResponse\x12;\n" +
+	"\tendpoints\x18\x01 \x03(\v2\x1d.merch.v1.WebhookEndpointInfoR\tendpoints\".\n" +
+	"\x1cDeleteWebhookEndpointRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"9\n" +
+	"\x1dDeleteWebhookEndpointResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x1f\n" +
+	"\x1dListWebhookDeadLettersRequest\"c\n" +
+	"\x1eListWebhookDeadLettersResponse\x12A\n" +
+	"\vdeadLetters\x18\x01 \x03(\v2\x1f.merch.v1.WebhookDeadLetterInfoR\vdeadLetters\"F\n" +
+	"\x12FetchEventsRequest\x12\x1a\n" +
+	"\bconsumer\x18\x01 \x01(\tR\bconsumer\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\"H\n" +
+	"\x13FetchEventsResponse\x121\n" +
+	"\x06events\x18\x01 \x03(\v2\x19.merch.v1.DomainEventInfoR\x06events\"N\n" +
+	"\x18CommitEventOffsetRequest\x12\x1a\n" +
+	"\bconsumer\x18\x01 \x01(\tR\bconsumer\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x03R\x06offset\"5\n" +
+	"\x19CommitEventOffsetResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xc7\x01\n" +
+	"\rFraudCaseInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x18\n" +
+	"\apattern\x18\x02 \x01(\tR\apattern\x12\x1c\n" +
+	"\tusernames\x18\x03 \x03(\tR\tusernames\x12\x18\n" +
+	"\adetails\x18\x04 \x01(\tR\adetails\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1e\n" +
+	"\n" +
+	"resolution\x18\x06 \x01(\tR\n" +
+	"resolution\x12\x1c\n" +
+	"\tcreatedAt\x18\a \x01(\tR\tcreatedAt\"m\n" +
+	"\x13WebhookEndpointInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x16\n" +
+	"\x06events\x18\x03 \x03(\tR\x06events\x12\x1c\n" +
+	"\tcreatedAt\x18\x04 \x01(\tR\tcreatedAt\"\xf7\x01\n" +
+	"\x15WebhookDeadLetterInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1e\n" +
+	"\n" +
+	"endpointId\x18\x02 \x01(\x05R\n" +
+	"endpointId\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x14\n" +
+	"\x05event\x18\x04 \x01(\tR\x05event\x12\x12\n" +
+	"\x04data\x18\x05 \x01(\tR\x04data\x12\x1a\n" +
+	"\battempts\x18\x06 \x01(\x05R\battempts\x12\x1c\n" +
+	"\tlastError\x18\a \x01(\tR\tlastError\x12\x1c\n" +
+	"\tcreatedAt\x18\b \x01(\tR\tcreatedAt\x12\x1a\n" +
+	"\bfailedAt\x18\t \x01(\tR\bfailedAt\"\x9b\x01\n" +
+	"\x0fDomainEventInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x03R\x06offset\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x18\n" +
+	"\auserIds\x18\x04 \x03(\x05R\auserIds\x12\x12\n" +
+	"\x04data\x18\x05 \x01(\tR\x04data\x12\x1e\n" +
+	"\n" +
+	"occurredAt\x18\x06 \x01(\tR\n" +
+	"occurredAt2\xe1\x11\n" +
+	"\x11MerchAdminService\x12\\\n" +
+	"\x11DeactivateAccount\x12\".merch.v1.DeactivateAccountRequest\x1a#.merch.v1.DeactivateAccountResponse\x12G\n" +
+	"\n" +
+	"CreateTeam\x12\x1b.merch.v1.CreateTeamRequest\x1a\x1c.merch.v1.CreateTeamResponse\x12P\n" +
+	"\rAddTeamMember\x12\x1e.merch.v1.AddTeamMemberRequest\x1a\x1f.merch.v1.AddTeamMemberResponse\x12_\n" +
+	"\x12SetTeamBudgetTopUp\x12#.merch.v1.SetTeamBudgetTopUpRequest\x1a$.merch.v1.SetTeamBudgetTopUpResponse\x12\\\n" +
+	"\x11SetTransferLimits\x12\".merch.v1.SetTransferLimitsRequest\x1a#.merch.v1.SetTransferLimitsResponse\x12S\n" +
+	"\x0eListFraudCases\x12\x1f.merch.v1.ListFraudCasesRequest\x1a .merch.v1.ListFraudCasesResponse\x12Y\n" +
+	"\x10ResolveFraudCase\x12!.merch.v1.ResolveFraudCaseRequest\x1a\".merch.v1.ResolveFraudCaseResponse\x12V\n" +
+	"\x0fFreezeFraudCase\x12 .merch.v1.FreezeFraudCaseRequest\x1a!.merch.v1.FreezeFraudCaseResponse\x12P\n" +
+	"\rFreezeAccount\x12\x1e.merch.v1.FreezeAccountRequest\x1a\x1f.merch.v1.FreezeAccountResponse\x12V\n" +
+	"\x0fUnfreezeAccount\x12 .merch.v1.UnfreezeAccountRequest\x1a!.merch.v1.UnfreezeAccountResponse\x12P\n" +
+	"\rCreateAuction\x12\x1e.merch.v1.CreateAuctionRequest\x1a\x1f.merch.v1.CreateAuctionResponse\x12M\n" +
+	"\fCreateRaffle\x12\x1d.merch.v1.CreateRaffleRequest\x1a\x1e.merch.v1.CreateRaffleResponse\x12V\n" +
+	"\x0fCreatePromoCode\x12 .merch.v1.CreatePromoCodeRequest\x1a!.merch.v1.CreatePromoCodeResponse\x12b\n" +
+	"\x13SchedulePriceChange\x12$.merch.v1.SchedulePriceChangeRequest\x1a%.merch.v1.SchedulePriceChangeResponse\x12S\n" +
+	"\x0eCreateCategory\x12\x1f.merch.v1.CreateCategoryRequest\x1a .merch.v1.CreateCategoryResponse\x12\\\n" +
+	"\x11UpdateGoodDetails\x12\".merch.v1.UpdateGoodDetailsRequest\x1a#.merch.v1.UpdateGoodDetailsResponse\x12Y\n" +
+	"\x10SetPurchaseLimit\x12!.merch.v1.SetPurchaseLimitRequest\x1a\".merch.v1.SetPurchaseLimitResponse\x12M\n" +
+	"\fAnnounceGood\x12\x1d.merch.v1.AnnounceGoodRequest\x1a\x1e.merch.v1.AnnounceGoodResponse\x12V\n" +
+	"\x0fMarkGoodArrived\x12 .merch.v1.MarkGoodArrivedRequest\x1a!.merch.v1.MarkGoodArrivedResponse\x12h\n" +
+	"\x15CreateWebhookEndpoint\x12&.merch.v1.CreateWebhookEndpointRequest\x1a'.merch.v1.CreateWebhookEndpointResponse\x12e\n" +
+	"\x14ListWebhookEndpoints\x12%.merch.v1.ListWebhookEndpointsRequest\x1a&.merch.v1.ListWebhookEndpointsResponse\x12h\n" +
+	"\x15DeleteWebhookEndpoint\x12&.merch.v1.DeleteWebhookEndpointRequest\x1a'.merch.v1.DeleteWebhookEndpointResponse\x12k\n" +
+	"\x16ListWebhookDeadLetters\x12'.merch.v1.ListWebhookDeadLettersRequest\x1a(.merch.v1.ListWebhookDeadLettersResponse\x12J\n" +
+	"\vFetchEvents\x12\x1c.merch.v1.FetchEventsRequest\x1a\x1d.merch.v1.FetchEventsResponse\x12\\\n" +
+	"\x11CommitEventOffset\x12\".merch.v1.CommitEventOffsetRequest\x1a#.merch.v1.CommitEventOffsetResponseB6Z4github.com/Lexv0lk/merch-store/api/merch/v1;merchapib\x06proto3"
+
+var (
+	file_admin_proto_rawDescOnce sync.Once
+	file_admin_proto_rawDescData []byte
+)
+
+func file_admin_proto_rawDescGZIP() []byte {
+	file_admin_proto_rawDescOnce.Do(func() {
+		file_admin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_admin_proto_rawDesc), len(file_admin_proto_rawDesc)))
+	})
+	return file_admin_proto_rawDescData
+}
+
+var file_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 54)
+var file_admin_proto_goTypes = []any{
+	(*DeactivateAccountRequest)(nil),       // 0: merch.v1.DeactivateAccountRequest
+	(*DeactivateAccountResponse)(nil),      // 1: merch.v1.DeactivateAccountResponse
+	(*CreateTeamRequest)(nil),              // 2: merch.v1.CreateTeamRequest
+	(*CreateTeamResponse)(nil),             // 3: merch.v1.CreateTeamResponse
+	(*AddTeamMemberRequest)(nil),           // 4: merch.v1.AddTeamMemberRequest
+	(*AddTeamMemberResponse)(nil),          // 5: merch.v1.AddTeamMemberResponse
+	(*SetTeamBudgetTopUpRequest)(nil),      // 6: merch.v1.SetTeamBudgetTopUpRequest
+	(*SetTeamBudgetTopUpResponse)(nil),     // 7: merch.v1.SetTeamBudgetTopUpResponse
+	(*SetTransferLimitsRequest)(nil),       // 8: merch.v1.SetTransferLimitsRequest
+	(*SetTransferLimitsResponse)(nil),      // 9: merch.v1.SetTransferLimitsResponse
+	(*ListFraudCasesRequest)(nil),          // 10: merch.v1.ListFraudCasesRequest
+	(*ListFraudCasesResponse)(nil),         // 11: merch.v1.ListFraudCasesResponse
+	(*ResolveFraudCaseRequest)(nil),        // 12: merch.v1.ResolveFraudCaseRequest
+	(*ResolveFraudCaseResponse)(nil),       // 13: merch.v1.ResolveFraudCaseResponse
+	(*FreezeFraudCaseRequest)(nil),         // 14: merch.v1.FreezeFraudCaseRequest
+	(*FreezeFraudCaseResponse)(nil),        // 15: merch.v1.FreezeFraudCaseResponse
+	(*FreezeAccountRequest)(nil),           // 16: merch.v1.FreezeAccountRequest
+	(*FreezeAccountResponse)(nil),          // 17: merch.v1.FreezeAccountResponse
+	(*UnfreezeAccountRequest)(nil),         // 18: merch.v1.UnfreezeAccountRequest
+	(*UnfreezeAccountResponse)(nil),        // 19: merch.v1.UnfreezeAccountResponse
+	(*CreateAuctionRequest)(nil),           // 20: merch.v1.CreateAuctionRequest
+	(*CreateAuctionResponse)(nil),          // 21: merch.v1.CreateAuctionResponse
+	(*CreateRaffleRequest)(nil),            // 22: merch.v1.CreateRaffleRequest
+	(*CreateRaffleResponse)(nil),           // 23: merch.v1.CreateRaffleResponse
+	(*CreatePromoCodeRequest)(nil),         // 24: merch.v1.CreatePromoCodeRequest
+	(*CreatePromoCodeResponse)(nil),        // 25: merch.v1.CreatePromoCodeResponse
+	(*SchedulePriceChangeRequest)(nil),     // 26: merch.v1.SchedulePriceChangeRequest
+	(*SchedulePriceChangeResponse)(nil),    // 27: merch.v1.SchedulePriceChangeResponse
+	(*CreateCategoryRequest)(nil),          // 28: merch.v1.CreateCategoryRequest
+	(*CreateCategoryResponse)(nil),         // 29: merch.v1.CreateCategoryResponse
+	(*UpdateGoodDetailsRequest)(nil),       // 30: merch.v1.UpdateGoodDetailsRequest
+	(*UpdateGoodDetailsResponse)(nil),      // 31: merch.v1.UpdateGoodDetailsResponse
+	(*SetPurchaseLimitRequest)(nil),        // 32: merch.v1.SetPurchaseLimitRequest
+	(*SetPurchaseLimitResponse)(nil),       // 33: merch.v1.SetPurchaseLimitResponse
+	(*AnnounceGoodRequest)(nil),            // 34: merch.v1.AnnounceGoodRequest
+	(*AnnounceGoodResponse)(nil),           // 35: merch.v1.AnnounceGoodResponse
+	(*MarkGoodArrivedRequest)(nil),         // 36: merch.v1.MarkGoodArrivedRequest
+	(*MarkGoodArrivedResponse)(nil),        // 37: merch.v1.MarkGoodArrivedResponse
+	(*CreateWebhookEndpointRequest)(nil),   // 38: merch.v1.CreateWebhookEndpointRequest
+	(*CreateWebhookEndpointResponse)(nil),  // 39: merch.v1.CreateWebhookEndpointResponse
+	(*ListWebhookEndpointsRequest)(nil),    // 40: merch.v1.ListWebhookEndpointsRequest
+	(*ListWebhookEndpointsResponse)(nil),   // 41: merch.v1.ListWebhookEndpointsResponse
+	(*DeleteWebhookEndpointRequest)(nil),   // 42: merch.v1.DeleteWebhookEndpointRequest
+	(*DeleteWebhookEndpointResponse)(nil),  // 43: merch.v1.DeleteWebhookEndpointResponse
+	(*ListWebhookDeadLettersRequest)(nil),  // 44: merch.v1.ListWebhookDeadLettersRequest
+	(*ListWebhookDeadLettersResponse)(nil), // 45: merch.v1.ListWebhookDeadLettersResponse
+	(*FetchEventsRequest)(nil),             // 46: merch.v1.FetchEventsRequest
+	(*FetchEventsResponse)(nil),            // 47: merch.v1.FetchEventsResponse
+	(*CommitEventOffsetRequest)(nil),       // 48: merch.v1.CommitEventOffsetRequest
+	(*CommitEventOffsetResponse)(nil),      // 49: merch.v1.CommitEventOffsetResponse
+	(*FraudCaseInfo)(nil),                  // 50: merch.v1.FraudCaseInfo
+	(*WebhookEndpointInfo)(nil),            // 51: merch.v1.WebhookEndpointInfo
+	(*WebhookDeadLetterInfo)(nil),          // 52: merch.v1.WebhookDeadLetterInfo
+	(*DomainEventInfo)(nil),                // 53: merch.v1.DomainEventInfo
+	(*GoodImage)(nil),                      // 54: merch.v1.GoodImage
+}
+var file_admin_proto_depIdxs = []int32{
+	50, // 0: merch.v1.ListFraudCasesResponse.cases:type_name -> merch.v1.FraudCaseInfo
+	54, // 1: merch.v1.UpdateGoodDetailsRequest.images:type_name -> merch.v1.GoodImage
+	51, // 2: merch.v1.ListWebhookEndpointsResponse.endpoints:type_name -> merch.v1.WebhookEndpointInfo
+	52, // 3: merch.v1.ListWebhookDeadLettersResponse.deadLetters:type_name -> merch.v1.WebhookDeadLetterInfo
+	53, // 4: merch.v1.FetchEventsResponse.events:type_name -> merch.v1.DomainEventInfo
+	0,  // 5: merch.v1.MerchAdminService.DeactivateAccount:input_type -> merch.v1.DeactivateAccountRequest
+	2,  // 6: merch.v1.MerchAdminService.CreateTeam:input_type -> merch.v1.CreateTeamRequest
+	4,  // 7: merch.v1.MerchAdminService.AddTeamMember:input_type -> merch.v1.AddTeamMemberRequest
+	6,  // 8: merch.v1.MerchAdminService.SetTeamBudgetTopUp:input_type -> merch.v1.SetTeamBudgetTopUpRequest
+	8,  // 9: merch.v1.MerchAdminService.SetTransferLimits:input_type -> merch.v1.SetTransferLimitsRequest
+	10, // 10: merch.v1.MerchAdminService.ListFraudCases:input_type -> merch.v1.ListFraudCasesRequest
+	12, // 11: merch.v1.MerchAdminService.ResolveFraudCase:input_type -> merch.v1.ResolveFraudCaseRequest
+	14, // 12: merch.v1.MerchAdminService.FreezeFraudCase:input_type -> merch.v1.FreezeFraudCaseRequest
+	16, // 13: merch.v1.MerchAdminService.FreezeAccount:input_type -> merch.v1.FreezeAccountRequest
+	18, // 14: merch.v1.MerchAdminService.UnfreezeAccount:input_type -> merch.v1.UnfreezeAccountRequest
+	20, // 15: merch.v1.MerchAdminService.CreateAuction:input_type -> merch.v1.CreateAuctionRequest
+	22, // 16: merch.v1.MerchAdminService.CreateRaffle:input_type -> merch.v1.CreateRaffleRequest
+	24, // 17: merch.v1.MerchAdminService.CreatePromoCode:input_type -> merch.v1.CreatePromoCodeRequest
+	26, // 18: merch.v1.MerchAdminService.SchedulePriceChange:input_type -> merch.v1.SchedulePriceChangeRequest
+	28, // 19: merch.v1.MerchAdminService.CreateCategory:input_type -> merch.v1.CreateCategoryRequest
+	30, // 20: merch.v1.MerchAdminService.UpdateGoodDetails:input_type -> merch.v1.UpdateGoodDetailsRequest
+	32, // 21: merch.v1.MerchAdminService.SetPurchaseLimit:input_type -> merch.v1.SetPurchaseLimitRequest
+	34, // 22: merch.v1.MerchAdminService.AnnounceGood:input_type -> merch.v1.AnnounceGoodRequest
+	36, // 23: merch.v1.MerchAdminService.MarkGoodArrived:input_type -> merch.v1.MarkGoodArrivedRequest
+	38, // 24: merch.v1.MerchAdminService.CreateWebhookEndpoint:input_type -> merch.v1.CreateWebhookEndpointRequest
+	40, // 25: merch.v1.MerchAdminService.ListWebhookEndpoints:input_type -> merch.v1.ListWebhookEndpointsRequest
+	42, // 26: merch.v1.MerchAdminService.DeleteWebhookEndpoint:input_type -> merch.v1.DeleteWebhookEndpointRequest
+	44, // 27: merch.v1.MerchAdminService.ListWebhookDeadLetters:input_type -> merch.v1.ListWebhookDeadLettersRequest
+	46, // 28: merch.v1.MerchAdminService.FetchEvents:input_type -> merch.v1.FetchEventsRequest
+	48, // 29: merch.v1.MerchAdminService.CommitEventOffset:input_type -> merch.v1.CommitEventOffsetRequest
+	1,  // 30: merch.v1.MerchAdminService.DeactivateAccount:output_type -> merch.v1.DeactivateAccountResponse
+	3,  // 31: merch.v1.MerchAdminService.CreateTeam:output_type -> merch.v1.CreateTeamResponse
+	5,  // 32: merch.v1.MerchAdminService.AddTeamMember:output_type -> merch.v1.AddTeamMemberResponse
+	7,  // 33: merch.v1.MerchAdminService.SetTeamBudgetTopUp:output_type -> merch.v1.SetTeamBudgetTopUpResponse
+	9,  // 34: merch.v1.MerchAdminService.SetTransferLimits:output_type -> merch.v1.SetTransferLimitsResponse
+	11, // 35: merch.v1.MerchAdminService.ListFraudCases:output_type -> merch.v1.ListFraudCasesResponse
+	13, // 36: merch.v1.MerchAdminService.ResolveFraudCase:output_type -> merch.v1.ResolveFraudCaseResponse
+	15, // 37: merch.v1.MerchAdminService.FreezeFraudCase:output_type -> merch.v1.FreezeFraudCaseResponse
+	17, // 38: merch.v1.MerchAdminService.FreezeAccount:output_type -> merch.v1.FreezeAccountResponse
+	19, // 39: merch.v1.MerchAdminService.UnfreezeAccount:output_type -> merch.v1.UnfreezeAccountResponse
+	21, // 40: merch.v1.MerchAdminService.CreateAuction:output_type -> merch.v1.CreateAuctionResponse
+	23, // 41: merch.v1.MerchAdminService.CreateRaffle:output_type -> merch.v1.CreateRaffleResponse
+	25, // 42: merch.v1.MerchAdminService.CreatePromoCode:output_type -> merch.v1.CreatePromoCodeResponse
+	27, // 43: merch.v1.MerchAdminService.SchedulePriceChange:output_type -> merch.v1.SchedulePriceChangeResponse
+	29, // 44: merch.v1.MerchAdminService.CreateCategory:output_type -> merch.v1.CreateCategoryResponse
+	31, // 45: merch.v1.MerchAdminService.UpdateGoodDetails:output_type -> merch.v1.UpdateGoodDetailsResponse
+	33, // 46: merch.v1.MerchAdminService.SetPurchaseLimit:output_type -> merch.v1.SetPurchaseLimitResponse
+	35, // 47: merch.v1.MerchAdminService.AnnounceGood:output_type -> merch.v1.AnnounceGoodResponse
+	37, // 48: merch.v1.MerchAdminService.MarkGoodArrived:output_type -> merch.v1.MarkGoodArrivedResponse
+	39, // 49: merch.v1.MerchAdminService.CreateWebhookEndpoint:output_type -> merch.v1.CreateWebhookEndpointResponse
+	41, // 50: merch.v1.MerchAdminService.ListWebhookEndpoints:output_type -> merch.v1.ListWebhookEndpointsResponse
+	43, // 51: merch.v1.MerchAdminService.DeleteWebhookEndpoint:output_type -> merch.v1.DeleteWebhookEndpointResponse
+	45, // 52: merch.v1.MerchAdminService.ListWebhookDeadLetters:output_type -> merch.v1.ListWebhookDeadLettersResponse
+	47, // 53: merch.v1.MerchAdminService.FetchEvents:output_type -> merch.v1.FetchEventsResponse
+	49, // 54: merch.v1.MerchAdminService.CommitEventOffset:output_type -> merch.v1.CommitEventOffsetResponse
+	30, // [30:55] is the sub-list for method output_type
+	5,  // [5:30] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_admin_proto_init() }
+func file_admin_proto_init() {
+	if File_admin_proto != nil {
+		return
+	}
+	file_store_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_admin_proto_rawDesc), len(file_admin_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   54,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_admin_proto_goTypes,
+		DependencyIndexes: file_admin_proto_depIdxs,
+		MessageInfos:      file_admin_proto_msgTypes,
+	}.Build()
+	File_admin_proto = out.File
+	file_admin_proto_goTypes = nil
+	file_admin_proto_depIdxs = nil
+}
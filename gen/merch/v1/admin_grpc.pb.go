@@ -0,0 +1,1033 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.1
+// source: admin.proto
+
+package merchapi
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	MerchAdminService_DeactivateAccount_FullMethodName      = "/merch.v1.MerchAdminService/DeactivateAccount"
+	MerchAdminService_CreateTeam_FullMethodName             = "/merch.v1.MerchAdminService/CreateTeam"
+	MerchAdminService_AddTeamMember_FullMethodName          = "/merch.v1.MerchAdminService/AddTeamMember"
+	MerchAdminService_SetTeamBudgetTopUp_FullMethodName     = "/merch.v1.MerchAdminService/SetTeamBudgetTopUp"
+	MerchAdminService_SetTransferLimits_FullMethodName      = "/merch.v1.MerchAdminService/SetTransferLimits"
+	MerchAdminService_ListFraudCases_FullMethodName         = "/merch.v1.MerchAdminService/ListFraudCases"
+	MerchAdminService_ResolveFraudCase_FullMethodName       = "/merch.v1.MerchAdminService/ResolveFraudCase"
+	MerchAdminService_FreezeFraudCase_FullMethodName        = "/merch.v1.MerchAdminService/FreezeFraudCase"
+	MerchAdminService_FreezeAccount_FullMethodName          = "/merch.v1.MerchAdminService/FreezeAccount"
+	MerchAdminService_UnfreezeAccount_FullMethodName        = "/merch.v1.MerchAdminService/UnfreezeAccount"
+	MerchAdminService_CreateAuction_FullMethodName          = "/merch.v1.MerchAdminService/CreateAuction"
+	MerchAdminService_CreateRaffle_FullMethodName           = "/merch.v1.MerchAdminService/CreateRaffle"
+	MerchAdminService_CreatePromoCode_FullMethodName        = "/merch.v1.MerchAdminService/CreatePromoCode"
+	MerchAdminService_SchedulePriceChange_FullMethodName    = "/merch.v1.MerchAdminService/SchedulePriceChange"
+	MerchAdminService_CreateCategory_FullMethodName         = "/merch.v1.MerchAdminService/CreateCategory"
+	MerchAdminService_UpdateGoodDetails_FullMethodName      = "/merch.v1.MerchAdminService/UpdateGoodDetails"
+	MerchAdminService_SetPurchaseLimit_FullMethodName       = "/merch.v1.MerchAdminService/SetPurchaseLimit"
+	MerchAdminService_AnnounceGood_FullMethodName           = "/merch.v1.MerchAdminService/AnnounceGood"
+	MerchAdminService_MarkGoodArrived_FullMethodName        = "/merch.v1.MerchAdminService/MarkGoodArrived"
+	MerchAdminService_CreateWebhookEndpoint_FullMethodName  = "/merch.v1.MerchAdminService/CreateWebhookEndpoint"
+	MerchAdminService_ListWebhookEndpoints_FullMethodName   = "/merch.v1.MerchAdminService/ListWebhookEndpoints"
+	MerchAdminService_DeleteWebhookEndpoint_FullMethodName  = "/merch.v1.MerchAdminService/DeleteWebhookEndpoint"
+	MerchAdminService_ListWebhookDeadLetters_FullMethodName = "/merch.v1.MerchAdminService/ListWebhookDeadLetters"
+	MerchAdminService_FetchEvents_FullMethodName            = "/merch.v1.MerchAdminService/FetchEvents"
+	MerchAdminService_CommitEventOffset_FullMethodName      = "/merch.v1.MerchAdminService/CommitEventOffset"
+)
+
+// MerchAdminServiceClient is the client API for MerchAdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MerchAdminServiceClient interface {
+	DeactivateAccount(ctx context.Context, in *DeactivateAccountRequest, opts ...grpc.CallOption) (*DeactivateAccountResponse, error)
+	CreateTeam(ctx context.Context, in *CreateTeamRequest, opts ...grpc.CallOption) (*CreateTeamResponse, error)
+	AddTeamMember(ctx context.Context, in *AddTeamMemberRequest, opts ...grpc.CallOption) (*AddTeamMemberResponse, error)
+	SetTeamBudgetTopUp(ctx context.Context, in *SetTeamBudgetTopUpRequest, opts ...grpc.CallOption) (*SetTeamBudgetTopUpResponse, error)
+	SetTransferLimits(ctx context.Context, in *SetTransferLimitsRequest, opts ...grpc.CallOption) (*SetTransferLimitsResponse, error)
+	ListFraudCases(ctx context.Context, in *ListFraudCasesRequest, opts ...grpc.CallOption) (*ListFraudCasesResponse, error)
+	ResolveFraudCase(ctx context.Context, in *ResolveFraudCaseRequest, opts ...grpc.CallOption) (*ResolveFraudCaseResponse, error)
+	FreezeFraudCase(ctx context.Context, in *FreezeFraudCaseRequest, opts ...grpc.CallOption) (*FreezeFraudCaseResponse, error)
+	FreezeAccount(ctx context.Context, in *FreezeAccountRequest, opts ...grpc.CallOption) (*FreezeAccountResponse, error)
+	UnfreezeAccount(ctx context.Context, in *UnfreezeAccountRequest, opts ...grpc.CallOption) (*UnfreezeAccountResponse, error)
+	CreateAuction(ctx context.Context, in *CreateAuctionRequest, opts ...grpc.CallOption) (*CreateAuctionResponse, error)
+	CreateRaffle(ctx context.Context, in *CreateRaffleRequest, opts ...grpc.CallOption) (*CreateRaffleResponse, error)
+	CreatePromoCode(ctx context.Context, in *CreatePromoCodeRequest, opts ...grpc.CallOption) (*CreatePromoCodeResponse, error)
+	SchedulePriceChange(ctx context.Context, in *SchedulePriceChangeRequest, opts ...grpc.CallOption) (*SchedulePriceChangeResponse, error)
+	CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*CreateCategoryResponse, error)
+	UpdateGoodDetails(ctx context.Context, in *UpdateGoodDetailsRequest, opts ...grpc.CallOption) (*UpdateGoodDetailsResponse, error)
+	SetPurchaseLimit(ctx context.Context, in *SetPurchaseLimitRequest, opts ...grpc.CallOption) (*SetPurchaseLimitResponse, error)
+	AnnounceGood(ctx context.Context, in *AnnounceGoodRequest, opts ...grpc.CallOption) (*AnnounceGoodResponse, error)
+	MarkGoodArrived(ctx context.Context, in *MarkGoodArrivedRequest, opts ...grpc.CallOption) (*MarkGoodArrivedResponse, error)
+	CreateWebhookEndpoint(ctx context.Context, in *CreateWebhookEndpointRequest, opts ...grpc.CallOption) (*CreateWebhookEndpointResponse, error)
+	ListWebhookEndpoints(ctx context.Context, in *ListWebhookEndpointsRequest, opts ...grpc.CallOption) (*ListWebhookEndpointsResponse, error)
+	DeleteWebhookEndpoint(ctx context.Context, in *DeleteWebhookEndpointRequest, opts ...grpc.CallOption) (*DeleteWebhookEndpointResponse, error)
+	ListWebhookDeadLetters(ctx context.Context, in *ListWebhookDeadLettersRequest, opts ...grpc.CallOption) (*ListWebhookDeadLettersResponse, error)
+	FetchEvents(ctx context.Context, in *FetchEventsRequest, opts ...grpc.CallOption) (*FetchEventsResponse, error)
+	CommitEventOffset(ctx context.Context, in *CommitEventOffsetRequest, opts ...grpc.CallOption) (*CommitEventOffsetResponse, error)
+}
+
+type merchAdminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMerchAdminServiceClient(cc grpc.ClientConnInterface) MerchAdminServiceClient {
+	return &merchAdminServiceClient{cc}
+}
+
+func (c *merchAdminServiceClient) DeactivateAccount(ctx context.Context, in *DeactivateAccountRequest, opts ...grpc.CallOption) (*DeactivateAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeactivateAccountResponse)
+	err := c.cc.Invoke(ctx, MerchAdminService_DeactivateAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchAdminServiceClient) CreateTeam(ctx context.Context, in *CreateTeamRequest, opts ...grpc.CallOption) (*CreateTeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTeamResponse)
+	err := c.cc.Invoke(ctx, MerchAdminService_CreateTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchAdminServiceClient) AddTeamMember(ctx context.Context, in *AddTeamMemberRequest, opts ...grpc.CallOption) (*AddTeamMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddTeamMemberResponse)
+	err := c.cc.Invoke(ctx, MerchAdminService_AddTeamMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchAdminServiceClient) SetTeamBudgetTopUp(ctx context.Context, in *SetTeamBudgetTopUpRequest, opts ...grpc.CallOption) (*SetTeamBudgetTopUpResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTeamBudgetTopUpResponse)
+	err := c.cc.Invoke(ctx, MerchAdminService_SetTeamBudgetTopUp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchAdminServiceClient) SetTransferLimits(ctx context.Context, in *SetTransferLimitsRequest, opts ...grpc.CallOption) (*SetTransferLimitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTransferLimitsResponse)
+	err := c.cc.Invoke(ctx, MerchAdminService_SetTransferLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchAdminServiceClient) ListFraudCases(ctx context.Context, in *ListFraudCasesRequest, opts ...grpc.CallOption) (*ListFraudCasesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFraudCasesResponse)
+	err := c.cc.Invoke(ctx, MerchAdminService_ListFraudCases_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchAdminServiceClient) ResolveFraudCase(ctx context.Context, in *ResolveFraudCaseRequest, opts ...grpc.CallOption) (*ResolveFraudCaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveFraudCaseResponse)
+	err := c.cc.Invoke(ctx, MerchAdminService_ResolveFraudCase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchAdminServiceClient) FreezeFraudCase(ctx context.Context, in *FreezeFraudCaseRequest, opts ...grpc.CallOption) (*FreezeFraudCaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FreezeFraudCaseResponse)
+	err := c.cc.Invoke(ctx, MerchAdminService_FreezeFraudCase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchAdminServiceClient) FreezeAccount(ctx context.Context, in *FreezeAccountRequest, opts ...grpc.CallOption) (*FreezeAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FreezeAccountResponse)
+	err := c.cc.Invoke(ctx, MerchAdminService_FreezeAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchAdminServiceClient) UnfreezeAccount(ctx context.Context, in *UnfreezeAccountRequest, opts ...grpc.CallOption) (*UnfreezeAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnfreezeAccountResponse)
+	err := c.cc.Invoke(ctx, MerchAdminService_UnfreezeAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchAdminServiceClient) CreateAuction(ctx context.Context, in *CreateAuctionRequest, opts ...grpc.CallOption) (*CreateAuctionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAuctionResponse)
+	err := c.cc.Invoke(ctx, MerchAdminService_CreateAuction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchAdminServiceClient) CreateRaffle(ctx context.Context, in *CreateRaffleRequest, opts ...grpc.CallOption) (*CreateRaffleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateRaffleResponse)
+	err := c.cc.Invoke(ctx, MerchAdminService_CreateRaffle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchAdminServiceClient) CreatePromoCode(ctx context.Context, in *CreatePromoCodeRequest, opts ...grpc.CallOption) (*CreatePromoCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePromoCodeResponse)
+	err := c.cc.Invoke(ctx, MerchAdminService_CreatePromoCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchAdminServiceClient) SchedulePriceChange(ctx context.Context, in *SchedulePriceChangeRequest, opts ...grpc.CallOption) (*SchedulePriceChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SchedulePriceChangeResponse)
+	err := c.cc.Invoke(ctx, MerchAdminService_SchedulePriceChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchAdminServiceClient) CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*CreateCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCategoryResponse)
+	err := c.cc.Invoke(ctx, MerchAdminService_CreateCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchAdminServiceClient) UpdateGoodDetails(ctx context.Context, in *UpdateGoodDetailsRequest, opts ...grpc.CallOption) (*UpdateGoodDetailsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateGoodDetailsResponse)
+	err := c.cc.Invoke(ctx, MerchAdminService_UpdateGoodDetails_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchAdminServiceClient) SetPurchaseLimit(ctx context.Context, in *SetPurchaseLimitRequest, opts ...grpc.CallOption) (*SetPurchaseLimitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPurchaseLimitResponse)
+	err := c.cc.Invoke(ctx, MerchAdminService_SetPurchaseLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchAdminServiceClient) AnnounceGood(ctx context.Context, in *AnnounceGoodRequest, opts ...grpc.CallOption) (*AnnounceGoodResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnnounceGoodResponse)
+	err := c.cc.Invoke(ctx, MerchAdminService_AnnounceGood_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchAdminServiceClient) MarkGoodArrived(ctx context.Context, in *MarkGoodArrivedRequest, opts ...grpc.CallOption) (*MarkGoodArrivedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkGoodArrivedResponse)
+	err := c.cc.Invoke(ctx, MerchAdminService_MarkGoodArrived_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchAdminServiceClient) CreateWebhookEndpoint(ctx context.Context, in *CreateWebhookEndpointRequest, opts ...grpc.CallOption) (*CreateWebhookEndpointResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateWebhookEndpointResponse)
+	err := c.cc.Invoke(ctx, MerchAdminService_CreateWebhookEndpoint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchAdminServiceClient) ListWebhookEndpoints(ctx context.Context, in *ListWebhookEndpointsRequest, opts ...grpc.CallOption) (*ListWebhookEndpointsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhookEndpointsResponse)
+	err := c.cc.Invoke(ctx, MerchAdminService_ListWebhookEndpoints_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchAdminServiceClient) DeleteWebhookEndpoint(ctx context.Context, in *DeleteWebhookEndpointRequest, opts ...grpc.CallOption) (*DeleteWebhookEndpointResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWebhookEndpointResponse)
+	err := c.cc.Invoke(ctx, MerchAdminService_DeleteWebhookEndpoint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchAdminServiceClient) ListWebhookDeadLetters(ctx context.Context, in *ListWebhookDeadLettersRequest, opts ...grpc.CallOption) (*ListWebhookDeadLettersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhookDeadLettersResponse)
+	err := c.cc.Invoke(ctx, MerchAdminService_ListWebhookDeadLetters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchAdminServiceClient) FetchEvents(ctx context.Context, in *FetchEventsRequest, opts ...grpc.CallOption) (*FetchEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FetchEventsResponse)
+	err := c.cc.Invoke(ctx, MerchAdminService_FetchEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchAdminServiceClient) CommitEventOffset(ctx context.Context, in *CommitEventOffsetRequest, opts ...grpc.CallOption) (*CommitEventOffsetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommitEventOffsetResponse)
+	err := c.cc.Invoke(ctx, MerchAdminService_CommitEventOffset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MerchAdminServiceServer is the server API for MerchAdminService service.
+// All implementations must embed UnimplementedMerchAdminServiceServer
+// for forward compatibility.
+type MerchAdminServiceServer interface {
+	DeactivateAccount(context.Context, *DeactivateAccountRequest) (*DeactivateAccountResponse, error)
+	CreateTeam(context.Context, *CreateTeamRequest) (*CreateTeamResponse, error)
+	AddTeamMember(context.Context, *AddTeamMemberRequest) (*AddTeamMemberResponse, error)
+	SetTeamBudgetTopUp(context.Context, *SetTeamBudgetTopUpRequest) (*SetTeamBudgetTopUpResponse, error)
+	SetTransferLimits(context.Context, *SetTransferLimitsRequest) (*SetTransferLimitsResponse, error)
+	ListFraudCases(context.Context, *ListFraudCasesRequest) (*ListFraudCasesResponse, error)
+	ResolveFraudCase(context.Context, *ResolveFraudCaseRequest) (*ResolveFraudCaseResponse, error)
+	FreezeFraudCase(context.Context, *FreezeFraudCaseRequest) (*FreezeFraudCaseResponse, error)
+	FreezeAccount(context.Context, *FreezeAccountRequest) (*FreezeAccountResponse, error)
+	UnfreezeAccount(context.Context, *UnfreezeAccountRequest) (*UnfreezeAccountResponse, error)
+	CreateAuction(context.Context, *CreateAuctionRequest) (*CreateAuctionResponse, error)
+	CreateRaffle(context.Context, *CreateRaffleRequest) (*CreateRaffleResponse, error)
+	CreatePromoCode(context.Context, *CreatePromoCodeRequest) (*CreatePromoCodeResponse, error)
+	SchedulePriceChange(context.Context, *SchedulePriceChangeRequest) (*SchedulePriceChangeResponse, error)
+	CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryResponse, error)
+	UpdateGoodDetails(context.Context, *UpdateGoodDetailsRequest) (*UpdateGoodDetailsResponse, error)
+	SetPurchaseLimit(context.Context, *SetPurchaseLimitRequest) (*SetPurchaseLimitResponse, error)
+	AnnounceGood(context.Context, *AnnounceGoodRequest) (*AnnounceGoodResponse, error)
+	MarkGoodArrived(context.Context, *MarkGoodArrivedRequest) (*MarkGoodArrivedResponse, error)
+	CreateWebhookEndpoint(context.Context, *CreateWebhookEndpointRequest) (*CreateWebhookEndpointResponse, error)
+	ListWebhookEndpoints(context.Context, *ListWebhookEndpointsRequest) (*ListWebhookEndpointsResponse, error)
+	DeleteWebhookEndpoint(context.Context, *DeleteWebhookEndpointRequest) (*DeleteWebhookEndpointResponse, error)
+	ListWebhookDeadLetters(context.Context, *ListWebhookDeadLettersRequest) (*ListWebhookDeadLettersResponse, error)
+	FetchEvents(context.Context, *FetchEventsRequest) (*FetchEventsResponse, error)
+	CommitEventOffset(context.Context, *CommitEventOffsetRequest) (*CommitEventOffsetResponse, error)
+	mustEmbedUnimplementedMerchAdminServiceServer()
+}
+
+// UnimplementedMerchAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMerchAdminServiceServer struct{}
+
+func (UnimplementedMerchAdminServiceServer) DeactivateAccount(context.Context, *DeactivateAccountRequest) (*DeactivateAccountResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeactivateAccount not implemented")
+}
+func (UnimplementedMerchAdminServiceServer) CreateTeam(context.Context, *CreateTeamRequest) (*CreateTeamResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateTeam not implemented")
+}
+func (UnimplementedMerchAdminServiceServer) AddTeamMember(context.Context, *AddTeamMemberRequest) (*AddTeamMemberResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddTeamMember not implemented")
+}
+func (UnimplementedMerchAdminServiceServer) SetTeamBudgetTopUp(context.Context, *SetTeamBudgetTopUpRequest) (*SetTeamBudgetTopUpResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetTeamBudgetTopUp not implemented")
+}
+func (UnimplementedMerchAdminServiceServer) SetTransferLimits(context.Context, *SetTransferLimitsRequest) (*SetTransferLimitsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetTransferLimits not implemented")
+}
+func (UnimplementedMerchAdminServiceServer) ListFraudCases(context.Context, *ListFraudCasesRequest) (*ListFraudCasesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListFraudCases not implemented")
+}
+func (UnimplementedMerchAdminServiceServer) ResolveFraudCase(context.Context, *ResolveFraudCaseRequest) (*ResolveFraudCaseResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResolveFraudCase not implemented")
+}
+func (UnimplementedMerchAdminServiceServer) FreezeFraudCase(context.Context, *FreezeFraudCaseRequest) (*FreezeFraudCaseResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method FreezeFraudCase not implemented")
+}
+func (UnimplementedMerchAdminServiceServer) FreezeAccount(context.Context, *FreezeAccountRequest) (*FreezeAccountResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method FreezeAccount not implemented")
+}
+func (UnimplementedMerchAdminServiceServer) UnfreezeAccount(context.Context, *UnfreezeAccountRequest) (*UnfreezeAccountResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnfreezeAccount not implemented")
+}
+func (UnimplementedMerchAdminServiceServer) CreateAuction(context.Context, *CreateAuctionRequest) (*CreateAuctionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateAuction not implemented")
+}
+func (UnimplementedMerchAdminServiceServer) CreateRaffle(context.Context, *CreateRaffleRequest) (*CreateRaffleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateRaffle not implemented")
+}
+func (UnimplementedMerchAdminServiceServer) CreatePromoCode(context.Context, *CreatePromoCodeRequest) (*CreatePromoCodeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreatePromoCode not implemented")
+}
+func (UnimplementedMerchAdminServiceServer) SchedulePriceChange(context.Context, *SchedulePriceChangeRequest) (*SchedulePriceChangeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SchedulePriceChange not implemented")
+}
+func (UnimplementedMerchAdminServiceServer) CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateCategory not implemented")
+}
+func (UnimplementedMerchAdminServiceServer) UpdateGoodDetails(context.Context, *UpdateGoodDetailsRequest) (*UpdateGoodDetailsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateGoodDetails not implemented")
+}
+func (UnimplementedMerchAdminServiceServer) SetPurchaseLimit(context.Context, *SetPurchaseLimitRequest) (*SetPurchaseLimitResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetPurchaseLimit not implemented")
+}
+func (UnimplementedMerchAdminServiceServer) AnnounceGood(context.Context, *AnnounceGoodRequest) (*AnnounceGoodResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AnnounceGood not implemented")
+}
+func (UnimplementedMerchAdminServiceServer) MarkGoodArrived(context.Context, *MarkGoodArrivedRequest) (*MarkGoodArrivedResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MarkGoodArrived not implemented")
+}
+func (UnimplementedMerchAdminServiceServer) CreateWebhookEndpoint(context.Context, *CreateWebhookEndpointRequest) (*CreateWebhookEndpointResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateWebhookEndpoint not implemented")
+}
+func (UnimplementedMerchAdminServiceServer) ListWebhookEndpoints(context.Context, *ListWebhookEndpointsRequest) (*ListWebhookEndpointsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListWebhookEndpoints not implemented")
+}
+func (UnimplementedMerchAdminServiceServer) DeleteWebhookEndpoint(context.Context, *DeleteWebhookEndpointRequest) (*DeleteWebhookEndpointResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteWebhookEndpoint not implemented")
+}
+func (UnimplementedMerchAdminServiceServer) ListWebhookDeadLetters(context.Context, *ListWebhookDeadLettersRequest) (*ListWebhookDeadLettersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListWebhookDeadLetters not implemented")
+}
+func (UnimplementedMerchAdminServiceServer) FetchEvents(context.Context, *FetchEventsRequest) (*FetchEventsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method FetchEvents not implemented")
+}
+func (UnimplementedMerchAdminServiceServer) CommitEventOffset(context.Context, *CommitEventOffsetRequest) (*CommitEventOffsetResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CommitEventOffset not implemented")
+}
+func (UnimplementedMerchAdminServiceServer) mustEmbedUnimplementedMerchAdminServiceServer() {}
+func (UnimplementedMerchAdminServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeMerchAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MerchAdminServiceServer will
+// result in compilation errors.
+type UnsafeMerchAdminServiceServer interface {
+	mustEmbedUnimplementedMerchAdminServiceServer()
+}
+
+func RegisterMerchAdminServiceServer(s grpc.ServiceRegistrar, srv MerchAdminServiceServer) {
+	// If the following call panics, it indicates UnimplementedMerchAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MerchAdminService_ServiceDesc, srv)
+}
+
+func _MerchAdminService_DeactivateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchAdminServiceServer).DeactivateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchAdminService_DeactivateAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchAdminServiceServer).DeactivateAccount(ctx, req.(*DeactivateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchAdminService_CreateTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchAdminServiceServer).CreateTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchAdminService_CreateTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchAdminServiceServer).CreateTeam(ctx, req.(*CreateTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchAdminService_AddTeamMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTeamMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchAdminServiceServer).AddTeamMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchAdminService_AddTeamMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchAdminServiceServer).AddTeamMember(ctx, req.(*AddTeamMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchAdminService_SetTeamBudgetTopUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTeamBudgetTopUpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchAdminServiceServer).SetTeamBudgetTopUp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchAdminService_SetTeamBudgetTopUp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchAdminServiceServer).SetTeamBudgetTopUp(ctx, req.(*SetTeamBudgetTopUpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchAdminService_SetTransferLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTransferLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchAdminServiceServer).SetTransferLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchAdminService_SetTransferLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchAdminServiceServer).SetTransferLimits(ctx, req.(*SetTransferLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchAdminService_ListFraudCases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFraudCasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchAdminServiceServer).ListFraudCases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchAdminService_ListFraudCases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchAdminServiceServer).ListFraudCases(ctx, req.(*ListFraudCasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchAdminService_ResolveFraudCase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveFraudCaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchAdminServiceServer).ResolveFraudCase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchAdminService_ResolveFraudCase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchAdminServiceServer).ResolveFraudCase(ctx, req.(*ResolveFraudCaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchAdminService_FreezeFraudCase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FreezeFraudCaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchAdminServiceServer).FreezeFraudCase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchAdminService_FreezeFraudCase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchAdminServiceServer).FreezeFraudCase(ctx, req.(*FreezeFraudCaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchAdminService_FreezeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FreezeAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchAdminServiceServer).FreezeAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchAdminService_FreezeAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchAdminServiceServer).FreezeAccount(ctx, req.(*FreezeAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchAdminService_UnfreezeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfreezeAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchAdminServiceServer).UnfreezeAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchAdminService_UnfreezeAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchAdminServiceServer).UnfreezeAccount(ctx, req.(*UnfreezeAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchAdminService_CreateAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAuctionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchAdminServiceServer).CreateAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchAdminService_CreateAuction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchAdminServiceServer).CreateAuction(ctx, req.(*CreateAuctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchAdminService_CreateRaffle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRaffleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchAdminServiceServer).CreateRaffle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchAdminService_CreateRaffle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchAdminServiceServer).CreateRaffle(ctx, req.(*CreateRaffleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchAdminService_CreatePromoCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePromoCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchAdminServiceServer).CreatePromoCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchAdminService_CreatePromoCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchAdminServiceServer).CreatePromoCode(ctx, req.(*CreatePromoCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchAdminService_SchedulePriceChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchedulePriceChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchAdminServiceServer).SchedulePriceChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchAdminService_SchedulePriceChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchAdminServiceServer).SchedulePriceChange(ctx, req.(*SchedulePriceChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchAdminService_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchAdminServiceServer).CreateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchAdminService_CreateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchAdminServiceServer).CreateCategory(ctx, req.(*CreateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchAdminService_UpdateGoodDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGoodDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchAdminServiceServer).UpdateGoodDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchAdminService_UpdateGoodDetails_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchAdminServiceServer).UpdateGoodDetails(ctx, req.(*UpdateGoodDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchAdminService_SetPurchaseLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPurchaseLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchAdminServiceServer).SetPurchaseLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchAdminService_SetPurchaseLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchAdminServiceServer).SetPurchaseLimit(ctx, req.(*SetPurchaseLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchAdminService_AnnounceGood_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnnounceGoodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchAdminServiceServer).AnnounceGood(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchAdminService_AnnounceGood_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchAdminServiceServer).AnnounceGood(ctx, req.(*AnnounceGoodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchAdminService_MarkGoodArrived_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkGoodArrivedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchAdminServiceServer).MarkGoodArrived(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchAdminService_MarkGoodArrived_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchAdminServiceServer).MarkGoodArrived(ctx, req.(*MarkGoodArrivedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchAdminService_CreateWebhookEndpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookEndpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchAdminServiceServer).CreateWebhookEndpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchAdminService_CreateWebhookEndpoint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchAdminServiceServer).CreateWebhookEndpoint(ctx, req.(*CreateWebhookEndpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchAdminService_ListWebhookEndpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookEndpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchAdminServiceServer).ListWebhookEndpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchAdminService_ListWebhookEndpoints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchAdminServiceServer).ListWebhookEndpoints(ctx, req.(*ListWebhookEndpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchAdminService_DeleteWebhookEndpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookEndpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchAdminServiceServer).DeleteWebhookEndpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchAdminService_DeleteWebhookEndpoint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchAdminServiceServer).DeleteWebhookEndpoint(ctx, req.(*DeleteWebhookEndpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchAdminService_ListWebhookDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchAdminServiceServer).ListWebhookDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchAdminService_ListWebhookDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchAdminServiceServer).ListWebhookDeadLetters(ctx, req.(*ListWebhookDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchAdminService_FetchEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchAdminServiceServer).FetchEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchAdminService_FetchEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchAdminServiceServer).FetchEvents(ctx, req.(*FetchEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchAdminService_CommitEventOffset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitEventOffsetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchAdminServiceServer).CommitEventOffset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchAdminService_CommitEventOffset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchAdminServiceServer).CommitEventOffset(ctx, req.(*CommitEventOffsetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MerchAdminService_ServiceDesc is the grpc.ServiceDesc for MerchAdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MerchAdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "merch.v1.MerchAdminService",
+	HandlerType: (*MerchAdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DeactivateAccount",
+			Handler:    _MerchAdminService_DeactivateAccount_Handler,
+		},
+		{
+			MethodName: "CreateTeam",
+			Handler:    _MerchAdminService_CreateTeam_Handler,
+		},
+		{
+			MethodName: "AddTeamMember",
+			Handler:    _MerchAdminService_AddTeamMember_Handler,
+		},
+		{
+			MethodName: "SetTeamBudgetTopUp",
+			Handler:    _MerchAdminService_SetTeamBudgetTopUp_Handler,
+		},
+		{
+			MethodName: "SetTransferLimits",
+			Handler:    _MerchAdminService_SetTransferLimits_Handler,
+		},
+		{
+			MethodName: "ListFraudCases",
+			Handler:    _MerchAdminService_ListFraudCases_Handler,
+		},
+		{
+			MethodName: "ResolveFraudCase",
+			Handler:    _MerchAdminService_ResolveFraudCase_Handler,
+		},
+		{
+			MethodName: "FreezeFraudCase",
+			Handler:    _MerchAdminService_FreezeFraudCase_Handler,
+		},
+		{
+			MethodName: "FreezeAccount",
+			Handler:    _MerchAdminService_FreezeAccount_Handler,
+		},
+		{
+			MethodName: "UnfreezeAccount",
+			Handler:    _MerchAdminService_UnfreezeAccount_Handler,
+		},
+		{
+			MethodName: "CreateAuction",
+			Handler:    _MerchAdminService_CreateAuction_Handler,
+		},
+		{
+			MethodName: "CreateRaffle",
+			Handler:    _MerchAdminService_CreateRaffle_Handler,
+		},
+		{
+			MethodName: "CreatePromoCode",
+			Handler:    _MerchAdminService_CreatePromoCode_Handler,
+		},
+		{
+			MethodName: "SchedulePriceChange",
+			Handler:    _MerchAdminService_SchedulePriceChange_Handler,
+		},
+		{
+			MethodName: "CreateCategory",
+			Handler:    _MerchAdminService_CreateCategory_Handler,
+		},
+		{
+			MethodName: "UpdateGoodDetails",
+			Handler:    _MerchAdminService_UpdateGoodDetails_Handler,
+		},
+		{
+			MethodName: "SetPurchaseLimit",
+			Handler:    _MerchAdminService_SetPurchaseLimit_Handler,
+		},
+		{
+			MethodName: "AnnounceGood",
+			Handler:    _MerchAdminService_AnnounceGood_Handler,
+		},
+		{
+			MethodName: "MarkGoodArrived",
+			Handler:    _MerchAdminService_MarkGoodArrived_Handler,
+		},
+		{
+			MethodName: "CreateWebhookEndpoint",
+			Handler:    _MerchAdminService_CreateWebhookEndpoint_Handler,
+		},
+		{
+			MethodName: "ListWebhookEndpoints",
+			Handler:    _MerchAdminService_ListWebhookEndpoints_Handler,
+		},
+		{
+			MethodName: "DeleteWebhookEndpoint",
+			Handler:    _MerchAdminService_DeleteWebhookEndpoint_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeadLetters",
+			Handler:    _MerchAdminService_ListWebhookDeadLetters_Handler,
+		},
+		{
+			MethodName: "FetchEvents",
+			Handler:    _MerchAdminService_FetchEvents_Handler,
+		},
+		{
+			MethodName: "CommitEventOffset",
+			Handler:    _MerchAdminService_CommitEventOffset_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "admin.proto",
+}
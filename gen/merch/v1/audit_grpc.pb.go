@@ -0,0 +1,121 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.1
+// source: audit.proto
+
+package merchapi
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	MerchAuditService_ListAuditLog_FullMethodName = "/merch.v1.MerchAuditService/ListAuditLog"
+)
+
+// MerchAuditServiceClient is the client API for MerchAuditService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MerchAuditServiceClient interface {
+	ListAuditLog(ctx context.Context, in *ListAuditLogRequest, opts ...grpc.CallOption) (*ListAuditLogResponse, error)
+}
+
+type merchAuditServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMerchAuditServiceClient(cc grpc.ClientConnInterface) MerchAuditServiceClient {
+	return &merchAuditServiceClient{cc}
+}
+
+func (c *merchAuditServiceClient) ListAuditLog(ctx context.Context, in *ListAuditLogRequest, opts ...grpc.CallOption) (*ListAuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditLogResponse)
+	err := c.cc.Invoke(ctx, MerchAuditService_ListAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MerchAuditServiceServer is the server API for MerchAuditService service.
+// All implementations must embed UnimplementedMerchAuditServiceServer
+// for forward compatibility.
+type MerchAuditServiceServer interface {
+	ListAuditLog(context.Context, *ListAuditLogRequest) (*ListAuditLogResponse, error)
+	mustEmbedUnimplementedMerchAuditServiceServer()
+}
+
+// UnimplementedMerchAuditServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMerchAuditServiceServer struct{}
+
+func (UnimplementedMerchAuditServiceServer) ListAuditLog(context.Context, *ListAuditLogRequest) (*ListAuditLogResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAuditLog not implemented")
+}
+func (UnimplementedMerchAuditServiceServer) mustEmbedUnimplementedMerchAuditServiceServer() {}
+func (UnimplementedMerchAuditServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeMerchAuditServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MerchAuditServiceServer will
+// result in compilation errors.
+type UnsafeMerchAuditServiceServer interface {
+	mustEmbedUnimplementedMerchAuditServiceServer()
+}
+
+func RegisterMerchAuditServiceServer(s grpc.ServiceRegistrar, srv MerchAuditServiceServer) {
+	// If the following call panics, it indicates UnimplementedMerchAuditServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MerchAuditService_ServiceDesc, srv)
+}
+
+func _MerchAuditService_ListAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchAuditServiceServer).ListAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchAuditService_ListAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchAuditServiceServer).ListAuditLog(ctx, req.(*ListAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MerchAuditService_ServiceDesc is the grpc.ServiceDesc for MerchAuditService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MerchAuditService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "merch.v1.MerchAuditService",
+	HandlerType: (*MerchAuditServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListAuditLog",
+			Handler:    _MerchAuditService_ListAuditLog_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "audit.proto",
+}
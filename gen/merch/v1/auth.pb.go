@@ -293,12 +293,188 @@ func (x *GetUsernamesResponse) GetUsernames() map[int32]string {
 	return nil
 }
 
+type GetUserIDsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Usernames     []string               `protobuf:"bytes,1,rep,name=usernames,proto3" json:"usernames,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserIDsRequest) Reset() {
+	*x = GetUserIDsRequest{}
+	mi := &file_auth_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserIDsRequest) ProtoMessage() {}
+
+func (x *GetUserIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserIDsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserIDsRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetUserIDsRequest) GetUsernames() []string {
+	if x != nil {
+		return x.Usernames
+	}
+	return nil
+}
+
+type GetUserIDsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIDs       map[string]int32       `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserIDsResponse) Reset() {
+	*x = GetUserIDsResponse{}
+	mi := &file_auth_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserIDsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserIDsResponse) ProtoMessage() {}
+
+func (x *GetUserIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserIDsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserIDsResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetUserIDsResponse) GetUserIDs() map[string]int32 {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type DeactivateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        int32                  `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateUserRequest) Reset() {
+	*x = DeactivateUserRequest{}
+	mi := &file_auth_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateUserRequest) ProtoMessage() {}
+
+func (x *DeactivateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateUserRequest.ProtoReflect.Descriptor instead.
+func (*DeactivateUserRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeactivateUserRequest) GetUserID() int32 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+type DeactivateUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateUserResponse) Reset() {
+	*x = DeactivateUserResponse{}
+	mi := &file_auth_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateUserResponse) ProtoMessage() {}
+
+func (x *DeactivateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateUserResponse.ProtoReflect.Descriptor instead.
+func (*DeactivateUserResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeactivateUserResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
 var File_auth_proto protoreflect.FileDescriptor
 
 const file_auth_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"auth.proto\x12\bmerch.v1\"E\n" +
+	"auth.proto\x12\bmerch.v1\x1a\vaudit.proto\"E\n" +
 	"\vAuthRequest\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\"$\n" +
@@ -314,11 +490,26 @@ const file_auth_proto_rawDesc = "" +
 	"\tusernames\x18\x01 \x03(\v2-.merch.v1.GetUsernamesResponse.UsernamesEntryR\tusernames\x1a<\n" +
 	"\x0eUsernamesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x012\xe1\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"1\n" +
+	"\x11GetUserIDsRequest\x12\x1c\n" +
+	"\tusernames\x18\x01 \x03(\tR\tusernames\"\x95\x01\n" +
+	"\x12GetUserIDsResponse\x12C\n" +
+	"\auserIDs\x18\x01 \x03(\v2).merch.v1.GetUserIDsResponse.UserIDsEntryR\auserIDs\x1a:\n" +
+	"\fUserIDsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"/\n" +
+	"\x15DeactivateUserRequest\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\x05R\x06userID\"2\n" +
+	"\x16DeactivateUserResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess2\xd7\x03\n" +
 	"\vAuthService\x12=\n" +
 	"\fAuthenticate\x12\x15.merch.v1.AuthRequest\x1a\x16.merch.v1.AuthResponse\x12D\n" +
 	"\tGetUserID\x12\x1a.merch.v1.GetUserIDRequest\x1a\x1b.merch.v1.GetUserIDResponse\x12M\n" +
-	"\fGetUsernames\x12\x1d.merch.v1.GetUsernamesRequest\x1a\x1e.merch.v1.GetUsernamesResponseB6Z4github.com/Lexv0lk/merch-store/api/merch/v1;merchapib\x06proto3"
+	"\fGetUsernames\x12\x1d.merch.v1.GetUsernamesRequest\x1a\x1e.merch.v1.GetUsernamesResponse\x12G\n" +
+	"\n" +
+	"GetUserIDs\x12\x1b.merch.v1.GetUserIDsRequest\x1a\x1c.merch.v1.GetUserIDsResponse\x12S\n" +
+	"\x0eDeactivateUser\x12\x1f.merch.v1.DeactivateUserRequest\x1a .merch.v1.DeactivateUserResponse\x12V\n" +
+	"\x0fListAuditEvents\x12 .merch.v1.ListAuditEventsRequest\x1a!.merch.v1.ListAuditEventsResponseB6Z4github.com/Lexv0lk/merch-store/api/merch/v1;merchapib\x06proto3"
 
 var (
 	file_auth_proto_rawDescOnce sync.Once
@@ -332,29 +523,43 @@ func file_auth_proto_rawDescGZIP() []byte {
 	return file_auth_proto_rawDescData
 }
 
-var file_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_auth_proto_goTypes = []any{
-	(*AuthRequest)(nil),          // 0: merch.v1.AuthRequest
-	(*AuthResponse)(nil),         // 1: merch.v1.AuthResponse
-	(*GetUserIDRequest)(nil),     // 2: merch.v1.GetUserIDRequest
-	(*GetUserIDResponse)(nil),    // 3: merch.v1.GetUserIDResponse
-	(*GetUsernamesRequest)(nil),  // 4: merch.v1.GetUsernamesRequest
-	(*GetUsernamesResponse)(nil), // 5: merch.v1.GetUsernamesResponse
-	nil,                          // 6: merch.v1.GetUsernamesResponse.UsernamesEntry
+	(*AuthRequest)(nil),             // 0: merch.v1.AuthRequest
+	(*AuthResponse)(nil),            // 1: merch.v1.AuthResponse
+	(*GetUserIDRequest)(nil),        // 2: merch.v1.GetUserIDRequest
+	(*GetUserIDResponse)(nil),       // 3: merch.v1.GetUserIDResponse
+	(*GetUsernamesRequest)(nil),     // 4: merch.v1.GetUsernamesRequest
+	(*GetUsernamesResponse)(nil),    // 5: merch.v1.GetUsernamesResponse
+	(*GetUserIDsRequest)(nil),       // 6: merch.v1.GetUserIDsRequest
+	(*GetUserIDsResponse)(nil),      // 7: merch.v1.GetUserIDsResponse
+	(*DeactivateUserRequest)(nil),   // 8: merch.v1.DeactivateUserRequest
+	(*DeactivateUserResponse)(nil),  // 9: merch.v1.DeactivateUserResponse
+	nil,                             // 10: merch.v1.GetUsernamesResponse.UsernamesEntry
+	nil,                             // 11: merch.v1.GetUserIDsResponse.UserIDsEntry
+	(*ListAuditEventsRequest)(nil),  // 12: merch.v1.ListAuditEventsRequest
+	(*ListAuditEventsResponse)(nil), // 13: merch.v1.ListAuditEventsResponse
 }
 var file_auth_proto_depIdxs = []int32{
-	6, // 0: merch.v1.GetUsernamesResponse.usernames:type_name -> merch.v1.GetUsernamesResponse.UsernamesEntry
-	0, // 1: merch.v1.AuthService.Authenticate:input_type -> merch.v1.AuthRequest
-	2, // 2: merch.v1.AuthService.GetUserID:input_type -> merch.v1.GetUserIDRequest
-	4, // 3: merch.v1.AuthService.GetUsernames:input_type -> merch.v1.GetUsernamesRequest
-	1, // 4: merch.v1.AuthService.Authenticate:output_type -> merch.v1.AuthResponse
-	3, // 5: merch.v1.AuthService.GetUserID:output_type -> merch.v1.GetUserIDResponse
-	5, // 6: merch.v1.AuthService.GetUsernames:output_type -> merch.v1.GetUsernamesResponse
-	4, // [4:7] is the sub-list for method output_type
-	1, // [1:4] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	10, // 0: merch.v1.GetUsernamesResponse.usernames:type_name -> merch.v1.GetUsernamesResponse.UsernamesEntry
+	11, // 1: merch.v1.GetUserIDsResponse.userIDs:type_name -> merch.v1.GetUserIDsResponse.UserIDsEntry
+	0,  // 2: merch.v1.AuthService.Authenticate:input_type -> merch.v1.AuthRequest
+	2,  // 3: merch.v1.AuthService.GetUserID:input_type -> merch.v1.GetUserIDRequest
+	4,  // 4: merch.v1.AuthService.GetUsernames:input_type -> merch.v1.GetUsernamesRequest
+	6,  // 5: merch.v1.AuthService.GetUserIDs:input_type -> merch.v1.GetUserIDsRequest
+	8,  // 6: merch.v1.AuthService.DeactivateUser:input_type -> merch.v1.DeactivateUserRequest
+	12, // 7: merch.v1.AuthService.ListAuditEvents:input_type -> merch.v1.ListAuditEventsRequest
+	1,  // 8: merch.v1.AuthService.Authenticate:output_type -> merch.v1.AuthResponse
+	3,  // 9: merch.v1.AuthService.GetUserID:output_type -> merch.v1.GetUserIDResponse
+	5,  // 10: merch.v1.AuthService.GetUsernames:output_type -> merch.v1.GetUsernamesResponse
+	7,  // 11: merch.v1.AuthService.GetUserIDs:output_type -> merch.v1.GetUserIDsResponse
+	9,  // 12: merch.v1.AuthService.DeactivateUser:output_type -> merch.v1.DeactivateUserResponse
+	13, // 13: merch.v1.AuthService.ListAuditEvents:output_type -> merch.v1.ListAuditEventsResponse
+	8,  // [8:14] is the sub-list for method output_type
+	2,  // [2:8] is the sub-list for method input_type
+	2,  // [2:2] is the sub-list for extension type_name
+	2,  // [2:2] is the sub-list for extension extendee
+	0,  // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_auth_proto_init() }
@@ -362,13 +567,14 @@ func file_auth_proto_init() {
 	if File_auth_proto != nil {
 		return
 	}
+	file_audit_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_auth_proto_rawDesc), len(file_auth_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
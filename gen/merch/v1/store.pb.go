@@ -22,9 +22,10 @@ const (
 )
 
 type GetUserInfoRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IncludeWishlist bool                   `protobuf:"varint,1,opt,name=includeWishlist,proto3" json:"includeWishlist,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *GetUserInfoRequest) Reset() {
@@ -57,11 +58,20 @@ func (*GetUserInfoRequest) Descriptor() ([]byte, []int) {
 	return file_store_proto_rawDescGZIP(), []int{0}
 }
 
+func (x *GetUserInfoRequest) GetIncludeWishlist() bool {
+	if x != nil {
+		return x.IncludeWishlist
+	}
+	return false
+}
+
 type GetUserInfoResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Balance       uint32                 `protobuf:"varint,1,opt,name=balance,proto3" json:"balance,omitempty"`
 	Inventory     []*InventoryItem       `protobuf:"bytes,2,rep,name=inventory,proto3" json:"inventory,omitempty"`
 	CoinHistory   *CoinHistory           `protobuf:"bytes,3,opt,name=coinHistory,proto3" json:"coinHistory,omitempty"`
+	ItemHistory   *ItemHistory           `protobuf:"bytes,4,opt,name=itemHistory,proto3" json:"itemHistory,omitempty"`
+	Wishlist      []*WishlistItem        `protobuf:"bytes,5,rep,name=wishlist,proto3" json:"wishlist,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -117,6 +127,20 @@ func (x *GetUserInfoResponse) GetCoinHistory() *CoinHistory {
 	return nil
 }
 
+func (x *GetUserInfoResponse) GetItemHistory() *ItemHistory {
+	if x != nil {
+		return x.ItemHistory
+	}
+	return nil
+}
+
+func (x *GetUserInfoResponse) GetWishlist() []*WishlistItem {
+	if x != nil {
+		return x.Wishlist
+	}
+	return nil
+}
+
 type SendCoinsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ToUsername    string                 `protobuf:"bytes,1,opt,name=toUsername,proto3" json:"toUsername,omitempty"`
@@ -213,16 +237,106 @@ func (x *SendCoinsResponse) GetSuccess() bool {
 	return false
 }
 
+type SendCoinsBatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfers     []*CoinTransfer        `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendCoinsBatchRequest) Reset() {
+	*x = SendCoinsBatchRequest{}
+	mi := &file_store_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendCoinsBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendCoinsBatchRequest) ProtoMessage() {}
+
+func (x *SendCoinsBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendCoinsBatchRequest.ProtoReflect.Descriptor instead.
+func (*SendCoinsBatchRequest) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SendCoinsBatchRequest) GetTransfers() []*CoinTransfer {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
+type SendCoinsBatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendCoinsBatchResponse) Reset() {
+	*x = SendCoinsBatchResponse{}
+	mi := &file_store_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendCoinsBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendCoinsBatchResponse) ProtoMessage() {}
+
+func (x *SendCoinsBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendCoinsBatchResponse.ProtoReflect.Descriptor instead.
+func (*SendCoinsBatchResponse) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SendCoinsBatchResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
 type BuyItemRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ItemName      string                 `protobuf:"bytes,1,opt,name=itemName,proto3" json:"itemName,omitempty"`
+	PromoCode     string                 `protobuf:"bytes,2,opt,name=promoCode,proto3" json:"promoCode,omitempty"`
+	Variant       string                 `protobuf:"bytes,3,opt,name=variant,proto3" json:"variant,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *BuyItemRequest) Reset() {
 	*x = BuyItemRequest{}
-	mi := &file_store_proto_msgTypes[4]
+	mi := &file_store_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -234,7 +348,7 @@ func (x *BuyItemRequest) String() string {
 func (*BuyItemRequest) ProtoMessage() {}
 
 func (x *BuyItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_store_proto_msgTypes[4]
+	mi := &file_store_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -247,7 +361,7 @@ func (x *BuyItemRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuyItemRequest.ProtoReflect.Descriptor instead.
 func (*BuyItemRequest) Descriptor() ([]byte, []int) {
-	return file_store_proto_rawDescGZIP(), []int{4}
+	return file_store_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *BuyItemRequest) GetItemName() string {
@@ -257,6 +371,20 @@ func (x *BuyItemRequest) GetItemName() string {
 	return ""
 }
 
+func (x *BuyItemRequest) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+func (x *BuyItemRequest) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
 type BuyItemResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -266,7 +394,7 @@ type BuyItemResponse struct {
 
 func (x *BuyItemResponse) Reset() {
 	*x = BuyItemResponse{}
-	mi := &file_store_proto_msgTypes[5]
+	mi := &file_store_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -278,7 +406,7 @@ func (x *BuyItemResponse) String() string {
 func (*BuyItemResponse) ProtoMessage() {}
 
 func (x *BuyItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_store_proto_msgTypes[5]
+	mi := &file_store_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -291,7 +419,7 @@ func (x *BuyItemResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuyItemResponse.ProtoReflect.Descriptor instead.
 func (*BuyItemResponse) Descriptor() ([]byte, []int) {
-	return file_store_proto_rawDescGZIP(), []int{5}
+	return file_store_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *BuyItemResponse) GetSuccess() bool {
@@ -301,29 +429,31 @@ func (x *BuyItemResponse) GetSuccess() bool {
 	return false
 }
 
-type InventoryItem struct {
+type GiftItemRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Quantity      uint32                 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	ItemName      string                 `protobuf:"bytes,1,opt,name=itemName,proto3" json:"itemName,omitempty"`
+	ToUsername    string                 `protobuf:"bytes,2,opt,name=toUsername,proto3" json:"toUsername,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Variant       string                 `protobuf:"bytes,4,opt,name=variant,proto3" json:"variant,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InventoryItem) Reset() {
-	*x = InventoryItem{}
-	mi := &file_store_proto_msgTypes[6]
+func (x *GiftItemRequest) Reset() {
+	*x = GiftItemRequest{}
+	mi := &file_store_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InventoryItem) String() string {
+func (x *GiftItemRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InventoryItem) ProtoMessage() {}
+func (*GiftItemRequest) ProtoMessage() {}
 
-func (x *InventoryItem) ProtoReflect() protoreflect.Message {
-	mi := &file_store_proto_msgTypes[6]
+func (x *GiftItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -334,48 +464,61 @@ func (x *InventoryItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InventoryItem.ProtoReflect.Descriptor instead.
-func (*InventoryItem) Descriptor() ([]byte, []int) {
-	return file_store_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use GiftItemRequest.ProtoReflect.Descriptor instead.
+func (*GiftItemRequest) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *InventoryItem) GetName() string {
+func (x *GiftItemRequest) GetItemName() string {
 	if x != nil {
-		return x.Name
+		return x.ItemName
 	}
 	return ""
 }
 
-func (x *InventoryItem) GetQuantity() uint32 {
+func (x *GiftItemRequest) GetToUsername() string {
 	if x != nil {
-		return x.Quantity
+		return x.ToUsername
 	}
-	return 0
+	return ""
 }
 
-type CoinHistory struct {
+func (x *GiftItemRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GiftItemRequest) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
+type GiftItemResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Received      []*ReceivedCoinsInfo   `protobuf:"bytes,1,rep,name=received,proto3" json:"received,omitempty"`
-	Sent          []*SentCoinsInfo       `protobuf:"bytes,2,rep,name=sent,proto3" json:"sent,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CoinHistory) Reset() {
-	*x = CoinHistory{}
-	mi := &file_store_proto_msgTypes[7]
+func (x *GiftItemResponse) Reset() {
+	*x = GiftItemResponse{}
+	mi := &file_store_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CoinHistory) String() string {
+func (x *GiftItemResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CoinHistory) ProtoMessage() {}
+func (*GiftItemResponse) ProtoMessage() {}
 
-func (x *CoinHistory) ProtoReflect() protoreflect.Message {
-	mi := &file_store_proto_msgTypes[7]
+func (x *GiftItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -386,48 +529,42 @@ func (x *CoinHistory) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CoinHistory.ProtoReflect.Descriptor instead.
-func (*CoinHistory) Descriptor() ([]byte, []int) {
-	return file_store_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *CoinHistory) GetReceived() []*ReceivedCoinsInfo {
-	if x != nil {
-		return x.Received
-	}
-	return nil
+// Deprecated: Use GiftItemResponse.ProtoReflect.Descriptor instead.
+func (*GiftItemResponse) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *CoinHistory) GetSent() []*SentCoinsInfo {
+func (x *GiftItemResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Sent
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-type ReceivedCoinsInfo struct {
+type TransferItemRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	FromUsername  string                 `protobuf:"bytes,1,opt,name=fromUsername,proto3" json:"fromUsername,omitempty"`
-	Amount        uint32                 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	ItemName      string                 `protobuf:"bytes,1,opt,name=itemName,proto3" json:"itemName,omitempty"`
+	ToUsername    string                 `protobuf:"bytes,2,opt,name=toUsername,proto3" json:"toUsername,omitempty"`
+	Variant       string                 `protobuf:"bytes,3,opt,name=variant,proto3" json:"variant,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReceivedCoinsInfo) Reset() {
-	*x = ReceivedCoinsInfo{}
-	mi := &file_store_proto_msgTypes[8]
+func (x *TransferItemRequest) Reset() {
+	*x = TransferItemRequest{}
+	mi := &file_store_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReceivedCoinsInfo) String() string {
+func (x *TransferItemRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReceivedCoinsInfo) ProtoMessage() {}
+func (*TransferItemRequest) ProtoMessage() {}
 
-func (x *ReceivedCoinsInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_store_proto_msgTypes[8]
+func (x *TransferItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -438,48 +575,54 @@ func (x *ReceivedCoinsInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReceivedCoinsInfo.ProtoReflect.Descriptor instead.
-func (*ReceivedCoinsInfo) Descriptor() ([]byte, []int) {
-	return file_store_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use TransferItemRequest.ProtoReflect.Descriptor instead.
+func (*TransferItemRequest) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ReceivedCoinsInfo) GetFromUsername() string {
+func (x *TransferItemRequest) GetItemName() string {
 	if x != nil {
-		return x.FromUsername
+		return x.ItemName
 	}
 	return ""
 }
 
-func (x *ReceivedCoinsInfo) GetAmount() uint32 {
+func (x *TransferItemRequest) GetToUsername() string {
 	if x != nil {
-		return x.Amount
+		return x.ToUsername
 	}
-	return 0
+	return ""
 }
 
-type SentCoinsInfo struct {
+func (x *TransferItemRequest) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
+type TransferItemResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ToUsername    string                 `protobuf:"bytes,1,opt,name=toUsername,proto3" json:"toUsername,omitempty"`
-	Amount        uint32                 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SentCoinsInfo) Reset() {
-	*x = SentCoinsInfo{}
-	mi := &file_store_proto_msgTypes[9]
+func (x *TransferItemResponse) Reset() {
+	*x = TransferItemResponse{}
+	mi := &file_store_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SentCoinsInfo) String() string {
+func (x *TransferItemResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SentCoinsInfo) ProtoMessage() {}
+func (*TransferItemResponse) ProtoMessage() {}
 
-func (x *SentCoinsInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_store_proto_msgTypes[9]
+func (x *TransferItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -490,49 +633,4407 @@ func (x *SentCoinsInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SentCoinsInfo.ProtoReflect.Descriptor instead.
-func (*SentCoinsInfo) Descriptor() ([]byte, []int) {
-	return file_store_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use TransferItemResponse.ProtoReflect.Descriptor instead.
+func (*TransferItemResponse) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *SentCoinsInfo) GetToUsername() string {
+func (x *TransferItemResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type SendFromTeamBudgetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=teamName,proto3" json:"teamName,omitempty"`
+	ToUsername    string                 `protobuf:"bytes,2,opt,name=toUsername,proto3" json:"toUsername,omitempty"`
+	Amount        uint32                 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendFromTeamBudgetRequest) Reset() {
+	*x = SendFromTeamBudgetRequest{}
+	mi := &file_store_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendFromTeamBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendFromTeamBudgetRequest) ProtoMessage() {}
+
+func (x *SendFromTeamBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendFromTeamBudgetRequest.ProtoReflect.Descriptor instead.
+func (*SendFromTeamBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SendFromTeamBudgetRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *SendFromTeamBudgetRequest) GetToUsername() string {
 	if x != nil {
 		return x.ToUsername
 	}
 	return ""
 }
 
-func (x *SentCoinsInfo) GetAmount() uint32 {
+func (x *SendFromTeamBudgetRequest) GetAmount() uint32 {
 	if x != nil {
 		return x.Amount
 	}
 	return 0
 }
 
-var File_store_proto protoreflect.FileDescriptor
-
-const file_store_proto_rawDesc = "" +
-	"\n" +
-	"\vstore.proto\x12\bmerch.v1\"\x14\n" +
-	"\x12GetUserInfoRequest\"\x9f\x01\n" +
-	"\x13GetUserInfoResponse\x12\x18\n" +
+type SendFromTeamBudgetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendFromTeamBudgetResponse) Reset() {
+	*x = SendFromTeamBudgetResponse{}
+	mi := &file_store_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendFromTeamBudgetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendFromTeamBudgetResponse) ProtoMessage() {}
+
+func (x *SendFromTeamBudgetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendFromTeamBudgetResponse.ProtoReflect.Descriptor instead.
+func (*SendFromTeamBudgetResponse) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SendFromTeamBudgetResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type CreatePaymentRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PayerUsername string                 `protobuf:"bytes,1,opt,name=payerUsername,proto3" json:"payerUsername,omitempty"`
+	Amount        uint32                 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	ExpiryDays    uint32                 `protobuf:"varint,4,opt,name=expiryDays,proto3" json:"expiryDays,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePaymentRequestRequest) Reset() {
+	*x = CreatePaymentRequestRequest{}
+	mi := &file_store_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePaymentRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePaymentRequestRequest) ProtoMessage() {}
+
+func (x *CreatePaymentRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePaymentRequestRequest.ProtoReflect.Descriptor instead.
+func (*CreatePaymentRequestRequest) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreatePaymentRequestRequest) GetPayerUsername() string {
+	if x != nil {
+		return x.PayerUsername
+	}
+	return ""
+}
+
+func (x *CreatePaymentRequestRequest) GetAmount() uint32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CreatePaymentRequestRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *CreatePaymentRequestRequest) GetExpiryDays() uint32 {
+	if x != nil {
+		return x.ExpiryDays
+	}
+	return 0
+}
+
+type CreatePaymentRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestID     int32                  `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePaymentRequestResponse) Reset() {
+	*x = CreatePaymentRequestResponse{}
+	mi := &file_store_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePaymentRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePaymentRequestResponse) ProtoMessage() {}
+
+func (x *CreatePaymentRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePaymentRequestResponse.ProtoReflect.Descriptor instead.
+func (*CreatePaymentRequestResponse) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreatePaymentRequestResponse) GetRequestID() int32 {
+	if x != nil {
+		return x.RequestID
+	}
+	return 0
+}
+
+type ListPaymentRequestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPaymentRequestsRequest) Reset() {
+	*x = ListPaymentRequestsRequest{}
+	mi := &file_store_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPaymentRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPaymentRequestsRequest) ProtoMessage() {}
+
+func (x *ListPaymentRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPaymentRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListPaymentRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{16}
+}
+
+type ListPaymentRequestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Requests      []*PaymentRequestInfo  `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPaymentRequestsResponse) Reset() {
+	*x = ListPaymentRequestsResponse{}
+	mi := &file_store_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPaymentRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPaymentRequestsResponse) ProtoMessage() {}
+
+func (x *ListPaymentRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPaymentRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListPaymentRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListPaymentRequestsResponse) GetRequests() []*PaymentRequestInfo {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type AcceptPaymentRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestID     int32                  `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptPaymentRequestRequest) Reset() {
+	*x = AcceptPaymentRequestRequest{}
+	mi := &file_store_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptPaymentRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptPaymentRequestRequest) ProtoMessage() {}
+
+func (x *AcceptPaymentRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptPaymentRequestRequest.ProtoReflect.Descriptor instead.
+func (*AcceptPaymentRequestRequest) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AcceptPaymentRequestRequest) GetRequestID() int32 {
+	if x != nil {
+		return x.RequestID
+	}
+	return 0
+}
+
+type AcceptPaymentRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptPaymentRequestResponse) Reset() {
+	*x = AcceptPaymentRequestResponse{}
+	mi := &file_store_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptPaymentRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptPaymentRequestResponse) ProtoMessage() {}
+
+func (x *AcceptPaymentRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptPaymentRequestResponse.ProtoReflect.Descriptor instead.
+func (*AcceptPaymentRequestResponse) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AcceptPaymentRequestResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type DeclinePaymentRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestID     int32                  `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclinePaymentRequestRequest) Reset() {
+	*x = DeclinePaymentRequestRequest{}
+	mi := &file_store_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclinePaymentRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclinePaymentRequestRequest) ProtoMessage() {}
+
+func (x *DeclinePaymentRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclinePaymentRequestRequest.ProtoReflect.Descriptor instead.
+func (*DeclinePaymentRequestRequest) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeclinePaymentRequestRequest) GetRequestID() int32 {
+	if x != nil {
+		return x.RequestID
+	}
+	return 0
+}
+
+type DeclinePaymentRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclinePaymentRequestResponse) Reset() {
+	*x = DeclinePaymentRequestResponse{}
+	mi := &file_store_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclinePaymentRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclinePaymentRequestResponse) ProtoMessage() {}
+
+func (x *DeclinePaymentRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclinePaymentRequestResponse.ProtoReflect.Descriptor instead.
+func (*DeclinePaymentRequestResponse) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeclinePaymentRequestResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ScheduleTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ToUsername    string                 `protobuf:"bytes,1,opt,name=toUsername,proto3" json:"toUsername,omitempty"`
+	Amount        uint32                 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	StartAt       string                 `protobuf:"bytes,3,opt,name=startAt,proto3" json:"startAt,omitempty"`
+	Recurrence    string                 `protobuf:"bytes,4,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleTransferRequest) Reset() {
+	*x = ScheduleTransferRequest{}
+	mi := &file_store_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleTransferRequest) ProtoMessage() {}
+
+func (x *ScheduleTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleTransferRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleTransferRequest) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ScheduleTransferRequest) GetToUsername() string {
+	if x != nil {
+		return x.ToUsername
+	}
+	return ""
+}
+
+func (x *ScheduleTransferRequest) GetAmount() uint32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ScheduleTransferRequest) GetStartAt() string {
+	if x != nil {
+		return x.StartAt
+	}
+	return ""
+}
+
+func (x *ScheduleTransferRequest) GetRecurrence() string {
+	if x != nil {
+		return x.Recurrence
+	}
+	return ""
+}
+
+type ScheduleTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleID    int32                  `protobuf:"varint,1,opt,name=scheduleID,proto3" json:"scheduleID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleTransferResponse) Reset() {
+	*x = ScheduleTransferResponse{}
+	mi := &file_store_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleTransferResponse) ProtoMessage() {}
+
+func (x *ScheduleTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleTransferResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleTransferResponse) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ScheduleTransferResponse) GetScheduleID() int32 {
+	if x != nil {
+		return x.ScheduleID
+	}
+	return 0
+}
+
+type ListScheduledTransfersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduledTransfersRequest) Reset() {
+	*x = ListScheduledTransfersRequest{}
+	mi := &file_store_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduledTransfersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledTransfersRequest) ProtoMessage() {}
+
+func (x *ListScheduledTransfersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledTransfersRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduledTransfersRequest) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{24}
+}
+
+type ListScheduledTransfersResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Transfers     []*ScheduledTransferInfo `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduledTransfersResponse) Reset() {
+	*x = ListScheduledTransfersResponse{}
+	mi := &file_store_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduledTransfersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledTransfersResponse) ProtoMessage() {}
+
+func (x *ListScheduledTransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledTransfersResponse.ProtoReflect.Descriptor instead.
+func (*ListScheduledTransfersResponse) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListScheduledTransfersResponse) GetTransfers() []*ScheduledTransferInfo {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
+type CancelScheduledTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleID    int32                  `protobuf:"varint,1,opt,name=scheduleID,proto3" json:"scheduleID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScheduledTransferRequest) Reset() {
+	*x = CancelScheduledTransferRequest{}
+	mi := &file_store_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduledTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledTransferRequest) ProtoMessage() {}
+
+func (x *CancelScheduledTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledTransferRequest.ProtoReflect.Descriptor instead.
+func (*CancelScheduledTransferRequest) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CancelScheduledTransferRequest) GetScheduleID() int32 {
+	if x != nil {
+		return x.ScheduleID
+	}
+	return 0
+}
+
+type CancelScheduledTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScheduledTransferResponse) Reset() {
+	*x = CancelScheduledTransferResponse{}
+	mi := &file_store_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduledTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledTransferResponse) ProtoMessage() {}
+
+func (x *CancelScheduledTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledTransferResponse.ProtoReflect.Descriptor instead.
+func (*CancelScheduledTransferResponse) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CancelScheduledTransferResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type CreateListingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemName      string                 `protobuf:"bytes,1,opt,name=itemName,proto3" json:"itemName,omitempty"`
+	Price         uint32                 `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
+	Variant       string                 `protobuf:"bytes,3,opt,name=variant,proto3" json:"variant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateListingRequest) Reset() {
+	*x = CreateListingRequest{}
+	mi := &file_store_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateListingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateListingRequest) ProtoMessage() {}
+
+func (x *CreateListingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateListingRequest.ProtoReflect.Descriptor instead.
+func (*CreateListingRequest) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CreateListingRequest) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+func (x *CreateListingRequest) GetPrice() uint32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CreateListingRequest) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
+type CreateListingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListingID     int32                  `protobuf:"varint,1,opt,name=listingID,proto3" json:"listingID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateListingResponse) Reset() {
+	*x = CreateListingResponse{}
+	mi := &file_store_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateListingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateListingResponse) ProtoMessage() {}
+
+func (x *CreateListingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateListingResponse.ProtoReflect.Descriptor instead.
+func (*CreateListingResponse) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CreateListingResponse) GetListingID() int32 {
+	if x != nil {
+		return x.ListingID
+	}
+	return 0
+}
+
+type UpdateListingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListingID     int32                  `protobuf:"varint,1,opt,name=listingID,proto3" json:"listingID,omitempty"`
+	Price         uint32                 `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateListingRequest) Reset() {
+	*x = UpdateListingRequest{}
+	mi := &file_store_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateListingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateListingRequest) ProtoMessage() {}
+
+func (x *UpdateListingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateListingRequest.ProtoReflect.Descriptor instead.
+func (*UpdateListingRequest) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UpdateListingRequest) GetListingID() int32 {
+	if x != nil {
+		return x.ListingID
+	}
+	return 0
+}
+
+func (x *UpdateListingRequest) GetPrice() uint32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+type UpdateListingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateListingResponse) Reset() {
+	*x = UpdateListingResponse{}
+	mi := &file_store_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateListingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateListingResponse) ProtoMessage() {}
+
+func (x *UpdateListingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateListingResponse.ProtoReflect.Descriptor instead.
+func (*UpdateListingResponse) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UpdateListingResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type CancelListingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListingID     int32                  `protobuf:"varint,1,opt,name=listingID,proto3" json:"listingID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelListingRequest) Reset() {
+	*x = CancelListingRequest{}
+	mi := &file_store_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelListingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelListingRequest) ProtoMessage() {}
+
+func (x *CancelListingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelListingRequest.ProtoReflect.Descriptor instead.
+func (*CancelListingRequest) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CancelListingRequest) GetListingID() int32 {
+	if x != nil {
+		return x.ListingID
+	}
+	return 0
+}
+
+type CancelListingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelListingResponse) Reset() {
+	*x = CancelListingResponse{}
+	mi := &file_store_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelListingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelListingResponse) ProtoMessage() {}
+
+func (x *CancelListingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelListingResponse.ProtoReflect.Descriptor instead.
+func (*CancelListingResponse) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CancelListingResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type SearchListingsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Query          string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	SellerUsername string                 `protobuf:"bytes,2,opt,name=sellerUsername,proto3" json:"sellerUsername,omitempty"`
+	MaxPrice       uint32                 `protobuf:"varint,3,opt,name=maxPrice,proto3" json:"maxPrice,omitempty"`
+	Limit          int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset         int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SearchListingsRequest) Reset() {
+	*x = SearchListingsRequest{}
+	mi := &file_store_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchListingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchListingsRequest) ProtoMessage() {}
+
+func (x *SearchListingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchListingsRequest.ProtoReflect.Descriptor instead.
+func (*SearchListingsRequest) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SearchListingsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchListingsRequest) GetSellerUsername() string {
+	if x != nil {
+		return x.SellerUsername
+	}
+	return ""
+}
+
+func (x *SearchListingsRequest) GetMaxPrice() uint32 {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return 0
+}
+
+func (x *SearchListingsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchListingsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type SearchListingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Listings      []*ListingInfo         `protobuf:"bytes,1,rep,name=listings,proto3" json:"listings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchListingsResponse) Reset() {
+	*x = SearchListingsResponse{}
+	mi := &file_store_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchListingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchListingsResponse) ProtoMessage() {}
+
+func (x *SearchListingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchListingsResponse.ProtoReflect.Descriptor instead.
+func (*SearchListingsResponse) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SearchListingsResponse) GetListings() []*ListingInfo {
+	if x != nil {
+		return x.Listings
+	}
+	return nil
+}
+
+type BuyListingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListingID     int32                  `protobuf:"varint,1,opt,name=listingID,proto3" json:"listingID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuyListingRequest) Reset() {
+	*x = BuyListingRequest{}
+	mi := &file_store_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuyListingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuyListingRequest) ProtoMessage() {}
+
+func (x *BuyListingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuyListingRequest.ProtoReflect.Descriptor instead.
+func (*BuyListingRequest) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *BuyListingRequest) GetListingID() int32 {
+	if x != nil {
+		return x.ListingID
+	}
+	return 0
+}
+
+type BuyListingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuyListingResponse) Reset() {
+	*x = BuyListingResponse{}
+	mi := &file_store_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuyListingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuyListingResponse) ProtoMessage() {}
+
+func (x *BuyListingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuyListingResponse.ProtoReflect.Descriptor instead.
+func (*BuyListingResponse) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *BuyListingResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListAuctionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuctionsRequest) Reset() {
+	*x = ListAuctionsRequest{}
+	mi := &file_store_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuctionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuctionsRequest) ProtoMessage() {}
+
+func (x *ListAuctionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuctionsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuctionsRequest) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{38}
+}
+
+type ListAuctionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Auctions      []*AuctionInfo         `protobuf:"bytes,1,rep,name=auctions,proto3" json:"auctions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuctionsResponse) Reset() {
+	*x = ListAuctionsResponse{}
+	mi := &file_store_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuctionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuctionsResponse) ProtoMessage() {}
+
+func (x *ListAuctionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuctionsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuctionsResponse) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListAuctionsResponse) GetAuctions() []*AuctionInfo {
+	if x != nil {
+		return x.Auctions
+	}
+	return nil
+}
+
+type PlaceBidRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuctionID     int32                  `protobuf:"varint,1,opt,name=auctionID,proto3" json:"auctionID,omitempty"`
+	Amount        uint32                 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaceBidRequest) Reset() {
+	*x = PlaceBidRequest{}
+	mi := &file_store_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceBidRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceBidRequest) ProtoMessage() {}
+
+func (x *PlaceBidRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceBidRequest.ProtoReflect.Descriptor instead.
+func (*PlaceBidRequest) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PlaceBidRequest) GetAuctionID() int32 {
+	if x != nil {
+		return x.AuctionID
+	}
+	return 0
+}
+
+func (x *PlaceBidRequest) GetAmount() uint32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type PlaceBidResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaceBidResponse) Reset() {
+	*x = PlaceBidResponse{}
+	mi := &file_store_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceBidResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceBidResponse) ProtoMessage() {}
+
+func (x *PlaceBidResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceBidResponse.ProtoReflect.Descriptor instead.
+func (*PlaceBidResponse) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *PlaceBidResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListRafflesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRafflesRequest) Reset() {
+	*x = ListRafflesRequest{}
+	mi := &file_store_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRafflesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRafflesRequest) ProtoMessage() {}
+
+func (x *ListRafflesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRafflesRequest.ProtoReflect.Descriptor instead.
+func (*ListRafflesRequest) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{42}
+}
+
+type ListRafflesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Raffles       []*RaffleInfo          `protobuf:"bytes,1,rep,name=raffles,proto3" json:"raffles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRafflesResponse) Reset() {
+	*x = ListRafflesResponse{}
+	mi := &file_store_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRafflesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRafflesResponse) ProtoMessage() {}
+
+func (x *ListRafflesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRafflesResponse.ProtoReflect.Descriptor instead.
+func (*ListRafflesResponse) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListRafflesResponse) GetRaffles() []*RaffleInfo {
+	if x != nil {
+		return x.Raffles
+	}
+	return nil
+}
+
+type BuyRaffleTicketsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RaffleID      int32                  `protobuf:"varint,1,opt,name=raffleID,proto3" json:"raffleID,omitempty"`
+	Count         uint32                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuyRaffleTicketsRequest) Reset() {
+	*x = BuyRaffleTicketsRequest{}
+	mi := &file_store_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuyRaffleTicketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuyRaffleTicketsRequest) ProtoMessage() {}
+
+func (x *BuyRaffleTicketsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuyRaffleTicketsRequest.ProtoReflect.Descriptor instead.
+func (*BuyRaffleTicketsRequest) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *BuyRaffleTicketsRequest) GetRaffleID() int32 {
+	if x != nil {
+		return x.RaffleID
+	}
+	return 0
+}
+
+func (x *BuyRaffleTicketsRequest) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type BuyRaffleTicketsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuyRaffleTicketsResponse) Reset() {
+	*x = BuyRaffleTicketsResponse{}
+	mi := &file_store_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuyRaffleTicketsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuyRaffleTicketsResponse) ProtoMessage() {}
+
+func (x *BuyRaffleTicketsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuyRaffleTicketsResponse.ProtoReflect.Descriptor instead.
+func (*BuyRaffleTicketsResponse) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *BuyRaffleTicketsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type AddToWishlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemName      string                 `protobuf:"bytes,1,opt,name=itemName,proto3" json:"itemName,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddToWishlistRequest) Reset() {
+	*x = AddToWishlistRequest{}
+	mi := &file_store_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddToWishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddToWishlistRequest) ProtoMessage() {}
+
+func (x *AddToWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddToWishlistRequest.ProtoReflect.Descriptor instead.
+func (*AddToWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *AddToWishlistRequest) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+type AddToWishlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddToWishlistResponse) Reset() {
+	*x = AddToWishlistResponse{}
+	mi := &file_store_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddToWishlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddToWishlistResponse) ProtoMessage() {}
+
+func (x *AddToWishlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddToWishlistResponse.ProtoReflect.Descriptor instead.
+func (*AddToWishlistResponse) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *AddToWishlistResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RemoveFromWishlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemName      string                 `protobuf:"bytes,1,opt,name=itemName,proto3" json:"itemName,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFromWishlistRequest) Reset() {
+	*x = RemoveFromWishlistRequest{}
+	mi := &file_store_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFromWishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFromWishlistRequest) ProtoMessage() {}
+
+func (x *RemoveFromWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFromWishlistRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFromWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RemoveFromWishlistRequest) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+type RemoveFromWishlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFromWishlistResponse) Reset() {
+	*x = RemoveFromWishlistResponse{}
+	mi := &file_store_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFromWishlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFromWishlistResponse) ProtoMessage() {}
+
+func (x *RemoveFromWishlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFromWishlistResponse.ProtoReflect.Descriptor instead.
+func (*RemoveFromWishlistResponse) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RemoveFromWishlistResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListWishlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWishlistRequest) Reset() {
+	*x = ListWishlistRequest{}
+	mi := &file_store_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWishlistRequest) ProtoMessage() {}
+
+func (x *ListWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWishlistRequest.ProtoReflect.Descriptor instead.
+func (*ListWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{50}
+}
+
+type ListWishlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*WishlistItem        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWishlistResponse) Reset() {
+	*x = ListWishlistResponse{}
+	mi := &file_store_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWishlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWishlistResponse) ProtoMessage() {}
+
+func (x *ListWishlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWishlistResponse.ProtoReflect.Descriptor instead.
+func (*ListWishlistResponse) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListWishlistResponse) GetItems() []*WishlistItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ListWishlistEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWishlistEventsRequest) Reset() {
+	*x = ListWishlistEventsRequest{}
+	mi := &file_store_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWishlistEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWishlistEventsRequest) ProtoMessage() {}
+
+func (x *ListWishlistEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWishlistEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListWishlistEventsRequest) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{52}
+}
+
+type ListWishlistEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*WishlistEvent       `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWishlistEventsResponse) Reset() {
+	*x = ListWishlistEventsResponse{}
+	mi := &file_store_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWishlistEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWishlistEventsResponse) ProtoMessage() {}
+
+func (x *ListWishlistEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWishlistEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListWishlistEventsResponse) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListWishlistEventsResponse) GetEvents() []*WishlistEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type ListGoodsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGoodsRequest) Reset() {
+	*x = ListGoodsRequest{}
+	mi := &file_store_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGoodsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGoodsRequest) ProtoMessage() {}
+
+func (x *ListGoodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGoodsRequest.ProtoReflect.Descriptor instead.
+func (*ListGoodsRequest) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListGoodsRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+type ListGoodsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*CatalogItem         `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGoodsResponse) Reset() {
+	*x = ListGoodsResponse{}
+	mi := &file_store_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGoodsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGoodsResponse) ProtoMessage() {}
+
+func (x *ListGoodsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGoodsResponse.ProtoReflect.Descriptor instead.
+func (*ListGoodsResponse) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListGoodsResponse) GetItems() []*CatalogItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type GetPriceHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemName      string                 `protobuf:"bytes,1,opt,name=itemName,proto3" json:"itemName,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceHistoryRequest) Reset() {
+	*x = GetPriceHistoryRequest{}
+	mi := &file_store_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceHistoryRequest) ProtoMessage() {}
+
+func (x *GetPriceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetPriceHistoryRequest) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+type GetPriceHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BasePrice     uint32                 `protobuf:"varint,1,opt,name=basePrice,proto3" json:"basePrice,omitempty"`
+	Changes       []*PriceChange         `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceHistoryResponse) Reset() {
+	*x = GetPriceHistoryResponse{}
+	mi := &file_store_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceHistoryResponse) ProtoMessage() {}
+
+func (x *GetPriceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetPriceHistoryResponse) GetBasePrice() uint32 {
+	if x != nil {
+		return x.BasePrice
+	}
+	return 0
+}
+
+func (x *GetPriceHistoryResponse) GetChanges() []*PriceChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type ListCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesRequest) Reset() {
+	*x = ListCategoriesRequest{}
+	mi := &file_store_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesRequest) ProtoMessage() {}
+
+func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{58}
+}
+
+type ListCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*CategoryInfo        `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesResponse) Reset() {
+	*x = ListCategoriesResponse{}
+	mi := &file_store_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesResponse) ProtoMessage() {}
+
+func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListCategoriesResponse) GetCategories() []*CategoryInfo {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type PlacePreorderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemName      string                 `protobuf:"bytes,1,opt,name=itemName,proto3" json:"itemName,omitempty"`
+	Variant       string                 `protobuf:"bytes,2,opt,name=variant,proto3" json:"variant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlacePreorderRequest) Reset() {
+	*x = PlacePreorderRequest{}
+	mi := &file_store_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlacePreorderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlacePreorderRequest) ProtoMessage() {}
+
+func (x *PlacePreorderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlacePreorderRequest.ProtoReflect.Descriptor instead.
+func (*PlacePreorderRequest) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *PlacePreorderRequest) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+func (x *PlacePreorderRequest) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
+type PlacePreorderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PreorderID    int32                  `protobuf:"varint,1,opt,name=preorderID,proto3" json:"preorderID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlacePreorderResponse) Reset() {
+	*x = PlacePreorderResponse{}
+	mi := &file_store_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlacePreorderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlacePreorderResponse) ProtoMessage() {}
+
+func (x *PlacePreorderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlacePreorderResponse.ProtoReflect.Descriptor instead.
+func (*PlacePreorderResponse) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *PlacePreorderResponse) GetPreorderID() int32 {
+	if x != nil {
+		return x.PreorderID
+	}
+	return 0
+}
+
+type ListPreordersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPreordersRequest) Reset() {
+	*x = ListPreordersRequest{}
+	mi := &file_store_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPreordersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPreordersRequest) ProtoMessage() {}
+
+func (x *ListPreordersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPreordersRequest.ProtoReflect.Descriptor instead.
+func (*ListPreordersRequest) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{62}
+}
+
+type ListPreordersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Preorders     []*PreorderInfo        `protobuf:"bytes,1,rep,name=preorders,proto3" json:"preorders,omitempty"`
+	HeldCoins     uint32                 `protobuf:"varint,2,opt,name=heldCoins,proto3" json:"heldCoins,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPreordersResponse) Reset() {
+	*x = ListPreordersResponse{}
+	mi := &file_store_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPreordersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPreordersResponse) ProtoMessage() {}
+
+func (x *ListPreordersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPreordersResponse.ProtoReflect.Descriptor instead.
+func (*ListPreordersResponse) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListPreordersResponse) GetPreorders() []*PreorderInfo {
+	if x != nil {
+		return x.Preorders
+	}
+	return nil
+}
+
+func (x *ListPreordersResponse) GetHeldCoins() uint32 {
+	if x != nil {
+		return x.HeldCoins
+	}
+	return 0
+}
+
+type CancelPreorderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PreorderID    int32                  `protobuf:"varint,1,opt,name=preorderID,proto3" json:"preorderID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelPreorderRequest) Reset() {
+	*x = CancelPreorderRequest{}
+	mi := &file_store_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelPreorderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPreorderRequest) ProtoMessage() {}
+
+func (x *CancelPreorderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPreorderRequest.ProtoReflect.Descriptor instead.
+func (*CancelPreorderRequest) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *CancelPreorderRequest) GetPreorderID() int32 {
+	if x != nil {
+		return x.PreorderID
+	}
+	return 0
+}
+
+type CancelPreorderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelPreorderResponse) Reset() {
+	*x = CancelPreorderResponse{}
+	mi := &file_store_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelPreorderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPreorderResponse) ProtoMessage() {}
+
+func (x *CancelPreorderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPreorderResponse.ProtoReflect.Descriptor instead.
+func (*CancelPreorderResponse) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *CancelPreorderResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type SubscribeEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeEventsRequest) Reset() {
+	*x = SubscribeEventsRequest{}
+	mi := &file_store_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeEventsRequest) ProtoMessage() {}
+
+func (x *SubscribeEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeEventsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeEventsRequest) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{66}
+}
+
+type InventoryItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Quantity      uint32                 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Gifts         []*GiftInfo            `protobuf:"bytes,3,rep,name=gifts,proto3" json:"gifts,omitempty"`
+	Variants      []*InventoryVariant    `protobuf:"bytes,4,rep,name=variants,proto3" json:"variants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InventoryItem) Reset() {
+	*x = InventoryItem{}
+	mi := &file_store_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryItem) ProtoMessage() {}
+
+func (x *InventoryItem) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryItem.ProtoReflect.Descriptor instead.
+func (*InventoryItem) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *InventoryItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InventoryItem) GetQuantity() uint32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *InventoryItem) GetGifts() []*GiftInfo {
+	if x != nil {
+		return x.Gifts
+	}
+	return nil
+}
+
+func (x *InventoryItem) GetVariants() []*InventoryVariant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+type InventoryVariant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Quantity      uint32                 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InventoryVariant) Reset() {
+	*x = InventoryVariant{}
+	mi := &file_store_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryVariant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryVariant) ProtoMessage() {}
+
+func (x *InventoryVariant) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryVariant.ProtoReflect.Descriptor instead.
+func (*InventoryVariant) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *InventoryVariant) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *InventoryVariant) GetQuantity() uint32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type GiftInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromUsername  string                 `protobuf:"bytes,1,opt,name=fromUsername,proto3" json:"fromUsername,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GiftInfo) Reset() {
+	*x = GiftInfo{}
+	mi := &file_store_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GiftInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GiftInfo) ProtoMessage() {}
+
+func (x *GiftInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GiftInfo.ProtoReflect.Descriptor instead.
+func (*GiftInfo) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GiftInfo) GetFromUsername() string {
+	if x != nil {
+		return x.FromUsername
+	}
+	return ""
+}
+
+func (x *GiftInfo) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type CoinHistory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Received      []*ReceivedCoinsInfo   `protobuf:"bytes,1,rep,name=received,proto3" json:"received,omitempty"`
+	Sent          []*SentCoinsInfo       `protobuf:"bytes,2,rep,name=sent,proto3" json:"sent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CoinHistory) Reset() {
+	*x = CoinHistory{}
+	mi := &file_store_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CoinHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoinHistory) ProtoMessage() {}
+
+func (x *CoinHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoinHistory.ProtoReflect.Descriptor instead.
+func (*CoinHistory) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CoinHistory) GetReceived() []*ReceivedCoinsInfo {
+	if x != nil {
+		return x.Received
+	}
+	return nil
+}
+
+func (x *CoinHistory) GetSent() []*SentCoinsInfo {
+	if x != nil {
+		return x.Sent
+	}
+	return nil
+}
+
+type ReceivedCoinsInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromUsername  string                 `protobuf:"bytes,1,opt,name=fromUsername,proto3" json:"fromUsername,omitempty"`
+	Amount        uint32                 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceivedCoinsInfo) Reset() {
+	*x = ReceivedCoinsInfo{}
+	mi := &file_store_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceivedCoinsInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceivedCoinsInfo) ProtoMessage() {}
+
+func (x *ReceivedCoinsInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceivedCoinsInfo.ProtoReflect.Descriptor instead.
+func (*ReceivedCoinsInfo) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ReceivedCoinsInfo) GetFromUsername() string {
+	if x != nil {
+		return x.FromUsername
+	}
+	return ""
+}
+
+func (x *ReceivedCoinsInfo) GetAmount() uint32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type SentCoinsInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ToUsername    string                 `protobuf:"bytes,1,opt,name=toUsername,proto3" json:"toUsername,omitempty"`
+	Amount        uint32                 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SentCoinsInfo) Reset() {
+	*x = SentCoinsInfo{}
+	mi := &file_store_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SentCoinsInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SentCoinsInfo) ProtoMessage() {}
+
+func (x *SentCoinsInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SentCoinsInfo.ProtoReflect.Descriptor instead.
+func (*SentCoinsInfo) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *SentCoinsInfo) GetToUsername() string {
+	if x != nil {
+		return x.ToUsername
+	}
+	return ""
+}
+
+func (x *SentCoinsInfo) GetAmount() uint32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type ItemHistory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Received      []*ReceivedItemInfo    `protobuf:"bytes,1,rep,name=received,proto3" json:"received,omitempty"`
+	Sent          []*SentItemInfo        `protobuf:"bytes,2,rep,name=sent,proto3" json:"sent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ItemHistory) Reset() {
+	*x = ItemHistory{}
+	mi := &file_store_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemHistory) ProtoMessage() {}
+
+func (x *ItemHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemHistory.ProtoReflect.Descriptor instead.
+func (*ItemHistory) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ItemHistory) GetReceived() []*ReceivedItemInfo {
+	if x != nil {
+		return x.Received
+	}
+	return nil
+}
+
+func (x *ItemHistory) GetSent() []*SentItemInfo {
+	if x != nil {
+		return x.Sent
+	}
+	return nil
+}
+
+type ReceivedItemInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromUsername  string                 `protobuf:"bytes,1,opt,name=fromUsername,proto3" json:"fromUsername,omitempty"`
+	ItemName      string                 `protobuf:"bytes,2,opt,name=itemName,proto3" json:"itemName,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceivedItemInfo) Reset() {
+	*x = ReceivedItemInfo{}
+	mi := &file_store_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceivedItemInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceivedItemInfo) ProtoMessage() {}
+
+func (x *ReceivedItemInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceivedItemInfo.ProtoReflect.Descriptor instead.
+func (*ReceivedItemInfo) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ReceivedItemInfo) GetFromUsername() string {
+	if x != nil {
+		return x.FromUsername
+	}
+	return ""
+}
+
+func (x *ReceivedItemInfo) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+type SentItemInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ToUsername    string                 `protobuf:"bytes,1,opt,name=toUsername,proto3" json:"toUsername,omitempty"`
+	ItemName      string                 `protobuf:"bytes,2,opt,name=itemName,proto3" json:"itemName,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SentItemInfo) Reset() {
+	*x = SentItemInfo{}
+	mi := &file_store_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SentItemInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SentItemInfo) ProtoMessage() {}
+
+func (x *SentItemInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SentItemInfo.ProtoReflect.Descriptor instead.
+func (*SentItemInfo) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *SentItemInfo) GetToUsername() string {
+	if x != nil {
+		return x.ToUsername
+	}
+	return ""
+}
+
+func (x *SentItemInfo) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+type CoinTransfer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ToUsername    string                 `protobuf:"bytes,1,opt,name=toUsername,proto3" json:"toUsername,omitempty"`
+	Amount        uint32                 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CoinTransfer) Reset() {
+	*x = CoinTransfer{}
+	mi := &file_store_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CoinTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoinTransfer) ProtoMessage() {}
+
+func (x *CoinTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoinTransfer.ProtoReflect.Descriptor instead.
+func (*CoinTransfer) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *CoinTransfer) GetToUsername() string {
+	if x != nil {
+		return x.ToUsername
+	}
+	return ""
+}
+
+func (x *CoinTransfer) GetAmount() uint32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type PaymentRequestInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FromUsername  string                 `protobuf:"bytes,2,opt,name=fromUsername,proto3" json:"fromUsername,omitempty"`
+	Amount        uint32                 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,6,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentRequestInfo) Reset() {
+	*x = PaymentRequestInfo{}
+	mi := &file_store_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentRequestInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentRequestInfo) ProtoMessage() {}
+
+func (x *PaymentRequestInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentRequestInfo.ProtoReflect.Descriptor instead.
+func (*PaymentRequestInfo) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *PaymentRequestInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PaymentRequestInfo) GetFromUsername() string {
+	if x != nil {
+		return x.FromUsername
+	}
+	return ""
+}
+
+func (x *PaymentRequestInfo) GetAmount() uint32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PaymentRequestInfo) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *PaymentRequestInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *PaymentRequestInfo) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type ScheduledTransferInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ToUsername    string                 `protobuf:"bytes,2,opt,name=toUsername,proto3" json:"toUsername,omitempty"`
+	Amount        uint32                 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Recurrence    string                 `protobuf:"bytes,4,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	NextRunAt     string                 `protobuf:"bytes,6,opt,name=nextRunAt,proto3" json:"nextRunAt,omitempty"`
+	Attempts      int32                  `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError     string                 `protobuf:"bytes,8,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduledTransferInfo) Reset() {
+	*x = ScheduledTransferInfo{}
+	mi := &file_store_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledTransferInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledTransferInfo) ProtoMessage() {}
+
+func (x *ScheduledTransferInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledTransferInfo.ProtoReflect.Descriptor instead.
+func (*ScheduledTransferInfo) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ScheduledTransferInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ScheduledTransferInfo) GetToUsername() string {
+	if x != nil {
+		return x.ToUsername
+	}
+	return ""
+}
+
+func (x *ScheduledTransferInfo) GetAmount() uint32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ScheduledTransferInfo) GetRecurrence() string {
+	if x != nil {
+		return x.Recurrence
+	}
+	return ""
+}
+
+func (x *ScheduledTransferInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ScheduledTransferInfo) GetNextRunAt() string {
+	if x != nil {
+		return x.NextRunAt
+	}
+	return ""
+}
+
+func (x *ScheduledTransferInfo) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *ScheduledTransferInfo) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+type ListingInfo struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ItemName       string                 `protobuf:"bytes,2,opt,name=itemName,proto3" json:"itemName,omitempty"`
+	SellerUsername string                 `protobuf:"bytes,3,opt,name=sellerUsername,proto3" json:"sellerUsername,omitempty"`
+	Price          uint32                 `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Variant        string                 `protobuf:"bytes,6,opt,name=variant,proto3" json:"variant,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListingInfo) Reset() {
+	*x = ListingInfo{}
+	mi := &file_store_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListingInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListingInfo) ProtoMessage() {}
+
+func (x *ListingInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListingInfo.ProtoReflect.Descriptor instead.
+func (*ListingInfo) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ListingInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ListingInfo) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+func (x *ListingInfo) GetSellerUsername() string {
+	if x != nil {
+		return x.SellerUsername
+	}
+	return ""
+}
+
+func (x *ListingInfo) GetPrice() uint32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ListingInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ListingInfo) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
+type AuctionInfo struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ItemName          string                 `protobuf:"bytes,2,opt,name=itemName,proto3" json:"itemName,omitempty"`
+	StartsAt          string                 `protobuf:"bytes,3,opt,name=startsAt,proto3" json:"startsAt,omitempty"`
+	EndsAt            string                 `protobuf:"bytes,4,opt,name=endsAt,proto3" json:"endsAt,omitempty"`
+	TopBid            uint32                 `protobuf:"varint,5,opt,name=topBid,proto3" json:"topBid,omitempty"`
+	TopBidderUsername string                 `protobuf:"bytes,6,opt,name=topBidderUsername,proto3" json:"topBidderUsername,omitempty"`
+	ReserveMet        bool                   `protobuf:"varint,7,opt,name=reserveMet,proto3" json:"reserveMet,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AuctionInfo) Reset() {
+	*x = AuctionInfo{}
+	mi := &file_store_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuctionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuctionInfo) ProtoMessage() {}
+
+func (x *AuctionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuctionInfo.ProtoReflect.Descriptor instead.
+func (*AuctionInfo) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *AuctionInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuctionInfo) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+func (x *AuctionInfo) GetStartsAt() string {
+	if x != nil {
+		return x.StartsAt
+	}
+	return ""
+}
+
+func (x *AuctionInfo) GetEndsAt() string {
+	if x != nil {
+		return x.EndsAt
+	}
+	return ""
+}
+
+func (x *AuctionInfo) GetTopBid() uint32 {
+	if x != nil {
+		return x.TopBid
+	}
+	return 0
+}
+
+func (x *AuctionInfo) GetTopBidderUsername() string {
+	if x != nil {
+		return x.TopBidderUsername
+	}
+	return ""
+}
+
+func (x *AuctionInfo) GetReserveMet() bool {
+	if x != nil {
+		return x.ReserveMet
+	}
+	return false
+}
+
+type RaffleInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ItemName      string                 `protobuf:"bytes,2,opt,name=itemName,proto3" json:"itemName,omitempty"`
+	TicketPrice   uint32                 `protobuf:"varint,3,opt,name=ticketPrice,proto3" json:"ticketPrice,omitempty"`
+	DrawAt        string                 `protobuf:"bytes,4,opt,name=drawAt,proto3" json:"drawAt,omitempty"`
+	TicketsSold   uint32                 `protobuf:"varint,5,opt,name=ticketsSold,proto3" json:"ticketsSold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RaffleInfo) Reset() {
+	*x = RaffleInfo{}
+	mi := &file_store_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RaffleInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RaffleInfo) ProtoMessage() {}
+
+func (x *RaffleInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RaffleInfo.ProtoReflect.Descriptor instead.
+func (*RaffleInfo) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *RaffleInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RaffleInfo) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+func (x *RaffleInfo) GetTicketPrice() uint32 {
+	if x != nil {
+		return x.TicketPrice
+	}
+	return 0
+}
+
+func (x *RaffleInfo) GetDrawAt() string {
+	if x != nil {
+		return x.DrawAt
+	}
+	return ""
+}
+
+func (x *RaffleInfo) GetTicketsSold() uint32 {
+	if x != nil {
+		return x.TicketsSold
+	}
+	return 0
+}
+
+type WishlistItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Price         uint32                 `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
+	CoinsNeeded   uint32                 `protobuf:"varint,3,opt,name=coinsNeeded,proto3" json:"coinsNeeded,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WishlistItem) Reset() {
+	*x = WishlistItem{}
+	mi := &file_store_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WishlistItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WishlistItem) ProtoMessage() {}
+
+func (x *WishlistItem) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WishlistItem.ProtoReflect.Descriptor instead.
+func (*WishlistItem) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *WishlistItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WishlistItem) GetPrice() uint32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *WishlistItem) GetCoinsNeeded() uint32 {
+	if x != nil {
+		return x.CoinsNeeded
+	}
+	return 0
+}
+
+type WishlistEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	ItemName      string                 `protobuf:"bytes,2,opt,name=itemName,proto3" json:"itemName,omitempty"`
+	OldPrice      uint32                 `protobuf:"varint,3,opt,name=oldPrice,proto3" json:"oldPrice,omitempty"`
+	NewPrice      uint32                 `protobuf:"varint,4,opt,name=newPrice,proto3" json:"newPrice,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WishlistEvent) Reset() {
+	*x = WishlistEvent{}
+	mi := &file_store_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WishlistEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WishlistEvent) ProtoMessage() {}
+
+func (x *WishlistEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WishlistEvent.ProtoReflect.Descriptor instead.
+func (*WishlistEvent) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *WishlistEvent) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *WishlistEvent) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+func (x *WishlistEvent) GetOldPrice() uint32 {
+	if x != nil {
+		return x.OldPrice
+	}
+	return 0
+}
+
+func (x *WishlistEvent) GetNewPrice() uint32 {
+	if x != nil {
+		return x.NewPrice
+	}
+	return 0
+}
+
+func (x *WishlistEvent) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CatalogItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Price         uint32                 `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
+	BasePrice     uint32                 `protobuf:"varint,3,opt,name=basePrice,proto3" json:"basePrice,omitempty"`
+	SaleEndsAt    string                 `protobuf:"bytes,4,opt,name=saleEndsAt,proto3" json:"saleEndsAt,omitempty"`
+	Variants      []*CatalogVariant      `protobuf:"bytes,5,rep,name=variants,proto3" json:"variants,omitempty"`
+	Category      string                 `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`
+	Description   string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	Images        []*GoodImage           `protobuf:"bytes,8,rep,name=images,proto3" json:"images,omitempty"`
+	Components    []*BundleComponent     `protobuf:"bytes,9,rep,name=components,proto3" json:"components,omitempty"`
+	Upcoming      bool                   `protobuf:"varint,10,opt,name=upcoming,proto3" json:"upcoming,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CatalogItem) Reset() {
+	*x = CatalogItem{}
+	mi := &file_store_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CatalogItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogItem) ProtoMessage() {}
+
+func (x *CatalogItem) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogItem.ProtoReflect.Descriptor instead.
+func (*CatalogItem) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *CatalogItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CatalogItem) GetPrice() uint32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CatalogItem) GetBasePrice() uint32 {
+	if x != nil {
+		return x.BasePrice
+	}
+	return 0
+}
+
+func (x *CatalogItem) GetSaleEndsAt() string {
+	if x != nil {
+		return x.SaleEndsAt
+	}
+	return ""
+}
+
+func (x *CatalogItem) GetVariants() []*CatalogVariant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *CatalogItem) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CatalogItem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CatalogItem) GetImages() []*GoodImage {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *CatalogItem) GetComponents() []*BundleComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *CatalogItem) GetUpcoming() bool {
+	if x != nil {
+		return x.Upcoming
+	}
+	return false
+}
+
+type GoodImage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	AltText       string                 `protobuf:"bytes,2,opt,name=altText,proto3" json:"altText,omitempty"`
+	Width         uint32                 `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`
+	Height        uint32                 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodImage) Reset() {
+	*x = GoodImage{}
+	mi := &file_store_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodImage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodImage) ProtoMessage() {}
+
+func (x *GoodImage) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodImage.ProtoReflect.Descriptor instead.
+func (*GoodImage) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GoodImage) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *GoodImage) GetAltText() string {
+	if x != nil {
+		return x.AltText
+	}
+	return ""
+}
+
+func (x *GoodImage) GetWidth() uint32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *GoodImage) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type CategoryInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryInfo) Reset() {
+	*x = CategoryInfo{}
+	mi := &file_store_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryInfo) ProtoMessage() {}
+
+func (x *CategoryInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryInfo.ProtoReflect.Descriptor instead.
+func (*CategoryInfo) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *CategoryInfo) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *CategoryInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CatalogVariant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Attributes    map[string]string      `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Price         uint32                 `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	StockTracked  bool                   `protobuf:"varint,4,opt,name=stockTracked,proto3" json:"stockTracked,omitempty"`
+	Stock         uint32                 `protobuf:"varint,5,opt,name=stock,proto3" json:"stock,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CatalogVariant) Reset() {
+	*x = CatalogVariant{}
+	mi := &file_store_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CatalogVariant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogVariant) ProtoMessage() {}
+
+func (x *CatalogVariant) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogVariant.ProtoReflect.Descriptor instead.
+func (*CatalogVariant) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *CatalogVariant) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *CatalogVariant) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *CatalogVariant) GetPrice() uint32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CatalogVariant) GetStockTracked() bool {
+	if x != nil {
+		return x.StockTracked
+	}
+	return false
+}
+
+func (x *CatalogVariant) GetStock() uint32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+type BundleComponent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemName      string                 `protobuf:"bytes,1,opt,name=itemName,proto3" json:"itemName,omitempty"`
+	Quantity      uint32                 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BundleComponent) Reset() {
+	*x = BundleComponent{}
+	mi := &file_store_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BundleComponent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BundleComponent) ProtoMessage() {}
+
+func (x *BundleComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BundleComponent.ProtoReflect.Descriptor instead.
+func (*BundleComponent) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *BundleComponent) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+func (x *BundleComponent) GetQuantity() uint32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type PreorderInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ItemName      string                 `protobuf:"bytes,2,opt,name=itemName,proto3" json:"itemName,omitempty"`
+	Variant       string                 `protobuf:"bytes,3,opt,name=variant,proto3" json:"variant,omitempty"`
+	Price         uint32                 `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	ResolvedAt    string                 `protobuf:"bytes,7,opt,name=resolvedAt,proto3" json:"resolvedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreorderInfo) Reset() {
+	*x = PreorderInfo{}
+	mi := &file_store_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreorderInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreorderInfo) ProtoMessage() {}
+
+func (x *PreorderInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreorderInfo.ProtoReflect.Descriptor instead.
+func (*PreorderInfo) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *PreorderInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PreorderInfo) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+func (x *PreorderInfo) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
+func (x *PreorderInfo) GetPrice() uint32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PreorderInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PreorderInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *PreorderInfo) GetResolvedAt() string {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return ""
+}
+
+type PriceChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Price         uint32                 `protobuf:"varint,1,opt,name=price,proto3" json:"price,omitempty"`
+	StartsAt      string                 `protobuf:"bytes,2,opt,name=startsAt,proto3" json:"startsAt,omitempty"`
+	EndsAt        string                 `protobuf:"bytes,3,opt,name=endsAt,proto3" json:"endsAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceChange) Reset() {
+	*x = PriceChange{}
+	mi := &file_store_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceChange) ProtoMessage() {}
+
+func (x *PriceChange) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceChange.ProtoReflect.Descriptor instead.
+func (*PriceChange) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *PriceChange) GetPrice() uint32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PriceChange) GetStartsAt() string {
+	if x != nil {
+		return x.StartsAt
+	}
+	return ""
+}
+
+func (x *PriceChange) GetEndsAt() string {
+	if x != nil {
+		return x.EndsAt
+	}
+	return ""
+}
+
+type UserEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Data          string                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	OccurredAt    string                 `protobuf:"bytes,3,opt,name=occurredAt,proto3" json:"occurredAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserEvent) Reset() {
+	*x = UserEvent{}
+	mi := &file_store_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserEvent) ProtoMessage() {}
+
+func (x *UserEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserEvent.ProtoReflect.Descriptor instead.
+func (*UserEvent) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *UserEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *UserEvent) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *UserEvent) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+var File_store_proto protoreflect.FileDescriptor
+
+const file_store_proto_rawDesc = "" +
+	"\n" +
+	"\vstore.proto\x12\bmerch.v1\">\n" +
+	"\x12GetUserInfoRequest\x12(\n" +
+	"\x0fincludeWishlist\x18\x01 \x01(\bR\x0fincludeWishlist\"\x8c\x02\n" +
+	"\x13GetUserInfoResponse\x12\x18\n" +
 	"\abalance\x18\x01 \x01(\rR\abalance\x125\n" +
 	"\tinventory\x18\x02 \x03(\v2\x17.merch.v1.InventoryItemR\tinventory\x127\n" +
-	"\vcoinHistory\x18\x03 \x01(\v2\x15.merch.v1.CoinHistoryR\vcoinHistory\"J\n" +
+	"\vcoinHistory\x18\x03 \x01(\v2\x15.merch.v1.CoinHistoryR\vcoinHistory\x127\n" +
+	"\vitemHistory\x18\x04 \x01(\v2\x15.merch.v1.ItemHistoryR\vitemHistory\x122\n" +
+	"\bwishlist\x18\x05 \x03(\v2\x16.merch.v1.WishlistItemR\bwishlist\"J\n" +
 	"\x10SendCoinsRequest\x12\x1e\n" +
 	"\n" +
 	"toUsername\x18\x01 \x01(\tR\n" +
 	"toUsername\x12\x16\n" +
 	"\x06amount\x18\x02 \x01(\rR\x06amount\"-\n" +
 	"\x11SendCoinsResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\",\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"M\n" +
+	"\x15SendCoinsBatchRequest\x124\n" +
+	"\ttransfers\x18\x01 \x03(\v2\x16.merch.v1.CoinTransferR\ttransfers\"2\n" +
+	"\x16SendCoinsBatchResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"d\n" +
 	"\x0eBuyItemRequest\x12\x1a\n" +
-	"\bitemName\x18\x01 \x01(\tR\bitemName\"+\n" +
+	"\bitemName\x18\x01 \x01(\tR\bitemName\x12\x1c\n" +
+	"\tpromoCode\x18\x02 \x01(\tR\tpromoCode\x12\x18\n" +
+	"\avariant\x18\x03 \x01(\tR\avariant\"+\n" +
 	"\x0fBuyItemResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"?\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x81\x01\n" +
+	"\x0fGiftItemRequest\x12\x1a\n" +
+	"\bitemName\x18\x01 \x01(\tR\bitemName\x12\x1e\n" +
+	"\n" +
+	"toUsername\x18\x02 \x01(\tR\n" +
+	"toUsername\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x18\n" +
+	"\avariant\x18\x04 \x01(\tR\avariant\",\n" +
+	"\x10GiftItemResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"k\n" +
+	"\x13TransferItemRequest\x12\x1a\n" +
+	"\bitemName\x18\x01 \x01(\tR\bitemName\x12\x1e\n" +
+	"\n" +
+	"toUsername\x18\x02 \x01(\tR\n" +
+	"toUsername\x12\x18\n" +
+	"\avariant\x18\x03 \x01(\tR\avariant\"0\n" +
+	"\x14TransferItemResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"o\n" +
+	"\x19SendFromTeamBudgetRequest\x12\x1a\n" +
+	"\bteamName\x18\x01 \x01(\tR\bteamName\x12\x1e\n" +
+	"\n" +
+	"toUsername\x18\x02 \x01(\tR\n" +
+	"toUsername\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\rR\x06amount\"6\n" +
+	"\x1aSendFromTeamBudgetResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x8f\x01\n" +
+	"\x1bCreatePaymentRequestRequest\x12$\n" +
+	"\rpayerUsername\x18\x01 \x01(\tR\rpayerUsername\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\rR\x06amount\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\x12\x1e\n" +
+	"\n" +
+	"expiryDays\x18\x04 \x01(\rR\n" +
+	"expiryDays\"<\n" +
+	"\x1cCreatePaymentRequestResponse\x12\x1c\n" +
+	"\trequestID\x18\x01 \x01(\x05R\trequestID\"\x1c\n" +
+	"\x1aListPaymentRequestsRequest\"W\n" +
+	"\x1bListPaymentRequestsResponse\x128\n" +
+	"\brequests\x18\x01 \x03(\v2\x1c.merch.v1.PaymentRequestInfoR\brequests\";\n" +
+	"\x1bAcceptPaymentRequestRequest\x12\x1c\n" +
+	"\trequestID\x18\x01 \x01(\x05R\trequestID\"8\n" +
+	"\x1cAcceptPaymentRequestResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"<\n" +
+	"\x1cDeclinePaymentRequestRequest\x12\x1c\n" +
+	"\trequestID\x18\x01 \x01(\x05R\trequestID\"9\n" +
+	"\x1dDeclinePaymentRequestResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x8b\x01\n" +
+	"\x17ScheduleTransferRequest\x12\x1e\n" +
+	"\n" +
+	"toUsername\x18\x01 \x01(\tR\n" +
+	"toUsername\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\rR\x06amount\x12\x18\n" +
+	"\astartAt\x18\x03 \x01(\tR\astartAt\x12\x1e\n" +
+	"\n" +
+	"recurrence\x18\x04 \x01(\tR\n" +
+	"recurrence\":\n" +
+	"\x18ScheduleTransferResponse\x12\x1e\n" +
+	"\n" +
+	"scheduleID\x18\x01 \x01(\x05R\n" +
+	"scheduleID\"\x1f\n" +
+	"\x1dListScheduledTransfersRequest\"_\n" +
+	"\x1eListScheduledTransfersResponse\x12=\n" +
+	"\ttransfers\x18\x01 \x03(\v2\x1f.merch.v1.ScheduledTransferInfoR\ttransfers\"@\n" +
+	"\x1eCancelScheduledTransferRequest\x12\x1e\n" +
+	"\n" +
+	"scheduleID\x18\x01 \x01(\x05R\n" +
+	"scheduleID\";\n" +
+	"\x1fCancelScheduledTransferResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"b\n" +
+	"\x14CreateListingRequest\x12\x1a\n" +
+	"\bitemName\x18\x01 \x01(\tR\bitemName\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\rR\x05price\x12\x18\n" +
+	"\avariant\x18\x03 \x01(\tR\avariant\"5\n" +
+	"\x15CreateListingResponse\x12\x1c\n" +
+	"\tlistingID\x18\x01 \x01(\x05R\tlistingID\"J\n" +
+	"\x14UpdateListingRequest\x12\x1c\n" +
+	"\tlistingID\x18\x01 \x01(\x05R\tlistingID\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\rR\x05price\"1\n" +
+	"\x15UpdateListingResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"4\n" +
+	"\x14CancelListingRequest\x12\x1c\n" +
+	"\tlistingID\x18\x01 \x01(\x05R\tlistingID\"1\n" +
+	"\x15CancelListingResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x9f\x01\n" +
+	"\x15SearchListingsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12&\n" +
+	"\x0esellerUsername\x18\x02 \x01(\tR\x0esellerUsername\x12\x1a\n" +
+	"\bmaxPrice\x18\x03 \x01(\rR\bmaxPrice\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x05 \x01(\x05R\x06offset\"K\n" +
+	"\x16SearchListingsResponse\x121\n" +
+	"\blistings\x18\x01 \x03(\v2\x15.merch.v1.ListingInfoR\blistings\"1\n" +
+	"\x11BuyListingRequest\x12\x1c\n" +
+	"\tlistingID\x18\x01 \x01(\x05R\tlistingID\".\n" +
+	"\x12BuyListingResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x15\n" +
+	"\x13ListAuctionsRequest\"I\n" +
+	"\x14ListAuctionsResponse\x121\n" +
+	"\bauctions\x18\x01 \x03(\v2\x15.merch.v1.AuctionInfoR\bauctions\"G\n" +
+	"\x0fPlaceBidRequest\x12\x1c\n" +
+	"\tauctionID\x18\x01 \x01(\x05R\tauctionID\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\rR\x06amount\",\n" +
+	"\x10PlaceBidResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x14\n" +
+	"\x12ListRafflesRequest\"E\n" +
+	"\x13ListRafflesResponse\x12.\n" +
+	"\araffles\x18\x01 \x03(\v2\x14.merch.v1.RaffleInfoR\araffles\"K\n" +
+	"\x17BuyRaffleTicketsRequest\x12\x1a\n" +
+	"\braffleID\x18\x01 \x01(\x05R\braffleID\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\rR\x05count\"4\n" +
+	"\x18BuyRaffleTicketsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"2\n" +
+	"\x14AddToWishlistRequest\x12\x1a\n" +
+	"\bitemName\x18\x01 \x01(\tR\bitemName\"1\n" +
+	"\x15AddToWishlistResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"7\n" +
+	"\x19RemoveFromWishlistRequest\x12\x1a\n" +
+	"\bitemName\x18\x01 \x01(\tR\bitemName\"6\n" +
+	"\x1aRemoveFromWishlistResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x15\n" +
+	"\x13ListWishlistRequest\"D\n" +
+	"\x14ListWishlistResponse\x12,\n" +
+	"\x05items\x18\x01 \x03(\v2\x16.merch.v1.WishlistItemR\x05items\"\x1b\n" +
+	"\x19ListWishlistEventsRequest\"M\n" +
+	"\x1aListWishlistEventsResponse\x12/\n" +
+	"\x06events\x18\x01 \x03(\v2\x17.merch.v1.WishlistEventR\x06events\".\n" +
+	"\x10ListGoodsRequest\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\"@\n" +
+	"\x11ListGoodsResponse\x12+\n" +
+	"\x05items\x18\x01 \x03(\v2\x15.merch.v1.CatalogItemR\x05items\"4\n" +
+	"\x16GetPriceHistoryRequest\x12\x1a\n" +
+	"\bitemName\x18\x01 \x01(\tR\bitemName\"h\n" +
+	"\x17GetPriceHistoryResponse\x12\x1c\n" +
+	"\tbasePrice\x18\x01 \x01(\rR\tbasePrice\x12/\n" +
+	"\achanges\x18\x02 \x03(\v2\x15.merch.v1.PriceChangeR\achanges\"\x17\n" +
+	"\x15ListCategoriesRequest\"P\n" +
+	"\x16ListCategoriesResponse\x126\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x16.merch.v1.CategoryInfoR\n" +
+	"categories\"L\n" +
+	"\x14PlacePreorderRequest\x12\x1a\n" +
+	"\bitemName\x18\x01 \x01(\tR\bitemName\x12\x18\n" +
+	"\avariant\x18\x02 \x01(\tR\avariant\"7\n" +
+	"\x15PlacePreorderResponse\x12\x1e\n" +
+	"\n" +
+	"preorderID\x18\x01 \x01(\x05R\n" +
+	"preorderID\"\x16\n" +
+	"\x14ListPreordersRequest\"k\n" +
+	"\x15ListPreordersResponse\x124\n" +
+	"\tpreorders\x18\x01 \x03(\v2\x16.merch.v1.PreorderInfoR\tpreorders\x12\x1c\n" +
+	"\theldCoins\x18\x02 \x01(\rR\theldCoins\"7\n" +
+	"\x15CancelPreorderRequest\x12\x1e\n" +
+	"\n" +
+	"preorderID\x18\x01 \x01(\x05R\n" +
+	"preorderID\"2\n" +
+	"\x16CancelPreorderResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x18\n" +
+	"\x16SubscribeEventsRequest\"\xa1\x01\n" +
 	"\rInventoryItem\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
-	"\bquantity\x18\x02 \x01(\rR\bquantity\"s\n" +
+	"\bquantity\x18\x02 \x01(\rR\bquantity\x12(\n" +
+	"\x05gifts\x18\x03 \x03(\v2\x12.merch.v1.GiftInfoR\x05gifts\x126\n" +
+	"\bvariants\x18\x04 \x03(\v2\x1a.merch.v1.InventoryVariantR\bvariants\"@\n" +
+	"\x10InventoryVariant\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\rR\bquantity\"H\n" +
+	"\bGiftInfo\x12\"\n" +
+	"\ffromUsername\x18\x01 \x01(\tR\ffromUsername\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"s\n" +
 	"\vCoinHistory\x127\n" +
 	"\breceived\x18\x01 \x03(\v2\x1b.merch.v1.ReceivedCoinsInfoR\breceived\x12+\n" +
 	"\x04sent\x18\x02 \x03(\v2\x17.merch.v1.SentCoinsInfoR\x04sent\"O\n" +
@@ -543,11 +5044,171 @@ const file_store_proto_rawDesc = "" +
 	"\n" +
 	"toUsername\x18\x01 \x01(\tR\n" +
 	"toUsername\x12\x16\n" +
-	"\x06amount\x18\x02 \x01(\rR\x06amount2\xe5\x01\n" +
+	"\x06amount\x18\x02 \x01(\rR\x06amount\"q\n" +
+	"\vItemHistory\x126\n" +
+	"\breceived\x18\x01 \x03(\v2\x1a.merch.v1.ReceivedItemInfoR\breceived\x12*\n" +
+	"\x04sent\x18\x02 \x03(\v2\x16.merch.v1.SentItemInfoR\x04sent\"R\n" +
+	"\x10ReceivedItemInfo\x12\"\n" +
+	"\ffromUsername\x18\x01 \x01(\tR\ffromUsername\x12\x1a\n" +
+	"\bitemName\x18\x02 \x01(\tR\bitemName\"J\n" +
+	"\fSentItemInfo\x12\x1e\n" +
+	"\n" +
+	"toUsername\x18\x01 \x01(\tR\n" +
+	"toUsername\x12\x1a\n" +
+	"\bitemName\x18\x02 \x01(\tR\bitemName\"F\n" +
+	"\fCoinTransfer\x12\x1e\n" +
+	"\n" +
+	"toUsername\x18\x01 \x01(\tR\n" +
+	"toUsername\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\rR\x06amount\"\xb0\x01\n" +
+	"\x12PaymentRequestInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\"\n" +
+	"\ffromUsername\x18\x02 \x01(\tR\ffromUsername\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\rR\x06amount\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\x12\x1c\n" +
+	"\tcreatedAt\x18\x05 \x01(\tR\tcreatedAt\x12\x1c\n" +
+	"\texpiresAt\x18\x06 \x01(\tR\texpiresAt\"\xef\x01\n" +
+	"\x15ScheduledTransferInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1e\n" +
+	"\n" +
+	"toUsername\x18\x02 \x01(\tR\n" +
+	"toUsername\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\rR\x06amount\x12\x1e\n" +
+	"\n" +
+	"recurrence\x18\x04 \x01(\tR\n" +
+	"recurrence\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1c\n" +
+	"\tnextRunAt\x18\x06 \x01(\tR\tnextRunAt\x12\x1a\n" +
+	"\battempts\x18\a \x01(\x05R\battempts\x12\x1c\n" +
+	"\tlastError\x18\b \x01(\tR\tlastError\"\xaf\x01\n" +
+	"\vListingInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1a\n" +
+	"\bitemName\x18\x02 \x01(\tR\bitemName\x12&\n" +
+	"\x0esellerUsername\x18\x03 \x01(\tR\x0esellerUsername\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\rR\x05price\x12\x1c\n" +
+	"\tcreatedAt\x18\x05 \x01(\tR\tcreatedAt\x12\x18\n" +
+	"\avariant\x18\x06 \x01(\tR\avariant\"\xd3\x01\n" +
+	"\vAuctionInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1a\n" +
+	"\bitemName\x18\x02 \x01(\tR\bitemName\x12\x1a\n" +
+	"\bstartsAt\x18\x03 \x01(\tR\bstartsAt\x12\x16\n" +
+	"\x06endsAt\x18\x04 \x01(\tR\x06endsAt\x12\x16\n" +
+	"\x06topBid\x18\x05 \x01(\rR\x06topBid\x12,\n" +
+	"\x11topBidderUsername\x18\x06 \x01(\tR\x11topBidderUsername\x12\x1e\n" +
+	"\n" +
+	"reserveMet\x18\a \x01(\bR\n" +
+	"reserveMet\"\x94\x01\n" +
+	"\n" +
+	"RaffleInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1a\n" +
+	"\bitemName\x18\x02 \x01(\tR\bitemName\x12 \n" +
+	"\vticketPrice\x18\x03 \x01(\rR\vticketPrice\x12\x16\n" +
+	"\x06drawAt\x18\x04 \x01(\tR\x06drawAt\x12 \n" +
+	"\vticketsSold\x18\x05 \x01(\rR\vticketsSold\"Z\n" +
+	"\fWishlistItem\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\rR\x05price\x12 \n" +
+	"\vcoinsNeeded\x18\x03 \x01(\rR\vcoinsNeeded\"\x95\x01\n" +
+	"\rWishlistEvent\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x1a\n" +
+	"\bitemName\x18\x02 \x01(\tR\bitemName\x12\x1a\n" +
+	"\boldPrice\x18\x03 \x01(\rR\boldPrice\x12\x1a\n" +
+	"\bnewPrice\x18\x04 \x01(\rR\bnewPrice\x12\x1c\n" +
+	"\tcreatedAt\x18\x05 \x01(\tR\tcreatedAt\"\xed\x02\n" +
+	"\vCatalogItem\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\rR\x05price\x12\x1c\n" +
+	"\tbasePrice\x18\x03 \x01(\rR\tbasePrice\x12\x1e\n" +
+	"\n" +
+	"saleEndsAt\x18\x04 \x01(\tR\n" +
+	"saleEndsAt\x124\n" +
+	"\bvariants\x18\x05 \x03(\v2\x18.merch.v1.CatalogVariantR\bvariants\x12\x1a\n" +
+	"\bcategory\x18\x06 \x01(\tR\bcategory\x12 \n" +
+	"\vdescription\x18\a \x01(\tR\vdescription\x12+\n" +
+	"\x06images\x18\b \x03(\v2\x13.merch.v1.GoodImageR\x06images\x129\n" +
+	"\n" +
+	"components\x18\t \x03(\v2\x19.merch.v1.BundleComponentR\n" +
+	"components\x12\x1a\n" +
+	"\bupcoming\x18\n" +
+	" \x01(\bR\bupcoming\"e\n" +
+	"\tGoodImage\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x18\n" +
+	"\aaltText\x18\x02 \x01(\tR\aaltText\x12\x14\n" +
+	"\x05width\x18\x03 \x01(\rR\x05width\x12\x16\n" +
+	"\x06height\x18\x04 \x01(\rR\x06height\"6\n" +
+	"\fCategoryInfo\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xfb\x01\n" +
+	"\x0eCatalogVariant\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12H\n" +
+	"\n" +
+	"attributes\x18\x02 \x03(\v2(.merch.v1.CatalogVariant.AttributesEntryR\n" +
+	"attributes\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\rR\x05price\x12\"\n" +
+	"\fstockTracked\x18\x04 \x01(\bR\fstockTracked\x12\x14\n" +
+	"\x05stock\x18\x05 \x01(\rR\x05stock\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"I\n" +
+	"\x0fBundleComponent\x12\x1a\n" +
+	"\bitemName\x18\x01 \x01(\tR\bitemName\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\rR\bquantity\"\xc0\x01\n" +
+	"\fPreorderInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1a\n" +
+	"\bitemName\x18\x02 \x01(\tR\bitemName\x12\x18\n" +
+	"\avariant\x18\x03 \x01(\tR\avariant\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\rR\x05price\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1c\n" +
+	"\tcreatedAt\x18\x06 \x01(\tR\tcreatedAt\x12\x1e\n" +
+	"\n" +
+	"resolvedAt\x18\a \x01(\tR\n" +
+	"resolvedAt\"W\n" +
+	"\vPriceChange\x12\x14\n" +
+	"\x05price\x18\x01 \x01(\rR\x05price\x12\x1a\n" +
+	"\bstartsAt\x18\x02 \x01(\tR\bstartsAt\x12\x16\n" +
+	"\x06endsAt\x18\x03 \x01(\tR\x06endsAt\"S\n" +
+	"\tUserEvent\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\tR\x04data\x12\x1e\n" +
+	"\n" +
+	"occurredAt\x18\x03 \x01(\tR\n" +
+	"occurredAt2\xe9\x16\n" +
 	"\x11MerchStoreService\x12J\n" +
 	"\vGetUserInfo\x12\x1c.merch.v1.GetUserInfoRequest\x1a\x1d.merch.v1.GetUserInfoResponse\x12D\n" +
-	"\tSendCoins\x12\x1a.merch.v1.SendCoinsRequest\x1a\x1b.merch.v1.SendCoinsResponse\x12>\n" +
-	"\aBuyItem\x12\x18.merch.v1.BuyItemRequest\x1a\x19.merch.v1.BuyItemResponseB6Z4github.com/Lexv0lk/merch-store/api/merch/v1;merchapib\x06proto3"
+	"\tSendCoins\x12\x1a.merch.v1.SendCoinsRequest\x1a\x1b.merch.v1.SendCoinsResponse\x12S\n" +
+	"\x0eSendCoinsBatch\x12\x1f.merch.v1.SendCoinsBatchRequest\x1a .merch.v1.SendCoinsBatchResponse\x12>\n" +
+	"\aBuyItem\x12\x18.merch.v1.BuyItemRequest\x1a\x19.merch.v1.BuyItemResponse\x12A\n" +
+	"\bGiftItem\x12\x19.merch.v1.GiftItemRequest\x1a\x1a.merch.v1.GiftItemResponse\x12M\n" +
+	"\fTransferItem\x12\x1d.merch.v1.TransferItemRequest\x1a\x1e.merch.v1.TransferItemResponse\x12_\n" +
+	"\x12SendFromTeamBudget\x12#.merch.v1.SendFromTeamBudgetRequest\x1a$.merch.v1.SendFromTeamBudgetResponse\x12e\n" +
+	"\x14CreatePaymentRequest\x12%.merch.v1.CreatePaymentRequestRequest\x1a&.merch.v1.CreatePaymentRequestResponse\x12b\n" +
+	"\x13ListPaymentRequests\x12$.merch.v1.ListPaymentRequestsRequest\x1a%.merch.v1.ListPaymentRequestsResponse\x12e\n" +
+	"\x14AcceptPaymentRequest\x12%.merch.v1.AcceptPaymentRequestRequest\x1a&.merch.v1.AcceptPaymentRequestResponse\x12h\n" +
+	"\x15DeclinePaymentRequest\x12&.merch.v1.DeclinePaymentRequestRequest\x1a'.merch.v1.DeclinePaymentRequestResponse\x12Y\n" +
+	"\x10ScheduleTransfer\x12!.merch.v1.ScheduleTransferRequest\x1a\".merch.v1.ScheduleTransferResponse\x12k\n" +
+	"\x16ListScheduledTransfers\x12'.merch.v1.ListScheduledTransfersRequest\x1a(.merch.v1.ListScheduledTransfersResponse\x12n\n" +
+	"\x17CancelScheduledTransfer\x12(.merch.v1.CancelScheduledTransferRequest\x1a).merch.v1.CancelScheduledTransferResponse\x12P\n" +
+	"\rCreateListing\x12\x1e.merch.v1.CreateListingRequest\x1a\x1f.merch.v1.CreateListingResponse\x12P\n" +
+	"\rUpdateListing\x12\x1e.merch.v1.UpdateListingRequest\x1a\x1f.merch.v1.UpdateListingResponse\x12P\n" +
+	"\rCancelListing\x12\x1e.merch.v1.CancelListingRequest\x1a\x1f.merch.v1.CancelListingResponse\x12S\n" +
+	"\x0eSearchListings\x12\x1f.merch.v1.SearchListingsRequest\x1a .merch.v1.SearchListingsResponse\x12G\n" +
+	"\n" +
+	"BuyListing\x12\x1b.merch.v1.BuyListingRequest\x1a\x1c.merch.v1.BuyListingResponse\x12M\n" +
+	"\fListAuctions\x12\x1d.merch.v1.ListAuctionsRequest\x1a\x1e.merch.v1.ListAuctionsResponse\x12A\n" +
+	"\bPlaceBid\x12\x19.merch.v1.PlaceBidRequest\x1a\x1a.merch.v1.PlaceBidResponse\x12J\n" +
+	"\vListRaffles\x12\x1c.merch.v1.ListRafflesRequest\x1a\x1d.merch.v1.ListRafflesResponse\x12Y\n" +
+	"\x10BuyRaffleTickets\x12!.merch.v1.BuyRaffleTicketsRequest\x1a\".merch.v1.BuyRaffleTicketsResponse\x12P\n" +
+	"\rAddToWishlist\x12\x1e.merch.v1.AddToWishlistRequest\x1a\x1f.merch.v1.AddToWishlistResponse\x12_\n" +
+	"\x12RemoveFromWishlist\x12#.merch.v1.RemoveFromWishlistRequest\x1a$.merch.v1.RemoveFromWishlistResponse\x12M\n" +
+	"\fListWishlist\x12\x1d.merch.v1.ListWishlistRequest\x1a\x1e.merch.v1.ListWishlistResponse\x12_\n" +
+	"\x12ListWishlistEvents\x12#.merch.v1.ListWishlistEventsRequest\x1a$.merch.v1.ListWishlistEventsResponse\x12D\n" +
+	"\tListGoods\x12\x1a.merch.v1.ListGoodsRequest\x1a\x1b.merch.v1.ListGoodsResponse\x12V\n" +
+	"\x0fGetPriceHistory\x12 .merch.v1.GetPriceHistoryRequest\x1a!.merch.v1.GetPriceHistoryResponse\x12S\n" +
+	"\x0eListCategories\x12\x1f.merch.v1.ListCategoriesRequest\x1a .merch.v1.ListCategoriesResponse\x12P\n" +
+	"\rPlacePreorder\x12\x1e.merch.v1.PlacePreorderRequest\x1a\x1f.merch.v1.PlacePreorderResponse\x12P\n" +
+	"\rListPreorders\x12\x1e.merch.v1.ListPreordersRequest\x1a\x1f.merch.v1.ListPreordersResponse\x12S\n" +
+	"\x0eCancelPreorder\x12\x1f.merch.v1.CancelPreorderRequest\x1a .merch.v1.CancelPreorderResponse\x12J\n" +
+	"\x0fSubscribeEvents\x12 .merch.v1.SubscribeEventsRequest\x1a\x13.merch.v1.UserEvent0\x01B6Z4github.com/Lexv0lk/merch-store/api/merch/v1;merchapib\x06proto3"
 
 var (
 	file_store_proto_rawDescOnce sync.Once
@@ -561,35 +5222,202 @@ func file_store_proto_rawDescGZIP() []byte {
 	return file_store_proto_rawDescData
 }
 
-var file_store_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_store_proto_msgTypes = make([]protoimpl.MessageInfo, 93)
 var file_store_proto_goTypes = []any{
-	(*GetUserInfoRequest)(nil),  // 0: merch.v1.GetUserInfoRequest
-	(*GetUserInfoResponse)(nil), // 1: merch.v1.GetUserInfoResponse
-	(*SendCoinsRequest)(nil),    // 2: merch.v1.SendCoinsRequest
-	(*SendCoinsResponse)(nil),   // 3: merch.v1.SendCoinsResponse
-	(*BuyItemRequest)(nil),      // 4: merch.v1.BuyItemRequest
-	(*BuyItemResponse)(nil),     // 5: merch.v1.BuyItemResponse
-	(*InventoryItem)(nil),       // 6: merch.v1.InventoryItem
-	(*CoinHistory)(nil),         // 7: merch.v1.CoinHistory
-	(*ReceivedCoinsInfo)(nil),   // 8: merch.v1.ReceivedCoinsInfo
-	(*SentCoinsInfo)(nil),       // 9: merch.v1.SentCoinsInfo
+	(*GetUserInfoRequest)(nil),              // 0: merch.v1.GetUserInfoRequest
+	(*GetUserInfoResponse)(nil),             // 1: merch.v1.GetUserInfoResponse
+	(*SendCoinsRequest)(nil),                // 2: merch.v1.SendCoinsRequest
+	(*SendCoinsResponse)(nil),               // 3: merch.v1.SendCoinsResponse
+	(*SendCoinsBatchRequest)(nil),           // 4: merch.v1.SendCoinsBatchRequest
+	(*SendCoinsBatchResponse)(nil),          // 5: merch.v1.SendCoinsBatchResponse
+	(*BuyItemRequest)(nil),                  // 6: merch.v1.BuyItemRequest
+	(*BuyItemResponse)(nil),                 // 7: merch.v1.BuyItemResponse
+	(*GiftItemRequest)(nil),                 // 8: merch.v1.GiftItemRequest
+	(*GiftItemResponse)(nil),                // 9: merch.v1.GiftItemResponse
+	(*TransferItemRequest)(nil),             // 10: merch.v1.TransferItemRequest
+	(*TransferItemResponse)(nil),            // 11: merch.v1.TransferItemResponse
+	(*SendFromTeamBudgetRequest)(nil),       // 12: merch.v1.SendFromTeamBudgetRequest
+	(*SendFromTeamBudgetResponse)(nil),      // 13: merch.v1.SendFromTeamBudgetResponse
+	(*CreatePaymentRequestRequest)(nil),     // 14: merch.v1.CreatePaymentRequestRequest
+	(*CreatePaymentRequestResponse)(nil),    // 15: merch.v1.CreatePaymentRequestResponse
+	(*ListPaymentRequestsRequest)(nil),      // 16: merch.v1.ListPaymentRequestsRequest
+	(*ListPaymentRequestsResponse)(nil),     // 17: merch.v1.ListPaymentRequestsResponse
+	(*AcceptPaymentRequestRequest)(nil),     // 18: merch.v1.AcceptPaymentRequestRequest
+	(*AcceptPaymentRequestResponse)(nil),    // 19: merch.v1.AcceptPaymentRequestResponse
+	(*DeclinePaymentRequestRequest)(nil),    // 20: merch.v1.DeclinePaymentRequestRequest
+	(*DeclinePaymentRequestResponse)(nil),   // 21: merch.v1.DeclinePaymentRequestResponse
+	(*ScheduleTransferRequest)(nil),         // 22: merch.v1.ScheduleTransferRequest
+	(*ScheduleTransferResponse)(nil),        // 23: merch.v1.ScheduleTransferResponse
+	(*ListScheduledTransfersRequest)(nil),   // 24: merch.v1.ListScheduledTransfersRequest
+	(*ListScheduledTransfersResponse)(nil),  // 25: merch.v1.ListScheduledTransfersResponse
+	(*CancelScheduledTransferRequest)(nil),  // 26: merch.v1.CancelScheduledTransferRequest
+	(*CancelScheduledTransferResponse)(nil), // 27: merch.v1.CancelScheduledTransferResponse
+	(*CreateListingRequest)(nil),            // 28: merch.v1.CreateListingRequest
+	(*CreateListingResponse)(nil),           // 29: merch.v1.CreateListingResponse
+	(*UpdateListingRequest)(nil),            // 30: merch.v1.UpdateListingRequest
+	(*UpdateListingResponse)(nil),           // 31: merch.v1.UpdateListingResponse
+	(*CancelListingRequest)(nil),            // 32: merch.v1.CancelListingRequest
+	(*CancelListingResponse)(nil),           // 33: merch.v1.CancelListingResponse
+	(*SearchListingsRequest)(nil),           // 34: merch.v1.SearchListingsRequest
+	(*SearchListingsResponse)(nil),          // 35: merch.v1.SearchListingsResponse
+	(*BuyListingRequest)(nil),               // 36: merch.v1.BuyListingRequest
+	(*BuyListingResponse)(nil),              // 37: merch.v1.BuyListingResponse
+	(*ListAuctionsRequest)(nil),             // 38: merch.v1.ListAuctionsRequest
+	(*ListAuctionsResponse)(nil),            // 39: merch.v1.ListAuctionsResponse
+	(*PlaceBidRequest)(nil),                 // 40: merch.v1.PlaceBidRequest
+	(*PlaceBidResponse)(nil),                // 41: merch.v1.PlaceBidResponse
+	(*ListRafflesRequest)(nil),              // 42: merch.v1.ListRafflesRequest
+	(*ListRafflesResponse)(nil),             // 43: merch.v1.ListRafflesResponse
+	(*BuyRaffleTicketsRequest)(nil),         // 44: merch.v1.BuyRaffleTicketsRequest
+	(*BuyRaffleTicketsResponse)(nil),        // 45: merch.v1.BuyRaffleTicketsResponse
+	(*AddToWishlistRequest)(nil),            // 46: merch.v1.AddToWishlistRequest
+	(*AddToWishlistResponse)(nil),           // 47: merch.v1.AddToWishlistResponse
+	(*RemoveFromWishlistRequest)(nil),       // 48: merch.v1.RemoveFromWishlistRequest
+	(*RemoveFromWishlistResponse)(nil),      // 49: merch.v1.RemoveFromWishlistResponse
+	(*ListWishlistRequest)(nil),             // 50: merch.v1.ListWishlistRequest
+	(*ListWishlistResponse)(nil),            // 51: merch.v1.ListWishlistResponse
+	(*ListWishlistEventsRequest)(nil),       // 52: merch.v1.ListWishlistEventsRequest
+	(*ListWishlistEventsResponse)(nil),      // 53: merch.v1.ListWishlistEventsResponse
+	(*ListGoodsRequest)(nil),                // 54: merch.v1.ListGoodsRequest
+	(*ListGoodsResponse)(nil),               // 55: merch.v1.ListGoodsResponse
+	(*GetPriceHistoryRequest)(nil),          // 56: merch.v1.GetPriceHistoryRequest
+	(*GetPriceHistoryResponse)(nil),         // 57: merch.v1.GetPriceHistoryResponse
+	(*ListCategoriesRequest)(nil),           // 58: merch.v1.ListCategoriesRequest
+	(*ListCategoriesResponse)(nil),          // 59: merch.v1.ListCategoriesResponse
+	(*PlacePreorderRequest)(nil),            // 60: merch.v1.PlacePreorderRequest
+	(*PlacePreorderResponse)(nil),           // 61: merch.v1.PlacePreorderResponse
+	(*ListPreordersRequest)(nil),            // 62: merch.v1.ListPreordersRequest
+	(*ListPreordersResponse)(nil),           // 63: merch.v1.ListPreordersResponse
+	(*CancelPreorderRequest)(nil),           // 64: merch.v1.CancelPreorderRequest
+	(*CancelPreorderResponse)(nil),          // 65: merch.v1.CancelPreorderResponse
+	(*SubscribeEventsRequest)(nil),          // 66: merch.v1.SubscribeEventsRequest
+	(*InventoryItem)(nil),                   // 67: merch.v1.InventoryItem
+	(*InventoryVariant)(nil),                // 68: merch.v1.InventoryVariant
+	(*GiftInfo)(nil),                        // 69: merch.v1.GiftInfo
+	(*CoinHistory)(nil),                     // 70: merch.v1.CoinHistory
+	(*ReceivedCoinsInfo)(nil),               // 71: merch.v1.ReceivedCoinsInfo
+	(*SentCoinsInfo)(nil),                   // 72: merch.v1.SentCoinsInfo
+	(*ItemHistory)(nil),                     // 73: merch.v1.ItemHistory
+	(*ReceivedItemInfo)(nil),                // 74: merch.v1.ReceivedItemInfo
+	(*SentItemInfo)(nil),                    // 75: merch.v1.SentItemInfo
+	(*CoinTransfer)(nil),                    // 76: merch.v1.CoinTransfer
+	(*PaymentRequestInfo)(nil),              // 77: merch.v1.PaymentRequestInfo
+	(*ScheduledTransferInfo)(nil),           // 78: merch.v1.ScheduledTransferInfo
+	(*ListingInfo)(nil),                     // 79: merch.v1.ListingInfo
+	(*AuctionInfo)(nil),                     // 80: merch.v1.AuctionInfo
+	(*RaffleInfo)(nil),                      // 81: merch.v1.RaffleInfo
+	(*WishlistItem)(nil),                    // 82: merch.v1.WishlistItem
+	(*WishlistEvent)(nil),                   // 83: merch.v1.WishlistEvent
+	(*CatalogItem)(nil),                     // 84: merch.v1.CatalogItem
+	(*GoodImage)(nil),                       // 85: merch.v1.GoodImage
+	(*CategoryInfo)(nil),                    // 86: merch.v1.CategoryInfo
+	(*CatalogVariant)(nil),                  // 87: merch.v1.CatalogVariant
+	(*BundleComponent)(nil),                 // 88: merch.v1.BundleComponent
+	(*PreorderInfo)(nil),                    // 89: merch.v1.PreorderInfo
+	(*PriceChange)(nil),                     // 90: merch.v1.PriceChange
+	(*UserEvent)(nil),                       // 91: merch.v1.UserEvent
+	nil,                                     // 92: merch.v1.CatalogVariant.AttributesEntry
 }
 var file_store_proto_depIdxs = []int32{
-	6, // 0: merch.v1.GetUserInfoResponse.inventory:type_name -> merch.v1.InventoryItem
-	7, // 1: merch.v1.GetUserInfoResponse.coinHistory:type_name -> merch.v1.CoinHistory
-	8, // 2: merch.v1.CoinHistory.received:type_name -> merch.v1.ReceivedCoinsInfo
-	9, // 3: merch.v1.CoinHistory.sent:type_name -> merch.v1.SentCoinsInfo
-	0, // 4: merch.v1.MerchStoreService.GetUserInfo:input_type -> merch.v1.GetUserInfoRequest
-	2, // 5: merch.v1.MerchStoreService.SendCoins:input_type -> merch.v1.SendCoinsRequest
-	4, // 6: merch.v1.MerchStoreService.BuyItem:input_type -> merch.v1.BuyItemRequest
-	1, // 7: merch.v1.MerchStoreService.GetUserInfo:output_type -> merch.v1.GetUserInfoResponse
-	3, // 8: merch.v1.MerchStoreService.SendCoins:output_type -> merch.v1.SendCoinsResponse
-	5, // 9: merch.v1.MerchStoreService.BuyItem:output_type -> merch.v1.BuyItemResponse
-	7, // [7:10] is the sub-list for method output_type
-	4, // [4:7] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	67, // 0: merch.v1.GetUserInfoResponse.inventory:type_name -> merch.v1.InventoryItem
+	70, // 1: merch.v1.GetUserInfoResponse.coinHistory:type_name -> merch.v1.CoinHistory
+	73, // 2: merch.v1.GetUserInfoResponse.itemHistory:type_name -> merch.v1.ItemHistory
+	82, // 3: merch.v1.GetUserInfoResponse.wishlist:type_name -> merch.v1.WishlistItem
+	76, // 4: merch.v1.SendCoinsBatchRequest.transfers:type_name -> merch.v1.CoinTransfer
+	77, // 5: merch.v1.ListPaymentRequestsResponse.requests:type_name -> merch.v1.PaymentRequestInfo
+	78, // 6: merch.v1.ListScheduledTransfersResponse.transfers:type_name -> merch.v1.ScheduledTransferInfo
+	79, // 7: merch.v1.SearchListingsResponse.listings:type_name -> merch.v1.ListingInfo
+	80, // 8: merch.v1.ListAuctionsResponse.auctions:type_name -> merch.v1.AuctionInfo
+	81, // 9: merch.v1.ListRafflesResponse.raffles:type_name -> merch.v1.RaffleInfo
+	82, // 10: merch.v1.ListWishlistResponse.items:type_name -> merch.v1.WishlistItem
+	83, // 11: merch.v1.ListWishlistEventsResponse.events:type_name -> merch.v1.WishlistEvent
+	84, // 12: merch.v1.ListGoodsResponse.items:type_name -> merch.v1.CatalogItem
+	90, // 13: merch.v1.GetPriceHistoryResponse.changes:type_name -> merch.v1.PriceChange
+	86, // 14: merch.v1.ListCategoriesResponse.categories:type_name -> merch.v1.CategoryInfo
+	89, // 15: merch.v1.ListPreordersResponse.preorders:type_name -> merch.v1.PreorderInfo
+	69, // 16: merch.v1.InventoryItem.gifts:type_name -> merch.v1.GiftInfo
+	68, // 17: merch.v1.InventoryItem.variants:type_name -> merch.v1.InventoryVariant
+	71, // 18: merch.v1.CoinHistory.received:type_name -> merch.v1.ReceivedCoinsInfo
+	72, // 19: merch.v1.CoinHistory.sent:type_name -> merch.v1.SentCoinsInfo
+	74, // 20: merch.v1.ItemHistory.received:type_name -> merch.v1.ReceivedItemInfo
+	75, // 21: merch.v1.ItemHistory.sent:type_name -> merch.v1.SentItemInfo
+	87, // 22: merch.v1.CatalogItem.variants:type_name -> merch.v1.CatalogVariant
+	85, // 23: merch.v1.CatalogItem.images:type_name -> merch.v1.GoodImage
+	88, // 24: merch.v1.CatalogItem.components:type_name -> merch.v1.BundleComponent
+	92, // 25: merch.v1.CatalogVariant.attributes:type_name -> merch.v1.CatalogVariant.AttributesEntry
+	0,  // 26: merch.v1.MerchStoreService.GetUserInfo:input_type -> merch.v1.GetUserInfoRequest
+	2,  // 27: merch.v1.MerchStoreService.SendCoins:input_type -> merch.v1.SendCoinsRequest
+	4,  // 28: merch.v1.MerchStoreService.SendCoinsBatch:input_type -> merch.v1.SendCoinsBatchRequest
+	6,  // 29: merch.v1.MerchStoreService.BuyItem:input_type -> merch.v1.BuyItemRequest
+	8,  // 30: merch.v1.MerchStoreService.GiftItem:input_type -> merch.v1.GiftItemRequest
+	10, // 31: merch.v1.MerchStoreService.TransferItem:input_type -> merch.v1.TransferItemRequest
+	12, // 32: merch.v1.MerchStoreService.SendFromTeamBudget:input_type -> merch.v1.SendFromTeamBudgetRequest
+	14, // 33: merch.v1.MerchStoreService.CreatePaymentRequest:input_type -> merch.v1.CreatePaymentRequestRequest
+	16, // 34: merch.v1.MerchStoreService.ListPaymentRequests:input_type -> merch.v1.ListPaymentRequestsRequest
+	18, // 35: merch.v1.MerchStoreService.AcceptPaymentRequest:input_type -> merch.v1.AcceptPaymentRequestRequest
+	20, // 36: merch.v1.MerchStoreService.DeclinePaymentRequest:input_type -> merch.v1.DeclinePaymentRequestRequest
+	22, // 37: merch.v1.MerchStoreService.ScheduleTransfer:input_type -> merch.v1.ScheduleTransferRequest
+	24, // 38: merch.v1.MerchStoreService.ListScheduledTransfers:input_type -> merch.v1.ListScheduledTransfersRequest
+	26, // 39: merch.v1.MerchStoreService.CancelScheduledTransfer:input_type -> merch.v1.CancelScheduledTransferRequest
+	28, // 40: merch.v1.MerchStoreService.CreateListing:input_type -> merch.v1.CreateListingRequest
+	30, // 41: merch.v1.MerchStoreService.UpdateListing:input_type -> merch.v1.UpdateListingRequest
+	32, // 42: merch.v1.MerchStoreService.CancelListing:input_type -> merch.v1.CancelListingRequest
+	34, // 43: merch.v1.MerchStoreService.SearchListings:input_type -> merch.v1.SearchListingsRequest
+	36, // 44: merch.v1.MerchStoreService.BuyListing:input_type -> merch.v1.BuyListingRequest
+	38, // 45: merch.v1.MerchStoreService.ListAuctions:input_type -> merch.v1.ListAuctionsRequest
+	40, // 46: merch.v1.MerchStoreService.PlaceBid:input_type -> merch.v1.PlaceBidRequest
+	42, // 47: merch.v1.MerchStoreService.ListRaffles:input_type -> merch.v1.ListRafflesRequest
+	44, // 48: merch.v1.MerchStoreService.BuyRaffleTickets:input_type -> merch.v1.BuyRaffleTicketsRequest
+	46, // 49: merch.v1.MerchStoreService.AddToWishlist:input_type -> merch.v1.AddToWishlistRequest
+	48, // 50: merch.v1.MerchStoreService.RemoveFromWishlist:input_type -> merch.v1.RemoveFromWishlistRequest
+	50, // 51: merch.v1.MerchStoreService.ListWishlist:input_type -> merch.v1.ListWishlistRequest
+	52, // 52: merch.v1.MerchStoreService.ListWishlistEvents:input_type -> merch.v1.ListWishlistEventsRequest
+	54, // 53: merch.v1.MerchStoreService.ListGoods:input_type -> merch.v1.ListGoodsRequest
+	56, // 54: merch.v1.MerchStoreService.GetPriceHistory:input_type -> merch.v1.GetPriceHistoryRequest
+	58, // 55: merch.v1.MerchStoreService.ListCategories:input_type -> merch.v1.ListCategoriesRequest
+	60, // 56: merch.v1.MerchStoreService.PlacePreorder:input_type -> merch.v1.PlacePreorderRequest
+	62, // 57: merch.v1.MerchStoreService.ListPreorders:input_type -> merch.v1.ListPreordersRequest
+	64, // 58: merch.v1.MerchStoreService.CancelPreorder:input_type -> merch.v1.CancelPreorderRequest
+	66, // 59: merch.v1.MerchStoreService.SubscribeEvents:input_type -> merch.v1.SubscribeEventsRequest
+	1,  // 60: merch.v1.MerchStoreService.GetUserInfo:output_type -> merch.v1.GetUserInfoResponse
+	3,  // 61: merch.v1.MerchStoreService.SendCoins:output_type -> merch.v1.SendCoinsResponse
+	5,  // 62: merch.v1.MerchStoreService.SendCoinsBatch:output_type -> merch.v1.SendCoinsBatchResponse
+	7,  // 63: merch.v1.MerchStoreService.BuyItem:output_type -> merch.v1.BuyItemResponse
+	9,  // 64: merch.v1.MerchStoreService.GiftItem:output_type -> merch.v1.GiftItemResponse
+	11, // 65: merch.v1.MerchStoreService.TransferItem:output_type -> merch.v1.TransferItemResponse
+	13, // 66: merch.v1.MerchStoreService.SendFromTeamBudget:output_type -> merch.v1.SendFromTeamBudgetResponse
+	15, // 67: merch.v1.MerchStoreService.CreatePaymentRequest:output_type -> merch.v1.CreatePaymentRequestResponse
+	17, // 68: merch.v1.MerchStoreService.ListPaymentRequests:output_type -> merch.v1.ListPaymentRequestsResponse
+	19, // 69: merch.v1.MerchStoreService.AcceptPaymentRequest:output_type -> merch.v1.AcceptPaymentRequestResponse
+	21, // 70: merch.v1.MerchStoreService.DeclinePaymentRequest:output_type -> merch.v1.DeclinePaymentRequestResponse
+	23, // 71: merch.v1.MerchStoreService.ScheduleTransfer:output_type -> merch.v1.ScheduleTransferResponse
+	25, // 72: merch.v1.MerchStoreService.ListScheduledTransfers:output_type -> merch.v1.ListScheduledTransfersResponse
+	27, // 73: merch.v1.MerchStoreService.CancelScheduledTransfer:output_type -> merch.v1.CancelScheduledTransferResponse
+	29, // 74: merch.v1.MerchStoreService.CreateListing:output_type -> merch.v1.CreateListingResponse
+	31, // 75: merch.v1.MerchStoreService.UpdateListing:output_type -> merch.v1.UpdateListingResponse
+	33, // 76: merch.v1.MerchStoreService.CancelListing:output_type -> merch.v1.CancelListingResponse
+	35, // 77: merch.v1.MerchStoreService.SearchListings:output_type -> merch.v1.SearchListingsResponse
+	37, // 78: merch.v1.MerchStoreService.BuyListing:output_type -> merch.v1.BuyListingResponse
+	39, // 79: merch.v1.MerchStoreService.ListAuctions:output_type -> merch.v1.ListAuctionsResponse
+	41, // 80: merch.v1.MerchStoreService.PlaceBid:output_type -> merch.v1.PlaceBidResponse
+	43, // 81: merch.v1.MerchStoreService.ListRaffles:output_type -> merch.v1.ListRafflesResponse
+	45, // 82: merch.v1.MerchStoreService.BuyRaffleTickets:output_type -> merch.v1.BuyRaffleTicketsResponse
+	47, // 83: merch.v1.MerchStoreService.AddToWishlist:output_type -> merch.v1.AddToWishlistResponse
+	49, // 84: merch.v1.MerchStoreService.RemoveFromWishlist:output_type -> merch.v1.RemoveFromWishlistResponse
+	51, // 85: merch.v1.MerchStoreService.ListWishlist:output_type -> merch.v1.ListWishlistResponse
+	53, // 86: merch.v1.MerchStoreService.ListWishlistEvents:output_type -> merch.v1.ListWishlistEventsResponse
+	55, // 87: merch.v1.MerchStoreService.ListGoods:output_type -> merch.v1.ListGoodsResponse
+	57, // 88: merch.v1.MerchStoreService.GetPriceHistory:output_type -> merch.v1.GetPriceHistoryResponse
+	59, // 89: merch.v1.MerchStoreService.ListCategories:output_type -> merch.v1.ListCategoriesResponse
+	61, // 90: merch.v1.MerchStoreService.PlacePreorder:output_type -> merch.v1.PlacePreorderResponse
+	63, // 91: merch.v1.MerchStoreService.ListPreorders:output_type -> merch.v1.ListPreordersResponse
+	65, // 92: merch.v1.MerchStoreService.CancelPreorder:output_type -> merch.v1.CancelPreorderResponse
+	91, // 93: merch.v1.MerchStoreService.SubscribeEvents:output_type -> merch.v1.UserEvent
+	60, // [60:94] is the sub-list for method output_type
+	26, // [26:60] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_store_proto_init() }
@@ -603,7 +5431,7 @@ func file_store_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_proto_rawDesc), len(file_store_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   93,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,9 +19,40 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MerchStoreService_GetUserInfo_FullMethodName = "/merch.v1.MerchStoreService/GetUserInfo"
-	MerchStoreService_SendCoins_FullMethodName   = "/merch.v1.MerchStoreService/SendCoins"
-	MerchStoreService_BuyItem_FullMethodName     = "/merch.v1.MerchStoreService/BuyItem"
+	MerchStoreService_GetUserInfo_FullMethodName             = "/merch.v1.MerchStoreService/GetUserInfo"
+	MerchStoreService_SendCoins_FullMethodName               = "/merch.v1.MerchStoreService/SendCoins"
+	MerchStoreService_SendCoinsBatch_FullMethodName          = "/merch.v1.MerchStoreService/SendCoinsBatch"
+	MerchStoreService_BuyItem_FullMethodName                 = "/merch.v1.MerchStoreService/BuyItem"
+	MerchStoreService_GiftItem_FullMethodName                = "/merch.v1.MerchStoreService/GiftItem"
+	MerchStoreService_TransferItem_FullMethodName            = "/merch.v1.MerchStoreService/TransferItem"
+	MerchStoreService_SendFromTeamBudget_FullMethodName      = "/merch.v1.MerchStoreService/SendFromTeamBudget"
+	MerchStoreService_CreatePaymentRequest_FullMethodName    = "/merch.v1.MerchStoreService/CreatePaymentRequest"
+	MerchStoreService_ListPaymentRequests_FullMethodName     = "/merch.v1.MerchStoreService/ListPaymentRequests"
+	MerchStoreService_AcceptPaymentRequest_FullMethodName    = "/merch.v1.MerchStoreService/AcceptPaymentRequest"
+	MerchStoreService_DeclinePaymentRequest_FullMethodName   = "/merch.v1.MerchStoreService/DeclinePaymentRequest"
+	MerchStoreService_ScheduleTransfer_FullMethodName        = "/merch.v1.MerchStoreService/ScheduleTransfer"
+	MerchStoreService_ListScheduledTransfers_FullMethodName  = "/merch.v1.MerchStoreService/ListScheduledTransfers"
+	MerchStoreService_CancelScheduledTransfer_FullMethodName = "/merch.v1.MerchStoreService/CancelScheduledTransfer"
+	MerchStoreService_CreateListing_FullMethodName           = "/merch.v1.MerchStoreService/CreateListing"
+	MerchStoreService_UpdateListing_FullMethodName           = "/merch.v1.MerchStoreService/UpdateListing"
+	MerchStoreService_CancelListing_FullMethodName           = "/merch.v1.MerchStoreService/CancelListing"
+	MerchStoreService_SearchListings_FullMethodName          = "/merch.v1.MerchStoreService/SearchListings"
+	MerchStoreService_BuyListing_FullMethodName              = "/merch.v1.MerchStoreService/BuyListing"
+	MerchStoreService_ListAuctions_FullMethodName            = "/merch.v1.MerchStoreService/ListAuctions"
+	MerchStoreService_PlaceBid_FullMethodName                = "/merch.v1.MerchStoreService/PlaceBid"
+	MerchStoreService_ListRaffles_FullMethodName             = "/merch.v1.MerchStoreService/ListRaffles"
+	MerchStoreService_BuyRaffleTickets_FullMethodName        = "/merch.v1.MerchStoreService/BuyRaffleTickets"
+	MerchStoreService_AddToWishlist_FullMethodName           = "/merch.v1.MerchStoreService/AddToWishlist"
+	MerchStoreService_RemoveFromWishlist_FullMethodName      = "/merch.v1.MerchStoreService/RemoveFromWishlist"
+	MerchStoreService_ListWishlist_FullMethodName            = "/merch.v1.MerchStoreService/ListWishlist"
+	MerchStoreService_ListWishlistEvents_FullMethodName      = "/merch.v1.MerchStoreService/ListWishlistEvents"
+	MerchStoreService_ListGoods_FullMethodName               = "/merch.v1.MerchStoreService/ListGoods"
+	MerchStoreService_GetPriceHistory_FullMethodName         = "/merch.v1.MerchStoreService/GetPriceHistory"
+	MerchStoreService_ListCategories_FullMethodName          = "/merch.v1.MerchStoreService/ListCategories"
+	MerchStoreService_PlacePreorder_FullMethodName           = "/merch.v1.MerchStoreService/PlacePreorder"
+	MerchStoreService_ListPreorders_FullMethodName           = "/merch.v1.MerchStoreService/ListPreorders"
+	MerchStoreService_CancelPreorder_FullMethodName          = "/merch.v1.MerchStoreService/CancelPreorder"
+	MerchStoreService_SubscribeEvents_FullMethodName         = "/merch.v1.MerchStoreService/SubscribeEvents"
 )
 
 // MerchStoreServiceClient is the client API for MerchStoreService service.
@@ -30,7 +61,38 @@ const (
 type MerchStoreServiceClient interface {
 	GetUserInfo(ctx context.Context, in *GetUserInfoRequest, opts ...grpc.CallOption) (*GetUserInfoResponse, error)
 	SendCoins(ctx context.Context, in *SendCoinsRequest, opts ...grpc.CallOption) (*SendCoinsResponse, error)
+	SendCoinsBatch(ctx context.Context, in *SendCoinsBatchRequest, opts ...grpc.CallOption) (*SendCoinsBatchResponse, error)
 	BuyItem(ctx context.Context, in *BuyItemRequest, opts ...grpc.CallOption) (*BuyItemResponse, error)
+	GiftItem(ctx context.Context, in *GiftItemRequest, opts ...grpc.CallOption) (*GiftItemResponse, error)
+	TransferItem(ctx context.Context, in *TransferItemRequest, opts ...grpc.CallOption) (*TransferItemResponse, error)
+	SendFromTeamBudget(ctx context.Context, in *SendFromTeamBudgetRequest, opts ...grpc.CallOption) (*SendFromTeamBudgetResponse, error)
+	CreatePaymentRequest(ctx context.Context, in *CreatePaymentRequestRequest, opts ...grpc.CallOption) (*CreatePaymentRequestResponse, error)
+	ListPaymentRequests(ctx context.Context, in *ListPaymentRequestsRequest, opts ...grpc.CallOption) (*ListPaymentRequestsResponse, error)
+	AcceptPaymentRequest(ctx context.Context, in *AcceptPaymentRequestRequest, opts ...grpc.CallOption) (*AcceptPaymentRequestResponse, error)
+	DeclinePaymentRequest(ctx context.Context, in *DeclinePaymentRequestRequest, opts ...grpc.CallOption) (*DeclinePaymentRequestResponse, error)
+	ScheduleTransfer(ctx context.Context, in *ScheduleTransferRequest, opts ...grpc.CallOption) (*ScheduleTransferResponse, error)
+	ListScheduledTransfers(ctx context.Context, in *ListScheduledTransfersRequest, opts ...grpc.CallOption) (*ListScheduledTransfersResponse, error)
+	CancelScheduledTransfer(ctx context.Context, in *CancelScheduledTransferRequest, opts ...grpc.CallOption) (*CancelScheduledTransferResponse, error)
+	CreateListing(ctx context.Context, in *CreateListingRequest, opts ...grpc.CallOption) (*CreateListingResponse, error)
+	UpdateListing(ctx context.Context, in *UpdateListingRequest, opts ...grpc.CallOption) (*UpdateListingResponse, error)
+	CancelListing(ctx context.Context, in *CancelListingRequest, opts ...grpc.CallOption) (*CancelListingResponse, error)
+	SearchListings(ctx context.Context, in *SearchListingsRequest, opts ...grpc.CallOption) (*SearchListingsResponse, error)
+	BuyListing(ctx context.Context, in *BuyListingRequest, opts ...grpc.CallOption) (*BuyListingResponse, error)
+	ListAuctions(ctx context.Context, in *ListAuctionsRequest, opts ...grpc.CallOption) (*ListAuctionsResponse, error)
+	PlaceBid(ctx context.Context, in *PlaceBidRequest, opts ...grpc.CallOption) (*PlaceBidResponse, error)
+	ListRaffles(ctx context.Context, in *ListRafflesRequest, opts ...grpc.CallOption) (*ListRafflesResponse, error)
+	BuyRaffleTickets(ctx context.Context, in *BuyRaffleTicketsRequest, opts ...grpc.CallOption) (*BuyRaffleTicketsResponse, error)
+	AddToWishlist(ctx context.Context, in *AddToWishlistRequest, opts ...grpc.CallOption) (*AddToWishlistResponse, error)
+	RemoveFromWishlist(ctx context.Context, in *RemoveFromWishlistRequest, opts ...grpc.CallOption) (*RemoveFromWishlistResponse, error)
+	ListWishlist(ctx context.Context, in *ListWishlistRequest, opts ...grpc.CallOption) (*ListWishlistResponse, error)
+	ListWishlistEvents(ctx context.Context, in *ListWishlistEventsRequest, opts ...grpc.CallOption) (*ListWishlistEventsResponse, error)
+	ListGoods(ctx context.Context, in *ListGoodsRequest, opts ...grpc.CallOption) (*ListGoodsResponse, error)
+	GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error)
+	ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
+	PlacePreorder(ctx context.Context, in *PlacePreorderRequest, opts ...grpc.CallOption) (*PlacePreorderResponse, error)
+	ListPreorders(ctx context.Context, in *ListPreordersRequest, opts ...grpc.CallOption) (*ListPreordersResponse, error)
+	CancelPreorder(ctx context.Context, in *CancelPreorderRequest, opts ...grpc.CallOption) (*CancelPreorderResponse, error)
+	SubscribeEvents(ctx context.Context, in *SubscribeEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[UserEvent], error)
 }
 
 type merchStoreServiceClient struct {
@@ -61,6 +123,16 @@ func (c *merchStoreServiceClient) SendCoins(ctx context.Context, in *SendCoinsRe
 	return out, nil
 }
 
+func (c *merchStoreServiceClient) SendCoinsBatch(ctx context.Context, in *SendCoinsBatchRequest, opts ...grpc.CallOption) (*SendCoinsBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendCoinsBatchResponse)
+	err := c.cc.Invoke(ctx, MerchStoreService_SendCoinsBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *merchStoreServiceClient) BuyItem(ctx context.Context, in *BuyItemRequest, opts ...grpc.CallOption) (*BuyItemResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BuyItemResponse)
@@ -71,13 +143,353 @@ func (c *merchStoreServiceClient) BuyItem(ctx context.Context, in *BuyItemReques
 	return out, nil
 }
 
+func (c *merchStoreServiceClient) GiftItem(ctx context.Context, in *GiftItemRequest, opts ...grpc.CallOption) (*GiftItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GiftItemResponse)
+	err := c.cc.Invoke(ctx, MerchStoreService_GiftItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchStoreServiceClient) TransferItem(ctx context.Context, in *TransferItemRequest, opts ...grpc.CallOption) (*TransferItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferItemResponse)
+	err := c.cc.Invoke(ctx, MerchStoreService_TransferItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchStoreServiceClient) SendFromTeamBudget(ctx context.Context, in *SendFromTeamBudgetRequest, opts ...grpc.CallOption) (*SendFromTeamBudgetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendFromTeamBudgetResponse)
+	err := c.cc.Invoke(ctx, MerchStoreService_SendFromTeamBudget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchStoreServiceClient) CreatePaymentRequest(ctx context.Context, in *CreatePaymentRequestRequest, opts ...grpc.CallOption) (*CreatePaymentRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePaymentRequestResponse)
+	err := c.cc.Invoke(ctx, MerchStoreService_CreatePaymentRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchStoreServiceClient) ListPaymentRequests(ctx context.Context, in *ListPaymentRequestsRequest, opts ...grpc.CallOption) (*ListPaymentRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPaymentRequestsResponse)
+	err := c.cc.Invoke(ctx, MerchStoreService_ListPaymentRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchStoreServiceClient) AcceptPaymentRequest(ctx context.Context, in *AcceptPaymentRequestRequest, opts ...grpc.CallOption) (*AcceptPaymentRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptPaymentRequestResponse)
+	err := c.cc.Invoke(ctx, MerchStoreService_AcceptPaymentRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchStoreServiceClient) DeclinePaymentRequest(ctx context.Context, in *DeclinePaymentRequestRequest, opts ...grpc.CallOption) (*DeclinePaymentRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeclinePaymentRequestResponse)
+	err := c.cc.Invoke(ctx, MerchStoreService_DeclinePaymentRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchStoreServiceClient) ScheduleTransfer(ctx context.Context, in *ScheduleTransferRequest, opts ...grpc.CallOption) (*ScheduleTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleTransferResponse)
+	err := c.cc.Invoke(ctx, MerchStoreService_ScheduleTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchStoreServiceClient) ListScheduledTransfers(ctx context.Context, in *ListScheduledTransfersRequest, opts ...grpc.CallOption) (*ListScheduledTransfersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScheduledTransfersResponse)
+	err := c.cc.Invoke(ctx, MerchStoreService_ListScheduledTransfers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchStoreServiceClient) CancelScheduledTransfer(ctx context.Context, in *CancelScheduledTransferRequest, opts ...grpc.CallOption) (*CancelScheduledTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelScheduledTransferResponse)
+	err := c.cc.Invoke(ctx, MerchStoreService_CancelScheduledTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchStoreServiceClient) CreateListing(ctx context.Context, in *CreateListingRequest, opts ...grpc.CallOption) (*CreateListingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateListingResponse)
+	err := c.cc.Invoke(ctx, MerchStoreService_CreateListing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchStoreServiceClient) UpdateListing(ctx context.Context, in *UpdateListingRequest, opts ...grpc.CallOption) (*UpdateListingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateListingResponse)
+	err := c.cc.Invoke(ctx, MerchStoreService_UpdateListing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchStoreServiceClient) CancelListing(ctx context.Context, in *CancelListingRequest, opts ...grpc.CallOption) (*CancelListingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelListingResponse)
+	err := c.cc.Invoke(ctx, MerchStoreService_CancelListing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchStoreServiceClient) SearchListings(ctx context.Context, in *SearchListingsRequest, opts ...grpc.CallOption) (*SearchListingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchListingsResponse)
+	err := c.cc.Invoke(ctx, MerchStoreService_SearchListings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchStoreServiceClient) BuyListing(ctx context.Context, in *BuyListingRequest, opts ...grpc.CallOption) (*BuyListingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BuyListingResponse)
+	err := c.cc.Invoke(ctx, MerchStoreService_BuyListing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchStoreServiceClient) ListAuctions(ctx context.Context, in *ListAuctionsRequest, opts ...grpc.CallOption) (*ListAuctionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuctionsResponse)
+	err := c.cc.Invoke(ctx, MerchStoreService_ListAuctions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchStoreServiceClient) PlaceBid(ctx context.Context, in *PlaceBidRequest, opts ...grpc.CallOption) (*PlaceBidResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlaceBidResponse)
+	err := c.cc.Invoke(ctx, MerchStoreService_PlaceBid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchStoreServiceClient) ListRaffles(ctx context.Context, in *ListRafflesRequest, opts ...grpc.CallOption) (*ListRafflesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRafflesResponse)
+	err := c.cc.Invoke(ctx, MerchStoreService_ListRaffles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchStoreServiceClient) BuyRaffleTickets(ctx context.Context, in *BuyRaffleTicketsRequest, opts ...grpc.CallOption) (*BuyRaffleTicketsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BuyRaffleTicketsResponse)
+	err := c.cc.Invoke(ctx, MerchStoreService_BuyRaffleTickets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchStoreServiceClient) AddToWishlist(ctx context.Context, in *AddToWishlistRequest, opts ...grpc.CallOption) (*AddToWishlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddToWishlistResponse)
+	err := c.cc.Invoke(ctx, MerchStoreService_AddToWishlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchStoreServiceClient) RemoveFromWishlist(ctx context.Context, in *RemoveFromWishlistRequest, opts ...grpc.CallOption) (*RemoveFromWishlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveFromWishlistResponse)
+	err := c.cc.Invoke(ctx, MerchStoreService_RemoveFromWishlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchStoreServiceClient) ListWishlist(ctx context.Context, in *ListWishlistRequest, opts ...grpc.CallOption) (*ListWishlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWishlistResponse)
+	err := c.cc.Invoke(ctx, MerchStoreService_ListWishlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchStoreServiceClient) ListWishlistEvents(ctx context.Context, in *ListWishlistEventsRequest, opts ...grpc.CallOption) (*ListWishlistEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWishlistEventsResponse)
+	err := c.cc.Invoke(ctx, MerchStoreService_ListWishlistEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchStoreServiceClient) ListGoods(ctx context.Context, in *ListGoodsRequest, opts ...grpc.CallOption) (*ListGoodsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGoodsResponse)
+	err := c.cc.Invoke(ctx, MerchStoreService_ListGoods_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchStoreServiceClient) GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, MerchStoreService_GetPriceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchStoreServiceClient) ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCategoriesResponse)
+	err := c.cc.Invoke(ctx, MerchStoreService_ListCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchStoreServiceClient) PlacePreorder(ctx context.Context, in *PlacePreorderRequest, opts ...grpc.CallOption) (*PlacePreorderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlacePreorderResponse)
+	err := c.cc.Invoke(ctx, MerchStoreService_PlacePreorder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchStoreServiceClient) ListPreorders(ctx context.Context, in *ListPreordersRequest, opts ...grpc.CallOption) (*ListPreordersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPreordersResponse)
+	err := c.cc.Invoke(ctx, MerchStoreService_ListPreorders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchStoreServiceClient) CancelPreorder(ctx context.Context, in *CancelPreorderRequest, opts ...grpc.CallOption) (*CancelPreorderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelPreorderResponse)
+	err := c.cc.Invoke(ctx, MerchStoreService_CancelPreorder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchStoreServiceClient) SubscribeEvents(ctx context.Context, in *SubscribeEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[UserEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MerchStoreService_ServiceDesc.Streams[0], MerchStoreService_SubscribeEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeEventsRequest, UserEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MerchStoreService_SubscribeEventsClient = grpc.ServerStreamingClient[UserEvent]
+
 // MerchStoreServiceServer is the server API for MerchStoreService service.
 // All implementations must embed UnimplementedMerchStoreServiceServer
 // for forward compatibility.
 type MerchStoreServiceServer interface {
 	GetUserInfo(context.Context, *GetUserInfoRequest) (*GetUserInfoResponse, error)
 	SendCoins(context.Context, *SendCoinsRequest) (*SendCoinsResponse, error)
+	SendCoinsBatch(context.Context, *SendCoinsBatchRequest) (*SendCoinsBatchResponse, error)
 	BuyItem(context.Context, *BuyItemRequest) (*BuyItemResponse, error)
+	GiftItem(context.Context, *GiftItemRequest) (*GiftItemResponse, error)
+	TransferItem(context.Context, *TransferItemRequest) (*TransferItemResponse, error)
+	SendFromTeamBudget(context.Context, *SendFromTeamBudgetRequest) (*SendFromTeamBudgetResponse, error)
+	CreatePaymentRequest(context.Context, *CreatePaymentRequestRequest) (*CreatePaymentRequestResponse, error)
+	ListPaymentRequests(context.Context, *ListPaymentRequestsRequest) (*ListPaymentRequestsResponse, error)
+	AcceptPaymentRequest(context.Context, *AcceptPaymentRequestRequest) (*AcceptPaymentRequestResponse, error)
+	DeclinePaymentRequest(context.Context, *DeclinePaymentRequestRequest) (*DeclinePaymentRequestResponse, error)
+	ScheduleTransfer(context.Context, *ScheduleTransferRequest) (*ScheduleTransferResponse, error)
+	ListScheduledTransfers(context.Context, *ListScheduledTransfersRequest) (*ListScheduledTransfersResponse, error)
+	CancelScheduledTransfer(context.Context, *CancelScheduledTransferRequest) (*CancelScheduledTransferResponse, error)
+	CreateListing(context.Context, *CreateListingRequest) (*CreateListingResponse, error)
+	UpdateListing(context.Context, *UpdateListingRequest) (*UpdateListingResponse, error)
+	CancelListing(context.Context, *CancelListingRequest) (*CancelListingResponse, error)
+	SearchListings(context.Context, *SearchListingsRequest) (*SearchListingsResponse, error)
+	BuyListing(context.Context, *BuyListingRequest) (*BuyListingResponse, error)
+	ListAuctions(context.Context, *ListAuctionsRequest) (*ListAuctionsResponse, error)
+	PlaceBid(context.Context, *PlaceBidRequest) (*PlaceBidResponse, error)
+	ListRaffles(context.Context, *ListRafflesRequest) (*ListRafflesResponse, error)
+	BuyRaffleTickets(context.Context, *BuyRaffleTicketsRequest) (*BuyRaffleTicketsResponse, error)
+	AddToWishlist(context.Context, *AddToWishlistRequest) (*AddToWishlistResponse, error)
+	RemoveFromWishlist(context.Context, *RemoveFromWishlistRequest) (*RemoveFromWishlistResponse, error)
+	ListWishlist(context.Context, *ListWishlistRequest) (*ListWishlistResponse, error)
+	ListWishlistEvents(context.Context, *ListWishlistEventsRequest) (*ListWishlistEventsResponse, error)
+	ListGoods(context.Context, *ListGoodsRequest) (*ListGoodsResponse, error)
+	GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error)
+	ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error)
+	PlacePreorder(context.Context, *PlacePreorderRequest) (*PlacePreorderResponse, error)
+	ListPreorders(context.Context, *ListPreordersRequest) (*ListPreordersResponse, error)
+	CancelPreorder(context.Context, *CancelPreorderRequest) (*CancelPreorderResponse, error)
+	SubscribeEvents(*SubscribeEventsRequest, grpc.ServerStreamingServer[UserEvent]) error
 	mustEmbedUnimplementedMerchStoreServiceServer()
 }
 
@@ -94,9 +506,102 @@ func (UnimplementedMerchStoreServiceServer) GetUserInfo(context.Context, *GetUse
 func (UnimplementedMerchStoreServiceServer) SendCoins(context.Context, *SendCoinsRequest) (*SendCoinsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SendCoins not implemented")
 }
+func (UnimplementedMerchStoreServiceServer) SendCoinsBatch(context.Context, *SendCoinsBatchRequest) (*SendCoinsBatchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SendCoinsBatch not implemented")
+}
 func (UnimplementedMerchStoreServiceServer) BuyItem(context.Context, *BuyItemRequest) (*BuyItemResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method BuyItem not implemented")
 }
+func (UnimplementedMerchStoreServiceServer) GiftItem(context.Context, *GiftItemRequest) (*GiftItemResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GiftItem not implemented")
+}
+func (UnimplementedMerchStoreServiceServer) TransferItem(context.Context, *TransferItemRequest) (*TransferItemResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TransferItem not implemented")
+}
+func (UnimplementedMerchStoreServiceServer) SendFromTeamBudget(context.Context, *SendFromTeamBudgetRequest) (*SendFromTeamBudgetResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SendFromTeamBudget not implemented")
+}
+func (UnimplementedMerchStoreServiceServer) CreatePaymentRequest(context.Context, *CreatePaymentRequestRequest) (*CreatePaymentRequestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreatePaymentRequest not implemented")
+}
+func (UnimplementedMerchStoreServiceServer) ListPaymentRequests(context.Context, *ListPaymentRequestsRequest) (*ListPaymentRequestsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPaymentRequests not implemented")
+}
+func (UnimplementedMerchStoreServiceServer) AcceptPaymentRequest(context.Context, *AcceptPaymentRequestRequest) (*AcceptPaymentRequestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AcceptPaymentRequest not implemented")
+}
+func (UnimplementedMerchStoreServiceServer) DeclinePaymentRequest(context.Context, *DeclinePaymentRequestRequest) (*DeclinePaymentRequestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeclinePaymentRequest not implemented")
+}
+func (UnimplementedMerchStoreServiceServer) ScheduleTransfer(context.Context, *ScheduleTransferRequest) (*ScheduleTransferResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ScheduleTransfer not implemented")
+}
+func (UnimplementedMerchStoreServiceServer) ListScheduledTransfers(context.Context, *ListScheduledTransfersRequest) (*ListScheduledTransfersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListScheduledTransfers not implemented")
+}
+func (UnimplementedMerchStoreServiceServer) CancelScheduledTransfer(context.Context, *CancelScheduledTransferRequest) (*CancelScheduledTransferResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelScheduledTransfer not implemented")
+}
+func (UnimplementedMerchStoreServiceServer) CreateListing(context.Context, *CreateListingRequest) (*CreateListingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateListing not implemented")
+}
+func (UnimplementedMerchStoreServiceServer) UpdateListing(context.Context, *UpdateListingRequest) (*UpdateListingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateListing not implemented")
+}
+func (UnimplementedMerchStoreServiceServer) CancelListing(context.Context, *CancelListingRequest) (*CancelListingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelListing not implemented")
+}
+func (UnimplementedMerchStoreServiceServer) SearchListings(context.Context, *SearchListingsRequest) (*SearchListingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchListings not implemented")
+}
+func (UnimplementedMerchStoreServiceServer) BuyListing(context.Context, *BuyListingRequest) (*BuyListingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BuyListing not implemented")
+}
+func (UnimplementedMerchStoreServiceServer) ListAuctions(context.Context, *ListAuctionsRequest) (*ListAuctionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAuctions not implemented")
+}
+func (UnimplementedMerchStoreServiceServer) PlaceBid(context.Context, *PlaceBidRequest) (*PlaceBidResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PlaceBid not implemented")
+}
+func (UnimplementedMerchStoreServiceServer) ListRaffles(context.Context, *ListRafflesRequest) (*ListRafflesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRaffles not implemented")
+}
+func (UnimplementedMerchStoreServiceServer) BuyRaffleTickets(context.Context, *BuyRaffleTicketsRequest) (*BuyRaffleTicketsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BuyRaffleTickets not implemented")
+}
+func (UnimplementedMerchStoreServiceServer) AddToWishlist(context.Context, *AddToWishlistRequest) (*AddToWishlistResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddToWishlist not implemented")
+}
+func (UnimplementedMerchStoreServiceServer) RemoveFromWishlist(context.Context, *RemoveFromWishlistRequest) (*RemoveFromWishlistResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveFromWishlist not implemented")
+}
+func (UnimplementedMerchStoreServiceServer) ListWishlist(context.Context, *ListWishlistRequest) (*ListWishlistResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListWishlist not implemented")
+}
+func (UnimplementedMerchStoreServiceServer) ListWishlistEvents(context.Context, *ListWishlistEventsRequest) (*ListWishlistEventsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListWishlistEvents not implemented")
+}
+func (UnimplementedMerchStoreServiceServer) ListGoods(context.Context, *ListGoodsRequest) (*ListGoodsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListGoods not implemented")
+}
+func (UnimplementedMerchStoreServiceServer) GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPriceHistory not implemented")
+}
+func (UnimplementedMerchStoreServiceServer) ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCategories not implemented")
+}
+func (UnimplementedMerchStoreServiceServer) PlacePreorder(context.Context, *PlacePreorderRequest) (*PlacePreorderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PlacePreorder not implemented")
+}
+func (UnimplementedMerchStoreServiceServer) ListPreorders(context.Context, *ListPreordersRequest) (*ListPreordersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPreorders not implemented")
+}
+func (UnimplementedMerchStoreServiceServer) CancelPreorder(context.Context, *CancelPreorderRequest) (*CancelPreorderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelPreorder not implemented")
+}
+func (UnimplementedMerchStoreServiceServer) SubscribeEvents(*SubscribeEventsRequest, grpc.ServerStreamingServer[UserEvent]) error {
+	return status.Error(codes.Unimplemented, "method SubscribeEvents not implemented")
+}
 func (UnimplementedMerchStoreServiceServer) mustEmbedUnimplementedMerchStoreServiceServer() {}
 func (UnimplementedMerchStoreServiceServer) testEmbeddedByValue()                           {}
 
@@ -154,17 +659,35 @@ func _MerchStoreService_SendCoins_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MerchStoreService_BuyItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BuyItemRequest)
+func _MerchStoreService_SendCoinsBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendCoinsBatchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MerchStoreServiceServer).BuyItem(ctx, in)
+		return srv.(MerchStoreServiceServer).SendCoinsBatch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MerchStoreService_BuyItem_FullMethodName,
+		FullMethod: MerchStoreService_SendCoinsBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchStoreServiceServer).SendCoinsBatch(ctx, req.(*SendCoinsBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchStoreService_BuyItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuyItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchStoreServiceServer).BuyItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchStoreService_BuyItem_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(MerchStoreServiceServer).BuyItem(ctx, req.(*BuyItemRequest))
@@ -172,6 +695,539 @@ func _MerchStoreService_BuyItem_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MerchStoreService_GiftItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GiftItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchStoreServiceServer).GiftItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchStoreService_GiftItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchStoreServiceServer).GiftItem(ctx, req.(*GiftItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchStoreService_TransferItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchStoreServiceServer).TransferItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchStoreService_TransferItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchStoreServiceServer).TransferItem(ctx, req.(*TransferItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchStoreService_SendFromTeamBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendFromTeamBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchStoreServiceServer).SendFromTeamBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchStoreService_SendFromTeamBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchStoreServiceServer).SendFromTeamBudget(ctx, req.(*SendFromTeamBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchStoreService_CreatePaymentRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePaymentRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchStoreServiceServer).CreatePaymentRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchStoreService_CreatePaymentRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchStoreServiceServer).CreatePaymentRequest(ctx, req.(*CreatePaymentRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchStoreService_ListPaymentRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPaymentRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchStoreServiceServer).ListPaymentRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchStoreService_ListPaymentRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchStoreServiceServer).ListPaymentRequests(ctx, req.(*ListPaymentRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchStoreService_AcceptPaymentRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptPaymentRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchStoreServiceServer).AcceptPaymentRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchStoreService_AcceptPaymentRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchStoreServiceServer).AcceptPaymentRequest(ctx, req.(*AcceptPaymentRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchStoreService_DeclinePaymentRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclinePaymentRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchStoreServiceServer).DeclinePaymentRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchStoreService_DeclinePaymentRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchStoreServiceServer).DeclinePaymentRequest(ctx, req.(*DeclinePaymentRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchStoreService_ScheduleTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchStoreServiceServer).ScheduleTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchStoreService_ScheduleTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchStoreServiceServer).ScheduleTransfer(ctx, req.(*ScheduleTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchStoreService_ListScheduledTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchStoreServiceServer).ListScheduledTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchStoreService_ListScheduledTransfers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchStoreServiceServer).ListScheduledTransfers(ctx, req.(*ListScheduledTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchStoreService_CancelScheduledTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchStoreServiceServer).CancelScheduledTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchStoreService_CancelScheduledTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchStoreServiceServer).CancelScheduledTransfer(ctx, req.(*CancelScheduledTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchStoreService_CreateListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateListingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchStoreServiceServer).CreateListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchStoreService_CreateListing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchStoreServiceServer).CreateListing(ctx, req.(*CreateListingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchStoreService_UpdateListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateListingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchStoreServiceServer).UpdateListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchStoreService_UpdateListing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchStoreServiceServer).UpdateListing(ctx, req.(*UpdateListingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchStoreService_CancelListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelListingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchStoreServiceServer).CancelListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchStoreService_CancelListing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchStoreServiceServer).CancelListing(ctx, req.(*CancelListingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchStoreService_SearchListings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchListingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchStoreServiceServer).SearchListings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchStoreService_SearchListings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchStoreServiceServer).SearchListings(ctx, req.(*SearchListingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchStoreService_BuyListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuyListingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchStoreServiceServer).BuyListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchStoreService_BuyListing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchStoreServiceServer).BuyListing(ctx, req.(*BuyListingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchStoreService_ListAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchStoreServiceServer).ListAuctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchStoreService_ListAuctions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchStoreServiceServer).ListAuctions(ctx, req.(*ListAuctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchStoreService_PlaceBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceBidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchStoreServiceServer).PlaceBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchStoreService_PlaceBid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchStoreServiceServer).PlaceBid(ctx, req.(*PlaceBidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchStoreService_ListRaffles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRafflesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchStoreServiceServer).ListRaffles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchStoreService_ListRaffles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchStoreServiceServer).ListRaffles(ctx, req.(*ListRafflesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchStoreService_BuyRaffleTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuyRaffleTicketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchStoreServiceServer).BuyRaffleTickets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchStoreService_BuyRaffleTickets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchStoreServiceServer).BuyRaffleTickets(ctx, req.(*BuyRaffleTicketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchStoreService_AddToWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddToWishlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchStoreServiceServer).AddToWishlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchStoreService_AddToWishlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchStoreServiceServer).AddToWishlist(ctx, req.(*AddToWishlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchStoreService_RemoveFromWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFromWishlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchStoreServiceServer).RemoveFromWishlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchStoreService_RemoveFromWishlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchStoreServiceServer).RemoveFromWishlist(ctx, req.(*RemoveFromWishlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchStoreService_ListWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWishlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchStoreServiceServer).ListWishlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchStoreService_ListWishlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchStoreServiceServer).ListWishlist(ctx, req.(*ListWishlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchStoreService_ListWishlistEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWishlistEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchStoreServiceServer).ListWishlistEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchStoreService_ListWishlistEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchStoreServiceServer).ListWishlistEvents(ctx, req.(*ListWishlistEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchStoreService_ListGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGoodsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchStoreServiceServer).ListGoods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchStoreService_ListGoods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchStoreServiceServer).ListGoods(ctx, req.(*ListGoodsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchStoreService_GetPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchStoreServiceServer).GetPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchStoreService_GetPriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchStoreServiceServer).GetPriceHistory(ctx, req.(*GetPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchStoreService_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchStoreServiceServer).ListCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchStoreService_ListCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchStoreServiceServer).ListCategories(ctx, req.(*ListCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchStoreService_PlacePreorder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlacePreorderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchStoreServiceServer).PlacePreorder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchStoreService_PlacePreorder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchStoreServiceServer).PlacePreorder(ctx, req.(*PlacePreorderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchStoreService_ListPreorders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPreordersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchStoreServiceServer).ListPreorders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchStoreService_ListPreorders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchStoreServiceServer).ListPreorders(ctx, req.(*ListPreordersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchStoreService_CancelPreorder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPreorderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchStoreServiceServer).CancelPreorder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchStoreService_CancelPreorder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchStoreServiceServer).CancelPreorder(ctx, req.(*CancelPreorderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchStoreService_SubscribeEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MerchStoreServiceServer).SubscribeEvents(m, &grpc.GenericServerStream[SubscribeEventsRequest, UserEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MerchStoreService_SubscribeEventsServer = grpc.ServerStreamingServer[UserEvent]
+
 // MerchStoreService_ServiceDesc is the grpc.ServiceDesc for MerchStoreService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -187,11 +1243,137 @@ var MerchStoreService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendCoins",
 			Handler:    _MerchStoreService_SendCoins_Handler,
 		},
+		{
+			MethodName: "SendCoinsBatch",
+			Handler:    _MerchStoreService_SendCoinsBatch_Handler,
+		},
 		{
 			MethodName: "BuyItem",
 			Handler:    _MerchStoreService_BuyItem_Handler,
 		},
+		{
+			MethodName: "GiftItem",
+			Handler:    _MerchStoreService_GiftItem_Handler,
+		},
+		{
+			MethodName: "TransferItem",
+			Handler:    _MerchStoreService_TransferItem_Handler,
+		},
+		{
+			MethodName: "SendFromTeamBudget",
+			Handler:    _MerchStoreService_SendFromTeamBudget_Handler,
+		},
+		{
+			MethodName: "CreatePaymentRequest",
+			Handler:    _MerchStoreService_CreatePaymentRequest_Handler,
+		},
+		{
+			MethodName: "ListPaymentRequests",
+			Handler:    _MerchStoreService_ListPaymentRequests_Handler,
+		},
+		{
+			MethodName: "AcceptPaymentRequest",
+			Handler:    _MerchStoreService_AcceptPaymentRequest_Handler,
+		},
+		{
+			MethodName: "DeclinePaymentRequest",
+			Handler:    _MerchStoreService_DeclinePaymentRequest_Handler,
+		},
+		{
+			MethodName: "ScheduleTransfer",
+			Handler:    _MerchStoreService_ScheduleTransfer_Handler,
+		},
+		{
+			MethodName: "ListScheduledTransfers",
+			Handler:    _MerchStoreService_ListScheduledTransfers_Handler,
+		},
+		{
+			MethodName: "CancelScheduledTransfer",
+			Handler:    _MerchStoreService_CancelScheduledTransfer_Handler,
+		},
+		{
+			MethodName: "CreateListing",
+			Handler:    _MerchStoreService_CreateListing_Handler,
+		},
+		{
+			MethodName: "UpdateListing",
+			Handler:    _MerchStoreService_UpdateListing_Handler,
+		},
+		{
+			MethodName: "CancelListing",
+			Handler:    _MerchStoreService_CancelListing_Handler,
+		},
+		{
+			MethodName: "SearchListings",
+			Handler:    _MerchStoreService_SearchListings_Handler,
+		},
+		{
+			MethodName: "BuyListing",
+			Handler:    _MerchStoreService_BuyListing_Handler,
+		},
+		{
+			MethodName: "ListAuctions",
+			Handler:    _MerchStoreService_ListAuctions_Handler,
+		},
+		{
+			MethodName: "PlaceBid",
+			Handler:    _MerchStoreService_PlaceBid_Handler,
+		},
+		{
+			MethodName: "ListRaffles",
+			Handler:    _MerchStoreService_ListRaffles_Handler,
+		},
+		{
+			MethodName: "BuyRaffleTickets",
+			Handler:    _MerchStoreService_BuyRaffleTickets_Handler,
+		},
+		{
+			MethodName: "AddToWishlist",
+			Handler:    _MerchStoreService_AddToWishlist_Handler,
+		},
+		{
+			MethodName: "RemoveFromWishlist",
+			Handler:    _MerchStoreService_RemoveFromWishlist_Handler,
+		},
+		{
+			MethodName: "ListWishlist",
+			Handler:    _MerchStoreService_ListWishlist_Handler,
+		},
+		{
+			MethodName: "ListWishlistEvents",
+			Handler:    _MerchStoreService_ListWishlistEvents_Handler,
+		},
+		{
+			MethodName: "ListGoods",
+			Handler:    _MerchStoreService_ListGoods_Handler,
+		},
+		{
+			MethodName: "GetPriceHistory",
+			Handler:    _MerchStoreService_GetPriceHistory_Handler,
+		},
+		{
+			MethodName: "ListCategories",
+			Handler:    _MerchStoreService_ListCategories_Handler,
+		},
+		{
+			MethodName: "PlacePreorder",
+			Handler:    _MerchStoreService_PlacePreorder_Handler,
+		},
+		{
+			MethodName: "ListPreorders",
+			Handler:    _MerchStoreService_ListPreorders_Handler,
+		},
+		{
+			MethodName: "CancelPreorder",
+			Handler:    _MerchStoreService_CancelPreorder_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeEvents",
+			Handler:       _MerchStoreService_SubscribeEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "store.proto",
 }
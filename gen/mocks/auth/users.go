@@ -50,6 +50,20 @@ func (mr *MockUsersRepositoryMockRecorder) CreateUser(ctx, username, hashedPassw
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUsersRepository)(nil).CreateUser), ctx, username, hashedPassword)
 }
 
+// DeactivateUser mocks base method.
+func (m *MockUsersRepository) DeactivateUser(ctx context.Context, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeactivateUser", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeactivateUser indicates an expected call of DeactivateUser.
+func (mr *MockUsersRepositoryMockRecorder) DeactivateUser(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivateUser", reflect.TypeOf((*MockUsersRepository)(nil).DeactivateUser), ctx, userID)
+}
+
 // GetUserID mocks base method.
 func (m *MockUsersRepository) GetUserID(ctx context.Context, username string) (int, error) {
 	m.ctrl.T.Helper()
@@ -65,6 +79,21 @@ func (mr *MockUsersRepositoryMockRecorder) GetUserID(ctx, username interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserID", reflect.TypeOf((*MockUsersRepository)(nil).GetUserID), ctx, username)
 }
 
+// GetUserIDs mocks base method.
+func (m *MockUsersRepository) GetUserIDs(ctx context.Context, usernames []string) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserIDs", ctx, usernames)
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserIDs indicates an expected call of GetUserIDs.
+func (mr *MockUsersRepositoryMockRecorder) GetUserIDs(ctx, usernames interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIDs", reflect.TypeOf((*MockUsersRepository)(nil).GetUserIDs), ctx, usernames)
+}
+
 // GetUsernames mocks base method.
 func (m *MockUsersRepository) GetUsernames(ctx context.Context, userIDs []int) (map[int]string, error) {
 	m.ctrl.T.Helper()
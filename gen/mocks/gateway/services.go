@@ -73,33 +73,412 @@ func (m *MockStoreService) EXPECT() *MockStoreServiceMockRecorder {
 	return m.recorder
 }
 
+// AcceptPaymentRequest mocks base method.
+func (m *MockStoreService) AcceptPaymentRequest(ctx context.Context, requestID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptPaymentRequest", ctx, requestID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcceptPaymentRequest indicates an expected call of AcceptPaymentRequest.
+func (mr *MockStoreServiceMockRecorder) AcceptPaymentRequest(ctx, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptPaymentRequest", reflect.TypeOf((*MockStoreService)(nil).AcceptPaymentRequest), ctx, requestID)
+}
+
+// AddToWishlist mocks base method.
+func (m *MockStoreService) AddToWishlist(ctx context.Context, itemName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddToWishlist", ctx, itemName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddToWishlist indicates an expected call of AddToWishlist.
+func (mr *MockStoreServiceMockRecorder) AddToWishlist(ctx, itemName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddToWishlist", reflect.TypeOf((*MockStoreService)(nil).AddToWishlist), ctx, itemName)
+}
+
 // BuyItem mocks base method.
-func (m *MockStoreService) BuyItem(ctx context.Context, itemName string) error {
+func (m *MockStoreService) BuyItem(ctx context.Context, itemName, variant, promoCode string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BuyItem", ctx, itemName)
+	ret := m.ctrl.Call(m, "BuyItem", ctx, itemName, variant, promoCode)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // BuyItem indicates an expected call of BuyItem.
-func (mr *MockStoreServiceMockRecorder) BuyItem(ctx, itemName interface{}) *gomock.Call {
+func (mr *MockStoreServiceMockRecorder) BuyItem(ctx, itemName, variant, promoCode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuyItem", reflect.TypeOf((*MockStoreService)(nil).BuyItem), ctx, itemName, variant, promoCode)
+}
+
+// BuyListing mocks base method.
+func (m *MockStoreService) BuyListing(ctx context.Context, listingID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuyListing", ctx, listingID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BuyListing indicates an expected call of BuyListing.
+func (mr *MockStoreServiceMockRecorder) BuyListing(ctx, listingID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuyListing", reflect.TypeOf((*MockStoreService)(nil).BuyListing), ctx, listingID)
+}
+
+// BuyRaffleTickets mocks base method.
+func (m *MockStoreService) BuyRaffleTickets(ctx context.Context, raffleID int, count uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuyRaffleTickets", ctx, raffleID, count)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BuyRaffleTickets indicates an expected call of BuyRaffleTickets.
+func (mr *MockStoreServiceMockRecorder) BuyRaffleTickets(ctx, raffleID, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuyRaffleTickets", reflect.TypeOf((*MockStoreService)(nil).BuyRaffleTickets), ctx, raffleID, count)
+}
+
+// CancelListing mocks base method.
+func (m *MockStoreService) CancelListing(ctx context.Context, listingID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelListing", ctx, listingID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelListing indicates an expected call of CancelListing.
+func (mr *MockStoreServiceMockRecorder) CancelListing(ctx, listingID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelListing", reflect.TypeOf((*MockStoreService)(nil).CancelListing), ctx, listingID)
+}
+
+// CancelPreorder mocks base method.
+func (m *MockStoreService) CancelPreorder(ctx context.Context, preorderID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelPreorder", ctx, preorderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelPreorder indicates an expected call of CancelPreorder.
+func (mr *MockStoreServiceMockRecorder) CancelPreorder(ctx, preorderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelPreorder", reflect.TypeOf((*MockStoreService)(nil).CancelPreorder), ctx, preorderID)
+}
+
+// CancelScheduledTransfer mocks base method.
+func (m *MockStoreService) CancelScheduledTransfer(ctx context.Context, scheduleID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelScheduledTransfer", ctx, scheduleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelScheduledTransfer indicates an expected call of CancelScheduledTransfer.
+func (mr *MockStoreServiceMockRecorder) CancelScheduledTransfer(ctx, scheduleID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelScheduledTransfer", reflect.TypeOf((*MockStoreService)(nil).CancelScheduledTransfer), ctx, scheduleID)
+}
+
+// CreateListing mocks base method.
+func (m *MockStoreService) CreateListing(ctx context.Context, itemName, variant string, price uint32) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateListing", ctx, itemName, variant, price)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateListing indicates an expected call of CreateListing.
+func (mr *MockStoreServiceMockRecorder) CreateListing(ctx, itemName, variant, price interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateListing", reflect.TypeOf((*MockStoreService)(nil).CreateListing), ctx, itemName, variant, price)
+}
+
+// CreatePaymentRequest mocks base method.
+func (m *MockStoreService) CreatePaymentRequest(ctx context.Context, payerUsername string, amount uint32, note string, expiryDays uint32) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePaymentRequest", ctx, payerUsername, amount, note, expiryDays)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePaymentRequest indicates an expected call of CreatePaymentRequest.
+func (mr *MockStoreServiceMockRecorder) CreatePaymentRequest(ctx, payerUsername, amount, note, expiryDays interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePaymentRequest", reflect.TypeOf((*MockStoreService)(nil).CreatePaymentRequest), ctx, payerUsername, amount, note, expiryDays)
+}
+
+// DeclinePaymentRequest mocks base method.
+func (m *MockStoreService) DeclinePaymentRequest(ctx context.Context, requestID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeclinePaymentRequest", ctx, requestID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeclinePaymentRequest indicates an expected call of DeclinePaymentRequest.
+func (mr *MockStoreServiceMockRecorder) DeclinePaymentRequest(ctx, requestID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuyItem", reflect.TypeOf((*MockStoreService)(nil).BuyItem), ctx, itemName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeclinePaymentRequest", reflect.TypeOf((*MockStoreService)(nil).DeclinePaymentRequest), ctx, requestID)
+}
+
+// GetPriceHistory mocks base method.
+func (m *MockStoreService) GetPriceHistory(ctx context.Context, itemName string) (domain.PriceHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPriceHistory", ctx, itemName)
+	ret0, _ := ret[0].(domain.PriceHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPriceHistory indicates an expected call of GetPriceHistory.
+func (mr *MockStoreServiceMockRecorder) GetPriceHistory(ctx, itemName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceHistory", reflect.TypeOf((*MockStoreService)(nil).GetPriceHistory), ctx, itemName)
 }
 
 // GetUserInfo mocks base method.
-func (m *MockStoreService) GetUserInfo(ctx context.Context) (domain.UserInfo, error) {
+func (m *MockStoreService) GetUserInfo(ctx context.Context, withWishlist bool) (domain.UserInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserInfo", ctx)
+	ret := m.ctrl.Call(m, "GetUserInfo", ctx, withWishlist)
 	ret0, _ := ret[0].(domain.UserInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserInfo indicates an expected call of GetUserInfo.
-func (mr *MockStoreServiceMockRecorder) GetUserInfo(ctx interface{}) *gomock.Call {
+func (mr *MockStoreServiceMockRecorder) GetUserInfo(ctx, withWishlist interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserInfo", reflect.TypeOf((*MockStoreService)(nil).GetUserInfo), ctx, withWishlist)
+}
+
+// GiftItem mocks base method.
+func (m *MockStoreService) GiftItem(ctx context.Context, itemName, variant, toUsername, message string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GiftItem", ctx, itemName, variant, toUsername, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GiftItem indicates an expected call of GiftItem.
+func (mr *MockStoreServiceMockRecorder) GiftItem(ctx, itemName, variant, toUsername, message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GiftItem", reflect.TypeOf((*MockStoreService)(nil).GiftItem), ctx, itemName, variant, toUsername, message)
+}
+
+// ListAuctions mocks base method.
+func (m *MockStoreService) ListAuctions(ctx context.Context) ([]domain.Auction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuctions", ctx)
+	ret0, _ := ret[0].([]domain.Auction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuctions indicates an expected call of ListAuctions.
+func (mr *MockStoreServiceMockRecorder) ListAuctions(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuctions", reflect.TypeOf((*MockStoreService)(nil).ListAuctions), ctx)
+}
+
+// ListCategories mocks base method.
+func (m *MockStoreService) ListCategories(ctx context.Context) ([]domain.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCategories", ctx)
+	ret0, _ := ret[0].([]domain.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCategories indicates an expected call of ListCategories.
+func (mr *MockStoreServiceMockRecorder) ListCategories(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategories", reflect.TypeOf((*MockStoreService)(nil).ListCategories), ctx)
+}
+
+// ListGoods mocks base method.
+func (m *MockStoreService) ListGoods(ctx context.Context, category string) ([]domain.CatalogItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGoods", ctx, category)
+	ret0, _ := ret[0].([]domain.CatalogItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGoods indicates an expected call of ListGoods.
+func (mr *MockStoreServiceMockRecorder) ListGoods(ctx, category interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserInfo", reflect.TypeOf((*MockStoreService)(nil).GetUserInfo), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGoods", reflect.TypeOf((*MockStoreService)(nil).ListGoods), ctx, category)
+}
+
+// ListPaymentRequests mocks base method.
+func (m *MockStoreService) ListPaymentRequests(ctx context.Context) ([]domain.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPaymentRequests", ctx)
+	ret0, _ := ret[0].([]domain.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPaymentRequests indicates an expected call of ListPaymentRequests.
+func (mr *MockStoreServiceMockRecorder) ListPaymentRequests(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPaymentRequests", reflect.TypeOf((*MockStoreService)(nil).ListPaymentRequests), ctx)
+}
+
+// ListPreorders mocks base method.
+func (m *MockStoreService) ListPreorders(ctx context.Context) (domain.Preorders, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPreorders", ctx)
+	ret0, _ := ret[0].(domain.Preorders)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPreorders indicates an expected call of ListPreorders.
+func (mr *MockStoreServiceMockRecorder) ListPreorders(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPreorders", reflect.TypeOf((*MockStoreService)(nil).ListPreorders), ctx)
+}
+
+// ListRaffles mocks base method.
+func (m *MockStoreService) ListRaffles(ctx context.Context) ([]domain.Raffle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRaffles", ctx)
+	ret0, _ := ret[0].([]domain.Raffle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRaffles indicates an expected call of ListRaffles.
+func (mr *MockStoreServiceMockRecorder) ListRaffles(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRaffles", reflect.TypeOf((*MockStoreService)(nil).ListRaffles), ctx)
+}
+
+// ListScheduledTransfers mocks base method.
+func (m *MockStoreService) ListScheduledTransfers(ctx context.Context) ([]domain.ScheduledTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListScheduledTransfers", ctx)
+	ret0, _ := ret[0].([]domain.ScheduledTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListScheduledTransfers indicates an expected call of ListScheduledTransfers.
+func (mr *MockStoreServiceMockRecorder) ListScheduledTransfers(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListScheduledTransfers", reflect.TypeOf((*MockStoreService)(nil).ListScheduledTransfers), ctx)
+}
+
+// ListWishlist mocks base method.
+func (m *MockStoreService) ListWishlist(ctx context.Context) ([]domain.WishlistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWishlist", ctx)
+	ret0, _ := ret[0].([]domain.WishlistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWishlist indicates an expected call of ListWishlist.
+func (mr *MockStoreServiceMockRecorder) ListWishlist(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWishlist", reflect.TypeOf((*MockStoreService)(nil).ListWishlist), ctx)
+}
+
+// ListWishlistEvents mocks base method.
+func (m *MockStoreService) ListWishlistEvents(ctx context.Context) ([]domain.WishlistEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWishlistEvents", ctx)
+	ret0, _ := ret[0].([]domain.WishlistEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWishlistEvents indicates an expected call of ListWishlistEvents.
+func (mr *MockStoreServiceMockRecorder) ListWishlistEvents(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWishlistEvents", reflect.TypeOf((*MockStoreService)(nil).ListWishlistEvents), ctx)
+}
+
+// PlaceBid mocks base method.
+func (m *MockStoreService) PlaceBid(ctx context.Context, auctionID int, amount uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaceBid", ctx, auctionID, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PlaceBid indicates an expected call of PlaceBid.
+func (mr *MockStoreServiceMockRecorder) PlaceBid(ctx, auctionID, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaceBid", reflect.TypeOf((*MockStoreService)(nil).PlaceBid), ctx, auctionID, amount)
+}
+
+// PlacePreorder mocks base method.
+func (m *MockStoreService) PlacePreorder(ctx context.Context, itemName, variant string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlacePreorder", ctx, itemName, variant)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlacePreorder indicates an expected call of PlacePreorder.
+func (mr *MockStoreServiceMockRecorder) PlacePreorder(ctx, itemName, variant interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlacePreorder", reflect.TypeOf((*MockStoreService)(nil).PlacePreorder), ctx, itemName, variant)
+}
+
+// RemoveFromWishlist mocks base method.
+func (m *MockStoreService) RemoveFromWishlist(ctx context.Context, itemName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFromWishlist", ctx, itemName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveFromWishlist indicates an expected call of RemoveFromWishlist.
+func (mr *MockStoreServiceMockRecorder) RemoveFromWishlist(ctx, itemName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromWishlist", reflect.TypeOf((*MockStoreService)(nil).RemoveFromWishlist), ctx, itemName)
+}
+
+// ScheduleTransfer mocks base method.
+func (m *MockStoreService) ScheduleTransfer(ctx context.Context, toUsername string, amount uint32, startAt, recurrence string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleTransfer", ctx, toUsername, amount, startAt, recurrence)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduleTransfer indicates an expected call of ScheduleTransfer.
+func (mr *MockStoreServiceMockRecorder) ScheduleTransfer(ctx, toUsername, amount, startAt, recurrence interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleTransfer", reflect.TypeOf((*MockStoreService)(nil).ScheduleTransfer), ctx, toUsername, amount, startAt, recurrence)
+}
+
+// SearchListings mocks base method.
+func (m *MockStoreService) SearchListings(ctx context.Context, filter domain.ListingFilter) ([]domain.Listing, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchListings", ctx, filter)
+	ret0, _ := ret[0].([]domain.Listing)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchListings indicates an expected call of SearchListings.
+func (mr *MockStoreServiceMockRecorder) SearchListings(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchListings", reflect.TypeOf((*MockStoreService)(nil).SearchListings), ctx, filter)
 }
 
 // SendCoins mocks base method.
@@ -115,3 +494,497 @@ func (mr *MockStoreServiceMockRecorder) SendCoins(ctx, toUsername, amount interf
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoins", reflect.TypeOf((*MockStoreService)(nil).SendCoins), ctx, toUsername, amount)
 }
+
+// SendCoinsBatch mocks base method.
+func (m *MockStoreService) SendCoinsBatch(ctx context.Context, transfers []domain.SentTransfer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoinsBatch", ctx, transfers)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCoinsBatch indicates an expected call of SendCoinsBatch.
+func (mr *MockStoreServiceMockRecorder) SendCoinsBatch(ctx, transfers interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsBatch", reflect.TypeOf((*MockStoreService)(nil).SendCoinsBatch), ctx, transfers)
+}
+
+// SendFromTeamBudget mocks base method.
+func (m *MockStoreService) SendFromTeamBudget(ctx context.Context, teamName, toUsername string, amount uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendFromTeamBudget", ctx, teamName, toUsername, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendFromTeamBudget indicates an expected call of SendFromTeamBudget.
+func (mr *MockStoreServiceMockRecorder) SendFromTeamBudget(ctx, teamName, toUsername, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendFromTeamBudget", reflect.TypeOf((*MockStoreService)(nil).SendFromTeamBudget), ctx, teamName, toUsername, amount)
+}
+
+// SubscribeEvents mocks base method.
+func (m *MockStoreService) SubscribeEvents(ctx context.Context, handle func(domain.UserEvent) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeEvents", ctx, handle)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubscribeEvents indicates an expected call of SubscribeEvents.
+func (mr *MockStoreServiceMockRecorder) SubscribeEvents(ctx, handle interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeEvents", reflect.TypeOf((*MockStoreService)(nil).SubscribeEvents), ctx, handle)
+}
+
+// TransferItem mocks base method.
+func (m *MockStoreService) TransferItem(ctx context.Context, itemName, variant, toUsername string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferItem", ctx, itemName, variant, toUsername)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TransferItem indicates an expected call of TransferItem.
+func (mr *MockStoreServiceMockRecorder) TransferItem(ctx, itemName, variant, toUsername interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferItem", reflect.TypeOf((*MockStoreService)(nil).TransferItem), ctx, itemName, variant, toUsername)
+}
+
+// UpdateListing mocks base method.
+func (m *MockStoreService) UpdateListing(ctx context.Context, listingID int, price uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateListing", ctx, listingID, price)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateListing indicates an expected call of UpdateListing.
+func (mr *MockStoreServiceMockRecorder) UpdateListing(ctx, listingID, price interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateListing", reflect.TypeOf((*MockStoreService)(nil).UpdateListing), ctx, listingID, price)
+}
+
+// MockAdminService is a mock of AdminService interface.
+type MockAdminService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminServiceMockRecorder
+}
+
+// MockAdminServiceMockRecorder is the mock recorder for MockAdminService.
+type MockAdminServiceMockRecorder struct {
+	mock *MockAdminService
+}
+
+// NewMockAdminService creates a new mock instance.
+func NewMockAdminService(ctrl *gomock.Controller) *MockAdminService {
+	mock := &MockAdminService{ctrl: ctrl}
+	mock.recorder = &MockAdminServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminService) EXPECT() *MockAdminServiceMockRecorder {
+	return m.recorder
+}
+
+// AddTeamMember mocks base method.
+func (m *MockAdminService) AddTeamMember(ctx context.Context, teamName, username string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTeamMember", ctx, teamName, username)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddTeamMember indicates an expected call of AddTeamMember.
+func (mr *MockAdminServiceMockRecorder) AddTeamMember(ctx, teamName, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTeamMember", reflect.TypeOf((*MockAdminService)(nil).AddTeamMember), ctx, teamName, username)
+}
+
+// AnnounceGood mocks base method.
+func (m *MockAdminService) AnnounceGood(ctx context.Context, itemName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AnnounceGood", ctx, itemName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AnnounceGood indicates an expected call of AnnounceGood.
+func (mr *MockAdminServiceMockRecorder) AnnounceGood(ctx, itemName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnnounceGood", reflect.TypeOf((*MockAdminService)(nil).AnnounceGood), ctx, itemName)
+}
+
+// CommitEventOffset mocks base method.
+func (m *MockAdminService) CommitEventOffset(ctx context.Context, consumer string, offset int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitEventOffset", ctx, consumer, offset)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CommitEventOffset indicates an expected call of CommitEventOffset.
+func (mr *MockAdminServiceMockRecorder) CommitEventOffset(ctx, consumer, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitEventOffset", reflect.TypeOf((*MockAdminService)(nil).CommitEventOffset), ctx, consumer, offset)
+}
+
+// CreateAuction mocks base method.
+func (m *MockAdminService) CreateAuction(ctx context.Context, itemName string, reservePrice uint32, startsAt, endsAt string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAuction", ctx, itemName, reservePrice, startsAt, endsAt)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAuction indicates an expected call of CreateAuction.
+func (mr *MockAdminServiceMockRecorder) CreateAuction(ctx, itemName, reservePrice, startsAt, endsAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuction", reflect.TypeOf((*MockAdminService)(nil).CreateAuction), ctx, itemName, reservePrice, startsAt, endsAt)
+}
+
+// CreateCategory mocks base method.
+func (m *MockAdminService) CreateCategory(ctx context.Context, slug, name string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCategory", ctx, slug, name)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCategory indicates an expected call of CreateCategory.
+func (mr *MockAdminServiceMockRecorder) CreateCategory(ctx, slug, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*MockAdminService)(nil).CreateCategory), ctx, slug, name)
+}
+
+// CreatePromoCode mocks base method.
+func (m *MockAdminService) CreatePromoCode(ctx context.Context, promo domain.PromoCode) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePromoCode", ctx, promo)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePromoCode indicates an expected call of CreatePromoCode.
+func (mr *MockAdminServiceMockRecorder) CreatePromoCode(ctx, promo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePromoCode", reflect.TypeOf((*MockAdminService)(nil).CreatePromoCode), ctx, promo)
+}
+
+// CreateRaffle mocks base method.
+func (m *MockAdminService) CreateRaffle(ctx context.Context, itemName string, ticketPrice uint32, drawAt string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRaffle", ctx, itemName, ticketPrice, drawAt)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRaffle indicates an expected call of CreateRaffle.
+func (mr *MockAdminServiceMockRecorder) CreateRaffle(ctx, itemName, ticketPrice, drawAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRaffle", reflect.TypeOf((*MockAdminService)(nil).CreateRaffle), ctx, itemName, ticketPrice, drawAt)
+}
+
+// CreateTeam mocks base method.
+func (m *MockAdminService) CreateTeam(ctx context.Context, name, managerUsername string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTeam", ctx, name, managerUsername)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTeam indicates an expected call of CreateTeam.
+func (mr *MockAdminServiceMockRecorder) CreateTeam(ctx, name, managerUsername interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTeam", reflect.TypeOf((*MockAdminService)(nil).CreateTeam), ctx, name, managerUsername)
+}
+
+// CreateWebhookEndpoint mocks base method.
+func (m *MockAdminService) CreateWebhookEndpoint(ctx context.Context, url string, events []string) (domain.WebhookEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhookEndpoint", ctx, url, events)
+	ret0, _ := ret[0].(domain.WebhookEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhookEndpoint indicates an expected call of CreateWebhookEndpoint.
+func (mr *MockAdminServiceMockRecorder) CreateWebhookEndpoint(ctx, url, events interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhookEndpoint", reflect.TypeOf((*MockAdminService)(nil).CreateWebhookEndpoint), ctx, url, events)
+}
+
+// DeactivateAccount mocks base method.
+func (m *MockAdminService) DeactivateAccount(ctx context.Context, username string, sweepBalance bool) (uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeactivateAccount", ctx, username, sweepBalance)
+	ret0, _ := ret[0].(uint32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeactivateAccount indicates an expected call of DeactivateAccount.
+func (mr *MockAdminServiceMockRecorder) DeactivateAccount(ctx, username, sweepBalance interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivateAccount", reflect.TypeOf((*MockAdminService)(nil).DeactivateAccount), ctx, username, sweepBalance)
+}
+
+// DeleteWebhookEndpoint mocks base method.
+func (m *MockAdminService) DeleteWebhookEndpoint(ctx context.Context, endpointID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhookEndpoint", ctx, endpointID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhookEndpoint indicates an expected call of DeleteWebhookEndpoint.
+func (mr *MockAdminServiceMockRecorder) DeleteWebhookEndpoint(ctx, endpointID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhookEndpoint", reflect.TypeOf((*MockAdminService)(nil).DeleteWebhookEndpoint), ctx, endpointID)
+}
+
+// FetchEvents mocks base method.
+func (m *MockAdminService) FetchEvents(ctx context.Context, consumer string, limit uint32) ([]domain.DomainEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchEvents", ctx, consumer, limit)
+	ret0, _ := ret[0].([]domain.DomainEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchEvents indicates an expected call of FetchEvents.
+func (mr *MockAdminServiceMockRecorder) FetchEvents(ctx, consumer, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchEvents", reflect.TypeOf((*MockAdminService)(nil).FetchEvents), ctx, consumer, limit)
+}
+
+// FreezeAccount mocks base method.
+func (m *MockAdminService) FreezeAccount(ctx context.Context, username, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FreezeAccount", ctx, username, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FreezeAccount indicates an expected call of FreezeAccount.
+func (mr *MockAdminServiceMockRecorder) FreezeAccount(ctx, username, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FreezeAccount", reflect.TypeOf((*MockAdminService)(nil).FreezeAccount), ctx, username, reason)
+}
+
+// FreezeFraudCase mocks base method.
+func (m *MockAdminService) FreezeFraudCase(ctx context.Context, caseID int, resolution string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FreezeFraudCase", ctx, caseID, resolution)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FreezeFraudCase indicates an expected call of FreezeFraudCase.
+func (mr *MockAdminServiceMockRecorder) FreezeFraudCase(ctx, caseID, resolution interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FreezeFraudCase", reflect.TypeOf((*MockAdminService)(nil).FreezeFraudCase), ctx, caseID, resolution)
+}
+
+// ListFraudCases mocks base method.
+func (m *MockAdminService) ListFraudCases(ctx context.Context, status string) ([]domain.FraudCase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFraudCases", ctx, status)
+	ret0, _ := ret[0].([]domain.FraudCase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFraudCases indicates an expected call of ListFraudCases.
+func (mr *MockAdminServiceMockRecorder) ListFraudCases(ctx, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFraudCases", reflect.TypeOf((*MockAdminService)(nil).ListFraudCases), ctx, status)
+}
+
+// ListWebhookDeadLetters mocks base method.
+func (m *MockAdminService) ListWebhookDeadLetters(ctx context.Context) ([]domain.WebhookDeadLetter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhookDeadLetters", ctx)
+	ret0, _ := ret[0].([]domain.WebhookDeadLetter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhookDeadLetters indicates an expected call of ListWebhookDeadLetters.
+func (mr *MockAdminServiceMockRecorder) ListWebhookDeadLetters(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookDeadLetters", reflect.TypeOf((*MockAdminService)(nil).ListWebhookDeadLetters), ctx)
+}
+
+// ListWebhookEndpoints mocks base method.
+func (m *MockAdminService) ListWebhookEndpoints(ctx context.Context) ([]domain.WebhookEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhookEndpoints", ctx)
+	ret0, _ := ret[0].([]domain.WebhookEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhookEndpoints indicates an expected call of ListWebhookEndpoints.
+func (mr *MockAdminServiceMockRecorder) ListWebhookEndpoints(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookEndpoints", reflect.TypeOf((*MockAdminService)(nil).ListWebhookEndpoints), ctx)
+}
+
+// MarkGoodArrived mocks base method.
+func (m *MockAdminService) MarkGoodArrived(ctx context.Context, itemName string) (domain.GoodArrival, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkGoodArrived", ctx, itemName)
+	ret0, _ := ret[0].(domain.GoodArrival)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkGoodArrived indicates an expected call of MarkGoodArrived.
+func (mr *MockAdminServiceMockRecorder) MarkGoodArrived(ctx, itemName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkGoodArrived", reflect.TypeOf((*MockAdminService)(nil).MarkGoodArrived), ctx, itemName)
+}
+
+// ResolveFraudCase mocks base method.
+func (m *MockAdminService) ResolveFraudCase(ctx context.Context, caseID int, resolution string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveFraudCase", ctx, caseID, resolution)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResolveFraudCase indicates an expected call of ResolveFraudCase.
+func (mr *MockAdminServiceMockRecorder) ResolveFraudCase(ctx, caseID, resolution interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveFraudCase", reflect.TypeOf((*MockAdminService)(nil).ResolveFraudCase), ctx, caseID, resolution)
+}
+
+// SchedulePriceChange mocks base method.
+func (m *MockAdminService) SchedulePriceChange(ctx context.Context, itemName string, price, discountPercent uint32, startsAt, endsAt string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SchedulePriceChange", ctx, itemName, price, discountPercent, startsAt, endsAt)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SchedulePriceChange indicates an expected call of SchedulePriceChange.
+func (mr *MockAdminServiceMockRecorder) SchedulePriceChange(ctx, itemName, price, discountPercent, startsAt, endsAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SchedulePriceChange", reflect.TypeOf((*MockAdminService)(nil).SchedulePriceChange), ctx, itemName, price, discountPercent, startsAt, endsAt)
+}
+
+// SetPurchaseLimit mocks base method.
+func (m *MockAdminService) SetPurchaseLimit(ctx context.Context, itemName string, maxQuantity, periodDays uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPurchaseLimit", ctx, itemName, maxQuantity, periodDays)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPurchaseLimit indicates an expected call of SetPurchaseLimit.
+func (mr *MockAdminServiceMockRecorder) SetPurchaseLimit(ctx, itemName, maxQuantity, periodDays interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPurchaseLimit", reflect.TypeOf((*MockAdminService)(nil).SetPurchaseLimit), ctx, itemName, maxQuantity, periodDays)
+}
+
+// SetTeamBudgetTopUp mocks base method.
+func (m *MockAdminService) SetTeamBudgetTopUp(ctx context.Context, teamName string, amount, periodHours uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTeamBudgetTopUp", ctx, teamName, amount, periodHours)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTeamBudgetTopUp indicates an expected call of SetTeamBudgetTopUp.
+func (mr *MockAdminServiceMockRecorder) SetTeamBudgetTopUp(ctx, teamName, amount, periodHours interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTeamBudgetTopUp", reflect.TypeOf((*MockAdminService)(nil).SetTeamBudgetTopUp), ctx, teamName, amount, periodHours)
+}
+
+// SetTransferLimits mocks base method.
+func (m *MockAdminService) SetTransferLimits(ctx context.Context, username string, limits domain.TransferLimits) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTransferLimits", ctx, username, limits)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTransferLimits indicates an expected call of SetTransferLimits.
+func (mr *MockAdminServiceMockRecorder) SetTransferLimits(ctx, username, limits interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTransferLimits", reflect.TypeOf((*MockAdminService)(nil).SetTransferLimits), ctx, username, limits)
+}
+
+// UnfreezeAccount mocks base method.
+func (m *MockAdminService) UnfreezeAccount(ctx context.Context, username, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnfreezeAccount", ctx, username, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnfreezeAccount indicates an expected call of UnfreezeAccount.
+func (mr *MockAdminServiceMockRecorder) UnfreezeAccount(ctx, username, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnfreezeAccount", reflect.TypeOf((*MockAdminService)(nil).UnfreezeAccount), ctx, username, reason)
+}
+
+// UpdateGoodDetails mocks base method.
+func (m *MockAdminService) UpdateGoodDetails(ctx context.Context, itemName string, details domain.GoodDetails) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGoodDetails", ctx, itemName, details)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateGoodDetails indicates an expected call of UpdateGoodDetails.
+func (mr *MockAdminServiceMockRecorder) UpdateGoodDetails(ctx, itemName, details interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGoodDetails", reflect.TypeOf((*MockAdminService)(nil).UpdateGoodDetails), ctx, itemName, details)
+}
+
+// MockAuditService is a mock of AuditService interface.
+type MockAuditService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditServiceMockRecorder
+}
+
+// MockAuditServiceMockRecorder is the mock recorder for MockAuditService.
+type MockAuditServiceMockRecorder struct {
+	mock *MockAuditService
+}
+
+// NewMockAuditService creates a new mock instance.
+func NewMockAuditService(ctrl *gomock.Controller) *MockAuditService {
+	mock := &MockAuditService{ctrl: ctrl}
+	mock.recorder = &MockAuditServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditService) EXPECT() *MockAuditServiceMockRecorder {
+	return m.recorder
+}
+
+// ListAuditLog mocks base method.
+func (m *MockAuditService) ListAuditLog(ctx context.Context, filter domain.AuditFilter) ([]domain.AuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditLog", ctx, filter)
+	ret0, _ := ret[0].([]domain.AuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditLog indicates an expected call of ListAuditLog.
+func (mr *MockAuditServiceMockRecorder) ListAuditLog(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditLog", reflect.TypeOf((*MockAuditService)(nil).ListAuditLog), ctx, filter)
+}
@@ -0,0 +1,982 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./gen/merch/v1/admin_grpc.pb.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	merchapi "github.com/Lexv0lk/merch-store/gen/merch/v1"
+	gomock "github.com/golang/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockMerchAdminServiceClient is a mock of MerchAdminServiceClient interface.
+type MockMerchAdminServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockMerchAdminServiceClientMockRecorder
+}
+
+// MockMerchAdminServiceClientMockRecorder is the mock recorder for MockMerchAdminServiceClient.
+type MockMerchAdminServiceClientMockRecorder struct {
+	mock *MockMerchAdminServiceClient
+}
+
+// NewMockMerchAdminServiceClient creates a new mock instance.
+func NewMockMerchAdminServiceClient(ctrl *gomock.Controller) *MockMerchAdminServiceClient {
+	mock := &MockMerchAdminServiceClient{ctrl: ctrl}
+	mock.recorder = &MockMerchAdminServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMerchAdminServiceClient) EXPECT() *MockMerchAdminServiceClientMockRecorder {
+	return m.recorder
+}
+
+// AddTeamMember mocks base method.
+func (m *MockMerchAdminServiceClient) AddTeamMember(ctx context.Context, in *merchapi.AddTeamMemberRequest, opts ...grpc.CallOption) (*merchapi.AddTeamMemberResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddTeamMember", varargs...)
+	ret0, _ := ret[0].(*merchapi.AddTeamMemberResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTeamMember indicates an expected call of AddTeamMember.
+func (mr *MockMerchAdminServiceClientMockRecorder) AddTeamMember(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTeamMember", reflect.TypeOf((*MockMerchAdminServiceClient)(nil).AddTeamMember), varargs...)
+}
+
+// AnnounceGood mocks base method.
+func (m *MockMerchAdminServiceClient) AnnounceGood(ctx context.Context, in *merchapi.AnnounceGoodRequest, opts ...grpc.CallOption) (*merchapi.AnnounceGoodResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AnnounceGood", varargs...)
+	ret0, _ := ret[0].(*merchapi.AnnounceGoodResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AnnounceGood indicates an expected call of AnnounceGood.
+func (mr *MockMerchAdminServiceClientMockRecorder) AnnounceGood(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnnounceGood", reflect.TypeOf((*MockMerchAdminServiceClient)(nil).AnnounceGood), varargs...)
+}
+
+// CommitEventOffset mocks base method.
+func (m *MockMerchAdminServiceClient) CommitEventOffset(ctx context.Context, in *merchapi.CommitEventOffsetRequest, opts ...grpc.CallOption) (*merchapi.CommitEventOffsetResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CommitEventOffset", varargs...)
+	ret0, _ := ret[0].(*merchapi.CommitEventOffsetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CommitEventOffset indicates an expected call of CommitEventOffset.
+func (mr *MockMerchAdminServiceClientMockRecorder) CommitEventOffset(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitEventOffset", reflect.TypeOf((*MockMerchAdminServiceClient)(nil).CommitEventOffset), varargs...)
+}
+
+// CreateAuction mocks base method.
+func (m *MockMerchAdminServiceClient) CreateAuction(ctx context.Context, in *merchapi.CreateAuctionRequest, opts ...grpc.CallOption) (*merchapi.CreateAuctionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateAuction", varargs...)
+	ret0, _ := ret[0].(*merchapi.CreateAuctionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAuction indicates an expected call of CreateAuction.
+func (mr *MockMerchAdminServiceClientMockRecorder) CreateAuction(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuction", reflect.TypeOf((*MockMerchAdminServiceClient)(nil).CreateAuction), varargs...)
+}
+
+// CreateCategory mocks base method.
+func (m *MockMerchAdminServiceClient) CreateCategory(ctx context.Context, in *merchapi.CreateCategoryRequest, opts ...grpc.CallOption) (*merchapi.CreateCategoryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateCategory", varargs...)
+	ret0, _ := ret[0].(*merchapi.CreateCategoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCategory indicates an expected call of CreateCategory.
+func (mr *MockMerchAdminServiceClientMockRecorder) CreateCategory(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*MockMerchAdminServiceClient)(nil).CreateCategory), varargs...)
+}
+
+// CreatePromoCode mocks base method.
+func (m *MockMerchAdminServiceClient) CreatePromoCode(ctx context.Context, in *merchapi.CreatePromoCodeRequest, opts ...grpc.CallOption) (*merchapi.CreatePromoCodeResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreatePromoCode", varargs...)
+	ret0, _ := ret[0].(*merchapi.CreatePromoCodeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePromoCode indicates an expected call of CreatePromoCode.
+func (mr *MockMerchAdminServiceClientMockRecorder) CreatePromoCode(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePromoCode", reflect.TypeOf((*MockMerchAdminServiceClient)(nil).CreatePromoCode), varargs...)
+}
+
+// CreateRaffle mocks base method.
+func (m *MockMerchAdminServiceClient) CreateRaffle(ctx context.Context, in *merchapi.CreateRaffleRequest, opts ...grpc.CallOption) (*merchapi.CreateRaffleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateRaffle", varargs...)
+	ret0, _ := ret[0].(*merchapi.CreateRaffleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRaffle indicates an expected call of CreateRaffle.
+func (mr *MockMerchAdminServiceClientMockRecorder) CreateRaffle(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRaffle", reflect.TypeOf((*MockMerchAdminServiceClient)(nil).CreateRaffle), varargs...)
+}
+
+// CreateTeam mocks base method.
+func (m *MockMerchAdminServiceClient) CreateTeam(ctx context.Context, in *merchapi.CreateTeamRequest, opts ...grpc.CallOption) (*merchapi.CreateTeamResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateTeam", varargs...)
+	ret0, _ := ret[0].(*merchapi.CreateTeamResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTeam indicates an expected call of CreateTeam.
+func (mr *MockMerchAdminServiceClientMockRecorder) CreateTeam(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTeam", reflect.TypeOf((*MockMerchAdminServiceClient)(nil).CreateTeam), varargs...)
+}
+
+// CreateWebhookEndpoint mocks base method.
+func (m *MockMerchAdminServiceClient) CreateWebhookEndpoint(ctx context.Context, in *merchapi.CreateWebhookEndpointRequest, opts ...grpc.CallOption) (*merchapi.CreateWebhookEndpointResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateWebhookEndpoint", varargs...)
+	ret0, _ := ret[0].(*merchapi.CreateWebhookEndpointResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhookEndpoint indicates an expected call of CreateWebhookEndpoint.
+func (mr *MockMerchAdminServiceClientMockRecorder) CreateWebhookEndpoint(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhookEndpoint", reflect.TypeOf((*MockMerchAdminServiceClient)(nil).CreateWebhookEndpoint), varargs...)
+}
+
+// DeactivateAccount mocks base method.
+func (m *MockMerchAdminServiceClient) DeactivateAccount(ctx context.Context, in *merchapi.DeactivateAccountRequest, opts ...grpc.CallOption) (*merchapi.DeactivateAccountResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeactivateAccount", varargs...)
+	ret0, _ := ret[0].(*merchapi.DeactivateAccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeactivateAccount indicates an expected call of DeactivateAccount.
+func (mr *MockMerchAdminServiceClientMockRecorder) DeactivateAccount(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivateAccount", reflect.TypeOf((*MockMerchAdminServiceClient)(nil).DeactivateAccount), varargs...)
+}
+
+// DeleteWebhookEndpoint mocks base method.
+func (m *MockMerchAdminServiceClient) DeleteWebhookEndpoint(ctx context.Context, in *merchapi.DeleteWebhookEndpointRequest, opts ...grpc.CallOption) (*merchapi.DeleteWebhookEndpointResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteWebhookEndpoint", varargs...)
+	ret0, _ := ret[0].(*merchapi.DeleteWebhookEndpointResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteWebhookEndpoint indicates an expected call of DeleteWebhookEndpoint.
+func (mr *MockMerchAdminServiceClientMockRecorder) DeleteWebhookEndpoint(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhookEndpoint", reflect.TypeOf((*MockMerchAdminServiceClient)(nil).DeleteWebhookEndpoint), varargs...)
+}
+
+// FetchEvents mocks base method.
+func (m *MockMerchAdminServiceClient) FetchEvents(ctx context.Context, in *merchapi.FetchEventsRequest, opts ...grpc.CallOption) (*merchapi.FetchEventsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FetchEvents", varargs...)
+	ret0, _ := ret[0].(*merchapi.FetchEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchEvents indicates an expected call of FetchEvents.
+func (mr *MockMerchAdminServiceClientMockRecorder) FetchEvents(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchEvents", reflect.TypeOf((*MockMerchAdminServiceClient)(nil).FetchEvents), varargs...)
+}
+
+// FreezeAccount mocks base method.
+func (m *MockMerchAdminServiceClient) FreezeAccount(ctx context.Context, in *merchapi.FreezeAccountRequest, opts ...grpc.CallOption) (*merchapi.FreezeAccountResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FreezeAccount", varargs...)
+	ret0, _ := ret[0].(*merchapi.FreezeAccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FreezeAccount indicates an expected call of FreezeAccount.
+func (mr *MockMerchAdminServiceClientMockRecorder) FreezeAccount(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FreezeAccount", reflect.TypeOf((*MockMerchAdminServiceClient)(nil).FreezeAccount), varargs...)
+}
+
+// FreezeFraudCase mocks base method.
+func (m *MockMerchAdminServiceClient) FreezeFraudCase(ctx context.Context, in *merchapi.FreezeFraudCaseRequest, opts ...grpc.CallOption) (*merchapi.FreezeFraudCaseResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FreezeFraudCase", varargs...)
+	ret0, _ := ret[0].(*merchapi.FreezeFraudCaseResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FreezeFraudCase indicates an expected call of FreezeFraudCase.
+func (mr *MockMerchAdminServiceClientMockRecorder) FreezeFraudCase(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FreezeFraudCase", reflect.TypeOf((*MockMerchAdminServiceClient)(nil).FreezeFraudCase), varargs...)
+}
+
+// ListFraudCases mocks base method.
+func (m *MockMerchAdminServiceClient) ListFraudCases(ctx context.Context, in *merchapi.ListFraudCasesRequest, opts ...grpc.CallOption) (*merchapi.ListFraudCasesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListFraudCases", varargs...)
+	ret0, _ := ret[0].(*merchapi.ListFraudCasesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFraudCases indicates an expected call of ListFraudCases.
+func (mr *MockMerchAdminServiceClientMockRecorder) ListFraudCases(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFraudCases", reflect.TypeOf((*MockMerchAdminServiceClient)(nil).ListFraudCases), varargs...)
+}
+
+// ListWebhookDeadLetters mocks base method.
+func (m *MockMerchAdminServiceClient) ListWebhookDeadLetters(ctx context.Context, in *merchapi.ListWebhookDeadLettersRequest, opts ...grpc.CallOption) (*merchapi.ListWebhookDeadLettersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListWebhookDeadLetters", varargs...)
+	ret0, _ := ret[0].(*merchapi.ListWebhookDeadLettersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhookDeadLetters indicates an expected call of ListWebhookDeadLetters.
+func (mr *MockMerchAdminServiceClientMockRecorder) ListWebhookDeadLetters(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookDeadLetters", reflect.TypeOf((*MockMerchAdminServiceClient)(nil).ListWebhookDeadLetters), varargs...)
+}
+
+// ListWebhookEndpoints mocks base method.
+func (m *MockMerchAdminServiceClient) ListWebhookEndpoints(ctx context.Context, in *merchapi.ListWebhookEndpointsRequest, opts ...grpc.CallOption) (*merchapi.ListWebhookEndpointsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListWebhookEndpoints", varargs...)
+	ret0, _ := ret[0].(*merchapi.ListWebhookEndpointsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhookEndpoints indicates an expected call of ListWebhookEndpoints.
+func (mr *MockMerchAdminServiceClientMockRecorder) ListWebhookEndpoints(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookEndpoints", reflect.TypeOf((*MockMerchAdminServiceClient)(nil).ListWebhookEndpoints), varargs...)
+}
+
+// MarkGoodArrived mocks base method.
+func (m *MockMerchAdminServiceClient) MarkGoodArrived(ctx context.Context, in *merchapi.MarkGoodArrivedRequest, opts ...grpc.CallOption) (*merchapi.MarkGoodArrivedResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MarkGoodArrived", varargs...)
+	ret0, _ := ret[0].(*merchapi.MarkGoodArrivedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkGoodArrived indicates an expected call of MarkGoodArrived.
+func (mr *MockMerchAdminServiceClientMockRecorder) MarkGoodArrived(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkGoodArrived", reflect.TypeOf((*MockMerchAdminServiceClient)(nil).MarkGoodArrived), varargs...)
+}
+
+// ResolveFraudCase mocks base method.
+func (m *MockMerchAdminServiceClient) ResolveFraudCase(ctx context.Context, in *merchapi.ResolveFraudCaseRequest, opts ...grpc.CallOption) (*merchapi.ResolveFraudCaseResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResolveFraudCase", varargs...)
+	ret0, _ := ret[0].(*merchapi.ResolveFraudCaseResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveFraudCase indicates an expected call of ResolveFraudCase.
+func (mr *MockMerchAdminServiceClientMockRecorder) ResolveFraudCase(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveFraudCase", reflect.TypeOf((*MockMerchAdminServiceClient)(nil).ResolveFraudCase), varargs...)
+}
+
+// SchedulePriceChange mocks base method.
+func (m *MockMerchAdminServiceClient) SchedulePriceChange(ctx context.Context, in *merchapi.SchedulePriceChangeRequest, opts ...grpc.CallOption) (*merchapi.SchedulePriceChangeResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SchedulePriceChange", varargs...)
+	ret0, _ := ret[0].(*merchapi.SchedulePriceChangeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SchedulePriceChange indicates an expected call of SchedulePriceChange.
+func (mr *MockMerchAdminServiceClientMockRecorder) SchedulePriceChange(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SchedulePriceChange", reflect.TypeOf((*MockMerchAdminServiceClient)(nil).SchedulePriceChange), varargs...)
+}
+
+// SetPurchaseLimit mocks base method.
+func (m *MockMerchAdminServiceClient) SetPurchaseLimit(ctx context.Context, in *merchapi.SetPurchaseLimitRequest, opts ...grpc.CallOption) (*merchapi.SetPurchaseLimitResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetPurchaseLimit", varargs...)
+	ret0, _ := ret[0].(*merchapi.SetPurchaseLimitResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetPurchaseLimit indicates an expected call of SetPurchaseLimit.
+func (mr *MockMerchAdminServiceClientMockRecorder) SetPurchaseLimit(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPurchaseLimit", reflect.TypeOf((*MockMerchAdminServiceClient)(nil).SetPurchaseLimit), varargs...)
+}
+
+// SetTeamBudgetTopUp mocks base method.
+func (m *MockMerchAdminServiceClient) SetTeamBudgetTopUp(ctx context.Context, in *merchapi.SetTeamBudgetTopUpRequest, opts ...grpc.CallOption) (*merchapi.SetTeamBudgetTopUpResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetTeamBudgetTopUp", varargs...)
+	ret0, _ := ret[0].(*merchapi.SetTeamBudgetTopUpResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTeamBudgetTopUp indicates an expected call of SetTeamBudgetTopUp.
+func (mr *MockMerchAdminServiceClientMockRecorder) SetTeamBudgetTopUp(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTeamBudgetTopUp", reflect.TypeOf((*MockMerchAdminServiceClient)(nil).SetTeamBudgetTopUp), varargs...)
+}
+
+// SetTransferLimits mocks base method.
+func (m *MockMerchAdminServiceClient) SetTransferLimits(ctx context.Context, in *merchapi.SetTransferLimitsRequest, opts ...grpc.CallOption) (*merchapi.SetTransferLimitsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetTransferLimits", varargs...)
+	ret0, _ := ret[0].(*merchapi.SetTransferLimitsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTransferLimits indicates an expected call of SetTransferLimits.
+func (mr *MockMerchAdminServiceClientMockRecorder) SetTransferLimits(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTransferLimits", reflect.TypeOf((*MockMerchAdminServiceClient)(nil).SetTransferLimits), varargs...)
+}
+
+// UnfreezeAccount mocks base method.
+func (m *MockMerchAdminServiceClient) UnfreezeAccount(ctx context.Context, in *merchapi.UnfreezeAccountRequest, opts ...grpc.CallOption) (*merchapi.UnfreezeAccountResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnfreezeAccount", varargs...)
+	ret0, _ := ret[0].(*merchapi.UnfreezeAccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnfreezeAccount indicates an expected call of UnfreezeAccount.
+func (mr *MockMerchAdminServiceClientMockRecorder) UnfreezeAccount(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnfreezeAccount", reflect.TypeOf((*MockMerchAdminServiceClient)(nil).UnfreezeAccount), varargs...)
+}
+
+// UpdateGoodDetails mocks base method.
+func (m *MockMerchAdminServiceClient) UpdateGoodDetails(ctx context.Context, in *merchapi.UpdateGoodDetailsRequest, opts ...grpc.CallOption) (*merchapi.UpdateGoodDetailsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateGoodDetails", varargs...)
+	ret0, _ := ret[0].(*merchapi.UpdateGoodDetailsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGoodDetails indicates an expected call of UpdateGoodDetails.
+func (mr *MockMerchAdminServiceClientMockRecorder) UpdateGoodDetails(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGoodDetails", reflect.TypeOf((*MockMerchAdminServiceClient)(nil).UpdateGoodDetails), varargs...)
+}
+
+// MockMerchAdminServiceServer is a mock of MerchAdminServiceServer interface.
+type MockMerchAdminServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockMerchAdminServiceServerMockRecorder
+}
+
+// MockMerchAdminServiceServerMockRecorder is the mock recorder for MockMerchAdminServiceServer.
+type MockMerchAdminServiceServerMockRecorder struct {
+	mock *MockMerchAdminServiceServer
+}
+
+// NewMockMerchAdminServiceServer creates a new mock instance.
+func NewMockMerchAdminServiceServer(ctrl *gomock.Controller) *MockMerchAdminServiceServer {
+	mock := &MockMerchAdminServiceServer{ctrl: ctrl}
+	mock.recorder = &MockMerchAdminServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMerchAdminServiceServer) EXPECT() *MockMerchAdminServiceServerMockRecorder {
+	return m.recorder
+}
+
+// AddTeamMember mocks base method.
+func (m *MockMerchAdminServiceServer) AddTeamMember(arg0 context.Context, arg1 *merchapi.AddTeamMemberRequest) (*merchapi.AddTeamMemberResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTeamMember", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.AddTeamMemberResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTeamMember indicates an expected call of AddTeamMember.
+func (mr *MockMerchAdminServiceServerMockRecorder) AddTeamMember(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTeamMember", reflect.TypeOf((*MockMerchAdminServiceServer)(nil).AddTeamMember), arg0, arg1)
+}
+
+// AnnounceGood mocks base method.
+func (m *MockMerchAdminServiceServer) AnnounceGood(arg0 context.Context, arg1 *merchapi.AnnounceGoodRequest) (*merchapi.AnnounceGoodResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AnnounceGood", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.AnnounceGoodResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AnnounceGood indicates an expected call of AnnounceGood.
+func (mr *MockMerchAdminServiceServerMockRecorder) AnnounceGood(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnnounceGood", reflect.TypeOf((*MockMerchAdminServiceServer)(nil).AnnounceGood), arg0, arg1)
+}
+
+// CommitEventOffset mocks base method.
+func (m *MockMerchAdminServiceServer) CommitEventOffset(arg0 context.Context, arg1 *merchapi.CommitEventOffsetRequest) (*merchapi.CommitEventOffsetResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitEventOffset", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.CommitEventOffsetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CommitEventOffset indicates an expected call of CommitEventOffset.
+func (mr *MockMerchAdminServiceServerMockRecorder) CommitEventOffset(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitEventOffset", reflect.TypeOf((*MockMerchAdminServiceServer)(nil).CommitEventOffset), arg0, arg1)
+}
+
+// CreateAuction mocks base method.
+func (m *MockMerchAdminServiceServer) CreateAuction(arg0 context.Context, arg1 *merchapi.CreateAuctionRequest) (*merchapi.CreateAuctionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAuction", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.CreateAuctionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAuction indicates an expected call of CreateAuction.
+func (mr *MockMerchAdminServiceServerMockRecorder) CreateAuction(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuction", reflect.TypeOf((*MockMerchAdminServiceServer)(nil).CreateAuction), arg0, arg1)
+}
+
+// CreateCategory mocks base method.
+func (m *MockMerchAdminServiceServer) CreateCategory(arg0 context.Context, arg1 *merchapi.CreateCategoryRequest) (*merchapi.CreateCategoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCategory", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.CreateCategoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCategory indicates an expected call of CreateCategory.
+func (mr *MockMerchAdminServiceServerMockRecorder) CreateCategory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*MockMerchAdminServiceServer)(nil).CreateCategory), arg0, arg1)
+}
+
+// CreatePromoCode mocks base method.
+func (m *MockMerchAdminServiceServer) CreatePromoCode(arg0 context.Context, arg1 *merchapi.CreatePromoCodeRequest) (*merchapi.CreatePromoCodeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePromoCode", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.CreatePromoCodeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePromoCode indicates an expected call of CreatePromoCode.
+func (mr *MockMerchAdminServiceServerMockRecorder) CreatePromoCode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePromoCode", reflect.TypeOf((*MockMerchAdminServiceServer)(nil).CreatePromoCode), arg0, arg1)
+}
+
+// CreateRaffle mocks base method.
+func (m *MockMerchAdminServiceServer) CreateRaffle(arg0 context.Context, arg1 *merchapi.CreateRaffleRequest) (*merchapi.CreateRaffleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRaffle", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.CreateRaffleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRaffle indicates an expected call of CreateRaffle.
+func (mr *MockMerchAdminServiceServerMockRecorder) CreateRaffle(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRaffle", reflect.TypeOf((*MockMerchAdminServiceServer)(nil).CreateRaffle), arg0, arg1)
+}
+
+// CreateTeam mocks base method.
+func (m *MockMerchAdminServiceServer) CreateTeam(arg0 context.Context, arg1 *merchapi.CreateTeamRequest) (*merchapi.CreateTeamResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTeam", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.CreateTeamResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTeam indicates an expected call of CreateTeam.
+func (mr *MockMerchAdminServiceServerMockRecorder) CreateTeam(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTeam", reflect.TypeOf((*MockMerchAdminServiceServer)(nil).CreateTeam), arg0, arg1)
+}
+
+// CreateWebhookEndpoint mocks base method.
+func (m *MockMerchAdminServiceServer) CreateWebhookEndpoint(arg0 context.Context, arg1 *merchapi.CreateWebhookEndpointRequest) (*merchapi.CreateWebhookEndpointResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhookEndpoint", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.CreateWebhookEndpointResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhookEndpoint indicates an expected call of CreateWebhookEndpoint.
+func (mr *MockMerchAdminServiceServerMockRecorder) CreateWebhookEndpoint(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhookEndpoint", reflect.TypeOf((*MockMerchAdminServiceServer)(nil).CreateWebhookEndpoint), arg0, arg1)
+}
+
+// DeactivateAccount mocks base method.
+func (m *MockMerchAdminServiceServer) DeactivateAccount(arg0 context.Context, arg1 *merchapi.DeactivateAccountRequest) (*merchapi.DeactivateAccountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeactivateAccount", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.DeactivateAccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeactivateAccount indicates an expected call of DeactivateAccount.
+func (mr *MockMerchAdminServiceServerMockRecorder) DeactivateAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivateAccount", reflect.TypeOf((*MockMerchAdminServiceServer)(nil).DeactivateAccount), arg0, arg1)
+}
+
+// DeleteWebhookEndpoint mocks base method.
+func (m *MockMerchAdminServiceServer) DeleteWebhookEndpoint(arg0 context.Context, arg1 *merchapi.DeleteWebhookEndpointRequest) (*merchapi.DeleteWebhookEndpointResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhookEndpoint", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.DeleteWebhookEndpointResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteWebhookEndpoint indicates an expected call of DeleteWebhookEndpoint.
+func (mr *MockMerchAdminServiceServerMockRecorder) DeleteWebhookEndpoint(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhookEndpoint", reflect.TypeOf((*MockMerchAdminServiceServer)(nil).DeleteWebhookEndpoint), arg0, arg1)
+}
+
+// FetchEvents mocks base method.
+func (m *MockMerchAdminServiceServer) FetchEvents(arg0 context.Context, arg1 *merchapi.FetchEventsRequest) (*merchapi.FetchEventsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchEvents", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.FetchEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchEvents indicates an expected call of FetchEvents.
+func (mr *MockMerchAdminServiceServerMockRecorder) FetchEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchEvents", reflect.TypeOf((*MockMerchAdminServiceServer)(nil).FetchEvents), arg0, arg1)
+}
+
+// FreezeAccount mocks base method.
+func (m *MockMerchAdminServiceServer) FreezeAccount(arg0 context.Context, arg1 *merchapi.FreezeAccountRequest) (*merchapi.FreezeAccountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FreezeAccount", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.FreezeAccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FreezeAccount indicates an expected call of FreezeAccount.
+func (mr *MockMerchAdminServiceServerMockRecorder) FreezeAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FreezeAccount", reflect.TypeOf((*MockMerchAdminServiceServer)(nil).FreezeAccount), arg0, arg1)
+}
+
+// FreezeFraudCase mocks base method.
+func (m *MockMerchAdminServiceServer) FreezeFraudCase(arg0 context.Context, arg1 *merchapi.FreezeFraudCaseRequest) (*merchapi.FreezeFraudCaseResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FreezeFraudCase", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.FreezeFraudCaseResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FreezeFraudCase indicates an expected call of FreezeFraudCase.
+func (mr *MockMerchAdminServiceServerMockRecorder) FreezeFraudCase(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FreezeFraudCase", reflect.TypeOf((*MockMerchAdminServiceServer)(nil).FreezeFraudCase), arg0, arg1)
+}
+
+// ListFraudCases mocks base method.
+func (m *MockMerchAdminServiceServer) ListFraudCases(arg0 context.Context, arg1 *merchapi.ListFraudCasesRequest) (*merchapi.ListFraudCasesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFraudCases", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.ListFraudCasesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFraudCases indicates an expected call of ListFraudCases.
+func (mr *MockMerchAdminServiceServerMockRecorder) ListFraudCases(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFraudCases", reflect.TypeOf((*MockMerchAdminServiceServer)(nil).ListFraudCases), arg0, arg1)
+}
+
+// ListWebhookDeadLetters mocks base method.
+func (m *MockMerchAdminServiceServer) ListWebhookDeadLetters(arg0 context.Context, arg1 *merchapi.ListWebhookDeadLettersRequest) (*merchapi.ListWebhookDeadLettersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhookDeadLetters", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.ListWebhookDeadLettersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhookDeadLetters indicates an expected call of ListWebhookDeadLetters.
+func (mr *MockMerchAdminServiceServerMockRecorder) ListWebhookDeadLetters(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookDeadLetters", reflect.TypeOf((*MockMerchAdminServiceServer)(nil).ListWebhookDeadLetters), arg0, arg1)
+}
+
+// ListWebhookEndpoints mocks base method.
+func (m *MockMerchAdminServiceServer) ListWebhookEndpoints(arg0 context.Context, arg1 *merchapi.ListWebhookEndpointsRequest) (*merchapi.ListWebhookEndpointsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhookEndpoints", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.ListWebhookEndpointsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhookEndpoints indicates an expected call of ListWebhookEndpoints.
+func (mr *MockMerchAdminServiceServerMockRecorder) ListWebhookEndpoints(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookEndpoints", reflect.TypeOf((*MockMerchAdminServiceServer)(nil).ListWebhookEndpoints), arg0, arg1)
+}
+
+// MarkGoodArrived mocks base method.
+func (m *MockMerchAdminServiceServer) MarkGoodArrived(arg0 context.Context, arg1 *merchapi.MarkGoodArrivedRequest) (*merchapi.MarkGoodArrivedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkGoodArrived", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.MarkGoodArrivedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkGoodArrived indicates an expected call of MarkGoodArrived.
+func (mr *MockMerchAdminServiceServerMockRecorder) MarkGoodArrived(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkGoodArrived", reflect.TypeOf((*MockMerchAdminServiceServer)(nil).MarkGoodArrived), arg0, arg1)
+}
+
+// ResolveFraudCase mocks base method.
+func (m *MockMerchAdminServiceServer) ResolveFraudCase(arg0 context.Context, arg1 *merchapi.ResolveFraudCaseRequest) (*merchapi.ResolveFraudCaseResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveFraudCase", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.ResolveFraudCaseResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveFraudCase indicates an expected call of ResolveFraudCase.
+func (mr *MockMerchAdminServiceServerMockRecorder) ResolveFraudCase(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveFraudCase", reflect.TypeOf((*MockMerchAdminServiceServer)(nil).ResolveFraudCase), arg0, arg1)
+}
+
+// SchedulePriceChange mocks base method.
+func (m *MockMerchAdminServiceServer) SchedulePriceChange(arg0 context.Context, arg1 *merchapi.SchedulePriceChangeRequest) (*merchapi.SchedulePriceChangeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SchedulePriceChange", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.SchedulePriceChangeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SchedulePriceChange indicates an expected call of SchedulePriceChange.
+func (mr *MockMerchAdminServiceServerMockRecorder) SchedulePriceChange(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SchedulePriceChange", reflect.TypeOf((*MockMerchAdminServiceServer)(nil).SchedulePriceChange), arg0, arg1)
+}
+
+// SetPurchaseLimit mocks base method.
+func (m *MockMerchAdminServiceServer) SetPurchaseLimit(arg0 context.Context, arg1 *merchapi.SetPurchaseLimitRequest) (*merchapi.SetPurchaseLimitResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPurchaseLimit", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.SetPurchaseLimitResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetPurchaseLimit indicates an expected call of SetPurchaseLimit.
+func (mr *MockMerchAdminServiceServerMockRecorder) SetPurchaseLimit(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPurchaseLimit", reflect.TypeOf((*MockMerchAdminServiceServer)(nil).SetPurchaseLimit), arg0, arg1)
+}
+
+// SetTeamBudgetTopUp mocks base method.
+func (m *MockMerchAdminServiceServer) SetTeamBudgetTopUp(arg0 context.Context, arg1 *merchapi.SetTeamBudgetTopUpRequest) (*merchapi.SetTeamBudgetTopUpResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTeamBudgetTopUp", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.SetTeamBudgetTopUpResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTeamBudgetTopUp indicates an expected call of SetTeamBudgetTopUp.
+func (mr *MockMerchAdminServiceServerMockRecorder) SetTeamBudgetTopUp(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTeamBudgetTopUp", reflect.TypeOf((*MockMerchAdminServiceServer)(nil).SetTeamBudgetTopUp), arg0, arg1)
+}
+
+// SetTransferLimits mocks base method.
+func (m *MockMerchAdminServiceServer) SetTransferLimits(arg0 context.Context, arg1 *merchapi.SetTransferLimitsRequest) (*merchapi.SetTransferLimitsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTransferLimits", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.SetTransferLimitsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTransferLimits indicates an expected call of SetTransferLimits.
+func (mr *MockMerchAdminServiceServerMockRecorder) SetTransferLimits(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTransferLimits", reflect.TypeOf((*MockMerchAdminServiceServer)(nil).SetTransferLimits), arg0, arg1)
+}
+
+// UnfreezeAccount mocks base method.
+func (m *MockMerchAdminServiceServer) UnfreezeAccount(arg0 context.Context, arg1 *merchapi.UnfreezeAccountRequest) (*merchapi.UnfreezeAccountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnfreezeAccount", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.UnfreezeAccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnfreezeAccount indicates an expected call of UnfreezeAccount.
+func (mr *MockMerchAdminServiceServerMockRecorder) UnfreezeAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnfreezeAccount", reflect.TypeOf((*MockMerchAdminServiceServer)(nil).UnfreezeAccount), arg0, arg1)
+}
+
+// UpdateGoodDetails mocks base method.
+func (m *MockMerchAdminServiceServer) UpdateGoodDetails(arg0 context.Context, arg1 *merchapi.UpdateGoodDetailsRequest) (*merchapi.UpdateGoodDetailsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGoodDetails", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.UpdateGoodDetailsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGoodDetails indicates an expected call of UpdateGoodDetails.
+func (mr *MockMerchAdminServiceServerMockRecorder) UpdateGoodDetails(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGoodDetails", reflect.TypeOf((*MockMerchAdminServiceServer)(nil).UpdateGoodDetails), arg0, arg1)
+}
+
+// mustEmbedUnimplementedMerchAdminServiceServer mocks base method.
+func (m *MockMerchAdminServiceServer) mustEmbedUnimplementedMerchAdminServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedMerchAdminServiceServer")
+}
+
+// mustEmbedUnimplementedMerchAdminServiceServer indicates an expected call of mustEmbedUnimplementedMerchAdminServiceServer.
+func (mr *MockMerchAdminServiceServerMockRecorder) mustEmbedUnimplementedMerchAdminServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedMerchAdminServiceServer", reflect.TypeOf((*MockMerchAdminServiceServer)(nil).mustEmbedUnimplementedMerchAdminServiceServer))
+}
+
+// MockUnsafeMerchAdminServiceServer is a mock of UnsafeMerchAdminServiceServer interface.
+type MockUnsafeMerchAdminServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnsafeMerchAdminServiceServerMockRecorder
+}
+
+// MockUnsafeMerchAdminServiceServerMockRecorder is the mock recorder for MockUnsafeMerchAdminServiceServer.
+type MockUnsafeMerchAdminServiceServerMockRecorder struct {
+	mock *MockUnsafeMerchAdminServiceServer
+}
+
+// NewMockUnsafeMerchAdminServiceServer creates a new mock instance.
+func NewMockUnsafeMerchAdminServiceServer(ctrl *gomock.Controller) *MockUnsafeMerchAdminServiceServer {
+	mock := &MockUnsafeMerchAdminServiceServer{ctrl: ctrl}
+	mock.recorder = &MockUnsafeMerchAdminServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnsafeMerchAdminServiceServer) EXPECT() *MockUnsafeMerchAdminServiceServerMockRecorder {
+	return m.recorder
+}
+
+// mustEmbedUnimplementedMerchAdminServiceServer mocks base method.
+func (m *MockUnsafeMerchAdminServiceServer) mustEmbedUnimplementedMerchAdminServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedMerchAdminServiceServer")
+}
+
+// mustEmbedUnimplementedMerchAdminServiceServer indicates an expected call of mustEmbedUnimplementedMerchAdminServiceServer.
+func (mr *MockUnsafeMerchAdminServiceServerMockRecorder) mustEmbedUnimplementedMerchAdminServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedMerchAdminServiceServer", reflect.TypeOf((*MockUnsafeMerchAdminServiceServer)(nil).mustEmbedUnimplementedMerchAdminServiceServer))
+}
@@ -0,0 +1,142 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./gen/merch/v1/audit_grpc.pb.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	merchapi "github.com/Lexv0lk/merch-store/gen/merch/v1"
+	gomock "github.com/golang/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockMerchAuditServiceClient is a mock of MerchAuditServiceClient interface.
+type MockMerchAuditServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockMerchAuditServiceClientMockRecorder
+}
+
+// MockMerchAuditServiceClientMockRecorder is the mock recorder for MockMerchAuditServiceClient.
+type MockMerchAuditServiceClientMockRecorder struct {
+	mock *MockMerchAuditServiceClient
+}
+
+// NewMockMerchAuditServiceClient creates a new mock instance.
+func NewMockMerchAuditServiceClient(ctrl *gomock.Controller) *MockMerchAuditServiceClient {
+	mock := &MockMerchAuditServiceClient{ctrl: ctrl}
+	mock.recorder = &MockMerchAuditServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMerchAuditServiceClient) EXPECT() *MockMerchAuditServiceClientMockRecorder {
+	return m.recorder
+}
+
+// ListAuditLog mocks base method.
+func (m *MockMerchAuditServiceClient) ListAuditLog(ctx context.Context, in *merchapi.ListAuditLogRequest, opts ...grpc.CallOption) (*merchapi.ListAuditLogResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAuditLog", varargs...)
+	ret0, _ := ret[0].(*merchapi.ListAuditLogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditLog indicates an expected call of ListAuditLog.
+func (mr *MockMerchAuditServiceClientMockRecorder) ListAuditLog(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditLog", reflect.TypeOf((*MockMerchAuditServiceClient)(nil).ListAuditLog), varargs...)
+}
+
+// MockMerchAuditServiceServer is a mock of MerchAuditServiceServer interface.
+type MockMerchAuditServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockMerchAuditServiceServerMockRecorder
+}
+
+// MockMerchAuditServiceServerMockRecorder is the mock recorder for MockMerchAuditServiceServer.
+type MockMerchAuditServiceServerMockRecorder struct {
+	mock *MockMerchAuditServiceServer
+}
+
+// NewMockMerchAuditServiceServer creates a new mock instance.
+func NewMockMerchAuditServiceServer(ctrl *gomock.Controller) *MockMerchAuditServiceServer {
+	mock := &MockMerchAuditServiceServer{ctrl: ctrl}
+	mock.recorder = &MockMerchAuditServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMerchAuditServiceServer) EXPECT() *MockMerchAuditServiceServerMockRecorder {
+	return m.recorder
+}
+
+// ListAuditLog mocks base method.
+func (m *MockMerchAuditServiceServer) ListAuditLog(arg0 context.Context, arg1 *merchapi.ListAuditLogRequest) (*merchapi.ListAuditLogResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditLog", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.ListAuditLogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditLog indicates an expected call of ListAuditLog.
+func (mr *MockMerchAuditServiceServerMockRecorder) ListAuditLog(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditLog", reflect.TypeOf((*MockMerchAuditServiceServer)(nil).ListAuditLog), arg0, arg1)
+}
+
+// mustEmbedUnimplementedMerchAuditServiceServer mocks base method.
+func (m *MockMerchAuditServiceServer) mustEmbedUnimplementedMerchAuditServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedMerchAuditServiceServer")
+}
+
+// mustEmbedUnimplementedMerchAuditServiceServer indicates an expected call of mustEmbedUnimplementedMerchAuditServiceServer.
+func (mr *MockMerchAuditServiceServerMockRecorder) mustEmbedUnimplementedMerchAuditServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedMerchAuditServiceServer", reflect.TypeOf((*MockMerchAuditServiceServer)(nil).mustEmbedUnimplementedMerchAuditServiceServer))
+}
+
+// MockUnsafeMerchAuditServiceServer is a mock of UnsafeMerchAuditServiceServer interface.
+type MockUnsafeMerchAuditServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnsafeMerchAuditServiceServerMockRecorder
+}
+
+// MockUnsafeMerchAuditServiceServerMockRecorder is the mock recorder for MockUnsafeMerchAuditServiceServer.
+type MockUnsafeMerchAuditServiceServerMockRecorder struct {
+	mock *MockUnsafeMerchAuditServiceServer
+}
+
+// NewMockUnsafeMerchAuditServiceServer creates a new mock instance.
+func NewMockUnsafeMerchAuditServiceServer(ctrl *gomock.Controller) *MockUnsafeMerchAuditServiceServer {
+	mock := &MockUnsafeMerchAuditServiceServer{ctrl: ctrl}
+	mock.recorder = &MockUnsafeMerchAuditServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnsafeMerchAuditServiceServer) EXPECT() *MockUnsafeMerchAuditServiceServerMockRecorder {
+	return m.recorder
+}
+
+// mustEmbedUnimplementedMerchAuditServiceServer mocks base method.
+func (m *MockUnsafeMerchAuditServiceServer) mustEmbedUnimplementedMerchAuditServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedMerchAuditServiceServer")
+}
+
+// mustEmbedUnimplementedMerchAuditServiceServer indicates an expected call of mustEmbedUnimplementedMerchAuditServiceServer.
+func (mr *MockUnsafeMerchAuditServiceServerMockRecorder) mustEmbedUnimplementedMerchAuditServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedMerchAuditServiceServer", reflect.TypeOf((*MockUnsafeMerchAuditServiceServer)(nil).mustEmbedUnimplementedMerchAuditServiceServer))
+}
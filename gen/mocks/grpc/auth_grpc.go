@@ -56,6 +56,26 @@ func (mr *MockAuthServiceClientMockRecorder) Authenticate(ctx, in interface{}, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockAuthServiceClient)(nil).Authenticate), varargs...)
 }
 
+// DeactivateUser mocks base method.
+func (m *MockAuthServiceClient) DeactivateUser(ctx context.Context, in *merchapi.DeactivateUserRequest, opts ...grpc.CallOption) (*merchapi.DeactivateUserResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeactivateUser", varargs...)
+	ret0, _ := ret[0].(*merchapi.DeactivateUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeactivateUser indicates an expected call of DeactivateUser.
+func (mr *MockAuthServiceClientMockRecorder) DeactivateUser(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivateUser", reflect.TypeOf((*MockAuthServiceClient)(nil).DeactivateUser), varargs...)
+}
+
 // GetUserID mocks base method.
 func (m *MockAuthServiceClient) GetUserID(ctx context.Context, in *merchapi.GetUserIDRequest, opts ...grpc.CallOption) (*merchapi.GetUserIDResponse, error) {
 	m.ctrl.T.Helper()
@@ -76,6 +96,26 @@ func (mr *MockAuthServiceClientMockRecorder) GetUserID(ctx, in interface{}, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserID", reflect.TypeOf((*MockAuthServiceClient)(nil).GetUserID), varargs...)
 }
 
+// GetUserIDs mocks base method.
+func (m *MockAuthServiceClient) GetUserIDs(ctx context.Context, in *merchapi.GetUserIDsRequest, opts ...grpc.CallOption) (*merchapi.GetUserIDsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUserIDs", varargs...)
+	ret0, _ := ret[0].(*merchapi.GetUserIDsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserIDs indicates an expected call of GetUserIDs.
+func (mr *MockAuthServiceClientMockRecorder) GetUserIDs(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIDs", reflect.TypeOf((*MockAuthServiceClient)(nil).GetUserIDs), varargs...)
+}
+
 // GetUsernames mocks base method.
 func (m *MockAuthServiceClient) GetUsernames(ctx context.Context, in *merchapi.GetUsernamesRequest, opts ...grpc.CallOption) (*merchapi.GetUsernamesResponse, error) {
 	m.ctrl.T.Helper()
@@ -96,6 +136,26 @@ func (mr *MockAuthServiceClientMockRecorder) GetUsernames(ctx, in interface{}, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsernames", reflect.TypeOf((*MockAuthServiceClient)(nil).GetUsernames), varargs...)
 }
 
+// ListAuditEvents mocks base method.
+func (m *MockAuthServiceClient) ListAuditEvents(ctx context.Context, in *merchapi.ListAuditEventsRequest, opts ...grpc.CallOption) (*merchapi.ListAuditEventsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAuditEvents", varargs...)
+	ret0, _ := ret[0].(*merchapi.ListAuditEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditEvents indicates an expected call of ListAuditEvents.
+func (mr *MockAuthServiceClientMockRecorder) ListAuditEvents(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditEvents", reflect.TypeOf((*MockAuthServiceClient)(nil).ListAuditEvents), varargs...)
+}
+
 // MockAuthServiceServer is a mock of AuthServiceServer interface.
 type MockAuthServiceServer struct {
 	ctrl     *gomock.Controller
@@ -134,6 +194,21 @@ func (mr *MockAuthServiceServerMockRecorder) Authenticate(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockAuthServiceServer)(nil).Authenticate), arg0, arg1)
 }
 
+// DeactivateUser mocks base method.
+func (m *MockAuthServiceServer) DeactivateUser(arg0 context.Context, arg1 *merchapi.DeactivateUserRequest) (*merchapi.DeactivateUserResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeactivateUser", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.DeactivateUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeactivateUser indicates an expected call of DeactivateUser.
+func (mr *MockAuthServiceServerMockRecorder) DeactivateUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivateUser", reflect.TypeOf((*MockAuthServiceServer)(nil).DeactivateUser), arg0, arg1)
+}
+
 // GetUserID mocks base method.
 func (m *MockAuthServiceServer) GetUserID(arg0 context.Context, arg1 *merchapi.GetUserIDRequest) (*merchapi.GetUserIDResponse, error) {
 	m.ctrl.T.Helper()
@@ -149,6 +224,21 @@ func (mr *MockAuthServiceServerMockRecorder) GetUserID(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserID", reflect.TypeOf((*MockAuthServiceServer)(nil).GetUserID), arg0, arg1)
 }
 
+// GetUserIDs mocks base method.
+func (m *MockAuthServiceServer) GetUserIDs(arg0 context.Context, arg1 *merchapi.GetUserIDsRequest) (*merchapi.GetUserIDsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserIDs", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.GetUserIDsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserIDs indicates an expected call of GetUserIDs.
+func (mr *MockAuthServiceServerMockRecorder) GetUserIDs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIDs", reflect.TypeOf((*MockAuthServiceServer)(nil).GetUserIDs), arg0, arg1)
+}
+
 // GetUsernames mocks base method.
 func (m *MockAuthServiceServer) GetUsernames(arg0 context.Context, arg1 *merchapi.GetUsernamesRequest) (*merchapi.GetUsernamesResponse, error) {
 	m.ctrl.T.Helper()
@@ -164,6 +254,21 @@ func (mr *MockAuthServiceServerMockRecorder) GetUsernames(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsernames", reflect.TypeOf((*MockAuthServiceServer)(nil).GetUsernames), arg0, arg1)
 }
 
+// ListAuditEvents mocks base method.
+func (m *MockAuthServiceServer) ListAuditEvents(arg0 context.Context, arg1 *merchapi.ListAuditEventsRequest) (*merchapi.ListAuditEventsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditEvents", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.ListAuditEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditEvents indicates an expected call of ListAuditEvents.
+func (mr *MockAuthServiceServerMockRecorder) ListAuditEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditEvents", reflect.TypeOf((*MockAuthServiceServer)(nil).ListAuditEvents), arg0, arg1)
+}
+
 // mustEmbedUnimplementedAuthServiceServer mocks base method.
 func (m *MockAuthServiceServer) mustEmbedUnimplementedAuthServiceServer() {
 	m.ctrl.T.Helper()
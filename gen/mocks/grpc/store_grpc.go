@@ -36,6 +36,46 @@ func (m *MockMerchStoreServiceClient) EXPECT() *MockMerchStoreServiceClientMockR
 	return m.recorder
 }
 
+// AcceptPaymentRequest mocks base method.
+func (m *MockMerchStoreServiceClient) AcceptPaymentRequest(ctx context.Context, in *merchapi.AcceptPaymentRequestRequest, opts ...grpc.CallOption) (*merchapi.AcceptPaymentRequestResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AcceptPaymentRequest", varargs...)
+	ret0, _ := ret[0].(*merchapi.AcceptPaymentRequestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptPaymentRequest indicates an expected call of AcceptPaymentRequest.
+func (mr *MockMerchStoreServiceClientMockRecorder) AcceptPaymentRequest(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptPaymentRequest", reflect.TypeOf((*MockMerchStoreServiceClient)(nil).AcceptPaymentRequest), varargs...)
+}
+
+// AddToWishlist mocks base method.
+func (m *MockMerchStoreServiceClient) AddToWishlist(ctx context.Context, in *merchapi.AddToWishlistRequest, opts ...grpc.CallOption) (*merchapi.AddToWishlistResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddToWishlist", varargs...)
+	ret0, _ := ret[0].(*merchapi.AddToWishlistResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddToWishlist indicates an expected call of AddToWishlist.
+func (mr *MockMerchStoreServiceClientMockRecorder) AddToWishlist(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddToWishlist", reflect.TypeOf((*MockMerchStoreServiceClient)(nil).AddToWishlist), varargs...)
+}
+
 // BuyItem mocks base method.
 func (m *MockMerchStoreServiceClient) BuyItem(ctx context.Context, in *merchapi.BuyItemRequest, opts ...grpc.CallOption) (*merchapi.BuyItemResponse, error) {
 	m.ctrl.T.Helper()
@@ -56,6 +96,186 @@ func (mr *MockMerchStoreServiceClientMockRecorder) BuyItem(ctx, in interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuyItem", reflect.TypeOf((*MockMerchStoreServiceClient)(nil).BuyItem), varargs...)
 }
 
+// BuyListing mocks base method.
+func (m *MockMerchStoreServiceClient) BuyListing(ctx context.Context, in *merchapi.BuyListingRequest, opts ...grpc.CallOption) (*merchapi.BuyListingResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BuyListing", varargs...)
+	ret0, _ := ret[0].(*merchapi.BuyListingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuyListing indicates an expected call of BuyListing.
+func (mr *MockMerchStoreServiceClientMockRecorder) BuyListing(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuyListing", reflect.TypeOf((*MockMerchStoreServiceClient)(nil).BuyListing), varargs...)
+}
+
+// BuyRaffleTickets mocks base method.
+func (m *MockMerchStoreServiceClient) BuyRaffleTickets(ctx context.Context, in *merchapi.BuyRaffleTicketsRequest, opts ...grpc.CallOption) (*merchapi.BuyRaffleTicketsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BuyRaffleTickets", varargs...)
+	ret0, _ := ret[0].(*merchapi.BuyRaffleTicketsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuyRaffleTickets indicates an expected call of BuyRaffleTickets.
+func (mr *MockMerchStoreServiceClientMockRecorder) BuyRaffleTickets(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuyRaffleTickets", reflect.TypeOf((*MockMerchStoreServiceClient)(nil).BuyRaffleTickets), varargs...)
+}
+
+// CancelListing mocks base method.
+func (m *MockMerchStoreServiceClient) CancelListing(ctx context.Context, in *merchapi.CancelListingRequest, opts ...grpc.CallOption) (*merchapi.CancelListingResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CancelListing", varargs...)
+	ret0, _ := ret[0].(*merchapi.CancelListingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelListing indicates an expected call of CancelListing.
+func (mr *MockMerchStoreServiceClientMockRecorder) CancelListing(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelListing", reflect.TypeOf((*MockMerchStoreServiceClient)(nil).CancelListing), varargs...)
+}
+
+// CancelPreorder mocks base method.
+func (m *MockMerchStoreServiceClient) CancelPreorder(ctx context.Context, in *merchapi.CancelPreorderRequest, opts ...grpc.CallOption) (*merchapi.CancelPreorderResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CancelPreorder", varargs...)
+	ret0, _ := ret[0].(*merchapi.CancelPreorderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelPreorder indicates an expected call of CancelPreorder.
+func (mr *MockMerchStoreServiceClientMockRecorder) CancelPreorder(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelPreorder", reflect.TypeOf((*MockMerchStoreServiceClient)(nil).CancelPreorder), varargs...)
+}
+
+// CancelScheduledTransfer mocks base method.
+func (m *MockMerchStoreServiceClient) CancelScheduledTransfer(ctx context.Context, in *merchapi.CancelScheduledTransferRequest, opts ...grpc.CallOption) (*merchapi.CancelScheduledTransferResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CancelScheduledTransfer", varargs...)
+	ret0, _ := ret[0].(*merchapi.CancelScheduledTransferResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelScheduledTransfer indicates an expected call of CancelScheduledTransfer.
+func (mr *MockMerchStoreServiceClientMockRecorder) CancelScheduledTransfer(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelScheduledTransfer", reflect.TypeOf((*MockMerchStoreServiceClient)(nil).CancelScheduledTransfer), varargs...)
+}
+
+// CreateListing mocks base method.
+func (m *MockMerchStoreServiceClient) CreateListing(ctx context.Context, in *merchapi.CreateListingRequest, opts ...grpc.CallOption) (*merchapi.CreateListingResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateListing", varargs...)
+	ret0, _ := ret[0].(*merchapi.CreateListingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateListing indicates an expected call of CreateListing.
+func (mr *MockMerchStoreServiceClientMockRecorder) CreateListing(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateListing", reflect.TypeOf((*MockMerchStoreServiceClient)(nil).CreateListing), varargs...)
+}
+
+// CreatePaymentRequest mocks base method.
+func (m *MockMerchStoreServiceClient) CreatePaymentRequest(ctx context.Context, in *merchapi.CreatePaymentRequestRequest, opts ...grpc.CallOption) (*merchapi.CreatePaymentRequestResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreatePaymentRequest", varargs...)
+	ret0, _ := ret[0].(*merchapi.CreatePaymentRequestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePaymentRequest indicates an expected call of CreatePaymentRequest.
+func (mr *MockMerchStoreServiceClientMockRecorder) CreatePaymentRequest(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePaymentRequest", reflect.TypeOf((*MockMerchStoreServiceClient)(nil).CreatePaymentRequest), varargs...)
+}
+
+// DeclinePaymentRequest mocks base method.
+func (m *MockMerchStoreServiceClient) DeclinePaymentRequest(ctx context.Context, in *merchapi.DeclinePaymentRequestRequest, opts ...grpc.CallOption) (*merchapi.DeclinePaymentRequestResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeclinePaymentRequest", varargs...)
+	ret0, _ := ret[0].(*merchapi.DeclinePaymentRequestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeclinePaymentRequest indicates an expected call of DeclinePaymentRequest.
+func (mr *MockMerchStoreServiceClientMockRecorder) DeclinePaymentRequest(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeclinePaymentRequest", reflect.TypeOf((*MockMerchStoreServiceClient)(nil).DeclinePaymentRequest), varargs...)
+}
+
+// GetPriceHistory mocks base method.
+func (m *MockMerchStoreServiceClient) GetPriceHistory(ctx context.Context, in *merchapi.GetPriceHistoryRequest, opts ...grpc.CallOption) (*merchapi.GetPriceHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPriceHistory", varargs...)
+	ret0, _ := ret[0].(*merchapi.GetPriceHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPriceHistory indicates an expected call of GetPriceHistory.
+func (mr *MockMerchStoreServiceClientMockRecorder) GetPriceHistory(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceHistory", reflect.TypeOf((*MockMerchStoreServiceClient)(nil).GetPriceHistory), varargs...)
+}
+
 // GetUserInfo mocks base method.
 func (m *MockMerchStoreServiceClient) GetUserInfo(ctx context.Context, in *merchapi.GetUserInfoRequest, opts ...grpc.CallOption) (*merchapi.GetUserInfoResponse, error) {
 	m.ctrl.T.Helper()
@@ -69,31 +289,431 @@ func (m *MockMerchStoreServiceClient) GetUserInfo(ctx context.Context, in *merch
 	return ret0, ret1
 }
 
-// GetUserInfo indicates an expected call of GetUserInfo.
-func (mr *MockMerchStoreServiceClientMockRecorder) GetUserInfo(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// GetUserInfo indicates an expected call of GetUserInfo.
+func (mr *MockMerchStoreServiceClientMockRecorder) GetUserInfo(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserInfo", reflect.TypeOf((*MockMerchStoreServiceClient)(nil).GetUserInfo), varargs...)
+}
+
+// GiftItem mocks base method.
+func (m *MockMerchStoreServiceClient) GiftItem(ctx context.Context, in *merchapi.GiftItemRequest, opts ...grpc.CallOption) (*merchapi.GiftItemResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GiftItem", varargs...)
+	ret0, _ := ret[0].(*merchapi.GiftItemResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GiftItem indicates an expected call of GiftItem.
+func (mr *MockMerchStoreServiceClientMockRecorder) GiftItem(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GiftItem", reflect.TypeOf((*MockMerchStoreServiceClient)(nil).GiftItem), varargs...)
+}
+
+// ListAuctions mocks base method.
+func (m *MockMerchStoreServiceClient) ListAuctions(ctx context.Context, in *merchapi.ListAuctionsRequest, opts ...grpc.CallOption) (*merchapi.ListAuctionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAuctions", varargs...)
+	ret0, _ := ret[0].(*merchapi.ListAuctionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuctions indicates an expected call of ListAuctions.
+func (mr *MockMerchStoreServiceClientMockRecorder) ListAuctions(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuctions", reflect.TypeOf((*MockMerchStoreServiceClient)(nil).ListAuctions), varargs...)
+}
+
+// ListCategories mocks base method.
+func (m *MockMerchStoreServiceClient) ListCategories(ctx context.Context, in *merchapi.ListCategoriesRequest, opts ...grpc.CallOption) (*merchapi.ListCategoriesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCategories", varargs...)
+	ret0, _ := ret[0].(*merchapi.ListCategoriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCategories indicates an expected call of ListCategories.
+func (mr *MockMerchStoreServiceClientMockRecorder) ListCategories(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategories", reflect.TypeOf((*MockMerchStoreServiceClient)(nil).ListCategories), varargs...)
+}
+
+// ListGoods mocks base method.
+func (m *MockMerchStoreServiceClient) ListGoods(ctx context.Context, in *merchapi.ListGoodsRequest, opts ...grpc.CallOption) (*merchapi.ListGoodsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListGoods", varargs...)
+	ret0, _ := ret[0].(*merchapi.ListGoodsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGoods indicates an expected call of ListGoods.
+func (mr *MockMerchStoreServiceClientMockRecorder) ListGoods(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGoods", reflect.TypeOf((*MockMerchStoreServiceClient)(nil).ListGoods), varargs...)
+}
+
+// ListPaymentRequests mocks base method.
+func (m *MockMerchStoreServiceClient) ListPaymentRequests(ctx context.Context, in *merchapi.ListPaymentRequestsRequest, opts ...grpc.CallOption) (*merchapi.ListPaymentRequestsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPaymentRequests", varargs...)
+	ret0, _ := ret[0].(*merchapi.ListPaymentRequestsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPaymentRequests indicates an expected call of ListPaymentRequests.
+func (mr *MockMerchStoreServiceClientMockRecorder) ListPaymentRequests(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPaymentRequests", reflect.TypeOf((*MockMerchStoreServiceClient)(nil).ListPaymentRequests), varargs...)
+}
+
+// ListPreorders mocks base method.
+func (m *MockMerchStoreServiceClient) ListPreorders(ctx context.Context, in *merchapi.ListPreordersRequest, opts ...grpc.CallOption) (*merchapi.ListPreordersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPreorders", varargs...)
+	ret0, _ := ret[0].(*merchapi.ListPreordersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPreorders indicates an expected call of ListPreorders.
+func (mr *MockMerchStoreServiceClientMockRecorder) ListPreorders(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPreorders", reflect.TypeOf((*MockMerchStoreServiceClient)(nil).ListPreorders), varargs...)
+}
+
+// ListRaffles mocks base method.
+func (m *MockMerchStoreServiceClient) ListRaffles(ctx context.Context, in *merchapi.ListRafflesRequest, opts ...grpc.CallOption) (*merchapi.ListRafflesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRaffles", varargs...)
+	ret0, _ := ret[0].(*merchapi.ListRafflesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRaffles indicates an expected call of ListRaffles.
+func (mr *MockMerchStoreServiceClientMockRecorder) ListRaffles(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRaffles", reflect.TypeOf((*MockMerchStoreServiceClient)(nil).ListRaffles), varargs...)
+}
+
+// ListScheduledTransfers mocks base method.
+func (m *MockMerchStoreServiceClient) ListScheduledTransfers(ctx context.Context, in *merchapi.ListScheduledTransfersRequest, opts ...grpc.CallOption) (*merchapi.ListScheduledTransfersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListScheduledTransfers", varargs...)
+	ret0, _ := ret[0].(*merchapi.ListScheduledTransfersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListScheduledTransfers indicates an expected call of ListScheduledTransfers.
+func (mr *MockMerchStoreServiceClientMockRecorder) ListScheduledTransfers(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListScheduledTransfers", reflect.TypeOf((*MockMerchStoreServiceClient)(nil).ListScheduledTransfers), varargs...)
+}
+
+// ListWishlist mocks base method.
+func (m *MockMerchStoreServiceClient) ListWishlist(ctx context.Context, in *merchapi.ListWishlistRequest, opts ...grpc.CallOption) (*merchapi.ListWishlistResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListWishlist", varargs...)
+	ret0, _ := ret[0].(*merchapi.ListWishlistResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWishlist indicates an expected call of ListWishlist.
+func (mr *MockMerchStoreServiceClientMockRecorder) ListWishlist(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWishlist", reflect.TypeOf((*MockMerchStoreServiceClient)(nil).ListWishlist), varargs...)
+}
+
+// ListWishlistEvents mocks base method.
+func (m *MockMerchStoreServiceClient) ListWishlistEvents(ctx context.Context, in *merchapi.ListWishlistEventsRequest, opts ...grpc.CallOption) (*merchapi.ListWishlistEventsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListWishlistEvents", varargs...)
+	ret0, _ := ret[0].(*merchapi.ListWishlistEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWishlistEvents indicates an expected call of ListWishlistEvents.
+func (mr *MockMerchStoreServiceClientMockRecorder) ListWishlistEvents(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWishlistEvents", reflect.TypeOf((*MockMerchStoreServiceClient)(nil).ListWishlistEvents), varargs...)
+}
+
+// PlaceBid mocks base method.
+func (m *MockMerchStoreServiceClient) PlaceBid(ctx context.Context, in *merchapi.PlaceBidRequest, opts ...grpc.CallOption) (*merchapi.PlaceBidResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PlaceBid", varargs...)
+	ret0, _ := ret[0].(*merchapi.PlaceBidResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlaceBid indicates an expected call of PlaceBid.
+func (mr *MockMerchStoreServiceClientMockRecorder) PlaceBid(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaceBid", reflect.TypeOf((*MockMerchStoreServiceClient)(nil).PlaceBid), varargs...)
+}
+
+// PlacePreorder mocks base method.
+func (m *MockMerchStoreServiceClient) PlacePreorder(ctx context.Context, in *merchapi.PlacePreorderRequest, opts ...grpc.CallOption) (*merchapi.PlacePreorderResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PlacePreorder", varargs...)
+	ret0, _ := ret[0].(*merchapi.PlacePreorderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlacePreorder indicates an expected call of PlacePreorder.
+func (mr *MockMerchStoreServiceClientMockRecorder) PlacePreorder(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlacePreorder", reflect.TypeOf((*MockMerchStoreServiceClient)(nil).PlacePreorder), varargs...)
+}
+
+// RemoveFromWishlist mocks base method.
+func (m *MockMerchStoreServiceClient) RemoveFromWishlist(ctx context.Context, in *merchapi.RemoveFromWishlistRequest, opts ...grpc.CallOption) (*merchapi.RemoveFromWishlistResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveFromWishlist", varargs...)
+	ret0, _ := ret[0].(*merchapi.RemoveFromWishlistResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveFromWishlist indicates an expected call of RemoveFromWishlist.
+func (mr *MockMerchStoreServiceClientMockRecorder) RemoveFromWishlist(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromWishlist", reflect.TypeOf((*MockMerchStoreServiceClient)(nil).RemoveFromWishlist), varargs...)
+}
+
+// ScheduleTransfer mocks base method.
+func (m *MockMerchStoreServiceClient) ScheduleTransfer(ctx context.Context, in *merchapi.ScheduleTransferRequest, opts ...grpc.CallOption) (*merchapi.ScheduleTransferResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ScheduleTransfer", varargs...)
+	ret0, _ := ret[0].(*merchapi.ScheduleTransferResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduleTransfer indicates an expected call of ScheduleTransfer.
+func (mr *MockMerchStoreServiceClientMockRecorder) ScheduleTransfer(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleTransfer", reflect.TypeOf((*MockMerchStoreServiceClient)(nil).ScheduleTransfer), varargs...)
+}
+
+// SearchListings mocks base method.
+func (m *MockMerchStoreServiceClient) SearchListings(ctx context.Context, in *merchapi.SearchListingsRequest, opts ...grpc.CallOption) (*merchapi.SearchListingsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SearchListings", varargs...)
+	ret0, _ := ret[0].(*merchapi.SearchListingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchListings indicates an expected call of SearchListings.
+func (mr *MockMerchStoreServiceClientMockRecorder) SearchListings(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchListings", reflect.TypeOf((*MockMerchStoreServiceClient)(nil).SearchListings), varargs...)
+}
+
+// SendCoins mocks base method.
+func (m *MockMerchStoreServiceClient) SendCoins(ctx context.Context, in *merchapi.SendCoinsRequest, opts ...grpc.CallOption) (*merchapi.SendCoinsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SendCoins", varargs...)
+	ret0, _ := ret[0].(*merchapi.SendCoinsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendCoins indicates an expected call of SendCoins.
+func (mr *MockMerchStoreServiceClientMockRecorder) SendCoins(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoins", reflect.TypeOf((*MockMerchStoreServiceClient)(nil).SendCoins), varargs...)
+}
+
+// SendCoinsBatch mocks base method.
+func (m *MockMerchStoreServiceClient) SendCoinsBatch(ctx context.Context, in *merchapi.SendCoinsBatchRequest, opts ...grpc.CallOption) (*merchapi.SendCoinsBatchResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SendCoinsBatch", varargs...)
+	ret0, _ := ret[0].(*merchapi.SendCoinsBatchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendCoinsBatch indicates an expected call of SendCoinsBatch.
+func (mr *MockMerchStoreServiceClientMockRecorder) SendCoinsBatch(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsBatch", reflect.TypeOf((*MockMerchStoreServiceClient)(nil).SendCoinsBatch), varargs...)
+}
+
+// SendFromTeamBudget mocks base method.
+func (m *MockMerchStoreServiceClient) SendFromTeamBudget(ctx context.Context, in *merchapi.SendFromTeamBudgetRequest, opts ...grpc.CallOption) (*merchapi.SendFromTeamBudgetResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SendFromTeamBudget", varargs...)
+	ret0, _ := ret[0].(*merchapi.SendFromTeamBudgetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendFromTeamBudget indicates an expected call of SendFromTeamBudget.
+func (mr *MockMerchStoreServiceClientMockRecorder) SendFromTeamBudget(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendFromTeamBudget", reflect.TypeOf((*MockMerchStoreServiceClient)(nil).SendFromTeamBudget), varargs...)
+}
+
+// SubscribeEvents mocks base method.
+func (m *MockMerchStoreServiceClient) SubscribeEvents(ctx context.Context, in *merchapi.SubscribeEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[merchapi.UserEvent], error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SubscribeEvents", varargs...)
+	ret0, _ := ret[0].(grpc.ServerStreamingClient[merchapi.UserEvent])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeEvents indicates an expected call of SubscribeEvents.
+func (mr *MockMerchStoreServiceClientMockRecorder) SubscribeEvents(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeEvents", reflect.TypeOf((*MockMerchStoreServiceClient)(nil).SubscribeEvents), varargs...)
+}
+
+// TransferItem mocks base method.
+func (m *MockMerchStoreServiceClient) TransferItem(ctx context.Context, in *merchapi.TransferItemRequest, opts ...grpc.CallOption) (*merchapi.TransferItemResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "TransferItem", varargs...)
+	ret0, _ := ret[0].(*merchapi.TransferItemResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransferItem indicates an expected call of TransferItem.
+func (mr *MockMerchStoreServiceClientMockRecorder) TransferItem(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserInfo", reflect.TypeOf((*MockMerchStoreServiceClient)(nil).GetUserInfo), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferItem", reflect.TypeOf((*MockMerchStoreServiceClient)(nil).TransferItem), varargs...)
 }
 
-// SendCoins mocks base method.
-func (m *MockMerchStoreServiceClient) SendCoins(ctx context.Context, in *merchapi.SendCoinsRequest, opts ...grpc.CallOption) (*merchapi.SendCoinsResponse, error) {
+// UpdateListing mocks base method.
+func (m *MockMerchStoreServiceClient) UpdateListing(ctx context.Context, in *merchapi.UpdateListingRequest, opts ...grpc.CallOption) (*merchapi.UpdateListingResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "SendCoins", varargs...)
-	ret0, _ := ret[0].(*merchapi.SendCoinsResponse)
+	ret := m.ctrl.Call(m, "UpdateListing", varargs...)
+	ret0, _ := ret[0].(*merchapi.UpdateListingResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SendCoins indicates an expected call of SendCoins.
-func (mr *MockMerchStoreServiceClientMockRecorder) SendCoins(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// UpdateListing indicates an expected call of UpdateListing.
+func (mr *MockMerchStoreServiceClientMockRecorder) UpdateListing(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoins", reflect.TypeOf((*MockMerchStoreServiceClient)(nil).SendCoins), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateListing", reflect.TypeOf((*MockMerchStoreServiceClient)(nil).UpdateListing), varargs...)
 }
 
 // MockMerchStoreServiceServer is a mock of MerchStoreServiceServer interface.
@@ -119,6 +739,36 @@ func (m *MockMerchStoreServiceServer) EXPECT() *MockMerchStoreServiceServerMockR
 	return m.recorder
 }
 
+// AcceptPaymentRequest mocks base method.
+func (m *MockMerchStoreServiceServer) AcceptPaymentRequest(arg0 context.Context, arg1 *merchapi.AcceptPaymentRequestRequest) (*merchapi.AcceptPaymentRequestResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptPaymentRequest", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.AcceptPaymentRequestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptPaymentRequest indicates an expected call of AcceptPaymentRequest.
+func (mr *MockMerchStoreServiceServerMockRecorder) AcceptPaymentRequest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptPaymentRequest", reflect.TypeOf((*MockMerchStoreServiceServer)(nil).AcceptPaymentRequest), arg0, arg1)
+}
+
+// AddToWishlist mocks base method.
+func (m *MockMerchStoreServiceServer) AddToWishlist(arg0 context.Context, arg1 *merchapi.AddToWishlistRequest) (*merchapi.AddToWishlistResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddToWishlist", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.AddToWishlistResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddToWishlist indicates an expected call of AddToWishlist.
+func (mr *MockMerchStoreServiceServerMockRecorder) AddToWishlist(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddToWishlist", reflect.TypeOf((*MockMerchStoreServiceServer)(nil).AddToWishlist), arg0, arg1)
+}
+
 // BuyItem mocks base method.
 func (m *MockMerchStoreServiceServer) BuyItem(arg0 context.Context, arg1 *merchapi.BuyItemRequest) (*merchapi.BuyItemResponse, error) {
 	m.ctrl.T.Helper()
@@ -134,6 +784,141 @@ func (mr *MockMerchStoreServiceServerMockRecorder) BuyItem(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuyItem", reflect.TypeOf((*MockMerchStoreServiceServer)(nil).BuyItem), arg0, arg1)
 }
 
+// BuyListing mocks base method.
+func (m *MockMerchStoreServiceServer) BuyListing(arg0 context.Context, arg1 *merchapi.BuyListingRequest) (*merchapi.BuyListingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuyListing", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.BuyListingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuyListing indicates an expected call of BuyListing.
+func (mr *MockMerchStoreServiceServerMockRecorder) BuyListing(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuyListing", reflect.TypeOf((*MockMerchStoreServiceServer)(nil).BuyListing), arg0, arg1)
+}
+
+// BuyRaffleTickets mocks base method.
+func (m *MockMerchStoreServiceServer) BuyRaffleTickets(arg0 context.Context, arg1 *merchapi.BuyRaffleTicketsRequest) (*merchapi.BuyRaffleTicketsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuyRaffleTickets", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.BuyRaffleTicketsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuyRaffleTickets indicates an expected call of BuyRaffleTickets.
+func (mr *MockMerchStoreServiceServerMockRecorder) BuyRaffleTickets(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuyRaffleTickets", reflect.TypeOf((*MockMerchStoreServiceServer)(nil).BuyRaffleTickets), arg0, arg1)
+}
+
+// CancelListing mocks base method.
+func (m *MockMerchStoreServiceServer) CancelListing(arg0 context.Context, arg1 *merchapi.CancelListingRequest) (*merchapi.CancelListingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelListing", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.CancelListingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelListing indicates an expected call of CancelListing.
+func (mr *MockMerchStoreServiceServerMockRecorder) CancelListing(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelListing", reflect.TypeOf((*MockMerchStoreServiceServer)(nil).CancelListing), arg0, arg1)
+}
+
+// CancelPreorder mocks base method.
+func (m *MockMerchStoreServiceServer) CancelPreorder(arg0 context.Context, arg1 *merchapi.CancelPreorderRequest) (*merchapi.CancelPreorderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelPreorder", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.CancelPreorderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelPreorder indicates an expected call of CancelPreorder.
+func (mr *MockMerchStoreServiceServerMockRecorder) CancelPreorder(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelPreorder", reflect.TypeOf((*MockMerchStoreServiceServer)(nil).CancelPreorder), arg0, arg1)
+}
+
+// CancelScheduledTransfer mocks base method.
+func (m *MockMerchStoreServiceServer) CancelScheduledTransfer(arg0 context.Context, arg1 *merchapi.CancelScheduledTransferRequest) (*merchapi.CancelScheduledTransferResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelScheduledTransfer", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.CancelScheduledTransferResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelScheduledTransfer indicates an expected call of CancelScheduledTransfer.
+func (mr *MockMerchStoreServiceServerMockRecorder) CancelScheduledTransfer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelScheduledTransfer", reflect.TypeOf((*MockMerchStoreServiceServer)(nil).CancelScheduledTransfer), arg0, arg1)
+}
+
+// CreateListing mocks base method.
+func (m *MockMerchStoreServiceServer) CreateListing(arg0 context.Context, arg1 *merchapi.CreateListingRequest) (*merchapi.CreateListingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateListing", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.CreateListingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateListing indicates an expected call of CreateListing.
+func (mr *MockMerchStoreServiceServerMockRecorder) CreateListing(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateListing", reflect.TypeOf((*MockMerchStoreServiceServer)(nil).CreateListing), arg0, arg1)
+}
+
+// CreatePaymentRequest mocks base method.
+func (m *MockMerchStoreServiceServer) CreatePaymentRequest(arg0 context.Context, arg1 *merchapi.CreatePaymentRequestRequest) (*merchapi.CreatePaymentRequestResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePaymentRequest", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.CreatePaymentRequestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePaymentRequest indicates an expected call of CreatePaymentRequest.
+func (mr *MockMerchStoreServiceServerMockRecorder) CreatePaymentRequest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePaymentRequest", reflect.TypeOf((*MockMerchStoreServiceServer)(nil).CreatePaymentRequest), arg0, arg1)
+}
+
+// DeclinePaymentRequest mocks base method.
+func (m *MockMerchStoreServiceServer) DeclinePaymentRequest(arg0 context.Context, arg1 *merchapi.DeclinePaymentRequestRequest) (*merchapi.DeclinePaymentRequestResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeclinePaymentRequest", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.DeclinePaymentRequestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeclinePaymentRequest indicates an expected call of DeclinePaymentRequest.
+func (mr *MockMerchStoreServiceServerMockRecorder) DeclinePaymentRequest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeclinePaymentRequest", reflect.TypeOf((*MockMerchStoreServiceServer)(nil).DeclinePaymentRequest), arg0, arg1)
+}
+
+// GetPriceHistory mocks base method.
+func (m *MockMerchStoreServiceServer) GetPriceHistory(arg0 context.Context, arg1 *merchapi.GetPriceHistoryRequest) (*merchapi.GetPriceHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPriceHistory", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.GetPriceHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPriceHistory indicates an expected call of GetPriceHistory.
+func (mr *MockMerchStoreServiceServerMockRecorder) GetPriceHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceHistory", reflect.TypeOf((*MockMerchStoreServiceServer)(nil).GetPriceHistory), arg0, arg1)
+}
+
 // GetUserInfo mocks base method.
 func (m *MockMerchStoreServiceServer) GetUserInfo(arg0 context.Context, arg1 *merchapi.GetUserInfoRequest) (*merchapi.GetUserInfoResponse, error) {
 	m.ctrl.T.Helper()
@@ -149,6 +934,231 @@ func (mr *MockMerchStoreServiceServerMockRecorder) GetUserInfo(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserInfo", reflect.TypeOf((*MockMerchStoreServiceServer)(nil).GetUserInfo), arg0, arg1)
 }
 
+// GiftItem mocks base method.
+func (m *MockMerchStoreServiceServer) GiftItem(arg0 context.Context, arg1 *merchapi.GiftItemRequest) (*merchapi.GiftItemResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GiftItem", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.GiftItemResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GiftItem indicates an expected call of GiftItem.
+func (mr *MockMerchStoreServiceServerMockRecorder) GiftItem(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GiftItem", reflect.TypeOf((*MockMerchStoreServiceServer)(nil).GiftItem), arg0, arg1)
+}
+
+// ListAuctions mocks base method.
+func (m *MockMerchStoreServiceServer) ListAuctions(arg0 context.Context, arg1 *merchapi.ListAuctionsRequest) (*merchapi.ListAuctionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuctions", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.ListAuctionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuctions indicates an expected call of ListAuctions.
+func (mr *MockMerchStoreServiceServerMockRecorder) ListAuctions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuctions", reflect.TypeOf((*MockMerchStoreServiceServer)(nil).ListAuctions), arg0, arg1)
+}
+
+// ListCategories mocks base method.
+func (m *MockMerchStoreServiceServer) ListCategories(arg0 context.Context, arg1 *merchapi.ListCategoriesRequest) (*merchapi.ListCategoriesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCategories", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.ListCategoriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCategories indicates an expected call of ListCategories.
+func (mr *MockMerchStoreServiceServerMockRecorder) ListCategories(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategories", reflect.TypeOf((*MockMerchStoreServiceServer)(nil).ListCategories), arg0, arg1)
+}
+
+// ListGoods mocks base method.
+func (m *MockMerchStoreServiceServer) ListGoods(arg0 context.Context, arg1 *merchapi.ListGoodsRequest) (*merchapi.ListGoodsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGoods", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.ListGoodsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGoods indicates an expected call of ListGoods.
+func (mr *MockMerchStoreServiceServerMockRecorder) ListGoods(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGoods", reflect.TypeOf((*MockMerchStoreServiceServer)(nil).ListGoods), arg0, arg1)
+}
+
+// ListPaymentRequests mocks base method.
+func (m *MockMerchStoreServiceServer) ListPaymentRequests(arg0 context.Context, arg1 *merchapi.ListPaymentRequestsRequest) (*merchapi.ListPaymentRequestsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPaymentRequests", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.ListPaymentRequestsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPaymentRequests indicates an expected call of ListPaymentRequests.
+func (mr *MockMerchStoreServiceServerMockRecorder) ListPaymentRequests(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPaymentRequests", reflect.TypeOf((*MockMerchStoreServiceServer)(nil).ListPaymentRequests), arg0, arg1)
+}
+
+// ListPreorders mocks base method.
+func (m *MockMerchStoreServiceServer) ListPreorders(arg0 context.Context, arg1 *merchapi.ListPreordersRequest) (*merchapi.ListPreordersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPreorders", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.ListPreordersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPreorders indicates an expected call of ListPreorders.
+func (mr *MockMerchStoreServiceServerMockRecorder) ListPreorders(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPreorders", reflect.TypeOf((*MockMerchStoreServiceServer)(nil).ListPreorders), arg0, arg1)
+}
+
+// ListRaffles mocks base method.
+func (m *MockMerchStoreServiceServer) ListRaffles(arg0 context.Context, arg1 *merchapi.ListRafflesRequest) (*merchapi.ListRafflesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRaffles", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.ListRafflesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRaffles indicates an expected call of ListRaffles.
+func (mr *MockMerchStoreServiceServerMockRecorder) ListRaffles(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRaffles", reflect.TypeOf((*MockMerchStoreServiceServer)(nil).ListRaffles), arg0, arg1)
+}
+
+// ListScheduledTransfers mocks base method.
+func (m *MockMerchStoreServiceServer) ListScheduledTransfers(arg0 context.Context, arg1 *merchapi.ListScheduledTransfersRequest) (*merchapi.ListScheduledTransfersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListScheduledTransfers", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.ListScheduledTransfersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListScheduledTransfers indicates an expected call of ListScheduledTransfers.
+func (mr *MockMerchStoreServiceServerMockRecorder) ListScheduledTransfers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListScheduledTransfers", reflect.TypeOf((*MockMerchStoreServiceServer)(nil).ListScheduledTransfers), arg0, arg1)
+}
+
+// ListWishlist mocks base method.
+func (m *MockMerchStoreServiceServer) ListWishlist(arg0 context.Context, arg1 *merchapi.ListWishlistRequest) (*merchapi.ListWishlistResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWishlist", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.ListWishlistResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWishlist indicates an expected call of ListWishlist.
+func (mr *MockMerchStoreServiceServerMockRecorder) ListWishlist(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWishlist", reflect.TypeOf((*MockMerchStoreServiceServer)(nil).ListWishlist), arg0, arg1)
+}
+
+// ListWishlistEvents mocks base method.
+func (m *MockMerchStoreServiceServer) ListWishlistEvents(arg0 context.Context, arg1 *merchapi.ListWishlistEventsRequest) (*merchapi.ListWishlistEventsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWishlistEvents", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.ListWishlistEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWishlistEvents indicates an expected call of ListWishlistEvents.
+func (mr *MockMerchStoreServiceServerMockRecorder) ListWishlistEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWishlistEvents", reflect.TypeOf((*MockMerchStoreServiceServer)(nil).ListWishlistEvents), arg0, arg1)
+}
+
+// PlaceBid mocks base method.
+func (m *MockMerchStoreServiceServer) PlaceBid(arg0 context.Context, arg1 *merchapi.PlaceBidRequest) (*merchapi.PlaceBidResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaceBid", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.PlaceBidResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlaceBid indicates an expected call of PlaceBid.
+func (mr *MockMerchStoreServiceServerMockRecorder) PlaceBid(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaceBid", reflect.TypeOf((*MockMerchStoreServiceServer)(nil).PlaceBid), arg0, arg1)
+}
+
+// PlacePreorder mocks base method.
+func (m *MockMerchStoreServiceServer) PlacePreorder(arg0 context.Context, arg1 *merchapi.PlacePreorderRequest) (*merchapi.PlacePreorderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlacePreorder", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.PlacePreorderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlacePreorder indicates an expected call of PlacePreorder.
+func (mr *MockMerchStoreServiceServerMockRecorder) PlacePreorder(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlacePreorder", reflect.TypeOf((*MockMerchStoreServiceServer)(nil).PlacePreorder), arg0, arg1)
+}
+
+// RemoveFromWishlist mocks base method.
+func (m *MockMerchStoreServiceServer) RemoveFromWishlist(arg0 context.Context, arg1 *merchapi.RemoveFromWishlistRequest) (*merchapi.RemoveFromWishlistResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFromWishlist", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.RemoveFromWishlistResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveFromWishlist indicates an expected call of RemoveFromWishlist.
+func (mr *MockMerchStoreServiceServerMockRecorder) RemoveFromWishlist(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromWishlist", reflect.TypeOf((*MockMerchStoreServiceServer)(nil).RemoveFromWishlist), arg0, arg1)
+}
+
+// ScheduleTransfer mocks base method.
+func (m *MockMerchStoreServiceServer) ScheduleTransfer(arg0 context.Context, arg1 *merchapi.ScheduleTransferRequest) (*merchapi.ScheduleTransferResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleTransfer", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.ScheduleTransferResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduleTransfer indicates an expected call of ScheduleTransfer.
+func (mr *MockMerchStoreServiceServerMockRecorder) ScheduleTransfer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleTransfer", reflect.TypeOf((*MockMerchStoreServiceServer)(nil).ScheduleTransfer), arg0, arg1)
+}
+
+// SearchListings mocks base method.
+func (m *MockMerchStoreServiceServer) SearchListings(arg0 context.Context, arg1 *merchapi.SearchListingsRequest) (*merchapi.SearchListingsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchListings", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.SearchListingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchListings indicates an expected call of SearchListings.
+func (mr *MockMerchStoreServiceServerMockRecorder) SearchListings(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchListings", reflect.TypeOf((*MockMerchStoreServiceServer)(nil).SearchListings), arg0, arg1)
+}
+
 // SendCoins mocks base method.
 func (m *MockMerchStoreServiceServer) SendCoins(arg0 context.Context, arg1 *merchapi.SendCoinsRequest) (*merchapi.SendCoinsResponse, error) {
 	m.ctrl.T.Helper()
@@ -164,6 +1174,80 @@ func (mr *MockMerchStoreServiceServerMockRecorder) SendCoins(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoins", reflect.TypeOf((*MockMerchStoreServiceServer)(nil).SendCoins), arg0, arg1)
 }
 
+// SendCoinsBatch mocks base method.
+func (m *MockMerchStoreServiceServer) SendCoinsBatch(arg0 context.Context, arg1 *merchapi.SendCoinsBatchRequest) (*merchapi.SendCoinsBatchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoinsBatch", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.SendCoinsBatchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendCoinsBatch indicates an expected call of SendCoinsBatch.
+func (mr *MockMerchStoreServiceServerMockRecorder) SendCoinsBatch(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsBatch", reflect.TypeOf((*MockMerchStoreServiceServer)(nil).SendCoinsBatch), arg0, arg1)
+}
+
+// SendFromTeamBudget mocks base method.
+func (m *MockMerchStoreServiceServer) SendFromTeamBudget(arg0 context.Context, arg1 *merchapi.SendFromTeamBudgetRequest) (*merchapi.SendFromTeamBudgetResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendFromTeamBudget", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.SendFromTeamBudgetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendFromTeamBudget indicates an expected call of SendFromTeamBudget.
+func (mr *MockMerchStoreServiceServerMockRecorder) SendFromTeamBudget(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendFromTeamBudget", reflect.TypeOf((*MockMerchStoreServiceServer)(nil).SendFromTeamBudget), arg0, arg1)
+}
+
+// SubscribeEvents mocks base method.
+func (m *MockMerchStoreServiceServer) SubscribeEvents(arg0 *merchapi.SubscribeEventsRequest, arg1 grpc.ServerStreamingServer[merchapi.UserEvent]) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeEvents", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubscribeEvents indicates an expected call of SubscribeEvents.
+func (mr *MockMerchStoreServiceServerMockRecorder) SubscribeEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeEvents", reflect.TypeOf((*MockMerchStoreServiceServer)(nil).SubscribeEvents), arg0, arg1)
+}
+
+// TransferItem mocks base method.
+func (m *MockMerchStoreServiceServer) TransferItem(arg0 context.Context, arg1 *merchapi.TransferItemRequest) (*merchapi.TransferItemResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferItem", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.TransferItemResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransferItem indicates an expected call of TransferItem.
+func (mr *MockMerchStoreServiceServerMockRecorder) TransferItem(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferItem", reflect.TypeOf((*MockMerchStoreServiceServer)(nil).TransferItem), arg0, arg1)
+}
+
+// UpdateListing mocks base method.
+func (m *MockMerchStoreServiceServer) UpdateListing(arg0 context.Context, arg1 *merchapi.UpdateListingRequest) (*merchapi.UpdateListingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateListing", arg0, arg1)
+	ret0, _ := ret[0].(*merchapi.UpdateListingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateListing indicates an expected call of UpdateListing.
+func (mr *MockMerchStoreServiceServerMockRecorder) UpdateListing(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateListing", reflect.TypeOf((*MockMerchStoreServiceServer)(nil).UpdateListing), arg0, arg1)
+}
+
 // mustEmbedUnimplementedMerchStoreServiceServer mocks base method.
 func (m *MockMerchStoreServiceServer) mustEmbedUnimplementedMerchStoreServiceServer() {
 	m.ctrl.T.Helper()
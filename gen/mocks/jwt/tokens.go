@@ -75,18 +75,18 @@ func (m *MockTokenIssuer) EXPECT() *MockTokenIssuerMockRecorder {
 }
 
 // IssueToken mocks base method.
-func (m *MockTokenIssuer) IssueToken(secret []byte, userID int, username string, timeLimit time.Duration) (string, error) {
+func (m *MockTokenIssuer) IssueToken(secret []byte, userID int, username, role string, timeLimit time.Duration) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IssueToken", secret, userID, username, timeLimit)
+	ret := m.ctrl.Call(m, "IssueToken", secret, userID, username, role, timeLimit)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // IssueToken indicates an expected call of IssueToken.
-func (mr *MockTokenIssuerMockRecorder) IssueToken(secret, userID, username, timeLimit interface{}) *gomock.Call {
+func (mr *MockTokenIssuerMockRecorder) IssueToken(secret, userID, username, role, timeLimit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueToken", reflect.TypeOf((*MockTokenIssuer)(nil).IssueToken), secret, userID, username, timeLimit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueToken", reflect.TypeOf((*MockTokenIssuer)(nil).IssueToken), secret, userID, username, role, timeLimit)
 }
 
 // MockTokenParser is a mock of TokenParser interface.
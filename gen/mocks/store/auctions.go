@@ -0,0 +1,215 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/store/domain/auctions.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	database "github.com/Lexv0lk/merch-store/internal/pkg/database"
+	domain "github.com/Lexv0lk/merch-store/internal/store/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAuctionsRepository is a mock of AuctionsRepository interface.
+type MockAuctionsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuctionsRepositoryMockRecorder
+}
+
+// MockAuctionsRepositoryMockRecorder is the mock recorder for MockAuctionsRepository.
+type MockAuctionsRepositoryMockRecorder struct {
+	mock *MockAuctionsRepository
+}
+
+// NewMockAuctionsRepository creates a new mock instance.
+func NewMockAuctionsRepository(ctrl *gomock.Controller) *MockAuctionsRepository {
+	mock := &MockAuctionsRepository{ctrl: ctrl}
+	mock.recorder = &MockAuctionsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuctionsRepository) EXPECT() *MockAuctionsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateAuction mocks base method.
+func (m *MockAuctionsRepository) CreateAuction(ctx context.Context, querier database.Querier, auction domain.Auction) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAuction", ctx, querier, auction)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAuction indicates an expected call of CreateAuction.
+func (mr *MockAuctionsRepositoryMockRecorder) CreateAuction(ctx, querier, auction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuction", reflect.TypeOf((*MockAuctionsRepository)(nil).CreateAuction), ctx, querier, auction)
+}
+
+// ListOpenAuctions mocks base method.
+func (m *MockAuctionsRepository) ListOpenAuctions(ctx context.Context) ([]domain.Auction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOpenAuctions", ctx)
+	ret0, _ := ret[0].([]domain.Auction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOpenAuctions indicates an expected call of ListOpenAuctions.
+func (mr *MockAuctionsRepositoryMockRecorder) ListOpenAuctions(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOpenAuctions", reflect.TypeOf((*MockAuctionsRepository)(nil).ListOpenAuctions), ctx)
+}
+
+// MockAuctionBidsProceeder is a mock of AuctionBidsProceeder interface.
+type MockAuctionBidsProceeder struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuctionBidsProceederMockRecorder
+}
+
+// MockAuctionBidsProceederMockRecorder is the mock recorder for MockAuctionBidsProceeder.
+type MockAuctionBidsProceederMockRecorder struct {
+	mock *MockAuctionBidsProceeder
+}
+
+// NewMockAuctionBidsProceeder creates a new mock instance.
+func NewMockAuctionBidsProceeder(ctrl *gomock.Controller) *MockAuctionBidsProceeder {
+	mock := &MockAuctionBidsProceeder{ctrl: ctrl}
+	mock.recorder = &MockAuctionBidsProceederMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuctionBidsProceeder) EXPECT() *MockAuctionBidsProceederMockRecorder {
+	return m.recorder
+}
+
+// LockAndGetAuction mocks base method.
+func (m *MockAuctionBidsProceeder) LockAndGetAuction(ctx context.Context, querier database.Querier, auctionID int) (domain.Auction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockAndGetAuction", ctx, querier, auctionID)
+	ret0, _ := ret[0].(domain.Auction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockAndGetAuction indicates an expected call of LockAndGetAuction.
+func (mr *MockAuctionBidsProceederMockRecorder) LockAndGetAuction(ctx, querier, auctionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockAndGetAuction", reflect.TypeOf((*MockAuctionBidsProceeder)(nil).LockAndGetAuction), ctx, querier, auctionID)
+}
+
+// PlaceBid mocks base method.
+func (m *MockAuctionBidsProceeder) PlaceBid(ctx context.Context, executor database.Executor, auctionID, bidderID int, amount uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaceBid", ctx, executor, auctionID, bidderID, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PlaceBid indicates an expected call of PlaceBid.
+func (mr *MockAuctionBidsProceederMockRecorder) PlaceBid(ctx, executor, auctionID, bidderID, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaceBid", reflect.TypeOf((*MockAuctionBidsProceeder)(nil).PlaceBid), ctx, executor, auctionID, bidderID, amount)
+}
+
+// RefundBid mocks base method.
+func (m *MockAuctionBidsProceeder) RefundBid(ctx context.Context, executor database.Executor, bid domain.AuctionBid) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundBid", ctx, executor, bid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefundBid indicates an expected call of RefundBid.
+func (mr *MockAuctionBidsProceederMockRecorder) RefundBid(ctx, executor, bid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundBid", reflect.TypeOf((*MockAuctionBidsProceeder)(nil).RefundBid), ctx, executor, bid)
+}
+
+// RefundUserBids mocks base method.
+func (m *MockAuctionBidsProceeder) RefundUserBids(ctx context.Context, executor database.QueryExecuter, userID int) ([]domain.AuctionBid, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundUserBids", ctx, executor, userID)
+	ret0, _ := ret[0].([]domain.AuctionBid)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefundUserBids indicates an expected call of RefundUserBids.
+func (mr *MockAuctionBidsProceederMockRecorder) RefundUserBids(ctx, executor, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundUserBids", reflect.TypeOf((*MockAuctionBidsProceeder)(nil).RefundUserBids), ctx, executor, userID)
+}
+
+// MockAuctionSettler is a mock of AuctionSettler interface.
+type MockAuctionSettler struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuctionSettlerMockRecorder
+}
+
+// MockAuctionSettlerMockRecorder is the mock recorder for MockAuctionSettler.
+type MockAuctionSettlerMockRecorder struct {
+	mock *MockAuctionSettler
+}
+
+// NewMockAuctionSettler creates a new mock instance.
+func NewMockAuctionSettler(ctrl *gomock.Controller) *MockAuctionSettler {
+	mock := &MockAuctionSettler{ctrl: ctrl}
+	mock.recorder = &MockAuctionSettlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuctionSettler) EXPECT() *MockAuctionSettlerMockRecorder {
+	return m.recorder
+}
+
+// AwardAuction mocks base method.
+func (m *MockAuctionSettler) AwardAuction(ctx context.Context, executor database.Executor, auction domain.Auction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AwardAuction", ctx, executor, auction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AwardAuction indicates an expected call of AwardAuction.
+func (mr *MockAuctionSettlerMockRecorder) AwardAuction(ctx, executor, auction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AwardAuction", reflect.TypeOf((*MockAuctionSettler)(nil).AwardAuction), ctx, executor, auction)
+}
+
+// CloseUnsoldAuction mocks base method.
+func (m *MockAuctionSettler) CloseUnsoldAuction(ctx context.Context, executor database.Executor, auctionID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseUnsoldAuction", ctx, executor, auctionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseUnsoldAuction indicates an expected call of CloseUnsoldAuction.
+func (mr *MockAuctionSettlerMockRecorder) CloseUnsoldAuction(ctx, executor, auctionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseUnsoldAuction", reflect.TypeOf((*MockAuctionSettler)(nil).CloseUnsoldAuction), ctx, executor, auctionID)
+}
+
+// FetchDueAuctions mocks base method.
+func (m *MockAuctionSettler) FetchDueAuctions(ctx context.Context, now time.Time, limit int) ([]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchDueAuctions", ctx, now, limit)
+	ret0, _ := ret[0].([]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchDueAuctions indicates an expected call of FetchDueAuctions.
+func (mr *MockAuctionSettlerMockRecorder) FetchDueAuctions(ctx, now, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchDueAuctions", reflect.TypeOf((*MockAuctionSettler)(nil).FetchDueAuctions), ctx, now, limit)
+}
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/store/domain/audit.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	audit "github.com/Lexv0lk/merch-store/internal/pkg/audit"
+	domain "github.com/Lexv0lk/merch-store/internal/store/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAuthAuditReader is a mock of AuthAuditReader interface.
+type MockAuthAuditReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthAuditReaderMockRecorder
+}
+
+// MockAuthAuditReaderMockRecorder is the mock recorder for MockAuthAuditReader.
+type MockAuthAuditReaderMockRecorder struct {
+	mock *MockAuthAuditReader
+}
+
+// NewMockAuthAuditReader creates a new mock instance.
+func NewMockAuthAuditReader(ctrl *gomock.Controller) *MockAuthAuditReader {
+	mock := &MockAuthAuditReader{ctrl: ctrl}
+	mock.recorder = &MockAuthAuditReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthAuditReader) EXPECT() *MockAuthAuditReaderMockRecorder {
+	return m.recorder
+}
+
+// ListAuthEvents mocks base method.
+func (m *MockAuthAuditReader) ListAuthEvents(ctx context.Context, filter audit.Filter) ([]domain.AuditEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuthEvents", ctx, filter)
+	ret0, _ := ret[0].([]domain.AuditEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuthEvents indicates an expected call of ListAuthEvents.
+func (mr *MockAuthAuditReaderMockRecorder) ListAuthEvents(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuthEvents", reflect.TypeOf((*MockAuthAuditReader)(nil).ListAuthEvents), ctx, filter)
+}
@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/store/domain/bundles.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/Lexv0lk/merch-store/internal/store/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockBundlesRepository is a mock of BundlesRepository interface.
+type MockBundlesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBundlesRepositoryMockRecorder
+}
+
+// MockBundlesRepositoryMockRecorder is the mock recorder for MockBundlesRepository.
+type MockBundlesRepositoryMockRecorder struct {
+	mock *MockBundlesRepository
+}
+
+// NewMockBundlesRepository creates a new mock instance.
+func NewMockBundlesRepository(ctrl *gomock.Controller) *MockBundlesRepository {
+	mock := &MockBundlesRepository{ctrl: ctrl}
+	mock.recorder = &MockBundlesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBundlesRepository) EXPECT() *MockBundlesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ListBundleComponents mocks base method.
+func (m *MockBundlesRepository) ListBundleComponents(ctx context.Context, bundleID int) ([]domain.BundleComponent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBundleComponents", ctx, bundleID)
+	ret0, _ := ret[0].([]domain.BundleComponent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBundleComponents indicates an expected call of ListBundleComponents.
+func (mr *MockBundlesRepositoryMockRecorder) ListBundleComponents(ctx, bundleID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBundleComponents", reflect.TypeOf((*MockBundlesRepository)(nil).ListBundleComponents), ctx, bundleID)
+}
+
+// ListComponents mocks base method.
+func (m *MockBundlesRepository) ListComponents(ctx context.Context) ([]domain.BundleComponent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListComponents", ctx)
+	ret0, _ := ret[0].([]domain.BundleComponent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListComponents indicates an expected call of ListComponents.
+func (mr *MockBundlesRepositoryMockRecorder) ListComponents(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListComponents", reflect.TypeOf((*MockBundlesRepository)(nil).ListComponents), ctx)
+}
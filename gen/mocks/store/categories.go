@@ -0,0 +1,119 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/store/domain/categories.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	database "github.com/Lexv0lk/merch-store/internal/pkg/database"
+	domain "github.com/Lexv0lk/merch-store/internal/store/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCategoriesRepository is a mock of CategoriesRepository interface.
+type MockCategoriesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCategoriesRepositoryMockRecorder
+}
+
+// MockCategoriesRepositoryMockRecorder is the mock recorder for MockCategoriesRepository.
+type MockCategoriesRepositoryMockRecorder struct {
+	mock *MockCategoriesRepository
+}
+
+// NewMockCategoriesRepository creates a new mock instance.
+func NewMockCategoriesRepository(ctrl *gomock.Controller) *MockCategoriesRepository {
+	mock := &MockCategoriesRepository{ctrl: ctrl}
+	mock.recorder = &MockCategoriesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCategoriesRepository) EXPECT() *MockCategoriesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateCategory mocks base method.
+func (m *MockCategoriesRepository) CreateCategory(ctx context.Context, querier database.Querier, category domain.Category) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCategory", ctx, querier, category)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCategory indicates an expected call of CreateCategory.
+func (mr *MockCategoriesRepositoryMockRecorder) CreateCategory(ctx, querier, category interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*MockCategoriesRepository)(nil).CreateCategory), ctx, querier, category)
+}
+
+// GetCategory mocks base method.
+func (m *MockCategoriesRepository) GetCategory(ctx context.Context, slug string) (domain.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategory", ctx, slug)
+	ret0, _ := ret[0].(domain.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategory indicates an expected call of GetCategory.
+func (mr *MockCategoriesRepositoryMockRecorder) GetCategory(ctx, slug interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategory", reflect.TypeOf((*MockCategoriesRepository)(nil).GetCategory), ctx, slug)
+}
+
+// ListCategories mocks base method.
+func (m *MockCategoriesRepository) ListCategories(ctx context.Context) ([]domain.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCategories", ctx)
+	ret0, _ := ret[0].([]domain.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCategories indicates an expected call of ListCategories.
+func (mr *MockCategoriesRepositoryMockRecorder) ListCategories(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategories", reflect.TypeOf((*MockCategoriesRepository)(nil).ListCategories), ctx)
+}
+
+// MockGoodDetailsUpdater is a mock of GoodDetailsUpdater interface.
+type MockGoodDetailsUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockGoodDetailsUpdaterMockRecorder
+}
+
+// MockGoodDetailsUpdaterMockRecorder is the mock recorder for MockGoodDetailsUpdater.
+type MockGoodDetailsUpdaterMockRecorder struct {
+	mock *MockGoodDetailsUpdater
+}
+
+// NewMockGoodDetailsUpdater creates a new mock instance.
+func NewMockGoodDetailsUpdater(ctrl *gomock.Controller) *MockGoodDetailsUpdater {
+	mock := &MockGoodDetailsUpdater{ctrl: ctrl}
+	mock.recorder = &MockGoodDetailsUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGoodDetailsUpdater) EXPECT() *MockGoodDetailsUpdaterMockRecorder {
+	return m.recorder
+}
+
+// UpdateGoodDetails mocks base method.
+func (m *MockGoodDetailsUpdater) UpdateGoodDetails(ctx context.Context, executor database.Executor, goodID int, details domain.GoodDetails) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGoodDetails", ctx, executor, goodID, details)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateGoodDetails indicates an expected call of UpdateGoodDetails.
+func (mr *MockGoodDetailsUpdaterMockRecorder) UpdateGoodDetails(ctx, executor, goodID, details interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGoodDetails", reflect.TypeOf((*MockGoodDetailsUpdater)(nil).UpdateGoodDetails), ctx, executor, goodID, details)
+}
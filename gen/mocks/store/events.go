@@ -0,0 +1,223 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/store/domain/events.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	database "github.com/Lexv0lk/merch-store/internal/pkg/database"
+	domain "github.com/Lexv0lk/merch-store/internal/store/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockEventOutbox is a mock of EventOutbox interface.
+type MockEventOutbox struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventOutboxMockRecorder
+}
+
+// MockEventOutboxMockRecorder is the mock recorder for MockEventOutbox.
+type MockEventOutboxMockRecorder struct {
+	mock *MockEventOutbox
+}
+
+// NewMockEventOutbox creates a new mock instance.
+func NewMockEventOutbox(ctrl *gomock.Controller) *MockEventOutbox {
+	mock := &MockEventOutbox{ctrl: ctrl}
+	mock.recorder = &MockEventOutboxMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventOutbox) EXPECT() *MockEventOutboxMockRecorder {
+	return m.recorder
+}
+
+// AppendEvent mocks base method.
+func (m *MockEventOutbox) AppendEvent(ctx context.Context, executor database.Executor, event domain.DomainEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendEvent", ctx, executor, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AppendEvent indicates an expected call of AppendEvent.
+func (mr *MockEventOutboxMockRecorder) AppendEvent(ctx, executor, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendEvent", reflect.TypeOf((*MockEventOutbox)(nil).AppendEvent), ctx, executor, event)
+}
+
+// MockEventLog is a mock of EventLog interface.
+type MockEventLog struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventLogMockRecorder
+}
+
+// MockEventLogMockRecorder is the mock recorder for MockEventLog.
+type MockEventLogMockRecorder struct {
+	mock *MockEventLog
+}
+
+// NewMockEventLog creates a new mock instance.
+func NewMockEventLog(ctrl *gomock.Controller) *MockEventLog {
+	mock := &MockEventLog{ctrl: ctrl}
+	mock.recorder = &MockEventLogMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventLog) EXPECT() *MockEventLogMockRecorder {
+	return m.recorder
+}
+
+// FetchEvents mocks base method.
+func (m *MockEventLog) FetchEvents(ctx context.Context, afterOffset int64, limit int) ([]domain.DomainEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchEvents", ctx, afterOffset, limit)
+	ret0, _ := ret[0].([]domain.DomainEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchEvents indicates an expected call of FetchEvents.
+func (mr *MockEventLogMockRecorder) FetchEvents(ctx, afterOffset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchEvents", reflect.TypeOf((*MockEventLog)(nil).FetchEvents), ctx, afterOffset, limit)
+}
+
+// GetLastEventOffset mocks base method.
+func (m *MockEventLog) GetLastEventOffset(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastEventOffset", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastEventOffset indicates an expected call of GetLastEventOffset.
+func (mr *MockEventLogMockRecorder) GetLastEventOffset(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastEventOffset", reflect.TypeOf((*MockEventLog)(nil).GetLastEventOffset), ctx)
+}
+
+// MoveOutboxEvents mocks base method.
+func (m *MockEventLog) MoveOutboxEvents(ctx context.Context, querier database.Querier, limit int) ([]domain.DomainEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveOutboxEvents", ctx, querier, limit)
+	ret0, _ := ret[0].([]domain.DomainEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveOutboxEvents indicates an expected call of MoveOutboxEvents.
+func (mr *MockEventLogMockRecorder) MoveOutboxEvents(ctx, querier, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveOutboxEvents", reflect.TypeOf((*MockEventLog)(nil).MoveOutboxEvents), ctx, querier, limit)
+}
+
+// TryLockRelay mocks base method.
+func (m *MockEventLog) TryLockRelay(ctx context.Context, querier database.Querier) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TryLockRelay", ctx, querier)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TryLockRelay indicates an expected call of TryLockRelay.
+func (mr *MockEventLogMockRecorder) TryLockRelay(ctx, querier interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TryLockRelay", reflect.TypeOf((*MockEventLog)(nil).TryLockRelay), ctx, querier)
+}
+
+// MockEventConsumersRepository is a mock of EventConsumersRepository interface.
+type MockEventConsumersRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventConsumersRepositoryMockRecorder
+}
+
+// MockEventConsumersRepositoryMockRecorder is the mock recorder for MockEventConsumersRepository.
+type MockEventConsumersRepositoryMockRecorder struct {
+	mock *MockEventConsumersRepository
+}
+
+// NewMockEventConsumersRepository creates a new mock instance.
+func NewMockEventConsumersRepository(ctrl *gomock.Controller) *MockEventConsumersRepository {
+	mock := &MockEventConsumersRepository{ctrl: ctrl}
+	mock.recorder = &MockEventConsumersRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventConsumersRepository) EXPECT() *MockEventConsumersRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CommitConsumerOffset mocks base method.
+func (m *MockEventConsumersRepository) CommitConsumerOffset(ctx context.Context, consumer string, offset int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitConsumerOffset", ctx, consumer, offset)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CommitConsumerOffset indicates an expected call of CommitConsumerOffset.
+func (mr *MockEventConsumersRepositoryMockRecorder) CommitConsumerOffset(ctx, consumer, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitConsumerOffset", reflect.TypeOf((*MockEventConsumersRepository)(nil).CommitConsumerOffset), ctx, consumer, offset)
+}
+
+// GetConsumerOffset mocks base method.
+func (m *MockEventConsumersRepository) GetConsumerOffset(ctx context.Context, consumer string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConsumerOffset", ctx, consumer)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConsumerOffset indicates an expected call of GetConsumerOffset.
+func (mr *MockEventConsumersRepositoryMockRecorder) GetConsumerOffset(ctx, consumer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConsumerOffset", reflect.TypeOf((*MockEventConsumersRepository)(nil).GetConsumerOffset), ctx, consumer)
+}
+
+// MockEventSink is a mock of EventSink interface.
+type MockEventSink struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventSinkMockRecorder
+}
+
+// MockEventSinkMockRecorder is the mock recorder for MockEventSink.
+type MockEventSinkMockRecorder struct {
+	mock *MockEventSink
+}
+
+// NewMockEventSink creates a new mock instance.
+func NewMockEventSink(ctrl *gomock.Controller) *MockEventSink {
+	mock := &MockEventSink{ctrl: ctrl}
+	mock.recorder = &MockEventSinkMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventSink) EXPECT() *MockEventSinkMockRecorder {
+	return m.recorder
+}
+
+// PublishEvents mocks base method.
+func (m *MockEventSink) PublishEvents(ctx context.Context, events []domain.DomainEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishEvents", ctx, events)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishEvents indicates an expected call of PublishEvents.
+func (mr *MockEventSinkMockRecorder) PublishEvents(ctx, events interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishEvents", reflect.TypeOf((*MockEventSink)(nil).PublishEvents), ctx, events)
+}
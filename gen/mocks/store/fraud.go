@@ -0,0 +1,165 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/store/domain/fraud.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	database "github.com/Lexv0lk/merch-store/internal/pkg/database"
+	domain "github.com/Lexv0lk/merch-store/internal/store/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockFraudDetector is a mock of FraudDetector interface.
+type MockFraudDetector struct {
+	ctrl     *gomock.Controller
+	recorder *MockFraudDetectorMockRecorder
+}
+
+// MockFraudDetectorMockRecorder is the mock recorder for MockFraudDetector.
+type MockFraudDetectorMockRecorder struct {
+	mock *MockFraudDetector
+}
+
+// NewMockFraudDetector creates a new mock instance.
+func NewMockFraudDetector(ctrl *gomock.Controller) *MockFraudDetector {
+	mock := &MockFraudDetector{ctrl: ctrl}
+	mock.recorder = &MockFraudDetectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFraudDetector) EXPECT() *MockFraudDetectorMockRecorder {
+	return m.recorder
+}
+
+// DetectCircularTransfers mocks base method.
+func (m *MockFraudDetector) DetectCircularTransfers(ctx context.Context, since time.Time, minTransfers int) ([]domain.SuspiciousActivity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetectCircularTransfers", ctx, since, minTransfers)
+	ret0, _ := ret[0].([]domain.SuspiciousActivity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetectCircularTransfers indicates an expected call of DetectCircularTransfers.
+func (mr *MockFraudDetectorMockRecorder) DetectCircularTransfers(ctx, since, minTransfers interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetectCircularTransfers", reflect.TypeOf((*MockFraudDetector)(nil).DetectCircularTransfers), ctx, since, minTransfers)
+}
+
+// DetectNewAccountDrains mocks base method.
+func (m *MockFraudDetector) DetectNewAccountDrains(ctx context.Context, since, createdAfter time.Time, minSenders int) ([]domain.SuspiciousActivity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetectNewAccountDrains", ctx, since, createdAfter, minSenders)
+	ret0, _ := ret[0].([]domain.SuspiciousActivity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetectNewAccountDrains indicates an expected call of DetectNewAccountDrains.
+func (mr *MockFraudDetectorMockRecorder) DetectNewAccountDrains(ctx, since, createdAfter, minSenders interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetectNewAccountDrains", reflect.TypeOf((*MockFraudDetector)(nil).DetectNewAccountDrains), ctx, since, createdAfter, minSenders)
+}
+
+// DetectTransferBursts mocks base method.
+func (m *MockFraudDetector) DetectTransferBursts(ctx context.Context, since time.Time, minTransfers int) ([]domain.SuspiciousActivity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetectTransferBursts", ctx, since, minTransfers)
+	ret0, _ := ret[0].([]domain.SuspiciousActivity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetectTransferBursts indicates an expected call of DetectTransferBursts.
+func (mr *MockFraudDetectorMockRecorder) DetectTransferBursts(ctx, since, minTransfers interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetectTransferBursts", reflect.TypeOf((*MockFraudDetector)(nil).DetectTransferBursts), ctx, since, minTransfers)
+}
+
+// MockFraudCasesRepository is a mock of FraudCasesRepository interface.
+type MockFraudCasesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockFraudCasesRepositoryMockRecorder
+}
+
+// MockFraudCasesRepositoryMockRecorder is the mock recorder for MockFraudCasesRepository.
+type MockFraudCasesRepositoryMockRecorder struct {
+	mock *MockFraudCasesRepository
+}
+
+// NewMockFraudCasesRepository creates a new mock instance.
+func NewMockFraudCasesRepository(ctrl *gomock.Controller) *MockFraudCasesRepository {
+	mock := &MockFraudCasesRepository{ctrl: ctrl}
+	mock.recorder = &MockFraudCasesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFraudCasesRepository) EXPECT() *MockFraudCasesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CloseFraudCase mocks base method.
+func (m *MockFraudCasesRepository) CloseFraudCase(ctx context.Context, executor database.Executor, caseID int, status, resolution string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseFraudCase", ctx, executor, caseID, status, resolution)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseFraudCase indicates an expected call of CloseFraudCase.
+func (mr *MockFraudCasesRepositoryMockRecorder) CloseFraudCase(ctx, executor, caseID, status, resolution interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseFraudCase", reflect.TypeOf((*MockFraudCasesRepository)(nil).CloseFraudCase), ctx, executor, caseID, status, resolution)
+}
+
+// ListFraudCases mocks base method.
+func (m *MockFraudCasesRepository) ListFraudCases(ctx context.Context, status string) ([]domain.FraudCase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFraudCases", ctx, status)
+	ret0, _ := ret[0].([]domain.FraudCase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFraudCases indicates an expected call of ListFraudCases.
+func (mr *MockFraudCasesRepositoryMockRecorder) ListFraudCases(ctx, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFraudCases", reflect.TypeOf((*MockFraudCasesRepository)(nil).ListFraudCases), ctx, status)
+}
+
+// LockAndGetFraudCase mocks base method.
+func (m *MockFraudCasesRepository) LockAndGetFraudCase(ctx context.Context, querier database.Querier, caseID int) (domain.FraudCase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockAndGetFraudCase", ctx, querier, caseID)
+	ret0, _ := ret[0].(domain.FraudCase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockAndGetFraudCase indicates an expected call of LockAndGetFraudCase.
+func (mr *MockFraudCasesRepositoryMockRecorder) LockAndGetFraudCase(ctx, querier, caseID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockAndGetFraudCase", reflect.TypeOf((*MockFraudCasesRepository)(nil).LockAndGetFraudCase), ctx, querier, caseID)
+}
+
+// SaveFraudCases mocks base method.
+func (m *MockFraudCasesRepository) SaveFraudCases(ctx context.Context, activities []domain.SuspiciousActivity) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveFraudCases", ctx, activities)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveFraudCases indicates an expected call of SaveFraudCases.
+func (mr *MockFraudCasesRepositoryMockRecorder) SaveFraudCases(ctx, activities interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveFraudCases", reflect.TypeOf((*MockFraudCasesRepository)(nil).SaveFraudCases), ctx, activities)
+}
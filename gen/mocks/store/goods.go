@@ -8,6 +8,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	database "github.com/Lexv0lk/merch-store/internal/pkg/database"
 	domain "github.com/Lexv0lk/merch-store/internal/store/domain"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -49,3 +50,55 @@ func (mr *MockGoodsRepositoryMockRecorder) GetGoodInfo(ctx, goodName interface{}
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGoodInfo", reflect.TypeOf((*MockGoodsRepository)(nil).GetGoodInfo), ctx, goodName)
 }
+
+// ListGoods mocks base method.
+func (m *MockGoodsRepository) ListGoods(ctx context.Context, category string) ([]domain.CatalogGood, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGoods", ctx, category)
+	ret0, _ := ret[0].([]domain.CatalogGood)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGoods indicates an expected call of ListGoods.
+func (mr *MockGoodsRepositoryMockRecorder) ListGoods(ctx, category interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGoods", reflect.TypeOf((*MockGoodsRepository)(nil).ListGoods), ctx, category)
+}
+
+// MockItemTransferProceeder is a mock of ItemTransferProceeder interface.
+type MockItemTransferProceeder struct {
+	ctrl     *gomock.Controller
+	recorder *MockItemTransferProceederMockRecorder
+}
+
+// MockItemTransferProceederMockRecorder is the mock recorder for MockItemTransferProceeder.
+type MockItemTransferProceederMockRecorder struct {
+	mock *MockItemTransferProceeder
+}
+
+// NewMockItemTransferProceeder creates a new mock instance.
+func NewMockItemTransferProceeder(ctrl *gomock.Controller) *MockItemTransferProceeder {
+	mock := &MockItemTransferProceeder{ctrl: ctrl}
+	mock.recorder = &MockItemTransferProceederMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockItemTransferProceeder) EXPECT() *MockItemTransferProceederMockRecorder {
+	return m.recorder
+}
+
+// ProceedItemTransfer mocks base method.
+func (m *MockItemTransferProceeder) ProceedItemTransfer(ctx context.Context, executor database.Executor, fromUserID, toUserID, goodID, variantID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProceedItemTransfer", ctx, executor, fromUserID, toUserID, goodID, variantID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProceedItemTransfer indicates an expected call of ProceedItemTransfer.
+func (mr *MockItemTransferProceederMockRecorder) ProceedItemTransfer(ctx, executor, fromUserID, toUserID, goodID, variantID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProceedItemTransfer", reflect.TypeOf((*MockItemTransferProceeder)(nil).ProceedItemTransfer), ctx, executor, fromUserID, toUserID, goodID, variantID)
+}
@@ -0,0 +1,229 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/store/domain/marketplace.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	database "github.com/Lexv0lk/merch-store/internal/pkg/database"
+	domain "github.com/Lexv0lk/merch-store/internal/store/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockListingsRepository is a mock of ListingsRepository interface.
+type MockListingsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockListingsRepositoryMockRecorder
+}
+
+// MockListingsRepositoryMockRecorder is the mock recorder for MockListingsRepository.
+type MockListingsRepositoryMockRecorder struct {
+	mock *MockListingsRepository
+}
+
+// NewMockListingsRepository creates a new mock instance.
+func NewMockListingsRepository(ctrl *gomock.Controller) *MockListingsRepository {
+	mock := &MockListingsRepository{ctrl: ctrl}
+	mock.recorder = &MockListingsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListingsRepository) EXPECT() *MockListingsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CancelListing mocks base method.
+func (m *MockListingsRepository) CancelListing(ctx context.Context, sellerID, listingID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelListing", ctx, sellerID, listingID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelListing indicates an expected call of CancelListing.
+func (mr *MockListingsRepositoryMockRecorder) CancelListing(ctx, sellerID, listingID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelListing", reflect.TypeOf((*MockListingsRepository)(nil).CancelListing), ctx, sellerID, listingID)
+}
+
+// CountActiveListings mocks base method.
+func (m *MockListingsRepository) CountActiveListings(ctx context.Context, querier database.Querier, sellerID, goodID, variantID int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountActiveListings", ctx, querier, sellerID, goodID, variantID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountActiveListings indicates an expected call of CountActiveListings.
+func (mr *MockListingsRepositoryMockRecorder) CountActiveListings(ctx, querier, sellerID, goodID, variantID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountActiveListings", reflect.TypeOf((*MockListingsRepository)(nil).CountActiveListings), ctx, querier, sellerID, goodID, variantID)
+}
+
+// CreateListing mocks base method.
+func (m *MockListingsRepository) CreateListing(ctx context.Context, querier database.Querier, listing domain.Listing) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateListing", ctx, querier, listing)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateListing indicates an expected call of CreateListing.
+func (mr *MockListingsRepositoryMockRecorder) CreateListing(ctx, querier, listing interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateListing", reflect.TypeOf((*MockListingsRepository)(nil).CreateListing), ctx, querier, listing)
+}
+
+// SearchListings mocks base method.
+func (m *MockListingsRepository) SearchListings(ctx context.Context, filter domain.ListingFilter) ([]domain.Listing, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchListings", ctx, filter)
+	ret0, _ := ret[0].([]domain.Listing)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchListings indicates an expected call of SearchListings.
+func (mr *MockListingsRepositoryMockRecorder) SearchListings(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchListings", reflect.TypeOf((*MockListingsRepository)(nil).SearchListings), ctx, filter)
+}
+
+// UpdateListingPrice mocks base method.
+func (m *MockListingsRepository) UpdateListingPrice(ctx context.Context, sellerID, listingID int, price uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateListingPrice", ctx, sellerID, listingID, price)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateListingPrice indicates an expected call of UpdateListingPrice.
+func (mr *MockListingsRepositoryMockRecorder) UpdateListingPrice(ctx, sellerID, listingID, price interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateListingPrice", reflect.TypeOf((*MockListingsRepository)(nil).UpdateListingPrice), ctx, sellerID, listingID, price)
+}
+
+// MockListingsSeller is a mock of ListingsSeller interface.
+type MockListingsSeller struct {
+	ctrl     *gomock.Controller
+	recorder *MockListingsSellerMockRecorder
+}
+
+// MockListingsSellerMockRecorder is the mock recorder for MockListingsSeller.
+type MockListingsSellerMockRecorder struct {
+	mock *MockListingsSeller
+}
+
+// NewMockListingsSeller creates a new mock instance.
+func NewMockListingsSeller(ctrl *gomock.Controller) *MockListingsSeller {
+	mock := &MockListingsSeller{ctrl: ctrl}
+	mock.recorder = &MockListingsSellerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListingsSeller) EXPECT() *MockListingsSellerMockRecorder {
+	return m.recorder
+}
+
+// CancelSellerListings mocks base method.
+func (m *MockListingsSeller) CancelSellerListings(ctx context.Context, executor database.Executor, sellerID int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelSellerListings", ctx, executor, sellerID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelSellerListings indicates an expected call of CancelSellerListings.
+func (mr *MockListingsSellerMockRecorder) CancelSellerListings(ctx, executor, sellerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelSellerListings", reflect.TypeOf((*MockListingsSeller)(nil).CancelSellerListings), ctx, executor, sellerID)
+}
+
+// CloseListing mocks base method.
+func (m *MockListingsSeller) CloseListing(ctx context.Context, executor database.Executor, listingID int, status string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseListing", ctx, executor, listingID, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseListing indicates an expected call of CloseListing.
+func (mr *MockListingsSellerMockRecorder) CloseListing(ctx, executor, listingID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseListing", reflect.TypeOf((*MockListingsSeller)(nil).CloseListing), ctx, executor, listingID, status)
+}
+
+// LockAndGetListing mocks base method.
+func (m *MockListingsSeller) LockAndGetListing(ctx context.Context, querier database.Querier, listingID int) (domain.Listing, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockAndGetListing", ctx, querier, listingID)
+	ret0, _ := ret[0].(domain.Listing)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockAndGetListing indicates an expected call of LockAndGetListing.
+func (mr *MockListingsSellerMockRecorder) LockAndGetListing(ctx, querier, listingID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockAndGetListing", reflect.TypeOf((*MockListingsSeller)(nil).LockAndGetListing), ctx, querier, listingID)
+}
+
+// MarkListingSold mocks base method.
+func (m *MockListingsSeller) MarkListingSold(ctx context.Context, executor database.Executor, listingID, buyerID int, fee uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkListingSold", ctx, executor, listingID, buyerID, fee)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkListingSold indicates an expected call of MarkListingSold.
+func (mr *MockListingsSellerMockRecorder) MarkListingSold(ctx, executor, listingID, buyerID, fee interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkListingSold", reflect.TypeOf((*MockListingsSeller)(nil).MarkListingSold), ctx, executor, listingID, buyerID, fee)
+}
+
+// MockInventoryCounter is a mock of InventoryCounter interface.
+type MockInventoryCounter struct {
+	ctrl     *gomock.Controller
+	recorder *MockInventoryCounterMockRecorder
+}
+
+// MockInventoryCounterMockRecorder is the mock recorder for MockInventoryCounter.
+type MockInventoryCounterMockRecorder struct {
+	mock *MockInventoryCounter
+}
+
+// NewMockInventoryCounter creates a new mock instance.
+func NewMockInventoryCounter(ctrl *gomock.Controller) *MockInventoryCounter {
+	mock := &MockInventoryCounter{ctrl: ctrl}
+	mock.recorder = &MockInventoryCounterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInventoryCounter) EXPECT() *MockInventoryCounterMockRecorder {
+	return m.recorder
+}
+
+// CountOwnedItems mocks base method.
+func (m *MockInventoryCounter) CountOwnedItems(ctx context.Context, querier database.Querier, userID, goodID, variantID int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountOwnedItems", ctx, querier, userID, goodID, variantID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountOwnedItems indicates an expected call of CountOwnedItems.
+func (mr *MockInventoryCounterMockRecorder) CountOwnedItems(ctx, querier, userID, goodID, variantID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountOwnedItems", reflect.TypeOf((*MockInventoryCounter)(nil).CountOwnedItems), ctx, querier, userID, goodID, variantID)
+}
@@ -50,6 +50,43 @@ func (mr *MockTransactionProceederMockRecorder) ProceedTransaction(ctx, executor
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProceedTransaction", reflect.TypeOf((*MockTransactionProceeder)(nil).ProceedTransaction), ctx, executor, amount, fromUserID, toUserID)
 }
 
+// MockCompanyPool is a mock of CompanyPool interface.
+type MockCompanyPool struct {
+	ctrl     *gomock.Controller
+	recorder *MockCompanyPoolMockRecorder
+}
+
+// MockCompanyPoolMockRecorder is the mock recorder for MockCompanyPool.
+type MockCompanyPoolMockRecorder struct {
+	mock *MockCompanyPool
+}
+
+// NewMockCompanyPool creates a new mock instance.
+func NewMockCompanyPool(ctrl *gomock.Controller) *MockCompanyPool {
+	mock := &MockCompanyPool{ctrl: ctrl}
+	mock.recorder = &MockCompanyPoolMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCompanyPool) EXPECT() *MockCompanyPoolMockRecorder {
+	return m.recorder
+}
+
+// TransferToPool mocks base method.
+func (m *MockCompanyPool) TransferToPool(ctx context.Context, executor database.Executor, fromUserID int, amount uint32, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferToPool", ctx, executor, fromUserID, amount, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TransferToPool indicates an expected call of TransferToPool.
+func (mr *MockCompanyPoolMockRecorder) TransferToPool(ctx, executor, fromUserID, amount, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferToPool", reflect.TypeOf((*MockCompanyPool)(nil).TransferToPool), ctx, executor, fromUserID, amount, reason)
+}
+
 // MockPurchaser is a mock of Purchaser interface.
 type MockPurchaser struct {
 	ctrl     *gomock.Controller
@@ -73,6 +110,34 @@ func (m *MockPurchaser) EXPECT() *MockPurchaserMockRecorder {
 	return m.recorder
 }
 
+// ProcessBundlePurchase mocks base method.
+func (m *MockPurchaser) ProcessBundlePurchase(ctx context.Context, executor database.Executor, userID int, bundle domain.GoodInfo, components []domain.BundleComponent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessBundlePurchase", ctx, executor, userID, bundle, components)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProcessBundlePurchase indicates an expected call of ProcessBundlePurchase.
+func (mr *MockPurchaserMockRecorder) ProcessBundlePurchase(ctx, executor, userID, bundle, components interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessBundlePurchase", reflect.TypeOf((*MockPurchaser)(nil).ProcessBundlePurchase), ctx, executor, userID, bundle, components)
+}
+
+// ProcessGift mocks base method.
+func (m *MockPurchaser) ProcessGift(ctx context.Context, executor database.Executor, buyerID, recipientID int, good domain.GoodInfo, message string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessGift", ctx, executor, buyerID, recipientID, good, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProcessGift indicates an expected call of ProcessGift.
+func (mr *MockPurchaserMockRecorder) ProcessGift(ctx, executor, buyerID, recipientID, good, message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessGift", reflect.TypeOf((*MockPurchaser)(nil).ProcessGift), ctx, executor, buyerID, recipientID, good, message)
+}
+
 // ProcessPurchase mocks base method.
 func (m *MockPurchaser) ProcessPurchase(ctx context.Context, executor database.Executor, userId int, good domain.GoodInfo) error {
 	m.ctrl.T.Helper()
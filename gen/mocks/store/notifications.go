@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/store/domain/notifications.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockBalanceFetcher is a mock of BalanceFetcher interface.
+type MockBalanceFetcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockBalanceFetcherMockRecorder
+}
+
+// MockBalanceFetcherMockRecorder is the mock recorder for MockBalanceFetcher.
+type MockBalanceFetcherMockRecorder struct {
+	mock *MockBalanceFetcher
+}
+
+// NewMockBalanceFetcher creates a new mock instance.
+func NewMockBalanceFetcher(ctrl *gomock.Controller) *MockBalanceFetcher {
+	mock := &MockBalanceFetcher{ctrl: ctrl}
+	mock.recorder = &MockBalanceFetcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBalanceFetcher) EXPECT() *MockBalanceFetcherMockRecorder {
+	return m.recorder
+}
+
+// FetchUserBalance mocks base method.
+func (m *MockBalanceFetcher) FetchUserBalance(ctx context.Context, userId int) (uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchUserBalance", ctx, userId)
+	ret0, _ := ret[0].(uint32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchUserBalance indicates an expected call of FetchUserBalance.
+func (mr *MockBalanceFetcherMockRecorder) FetchUserBalance(ctx, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchUserBalance", reflect.TypeOf((*MockBalanceFetcher)(nil).FetchUserBalance), ctx, userId)
+}
@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/store/domain/payment_requests.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	database "github.com/Lexv0lk/merch-store/internal/pkg/database"
+	domain "github.com/Lexv0lk/merch-store/internal/store/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPaymentRequestsRepository is a mock of PaymentRequestsRepository interface.
+type MockPaymentRequestsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentRequestsRepositoryMockRecorder
+}
+
+// MockPaymentRequestsRepositoryMockRecorder is the mock recorder for MockPaymentRequestsRepository.
+type MockPaymentRequestsRepositoryMockRecorder struct {
+	mock *MockPaymentRequestsRepository
+}
+
+// NewMockPaymentRequestsRepository creates a new mock instance.
+func NewMockPaymentRequestsRepository(ctrl *gomock.Controller) *MockPaymentRequestsRepository {
+	mock := &MockPaymentRequestsRepository{ctrl: ctrl}
+	mock.recorder = &MockPaymentRequestsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentRequestsRepository) EXPECT() *MockPaymentRequestsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClosePaymentRequest mocks base method.
+func (m *MockPaymentRequestsRepository) ClosePaymentRequest(ctx context.Context, executor database.Executor, requestID int, status string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClosePaymentRequest", ctx, executor, requestID, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClosePaymentRequest indicates an expected call of ClosePaymentRequest.
+func (mr *MockPaymentRequestsRepositoryMockRecorder) ClosePaymentRequest(ctx, executor, requestID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClosePaymentRequest", reflect.TypeOf((*MockPaymentRequestsRepository)(nil).ClosePaymentRequest), ctx, executor, requestID, status)
+}
+
+// CreatePaymentRequest mocks base method.
+func (m *MockPaymentRequestsRepository) CreatePaymentRequest(ctx context.Context, request domain.PaymentRequest) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePaymentRequest", ctx, request)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePaymentRequest indicates an expected call of CreatePaymentRequest.
+func (mr *MockPaymentRequestsRepositoryMockRecorder) CreatePaymentRequest(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePaymentRequest", reflect.TypeOf((*MockPaymentRequestsRepository)(nil).CreatePaymentRequest), ctx, request)
+}
+
+// ListPendingPaymentRequests mocks base method.
+func (m *MockPaymentRequestsRepository) ListPendingPaymentRequests(ctx context.Context, payerID int, now time.Time) ([]domain.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingPaymentRequests", ctx, payerID, now)
+	ret0, _ := ret[0].([]domain.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingPaymentRequests indicates an expected call of ListPendingPaymentRequests.
+func (mr *MockPaymentRequestsRepositoryMockRecorder) ListPendingPaymentRequests(ctx, payerID, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingPaymentRequests", reflect.TypeOf((*MockPaymentRequestsRepository)(nil).ListPendingPaymentRequests), ctx, payerID, now)
+}
+
+// LockAndGetPaymentRequest mocks base method.
+func (m *MockPaymentRequestsRepository) LockAndGetPaymentRequest(ctx context.Context, querier database.Querier, requestID int) (domain.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockAndGetPaymentRequest", ctx, querier, requestID)
+	ret0, _ := ret[0].(domain.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockAndGetPaymentRequest indicates an expected call of LockAndGetPaymentRequest.
+func (mr *MockPaymentRequestsRepositoryMockRecorder) LockAndGetPaymentRequest(ctx, querier, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockAndGetPaymentRequest", reflect.TypeOf((*MockPaymentRequestsRepository)(nil).LockAndGetPaymentRequest), ctx, querier, requestID)
+}
@@ -0,0 +1,245 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/store/domain/preorders.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	database "github.com/Lexv0lk/merch-store/internal/pkg/database"
+	domain "github.com/Lexv0lk/merch-store/internal/store/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPreordersRepository is a mock of PreordersRepository interface.
+type MockPreordersRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPreordersRepositoryMockRecorder
+}
+
+// MockPreordersRepositoryMockRecorder is the mock recorder for MockPreordersRepository.
+type MockPreordersRepositoryMockRecorder struct {
+	mock *MockPreordersRepository
+}
+
+// NewMockPreordersRepository creates a new mock instance.
+func NewMockPreordersRepository(ctrl *gomock.Controller) *MockPreordersRepository {
+	mock := &MockPreordersRepository{ctrl: ctrl}
+	mock.recorder = &MockPreordersRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPreordersRepository) EXPECT() *MockPreordersRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FetchHeldCoins mocks base method.
+func (m *MockPreordersRepository) FetchHeldCoins(ctx context.Context, userID int) (uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchHeldCoins", ctx, userID)
+	ret0, _ := ret[0].(uint32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchHeldCoins indicates an expected call of FetchHeldCoins.
+func (mr *MockPreordersRepositoryMockRecorder) FetchHeldCoins(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchHeldCoins", reflect.TypeOf((*MockPreordersRepository)(nil).FetchHeldCoins), ctx, userID)
+}
+
+// ListUserPreorders mocks base method.
+func (m *MockPreordersRepository) ListUserPreorders(ctx context.Context, userID int) ([]domain.Preorder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserPreorders", ctx, userID)
+	ret0, _ := ret[0].([]domain.Preorder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserPreorders indicates an expected call of ListUserPreorders.
+func (mr *MockPreordersRepositoryMockRecorder) ListUserPreorders(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserPreorders", reflect.TypeOf((*MockPreordersRepository)(nil).ListUserPreorders), ctx, userID)
+}
+
+// MockPreorderProceeder is a mock of PreorderProceeder interface.
+type MockPreorderProceeder struct {
+	ctrl     *gomock.Controller
+	recorder *MockPreorderProceederMockRecorder
+}
+
+// MockPreorderProceederMockRecorder is the mock recorder for MockPreorderProceeder.
+type MockPreorderProceederMockRecorder struct {
+	mock *MockPreorderProceeder
+}
+
+// NewMockPreorderProceeder creates a new mock instance.
+func NewMockPreorderProceeder(ctrl *gomock.Controller) *MockPreorderProceeder {
+	mock := &MockPreorderProceeder{ctrl: ctrl}
+	mock.recorder = &MockPreorderProceederMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPreorderProceeder) EXPECT() *MockPreorderProceederMockRecorder {
+	return m.recorder
+}
+
+// CancelPreorder mocks base method.
+func (m *MockPreorderProceeder) CancelPreorder(ctx context.Context, executor database.Executor, preorder domain.Preorder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelPreorder", ctx, executor, preorder)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelPreorder indicates an expected call of CancelPreorder.
+func (mr *MockPreorderProceederMockRecorder) CancelPreorder(ctx, executor, preorder interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelPreorder", reflect.TypeOf((*MockPreorderProceeder)(nil).CancelPreorder), ctx, executor, preorder)
+}
+
+// FulfillPreorder mocks base method.
+func (m *MockPreorderProceeder) FulfillPreorder(ctx context.Context, executor database.Executor, preorder domain.Preorder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FulfillPreorder", ctx, executor, preorder)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FulfillPreorder indicates an expected call of FulfillPreorder.
+func (mr *MockPreorderProceederMockRecorder) FulfillPreorder(ctx, executor, preorder interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FulfillPreorder", reflect.TypeOf((*MockPreorderProceeder)(nil).FulfillPreorder), ctx, executor, preorder)
+}
+
+// LockAndGetPreorder mocks base method.
+func (m *MockPreorderProceeder) LockAndGetPreorder(ctx context.Context, querier database.Querier, preorderID int) (domain.Preorder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockAndGetPreorder", ctx, querier, preorderID)
+	ret0, _ := ret[0].(domain.Preorder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockAndGetPreorder indicates an expected call of LockAndGetPreorder.
+func (mr *MockPreorderProceederMockRecorder) LockAndGetPreorder(ctx, querier, preorderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockAndGetPreorder", reflect.TypeOf((*MockPreorderProceeder)(nil).LockAndGetPreorder), ctx, querier, preorderID)
+}
+
+// LockPendingPreorders mocks base method.
+func (m *MockPreorderProceeder) LockPendingPreorders(ctx context.Context, querier database.Querier, goodID int) ([]domain.Preorder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockPendingPreorders", ctx, querier, goodID)
+	ret0, _ := ret[0].([]domain.Preorder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockPendingPreorders indicates an expected call of LockPendingPreorders.
+func (mr *MockPreorderProceederMockRecorder) LockPendingPreorders(ctx, querier, goodID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockPendingPreorders", reflect.TypeOf((*MockPreorderProceeder)(nil).LockPendingPreorders), ctx, querier, goodID)
+}
+
+// LockPreorderBalances mocks base method.
+func (m *MockPreorderProceeder) LockPreorderBalances(ctx context.Context, querier database.Querier, goodID int) (map[int]bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockPreorderBalances", ctx, querier, goodID)
+	ret0, _ := ret[0].(map[int]bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockPreorderBalances indicates an expected call of LockPreorderBalances.
+func (mr *MockPreorderProceederMockRecorder) LockPreorderBalances(ctx, querier, goodID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockPreorderBalances", reflect.TypeOf((*MockPreorderProceeder)(nil).LockPreorderBalances), ctx, querier, goodID)
+}
+
+// LockUpcomingGood mocks base method.
+func (m *MockPreorderProceeder) LockUpcomingGood(ctx context.Context, querier database.Querier, goodID int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockUpcomingGood", ctx, querier, goodID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockUpcomingGood indicates an expected call of LockUpcomingGood.
+func (mr *MockPreorderProceederMockRecorder) LockUpcomingGood(ctx, querier, goodID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockUpcomingGood", reflect.TypeOf((*MockPreorderProceeder)(nil).LockUpcomingGood), ctx, querier, goodID)
+}
+
+// LockUserPendingPreorders mocks base method.
+func (m *MockPreorderProceeder) LockUserPendingPreorders(ctx context.Context, querier database.Querier, userID int) ([]domain.Preorder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockUserPendingPreorders", ctx, querier, userID)
+	ret0, _ := ret[0].([]domain.Preorder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockUserPendingPreorders indicates an expected call of LockUserPendingPreorders.
+func (mr *MockPreorderProceederMockRecorder) LockUserPendingPreorders(ctx, querier, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockUserPendingPreorders", reflect.TypeOf((*MockPreorderProceeder)(nil).LockUserPendingPreorders), ctx, querier, userID)
+}
+
+// PlacePreorder mocks base method.
+func (m *MockPreorderProceeder) PlacePreorder(ctx context.Context, executor database.QueryExecuter, preorder domain.Preorder) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlacePreorder", ctx, executor, preorder)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlacePreorder indicates an expected call of PlacePreorder.
+func (mr *MockPreorderProceederMockRecorder) PlacePreorder(ctx, executor, preorder interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlacePreorder", reflect.TypeOf((*MockPreorderProceeder)(nil).PlacePreorder), ctx, executor, preorder)
+}
+
+// MockGoodAvailabilityUpdater is a mock of GoodAvailabilityUpdater interface.
+type MockGoodAvailabilityUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockGoodAvailabilityUpdaterMockRecorder
+}
+
+// MockGoodAvailabilityUpdaterMockRecorder is the mock recorder for MockGoodAvailabilityUpdater.
+type MockGoodAvailabilityUpdaterMockRecorder struct {
+	mock *MockGoodAvailabilityUpdater
+}
+
+// NewMockGoodAvailabilityUpdater creates a new mock instance.
+func NewMockGoodAvailabilityUpdater(ctrl *gomock.Controller) *MockGoodAvailabilityUpdater {
+	mock := &MockGoodAvailabilityUpdater{ctrl: ctrl}
+	mock.recorder = &MockGoodAvailabilityUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGoodAvailabilityUpdater) EXPECT() *MockGoodAvailabilityUpdaterMockRecorder {
+	return m.recorder
+}
+
+// SetGoodUpcoming mocks base method.
+func (m *MockGoodAvailabilityUpdater) SetGoodUpcoming(ctx context.Context, executor database.Executor, goodID int, upcoming bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetGoodUpcoming", ctx, executor, goodID, upcoming)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetGoodUpcoming indicates an expected call of SetGoodUpcoming.
+func (mr *MockGoodAvailabilityUpdaterMockRecorder) SetGoodUpcoming(ctx, executor, goodID, upcoming interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGoodUpcoming", reflect.TypeOf((*MockGoodAvailabilityUpdater)(nil).SetGoodUpcoming), ctx, executor, goodID, upcoming)
+}
@@ -0,0 +1,67 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/store/domain/prices.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	database "github.com/Lexv0lk/merch-store/internal/pkg/database"
+	domain "github.com/Lexv0lk/merch-store/internal/store/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPriceSchedulesRepository is a mock of PriceSchedulesRepository interface.
+type MockPriceSchedulesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPriceSchedulesRepositoryMockRecorder
+}
+
+// MockPriceSchedulesRepositoryMockRecorder is the mock recorder for MockPriceSchedulesRepository.
+type MockPriceSchedulesRepositoryMockRecorder struct {
+	mock *MockPriceSchedulesRepository
+}
+
+// NewMockPriceSchedulesRepository creates a new mock instance.
+func NewMockPriceSchedulesRepository(ctrl *gomock.Controller) *MockPriceSchedulesRepository {
+	mock := &MockPriceSchedulesRepository{ctrl: ctrl}
+	mock.recorder = &MockPriceSchedulesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPriceSchedulesRepository) EXPECT() *MockPriceSchedulesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreatePriceSchedule mocks base method.
+func (m *MockPriceSchedulesRepository) CreatePriceSchedule(ctx context.Context, querier database.Querier, schedule domain.PriceSchedule) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePriceSchedule", ctx, querier, schedule)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePriceSchedule indicates an expected call of CreatePriceSchedule.
+func (mr *MockPriceSchedulesRepositoryMockRecorder) CreatePriceSchedule(ctx, querier, schedule interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePriceSchedule", reflect.TypeOf((*MockPriceSchedulesRepository)(nil).CreatePriceSchedule), ctx, querier, schedule)
+}
+
+// ListPriceSchedules mocks base method.
+func (m *MockPriceSchedulesRepository) ListPriceSchedules(ctx context.Context, goodID int) ([]domain.PriceSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPriceSchedules", ctx, goodID)
+	ret0, _ := ret[0].([]domain.PriceSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPriceSchedules indicates an expected call of ListPriceSchedules.
+func (mr *MockPriceSchedulesRepositoryMockRecorder) ListPriceSchedules(ctx, goodID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPriceSchedules", reflect.TypeOf((*MockPriceSchedulesRepository)(nil).ListPriceSchedules), ctx, goodID)
+}
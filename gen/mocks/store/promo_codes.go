@@ -0,0 +1,134 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/store/domain/promo_codes.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	database "github.com/Lexv0lk/merch-store/internal/pkg/database"
+	domain "github.com/Lexv0lk/merch-store/internal/store/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPromoCodesRepository is a mock of PromoCodesRepository interface.
+type MockPromoCodesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPromoCodesRepositoryMockRecorder
+}
+
+// MockPromoCodesRepositoryMockRecorder is the mock recorder for MockPromoCodesRepository.
+type MockPromoCodesRepositoryMockRecorder struct {
+	mock *MockPromoCodesRepository
+}
+
+// NewMockPromoCodesRepository creates a new mock instance.
+func NewMockPromoCodesRepository(ctrl *gomock.Controller) *MockPromoCodesRepository {
+	mock := &MockPromoCodesRepository{ctrl: ctrl}
+	mock.recorder = &MockPromoCodesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPromoCodesRepository) EXPECT() *MockPromoCodesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreatePromoCode mocks base method.
+func (m *MockPromoCodesRepository) CreatePromoCode(ctx context.Context, querier database.Querier, promo domain.PromoCode) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePromoCode", ctx, querier, promo)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePromoCode indicates an expected call of CreatePromoCode.
+func (mr *MockPromoCodesRepositoryMockRecorder) CreatePromoCode(ctx, querier, promo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePromoCode", reflect.TypeOf((*MockPromoCodesRepository)(nil).CreatePromoCode), ctx, querier, promo)
+}
+
+// GetPromoCode mocks base method.
+func (m *MockPromoCodesRepository) GetPromoCode(ctx context.Context, code string) (domain.PromoCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPromoCode", ctx, code)
+	ret0, _ := ret[0].(domain.PromoCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPromoCode indicates an expected call of GetPromoCode.
+func (mr *MockPromoCodesRepositoryMockRecorder) GetPromoCode(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPromoCode", reflect.TypeOf((*MockPromoCodesRepository)(nil).GetPromoCode), ctx, code)
+}
+
+// MockPromoRedeemer is a mock of PromoRedeemer interface.
+type MockPromoRedeemer struct {
+	ctrl     *gomock.Controller
+	recorder *MockPromoRedeemerMockRecorder
+}
+
+// MockPromoRedeemerMockRecorder is the mock recorder for MockPromoRedeemer.
+type MockPromoRedeemerMockRecorder struct {
+	mock *MockPromoRedeemer
+}
+
+// NewMockPromoRedeemer creates a new mock instance.
+func NewMockPromoRedeemer(ctrl *gomock.Controller) *MockPromoRedeemer {
+	mock := &MockPromoRedeemer{ctrl: ctrl}
+	mock.recorder = &MockPromoRedeemerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPromoRedeemer) EXPECT() *MockPromoRedeemerMockRecorder {
+	return m.recorder
+}
+
+// CountUserRedemptions mocks base method.
+func (m *MockPromoRedeemer) CountUserRedemptions(ctx context.Context, querier database.Querier, promoID, userID int) (uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUserRedemptions", ctx, querier, promoID, userID)
+	ret0, _ := ret[0].(uint32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUserRedemptions indicates an expected call of CountUserRedemptions.
+func (mr *MockPromoRedeemerMockRecorder) CountUserRedemptions(ctx, querier, promoID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUserRedemptions", reflect.TypeOf((*MockPromoRedeemer)(nil).CountUserRedemptions), ctx, querier, promoID, userID)
+}
+
+// LockAndGetPromoCode mocks base method.
+func (m *MockPromoRedeemer) LockAndGetPromoCode(ctx context.Context, querier database.Querier, code string) (domain.PromoCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockAndGetPromoCode", ctx, querier, code)
+	ret0, _ := ret[0].(domain.PromoCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockAndGetPromoCode indicates an expected call of LockAndGetPromoCode.
+func (mr *MockPromoRedeemerMockRecorder) LockAndGetPromoCode(ctx, querier, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockAndGetPromoCode", reflect.TypeOf((*MockPromoRedeemer)(nil).LockAndGetPromoCode), ctx, querier, code)
+}
+
+// RecordRedemption mocks base method.
+func (m *MockPromoRedeemer) RecordRedemption(ctx context.Context, executor database.Executor, promoID, userID, goodID int, discount uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordRedemption", ctx, executor, promoID, userID, goodID, discount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordRedemption indicates an expected call of RecordRedemption.
+func (mr *MockPromoRedeemerMockRecorder) RecordRedemption(ctx, executor, promoID, userID, goodID, discount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordRedemption", reflect.TypeOf((*MockPromoRedeemer)(nil).RecordRedemption), ctx, executor, promoID, userID, goodID, discount)
+}
@@ -0,0 +1,119 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/store/domain/purchase_limits.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	database "github.com/Lexv0lk/merch-store/internal/pkg/database"
+	domain "github.com/Lexv0lk/merch-store/internal/store/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPurchaseLimitsRepository is a mock of PurchaseLimitsRepository interface.
+type MockPurchaseLimitsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPurchaseLimitsRepositoryMockRecorder
+}
+
+// MockPurchaseLimitsRepositoryMockRecorder is the mock recorder for MockPurchaseLimitsRepository.
+type MockPurchaseLimitsRepositoryMockRecorder struct {
+	mock *MockPurchaseLimitsRepository
+}
+
+// NewMockPurchaseLimitsRepository creates a new mock instance.
+func NewMockPurchaseLimitsRepository(ctrl *gomock.Controller) *MockPurchaseLimitsRepository {
+	mock := &MockPurchaseLimitsRepository{ctrl: ctrl}
+	mock.recorder = &MockPurchaseLimitsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPurchaseLimitsRepository) EXPECT() *MockPurchaseLimitsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// RemovePurchaseLimit mocks base method.
+func (m *MockPurchaseLimitsRepository) RemovePurchaseLimit(ctx context.Context, executor database.Executor, goodID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePurchaseLimit", ctx, executor, goodID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemovePurchaseLimit indicates an expected call of RemovePurchaseLimit.
+func (mr *MockPurchaseLimitsRepositoryMockRecorder) RemovePurchaseLimit(ctx, executor, goodID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePurchaseLimit", reflect.TypeOf((*MockPurchaseLimitsRepository)(nil).RemovePurchaseLimit), ctx, executor, goodID)
+}
+
+// SetPurchaseLimit mocks base method.
+func (m *MockPurchaseLimitsRepository) SetPurchaseLimit(ctx context.Context, executor database.Executor, limit domain.PurchaseLimit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPurchaseLimit", ctx, executor, limit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPurchaseLimit indicates an expected call of SetPurchaseLimit.
+func (mr *MockPurchaseLimitsRepositoryMockRecorder) SetPurchaseLimit(ctx, executor, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPurchaseLimit", reflect.TypeOf((*MockPurchaseLimitsRepository)(nil).SetPurchaseLimit), ctx, executor, limit)
+}
+
+// MockPurchaseLimitChecker is a mock of PurchaseLimitChecker interface.
+type MockPurchaseLimitChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockPurchaseLimitCheckerMockRecorder
+}
+
+// MockPurchaseLimitCheckerMockRecorder is the mock recorder for MockPurchaseLimitChecker.
+type MockPurchaseLimitCheckerMockRecorder struct {
+	mock *MockPurchaseLimitChecker
+}
+
+// NewMockPurchaseLimitChecker creates a new mock instance.
+func NewMockPurchaseLimitChecker(ctrl *gomock.Controller) *MockPurchaseLimitChecker {
+	mock := &MockPurchaseLimitChecker{ctrl: ctrl}
+	mock.recorder = &MockPurchaseLimitCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPurchaseLimitChecker) EXPECT() *MockPurchaseLimitCheckerMockRecorder {
+	return m.recorder
+}
+
+// CountUserAcquisitions mocks base method.
+func (m *MockPurchaseLimitChecker) CountUserAcquisitions(ctx context.Context, querier database.Querier, userID, goodID int, since time.Time) (uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUserAcquisitions", ctx, querier, userID, goodID, since)
+	ret0, _ := ret[0].(uint32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUserAcquisitions indicates an expected call of CountUserAcquisitions.
+func (mr *MockPurchaseLimitCheckerMockRecorder) CountUserAcquisitions(ctx, querier, userID, goodID, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUserAcquisitions", reflect.TypeOf((*MockPurchaseLimitChecker)(nil).CountUserAcquisitions), ctx, querier, userID, goodID, since)
+}
+
+// ListPurchaseLimits mocks base method.
+func (m *MockPurchaseLimitChecker) ListPurchaseLimits(ctx context.Context, querier database.Querier, goodIDs []int) ([]domain.PurchaseLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPurchaseLimits", ctx, querier, goodIDs)
+	ret0, _ := ret[0].([]domain.PurchaseLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPurchaseLimits indicates an expected call of ListPurchaseLimits.
+func (mr *MockPurchaseLimitCheckerMockRecorder) ListPurchaseLimits(ctx, querier, goodIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPurchaseLimits", reflect.TypeOf((*MockPurchaseLimitChecker)(nil).ListPurchaseLimits), ctx, querier, goodIDs)
+}
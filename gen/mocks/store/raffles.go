@@ -0,0 +1,245 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/store/domain/raffles.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	database "github.com/Lexv0lk/merch-store/internal/pkg/database"
+	domain "github.com/Lexv0lk/merch-store/internal/store/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRafflesRepository is a mock of RafflesRepository interface.
+type MockRafflesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRafflesRepositoryMockRecorder
+}
+
+// MockRafflesRepositoryMockRecorder is the mock recorder for MockRafflesRepository.
+type MockRafflesRepositoryMockRecorder struct {
+	mock *MockRafflesRepository
+}
+
+// NewMockRafflesRepository creates a new mock instance.
+func NewMockRafflesRepository(ctrl *gomock.Controller) *MockRafflesRepository {
+	mock := &MockRafflesRepository{ctrl: ctrl}
+	mock.recorder = &MockRafflesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRafflesRepository) EXPECT() *MockRafflesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateRaffle mocks base method.
+func (m *MockRafflesRepository) CreateRaffle(ctx context.Context, querier database.Querier, raffle domain.Raffle) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRaffle", ctx, querier, raffle)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRaffle indicates an expected call of CreateRaffle.
+func (mr *MockRafflesRepositoryMockRecorder) CreateRaffle(ctx, querier, raffle interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRaffle", reflect.TypeOf((*MockRafflesRepository)(nil).CreateRaffle), ctx, querier, raffle)
+}
+
+// GetRaffle mocks base method.
+func (m *MockRafflesRepository) GetRaffle(ctx context.Context, raffleID int) (domain.Raffle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRaffle", ctx, raffleID)
+	ret0, _ := ret[0].(domain.Raffle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRaffle indicates an expected call of GetRaffle.
+func (mr *MockRafflesRepositoryMockRecorder) GetRaffle(ctx, raffleID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRaffle", reflect.TypeOf((*MockRafflesRepository)(nil).GetRaffle), ctx, raffleID)
+}
+
+// ListOpenRaffles mocks base method.
+func (m *MockRafflesRepository) ListOpenRaffles(ctx context.Context) ([]domain.Raffle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOpenRaffles", ctx)
+	ret0, _ := ret[0].([]domain.Raffle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOpenRaffles indicates an expected call of ListOpenRaffles.
+func (mr *MockRafflesRepositoryMockRecorder) ListOpenRaffles(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOpenRaffles", reflect.TypeOf((*MockRafflesRepository)(nil).ListOpenRaffles), ctx)
+}
+
+// MockRaffleTicketsProceeder is a mock of RaffleTicketsProceeder interface.
+type MockRaffleTicketsProceeder struct {
+	ctrl     *gomock.Controller
+	recorder *MockRaffleTicketsProceederMockRecorder
+}
+
+// MockRaffleTicketsProceederMockRecorder is the mock recorder for MockRaffleTicketsProceeder.
+type MockRaffleTicketsProceederMockRecorder struct {
+	mock *MockRaffleTicketsProceeder
+}
+
+// NewMockRaffleTicketsProceeder creates a new mock instance.
+func NewMockRaffleTicketsProceeder(ctrl *gomock.Controller) *MockRaffleTicketsProceeder {
+	mock := &MockRaffleTicketsProceeder{ctrl: ctrl}
+	mock.recorder = &MockRaffleTicketsProceederMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRaffleTicketsProceeder) EXPECT() *MockRaffleTicketsProceederMockRecorder {
+	return m.recorder
+}
+
+// IssueTickets mocks base method.
+func (m *MockRaffleTicketsProceeder) IssueTickets(ctx context.Context, executor database.Executor, raffleID, userID int, count, totalPrice uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueTickets", ctx, executor, raffleID, userID, count, totalPrice)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IssueTickets indicates an expected call of IssueTickets.
+func (mr *MockRaffleTicketsProceederMockRecorder) IssueTickets(ctx, executor, raffleID, userID, count, totalPrice interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueTickets", reflect.TypeOf((*MockRaffleTicketsProceeder)(nil).IssueTickets), ctx, executor, raffleID, userID, count, totalPrice)
+}
+
+// LockAndGetRaffle mocks base method.
+func (m *MockRaffleTicketsProceeder) LockAndGetRaffle(ctx context.Context, querier database.Querier, raffleID int) (domain.Raffle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockAndGetRaffle", ctx, querier, raffleID)
+	ret0, _ := ret[0].(domain.Raffle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockAndGetRaffle indicates an expected call of LockAndGetRaffle.
+func (mr *MockRaffleTicketsProceederMockRecorder) LockAndGetRaffle(ctx, querier, raffleID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockAndGetRaffle", reflect.TypeOf((*MockRaffleTicketsProceeder)(nil).LockAndGetRaffle), ctx, querier, raffleID)
+}
+
+// RefundUserTickets mocks base method.
+func (m *MockRaffleTicketsProceeder) RefundUserTickets(ctx context.Context, executor database.QueryExecuter, userID int) (uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundUserTickets", ctx, executor, userID)
+	ret0, _ := ret[0].(uint32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefundUserTickets indicates an expected call of RefundUserTickets.
+func (mr *MockRaffleTicketsProceederMockRecorder) RefundUserTickets(ctx, executor, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundUserTickets", reflect.TypeOf((*MockRaffleTicketsProceeder)(nil).RefundUserTickets), ctx, executor, userID)
+}
+
+// MockRaffleDrawer is a mock of RaffleDrawer interface.
+type MockRaffleDrawer struct {
+	ctrl     *gomock.Controller
+	recorder *MockRaffleDrawerMockRecorder
+}
+
+// MockRaffleDrawerMockRecorder is the mock recorder for MockRaffleDrawer.
+type MockRaffleDrawerMockRecorder struct {
+	mock *MockRaffleDrawer
+}
+
+// NewMockRaffleDrawer creates a new mock instance.
+func NewMockRaffleDrawer(ctrl *gomock.Controller) *MockRaffleDrawer {
+	mock := &MockRaffleDrawer{ctrl: ctrl}
+	mock.recorder = &MockRaffleDrawerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRaffleDrawer) EXPECT() *MockRaffleDrawerMockRecorder {
+	return m.recorder
+}
+
+// CloseVoidRaffle mocks base method.
+func (m *MockRaffleDrawer) CloseVoidRaffle(ctx context.Context, executor database.Executor, raffleID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseVoidRaffle", ctx, executor, raffleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseVoidRaffle indicates an expected call of CloseVoidRaffle.
+func (mr *MockRaffleDrawerMockRecorder) CloseVoidRaffle(ctx, executor, raffleID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseVoidRaffle", reflect.TypeOf((*MockRaffleDrawer)(nil).CloseVoidRaffle), ctx, executor, raffleID)
+}
+
+// CompleteDraw mocks base method.
+func (m *MockRaffleDrawer) CompleteDraw(ctx context.Context, executor database.Executor, raffle domain.Raffle, draw domain.RaffleDraw) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteDraw", ctx, executor, raffle, draw)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteDraw indicates an expected call of CompleteDraw.
+func (mr *MockRaffleDrawerMockRecorder) CompleteDraw(ctx, executor, raffle, draw interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteDraw", reflect.TypeOf((*MockRaffleDrawer)(nil).CompleteDraw), ctx, executor, raffle, draw)
+}
+
+// FetchDueRaffles mocks base method.
+func (m *MockRaffleDrawer) FetchDueRaffles(ctx context.Context, now time.Time, limit int) ([]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchDueRaffles", ctx, now, limit)
+	ret0, _ := ret[0].([]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchDueRaffles indicates an expected call of FetchDueRaffles.
+func (mr *MockRaffleDrawerMockRecorder) FetchDueRaffles(ctx, now, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchDueRaffles", reflect.TypeOf((*MockRaffleDrawer)(nil).FetchDueRaffles), ctx, now, limit)
+}
+
+// FetchTickets mocks base method.
+func (m *MockRaffleDrawer) FetchTickets(ctx context.Context, querier database.Querier, raffleID int) ([]domain.RaffleTicket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchTickets", ctx, querier, raffleID)
+	ret0, _ := ret[0].([]domain.RaffleTicket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchTickets indicates an expected call of FetchTickets.
+func (mr *MockRaffleDrawerMockRecorder) FetchTickets(ctx, querier, raffleID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTickets", reflect.TypeOf((*MockRaffleDrawer)(nil).FetchTickets), ctx, querier, raffleID)
+}
+
+// RefundTickets mocks base method.
+func (m *MockRaffleDrawer) RefundTickets(ctx context.Context, executor database.Executor, raffle domain.Raffle, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundTickets", ctx, executor, raffle, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefundTickets indicates an expected call of RefundTickets.
+func (mr *MockRaffleDrawerMockRecorder) RefundTickets(ctx, executor, raffle, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundTickets", reflect.TypeOf((*MockRaffleDrawer)(nil).RefundTickets), ctx, executor, raffle, userID)
+}
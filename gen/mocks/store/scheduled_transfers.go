@@ -0,0 +1,179 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/store/domain/scheduled_transfers.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	database "github.com/Lexv0lk/merch-store/internal/pkg/database"
+	domain "github.com/Lexv0lk/merch-store/internal/store/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockScheduledTransfersRepository is a mock of ScheduledTransfersRepository interface.
+type MockScheduledTransfersRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockScheduledTransfersRepositoryMockRecorder
+}
+
+// MockScheduledTransfersRepositoryMockRecorder is the mock recorder for MockScheduledTransfersRepository.
+type MockScheduledTransfersRepositoryMockRecorder struct {
+	mock *MockScheduledTransfersRepository
+}
+
+// NewMockScheduledTransfersRepository creates a new mock instance.
+func NewMockScheduledTransfersRepository(ctrl *gomock.Controller) *MockScheduledTransfersRepository {
+	mock := &MockScheduledTransfersRepository{ctrl: ctrl}
+	mock.recorder = &MockScheduledTransfersRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScheduledTransfersRepository) EXPECT() *MockScheduledTransfersRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CancelScheduledTransfer mocks base method.
+func (m *MockScheduledTransfersRepository) CancelScheduledTransfer(ctx context.Context, senderID, transferID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelScheduledTransfer", ctx, senderID, transferID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelScheduledTransfer indicates an expected call of CancelScheduledTransfer.
+func (mr *MockScheduledTransfersRepositoryMockRecorder) CancelScheduledTransfer(ctx, senderID, transferID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelScheduledTransfer", reflect.TypeOf((*MockScheduledTransfersRepository)(nil).CancelScheduledTransfer), ctx, senderID, transferID)
+}
+
+// CountActiveScheduledTransfers mocks base method.
+func (m *MockScheduledTransfersRepository) CountActiveScheduledTransfers(ctx context.Context, senderID int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountActiveScheduledTransfers", ctx, senderID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountActiveScheduledTransfers indicates an expected call of CountActiveScheduledTransfers.
+func (mr *MockScheduledTransfersRepositoryMockRecorder) CountActiveScheduledTransfers(ctx, senderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountActiveScheduledTransfers", reflect.TypeOf((*MockScheduledTransfersRepository)(nil).CountActiveScheduledTransfers), ctx, senderID)
+}
+
+// CreateScheduledTransfer mocks base method.
+func (m *MockScheduledTransfersRepository) CreateScheduledTransfer(ctx context.Context, transfer domain.ScheduledTransfer) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateScheduledTransfer", ctx, transfer)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateScheduledTransfer indicates an expected call of CreateScheduledTransfer.
+func (mr *MockScheduledTransfersRepositoryMockRecorder) CreateScheduledTransfer(ctx, transfer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateScheduledTransfer", reflect.TypeOf((*MockScheduledTransfersRepository)(nil).CreateScheduledTransfer), ctx, transfer)
+}
+
+// ListScheduledTransfers mocks base method.
+func (m *MockScheduledTransfersRepository) ListScheduledTransfers(ctx context.Context, senderID int) ([]domain.ScheduledTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListScheduledTransfers", ctx, senderID)
+	ret0, _ := ret[0].([]domain.ScheduledTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListScheduledTransfers indicates an expected call of ListScheduledTransfers.
+func (mr *MockScheduledTransfersRepositoryMockRecorder) ListScheduledTransfers(ctx, senderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListScheduledTransfers", reflect.TypeOf((*MockScheduledTransfersRepository)(nil).ListScheduledTransfers), ctx, senderID)
+}
+
+// MockScheduledTransfersExecutor is a mock of ScheduledTransfersExecutor interface.
+type MockScheduledTransfersExecutor struct {
+	ctrl     *gomock.Controller
+	recorder *MockScheduledTransfersExecutorMockRecorder
+}
+
+// MockScheduledTransfersExecutorMockRecorder is the mock recorder for MockScheduledTransfersExecutor.
+type MockScheduledTransfersExecutorMockRecorder struct {
+	mock *MockScheduledTransfersExecutor
+}
+
+// NewMockScheduledTransfersExecutor creates a new mock instance.
+func NewMockScheduledTransfersExecutor(ctrl *gomock.Controller) *MockScheduledTransfersExecutor {
+	mock := &MockScheduledTransfersExecutor{ctrl: ctrl}
+	mock.recorder = &MockScheduledTransfersExecutorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScheduledTransfersExecutor) EXPECT() *MockScheduledTransfersExecutorMockRecorder {
+	return m.recorder
+}
+
+// CancelSenderScheduledTransfers mocks base method.
+func (m *MockScheduledTransfersExecutor) CancelSenderScheduledTransfers(ctx context.Context, executor database.Executor, senderID int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelSenderScheduledTransfers", ctx, executor, senderID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelSenderScheduledTransfers indicates an expected call of CancelSenderScheduledTransfers.
+func (mr *MockScheduledTransfersExecutorMockRecorder) CancelSenderScheduledTransfers(ctx, executor, senderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelSenderScheduledTransfers", reflect.TypeOf((*MockScheduledTransfersExecutor)(nil).CancelSenderScheduledTransfers), ctx, executor, senderID)
+}
+
+// FetchDueScheduledTransfers mocks base method.
+func (m *MockScheduledTransfersExecutor) FetchDueScheduledTransfers(ctx context.Context, now time.Time, limit int) ([]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchDueScheduledTransfers", ctx, now, limit)
+	ret0, _ := ret[0].([]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchDueScheduledTransfers indicates an expected call of FetchDueScheduledTransfers.
+func (mr *MockScheduledTransfersExecutorMockRecorder) FetchDueScheduledTransfers(ctx, now, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchDueScheduledTransfers", reflect.TypeOf((*MockScheduledTransfersExecutor)(nil).FetchDueScheduledTransfers), ctx, now, limit)
+}
+
+// LockAndGetScheduledTransfer mocks base method.
+func (m *MockScheduledTransfersExecutor) LockAndGetScheduledTransfer(ctx context.Context, querier database.Querier, transferID int) (domain.ScheduledTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockAndGetScheduledTransfer", ctx, querier, transferID)
+	ret0, _ := ret[0].(domain.ScheduledTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockAndGetScheduledTransfer indicates an expected call of LockAndGetScheduledTransfer.
+func (mr *MockScheduledTransfersExecutorMockRecorder) LockAndGetScheduledTransfer(ctx, querier, transferID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockAndGetScheduledTransfer", reflect.TypeOf((*MockScheduledTransfersExecutor)(nil).LockAndGetScheduledTransfer), ctx, querier, transferID)
+}
+
+// UpdateScheduledTransfer mocks base method.
+func (m *MockScheduledTransfersExecutor) UpdateScheduledTransfer(ctx context.Context, executor database.Executor, transfer domain.ScheduledTransfer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateScheduledTransfer", ctx, executor, transfer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateScheduledTransfer indicates an expected call of UpdateScheduledTransfer.
+func (mr *MockScheduledTransfersExecutorMockRecorder) UpdateScheduledTransfer(ctx, executor, transfer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateScheduledTransfer", reflect.TypeOf((*MockScheduledTransfersExecutor)(nil).UpdateScheduledTransfer), ctx, executor, transfer)
+}
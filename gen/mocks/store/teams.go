@@ -0,0 +1,232 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/store/domain/teams.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	database "github.com/Lexv0lk/merch-store/internal/pkg/database"
+	domain "github.com/Lexv0lk/merch-store/internal/store/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTeamsRepository is a mock of TeamsRepository interface.
+type MockTeamsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTeamsRepositoryMockRecorder
+}
+
+// MockTeamsRepositoryMockRecorder is the mock recorder for MockTeamsRepository.
+type MockTeamsRepositoryMockRecorder struct {
+	mock *MockTeamsRepository
+}
+
+// NewMockTeamsRepository creates a new mock instance.
+func NewMockTeamsRepository(ctrl *gomock.Controller) *MockTeamsRepository {
+	mock := &MockTeamsRepository{ctrl: ctrl}
+	mock.recorder = &MockTeamsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTeamsRepository) EXPECT() *MockTeamsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddTeamMember mocks base method.
+func (m *MockTeamsRepository) AddTeamMember(ctx context.Context, executor database.QueryExecuter, teamName string, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTeamMember", ctx, executor, teamName, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddTeamMember indicates an expected call of AddTeamMember.
+func (mr *MockTeamsRepositoryMockRecorder) AddTeamMember(ctx, executor, teamName, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTeamMember", reflect.TypeOf((*MockTeamsRepository)(nil).AddTeamMember), ctx, executor, teamName, userID)
+}
+
+// CreateTeam mocks base method.
+func (m *MockTeamsRepository) CreateTeam(ctx context.Context, querier database.Querier, name string, managerID int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTeam", ctx, querier, name, managerID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTeam indicates an expected call of CreateTeam.
+func (mr *MockTeamsRepositoryMockRecorder) CreateTeam(ctx, querier, name, managerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTeam", reflect.TypeOf((*MockTeamsRepository)(nil).CreateTeam), ctx, querier, name, managerID)
+}
+
+// SetBudgetTopUp mocks base method.
+func (m *MockTeamsRepository) SetBudgetTopUp(ctx context.Context, executor database.Executor, teamName string, amount uint32, period time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBudgetTopUp", ctx, executor, teamName, amount, period)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetBudgetTopUp indicates an expected call of SetBudgetTopUp.
+func (mr *MockTeamsRepositoryMockRecorder) SetBudgetTopUp(ctx, executor, teamName, amount, period interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBudgetTopUp", reflect.TypeOf((*MockTeamsRepository)(nil).SetBudgetTopUp), ctx, executor, teamName, amount, period)
+}
+
+// MockTeamBudgetLocker is a mock of TeamBudgetLocker interface.
+type MockTeamBudgetLocker struct {
+	ctrl     *gomock.Controller
+	recorder *MockTeamBudgetLockerMockRecorder
+}
+
+// MockTeamBudgetLockerMockRecorder is the mock recorder for MockTeamBudgetLocker.
+type MockTeamBudgetLockerMockRecorder struct {
+	mock *MockTeamBudgetLocker
+}
+
+// NewMockTeamBudgetLocker creates a new mock instance.
+func NewMockTeamBudgetLocker(ctrl *gomock.Controller) *MockTeamBudgetLocker {
+	mock := &MockTeamBudgetLocker{ctrl: ctrl}
+	mock.recorder = &MockTeamBudgetLockerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTeamBudgetLocker) EXPECT() *MockTeamBudgetLockerMockRecorder {
+	return m.recorder
+}
+
+// LockAndGetTeam mocks base method.
+func (m *MockTeamBudgetLocker) LockAndGetTeam(ctx context.Context, querier database.Querier, teamName string) (domain.TeamInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockAndGetTeam", ctx, querier, teamName)
+	ret0, _ := ret[0].(domain.TeamInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockAndGetTeam indicates an expected call of LockAndGetTeam.
+func (mr *MockTeamBudgetLockerMockRecorder) LockAndGetTeam(ctx, querier, teamName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockAndGetTeam", reflect.TypeOf((*MockTeamBudgetLocker)(nil).LockAndGetTeam), ctx, querier, teamName)
+}
+
+// MockTeamMembershipChecker is a mock of TeamMembershipChecker interface.
+type MockTeamMembershipChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockTeamMembershipCheckerMockRecorder
+}
+
+// MockTeamMembershipCheckerMockRecorder is the mock recorder for MockTeamMembershipChecker.
+type MockTeamMembershipCheckerMockRecorder struct {
+	mock *MockTeamMembershipChecker
+}
+
+// NewMockTeamMembershipChecker creates a new mock instance.
+func NewMockTeamMembershipChecker(ctrl *gomock.Controller) *MockTeamMembershipChecker {
+	mock := &MockTeamMembershipChecker{ctrl: ctrl}
+	mock.recorder = &MockTeamMembershipCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTeamMembershipChecker) EXPECT() *MockTeamMembershipCheckerMockRecorder {
+	return m.recorder
+}
+
+// IsTeamMember mocks base method.
+func (m *MockTeamMembershipChecker) IsTeamMember(ctx context.Context, querier database.Querier, teamID, userID int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsTeamMember", ctx, querier, teamID, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsTeamMember indicates an expected call of IsTeamMember.
+func (mr *MockTeamMembershipCheckerMockRecorder) IsTeamMember(ctx, querier, teamID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTeamMember", reflect.TypeOf((*MockTeamMembershipChecker)(nil).IsTeamMember), ctx, querier, teamID, userID)
+}
+
+// MockTeamBudgetProceeder is a mock of TeamBudgetProceeder interface.
+type MockTeamBudgetProceeder struct {
+	ctrl     *gomock.Controller
+	recorder *MockTeamBudgetProceederMockRecorder
+}
+
+// MockTeamBudgetProceederMockRecorder is the mock recorder for MockTeamBudgetProceeder.
+type MockTeamBudgetProceederMockRecorder struct {
+	mock *MockTeamBudgetProceeder
+}
+
+// NewMockTeamBudgetProceeder creates a new mock instance.
+func NewMockTeamBudgetProceeder(ctrl *gomock.Controller) *MockTeamBudgetProceeder {
+	mock := &MockTeamBudgetProceeder{ctrl: ctrl}
+	mock.recorder = &MockTeamBudgetProceederMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTeamBudgetProceeder) EXPECT() *MockTeamBudgetProceederMockRecorder {
+	return m.recorder
+}
+
+// ProceedTeamTransfer mocks base method.
+func (m *MockTeamBudgetProceeder) ProceedTeamTransfer(ctx context.Context, executor database.Executor, teamID int, amount uint32, managerID, toUserID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProceedTeamTransfer", ctx, executor, teamID, amount, managerID, toUserID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProceedTeamTransfer indicates an expected call of ProceedTeamTransfer.
+func (mr *MockTeamBudgetProceederMockRecorder) ProceedTeamTransfer(ctx, executor, teamID, amount, managerID, toUserID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProceedTeamTransfer", reflect.TypeOf((*MockTeamBudgetProceeder)(nil).ProceedTeamTransfer), ctx, executor, teamID, amount, managerID, toUserID)
+}
+
+// MockTeamBudgetTopUpper is a mock of TeamBudgetTopUpper interface.
+type MockTeamBudgetTopUpper struct {
+	ctrl     *gomock.Controller
+	recorder *MockTeamBudgetTopUpperMockRecorder
+}
+
+// MockTeamBudgetTopUpperMockRecorder is the mock recorder for MockTeamBudgetTopUpper.
+type MockTeamBudgetTopUpperMockRecorder struct {
+	mock *MockTeamBudgetTopUpper
+}
+
+// NewMockTeamBudgetTopUpper creates a new mock instance.
+func NewMockTeamBudgetTopUpper(ctrl *gomock.Controller) *MockTeamBudgetTopUpper {
+	mock := &MockTeamBudgetTopUpper{ctrl: ctrl}
+	mock.recorder = &MockTeamBudgetTopUpperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTeamBudgetTopUpper) EXPECT() *MockTeamBudgetTopUpperMockRecorder {
+	return m.recorder
+}
+
+// TopUpDueBudgets mocks base method.
+func (m *MockTeamBudgetTopUpper) TopUpDueBudgets(ctx context.Context, querier database.Querier) ([]domain.TeamTopUp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TopUpDueBudgets", ctx, querier)
+	ret0, _ := ret[0].([]domain.TeamTopUp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TopUpDueBudgets indicates an expected call of TopUpDueBudgets.
+func (mr *MockTeamBudgetTopUpperMockRecorder) TopUpDueBudgets(ctx, querier interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TopUpDueBudgets", reflect.TypeOf((*MockTeamBudgetTopUpper)(nil).TopUpDueBudgets), ctx, querier)
+}
@@ -0,0 +1,141 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/store/domain/transfer_limits.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	database "github.com/Lexv0lk/merch-store/internal/pkg/database"
+	domain "github.com/Lexv0lk/merch-store/internal/store/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTransferLimitsProvider is a mock of TransferLimitsProvider interface.
+type MockTransferLimitsProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransferLimitsProviderMockRecorder
+}
+
+// MockTransferLimitsProviderMockRecorder is the mock recorder for MockTransferLimitsProvider.
+type MockTransferLimitsProviderMockRecorder struct {
+	mock *MockTransferLimitsProvider
+}
+
+// NewMockTransferLimitsProvider creates a new mock instance.
+func NewMockTransferLimitsProvider(ctrl *gomock.Controller) *MockTransferLimitsProvider {
+	mock := &MockTransferLimitsProvider{ctrl: ctrl}
+	mock.recorder = &MockTransferLimitsProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransferLimitsProvider) EXPECT() *MockTransferLimitsProviderMockRecorder {
+	return m.recorder
+}
+
+// GetTransferLimits mocks base method.
+func (m *MockTransferLimitsProvider) GetTransferLimits(ctx context.Context, querier database.Querier, userId int) (domain.TransferLimits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransferLimits", ctx, querier, userId)
+	ret0, _ := ret[0].(domain.TransferLimits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransferLimits indicates an expected call of GetTransferLimits.
+func (mr *MockTransferLimitsProviderMockRecorder) GetTransferLimits(ctx, querier, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransferLimits", reflect.TypeOf((*MockTransferLimitsProvider)(nil).GetTransferLimits), ctx, querier, userId)
+}
+
+// MockTransferStatsFetcher is a mock of TransferStatsFetcher interface.
+type MockTransferStatsFetcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransferStatsFetcherMockRecorder
+}
+
+// MockTransferStatsFetcherMockRecorder is the mock recorder for MockTransferStatsFetcher.
+type MockTransferStatsFetcherMockRecorder struct {
+	mock *MockTransferStatsFetcher
+}
+
+// NewMockTransferStatsFetcher creates a new mock instance.
+func NewMockTransferStatsFetcher(ctrl *gomock.Controller) *MockTransferStatsFetcher {
+	mock := &MockTransferStatsFetcher{ctrl: ctrl}
+	mock.recorder = &MockTransferStatsFetcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransferStatsFetcher) EXPECT() *MockTransferStatsFetcherMockRecorder {
+	return m.recorder
+}
+
+// FetchTransferStats mocks base method.
+func (m *MockTransferStatsFetcher) FetchTransferStats(ctx context.Context, querier database.Querier, fromUserID, toUserID int) (domain.TransferStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchTransferStats", ctx, querier, fromUserID, toUserID)
+	ret0, _ := ret[0].(domain.TransferStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchTransferStats indicates an expected call of FetchTransferStats.
+func (mr *MockTransferStatsFetcherMockRecorder) FetchTransferStats(ctx, querier, fromUserID, toUserID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTransferStats", reflect.TypeOf((*MockTransferStatsFetcher)(nil).FetchTransferStats), ctx, querier, fromUserID, toUserID)
+}
+
+// MockTransferLimitsRepository is a mock of TransferLimitsRepository interface.
+type MockTransferLimitsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransferLimitsRepositoryMockRecorder
+}
+
+// MockTransferLimitsRepositoryMockRecorder is the mock recorder for MockTransferLimitsRepository.
+type MockTransferLimitsRepositoryMockRecorder struct {
+	mock *MockTransferLimitsRepository
+}
+
+// NewMockTransferLimitsRepository creates a new mock instance.
+func NewMockTransferLimitsRepository(ctrl *gomock.Controller) *MockTransferLimitsRepository {
+	mock := &MockTransferLimitsRepository{ctrl: ctrl}
+	mock.recorder = &MockTransferLimitsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransferLimitsRepository) EXPECT() *MockTransferLimitsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SetDefaultTransferLimits mocks base method.
+func (m *MockTransferLimitsRepository) SetDefaultTransferLimits(ctx context.Context, executor database.Executor, limits domain.TransferLimits) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDefaultTransferLimits", ctx, executor, limits)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDefaultTransferLimits indicates an expected call of SetDefaultTransferLimits.
+func (mr *MockTransferLimitsRepositoryMockRecorder) SetDefaultTransferLimits(ctx, executor, limits interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDefaultTransferLimits", reflect.TypeOf((*MockTransferLimitsRepository)(nil).SetDefaultTransferLimits), ctx, executor, limits)
+}
+
+// SetUserTransferLimits mocks base method.
+func (m *MockTransferLimitsRepository) SetUserTransferLimits(ctx context.Context, executor database.Executor, userId int, limits domain.TransferLimits) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserTransferLimits", ctx, executor, userId, limits)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetUserTransferLimits indicates an expected call of SetUserTransferLimits.
+func (mr *MockTransferLimitsRepositoryMockRecorder) SetUserTransferLimits(ctx, executor, userId, limits interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserTransferLimits", reflect.TypeOf((*MockTransferLimitsRepository)(nil).SetUserTransferLimits), ctx, executor, userId, limits)
+}
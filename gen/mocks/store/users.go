@@ -88,6 +88,184 @@ func (mr *MockUserBalanceLockerMockRecorder) LockAndGetUserBalance(ctx, querier,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockAndGetUserBalance", reflect.TypeOf((*MockUserBalanceLocker)(nil).LockAndGetUserBalance), ctx, querier, userId)
 }
 
+// MockBalanceStatusChecker is a mock of BalanceStatusChecker interface.
+type MockBalanceStatusChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockBalanceStatusCheckerMockRecorder
+}
+
+// MockBalanceStatusCheckerMockRecorder is the mock recorder for MockBalanceStatusChecker.
+type MockBalanceStatusCheckerMockRecorder struct {
+	mock *MockBalanceStatusChecker
+}
+
+// NewMockBalanceStatusChecker creates a new mock instance.
+func NewMockBalanceStatusChecker(ctrl *gomock.Controller) *MockBalanceStatusChecker {
+	mock := &MockBalanceStatusChecker{ctrl: ctrl}
+	mock.recorder = &MockBalanceStatusCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBalanceStatusChecker) EXPECT() *MockBalanceStatusCheckerMockRecorder {
+	return m.recorder
+}
+
+// IsBalanceActive mocks base method.
+func (m *MockBalanceStatusChecker) IsBalanceActive(ctx context.Context, querier database.Querier, userId int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsBalanceActive", ctx, querier, userId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsBalanceActive indicates an expected call of IsBalanceActive.
+func (mr *MockBalanceStatusCheckerMockRecorder) IsBalanceActive(ctx, querier, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBalanceActive", reflect.TypeOf((*MockBalanceStatusChecker)(nil).IsBalanceActive), ctx, querier, userId)
+}
+
+// IsBalanceFrozen mocks base method.
+func (m *MockBalanceStatusChecker) IsBalanceFrozen(ctx context.Context, querier database.Querier, userId int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsBalanceFrozen", ctx, querier, userId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsBalanceFrozen indicates an expected call of IsBalanceFrozen.
+func (mr *MockBalanceStatusCheckerMockRecorder) IsBalanceFrozen(ctx, querier, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBalanceFrozen", reflect.TypeOf((*MockBalanceStatusChecker)(nil).IsBalanceFrozen), ctx, querier, userId)
+}
+
+// MockBalanceDeactivator is a mock of BalanceDeactivator interface.
+type MockBalanceDeactivator struct {
+	ctrl     *gomock.Controller
+	recorder *MockBalanceDeactivatorMockRecorder
+}
+
+// MockBalanceDeactivatorMockRecorder is the mock recorder for MockBalanceDeactivator.
+type MockBalanceDeactivatorMockRecorder struct {
+	mock *MockBalanceDeactivator
+}
+
+// NewMockBalanceDeactivator creates a new mock instance.
+func NewMockBalanceDeactivator(ctrl *gomock.Controller) *MockBalanceDeactivator {
+	mock := &MockBalanceDeactivator{ctrl: ctrl}
+	mock.recorder = &MockBalanceDeactivatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBalanceDeactivator) EXPECT() *MockBalanceDeactivatorMockRecorder {
+	return m.recorder
+}
+
+// DeactivateBalance mocks base method.
+func (m *MockBalanceDeactivator) DeactivateBalance(ctx context.Context, executor database.Executor, userId int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeactivateBalance", ctx, executor, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeactivateBalance indicates an expected call of DeactivateBalance.
+func (mr *MockBalanceDeactivatorMockRecorder) DeactivateBalance(ctx, executor, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivateBalance", reflect.TypeOf((*MockBalanceDeactivator)(nil).DeactivateBalance), ctx, executor, userId)
+}
+
+// MockBalanceFreezer is a mock of BalanceFreezer interface.
+type MockBalanceFreezer struct {
+	ctrl     *gomock.Controller
+	recorder *MockBalanceFreezerMockRecorder
+}
+
+// MockBalanceFreezerMockRecorder is the mock recorder for MockBalanceFreezer.
+type MockBalanceFreezerMockRecorder struct {
+	mock *MockBalanceFreezer
+}
+
+// NewMockBalanceFreezer creates a new mock instance.
+func NewMockBalanceFreezer(ctrl *gomock.Controller) *MockBalanceFreezer {
+	mock := &MockBalanceFreezer{ctrl: ctrl}
+	mock.recorder = &MockBalanceFreezerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBalanceFreezer) EXPECT() *MockBalanceFreezerMockRecorder {
+	return m.recorder
+}
+
+// FreezeBalance mocks base method.
+func (m *MockBalanceFreezer) FreezeBalance(ctx context.Context, executor database.Executor, userId int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FreezeBalance", ctx, executor, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FreezeBalance indicates an expected call of FreezeBalance.
+func (mr *MockBalanceFreezerMockRecorder) FreezeBalance(ctx, executor, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FreezeBalance", reflect.TypeOf((*MockBalanceFreezer)(nil).FreezeBalance), ctx, executor, userId)
+}
+
+// UnfreezeBalance mocks base method.
+func (m *MockBalanceFreezer) UnfreezeBalance(ctx context.Context, executor database.Executor, userId int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnfreezeBalance", ctx, executor, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnfreezeBalance indicates an expected call of UnfreezeBalance.
+func (mr *MockBalanceFreezerMockRecorder) UnfreezeBalance(ctx, executor, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnfreezeBalance", reflect.TypeOf((*MockBalanceFreezer)(nil).UnfreezeBalance), ctx, executor, userId)
+}
+
+// MockFreezeLogRecorder is a mock of FreezeLogRecorder interface.
+type MockFreezeLogRecorder struct {
+	ctrl     *gomock.Controller
+	recorder *MockFreezeLogRecorderMockRecorder
+}
+
+// MockFreezeLogRecorderMockRecorder is the mock recorder for MockFreezeLogRecorder.
+type MockFreezeLogRecorderMockRecorder struct {
+	mock *MockFreezeLogRecorder
+}
+
+// NewMockFreezeLogRecorder creates a new mock instance.
+func NewMockFreezeLogRecorder(ctrl *gomock.Controller) *MockFreezeLogRecorder {
+	mock := &MockFreezeLogRecorder{ctrl: ctrl}
+	mock.recorder = &MockFreezeLogRecorderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFreezeLogRecorder) EXPECT() *MockFreezeLogRecorderMockRecorder {
+	return m.recorder
+}
+
+// RecordFreezeChange mocks base method.
+func (m *MockFreezeLogRecorder) RecordFreezeChange(ctx context.Context, executor database.Executor, change domain.FreezeChange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordFreezeChange", ctx, executor, change)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordFreezeChange indicates an expected call of RecordFreezeChange.
+func (mr *MockFreezeLogRecorderMockRecorder) RecordFreezeChange(ctx, executor, change interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordFreezeChange", reflect.TypeOf((*MockFreezeLogRecorder)(nil).RecordFreezeChange), ctx, executor, change)
+}
+
 // MockUserInfoRepository is a mock of UserInfoRepository interface.
 type MockUserInfoRepository struct {
 	ctrl     *gomock.Controller
@@ -141,6 +319,36 @@ func (mr *MockUserInfoRepositoryMockRecorder) FetchUserCoinTransfers(ctx, userId
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchUserCoinTransfers", reflect.TypeOf((*MockUserInfoRepository)(nil).FetchUserCoinTransfers), ctx, userId)
 }
 
+// FetchUserGifts mocks base method.
+func (m *MockUserInfoRepository) FetchUserGifts(ctx context.Context, userId int) ([]domain.Gift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchUserGifts", ctx, userId)
+	ret0, _ := ret[0].([]domain.Gift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchUserGifts indicates an expected call of FetchUserGifts.
+func (mr *MockUserInfoRepositoryMockRecorder) FetchUserGifts(ctx, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchUserGifts", reflect.TypeOf((*MockUserInfoRepository)(nil).FetchUserGifts), ctx, userId)
+}
+
+// FetchUserItemTransfers mocks base method.
+func (m *MockUserInfoRepository) FetchUserItemTransfers(ctx context.Context, userId int) (domain.ItemTransferHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchUserItemTransfers", ctx, userId)
+	ret0, _ := ret[0].(domain.ItemTransferHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchUserItemTransfers indicates an expected call of FetchUserItemTransfers.
+func (mr *MockUserInfoRepositoryMockRecorder) FetchUserItemTransfers(ctx, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchUserItemTransfers", reflect.TypeOf((*MockUserInfoRepository)(nil).FetchUserItemTransfers), ctx, userId)
+}
+
 // FetchUserPurchases mocks base method.
 func (m *MockUserInfoRepository) FetchUserPurchases(ctx context.Context, userId int) (map[domain.Good]uint32, error) {
 	m.ctrl.T.Helper()
@@ -156,6 +364,21 @@ func (mr *MockUserInfoRepositoryMockRecorder) FetchUserPurchases(ctx, userId int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchUserPurchases", reflect.TypeOf((*MockUserInfoRepository)(nil).FetchUserPurchases), ctx, userId)
 }
 
+// FetchUserWishlist mocks base method.
+func (m *MockUserInfoRepository) FetchUserWishlist(ctx context.Context, userId int) ([]domain.WishlistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchUserWishlist", ctx, userId)
+	ret0, _ := ret[0].([]domain.WishlistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchUserWishlist indicates an expected call of FetchUserWishlist.
+func (mr *MockUserInfoRepositoryMockRecorder) FetchUserWishlist(ctx, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchUserWishlist", reflect.TypeOf((*MockUserInfoRepository)(nil).FetchUserWishlist), ctx, userId)
+}
+
 // MockUsernameGetter is a mock of UsernameGetter interface.
 type MockUsernameGetter struct {
 	ctrl     *gomock.Controller
@@ -252,40 +475,59 @@ func (mr *MockUserIDFetcherMockRecorder) FetchUserID(ctx, username interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchUserID", reflect.TypeOf((*MockUserIDFetcher)(nil).FetchUserID), ctx, username)
 }
 
-// MockUserBalanceFetcher is a mock of UserBalanceFetcher interface.
-type MockUserBalanceFetcher struct {
+// FetchUserIDs mocks base method.
+func (m *MockUserIDFetcher) FetchUserIDs(ctx context.Context, usernames ...string) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range usernames {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FetchUserIDs", varargs...)
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchUserIDs indicates an expected call of FetchUserIDs.
+func (mr *MockUserIDFetcherMockRecorder) FetchUserIDs(ctx interface{}, usernames ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, usernames...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchUserIDs", reflect.TypeOf((*MockUserIDFetcher)(nil).FetchUserIDs), varargs...)
+}
+
+// MockUserDeactivator is a mock of UserDeactivator interface.
+type MockUserDeactivator struct {
 	ctrl     *gomock.Controller
-	recorder *MockUserBalanceFetcherMockRecorder
+	recorder *MockUserDeactivatorMockRecorder
 }
 
-// MockUserBalanceFetcherMockRecorder is the mock recorder for MockUserBalanceFetcher.
-type MockUserBalanceFetcherMockRecorder struct {
-	mock *MockUserBalanceFetcher
+// MockUserDeactivatorMockRecorder is the mock recorder for MockUserDeactivator.
+type MockUserDeactivatorMockRecorder struct {
+	mock *MockUserDeactivator
 }
 
-// NewMockUserBalanceFetcher creates a new mock instance.
-func NewMockUserBalanceFetcher(ctrl *gomock.Controller) *MockUserBalanceFetcher {
-	mock := &MockUserBalanceFetcher{ctrl: ctrl}
-	mock.recorder = &MockUserBalanceFetcherMockRecorder{mock}
+// NewMockUserDeactivator creates a new mock instance.
+func NewMockUserDeactivator(ctrl *gomock.Controller) *MockUserDeactivator {
+	mock := &MockUserDeactivator{ctrl: ctrl}
+	mock.recorder = &MockUserDeactivatorMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockUserBalanceFetcher) EXPECT() *MockUserBalanceFetcherMockRecorder {
+func (m *MockUserDeactivator) EXPECT() *MockUserDeactivatorMockRecorder {
 	return m.recorder
 }
 
-// FetchUserBalance mocks base method.
-func (m *MockUserBalanceFetcher) FetchUserBalance(ctx context.Context, userId int) (uint32, error) {
+// DeactivateUser mocks base method.
+func (m *MockUserDeactivator) DeactivateUser(ctx context.Context, userId int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FetchUserBalance", ctx, userId)
-	ret0, _ := ret[0].(uint32)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeactivateUser", ctx, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// FetchUserBalance indicates an expected call of FetchUserBalance.
-func (mr *MockUserBalanceFetcherMockRecorder) FetchUserBalance(ctx, userId interface{}) *gomock.Call {
+// DeactivateUser indicates an expected call of DeactivateUser.
+func (mr *MockUserDeactivatorMockRecorder) DeactivateUser(ctx, userId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchUserBalance", reflect.TypeOf((*MockUserBalanceFetcher)(nil).FetchUserBalance), ctx, userId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivateUser", reflect.TypeOf((*MockUserDeactivator)(nil).DeactivateUser), ctx, userId)
 }
@@ -0,0 +1,104 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/store/domain/variants.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	database "github.com/Lexv0lk/merch-store/internal/pkg/database"
+	domain "github.com/Lexv0lk/merch-store/internal/store/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockVariantsRepository is a mock of VariantsRepository interface.
+type MockVariantsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockVariantsRepositoryMockRecorder
+}
+
+// MockVariantsRepositoryMockRecorder is the mock recorder for MockVariantsRepository.
+type MockVariantsRepositoryMockRecorder struct {
+	mock *MockVariantsRepository
+}
+
+// NewMockVariantsRepository creates a new mock instance.
+func NewMockVariantsRepository(ctrl *gomock.Controller) *MockVariantsRepository {
+	mock := &MockVariantsRepository{ctrl: ctrl}
+	mock.recorder = &MockVariantsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVariantsRepository) EXPECT() *MockVariantsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ListGoodVariants mocks base method.
+func (m *MockVariantsRepository) ListGoodVariants(ctx context.Context, goodID int) ([]domain.Variant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGoodVariants", ctx, goodID)
+	ret0, _ := ret[0].([]domain.Variant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGoodVariants indicates an expected call of ListGoodVariants.
+func (mr *MockVariantsRepositoryMockRecorder) ListGoodVariants(ctx, goodID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGoodVariants", reflect.TypeOf((*MockVariantsRepository)(nil).ListGoodVariants), ctx, goodID)
+}
+
+// ListVariants mocks base method.
+func (m *MockVariantsRepository) ListVariants(ctx context.Context) ([]domain.Variant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVariants", ctx)
+	ret0, _ := ret[0].([]domain.Variant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVariants indicates an expected call of ListVariants.
+func (mr *MockVariantsRepositoryMockRecorder) ListVariants(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVariants", reflect.TypeOf((*MockVariantsRepository)(nil).ListVariants), ctx)
+}
+
+// MockStockKeeper is a mock of StockKeeper interface.
+type MockStockKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockStockKeeperMockRecorder
+}
+
+// MockStockKeeperMockRecorder is the mock recorder for MockStockKeeper.
+type MockStockKeeperMockRecorder struct {
+	mock *MockStockKeeper
+}
+
+// NewMockStockKeeper creates a new mock instance.
+func NewMockStockKeeper(ctrl *gomock.Controller) *MockStockKeeper {
+	mock := &MockStockKeeper{ctrl: ctrl}
+	mock.recorder = &MockStockKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStockKeeper) EXPECT() *MockStockKeeperMockRecorder {
+	return m.recorder
+}
+
+// TakeFromStock mocks base method.
+func (m *MockStockKeeper) TakeFromStock(ctx context.Context, executor database.Executor, variantID int, quantity uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TakeFromStock", ctx, executor, variantID, quantity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TakeFromStock indicates an expected call of TakeFromStock.
+func (mr *MockStockKeeperMockRecorder) TakeFromStock(ctx, executor, variantID, quantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TakeFromStock", reflect.TypeOf((*MockStockKeeper)(nil).TakeFromStock), ctx, executor, variantID, quantity)
+}
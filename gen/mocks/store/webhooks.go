@@ -0,0 +1,281 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/store/domain/webhooks.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	database "github.com/Lexv0lk/merch-store/internal/pkg/database"
+	domain "github.com/Lexv0lk/merch-store/internal/store/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockWebhookEndpointsRepository is a mock of WebhookEndpointsRepository interface.
+type MockWebhookEndpointsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookEndpointsRepositoryMockRecorder
+}
+
+// MockWebhookEndpointsRepositoryMockRecorder is the mock recorder for MockWebhookEndpointsRepository.
+type MockWebhookEndpointsRepositoryMockRecorder struct {
+	mock *MockWebhookEndpointsRepository
+}
+
+// NewMockWebhookEndpointsRepository creates a new mock instance.
+func NewMockWebhookEndpointsRepository(ctrl *gomock.Controller) *MockWebhookEndpointsRepository {
+	mock := &MockWebhookEndpointsRepository{ctrl: ctrl}
+	mock.recorder = &MockWebhookEndpointsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookEndpointsRepository) EXPECT() *MockWebhookEndpointsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountWebhookEndpoints mocks base method.
+func (m *MockWebhookEndpointsRepository) CountWebhookEndpoints(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountWebhookEndpoints", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountWebhookEndpoints indicates an expected call of CountWebhookEndpoints.
+func (mr *MockWebhookEndpointsRepositoryMockRecorder) CountWebhookEndpoints(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountWebhookEndpoints", reflect.TypeOf((*MockWebhookEndpointsRepository)(nil).CountWebhookEndpoints), ctx)
+}
+
+// CreateWebhookEndpoint mocks base method.
+func (m *MockWebhookEndpointsRepository) CreateWebhookEndpoint(ctx context.Context, querier database.Querier, endpoint domain.WebhookEndpoint) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhookEndpoint", ctx, querier, endpoint)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhookEndpoint indicates an expected call of CreateWebhookEndpoint.
+func (mr *MockWebhookEndpointsRepositoryMockRecorder) CreateWebhookEndpoint(ctx, querier, endpoint interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhookEndpoint", reflect.TypeOf((*MockWebhookEndpointsRepository)(nil).CreateWebhookEndpoint), ctx, querier, endpoint)
+}
+
+// DeleteWebhookEndpoint mocks base method.
+func (m *MockWebhookEndpointsRepository) DeleteWebhookEndpoint(ctx context.Context, executor database.Executor, endpointID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhookEndpoint", ctx, executor, endpointID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhookEndpoint indicates an expected call of DeleteWebhookEndpoint.
+func (mr *MockWebhookEndpointsRepositoryMockRecorder) DeleteWebhookEndpoint(ctx, executor, endpointID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhookEndpoint", reflect.TypeOf((*MockWebhookEndpointsRepository)(nil).DeleteWebhookEndpoint), ctx, executor, endpointID)
+}
+
+// ListWebhookDeadLetters mocks base method.
+func (m *MockWebhookEndpointsRepository) ListWebhookDeadLetters(ctx context.Context, limit int) ([]domain.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhookDeadLetters", ctx, limit)
+	ret0, _ := ret[0].([]domain.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhookDeadLetters indicates an expected call of ListWebhookDeadLetters.
+func (mr *MockWebhookEndpointsRepositoryMockRecorder) ListWebhookDeadLetters(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookDeadLetters", reflect.TypeOf((*MockWebhookEndpointsRepository)(nil).ListWebhookDeadLetters), ctx, limit)
+}
+
+// ListWebhookEndpoints mocks base method.
+func (m *MockWebhookEndpointsRepository) ListWebhookEndpoints(ctx context.Context) ([]domain.WebhookEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhookEndpoints", ctx)
+	ret0, _ := ret[0].([]domain.WebhookEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhookEndpoints indicates an expected call of ListWebhookEndpoints.
+func (mr *MockWebhookEndpointsRepositoryMockRecorder) ListWebhookEndpoints(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookEndpoints", reflect.TypeOf((*MockWebhookEndpointsRepository)(nil).ListWebhookEndpoints), ctx)
+}
+
+// MockWebhookPublisher is a mock of WebhookPublisher interface.
+type MockWebhookPublisher struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookPublisherMockRecorder
+}
+
+// MockWebhookPublisherMockRecorder is the mock recorder for MockWebhookPublisher.
+type MockWebhookPublisherMockRecorder struct {
+	mock *MockWebhookPublisher
+}
+
+// NewMockWebhookPublisher creates a new mock instance.
+func NewMockWebhookPublisher(ctrl *gomock.Controller) *MockWebhookPublisher {
+	mock := &MockWebhookPublisher{ctrl: ctrl}
+	mock.recorder = &MockWebhookPublisherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookPublisher) EXPECT() *MockWebhookPublisherMockRecorder {
+	return m.recorder
+}
+
+// PublishWebhook mocks base method.
+func (m *MockWebhookPublisher) PublishWebhook(ctx context.Context, executor database.Executor, event domain.WebhookEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishWebhook", ctx, executor, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishWebhook indicates an expected call of PublishWebhook.
+func (mr *MockWebhookPublisherMockRecorder) PublishWebhook(ctx, executor, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishWebhook", reflect.TypeOf((*MockWebhookPublisher)(nil).PublishWebhook), ctx, executor, event)
+}
+
+// MockWebhookDeliveryQueue is a mock of WebhookDeliveryQueue interface.
+type MockWebhookDeliveryQueue struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookDeliveryQueueMockRecorder
+}
+
+// MockWebhookDeliveryQueueMockRecorder is the mock recorder for MockWebhookDeliveryQueue.
+type MockWebhookDeliveryQueueMockRecorder struct {
+	mock *MockWebhookDeliveryQueue
+}
+
+// NewMockWebhookDeliveryQueue creates a new mock instance.
+func NewMockWebhookDeliveryQueue(ctrl *gomock.Controller) *MockWebhookDeliveryQueue {
+	mock := &MockWebhookDeliveryQueue{ctrl: ctrl}
+	mock.recorder = &MockWebhookDeliveryQueueMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookDeliveryQueue) EXPECT() *MockWebhookDeliveryQueueMockRecorder {
+	return m.recorder
+}
+
+// CompleteWebhookDelivery mocks base method.
+func (m *MockWebhookDeliveryQueue) CompleteWebhookDelivery(ctx context.Context, executor database.Executor, deliveryID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteWebhookDelivery", ctx, executor, deliveryID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteWebhookDelivery indicates an expected call of CompleteWebhookDelivery.
+func (mr *MockWebhookDeliveryQueueMockRecorder) CompleteWebhookDelivery(ctx, executor, deliveryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteWebhookDelivery", reflect.TypeOf((*MockWebhookDeliveryQueue)(nil).CompleteWebhookDelivery), ctx, executor, deliveryID)
+}
+
+// FetchDueWebhookDeliveries mocks base method.
+func (m *MockWebhookDeliveryQueue) FetchDueWebhookDeliveries(ctx context.Context, now time.Time, limit int) ([]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchDueWebhookDeliveries", ctx, now, limit)
+	ret0, _ := ret[0].([]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchDueWebhookDeliveries indicates an expected call of FetchDueWebhookDeliveries.
+func (mr *MockWebhookDeliveryQueueMockRecorder) FetchDueWebhookDeliveries(ctx, now, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchDueWebhookDeliveries", reflect.TypeOf((*MockWebhookDeliveryQueue)(nil).FetchDueWebhookDeliveries), ctx, now, limit)
+}
+
+// LockAndGetWebhookDelivery mocks base method.
+func (m *MockWebhookDeliveryQueue) LockAndGetWebhookDelivery(ctx context.Context, querier database.Querier, deliveryID int) (domain.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockAndGetWebhookDelivery", ctx, querier, deliveryID)
+	ret0, _ := ret[0].(domain.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockAndGetWebhookDelivery indicates an expected call of LockAndGetWebhookDelivery.
+func (mr *MockWebhookDeliveryQueueMockRecorder) LockAndGetWebhookDelivery(ctx, querier, deliveryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockAndGetWebhookDelivery", reflect.TypeOf((*MockWebhookDeliveryQueue)(nil).LockAndGetWebhookDelivery), ctx, querier, deliveryID)
+}
+
+// MoveWebhookToDeadLetters mocks base method.
+func (m *MockWebhookDeliveryQueue) MoveWebhookToDeadLetters(ctx context.Context, executor database.Executor, delivery domain.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveWebhookToDeadLetters", ctx, executor, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveWebhookToDeadLetters indicates an expected call of MoveWebhookToDeadLetters.
+func (mr *MockWebhookDeliveryQueueMockRecorder) MoveWebhookToDeadLetters(ctx, executor, delivery interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveWebhookToDeadLetters", reflect.TypeOf((*MockWebhookDeliveryQueue)(nil).MoveWebhookToDeadLetters), ctx, executor, delivery)
+}
+
+// ScheduleWebhookRetry mocks base method.
+func (m *MockWebhookDeliveryQueue) ScheduleWebhookRetry(ctx context.Context, executor database.Executor, delivery domain.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleWebhookRetry", ctx, executor, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ScheduleWebhookRetry indicates an expected call of ScheduleWebhookRetry.
+func (mr *MockWebhookDeliveryQueueMockRecorder) ScheduleWebhookRetry(ctx, executor, delivery interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleWebhookRetry", reflect.TypeOf((*MockWebhookDeliveryQueue)(nil).ScheduleWebhookRetry), ctx, executor, delivery)
+}
+
+// MockWebhookSender is a mock of WebhookSender interface.
+type MockWebhookSender struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookSenderMockRecorder
+}
+
+// MockWebhookSenderMockRecorder is the mock recorder for MockWebhookSender.
+type MockWebhookSenderMockRecorder struct {
+	mock *MockWebhookSender
+}
+
+// NewMockWebhookSender creates a new mock instance.
+func NewMockWebhookSender(ctrl *gomock.Controller) *MockWebhookSender {
+	mock := &MockWebhookSender{ctrl: ctrl}
+	mock.recorder = &MockWebhookSenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookSender) EXPECT() *MockWebhookSenderMockRecorder {
+	return m.recorder
+}
+
+// SendWebhook mocks base method.
+func (m *MockWebhookSender) SendWebhook(ctx context.Context, delivery domain.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendWebhook", ctx, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendWebhook indicates an expected call of SendWebhook.
+func (mr *MockWebhookSenderMockRecorder) SendWebhook(ctx, delivery interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendWebhook", reflect.TypeOf((*MockWebhookSender)(nil).SendWebhook), ctx, delivery)
+}
@@ -0,0 +1,198 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/store/domain/wishlist.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	database "github.com/Lexv0lk/merch-store/internal/pkg/database"
+	domain "github.com/Lexv0lk/merch-store/internal/store/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockWishlistRepository is a mock of WishlistRepository interface.
+type MockWishlistRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWishlistRepositoryMockRecorder
+}
+
+// MockWishlistRepositoryMockRecorder is the mock recorder for MockWishlistRepository.
+type MockWishlistRepositoryMockRecorder struct {
+	mock *MockWishlistRepository
+}
+
+// NewMockWishlistRepository creates a new mock instance.
+func NewMockWishlistRepository(ctrl *gomock.Controller) *MockWishlistRepository {
+	mock := &MockWishlistRepository{ctrl: ctrl}
+	mock.recorder = &MockWishlistRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWishlistRepository) EXPECT() *MockWishlistRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddWishlistItem mocks base method.
+func (m *MockWishlistRepository) AddWishlistItem(ctx context.Context, userID int, good domain.GoodInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWishlistItem", ctx, userID, good)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddWishlistItem indicates an expected call of AddWishlistItem.
+func (mr *MockWishlistRepositoryMockRecorder) AddWishlistItem(ctx, userID, good interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWishlistItem", reflect.TypeOf((*MockWishlistRepository)(nil).AddWishlistItem), ctx, userID, good)
+}
+
+// CountWishlistItems mocks base method.
+func (m *MockWishlistRepository) CountWishlistItems(ctx context.Context, userID int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountWishlistItems", ctx, userID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountWishlistItems indicates an expected call of CountWishlistItems.
+func (mr *MockWishlistRepositoryMockRecorder) CountWishlistItems(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountWishlistItems", reflect.TypeOf((*MockWishlistRepository)(nil).CountWishlistItems), ctx, userID)
+}
+
+// ListWishlistEvents mocks base method.
+func (m *MockWishlistRepository) ListWishlistEvents(ctx context.Context, userID, limit int) ([]domain.WishlistEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWishlistEvents", ctx, userID, limit)
+	ret0, _ := ret[0].([]domain.WishlistEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWishlistEvents indicates an expected call of ListWishlistEvents.
+func (mr *MockWishlistRepositoryMockRecorder) ListWishlistEvents(ctx, userID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWishlistEvents", reflect.TypeOf((*MockWishlistRepository)(nil).ListWishlistEvents), ctx, userID, limit)
+}
+
+// RemoveWishlistItem mocks base method.
+func (m *MockWishlistRepository) RemoveWishlistItem(ctx context.Context, userID, goodID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveWishlistItem", ctx, userID, goodID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveWishlistItem indicates an expected call of RemoveWishlistItem.
+func (mr *MockWishlistRepositoryMockRecorder) RemoveWishlistItem(ctx, userID, goodID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveWishlistItem", reflect.TypeOf((*MockWishlistRepository)(nil).RemoveWishlistItem), ctx, userID, goodID)
+}
+
+// MockWishlistPriceWatcher is a mock of WishlistPriceWatcher interface.
+type MockWishlistPriceWatcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockWishlistPriceWatcherMockRecorder
+}
+
+// MockWishlistPriceWatcherMockRecorder is the mock recorder for MockWishlistPriceWatcher.
+type MockWishlistPriceWatcherMockRecorder struct {
+	mock *MockWishlistPriceWatcher
+}
+
+// NewMockWishlistPriceWatcher creates a new mock instance.
+func NewMockWishlistPriceWatcher(ctrl *gomock.Controller) *MockWishlistPriceWatcher {
+	mock := &MockWishlistPriceWatcher{ctrl: ctrl}
+	mock.recorder = &MockWishlistPriceWatcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWishlistPriceWatcher) EXPECT() *MockWishlistPriceWatcherMockRecorder {
+	return m.recorder
+}
+
+// FetchPriceChanges mocks base method.
+func (m *MockWishlistPriceWatcher) FetchPriceChanges(ctx context.Context, querier database.Querier, limit int) ([]domain.WishlistPriceChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchPriceChanges", ctx, querier, limit)
+	ret0, _ := ret[0].([]domain.WishlistPriceChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchPriceChanges indicates an expected call of FetchPriceChanges.
+func (mr *MockWishlistPriceWatcherMockRecorder) FetchPriceChanges(ctx, querier, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchPriceChanges", reflect.TypeOf((*MockWishlistPriceWatcher)(nil).FetchPriceChanges), ctx, querier, limit)
+}
+
+// RecordWishlistEvent mocks base method.
+func (m *MockWishlistPriceWatcher) RecordWishlistEvent(ctx context.Context, executor database.Executor, change domain.WishlistPriceChange, kind string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordWishlistEvent", ctx, executor, change, kind)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordWishlistEvent indicates an expected call of RecordWishlistEvent.
+func (mr *MockWishlistPriceWatcherMockRecorder) RecordWishlistEvent(ctx, executor, change, kind interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordWishlistEvent", reflect.TypeOf((*MockWishlistPriceWatcher)(nil).RecordWishlistEvent), ctx, executor, change, kind)
+}
+
+// UpdateSeenPrice mocks base method.
+func (m *MockWishlistPriceWatcher) UpdateSeenPrice(ctx context.Context, executor database.Executor, change domain.WishlistPriceChange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSeenPrice", ctx, executor, change)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSeenPrice indicates an expected call of UpdateSeenPrice.
+func (mr *MockWishlistPriceWatcherMockRecorder) UpdateSeenPrice(ctx, executor, change interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSeenPrice", reflect.TypeOf((*MockWishlistPriceWatcher)(nil).UpdateSeenPrice), ctx, executor, change)
+}
+
+// MockWishlistStockWatcher is a mock of WishlistStockWatcher interface.
+type MockWishlistStockWatcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockWishlistStockWatcherMockRecorder
+}
+
+// MockWishlistStockWatcherMockRecorder is the mock recorder for MockWishlistStockWatcher.
+type MockWishlistStockWatcherMockRecorder struct {
+	mock *MockWishlistStockWatcher
+}
+
+// NewMockWishlistStockWatcher creates a new mock instance.
+func NewMockWishlistStockWatcher(ctrl *gomock.Controller) *MockWishlistStockWatcher {
+	mock := &MockWishlistStockWatcher{ctrl: ctrl}
+	mock.recorder = &MockWishlistStockWatcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWishlistStockWatcher) EXPECT() *MockWishlistStockWatcherMockRecorder {
+	return m.recorder
+}
+
+// RecordBackInStock mocks base method.
+func (m *MockWishlistStockWatcher) RecordBackInStock(ctx context.Context, executor database.Executor, goodID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordBackInStock", ctx, executor, goodID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordBackInStock indicates an expected call of RecordBackInStock.
+func (mr *MockWishlistStockWatcherMockRecorder) RecordBackInStock(ctx, executor, goodID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordBackInStock", reflect.TypeOf((*MockWishlistStockWatcher)(nil).RecordBackInStock), ctx, executor, goodID)
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/Lexv0lk/merch-store/internal/auth/domain"
+	"github.com/Lexv0lk/merch-store/internal/pkg/audit"
 	"github.com/Lexv0lk/merch-store/internal/pkg/jwt"
 )
 
@@ -16,6 +17,7 @@ type Authenticator struct {
 	usersRepository domain.UsersRepository
 	passwordHasher  domain.PasswordHasher
 	tokenIssuer     jwt.TokenIssuer
+	auditRecorder   audit.Recorder
 	secretKey       []byte
 }
 
@@ -23,12 +25,14 @@ func NewAuthenticator(
 	usersRepository domain.UsersRepository,
 	passwordHasher domain.PasswordHasher,
 	tokenIssuer jwt.TokenIssuer,
+	auditRecorder audit.Recorder,
 	secretKey string,
 ) *Authenticator {
 	return &Authenticator{
 		usersRepository: usersRepository,
 		passwordHasher:  passwordHasher,
 		tokenIssuer:     tokenIssuer,
+		auditRecorder:   auditRecorder,
 		secretKey:       []byte(secretKey),
 	}
 }
@@ -56,9 +60,43 @@ func (a *Authenticator) Authenticate(ctx context.Context, username, password str
 		}
 
 		if !valid {
-			return "", &domain.CredentialsMismatchError{Msg: "username or password is incorrect"}
+			return "", a.recordFailedLogin(ctx, userInfo, &domain.CredentialsMismatchError{Msg: "username or password is incorrect"})
 		}
+
+		if !userInfo.IsActive {
+			return "", a.recordFailedLogin(ctx, userInfo, &domain.UserDeactivatedError{Msg: "user is deactivated"})
+		}
+	}
+
+	token, err := a.tokenIssuer.IssueToken(a.secretKey, userInfo.ID, userInfo.Username, userInfo.Role, tokenTimeLimit)
+	if err != nil {
+		return "", err
+	}
+
+	err = a.auditRecorder.Record(ctx, audit.Event{
+		ActorID: userInfo.ID,
+		Action:  audit.ActionLogin,
+		Target:  audit.UserTarget(userInfo.Username),
+		After:   map[string]any{"role": userInfo.Role, "registered": !found},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+// recordFailedLogin stores the rejected attempt and returns loginErr, or the recording error if storing failed.
+func (a *Authenticator) recordFailedLogin(ctx context.Context, userInfo domain.UserInfo, loginErr error) error {
+	err := a.auditRecorder.Record(ctx, audit.Event{
+		ActorID: userInfo.ID,
+		Action:  audit.ActionLoginFailed,
+		Target:  audit.UserTarget(userInfo.Username),
+		After:   map[string]any{"reason": loginErr.Error()},
+	})
+	if err != nil {
+		return err
 	}
 
-	return a.tokenIssuer.IssueToken(a.secretKey, userInfo.ID, userInfo.Username, tokenTimeLimit)
+	return loginErr
 }
@@ -1,12 +1,15 @@
 package application
 
 import (
+	"context"
 	"testing"
 	"time"
 
+	auditmocks "github.com/Lexv0lk/merch-store/gen/mocks/audit"
 	authmocks "github.com/Lexv0lk/merch-store/gen/mocks/auth"
 	jwtmocks "github.com/Lexv0lk/merch-store/gen/mocks/jwt"
 	"github.com/Lexv0lk/merch-store/internal/auth/domain"
+	"github.com/Lexv0lk/merch-store/internal/pkg/audit"
 	"github.com/Lexv0lk/merch-store/internal/pkg/jwt"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -22,8 +25,9 @@ func TestAuthenticator_Authenticate(t *testing.T) {
 
 		prepareFn func(t *testing.T, ctrl *gomock.Controller) (domain.UsersRepository, domain.PasswordHasher, jwt.TokenIssuer)
 
-		expectedToken string
-		expectedErr   error
+		expectedAuditAction string
+		expectedToken       string
+		expectedErr         error
 	}
 
 	tests := []testCase{
@@ -43,13 +47,16 @@ func TestAuthenticator_Authenticate(t *testing.T) {
 					ID:           1,
 					Username:     "newuser",
 					PasswordHash: "hashed_password",
+					Role:         jwt.RoleEmployee,
+					IsActive:     true,
 				}, nil)
-				tokenIssuer.EXPECT().IssueToken([]byte("secret"), 1, "newuser", time.Hour).Return("jwt_token", nil)
+				tokenIssuer.EXPECT().IssueToken([]byte("secret"), 1, "newuser", jwt.RoleEmployee, time.Hour).Return("jwt_token", nil)
 
 				return usersRepo, passwordHasher, tokenIssuer
 			},
-			expectedToken: "jwt_token",
-			expectedErr:   nil,
+			expectedAuditAction: audit.ActionLogin,
+			expectedToken:       "jwt_token",
+			expectedErr:         nil,
 		},
 		{
 			name:      "existing user with correct password",
@@ -65,14 +72,17 @@ func TestAuthenticator_Authenticate(t *testing.T) {
 					ID:           2,
 					Username:     "existinguser",
 					PasswordHash: "stored_hash",
+					Role:         jwt.RoleEmployee,
+					IsActive:     true,
 				}, true, nil)
 				passwordHasher.EXPECT().VerifyPassword("correctpassword", "stored_hash").Return(true, nil)
-				tokenIssuer.EXPECT().IssueToken([]byte("secret"), 2, "existinguser", time.Hour).Return("jwt_token", nil)
+				tokenIssuer.EXPECT().IssueToken([]byte("secret"), 2, "existinguser", jwt.RoleEmployee, time.Hour).Return("jwt_token", nil)
 
 				return usersRepo, passwordHasher, tokenIssuer
 			},
-			expectedToken: "jwt_token",
-			expectedErr:   nil,
+			expectedAuditAction: audit.ActionLogin,
+			expectedToken:       "jwt_token",
+			expectedErr:         nil,
 		},
 		{
 			name:      "existing user with incorrect password",
@@ -88,13 +98,41 @@ func TestAuthenticator_Authenticate(t *testing.T) {
 					ID:           2,
 					Username:     "existinguser",
 					PasswordHash: "stored_hash",
+					Role:         jwt.RoleEmployee,
+					IsActive:     true,
 				}, true, nil)
 				passwordHasher.EXPECT().VerifyPassword("wrongpassword", "stored_hash").Return(false, nil)
 
 				return usersRepo, passwordHasher, tokenIssuer
 			},
-			expectedToken: "",
-			expectedErr:   &domain.CredentialsMismatchError{},
+			expectedAuditAction: audit.ActionLoginFailed,
+			expectedToken:       "",
+			expectedErr:         &domain.CredentialsMismatchError{},
+		},
+		{
+			name:      "deactivated user with correct password",
+			username:  "leaver",
+			password:  "correctpassword",
+			secretKey: "secret",
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) (domain.UsersRepository, domain.PasswordHasher, jwt.TokenIssuer) {
+				usersRepo := authmocks.NewMockUsersRepository(ctrl)
+				passwordHasher := authmocks.NewMockPasswordHasher(ctrl)
+				tokenIssuer := jwtmocks.NewMockTokenIssuer(ctrl)
+
+				usersRepo.EXPECT().TryGetUserInfo(gomock.Any(), "leaver").Return(domain.UserInfo{
+					ID:           3,
+					Username:     "leaver",
+					PasswordHash: "stored_hash",
+					Role:         jwt.RoleEmployee,
+					IsActive:     false,
+				}, true, nil)
+				passwordHasher.EXPECT().VerifyPassword("correctpassword", "stored_hash").Return(true, nil)
+
+				return usersRepo, passwordHasher, tokenIssuer
+			},
+			expectedAuditAction: audit.ActionLoginFailed,
+			expectedToken:       "",
+			expectedErr:         &domain.UserDeactivatedError{},
 		},
 		{
 			name:      "error getting user info",
@@ -164,6 +202,8 @@ func TestAuthenticator_Authenticate(t *testing.T) {
 					ID:           1,
 					Username:     "existinguser",
 					PasswordHash: "stored_hash",
+					Role:         jwt.RoleEmployee,
+					IsActive:     true,
 				}, true, nil)
 				passwordHasher.EXPECT().VerifyPassword("password", "stored_hash").Return(false, assert.AnError)
 
@@ -188,8 +228,10 @@ func TestAuthenticator_Authenticate(t *testing.T) {
 					ID:           1,
 					Username:     "newuser",
 					PasswordHash: "hashed_password",
+					Role:         jwt.RoleEmployee,
+					IsActive:     true,
 				}, nil)
-				tokenIssuer.EXPECT().IssueToken([]byte("secret"), 1, "newuser", time.Hour).Return("", assert.AnError)
+				tokenIssuer.EXPECT().IssueToken([]byte("secret"), 1, "newuser", jwt.RoleEmployee, time.Hour).Return("", assert.AnError)
 
 				return usersRepo, passwordHasher, tokenIssuer
 			},
@@ -205,7 +247,17 @@ func TestAuthenticator_Authenticate(t *testing.T) {
 			ctrl := gomock.NewController(t)
 
 			usersRepoMock, passwordHasherMock, tokenIssuerMock := tc.prepareFn(t, ctrl)
-			authenticator := NewAuthenticator(usersRepoMock, passwordHasherMock, tokenIssuerMock, tc.secretKey)
+			auditRecorderMock := auditmocks.NewMockRecorder(ctrl)
+			if tc.expectedAuditAction != "" {
+				auditRecorderMock.EXPECT().Record(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, event audit.Event) error {
+						assert.Equal(t, tc.expectedAuditAction, event.Action)
+						assert.Equal(t, audit.UserTarget(tc.username), event.Target)
+						return nil
+					})
+			}
+
+			authenticator := NewAuthenticator(usersRepoMock, passwordHasherMock, tokenIssuerMock, auditRecorderMock, tc.secretKey)
 
 			token, err := authenticator.Authenticate(t.Context(), tc.username, tc.password)
 
@@ -10,6 +10,7 @@ import (
 	"github.com/Lexv0lk/merch-store/internal/auth/domain"
 	grpcwrap "github.com/Lexv0lk/merch-store/internal/auth/grpc"
 	"github.com/Lexv0lk/merch-store/internal/auth/infrastructure/postgres"
+	"github.com/Lexv0lk/merch-store/internal/pkg/audit"
 	"github.com/Lexv0lk/merch-store/internal/pkg/jwt"
 	"github.com/Lexv0lk/merch-store/internal/pkg/logging"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -47,11 +48,14 @@ func (a *AuthApp) Run(ctx context.Context, grpcLis net.Listener) error {
 	passwordHasher := domain.NewArgonPasswordHasher()
 	tokenIssuer := jwt.NewJWTTokenIssuer()
 	postgresUserRepository := postgres.NewUsersRepository(dbpool, logger)
+	auditLog := audit.NewPostgresLog(dbpool)
 
-	authenticator := application.NewAuthenticator(postgresUserRepository, passwordHasher, tokenIssuer, a.cfg.SecretKey)
+	authenticator := application.NewAuthenticator(postgresUserRepository, passwordHasher, tokenIssuer, auditLog, a.cfg.SecretKey)
 
-	grpcServer := grpc.NewServer()
-	authServer := grpcwrap.NewAuthServerGRPC(authenticator, postgresUserRepository, logger)
+	roleInterceptor := grpcwrap.NewRoleInterceptorFabric(a.cfg.SecretKey, jwt.NewJWTTokenParser(), logger)
+
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(audit.RequestIDInterceptor, roleInterceptor.GetInterceptor()))
+	authServer := grpcwrap.NewAuthServerGRPC(authenticator, postgresUserRepository, auditLog, logger)
 	merchapi.RegisterAuthServiceServer(grpcServer, authServer)
 
 	a.grpcServer = grpcServer
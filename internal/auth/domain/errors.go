@@ -33,3 +33,20 @@ func (e *UserNotFoundError) Is(target error) bool {
 }
 
 //endregion
+
+//region UserDeactivatedError
+
+type UserDeactivatedError struct {
+	Msg string
+}
+
+func (e *UserDeactivatedError) Error() string {
+	return e.Msg
+}
+
+func (e *UserDeactivatedError) Is(target error) bool {
+	_, ok := target.(*UserDeactivatedError)
+	return ok
+}
+
+//endregion
@@ -7,10 +7,14 @@ type UsersRepository interface {
 	TryGetUserInfo(ctx context.Context, username string) (UserInfo, bool, error)
 	GetUserID(ctx context.Context, username string) (int, error)
 	GetUsernames(ctx context.Context, userIDs []int) (map[int]string, error)
+	GetUserIDs(ctx context.Context, usernames []string) (map[string]int, error)
+	DeactivateUser(ctx context.Context, userID int) error
 }
 
 type UserInfo struct {
 	ID           int
 	Username     string
 	PasswordHash string
+	Role         string
+	IsActive     bool
 }
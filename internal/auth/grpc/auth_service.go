@@ -3,28 +3,35 @@ package grpc
 import (
 	"context"
 	"errors"
+	"time"
 
 	merchapi "github.com/Lexv0lk/merch-store/gen/merch/v1"
 	"github.com/Lexv0lk/merch-store/internal/auth/domain"
+	"github.com/Lexv0lk/merch-store/internal/pkg/audit"
 	"github.com/Lexv0lk/merch-store/internal/pkg/jwt"
 	"github.com/Lexv0lk/merch-store/internal/pkg/logging"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+const auditSource = "auth"
+
 type AuthServerGRPC struct {
 	merchapi.UnsafeAuthServiceServer
 
 	authenticator  jwt.Authenticator
 	logger         logging.Logger
 	userRepository domain.UsersRepository
+	auditReader    audit.Reader
 }
 
-func NewAuthServerGRPC(authenticator jwt.Authenticator, userRepository domain.UsersRepository, logger logging.Logger) *AuthServerGRPC {
+func NewAuthServerGRPC(authenticator jwt.Authenticator, userRepository domain.UsersRepository,
+	auditReader audit.Reader, logger logging.Logger) *AuthServerGRPC {
 	return &AuthServerGRPC{
 		authenticator:  authenticator,
 		logger:         logger,
 		userRepository: userRepository,
+		auditReader:    auditReader,
 	}
 }
 
@@ -40,6 +47,10 @@ func (s *AuthServerGRPC) Authenticate(ctx context.Context, in *merchapi.AuthRequ
 			return nil, status.Error(codes.Unauthenticated, "mismatched credentials")
 		}
 
+		if errors.Is(err, &domain.UserDeactivatedError{}) {
+			return nil, status.Error(codes.PermissionDenied, "user is deactivated")
+		}
+
 		return nil, status.Error(codes.Internal, "internal server error")
 	}
 
@@ -83,3 +94,89 @@ func (s *AuthServerGRPC) GetUsernames(ctx context.Context, in *merchapi.GetUsern
 
 	return &merchapi.GetUsernamesResponse{Usernames: usernames}, nil
 }
+
+// GetUserIDs resolves the given usernames at once. Unknown usernames are left out of the response.
+func (s *AuthServerGRPC) GetUserIDs(ctx context.Context, in *merchapi.GetUserIDsRequest) (*merchapi.GetUserIDsResponse, error) {
+	userIDsMap, err := s.userRepository.GetUserIDs(ctx, in.GetUsernames())
+	if err != nil {
+		s.logger.Error("failed to get user IDs", "error", err.Error())
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	userIDs := make(map[string]int32, len(userIDsMap))
+	for username, id := range userIDsMap {
+		userIDs[username] = int32(id)
+	}
+
+	return &merchapi.GetUserIDsResponse{UserIDs: userIDs}, nil
+}
+
+func (s *AuthServerGRPC) DeactivateUser(ctx context.Context, in *merchapi.DeactivateUserRequest) (*merchapi.DeactivateUserResponse, error) {
+	err := s.userRepository.DeactivateUser(ctx, int(in.GetUserID()))
+	if err != nil {
+		s.logger.Error("failed to deactivate user", "error", err.Error())
+
+		if errors.Is(err, &domain.UserNotFoundError{}) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &merchapi.DeactivateUserResponse{Success: true}, nil
+}
+
+func (s *AuthServerGRPC) ListAuditEvents(ctx context.Context, in *merchapi.ListAuditEventsRequest) (*merchapi.ListAuditEventsResponse, error) {
+	from, err := audit.ParseTimeBound(in.GetFrom())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	to, err := audit.ParseTimeBound(in.GetTo())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	events, err := s.auditReader.ListEvents(ctx, audit.Filter{
+		ActorID: int(in.GetActorID()),
+		Action:  in.GetAction(),
+		Target:  in.GetTarget(),
+		From:    from,
+		To:      to,
+		Limit:   int(in.GetLimit()),
+	})
+	if err != nil {
+		s.logger.Error("failed to list audit events", "error", err.Error())
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	actorIDs := make([]int, 0, len(events))
+	for _, event := range events {
+		actorIDs = append(actorIDs, event.ActorID)
+	}
+
+	usernames, err := s.userRepository.GetUsernames(ctx, actorIDs)
+	if err != nil {
+		s.logger.Error("failed to get usernames", "error", err.Error())
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	resp := &merchapi.ListAuditEventsResponse{
+		Events: make([]*merchapi.AuditEvent, 0, len(events)),
+	}
+	for _, event := range events {
+		resp.Events = append(resp.Events, &merchapi.AuditEvent{
+			Source:    auditSource,
+			ActorID:   int32(event.ActorID),
+			Actor:     usernames[event.ActorID],
+			Action:    event.Action,
+			Target:    event.Target,
+			RequestID: event.RequestID,
+			Before:    audit.EncodeValues(event.Before),
+			After:     audit.EncodeValues(event.After),
+			CreatedAt: event.CreatedAt.UTC().Format(time.RFC3339),
+		})
+	}
+
+	return resp, nil
+}
@@ -3,12 +3,15 @@ package grpc
 import (
 	"errors"
 	"testing"
+	"time"
 
 	merchapi "github.com/Lexv0lk/merch-store/gen/merch/v1"
+	auditmocks "github.com/Lexv0lk/merch-store/gen/mocks/audit"
 	authmocks "github.com/Lexv0lk/merch-store/gen/mocks/auth"
 	jwtmocks "github.com/Lexv0lk/merch-store/gen/mocks/jwt"
 	loggingmocks "github.com/Lexv0lk/merch-store/gen/mocks/logging"
 	"github.com/Lexv0lk/merch-store/internal/auth/domain"
+	"github.com/Lexv0lk/merch-store/internal/pkg/audit"
 	"github.com/Lexv0lk/merch-store/internal/pkg/jwt"
 	"github.com/Lexv0lk/merch-store/internal/pkg/logging"
 	"github.com/golang/mock/gomock"
@@ -31,6 +34,7 @@ func TestAuthServerGRPC_Authenticate(t *testing.T) {
 	}
 
 	unauthenticated := codes.Unauthenticated
+	permissionDenied := codes.PermissionDenied
 	internal := codes.Internal
 
 	tests := []testCase{
@@ -71,6 +75,25 @@ func TestAuthServerGRPC_Authenticate(t *testing.T) {
 			expectedResp: merchapi.AuthResponse{},
 			expectedCode: &unauthenticated,
 		},
+		{
+			name: "deactivated user error",
+			req: merchapi.AuthRequest{
+				Username: "leaver",
+				Password: "testpassword",
+			},
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) (jwt.Authenticator, domain.UsersRepository, logging.Logger) {
+				authenticator := jwtmocks.NewMockAuthenticator(ctrl)
+				usersRepo := authmocks.NewMockUsersRepository(ctrl)
+				logger := loggingmocks.NewMockLogger(ctrl)
+
+				authenticator.EXPECT().Authenticate(gomock.Any(), "leaver", "testpassword").Return("", &domain.UserDeactivatedError{Msg: "user is deactivated"})
+				logger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any())
+
+				return authenticator, usersRepo, logger
+			},
+			expectedResp: merchapi.AuthResponse{},
+			expectedCode: &permissionDenied,
+		},
 		{
 			name: "internal server error",
 			req: merchapi.AuthRequest{
@@ -98,7 +121,7 @@ func TestAuthServerGRPC_Authenticate(t *testing.T) {
 			t.Parallel()
 			authenticator, usersRepo, logger := tt.prepareFn(t, gomock.NewController(t))
 
-			authServer := NewAuthServerGRPC(authenticator, usersRepo, logger)
+			authServer := NewAuthServerGRPC(authenticator, usersRepo, auditmocks.NewMockReader(gomock.NewController(t)), logger)
 
 			resp, err := authServer.Authenticate(t.Context(), &tt.req)
 
@@ -111,3 +134,172 @@ func TestAuthServerGRPC_Authenticate(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthServerGRPC_DeactivateUser(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name string
+		req  merchapi.DeactivateUserRequest
+
+		prepareFn func(t *testing.T, ctrl *gomock.Controller) (domain.UsersRepository, logging.Logger)
+
+		expectedCode codes.Code
+	}
+
+	tests := []testCase{
+		{
+			name: "successful deactivation",
+			req:  merchapi.DeactivateUserRequest{UserID: 1},
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) (domain.UsersRepository, logging.Logger) {
+				usersRepo := authmocks.NewMockUsersRepository(ctrl)
+				logger := loggingmocks.NewMockLogger(ctrl)
+
+				usersRepo.EXPECT().DeactivateUser(gomock.Any(), 1).Return(nil)
+
+				return usersRepo, logger
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name: "user not found",
+			req:  merchapi.DeactivateUserRequest{UserID: 42},
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) (domain.UsersRepository, logging.Logger) {
+				usersRepo := authmocks.NewMockUsersRepository(ctrl)
+				logger := loggingmocks.NewMockLogger(ctrl)
+
+				usersRepo.EXPECT().DeactivateUser(gomock.Any(), 42).Return(&domain.UserNotFoundError{})
+				logger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any())
+
+				return usersRepo, logger
+			},
+			expectedCode: codes.NotFound,
+		},
+		{
+			name: "internal server error",
+			req:  merchapi.DeactivateUserRequest{UserID: 1},
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) (domain.UsersRepository, logging.Logger) {
+				usersRepo := authmocks.NewMockUsersRepository(ctrl)
+				logger := loggingmocks.NewMockLogger(ctrl)
+
+				usersRepo.EXPECT().DeactivateUser(gomock.Any(), 1).Return(errors.New("database error"))
+				logger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any())
+
+				return usersRepo, logger
+			},
+			expectedCode: codes.Internal,
+		},
+	}
+
+	for i := range tests {
+		tt := &tests[i]
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			usersRepo, logger := tt.prepareFn(t, ctrl)
+
+			authServer := NewAuthServerGRPC(jwtmocks.NewMockAuthenticator(ctrl), usersRepo, auditmocks.NewMockReader(ctrl), logger)
+
+			resp, err := authServer.DeactivateUser(t.Context(), &tt.req)
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.expectedCode == codes.OK {
+				assert.True(t, resp.Success)
+			}
+		})
+	}
+}
+
+func TestAuthServerGRPC_ListAuditEvents(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name string
+		req  merchapi.ListAuditEventsRequest
+
+		prepareFn func(t *testing.T, ctrl *gomock.Controller) (domain.UsersRepository, audit.Reader, logging.Logger)
+
+		expectedCode   codes.Code
+		expectedEvents []*merchapi.AuditEvent
+	}
+
+	createdAt := time.Date(2026, 4, 12, 10, 0, 0, 0, time.UTC)
+
+	tests := []testCase{
+		{
+			name: "events listed with usernames",
+			req:  merchapi.ListAuditEventsRequest{Action: audit.ActionLogin, From: "2026-04-12T00:00:00Z", Limit: 10},
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) (domain.UsersRepository, audit.Reader, logging.Logger) {
+				usersRepo := authmocks.NewMockUsersRepository(ctrl)
+				reader := auditmocks.NewMockReader(ctrl)
+
+				reader.EXPECT().ListEvents(gomock.Any(), audit.Filter{
+					Action: audit.ActionLogin,
+					From:   time.Date(2026, 4, 12, 0, 0, 0, 0, time.UTC),
+					Limit:  10,
+				}).Return([]audit.Event{{
+					ActorID:   3,
+					Action:    audit.ActionLogin,
+					Target:    "user:alice",
+					RequestID: "req-1",
+					After:     map[string]any{"role": "employee"},
+					CreatedAt: createdAt,
+				}}, nil)
+				usersRepo.EXPECT().GetUsernames(gomock.Any(), []int{3}).Return(map[int]string{3: "alice"}, nil)
+
+				return usersRepo, reader, loggingmocks.NewMockLogger(ctrl)
+			},
+			expectedCode: codes.OK,
+			expectedEvents: []*merchapi.AuditEvent{{
+				Source:    "auth",
+				ActorID:   3,
+				Actor:     "alice",
+				Action:    audit.ActionLogin,
+				Target:    "user:alice",
+				RequestID: "req-1",
+				After:     `{"role":"employee"}`,
+				CreatedAt: "2026-04-12T10:00:00Z",
+			}},
+		},
+		{
+			name: "invalid time bound",
+			req:  merchapi.ListAuditEventsRequest{To: "yesterday"},
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) (domain.UsersRepository, audit.Reader, logging.Logger) {
+				return authmocks.NewMockUsersRepository(ctrl), auditmocks.NewMockReader(ctrl), loggingmocks.NewMockLogger(ctrl)
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "reader error",
+			req:  merchapi.ListAuditEventsRequest{},
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) (domain.UsersRepository, audit.Reader, logging.Logger) {
+				reader := auditmocks.NewMockReader(ctrl)
+				logger := loggingmocks.NewMockLogger(ctrl)
+
+				reader.EXPECT().ListEvents(gomock.Any(), audit.Filter{}).Return(nil, errors.New("database error"))
+				logger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any())
+
+				return authmocks.NewMockUsersRepository(ctrl), reader, logger
+			},
+			expectedCode: codes.Internal,
+		},
+	}
+
+	for i := range tests {
+		tt := &tests[i]
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			usersRepo, reader, logger := tt.prepareFn(t, ctrl)
+
+			authServer := NewAuthServerGRPC(jwtmocks.NewMockAuthenticator(ctrl), usersRepo, reader, logger)
+
+			resp, err := authServer.ListAuditEvents(t.Context(), &tt.req)
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.expectedCode == codes.OK {
+				assert.Equal(t, tt.expectedEvents, resp.Events)
+			}
+		})
+	}
+}
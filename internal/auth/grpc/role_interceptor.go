@@ -0,0 +1,82 @@
+package grpc
+
+import (
+	"context"
+
+	merchapi "github.com/Lexv0lk/merch-store/gen/merch/v1"
+	"github.com/Lexv0lk/merch-store/internal/pkg/jwt"
+	"github.com/Lexv0lk/merch-store/internal/pkg/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// requiredRoles maps the restricted methods to the role the caller must have. The store passes the token of the
+// user it calls them for.
+var requiredRoles = map[string]string{
+	merchapi.AuthService_DeactivateUser_FullMethodName:  jwt.RoleAdmin,
+	merchapi.AuthService_ListAuditEvents_FullMethodName: jwt.RoleAuditor,
+}
+
+type RoleInterceptorFabric struct {
+	secretKey   string
+	tokenParser jwt.TokenParser
+	logger      logging.Logger
+}
+
+func NewRoleInterceptorFabric(
+	secretKey string,
+	tokenParser jwt.TokenParser,
+	logger logging.Logger,
+) *RoleInterceptorFabric {
+	return &RoleInterceptorFabric{
+		secretKey:   secretKey,
+		tokenParser: tokenParser,
+		logger:      logger,
+	}
+}
+
+func (i *RoleInterceptorFabric) GetInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		err := i.checkRole(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// checkRole lets calls of unrestricted methods through and requires a valid token with the required role for
+// the restricted ones.
+func (i *RoleInterceptorFabric) checkRole(ctx context.Context, fullMethod string) error {
+	requiredRole, found := requiredRoles[fullMethod]
+	if !found {
+		return nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	tokens := md.Get(jwt.TokenMetadataKey)
+	if len(tokens) == 0 {
+		return status.Error(codes.Unauthenticated, "authorization token is missing")
+	}
+
+	claims, err := i.tokenParser.ParseToken([]byte(i.secretKey), tokens[0])
+	if err != nil {
+		i.logger.Error("failed to parse user token", "error", err.Error())
+		return status.Error(codes.Unauthenticated, "invalid token")
+	}
+
+	if claims.Role != requiredRole {
+		i.logger.Warn("user without required role tried to call restricted method", "method", fullMethod)
+		return status.Errorf(codes.PermissionDenied, "%s role required", requiredRole)
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	merchapi "github.com/Lexv0lk/merch-store/gen/merch/v1"
+	jwtmocks "github.com/Lexv0lk/merch-store/gen/mocks/jwt"
+	loggingmocks "github.com/Lexv0lk/merch-store/gen/mocks/logging"
+	"github.com/Lexv0lk/merch-store/internal/pkg/jwt"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestRoleInterceptorFabric_GetInterceptor(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name   string
+		method string
+		token  string
+
+		prepareFn func(t *testing.T, tokenParser *jwtmocks.MockTokenParser, logger *loggingmocks.MockLogger)
+
+		expectedErrCode codes.Code
+	}
+
+	tests := []testCase{
+		{
+			name:   "admin deactivates user",
+			method: merchapi.AuthService_DeactivateUser_FullMethodName,
+			token:  "admin_token",
+			prepareFn: func(t *testing.T, tokenParser *jwtmocks.MockTokenParser, logger *loggingmocks.MockLogger) {
+				tokenParser.EXPECT().ParseToken([]byte("secret"), "admin_token").
+					Return(&jwt.Claims{UserID: 1, Role: jwt.RoleAdmin}, nil)
+			},
+			expectedErrCode: codes.OK,
+		},
+		{
+			name:            "deactivation without token",
+			method:          merchapi.AuthService_DeactivateUser_FullMethodName,
+			prepareFn:       func(t *testing.T, tokenParser *jwtmocks.MockTokenParser, logger *loggingmocks.MockLogger) {},
+			expectedErrCode: codes.Unauthenticated,
+		},
+		{
+			name:   "deactivation with invalid token",
+			method: merchapi.AuthService_DeactivateUser_FullMethodName,
+			token:  "invalid_token",
+			prepareFn: func(t *testing.T, tokenParser *jwtmocks.MockTokenParser, logger *loggingmocks.MockLogger) {
+				tokenParser.EXPECT().ParseToken([]byte("secret"), "invalid_token").Return(nil, errors.New("invalid"))
+				logger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any())
+			},
+			expectedErrCode: codes.Unauthenticated,
+		},
+		{
+			name:   "employee deactivates user",
+			method: merchapi.AuthService_DeactivateUser_FullMethodName,
+			token:  "employee_token",
+			prepareFn: func(t *testing.T, tokenParser *jwtmocks.MockTokenParser, logger *loggingmocks.MockLogger) {
+				tokenParser.EXPECT().ParseToken([]byte("secret"), "employee_token").
+					Return(&jwt.Claims{UserID: 2, Role: jwt.RoleEmployee}, nil)
+				logger.EXPECT().Warn(gomock.Any(), gomock.Any(), gomock.Any())
+			},
+			expectedErrCode: codes.PermissionDenied,
+		},
+		{
+			name:   "auditor lists audit events",
+			method: merchapi.AuthService_ListAuditEvents_FullMethodName,
+			token:  "auditor_token",
+			prepareFn: func(t *testing.T, tokenParser *jwtmocks.MockTokenParser, logger *loggingmocks.MockLogger) {
+				tokenParser.EXPECT().ParseToken([]byte("secret"), "auditor_token").
+					Return(&jwt.Claims{UserID: 3, Role: jwt.RoleAuditor}, nil)
+			},
+			expectedErrCode: codes.OK,
+		},
+		{
+			name:            "audit events without token",
+			method:          merchapi.AuthService_ListAuditEvents_FullMethodName,
+			prepareFn:       func(t *testing.T, tokenParser *jwtmocks.MockTokenParser, logger *loggingmocks.MockLogger) {},
+			expectedErrCode: codes.Unauthenticated,
+		},
+		{
+			name:   "admin lists audit events",
+			method: merchapi.AuthService_ListAuditEvents_FullMethodName,
+			token:  "admin_token",
+			prepareFn: func(t *testing.T, tokenParser *jwtmocks.MockTokenParser, logger *loggingmocks.MockLogger) {
+				tokenParser.EXPECT().ParseToken([]byte("secret"), "admin_token").
+					Return(&jwt.Claims{UserID: 1, Role: jwt.RoleAdmin}, nil)
+				logger.EXPECT().Warn(gomock.Any(), gomock.Any(), gomock.Any())
+			},
+			expectedErrCode: codes.PermissionDenied,
+		},
+		{
+			name:            "unrestricted method without token",
+			method:          merchapi.AuthService_GetUsernames_FullMethodName,
+			prepareFn:       func(t *testing.T, tokenParser *jwtmocks.MockTokenParser, logger *loggingmocks.MockLogger) {},
+			expectedErrCode: codes.OK,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			tokenParser := jwtmocks.NewMockTokenParser(ctrl)
+			logger := loggingmocks.NewMockLogger(ctrl)
+			tt.prepareFn(t, tokenParser, logger)
+
+			ctx := context.Background()
+			if tt.token != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(jwt.TokenMetadataKey, tt.token))
+			}
+
+			handlerCalled := false
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				handlerCalled = true
+				return nil, nil
+			}
+
+			fabric := NewRoleInterceptorFabric("secret", tokenParser, logger)
+			_, err := fabric.GetInterceptor()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+
+			assert.Equal(t, tt.expectedErrCode, status.Code(err))
+			assert.Equal(t, tt.expectedErrCode == codes.OK, handlerCalled)
+		})
+	}
+}
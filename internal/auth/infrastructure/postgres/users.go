@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/Lexv0lk/merch-store/internal/auth/domain"
 	"github.com/Lexv0lk/merch-store/internal/pkg/database"
@@ -23,11 +24,12 @@ func NewUsersRepository(querier database.Querier, logger logging.Logger) *UsersR
 }
 
 func (r *UsersRepository) CreateUser(ctx context.Context, username, hashedPassword string) (domain.UserInfo, error) {
-	creationSQL := `INSERT INTO users (username, password_hash) VALUES ($1, $2) RETURNING id, username, password_hash`
+	creationSQL := `INSERT INTO users (username, password_hash) VALUES ($1, $2)
+			RETURNING id, username, password_hash, role, is_active`
 
 	var userInfo domain.UserInfo
 	row := r.querier.QueryRow(ctx, creationSQL, username, hashedPassword)
-	err := row.Scan(&userInfo.ID, &userInfo.Username, &userInfo.PasswordHash)
+	err := row.Scan(&userInfo.ID, &userInfo.Username, &userInfo.PasswordHash, &userInfo.Role, &userInfo.IsActive)
 	if err != nil {
 		return domain.UserInfo{}, err
 	}
@@ -37,10 +39,10 @@ func (r *UsersRepository) CreateUser(ctx context.Context, username, hashedPasswo
 
 func (r *UsersRepository) TryGetUserInfo(ctx context.Context, username string) (domain.UserInfo, bool, error) {
 	var userInfo domain.UserInfo
-	querySQL := `SELECT id, username, password_hash FROM users WHERE username = $1`
+	querySQL := `SELECT id, username, password_hash, role, is_active FROM users WHERE username = $1`
 
 	row := r.querier.QueryRow(ctx, querySQL, username)
-	err := row.Scan(&userInfo.ID, &userInfo.Username, &userInfo.PasswordHash)
+	err := row.Scan(&userInfo.ID, &userInfo.Username, &userInfo.PasswordHash, &userInfo.Role, &userInfo.IsActive)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return domain.UserInfo{}, false, nil
@@ -97,3 +99,49 @@ func (r *UsersRepository) GetUsernames(ctx context.Context, userIDs []int) (map[
 
 	return usernames, nil
 }
+
+func (r *UsersRepository) GetUserIDs(ctx context.Context, usernames []string) (map[string]int, error) {
+	querySQL := `SELECT id, username FROM users WHERE username = ANY($1)`
+
+	rows, err := r.querier.Query(ctx, querySQL, usernames)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	userIDs := make(map[string]int)
+
+	for rows.Next() {
+		var id int
+		var username string
+
+		if err := rows.Scan(&id, &username); err != nil {
+			return nil, err
+		}
+
+		userIDs[username] = id
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return userIDs, nil
+}
+
+func (r *UsersRepository) DeactivateUser(ctx context.Context, userID int) error {
+	querySQL := `UPDATE users SET is_active = FALSE, deactivated_at = COALESCE(deactivated_at, now())
+			WHERE id = $1 RETURNING id`
+
+	var deactivatedID int
+	err := r.querier.QueryRow(ctx, querySQL, userID).Scan(&deactivatedID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &domain.UserNotFoundError{Msg: fmt.Sprintf("user with id %d not found", userID)}
+		}
+
+		return err
+	}
+
+	return nil
+}
@@ -32,8 +32,8 @@ func TestUsersRepository_CreateUser(t *testing.T) {
 			hashedPassword: "hashed_password",
 			prepareFn: func(t *testing.T, mock pgxmock.PgxPoolIface) {
 				t.Helper()
-				rows := pgxmock.NewRows([]string{"id", "username", "password_hash"}).
-					AddRow(1, "testuser", "hashed_password")
+				rows := pgxmock.NewRows([]string{"id", "username", "password_hash", "role", "is_active"}).
+					AddRow(1, "testuser", "hashed_password", "employee", true)
 				mock.ExpectQuery("INSERT INTO users").
 					WithArgs("testuser", "hashed_password").
 					WillReturnRows(rows)
@@ -42,6 +42,8 @@ func TestUsersRepository_CreateUser(t *testing.T) {
 				ID:           1,
 				Username:     "testuser",
 				PasswordHash: "hashed_password",
+				Role:         "employee",
+				IsActive:     true,
 			},
 			expectedErr: nil,
 		},
@@ -124,8 +126,8 @@ func TestUsersRepository_TryGetUserInfo(t *testing.T) {
 			username: "existinguser",
 			prepareFn: func(t *testing.T, mock pgxmock.PgxPoolIface) {
 				t.Helper()
-				rows := pgxmock.NewRows([]string{"id", "username", "password_hash"}).
-					AddRow(1, "existinguser", "hashed_password")
+				rows := pgxmock.NewRows([]string{"id", "username", "password_hash", "role", "is_active"}).
+					AddRow(1, "existinguser", "hashed_password", "employee", true)
 				mock.ExpectQuery("SELECT").
 					WithArgs("existinguser").
 					WillReturnRows(rows)
@@ -134,6 +136,8 @@ func TestUsersRepository_TryGetUserInfo(t *testing.T) {
 				ID:           1,
 				Username:     "existinguser",
 				PasswordHash: "hashed_password",
+				Role:         "employee",
+				IsActive:     true,
 			},
 			expectedFound: true,
 			expectedErr:   nil,
@@ -195,3 +199,146 @@ func TestUsersRepository_TryGetUserInfo(t *testing.T) {
 		})
 	}
 }
+
+func TestUsersRepository_DeactivateUser(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name   string
+		userID int
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxPoolIface)
+
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name:   "user deactivated",
+			userID: 1,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery("UPDATE users").
+					WithArgs(1).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(1))
+			},
+			expectedErr: nil,
+		},
+		{
+			name:   "user not found",
+			userID: 42,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery("UPDATE users").
+					WithArgs(42).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			expectedErr: &domain.UserNotFoundError{},
+		},
+		{
+			name:   "database error",
+			userID: 1,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery("UPDATE users").
+					WithArgs(1).
+					WillReturnError(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.prepareFn(t, mock)
+
+			logger := mocks.NewMockLogger(ctrl)
+			repo := NewUsersRepository(mock, logger)
+			err = repo.DeactivateUser(t.Context(), tt.userID)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUsersRepository_GetUserIDs(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name      string
+		usernames []string
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxPoolIface)
+
+		expectedIDs map[string]int
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name:      "known users resolved",
+			usernames: []string{"alice", "bob", "ghost"},
+			prepareFn: func(t *testing.T, mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery("SELECT id, username FROM users").
+					WithArgs([]string{"alice", "bob", "ghost"}).
+					WillReturnRows(pgxmock.NewRows([]string{"id", "username"}).AddRow(1, "alice").AddRow(2, "bob"))
+			},
+			expectedIDs: map[string]int{"alice": 1, "bob": 2},
+		},
+		{
+			name:      "database error",
+			usernames: []string{"alice"},
+			prepareFn: func(t *testing.T, mock pgxmock.PgxPoolIface) {
+				t.Helper()
+				mock.ExpectQuery("SELECT id, username FROM users").
+					WithArgs([]string{"alice"}).
+					WillReturnError(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mock, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mock.Close()
+
+			tt.prepareFn(t, mock)
+
+			logger := mocks.NewMockLogger(ctrl)
+			repo := NewUsersRepository(mock, logger)
+			userIDs, err := repo.GetUserIDs(t.Context(), tt.usernames)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedIDs, userIDs)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
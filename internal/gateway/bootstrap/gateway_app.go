@@ -38,7 +38,11 @@ func (a *GatewayApp) Run(ctx context.Context, httpLis net.Listener) error {
 	logger := a.logger
 	cfg := a.cfg
 
-	grpcAuthConn, err := grpc.NewClient(cfg.GrpcAuthHost+cfg.GrpcAuthPort, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	grpcAuthConn, err := grpc.NewClient(
+		cfg.GrpcAuthHost+cfg.GrpcAuthPort,
+		grpc.WithUnaryInterceptor(grpcwrap.NewRequestIDInterceptor),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
 	if err != nil {
 		return fmt.Errorf("failed to connect to auth grpc server: %w", err)
 	}
@@ -46,7 +50,8 @@ func (a *GatewayApp) Run(ctx context.Context, httpLis net.Listener) error {
 
 	grpcStoreConn, err := grpc.NewClient(
 		cfg.GrpcStoreHost+cfg.GrpcStorePort,
-		grpc.WithUnaryInterceptor(grpcwrap.NewJWTTokenInterceptor),
+		grpc.WithChainUnaryInterceptor(grpcwrap.NewJWTTokenInterceptor, grpcwrap.NewRequestIDInterceptor),
+		grpc.WithChainStreamInterceptor(grpcwrap.NewJWTTokenStreamInterceptor, grpcwrap.NewRequestIDStreamInterceptor),
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 	)
 	if err != nil {
@@ -55,6 +60,7 @@ func (a *GatewayApp) Run(ctx context.Context, httpLis net.Listener) error {
 	defer grpcStoreConn.Close()
 
 	router := gin.Default()
+	router.Use(httpwrap.NewRequestIDMiddleware())
 
 	router.GET("/healthz", func(c *gin.Context) {
 		c.Status(http.StatusOK)
@@ -66,6 +72,12 @@ func (a *GatewayApp) Run(ctx context.Context, httpLis net.Listener) error {
 	storeService := grpcwrap.NewStoreAdapter(merchapi.NewMerchStoreServiceClient(grpcStoreConn))
 	storeHandler := httpwrap.NewStoreHandler(storeService)
 
+	adminService := grpcwrap.NewAdminAdapter(merchapi.NewMerchAdminServiceClient(grpcStoreConn))
+	adminHandler := httpwrap.NewAdminHandler(adminService)
+
+	auditService := grpcwrap.NewAuditAdapter(merchapi.NewMerchAuditServiceClient(grpcStoreConn))
+	auditHandler := httpwrap.NewAuditHandler(auditService)
+
 	api := router.Group("/api")
 	{
 		api.POST("/auth", authHandler.Authenticate)
@@ -73,8 +85,71 @@ func (a *GatewayApp) Run(ctx context.Context, httpLis net.Listener) error {
 		authenticated := api.Group("/", httpwrap.NewAuthMiddleware())
 		{
 			authenticated.GET("/info", storeHandler.GetInfo)
+			authenticated.GET("/events", storeHandler.SubscribeEvents)
+			authenticated.GET("/catalog", storeHandler.ListGoods)
+			authenticated.GET("/catalog/:"+httpwrap.ItemNameKey+"/prices", storeHandler.GetPriceHistory)
+			authenticated.GET("/categories", storeHandler.ListCategories)
 			authenticated.POST("/sendCoin", storeHandler.SendCoin)
+			authenticated.POST("/sendCoinBatch", storeHandler.SendCoinsBatch)
 			authenticated.GET("/buy/:"+httpwrap.ItemNameKey, storeHandler.BuyItem)
+			authenticated.POST("/gift/:"+httpwrap.ItemNameKey, storeHandler.GiftItem)
+			authenticated.POST("/transferItem/:"+httpwrap.ItemNameKey, storeHandler.TransferItem)
+			authenticated.POST("/teams/:"+httpwrap.TeamNameKey+"/send", storeHandler.SendFromTeamBudget)
+			authenticated.POST("/payment-requests", storeHandler.CreatePaymentRequest)
+			authenticated.GET("/payment-requests", storeHandler.ListPaymentRequests)
+			authenticated.POST("/payment-requests/:"+httpwrap.PaymentRequestIDKey+"/accept", storeHandler.AcceptPaymentRequest)
+			authenticated.POST("/payment-requests/:"+httpwrap.PaymentRequestIDKey+"/decline", storeHandler.DeclinePaymentRequest)
+			authenticated.POST("/scheduled-transfers", storeHandler.ScheduleTransfer)
+			authenticated.GET("/scheduled-transfers", storeHandler.ListScheduledTransfers)
+			authenticated.DELETE("/scheduled-transfers/:"+httpwrap.ScheduledTransferIDKey, storeHandler.CancelScheduledTransfer)
+			authenticated.POST("/marketplace/listings", storeHandler.CreateListing)
+			authenticated.GET("/marketplace/listings", storeHandler.SearchListings)
+			authenticated.PATCH("/marketplace/listings/:"+httpwrap.ListingIDKey, storeHandler.UpdateListing)
+			authenticated.DELETE("/marketplace/listings/:"+httpwrap.ListingIDKey, storeHandler.CancelListing)
+			authenticated.POST("/marketplace/listings/:"+httpwrap.ListingIDKey+"/buy", storeHandler.BuyListing)
+			authenticated.GET("/auctions", storeHandler.ListAuctions)
+			authenticated.POST("/auctions/:"+httpwrap.AuctionIDKey+"/bids", storeHandler.PlaceBid)
+			authenticated.GET("/raffles", storeHandler.ListRaffles)
+			authenticated.POST("/raffles/:"+httpwrap.RaffleIDKey+"/tickets", storeHandler.BuyRaffleTickets)
+			authenticated.GET("/wishlist", storeHandler.ListWishlist)
+			authenticated.GET("/wishlist/events", storeHandler.ListWishlistEvents)
+			authenticated.PUT("/wishlist/:"+httpwrap.ItemNameKey, storeHandler.AddToWishlist)
+			authenticated.DELETE("/wishlist/:"+httpwrap.ItemNameKey, storeHandler.RemoveFromWishlist)
+			authenticated.POST("/preorders", storeHandler.PlacePreorder)
+			authenticated.GET("/preorders", storeHandler.ListPreorders)
+			authenticated.DELETE("/preorders/:"+httpwrap.PreorderIDKey, storeHandler.CancelPreorder)
+
+			admin := authenticated.Group("/admin")
+			{
+				admin.POST("/users/:"+httpwrap.UsernameKey+"/deactivate", adminHandler.DeactivateAccount)
+				admin.POST("/users/:"+httpwrap.UsernameKey+"/freeze", adminHandler.FreezeAccount)
+				admin.POST("/users/:"+httpwrap.UsernameKey+"/unfreeze", adminHandler.UnfreezeAccount)
+				admin.POST("/teams", adminHandler.CreateTeam)
+				admin.POST("/teams/:"+httpwrap.TeamNameKey+"/members", adminHandler.AddTeamMember)
+				admin.PUT("/teams/:"+httpwrap.TeamNameKey+"/topup", adminHandler.SetTeamBudgetTopUp)
+				admin.PUT("/limits", adminHandler.SetDefaultTransferLimits)
+				admin.PUT("/users/:"+httpwrap.UsernameKey+"/limits", adminHandler.SetUserTransferLimits)
+				admin.GET("/fraud-cases", adminHandler.ListFraudCases)
+				admin.POST("/fraud-cases/:"+httpwrap.FraudCaseIDKey+"/resolve", adminHandler.ResolveFraudCase)
+				admin.POST("/fraud-cases/:"+httpwrap.FraudCaseIDKey+"/freeze", adminHandler.FreezeFraudCase)
+				admin.POST("/auctions", adminHandler.CreateAuction)
+				admin.POST("/raffles", adminHandler.CreateRaffle)
+				admin.POST("/promo-codes", adminHandler.CreatePromoCode)
+				admin.POST("/price-schedules", adminHandler.SchedulePriceChange)
+				admin.POST("/categories", adminHandler.CreateCategory)
+				admin.PUT("/goods/:"+httpwrap.ItemNameKey, adminHandler.UpdateGoodDetails)
+				admin.PUT("/goods/:"+httpwrap.ItemNameKey+"/limit", adminHandler.SetPurchaseLimit)
+				admin.POST("/goods/:"+httpwrap.ItemNameKey+"/announce", adminHandler.AnnounceGood)
+				admin.POST("/goods/:"+httpwrap.ItemNameKey+"/arrived", adminHandler.MarkGoodArrived)
+				admin.POST("/webhooks", adminHandler.CreateWebhookEndpoint)
+				admin.GET("/webhooks", adminHandler.ListWebhookEndpoints)
+				admin.GET("/webhooks/dead-letters", adminHandler.ListWebhookDeadLetters)
+				admin.DELETE("/webhooks/:"+httpwrap.WebhookIDKey, adminHandler.DeleteWebhookEndpoint)
+				admin.GET("/events", adminHandler.FetchEvents)
+				admin.PUT("/events/consumers/:"+httpwrap.ConsumerKey+"/offset", adminHandler.CommitEventOffset)
+			}
+
+			authenticated.GET("/audit", auditHandler.ListAuditLog)
 		}
 	}
 
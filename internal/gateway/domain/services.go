@@ -9,7 +9,72 @@ type AuthService interface {
 }
 
 type StoreService interface {
-	BuyItem(ctx context.Context, itemName string) error
+	BuyItem(ctx context.Context, itemName, variant, promoCode string) error
+	GiftItem(ctx context.Context, itemName, variant, toUsername, message string) error
+	TransferItem(ctx context.Context, itemName, variant, toUsername string) error
 	SendCoins(ctx context.Context, toUsername string, amount uint32) error
-	GetUserInfo(ctx context.Context) (UserInfo, error)
+	SendCoinsBatch(ctx context.Context, transfers []SentTransfer) error
+	GetUserInfo(ctx context.Context, withWishlist bool) (UserInfo, error)
+	SendFromTeamBudget(ctx context.Context, teamName, toUsername string, amount uint32) error
+	CreatePaymentRequest(ctx context.Context, payerUsername string, amount uint32, note string, expiryDays uint32) (int, error)
+	ListPaymentRequests(ctx context.Context) ([]PaymentRequest, error)
+	AcceptPaymentRequest(ctx context.Context, requestID int) error
+	DeclinePaymentRequest(ctx context.Context, requestID int) error
+	ScheduleTransfer(ctx context.Context, toUsername string, amount uint32, startAt, recurrence string) (int, error)
+	ListScheduledTransfers(ctx context.Context) ([]ScheduledTransfer, error)
+	CancelScheduledTransfer(ctx context.Context, scheduleID int) error
+	CreateListing(ctx context.Context, itemName, variant string, price uint32) (int, error)
+	UpdateListing(ctx context.Context, listingID int, price uint32) error
+	CancelListing(ctx context.Context, listingID int) error
+	SearchListings(ctx context.Context, filter ListingFilter) ([]Listing, error)
+	BuyListing(ctx context.Context, listingID int) error
+	ListAuctions(ctx context.Context) ([]Auction, error)
+	PlaceBid(ctx context.Context, auctionID int, amount uint32) error
+	ListRaffles(ctx context.Context) ([]Raffle, error)
+	BuyRaffleTickets(ctx context.Context, raffleID int, count uint32) error
+	AddToWishlist(ctx context.Context, itemName string) error
+	RemoveFromWishlist(ctx context.Context, itemName string) error
+	ListWishlist(ctx context.Context) ([]WishlistItem, error)
+	ListWishlistEvents(ctx context.Context) ([]WishlistEvent, error)
+	ListGoods(ctx context.Context, category string) ([]CatalogItem, error)
+	ListCategories(ctx context.Context) ([]Category, error)
+	GetPriceHistory(ctx context.Context, itemName string) (PriceHistory, error)
+	PlacePreorder(ctx context.Context, itemName, variant string) (int, error)
+	ListPreorders(ctx context.Context) (Preorders, error)
+	CancelPreorder(ctx context.Context, preorderID int) error
+	// SubscribeEvents passes the user's notifications to handle until the context is done, the stream ends or
+	// handle fails.
+	SubscribeEvents(ctx context.Context, handle func(UserEvent) error) error
+}
+
+type AdminService interface {
+	DeactivateAccount(ctx context.Context, username string, sweepBalance bool) (uint32, error)
+	CreateTeam(ctx context.Context, name, managerUsername string) (int, error)
+	AddTeamMember(ctx context.Context, teamName, username string) error
+	SetTeamBudgetTopUp(ctx context.Context, teamName string, amount, periodHours uint32) error
+	SetTransferLimits(ctx context.Context, username string, limits TransferLimits) error
+	ListFraudCases(ctx context.Context, status string) ([]FraudCase, error)
+	ResolveFraudCase(ctx context.Context, caseID int, resolution string) error
+	FreezeFraudCase(ctx context.Context, caseID int, resolution string) error
+	FreezeAccount(ctx context.Context, username, reason string) error
+	UnfreezeAccount(ctx context.Context, username, reason string) error
+	CreateAuction(ctx context.Context, itemName string, reservePrice uint32, startsAt, endsAt string) (int, error)
+	CreateRaffle(ctx context.Context, itemName string, ticketPrice uint32, drawAt string) (int, error)
+	CreatePromoCode(ctx context.Context, promo PromoCode) (int, error)
+	SchedulePriceChange(ctx context.Context, itemName string, price, discountPercent uint32, startsAt, endsAt string) (int, error)
+	CreateCategory(ctx context.Context, slug, name string) (int, error)
+	UpdateGoodDetails(ctx context.Context, itemName string, details GoodDetails) error
+	SetPurchaseLimit(ctx context.Context, itemName string, maxQuantity, periodDays uint32) error
+	AnnounceGood(ctx context.Context, itemName string) error
+	MarkGoodArrived(ctx context.Context, itemName string) (GoodArrival, error)
+	CreateWebhookEndpoint(ctx context.Context, url string, events []string) (WebhookEndpoint, error)
+	ListWebhookEndpoints(ctx context.Context) ([]WebhookEndpoint, error)
+	DeleteWebhookEndpoint(ctx context.Context, endpointID int) error
+	ListWebhookDeadLetters(ctx context.Context) ([]WebhookDeadLetter, error)
+	FetchEvents(ctx context.Context, consumer string, limit uint32) ([]DomainEvent, error)
+	CommitEventOffset(ctx context.Context, consumer string, offset int64) error
+}
+
+type AuditService interface {
+	ListAuditLog(ctx context.Context, filter AuditFilter) ([]AuditEvent, error)
 }
@@ -1,9 +1,13 @@
 package domain
 
+import "encoding/json"
+
 type UserInfo struct {
 	Balance         uint32          `json:"balance"`
 	Inventory       []InventoryItem `json:"inventory"`
 	TransferHistory TransferHistory `json:"coinHistory"`
+	ItemHistory     ItemHistory     `json:"itemHistory"`
+	Wishlist        []WishlistItem  `json:"wishlist,omitempty"`
 }
 
 type TransferHistory struct {
@@ -11,11 +15,40 @@ type TransferHistory struct {
 	Sent     []SentTransfer     `json:"sent"`
 }
 
+// InventoryItem is an owned item. Variants break the quantity down by the bought variant, items bought
+// without a variant aren't listed there.
 type InventoryItem struct {
-	Name     string `json:"type"`
+	Name     string             `json:"type"`
+	Quantity uint32             `json:"quantity"`
+	Gifts    []Gift             `json:"gifts,omitempty"`
+	Variants []InventoryVariant `json:"variants,omitempty"`
+}
+
+type InventoryVariant struct {
+	SKU      string `json:"sku"`
 	Quantity uint32 `json:"quantity"`
 }
 
+type Gift struct {
+	From    string `json:"fromUser"`
+	Message string `json:"message,omitempty"`
+}
+
+type ItemHistory struct {
+	Received []ReceivedItem `json:"received"`
+	Sent     []SentItem     `json:"sent"`
+}
+
+type ReceivedItem struct {
+	From string `json:"fromUser"`
+	Item string `json:"type"`
+}
+
+type SentItem struct {
+	To   string `json:"toUser"`
+	Item string `json:"type"`
+}
+
 type ReceivedTransfer struct {
 	From   string `json:"fromUser"`
 	Amount uint32 `json:"amount"`
@@ -25,3 +58,244 @@ type SentTransfer struct {
 	To     string `json:"toUser"`
 	Amount uint32 `json:"amount"`
 }
+
+type TransferLimits struct {
+	MaxSingleTransfer           uint32 `json:"maxSingleTransfer"`
+	DailyOutgoingCap            uint32 `json:"dailyOutgoingCap"`
+	MaxTransfersPerHour         uint32 `json:"maxTransfersPerHour"`
+	MaxReceivedFromSenderPerDay uint32 `json:"maxReceivedFromSenderPerDay"`
+}
+
+// PromoCode is a discount created by an admin. An empty Item applies it to the whole catalog.
+type PromoCode struct {
+	Code           string `json:"code" binding:"required"`
+	Kind           string `json:"kind" binding:"required,oneof=percent fixed"`
+	Value          uint32 `json:"value" binding:"required,gt=0"`
+	Item           string `json:"type"`
+	MaxUses        uint32 `json:"maxUses"`
+	MaxUsesPerUser uint32 `json:"maxUsesPerUser"`
+	ValidFrom      string `json:"validFrom"`
+	ValidUntil     string `json:"validUntil" binding:"required"`
+}
+
+type FraudCase struct {
+	Id         int      `json:"id"`
+	Pattern    string   `json:"pattern"`
+	Usernames  []string `json:"usernames"`
+	Details    string   `json:"details"`
+	Status     string   `json:"status"`
+	Resolution string   `json:"resolution,omitempty"`
+	CreatedAt  string   `json:"createdAt"`
+}
+
+type PaymentRequest struct {
+	Id        int    `json:"id"`
+	From      string `json:"fromUser"`
+	Amount    uint32 `json:"amount"`
+	Note      string `json:"note,omitempty"`
+	CreatedAt string `json:"createdAt"`
+	ExpiresAt string `json:"expiresAt"`
+}
+
+type ScheduledTransfer struct {
+	Id         int    `json:"id"`
+	To         string `json:"toUser"`
+	Amount     uint32 `json:"amount"`
+	Recurrence string `json:"recurrence"`
+	Status     string `json:"status"`
+	NextRunAt  string `json:"nextRunAt"`
+	Attempts   int    `json:"attempts"`
+	LastError  string `json:"lastError,omitempty"`
+}
+
+type Listing struct {
+	Id        int    `json:"id"`
+	Item      string `json:"type"`
+	Variant   string `json:"variant,omitempty"`
+	Seller    string `json:"seller"`
+	Price     uint32 `json:"price"`
+	CreatedAt string `json:"createdAt"`
+}
+
+type ListingFilter struct {
+	Query    string
+	Seller   string
+	MaxPrice uint32
+	Limit    uint32
+	Offset   uint32
+}
+
+type Auction struct {
+	Id         int    `json:"id"`
+	Item       string `json:"type"`
+	StartsAt   string `json:"startsAt"`
+	EndsAt     string `json:"endsAt"`
+	TopBid     uint32 `json:"topBid"`
+	TopBidder  string `json:"topBidder,omitempty"`
+	ReserveMet bool   `json:"reserveMet"`
+}
+
+type Raffle struct {
+	Id          int    `json:"id"`
+	Item        string `json:"type"`
+	TicketPrice uint32 `json:"ticketPrice"`
+	DrawAt      string `json:"drawAt"`
+	TicketsSold uint32 `json:"ticketsSold"`
+}
+
+type WishlistItem struct {
+	Name        string `json:"type"`
+	Price       uint32 `json:"price"`
+	CoinsNeeded uint32 `json:"coinsNeeded"`
+}
+
+type WishlistEvent struct {
+	Kind      string `json:"kind"`
+	Item      string `json:"type"`
+	OldPrice  uint32 `json:"oldPrice"`
+	NewPrice  uint32 `json:"newPrice"`
+	CreatedAt string `json:"createdAt"`
+}
+
+type AuditEvent struct {
+	Source    string          `json:"source"`
+	Actor     string          `json:"actor"`
+	Action    string          `json:"action"`
+	Target    string          `json:"target"`
+	RequestID string          `json:"requestId,omitempty"`
+	Before    json.RawMessage `json:"before,omitempty"`
+	After     json.RawMessage `json:"after,omitempty"`
+	CreatedAt string          `json:"createdAt"`
+}
+
+type AuditFilter struct {
+	Source string
+	Actor  string
+	Action string
+	Target string
+	From   string
+	To     string
+	Limit  uint32
+}
+
+// CatalogItem is an item at its current price. SaleEndsAt is set while a time-boxed price change is active.
+type CatalogItem struct {
+	Name        string           `json:"type"`
+	Price       uint32           `json:"price"`
+	BasePrice   uint32           `json:"basePrice"`
+	SaleEndsAt  string           `json:"saleEndsAt,omitempty"`
+	Variants    []CatalogVariant `json:"variants,omitempty"`
+	Category    string           `json:"category,omitempty"`
+	Description string           `json:"description,omitempty"`
+	Images      []GoodImage      `json:"images,omitempty"`
+	Components  []BundleItem     `json:"components,omitempty"`
+	Upcoming    bool             `json:"upcoming,omitempty"`
+}
+
+// BundleItem is an item contained in a bundle.
+type BundleItem struct {
+	Name     string `json:"type"`
+	Quantity uint32 `json:"quantity"`
+}
+
+type GoodImage struct {
+	URL     string `json:"url" binding:"required"`
+	AltText string `json:"altText"`
+	Width   uint32 `json:"width"`
+	Height  uint32 `json:"height"`
+}
+
+type Category struct {
+	Slug string `json:"slug"`
+	Name string `json:"name"`
+}
+
+// GoodDetails replaces the category, description and images of an item. An empty category leaves it
+// uncategorized.
+type GoodDetails struct {
+	Category    string      `json:"category"`
+	Description string      `json:"description"`
+	Images      []GoodImage `json:"images" binding:"dive"`
+}
+
+// CatalogVariant is a variant of an item that must be picked when buying it. Stock is only set for variants
+// with tracked stock.
+type CatalogVariant struct {
+	SKU        string            `json:"sku"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+	Price      uint32            `json:"price"`
+	Stock      *uint32           `json:"stock,omitempty"`
+}
+
+type PriceHistory struct {
+	BasePrice uint32        `json:"basePrice"`
+	Changes   []PriceChange `json:"changes"`
+}
+
+type PriceChange struct {
+	Price    uint32 `json:"price"`
+	StartsAt string `json:"startsAt"`
+	EndsAt   string `json:"endsAt,omitempty"`
+}
+
+// Preorders lists the user's pre-orders together with the coins held for the pending ones.
+type Preorders struct {
+	HeldCoins uint32     `json:"heldCoins"`
+	Preorders []Preorder `json:"preorders"`
+}
+
+type Preorder struct {
+	Id         int    `json:"id"`
+	ItemName   string `json:"type"`
+	Variant    string `json:"variant,omitempty"`
+	Price      uint32 `json:"price"`
+	Status     string `json:"status"`
+	CreatedAt  string `json:"createdAt"`
+	ResolvedAt string `json:"resolvedAt,omitempty"`
+}
+
+// UserEvent is a notification pushed to the user: a new balance, coins received or a pre-order status update.
+type UserEvent struct {
+	Type       string          `json:"type"`
+	Data       json.RawMessage `json:"data,omitempty"`
+	OccurredAt string          `json:"occurredAt"`
+}
+
+// GoodArrival reports the pre-orders fulfilled when a good arrived and the ones still waiting for stock.
+type GoodArrival struct {
+	Fulfilled int `json:"fulfilled"`
+	Pending   int `json:"pending"`
+}
+
+// WebhookEndpoint receives the listed events. Secret is only returned when the endpoint is created.
+type WebhookEndpoint struct {
+	Id        int      `json:"id"`
+	URL       string   `json:"url"`
+	Events    []string `json:"events"`
+	Secret    string   `json:"secret,omitempty"`
+	CreatedAt string   `json:"createdAt,omitempty"`
+}
+
+// WebhookDeadLetter is a webhook that couldn't be delivered within its attempts.
+type WebhookDeadLetter struct {
+	Id         int             `json:"id"`
+	EndpointID int             `json:"endpointId"`
+	URL        string          `json:"url"`
+	Event      string          `json:"event"`
+	Data       json.RawMessage `json:"data,omitempty"`
+	Attempts   int             `json:"attempts"`
+	LastError  string          `json:"lastError"`
+	CreatedAt  string          `json:"createdAt"`
+	FailedAt   string          `json:"failedAt"`
+}
+
+// DomainEvent is an event from the store event log. Offset orders the events, Id stays the same when a relayed
+// event is published again.
+type DomainEvent struct {
+	Id         int64           `json:"id"`
+	Offset     int64           `json:"offset"`
+	Type       string          `json:"type"`
+	UserIDs    []int           `json:"userIds"`
+	Data       json.RawMessage `json:"data,omitempty"`
+	OccurredAt string          `json:"occurredAt"`
+}
@@ -0,0 +1,473 @@
+package grpc
+
+import (
+	"context"
+
+	merchapi "github.com/Lexv0lk/merch-store/gen/merch/v1"
+	"github.com/Lexv0lk/merch-store/internal/gateway/domain"
+)
+
+type AdminAdapter struct {
+	client merchapi.MerchAdminServiceClient
+}
+
+func NewAdminAdapter(client merchapi.MerchAdminServiceClient) *AdminAdapter {
+	return &AdminAdapter{
+		client: client,
+	}
+}
+
+func (a *AdminAdapter) DeactivateAccount(ctx context.Context, username string, sweepBalance bool) (uint32, error) {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	req := &merchapi.DeactivateAccountRequest{
+		Username:     username,
+		SweepBalance: sweepBalance,
+	}
+
+	resp, err := a.client.DeactivateAccount(limitCtx, req)
+	if err != nil {
+		return 0, err
+	}
+
+	return resp.SweptAmount, nil
+}
+
+func (a *AdminAdapter) CreateTeam(ctx context.Context, name, managerUsername string) (int, error) {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	req := &merchapi.CreateTeamRequest{
+		Name:            name,
+		ManagerUsername: managerUsername,
+	}
+
+	resp, err := a.client.CreateTeam(limitCtx, req)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(resp.TeamID), nil
+}
+
+func (a *AdminAdapter) AddTeamMember(ctx context.Context, teamName, username string) error {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	req := &merchapi.AddTeamMemberRequest{
+		TeamName: teamName,
+		Username: username,
+	}
+
+	_, err := a.client.AddTeamMember(limitCtx, req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (a *AdminAdapter) SetTeamBudgetTopUp(ctx context.Context, teamName string, amount, periodHours uint32) error {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	req := &merchapi.SetTeamBudgetTopUpRequest{
+		TeamName:    teamName,
+		Amount:      amount,
+		PeriodHours: periodHours,
+	}
+
+	_, err := a.client.SetTeamBudgetTopUp(limitCtx, req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (a *AdminAdapter) SetTransferLimits(ctx context.Context, username string, limits domain.TransferLimits) error {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	req := &merchapi.SetTransferLimitsRequest{
+		Username:                    username,
+		MaxSingleTransfer:           limits.MaxSingleTransfer,
+		DailyOutgoingCap:            limits.DailyOutgoingCap,
+		MaxTransfersPerHour:         limits.MaxTransfersPerHour,
+		MaxReceivedFromSenderPerDay: limits.MaxReceivedFromSenderPerDay,
+	}
+
+	_, err := a.client.SetTransferLimits(limitCtx, req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (a *AdminAdapter) ListFraudCases(ctx context.Context, status string) ([]domain.FraudCase, error) {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	req := &merchapi.ListFraudCasesRequest{
+		Status: status,
+	}
+
+	resp, err := a.client.ListFraudCases(limitCtx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	cases := make([]domain.FraudCase, 0, len(resp.Cases))
+	for _, fraudCase := range resp.Cases {
+		cases = append(cases, domain.FraudCase{
+			Id:         int(fraudCase.Id),
+			Pattern:    fraudCase.Pattern,
+			Usernames:  fraudCase.Usernames,
+			Details:    fraudCase.Details,
+			Status:     fraudCase.Status,
+			Resolution: fraudCase.Resolution,
+			CreatedAt:  fraudCase.CreatedAt,
+		})
+	}
+
+	return cases, nil
+}
+
+func (a *AdminAdapter) ResolveFraudCase(ctx context.Context, caseID int, resolution string) error {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	req := &merchapi.ResolveFraudCaseRequest{
+		CaseID:     int32(caseID),
+		Resolution: resolution,
+	}
+
+	_, err := a.client.ResolveFraudCase(limitCtx, req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (a *AdminAdapter) FreezeFraudCase(ctx context.Context, caseID int, resolution string) error {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	req := &merchapi.FreezeFraudCaseRequest{
+		CaseID:     int32(caseID),
+		Resolution: resolution,
+	}
+
+	_, err := a.client.FreezeFraudCase(limitCtx, req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (a *AdminAdapter) FreezeAccount(ctx context.Context, username, reason string) error {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	req := &merchapi.FreezeAccountRequest{
+		Username: username,
+		Reason:   reason,
+	}
+
+	_, err := a.client.FreezeAccount(limitCtx, req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (a *AdminAdapter) UnfreezeAccount(ctx context.Context, username, reason string) error {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	req := &merchapi.UnfreezeAccountRequest{
+		Username: username,
+		Reason:   reason,
+	}
+
+	_, err := a.client.UnfreezeAccount(limitCtx, req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (a *AdminAdapter) CreateAuction(ctx context.Context, itemName string, reservePrice uint32, startsAt, endsAt string) (int, error) {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	req := &merchapi.CreateAuctionRequest{
+		ItemName:     itemName,
+		ReservePrice: reservePrice,
+		StartsAt:     startsAt,
+		EndsAt:       endsAt,
+	}
+
+	resp, err := a.client.CreateAuction(limitCtx, req)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(resp.AuctionID), nil
+}
+
+func (a *AdminAdapter) CreateRaffle(ctx context.Context, itemName string, ticketPrice uint32, drawAt string) (int, error) {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	req := &merchapi.CreateRaffleRequest{
+		ItemName:    itemName,
+		TicketPrice: ticketPrice,
+		DrawAt:      drawAt,
+	}
+
+	resp, err := a.client.CreateRaffle(limitCtx, req)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(resp.RaffleID), nil
+}
+
+func (a *AdminAdapter) CreatePromoCode(ctx context.Context, promo domain.PromoCode) (int, error) {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	req := &merchapi.CreatePromoCodeRequest{
+		Code:           promo.Code,
+		Kind:           promo.Kind,
+		Value:          promo.Value,
+		ItemName:       promo.Item,
+		MaxUses:        promo.MaxUses,
+		MaxUsesPerUser: promo.MaxUsesPerUser,
+		ValidFrom:      promo.ValidFrom,
+		ValidUntil:     promo.ValidUntil,
+	}
+
+	resp, err := a.client.CreatePromoCode(limitCtx, req)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(resp.PromoID), nil
+}
+
+func (a *AdminAdapter) SchedulePriceChange(ctx context.Context, itemName string, price, discountPercent uint32,
+	startsAt, endsAt string) (int, error) {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	req := &merchapi.SchedulePriceChangeRequest{
+		ItemName:        itemName,
+		Price:           price,
+		DiscountPercent: discountPercent,
+		StartsAt:        startsAt,
+		EndsAt:          endsAt,
+	}
+
+	resp, err := a.client.SchedulePriceChange(limitCtx, req)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(resp.ScheduleID), nil
+}
+
+func (a *AdminAdapter) CreateCategory(ctx context.Context, slug, name string) (int, error) {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	resp, err := a.client.CreateCategory(limitCtx, &merchapi.CreateCategoryRequest{Slug: slug, Name: name})
+	if err != nil {
+		return 0, err
+	}
+
+	return int(resp.CategoryID), nil
+}
+
+func (a *AdminAdapter) UpdateGoodDetails(ctx context.Context, itemName string, details domain.GoodDetails) error {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	req := &merchapi.UpdateGoodDetailsRequest{
+		ItemName:    itemName,
+		Category:    details.Category,
+		Description: details.Description,
+		Images:      make([]*merchapi.GoodImage, 0, len(details.Images)),
+	}
+	for _, image := range details.Images {
+		req.Images = append(req.Images, &merchapi.GoodImage{
+			Url:     image.URL,
+			AltText: image.AltText,
+			Width:   image.Width,
+			Height:  image.Height,
+		})
+	}
+
+	_, err := a.client.UpdateGoodDetails(limitCtx, req)
+	return err
+}
+
+func (a *AdminAdapter) SetPurchaseLimit(ctx context.Context, itemName string, maxQuantity, periodDays uint32) error {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	req := &merchapi.SetPurchaseLimitRequest{
+		ItemName:    itemName,
+		MaxQuantity: maxQuantity,
+		PeriodDays:  periodDays,
+	}
+
+	_, err := a.client.SetPurchaseLimit(limitCtx, req)
+	return err
+}
+
+func (a *AdminAdapter) AnnounceGood(ctx context.Context, itemName string) error {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	_, err := a.client.AnnounceGood(limitCtx, &merchapi.AnnounceGoodRequest{ItemName: itemName})
+	return err
+}
+
+func (a *AdminAdapter) MarkGoodArrived(ctx context.Context, itemName string) (domain.GoodArrival, error) {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	resp, err := a.client.MarkGoodArrived(limitCtx, &merchapi.MarkGoodArrivedRequest{ItemName: itemName})
+	if err != nil {
+		return domain.GoodArrival{}, err
+	}
+
+	return domain.GoodArrival{
+		Fulfilled: int(resp.Fulfilled),
+		Pending:   int(resp.Pending),
+	}, nil
+}
+
+func (a *AdminAdapter) CreateWebhookEndpoint(ctx context.Context, url string, events []string) (domain.WebhookEndpoint, error) {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	req := &merchapi.CreateWebhookEndpointRequest{
+		Url:    url,
+		Events: events,
+	}
+
+	resp, err := a.client.CreateWebhookEndpoint(limitCtx, req)
+	if err != nil {
+		return domain.WebhookEndpoint{}, err
+	}
+
+	return domain.WebhookEndpoint{
+		Id:     int(resp.Id),
+		URL:    url,
+		Events: events,
+		Secret: resp.Secret,
+	}, nil
+}
+
+func (a *AdminAdapter) ListWebhookEndpoints(ctx context.Context) ([]domain.WebhookEndpoint, error) {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	resp, err := a.client.ListWebhookEndpoints(limitCtx, &merchapi.ListWebhookEndpointsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	endpoints := make([]domain.WebhookEndpoint, 0, len(resp.Endpoints))
+	for _, endpoint := range resp.Endpoints {
+		endpoints = append(endpoints, domain.WebhookEndpoint{
+			Id:        int(endpoint.Id),
+			URL:       endpoint.Url,
+			Events:    endpoint.Events,
+			CreatedAt: endpoint.CreatedAt,
+		})
+	}
+
+	return endpoints, nil
+}
+
+func (a *AdminAdapter) DeleteWebhookEndpoint(ctx context.Context, endpointID int) error {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	_, err := a.client.DeleteWebhookEndpoint(limitCtx, &merchapi.DeleteWebhookEndpointRequest{Id: int32(endpointID)})
+	return err
+}
+
+func (a *AdminAdapter) ListWebhookDeadLetters(ctx context.Context) ([]domain.WebhookDeadLetter, error) {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	resp, err := a.client.ListWebhookDeadLetters(limitCtx, &merchapi.ListWebhookDeadLettersRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	deadLetters := make([]domain.WebhookDeadLetter, 0, len(resp.DeadLetters))
+	for _, deadLetter := range resp.DeadLetters {
+		deadLetters = append(deadLetters, domain.WebhookDeadLetter{
+			Id:         int(deadLetter.Id),
+			EndpointID: int(deadLetter.EndpointId),
+			URL:        deadLetter.Url,
+			Event:      deadLetter.Event,
+			Data:       rawValues(deadLetter.Data),
+			Attempts:   int(deadLetter.Attempts),
+			LastError:  deadLetter.LastError,
+			CreatedAt:  deadLetter.CreatedAt,
+			FailedAt:   deadLetter.FailedAt,
+		})
+	}
+
+	return deadLetters, nil
+}
+
+func (a *AdminAdapter) FetchEvents(ctx context.Context, consumer string, limit uint32) ([]domain.DomainEvent, error) {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	resp, err := a.client.FetchEvents(limitCtx, &merchapi.FetchEventsRequest{Consumer: consumer, Limit: limit})
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]domain.DomainEvent, 0, len(resp.Events))
+	for _, event := range resp.Events {
+		userIDs := make([]int, 0, len(event.UserIds))
+		for _, userID := range event.UserIds {
+			userIDs = append(userIDs, int(userID))
+		}
+
+		events = append(events, domain.DomainEvent{
+			Id:         event.Id,
+			Offset:     event.Offset,
+			Type:       event.Type,
+			UserIDs:    userIDs,
+			Data:       rawValues(event.Data),
+			OccurredAt: event.OccurredAt,
+		})
+	}
+
+	return events, nil
+}
+
+func (a *AdminAdapter) CommitEventOffset(ctx context.Context, consumer string, offset int64) error {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	_, err := a.client.CommitEventOffset(limitCtx, &merchapi.CommitEventOffsetRequest{Consumer: consumer, Offset: offset})
+	return err
+}
@@ -0,0 +1,151 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	merchapi "github.com/Lexv0lk/merch-store/gen/merch/v1"
+	mocks "github.com/Lexv0lk/merch-store/gen/mocks/grpc"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminAdapter_DeactivateAccount(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name         string
+		username     string
+		sweepBalance bool
+
+		expectedSwept uint32
+		expectedErr   error
+
+		prepareFn func(t *testing.T, ctrl *gomock.Controller) merchapi.MerchAdminServiceClient
+	}
+
+	tests := []testCase{
+		{
+			name:          "successful deactivation",
+			username:      "leaver",
+			sweepBalance:  true,
+			expectedSwept: 450,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) merchapi.MerchAdminServiceClient {
+				t.Helper()
+				clientMock := mocks.NewMockMerchAdminServiceClient(ctrl)
+
+				clientMock.EXPECT().DeactivateAccount(gomock.Any(), &merchapi.DeactivateAccountRequest{
+					Username:     "leaver",
+					SweepBalance: true,
+				}).Return(&merchapi.DeactivateAccountResponse{Success: true, SweptAmount: 450}, nil).Times(1)
+
+				return clientMock
+			},
+		},
+		{
+			name:        "fail to deactivate",
+			username:    "leaver",
+			expectedErr: assert.AnError,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) merchapi.MerchAdminServiceClient {
+				t.Helper()
+				clientMock := mocks.NewMockMerchAdminServiceClient(ctrl)
+
+				clientMock.EXPECT().DeactivateAccount(gomock.Any(), gomock.Any()).Return(nil, assert.AnError).Times(1)
+
+				return clientMock
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			clientMock := tt.prepareFn(t, ctrl)
+			adapter := NewAdminAdapter(clientMock)
+
+			swept, err := adapter.DeactivateAccount(context.Background(), tt.username, tt.sweepBalance)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedSwept, swept)
+			}
+		})
+	}
+}
+
+func TestAdminAdapter_CreateTeam(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name            string
+		teamName        string
+		managerUsername string
+
+		expectedID  int
+		expectedErr error
+
+		prepareFn func(t *testing.T, ctrl *gomock.Controller) merchapi.MerchAdminServiceClient
+	}
+
+	tests := []testCase{
+		{
+			name:            "successful team creation",
+			teamName:        "platform",
+			managerUsername: "boss",
+			expectedID:      7,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) merchapi.MerchAdminServiceClient {
+				t.Helper()
+				clientMock := mocks.NewMockMerchAdminServiceClient(ctrl)
+
+				clientMock.EXPECT().CreateTeam(gomock.Any(), &merchapi.CreateTeamRequest{
+					Name:            "platform",
+					ManagerUsername: "boss",
+				}).Return(&merchapi.CreateTeamResponse{TeamID: 7}, nil).Times(1)
+
+				return clientMock
+			},
+		},
+		{
+			name:        "fail to create team",
+			teamName:    "platform",
+			expectedErr: assert.AnError,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) merchapi.MerchAdminServiceClient {
+				t.Helper()
+				clientMock := mocks.NewMockMerchAdminServiceClient(ctrl)
+
+				clientMock.EXPECT().CreateTeam(gomock.Any(), gomock.Any()).Return(nil, assert.AnError).Times(1)
+
+				return clientMock
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			clientMock := tt.prepareFn(t, ctrl)
+			adapter := NewAdminAdapter(clientMock)
+
+			teamID, err := adapter.CreateTeam(context.Background(), tt.teamName, tt.managerUsername)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedID, teamID)
+			}
+		})
+	}
+}
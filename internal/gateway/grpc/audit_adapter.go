@@ -0,0 +1,63 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+
+	merchapi "github.com/Lexv0lk/merch-store/gen/merch/v1"
+	"github.com/Lexv0lk/merch-store/internal/gateway/domain"
+)
+
+type AuditAdapter struct {
+	client merchapi.MerchAuditServiceClient
+}
+
+func NewAuditAdapter(client merchapi.MerchAuditServiceClient) *AuditAdapter {
+	return &AuditAdapter{
+		client: client,
+	}
+}
+
+func (a *AuditAdapter) ListAuditLog(ctx context.Context, filter domain.AuditFilter) ([]domain.AuditEvent, error) {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	req := &merchapi.ListAuditLogRequest{
+		Source: filter.Source,
+		Actor:  filter.Actor,
+		Action: filter.Action,
+		Target: filter.Target,
+		From:   filter.From,
+		To:     filter.To,
+		Limit:  filter.Limit,
+	}
+
+	resp, err := a.client.ListAuditLog(limitCtx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]domain.AuditEvent, 0, len(resp.Events))
+	for _, event := range resp.Events {
+		events = append(events, domain.AuditEvent{
+			Source:    event.Source,
+			Actor:     event.Actor,
+			Action:    event.Action,
+			Target:    event.Target,
+			RequestID: event.RequestID,
+			Before:    rawValues(event.Before),
+			After:     rawValues(event.After),
+			CreatedAt: event.CreatedAt,
+		})
+	}
+
+	return events, nil
+}
+
+func rawValues(encoded string) json.RawMessage {
+	if encoded == "" {
+		return nil
+	}
+
+	return json.RawMessage(encoded)
+}
@@ -0,0 +1,132 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	merchapi "github.com/Lexv0lk/merch-store/gen/merch/v1"
+	mocks "github.com/Lexv0lk/merch-store/gen/mocks/grpc"
+	"github.com/Lexv0lk/merch-store/internal/gateway/domain"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditAdapter_ListAuditLog(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name   string
+		filter domain.AuditFilter
+
+		expectedEvents []domain.AuditEvent
+		expectedErr    error
+
+		prepareFn func(t *testing.T, ctrl *gomock.Controller) merchapi.MerchAuditServiceClient
+	}
+
+	tests := []testCase{
+		{
+			name:   "events listed",
+			filter: domain.AuditFilter{Source: "store", Action: "refund", From: "2026-01-01T00:00:00Z", Limit: 10},
+			expectedEvents: []domain.AuditEvent{
+				{
+					Source:    "store",
+					Actor:     "admin",
+					Action:    "refund",
+					Target:    "auction:4",
+					RequestID: "req-1",
+					After:     json.RawMessage(`{"amount":100,"reason":"outbid","userId":2}`),
+					CreatedAt: "2026-01-02T10:00:00Z",
+				},
+				{
+					Source:    "store",
+					Action:    "account-freeze",
+					Target:    "user:bob",
+					Before:    json.RawMessage(`{"frozen":false}`),
+					After:     json.RawMessage(`{"frozen":true}`),
+					CreatedAt: "2026-01-02T11:00:00Z",
+				},
+			},
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) merchapi.MerchAuditServiceClient {
+				t.Helper()
+				clientMock := mocks.NewMockMerchAuditServiceClient(ctrl)
+
+				clientMock.EXPECT().ListAuditLog(gomock.Any(), &merchapi.ListAuditLogRequest{
+					Source: "store",
+					Action: "refund",
+					From:   "2026-01-01T00:00:00Z",
+					Limit:  10,
+				}).Return(&merchapi.ListAuditLogResponse{Events: []*merchapi.AuditEvent{
+					{
+						Source:    "store",
+						Actor:     "admin",
+						Action:    "refund",
+						Target:    "auction:4",
+						RequestID: "req-1",
+						After:     `{"amount":100,"reason":"outbid","userId":2}`,
+						CreatedAt: "2026-01-02T10:00:00Z",
+					},
+					{
+						Source:    "store",
+						Action:    "account-freeze",
+						Target:    "user:bob",
+						Before:    `{"frozen":false}`,
+						After:     `{"frozen":true}`,
+						CreatedAt: "2026-01-02T11:00:00Z",
+					},
+				}}, nil).Times(1)
+
+				return clientMock
+			},
+		},
+		{
+			name:           "no events",
+			expectedEvents: []domain.AuditEvent{},
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) merchapi.MerchAuditServiceClient {
+				t.Helper()
+				clientMock := mocks.NewMockMerchAuditServiceClient(ctrl)
+
+				clientMock.EXPECT().ListAuditLog(gomock.Any(), &merchapi.ListAuditLogRequest{}).
+					Return(&merchapi.ListAuditLogResponse{}, nil).Times(1)
+
+				return clientMock
+			},
+		},
+		{
+			name:        "fail to list events",
+			expectedErr: assert.AnError,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) merchapi.MerchAuditServiceClient {
+				t.Helper()
+				clientMock := mocks.NewMockMerchAuditServiceClient(ctrl)
+
+				clientMock.EXPECT().ListAuditLog(gomock.Any(), gomock.Any()).Return(nil, assert.AnError).Times(1)
+
+				return clientMock
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			clientMock := tt.prepareFn(t, ctrl)
+			adapter := NewAuditAdapter(clientMock)
+
+			events, err := adapter.ListAuditLog(context.Background(), tt.filter)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedEvents, events)
+			}
+		})
+	}
+}
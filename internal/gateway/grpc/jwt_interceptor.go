@@ -22,3 +22,18 @@ func NewJWTTokenInterceptor(
 
 	return invoker(ctx, method, req, reply, cc, opts...)
 }
+
+func NewJWTTokenStreamInterceptor(
+	ctx context.Context,
+	desc *grpc.StreamDesc,
+	cc *grpc.ClientConn,
+	method string,
+	streamer grpc.Streamer,
+	opts ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	if token, ok := ctx.Value(jwt.TokenContextKey).(string); ok {
+		ctx = metadata.AppendToOutgoingContext(ctx, jwt.TokenMetadataKey, token)
+	}
+
+	return streamer(ctx, desc, cc, method, opts...)
+}
@@ -0,0 +1,39 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/audit"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func NewRequestIDInterceptor(
+	ctx context.Context,
+	method string,
+	req, reply interface{},
+	cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	if requestID, ok := ctx.Value(audit.RequestIDContextKey).(string); ok {
+		ctx = metadata.AppendToOutgoingContext(ctx, audit.RequestIDMetadataKey, requestID)
+	}
+
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+func NewRequestIDStreamInterceptor(
+	ctx context.Context,
+	desc *grpc.StreamDesc,
+	cc *grpc.ClientConn,
+	method string,
+	streamer grpc.Streamer,
+	opts ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	if requestID, ok := ctx.Value(audit.RequestIDContextKey).(string); ok {
+		ctx = metadata.AppendToOutgoingContext(ctx, audit.RequestIDMetadataKey, requestID)
+	}
+
+	return streamer(ctx, desc, cc, method, opts...)
+}
@@ -2,6 +2,8 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"io"
 
 	merchapi "github.com/Lexv0lk/merch-store/gen/merch/v1"
 	"github.com/Lexv0lk/merch-store/internal/gateway/domain"
@@ -17,12 +19,14 @@ func NewStoreAdapter(client merchapi.MerchStoreServiceClient) *StoreAdapter {
 	}
 }
 
-func (a *StoreAdapter) BuyItem(ctx context.Context, itemName string) error {
+func (a *StoreAdapter) BuyItem(ctx context.Context, itemName, variant, promoCode string) error {
 	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
 	defer cancel()
 
 	req := &merchapi.BuyItemRequest{
-		ItemName: itemName,
+		ItemName:  itemName,
+		Variant:   variant,
+		PromoCode: promoCode,
 	}
 
 	_, err := a.client.BuyItem(limitCtx, req)
@@ -33,6 +37,35 @@ func (a *StoreAdapter) BuyItem(ctx context.Context, itemName string) error {
 	return nil
 }
 
+func (a *StoreAdapter) GiftItem(ctx context.Context, itemName, variant, toUsername, message string) error {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	req := &merchapi.GiftItemRequest{
+		ItemName:   itemName,
+		Variant:    variant,
+		ToUsername: toUsername,
+		Message:    message,
+	}
+
+	_, err := a.client.GiftItem(limitCtx, req)
+	return err
+}
+
+func (a *StoreAdapter) TransferItem(ctx context.Context, itemName, variant, toUsername string) error {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	req := &merchapi.TransferItemRequest{
+		ItemName:   itemName,
+		ToUsername: toUsername,
+		Variant:    variant,
+	}
+
+	_, err := a.client.TransferItem(limitCtx, req)
+	return err
+}
+
 func (a *StoreAdapter) SendCoins(ctx context.Context, toUsername string, amount uint32) error {
 	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
 	defer cancel()
@@ -50,11 +83,31 @@ func (a *StoreAdapter) SendCoins(ctx context.Context, toUsername string, amount
 	return nil
 }
 
-func (a *StoreAdapter) GetUserInfo(ctx context.Context) (domain.UserInfo, error) {
+func (a *StoreAdapter) SendCoinsBatch(ctx context.Context, transfers []domain.SentTransfer) error {
 	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
 	defer cancel()
 
-	req := &merchapi.GetUserInfoRequest{}
+	req := &merchapi.SendCoinsBatchRequest{
+		Transfers: make([]*merchapi.CoinTransfer, 0, len(transfers)),
+	}
+	for _, transfer := range transfers {
+		req.Transfers = append(req.Transfers, &merchapi.CoinTransfer{
+			ToUsername: transfer.To,
+			Amount:     transfer.Amount,
+		})
+	}
+
+	_, err := a.client.SendCoinsBatch(limitCtx, req)
+	return err
+}
+
+func (a *StoreAdapter) GetUserInfo(ctx context.Context, withWishlist bool) (domain.UserInfo, error) {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	req := &merchapi.GetUserInfoRequest{
+		IncludeWishlist: withWishlist,
+	}
 
 	resp, err := a.client.GetUserInfo(limitCtx, req)
 	if err != nil {
@@ -64,6 +117,373 @@ func (a *StoreAdapter) GetUserInfo(ctx context.Context) (domain.UserInfo, error)
 	return convertToUserInfo(resp), nil
 }
 
+func (a *StoreAdapter) SendFromTeamBudget(ctx context.Context, teamName, toUsername string, amount uint32) error {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	req := &merchapi.SendFromTeamBudgetRequest{
+		TeamName:   teamName,
+		ToUsername: toUsername,
+		Amount:     amount,
+	}
+
+	_, err := a.client.SendFromTeamBudget(limitCtx, req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (a *StoreAdapter) CreatePaymentRequest(ctx context.Context, payerUsername string, amount uint32, note string, expiryDays uint32) (int, error) {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	req := &merchapi.CreatePaymentRequestRequest{
+		PayerUsername: payerUsername,
+		Amount:        amount,
+		Note:          note,
+		ExpiryDays:    expiryDays,
+	}
+
+	resp, err := a.client.CreatePaymentRequest(limitCtx, req)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(resp.RequestID), nil
+}
+
+func (a *StoreAdapter) ListPaymentRequests(ctx context.Context) ([]domain.PaymentRequest, error) {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	resp, err := a.client.ListPaymentRequests(limitCtx, &merchapi.ListPaymentRequestsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	requests := make([]domain.PaymentRequest, 0, len(resp.Requests))
+	for _, request := range resp.Requests {
+		requests = append(requests, domain.PaymentRequest{
+			Id:        int(request.Id),
+			From:      request.FromUsername,
+			Amount:    request.Amount,
+			Note:      request.Note,
+			CreatedAt: request.CreatedAt,
+			ExpiresAt: request.ExpiresAt,
+		})
+	}
+
+	return requests, nil
+}
+
+func (a *StoreAdapter) AcceptPaymentRequest(ctx context.Context, requestID int) error {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	req := &merchapi.AcceptPaymentRequestRequest{
+		RequestID: int32(requestID),
+	}
+
+	_, err := a.client.AcceptPaymentRequest(limitCtx, req)
+	return err
+}
+
+func (a *StoreAdapter) DeclinePaymentRequest(ctx context.Context, requestID int) error {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	req := &merchapi.DeclinePaymentRequestRequest{
+		RequestID: int32(requestID),
+	}
+
+	_, err := a.client.DeclinePaymentRequest(limitCtx, req)
+	return err
+}
+
+func (a *StoreAdapter) ScheduleTransfer(ctx context.Context, toUsername string, amount uint32, startAt, recurrence string) (int, error) {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	req := &merchapi.ScheduleTransferRequest{
+		ToUsername: toUsername,
+		Amount:     amount,
+		StartAt:    startAt,
+		Recurrence: recurrence,
+	}
+
+	resp, err := a.client.ScheduleTransfer(limitCtx, req)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(resp.ScheduleID), nil
+}
+
+func (a *StoreAdapter) ListScheduledTransfers(ctx context.Context) ([]domain.ScheduledTransfer, error) {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	resp, err := a.client.ListScheduledTransfers(limitCtx, &merchapi.ListScheduledTransfersRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	transfers := make([]domain.ScheduledTransfer, 0, len(resp.Transfers))
+	for _, transfer := range resp.Transfers {
+		transfers = append(transfers, domain.ScheduledTransfer{
+			Id:         int(transfer.Id),
+			To:         transfer.ToUsername,
+			Amount:     transfer.Amount,
+			Recurrence: transfer.Recurrence,
+			Status:     transfer.Status,
+			NextRunAt:  transfer.NextRunAt,
+			Attempts:   int(transfer.Attempts),
+			LastError:  transfer.LastError,
+		})
+	}
+
+	return transfers, nil
+}
+
+func (a *StoreAdapter) CancelScheduledTransfer(ctx context.Context, scheduleID int) error {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	req := &merchapi.CancelScheduledTransferRequest{
+		ScheduleID: int32(scheduleID),
+	}
+
+	_, err := a.client.CancelScheduledTransfer(limitCtx, req)
+	return err
+}
+
+func (a *StoreAdapter) CreateListing(ctx context.Context, itemName, variant string, price uint32) (int, error) {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	req := &merchapi.CreateListingRequest{
+		ItemName: itemName,
+		Price:    price,
+		Variant:  variant,
+	}
+
+	resp, err := a.client.CreateListing(limitCtx, req)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(resp.ListingID), nil
+}
+
+func (a *StoreAdapter) UpdateListing(ctx context.Context, listingID int, price uint32) error {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	req := &merchapi.UpdateListingRequest{
+		ListingID: int32(listingID),
+		Price:     price,
+	}
+
+	_, err := a.client.UpdateListing(limitCtx, req)
+	return err
+}
+
+func (a *StoreAdapter) CancelListing(ctx context.Context, listingID int) error {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	req := &merchapi.CancelListingRequest{
+		ListingID: int32(listingID),
+	}
+
+	_, err := a.client.CancelListing(limitCtx, req)
+	return err
+}
+
+func (a *StoreAdapter) SearchListings(ctx context.Context, filter domain.ListingFilter) ([]domain.Listing, error) {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	req := &merchapi.SearchListingsRequest{
+		Query:          filter.Query,
+		SellerUsername: filter.Seller,
+		MaxPrice:       filter.MaxPrice,
+		Limit:          int32(filter.Limit),
+		Offset:         int32(filter.Offset),
+	}
+
+	resp, err := a.client.SearchListings(limitCtx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	listings := make([]domain.Listing, 0, len(resp.Listings))
+	for _, listing := range resp.Listings {
+		listings = append(listings, domain.Listing{
+			Id:        int(listing.Id),
+			Item:      listing.ItemName,
+			Variant:   listing.Variant,
+			Seller:    listing.SellerUsername,
+			Price:     listing.Price,
+			CreatedAt: listing.CreatedAt,
+		})
+	}
+
+	return listings, nil
+}
+
+func (a *StoreAdapter) BuyListing(ctx context.Context, listingID int) error {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	req := &merchapi.BuyListingRequest{
+		ListingID: int32(listingID),
+	}
+
+	_, err := a.client.BuyListing(limitCtx, req)
+	return err
+}
+
+func (a *StoreAdapter) ListAuctions(ctx context.Context) ([]domain.Auction, error) {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	resp, err := a.client.ListAuctions(limitCtx, &merchapi.ListAuctionsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	auctions := make([]domain.Auction, 0, len(resp.Auctions))
+	for _, auction := range resp.Auctions {
+		auctions = append(auctions, domain.Auction{
+			Id:         int(auction.Id),
+			Item:       auction.ItemName,
+			StartsAt:   auction.StartsAt,
+			EndsAt:     auction.EndsAt,
+			TopBid:     auction.TopBid,
+			TopBidder:  auction.TopBidderUsername,
+			ReserveMet: auction.ReserveMet,
+		})
+	}
+
+	return auctions, nil
+}
+
+func (a *StoreAdapter) PlaceBid(ctx context.Context, auctionID int, amount uint32) error {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	req := &merchapi.PlaceBidRequest{
+		AuctionID: int32(auctionID),
+		Amount:    amount,
+	}
+
+	_, err := a.client.PlaceBid(limitCtx, req)
+	return err
+}
+
+func (a *StoreAdapter) ListRaffles(ctx context.Context) ([]domain.Raffle, error) {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	resp, err := a.client.ListRaffles(limitCtx, &merchapi.ListRafflesRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	raffles := make([]domain.Raffle, 0, len(resp.Raffles))
+	for _, raffle := range resp.Raffles {
+		raffles = append(raffles, domain.Raffle{
+			Id:          int(raffle.Id),
+			Item:        raffle.ItemName,
+			TicketPrice: raffle.TicketPrice,
+			DrawAt:      raffle.DrawAt,
+			TicketsSold: raffle.TicketsSold,
+		})
+	}
+
+	return raffles, nil
+}
+
+func (a *StoreAdapter) BuyRaffleTickets(ctx context.Context, raffleID int, count uint32) error {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	req := &merchapi.BuyRaffleTicketsRequest{
+		RaffleID: int32(raffleID),
+		Count:    count,
+	}
+
+	_, err := a.client.BuyRaffleTickets(limitCtx, req)
+	return err
+}
+
+func (a *StoreAdapter) AddToWishlist(ctx context.Context, itemName string) error {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	_, err := a.client.AddToWishlist(limitCtx, &merchapi.AddToWishlistRequest{ItemName: itemName})
+	return err
+}
+
+func (a *StoreAdapter) RemoveFromWishlist(ctx context.Context, itemName string) error {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	_, err := a.client.RemoveFromWishlist(limitCtx, &merchapi.RemoveFromWishlistRequest{ItemName: itemName})
+	return err
+}
+
+func (a *StoreAdapter) ListWishlist(ctx context.Context) ([]domain.WishlistItem, error) {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	resp, err := a.client.ListWishlist(limitCtx, &merchapi.ListWishlistRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return convertToWishlist(resp.Items), nil
+}
+
+func (a *StoreAdapter) ListWishlistEvents(ctx context.Context) ([]domain.WishlistEvent, error) {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	resp, err := a.client.ListWishlistEvents(limitCtx, &merchapi.ListWishlistEventsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]domain.WishlistEvent, 0, len(resp.Events))
+	for _, event := range resp.Events {
+		events = append(events, domain.WishlistEvent{
+			Kind:      event.Kind,
+			Item:      event.ItemName,
+			OldPrice:  event.OldPrice,
+			NewPrice:  event.NewPrice,
+			CreatedAt: event.CreatedAt,
+		})
+	}
+
+	return events, nil
+}
+
+func convertToWishlist(items []*merchapi.WishlistItem) []domain.WishlistItem {
+	wishlist := make([]domain.WishlistItem, 0, len(items))
+	for _, item := range items {
+		wishlist = append(wishlist, domain.WishlistItem{
+			Name:        item.Name,
+			Price:       item.Price,
+			CoinsNeeded: item.CoinsNeeded,
+		})
+	}
+
+	return wishlist
+}
+
 func convertToUserInfo(resp *merchapi.GetUserInfoResponse) domain.UserInfo {
 	userInfo := domain.UserInfo{
 		Balance:   resp.Balance,
@@ -72,13 +492,33 @@ func convertToUserInfo(resp *merchapi.GetUserInfoResponse) domain.UserInfo {
 			Received: make([]domain.ReceivedTransfer, 0, len(resp.CoinHistory.Received)),
 			Sent:     make([]domain.SentTransfer, 0, len(resp.CoinHistory.Sent)),
 		},
+		ItemHistory: domain.ItemHistory{
+			Received: make([]domain.ReceivedItem, 0, len(resp.GetItemHistory().GetReceived())),
+			Sent:     make([]domain.SentItem, 0, len(resp.GetItemHistory().GetSent())),
+		},
 	}
 
 	for _, item := range resp.Inventory {
-		userInfo.Inventory = append(userInfo.Inventory, domain.InventoryItem{
+		inventoryItem := domain.InventoryItem{
 			Name:     item.Name,
 			Quantity: item.Quantity,
-		})
+		}
+
+		for _, variant := range item.Variants {
+			inventoryItem.Variants = append(inventoryItem.Variants, domain.InventoryVariant{
+				SKU:      variant.Sku,
+				Quantity: variant.Quantity,
+			})
+		}
+
+		for _, gift := range item.Gifts {
+			inventoryItem.Gifts = append(inventoryItem.Gifts, domain.Gift{
+				From:    gift.FromUsername,
+				Message: gift.Message,
+			})
+		}
+
+		userInfo.Inventory = append(userInfo.Inventory, inventoryItem)
 	}
 
 	for _, received := range resp.CoinHistory.Received {
@@ -95,5 +535,207 @@ func convertToUserInfo(resp *merchapi.GetUserInfoResponse) domain.UserInfo {
 		})
 	}
 
+	for _, received := range resp.GetItemHistory().GetReceived() {
+		userInfo.ItemHistory.Received = append(userInfo.ItemHistory.Received, domain.ReceivedItem{
+			From: received.FromUsername,
+			Item: received.ItemName,
+		})
+	}
+
+	for _, sent := range resp.GetItemHistory().GetSent() {
+		userInfo.ItemHistory.Sent = append(userInfo.ItemHistory.Sent, domain.SentItem{
+			To:   sent.ToUsername,
+			Item: sent.ItemName,
+		})
+	}
+
+	if len(resp.Wishlist) > 0 {
+		userInfo.Wishlist = convertToWishlist(resp.Wishlist)
+	}
+
 	return userInfo
 }
+
+func (a *StoreAdapter) ListGoods(ctx context.Context, category string) ([]domain.CatalogItem, error) {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	resp, err := a.client.ListGoods(limitCtx, &merchapi.ListGoodsRequest{Category: category})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]domain.CatalogItem, 0, len(resp.Items))
+	for _, item := range resp.Items {
+		catalogItem := domain.CatalogItem{
+			Name:        item.Name,
+			Price:       item.Price,
+			BasePrice:   item.BasePrice,
+			SaleEndsAt:  item.SaleEndsAt,
+			Category:    item.Category,
+			Description: item.Description,
+			Upcoming:    item.Upcoming,
+		}
+
+		for _, component := range item.Components {
+			catalogItem.Components = append(catalogItem.Components, domain.BundleItem{
+				Name:     component.ItemName,
+				Quantity: component.Quantity,
+			})
+		}
+
+		for _, image := range item.Images {
+			catalogItem.Images = append(catalogItem.Images, domain.GoodImage{
+				URL:     image.Url,
+				AltText: image.AltText,
+				Width:   image.Width,
+				Height:  image.Height,
+			})
+		}
+
+		for _, variant := range item.Variants {
+			catalogVariant := domain.CatalogVariant{
+				SKU:        variant.Sku,
+				Attributes: variant.Attributes,
+				Price:      variant.Price,
+			}
+			if variant.StockTracked {
+				stock := variant.Stock
+				catalogVariant.Stock = &stock
+			}
+
+			catalogItem.Variants = append(catalogItem.Variants, catalogVariant)
+		}
+
+		items = append(items, catalogItem)
+	}
+
+	return items, nil
+}
+
+func (a *StoreAdapter) ListCategories(ctx context.Context) ([]domain.Category, error) {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	resp, err := a.client.ListCategories(limitCtx, &merchapi.ListCategoriesRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	categories := make([]domain.Category, 0, len(resp.Categories))
+	for _, category := range resp.Categories {
+		categories = append(categories, domain.Category{
+			Slug: category.Slug,
+			Name: category.Name,
+		})
+	}
+
+	return categories, nil
+}
+
+func (a *StoreAdapter) GetPriceHistory(ctx context.Context, itemName string) (domain.PriceHistory, error) {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	resp, err := a.client.GetPriceHistory(limitCtx, &merchapi.GetPriceHistoryRequest{ItemName: itemName})
+	if err != nil {
+		return domain.PriceHistory{}, err
+	}
+
+	history := domain.PriceHistory{
+		BasePrice: resp.BasePrice,
+		Changes:   make([]domain.PriceChange, 0, len(resp.Changes)),
+	}
+	for _, change := range resp.Changes {
+		history.Changes = append(history.Changes, domain.PriceChange{
+			Price:    change.Price,
+			StartsAt: change.StartsAt,
+			EndsAt:   change.EndsAt,
+		})
+	}
+
+	return history, nil
+}
+
+func (a *StoreAdapter) PlacePreorder(ctx context.Context, itemName, variant string) (int, error) {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	req := &merchapi.PlacePreorderRequest{
+		ItemName: itemName,
+		Variant:  variant,
+	}
+
+	resp, err := a.client.PlacePreorder(limitCtx, req)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(resp.PreorderID), nil
+}
+
+func (a *StoreAdapter) ListPreorders(ctx context.Context) (domain.Preorders, error) {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	resp, err := a.client.ListPreorders(limitCtx, &merchapi.ListPreordersRequest{})
+	if err != nil {
+		return domain.Preorders{}, err
+	}
+
+	preorders := domain.Preorders{
+		HeldCoins: resp.HeldCoins,
+		Preorders: make([]domain.Preorder, 0, len(resp.Preorders)),
+	}
+	for _, preorder := range resp.Preorders {
+		preorders.Preorders = append(preorders.Preorders, domain.Preorder{
+			Id:         int(preorder.Id),
+			ItemName:   preorder.ItemName,
+			Variant:    preorder.Variant,
+			Price:      preorder.Price,
+			Status:     preorder.Status,
+			CreatedAt:  preorder.CreatedAt,
+			ResolvedAt: preorder.ResolvedAt,
+		})
+	}
+
+	return preorders, nil
+}
+
+func (a *StoreAdapter) CancelPreorder(ctx context.Context, preorderID int) error {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	req := &merchapi.CancelPreorderRequest{
+		PreorderID: int32(preorderID),
+	}
+
+	_, err := a.client.CancelPreorder(limitCtx, req)
+	return err
+}
+
+// SubscribeEvents isn't limited in time, the stream stays open as long as the caller's context.
+func (a *StoreAdapter) SubscribeEvents(ctx context.Context, handle func(domain.UserEvent) error) error {
+	stream, err := a.client.SubscribeEvents(ctx, &merchapi.SubscribeEventsRequest{})
+	if err != nil {
+		return err
+	}
+
+	for {
+		event, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		err = handle(domain.UserEvent{
+			Type:       event.Type,
+			Data:       rawValues(event.Data),
+			OccurredAt: event.OccurredAt,
+		})
+		if err != nil {
+			return err
+		}
+	}
+}
@@ -2,6 +2,8 @@ package grpc
 
 import (
 	"context"
+	"encoding/json"
+	"io"
 	"testing"
 
 	merchapi "github.com/Lexv0lk/merch-store/gen/merch/v1"
@@ -9,14 +11,17 @@ import (
 	"github.com/Lexv0lk/merch-store/internal/gateway/domain"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
 )
 
 func TestStoreAdapter_BuyItem(t *testing.T) {
 	t.Parallel()
 
 	type testCase struct {
-		name     string
-		itemName string
+		name      string
+		itemName  string
+		variant   string
+		promoCode string
 
 		expectedErr error
 
@@ -37,6 +42,38 @@ func TestStoreAdapter_BuyItem(t *testing.T) {
 				return clientMock
 			},
 		},
+		{
+			name:      "promo code is passed on",
+			itemName:  "Cool T-Shirt",
+			promoCode: "SPRING10",
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) merchapi.MerchStoreServiceClient {
+				t.Helper()
+				clientMock := mocks.NewMockMerchStoreServiceClient(ctrl)
+
+				clientMock.EXPECT().
+					BuyItem(gomock.Any(), &merchapi.BuyItemRequest{ItemName: "Cool T-Shirt", PromoCode: "SPRING10"}).
+					Return(nil, nil).Times(1)
+
+				return clientMock
+			},
+		},
+		{
+			name:     "variant is passed on",
+			itemName: "t-shirt",
+			variant:  "t-shirt-m",
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) merchapi.MerchStoreServiceClient {
+				t.Helper()
+				clientMock := mocks.NewMockMerchStoreServiceClient(ctrl)
+
+				clientMock.EXPECT().
+					BuyItem(gomock.Any(), &merchapi.BuyItemRequest{ItemName: "t-shirt", Variant: "t-shirt-m"}).
+					Return(nil, nil).Times(1)
+
+				return clientMock
+			},
+		},
 		{
 			name:        "fail to buy item",
 			itemName:    "Cool T-Shirt",
@@ -62,7 +99,7 @@ func TestStoreAdapter_BuyItem(t *testing.T) {
 			clientMock := tt.prepareFn(t, ctrl)
 			adapter := NewStoreAdapter(clientMock)
 
-			err := adapter.BuyItem(context.Background(), tt.itemName)
+			err := adapter.BuyItem(context.Background(), tt.itemName, tt.variant, tt.promoCode)
 
 			if tt.expectedErr != nil {
 				assert.ErrorIs(t, err, tt.expectedErr)
@@ -142,7 +179,8 @@ func TestStoreAdapter_GetUserInfo(t *testing.T) {
 	t.Parallel()
 
 	type testCase struct {
-		name string
+		name         string
+		withWishlist bool
 
 		expectedRes domain.UserInfo
 		expectedErr error
@@ -166,6 +204,12 @@ func TestStoreAdapter_GetUserInfo(t *testing.T) {
 						{To: "receiver", Amount: 30},
 					},
 				},
+				ItemHistory: domain.ItemHistory{
+					Received: []domain.ReceivedItem{
+						{From: "sender", Item: "Mug"},
+					},
+					Sent: []domain.SentItem{},
+				},
 			},
 
 			prepareFn: func(t *testing.T, ctrl *gomock.Controller) merchapi.MerchStoreServiceClient {
@@ -185,11 +229,45 @@ func TestStoreAdapter_GetUserInfo(t *testing.T) {
 							{ToUsername: "receiver", Amount: 30},
 						},
 					},
+					ItemHistory: &merchapi.ItemHistory{
+						Received: []*merchapi.ReceivedItemInfo{
+							{FromUsername: "sender", ItemName: "Mug"},
+						},
+					},
 				}, nil).Times(1)
 
 				return clientMock
 			},
 		},
+		{
+			name:         "user info with wishlist",
+			withWishlist: true,
+			expectedRes: domain.UserInfo{
+				Balance:         100,
+				Inventory:       []domain.InventoryItem{},
+				TransferHistory: domain.TransferHistory{Received: []domain.ReceivedTransfer{}, Sent: []domain.SentTransfer{}},
+				ItemHistory:     domain.ItemHistory{Received: []domain.ReceivedItem{}, Sent: []domain.SentItem{}},
+				Wishlist: []domain.WishlistItem{
+					{Name: "powerbank", Price: 200, CoinsNeeded: 100},
+				},
+			},
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) merchapi.MerchStoreServiceClient {
+				t.Helper()
+				clientMock := mocks.NewMockMerchStoreServiceClient(ctrl)
+
+				clientMock.EXPECT().GetUserInfo(gomock.Any(), &merchapi.GetUserInfoRequest{IncludeWishlist: true}).
+					Return(&merchapi.GetUserInfoResponse{
+						Balance:     100,
+						CoinHistory: &merchapi.CoinHistory{},
+						Wishlist: []*merchapi.WishlistItem{
+							{Name: "powerbank", Price: 200, CoinsNeeded: 100},
+						},
+					}, nil).Times(1)
+
+				return clientMock
+			},
+		},
 		{
 			name:        "fail to get user info",
 			expectedErr: assert.AnError,
@@ -215,7 +293,7 @@ func TestStoreAdapter_GetUserInfo(t *testing.T) {
 			clientMock := tt.prepareFn(t, ctrl)
 			adapter := NewStoreAdapter(clientMock)
 
-			res, err := adapter.GetUserInfo(context.Background())
+			res, err := adapter.GetUserInfo(context.Background(), tt.withWishlist)
 
 			if tt.expectedErr != nil {
 				assert.ErrorIs(t, err, tt.expectedErr)
@@ -270,6 +348,72 @@ func TestConvertToUserInfo(t *testing.T) {
 						{To: "receiver1", Amount: 30},
 					},
 				},
+				ItemHistory: domain.ItemHistory{
+					Received: []domain.ReceivedItem{},
+					Sent:     []domain.SentItem{},
+				},
+			},
+		},
+		{
+			name: "inventory with gifts",
+			resp: &merchapi.GetUserInfoResponse{
+				Balance: 900,
+				Inventory: []*merchapi.InventoryItem{
+					{Name: "Mug", Quantity: 2, Gifts: []*merchapi.GiftInfo{
+						{FromUsername: "friend", Message: "enjoy"},
+					}},
+				},
+				CoinHistory: &merchapi.CoinHistory{
+					Received: []*merchapi.ReceivedCoinsInfo{},
+					Sent:     []*merchapi.SentCoinsInfo{},
+				},
+			},
+			expectedRes: domain.UserInfo{
+				Balance: 900,
+				Inventory: []domain.InventoryItem{
+					{Name: "Mug", Quantity: 2, Gifts: []domain.Gift{
+						{From: "friend", Message: "enjoy"},
+					}},
+				},
+				TransferHistory: domain.TransferHistory{
+					Received: []domain.ReceivedTransfer{},
+					Sent:     []domain.SentTransfer{},
+				},
+				ItemHistory: domain.ItemHistory{
+					Received: []domain.ReceivedItem{},
+					Sent:     []domain.SentItem{},
+				},
+			},
+		},
+		{
+			name: "inventory by variant",
+			resp: &merchapi.GetUserInfoResponse{
+				Balance: 820,
+				Inventory: []*merchapi.InventoryItem{
+					{Name: "t-shirt", Quantity: 3, Variants: []*merchapi.InventoryVariant{
+						{Sku: "t-shirt-m", Quantity: 2},
+					}},
+				},
+				CoinHistory: &merchapi.CoinHistory{
+					Received: []*merchapi.ReceivedCoinsInfo{},
+					Sent:     []*merchapi.SentCoinsInfo{},
+				},
+			},
+			expectedRes: domain.UserInfo{
+				Balance: 820,
+				Inventory: []domain.InventoryItem{
+					{Name: "t-shirt", Quantity: 3, Variants: []domain.InventoryVariant{
+						{SKU: "t-shirt-m", Quantity: 2},
+					}},
+				},
+				TransferHistory: domain.TransferHistory{
+					Received: []domain.ReceivedTransfer{},
+					Sent:     []domain.SentTransfer{},
+				},
+				ItemHistory: domain.ItemHistory{
+					Received: []domain.ReceivedItem{},
+					Sent:     []domain.SentItem{},
+				},
 			},
 		},
 		{
@@ -289,6 +433,10 @@ func TestConvertToUserInfo(t *testing.T) {
 					Received: []domain.ReceivedTransfer{},
 					Sent:     []domain.SentTransfer{},
 				},
+				ItemHistory: domain.ItemHistory{
+					Received: []domain.ReceivedItem{},
+					Sent:     []domain.SentItem{},
+				},
 			},
 		},
 		{
@@ -312,6 +460,10 @@ func TestConvertToUserInfo(t *testing.T) {
 					Received: []domain.ReceivedTransfer{},
 					Sent:     []domain.SentTransfer{},
 				},
+				ItemHistory: domain.ItemHistory{
+					Received: []domain.ReceivedItem{},
+					Sent:     []domain.SentItem{},
+				},
 			},
 		},
 	}
@@ -327,3 +479,185 @@ func TestConvertToUserInfo(t *testing.T) {
 		})
 	}
 }
+
+func TestStoreAdapter_SendFromTeamBudget(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name       string
+		teamName   string
+		toUsername string
+		amount     uint32
+
+		expectedErr error
+
+		prepareFn func(t *testing.T, ctrl *gomock.Controller) merchapi.MerchStoreServiceClient
+	}
+
+	tests := []testCase{
+		{
+			name:       "successful team budget transfer",
+			teamName:   "platform",
+			toUsername: "report",
+			amount:     100,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) merchapi.MerchStoreServiceClient {
+				t.Helper()
+				clientMock := mocks.NewMockMerchStoreServiceClient(ctrl)
+
+				clientMock.EXPECT().SendFromTeamBudget(gomock.Any(), &merchapi.SendFromTeamBudgetRequest{
+					TeamName:   "platform",
+					ToUsername: "report",
+					Amount:     100,
+				}).Return(&merchapi.SendFromTeamBudgetResponse{Success: true}, nil).Times(1)
+
+				return clientMock
+			},
+		},
+		{
+			name:        "fail to send from team budget",
+			teamName:    "platform",
+			toUsername:  "report",
+			amount:      100,
+			expectedErr: assert.AnError,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) merchapi.MerchStoreServiceClient {
+				t.Helper()
+				clientMock := mocks.NewMockMerchStoreServiceClient(ctrl)
+
+				clientMock.EXPECT().SendFromTeamBudget(gomock.Any(), gomock.Any()).Return(nil, assert.AnError).Times(1)
+
+				return clientMock
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			clientMock := tt.prepareFn(t, ctrl)
+			adapter := NewStoreAdapter(clientMock)
+
+			err := adapter.SendFromTeamBudget(context.Background(), tt.teamName, tt.toUsername, tt.amount)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// eventStream replays the events and then fails with err, io.EOF by default.
+type eventStream struct {
+	grpc.ClientStream
+	events []*merchapi.UserEvent
+	err    error
+}
+
+func (s *eventStream) Recv() (*merchapi.UserEvent, error) {
+	if len(s.events) == 0 {
+		if s.err != nil {
+			return nil, s.err
+		}
+		return nil, io.EOF
+	}
+
+	event := s.events[0]
+	s.events = s.events[1:]
+	return event, nil
+}
+
+func TestStoreAdapter_SubscribeEvents(t *testing.T) {
+	t.Parallel()
+
+	events := []*merchapi.UserEvent{
+		{Type: "balance", Data: `{"balance":1000}`, OccurredAt: "2026-08-10T10:00:00Z"},
+		{Type: "transfer", Data: `{"amount":100,"fromUser":"bob"}`, OccurredAt: "2026-08-10T10:00:01Z"},
+	}
+
+	type testCase struct {
+		name string
+
+		prepareFn func(t *testing.T, ctrl *gomock.Controller) merchapi.MerchStoreServiceClient
+
+		expectedEvents []domain.UserEvent
+		expectedErr    error
+	}
+
+	tests := []testCase{
+		{
+			name: "events until the stream ends",
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) merchapi.MerchStoreServiceClient {
+				t.Helper()
+				clientMock := mocks.NewMockMerchStoreServiceClient(ctrl)
+
+				clientMock.EXPECT().SubscribeEvents(gomock.Any(), &merchapi.SubscribeEventsRequest{}).
+					Return(&eventStream{events: events}, nil).Times(1)
+
+				return clientMock
+			},
+			expectedEvents: []domain.UserEvent{
+				{Type: "balance", Data: json.RawMessage(`{"balance":1000}`), OccurredAt: "2026-08-10T10:00:00Z"},
+				{Type: "transfer", Data: json.RawMessage(`{"amount":100,"fromUser":"bob"}`), OccurredAt: "2026-08-10T10:00:01Z"},
+			},
+		},
+		{
+			name: "stream failure",
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) merchapi.MerchStoreServiceClient {
+				t.Helper()
+				clientMock := mocks.NewMockMerchStoreServiceClient(ctrl)
+
+				clientMock.EXPECT().SubscribeEvents(gomock.Any(), gomock.Any()).
+					Return(&eventStream{events: events[:1], err: assert.AnError}, nil).Times(1)
+
+				return clientMock
+			},
+			expectedEvents: []domain.UserEvent{
+				{Type: "balance", Data: json.RawMessage(`{"balance":1000}`), OccurredAt: "2026-08-10T10:00:00Z"},
+			},
+			expectedErr: assert.AnError,
+		},
+		{
+			name: "fail to subscribe",
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) merchapi.MerchStoreServiceClient {
+				t.Helper()
+				clientMock := mocks.NewMockMerchStoreServiceClient(ctrl)
+
+				clientMock.EXPECT().SubscribeEvents(gomock.Any(), gomock.Any()).Return(nil, assert.AnError).Times(1)
+
+				return clientMock
+			},
+			expectedEvents: []domain.UserEvent{},
+			expectedErr:    assert.AnError,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			clientMock := tt.prepareFn(t, ctrl)
+			adapter := NewStoreAdapter(clientMock)
+
+			received := make([]domain.UserEvent, 0)
+			err := adapter.SubscribeEvents(context.Background(), func(event domain.UserEvent) error {
+				received = append(received, event)
+				return nil
+			})
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedEvents, received)
+		})
+	}
+}
@@ -0,0 +1,482 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/Lexv0lk/merch-store/internal/gateway/domain"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	UsernameKey    = "username"
+	FraudCaseIDKey = "caseId"
+	WebhookIDKey   = "webhookId"
+	ConsumerKey    = "consumer"
+	statusQueryKey = "status"
+)
+
+type deactivateAccountRequestBody struct {
+	SweepBalance bool `json:"sweepBalance"`
+}
+
+type createTeamRequestBody struct {
+	Name    string `json:"name" binding:"required"`
+	Manager string `json:"manager" binding:"required"`
+}
+
+type addTeamMemberRequestBody struct {
+	Username string `json:"username" binding:"required"`
+}
+
+type setTeamBudgetTopUpRequestBody struct {
+	Amount      uint32 `json:"amount"`
+	PeriodHours uint32 `json:"periodHours"`
+}
+
+type fraudCaseResolutionRequestBody struct {
+	Resolution string `json:"resolution" binding:"required"`
+}
+
+type accountFreezeRequestBody struct {
+	Reason string `json:"reason" binding:"required"`
+}
+
+type createAuctionRequestBody struct {
+	ItemName     string `json:"type" binding:"required"`
+	ReservePrice uint32 `json:"reservePrice"`
+	StartsAt     string `json:"startsAt"`
+	EndsAt       string `json:"endsAt" binding:"required"`
+}
+
+type createRaffleRequestBody struct {
+	ItemName    string `json:"type" binding:"required"`
+	TicketPrice uint32 `json:"ticketPrice" binding:"required,gt=0"`
+	DrawAt      string `json:"drawAt" binding:"required"`
+}
+
+type schedulePriceChangeRequestBody struct {
+	ItemName        string `json:"type" binding:"required"`
+	Price           uint32 `json:"price"`
+	DiscountPercent uint32 `json:"discountPercent"`
+	StartsAt        string `json:"startsAt"`
+	EndsAt          string `json:"endsAt"`
+}
+
+type purchaseLimitRequestBody struct {
+	MaxQuantity uint32 `json:"maxQuantity"`
+	PeriodDays  uint32 `json:"periodDays"`
+}
+
+type createCategoryRequestBody struct {
+	Slug string `json:"slug" binding:"required"`
+	Name string `json:"name" binding:"required"`
+}
+
+type createWebhookEndpointRequestBody struct {
+	URL    string   `json:"url" binding:"required"`
+	Events []string `json:"events" binding:"required,min=1"`
+}
+
+type commitEventOffsetRequestBody struct {
+	Offset *int64 `json:"offset" binding:"required"`
+}
+
+type AdminHandler struct {
+	service domain.AdminService
+}
+
+func NewAdminHandler(service domain.AdminService) *AdminHandler {
+	return &AdminHandler{
+		service: service,
+	}
+}
+
+func (h *AdminHandler) DeactivateAccount(c *gin.Context) {
+	var body deactivateAccountRequestBody
+
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"errors": "invalid request body"})
+			return
+		}
+	}
+
+	swept, err := h.service.DeactivateAccount(c, c.Param(UsernameKey), body.SweepBalance)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"sweptAmount": swept})
+}
+
+func (h *AdminHandler) CreateTeam(c *gin.Context) {
+	var body createTeamRequestBody
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errors": "invalid request body"})
+		return
+	}
+
+	teamID, err := h.service.CreateTeam(c, body.Name, body.Manager)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"teamId": teamID})
+}
+
+func (h *AdminHandler) AddTeamMember(c *gin.Context) {
+	var body addTeamMemberRequestBody
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errors": "invalid request body"})
+		return
+	}
+
+	err := h.service.AddTeamMember(c, c.Param(TeamNameKey), body.Username)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func (h *AdminHandler) SetTeamBudgetTopUp(c *gin.Context) {
+	var body setTeamBudgetTopUpRequestBody
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errors": "invalid request body"})
+		return
+	}
+
+	err := h.service.SetTeamBudgetTopUp(c, c.Param(TeamNameKey), body.Amount, body.PeriodHours)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func (h *AdminHandler) SetDefaultTransferLimits(c *gin.Context) {
+	h.setTransferLimits(c, "")
+}
+
+func (h *AdminHandler) SetUserTransferLimits(c *gin.Context) {
+	h.setTransferLimits(c, c.Param(UsernameKey))
+}
+
+func (h *AdminHandler) setTransferLimits(c *gin.Context, username string) {
+	var body domain.TransferLimits
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errors": "invalid request body"})
+		return
+	}
+
+	err := h.service.SetTransferLimits(c, username, body)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func (h *AdminHandler) ListFraudCases(c *gin.Context) {
+	cases, err := h.service.ListFraudCases(c, c.Query(statusQueryKey))
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"cases": cases})
+}
+
+func (h *AdminHandler) ResolveFraudCase(c *gin.Context) {
+	h.closeFraudCase(c, h.service.ResolveFraudCase)
+}
+
+func (h *AdminHandler) FreezeFraudCase(c *gin.Context) {
+	h.closeFraudCase(c, h.service.FreezeFraudCase)
+}
+
+func (h *AdminHandler) closeFraudCase(c *gin.Context, closeFn func(ctx context.Context, caseID int, resolution string) error) {
+	caseID, err := strconv.Atoi(c.Param(FraudCaseIDKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errors": "invalid case id"})
+		return
+	}
+
+	var body fraudCaseResolutionRequestBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errors": "invalid request body"})
+		return
+	}
+
+	err = closeFn(c, caseID, body.Resolution)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func (h *AdminHandler) FreezeAccount(c *gin.Context) {
+	h.changeAccountFreeze(c, h.service.FreezeAccount)
+}
+
+func (h *AdminHandler) UnfreezeAccount(c *gin.Context) {
+	h.changeAccountFreeze(c, h.service.UnfreezeAccount)
+}
+
+func (h *AdminHandler) changeAccountFreeze(c *gin.Context, changeFn func(ctx context.Context, username, reason string) error) {
+	var body accountFreezeRequestBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errors": "invalid request body"})
+		return
+	}
+
+	err := changeFn(c, c.Param(UsernameKey), body.Reason)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func (h *AdminHandler) CreateAuction(c *gin.Context) {
+	var body createAuctionRequestBody
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errors": "invalid request body"})
+		return
+	}
+
+	auctionID, err := h.service.CreateAuction(c, body.ItemName, body.ReservePrice, body.StartsAt, body.EndsAt)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"auctionId": auctionID})
+}
+
+func (h *AdminHandler) CreateRaffle(c *gin.Context) {
+	var body createRaffleRequestBody
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errors": "invalid request body"})
+		return
+	}
+
+	raffleID, err := h.service.CreateRaffle(c, body.ItemName, body.TicketPrice, body.DrawAt)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"raffleId": raffleID})
+}
+
+func (h *AdminHandler) CreatePromoCode(c *gin.Context) {
+	var body domain.PromoCode
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errors": "invalid request body"})
+		return
+	}
+
+	promoID, err := h.service.CreatePromoCode(c, body)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"promoId": promoID})
+}
+
+func (h *AdminHandler) SchedulePriceChange(c *gin.Context) {
+	var body schedulePriceChangeRequestBody
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errors": "invalid request body"})
+		return
+	}
+
+	scheduleID, err := h.service.SchedulePriceChange(c, body.ItemName, body.Price, body.DiscountPercent, body.StartsAt, body.EndsAt)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"scheduleId": scheduleID})
+}
+
+func (h *AdminHandler) CreateCategory(c *gin.Context) {
+	var body createCategoryRequestBody
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errors": "invalid request body"})
+		return
+	}
+
+	categoryID, err := h.service.CreateCategory(c, body.Slug, body.Name)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"categoryId": categoryID})
+}
+
+func (h *AdminHandler) UpdateGoodDetails(c *gin.Context) {
+	var body domain.GoodDetails
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errors": "invalid request body"})
+		return
+	}
+
+	err := h.service.UpdateGoodDetails(c, c.Param(ItemNameKey), body)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func (h *AdminHandler) SetPurchaseLimit(c *gin.Context) {
+	var body purchaseLimitRequestBody
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errors": "invalid request body"})
+		return
+	}
+
+	err := h.service.SetPurchaseLimit(c, c.Param(ItemNameKey), body.MaxQuantity, body.PeriodDays)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func (h *AdminHandler) AnnounceGood(c *gin.Context) {
+	err := h.service.AnnounceGood(c, c.Param(ItemNameKey))
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func (h *AdminHandler) MarkGoodArrived(c *gin.Context) {
+	arrival, err := h.service.MarkGoodArrived(c, c.Param(ItemNameKey))
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, arrival)
+}
+
+func (h *AdminHandler) CreateWebhookEndpoint(c *gin.Context) {
+	var body createWebhookEndpointRequestBody
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errors": "invalid request body"})
+		return
+	}
+
+	endpoint, err := h.service.CreateWebhookEndpoint(c, body.URL, body.Events)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, endpoint)
+}
+
+func (h *AdminHandler) ListWebhookEndpoints(c *gin.Context) {
+	endpoints, err := h.service.ListWebhookEndpoints(c)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"webhooks": endpoints})
+}
+
+func (h *AdminHandler) DeleteWebhookEndpoint(c *gin.Context) {
+	endpointID, err := strconv.Atoi(c.Param(WebhookIDKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errors": "invalid webhook id"})
+		return
+	}
+
+	err = h.service.DeleteWebhookEndpoint(c, endpointID)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func (h *AdminHandler) ListWebhookDeadLetters(c *gin.Context) {
+	deadLetters, err := h.service.ListWebhookDeadLetters(c)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"deadLetters": deadLetters})
+}
+
+func (h *AdminHandler) FetchEvents(c *gin.Context) {
+	var limit uint64
+	if rawLimit := c.Query("limit"); rawLimit != "" {
+		var err error
+		limit, err = strconv.ParseUint(rawLimit, 10, 32)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"errors": "invalid limit"})
+			return
+		}
+	}
+
+	events, err := h.service.FetchEvents(c, c.Query(ConsumerKey), uint32(limit))
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"events": events})
+}
+
+func (h *AdminHandler) CommitEventOffset(c *gin.Context) {
+	var body commitEventOffsetRequestBody
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errors": "invalid request body"})
+		return
+	}
+
+	err := h.service.CommitEventOffset(c, c.Param(ConsumerKey), *body.Offset)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
@@ -0,0 +1,1455 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mocks "github.com/Lexv0lk/merch-store/gen/mocks/gateway"
+	"github.com/Lexv0lk/merch-store/internal/gateway/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAdminHandler_DeactivateAccount(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name           string
+		username       string
+		requestBody    interface{}
+		expectedStatus int
+
+		prepareFn       func(t *testing.T, ctrl *gomock.Controller) domain.AdminService
+		checkResponseFn func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}
+
+	tests := []testCase{
+		{
+			name:           "successful deactivation with sweep",
+			username:       "leaver",
+			requestBody:    deactivateAccountRequestBody{SweepBalance: true},
+			expectedStatus: http.StatusOK,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				mockService := mocks.NewMockAdminService(ctrl)
+				mockService.EXPECT().
+					DeactivateAccount(gomock.Any(), "leaver", true).
+					Return(uint32(450), nil).
+					Times(1)
+
+				return mockService
+			},
+			checkResponseFn: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				var response map[string]uint32
+				err := json.Unmarshal(recorder.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, uint32(450), response["sweptAmount"])
+			},
+		},
+		{
+			name:           "successful deactivation without body",
+			username:       "leaver",
+			expectedStatus: http.StatusOK,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				mockService := mocks.NewMockAdminService(ctrl)
+				mockService.EXPECT().
+					DeactivateAccount(gomock.Any(), "leaver", false).
+					Return(uint32(0), nil).
+					Times(1)
+
+				return mockService
+			},
+		},
+		{
+			name:           "invalid_request_body",
+			username:       "leaver",
+			requestBody:    map[string]interface{}{"sweepBalance": "yes"},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				return mocks.NewMockAdminService(ctrl)
+			},
+		},
+		{
+			name:           "permission_denied_error",
+			username:       "leaver",
+			expectedStatus: http.StatusForbidden,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				mockService := mocks.NewMockAdminService(ctrl)
+				mockService.EXPECT().
+					DeactivateAccount(gomock.Any(), "leaver", false).
+					Return(uint32(0), status.Error(codes.PermissionDenied, "admin role required"))
+
+				return mockService
+			},
+		},
+		{
+			name:           "not_found_error",
+			username:       "ghost",
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				mockService := mocks.NewMockAdminService(ctrl)
+				mockService.EXPECT().
+					DeactivateAccount(gomock.Any(), "ghost", false).
+					Return(uint32(0), status.Error(codes.NotFound, "user not found"))
+
+				return mockService
+			},
+		},
+		{
+			name:           "internal_server_error",
+			username:       "leaver",
+			expectedStatus: http.StatusInternalServerError,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				mockService := mocks.NewMockAdminService(ctrl)
+				mockService.EXPECT().
+					DeactivateAccount(gomock.Any(), "leaver", false).
+					Return(uint32(0), status.Error(codes.Internal, "database error"))
+
+				return mockService
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockService := tt.prepareFn(t, ctrl)
+			handler := NewAdminHandler(mockService)
+
+			writer := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(writer)
+
+			var body []byte
+			if tt.requestBody != nil {
+				body, _ = json.Marshal(tt.requestBody)
+			}
+			c.Request = httptest.NewRequest(http.MethodPost, "/admin/users/"+tt.username+"/deactivate", bytes.NewReader(body))
+			c.Request.Header.Set("Content-Type", "application/json")
+			c.Params = gin.Params{{Key: UsernameKey, Value: tt.username}}
+
+			handler.DeactivateAccount(c)
+
+			assert.Equal(t, tt.expectedStatus, writer.Code)
+			if tt.checkResponseFn != nil {
+				tt.checkResponseFn(t, writer)
+			}
+		})
+	}
+}
+
+func TestAdminHandler_CreateTeam(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name           string
+		requestBody    interface{}
+		expectedStatus int
+
+		prepareFn       func(t *testing.T, ctrl *gomock.Controller) domain.AdminService
+		checkResponseFn func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}
+
+	tests := []testCase{
+		{
+			name:           "successful team creation",
+			requestBody:    createTeamRequestBody{Name: "platform", Manager: "boss"},
+			expectedStatus: http.StatusOK,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				mockService := mocks.NewMockAdminService(ctrl)
+				mockService.EXPECT().
+					CreateTeam(gomock.Any(), "platform", "boss").
+					Return(7, nil).
+					Times(1)
+
+				return mockService
+			},
+			checkResponseFn: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				var response map[string]int
+				err := json.Unmarshal(recorder.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, 7, response["teamId"])
+			},
+		},
+		{
+			name:           "missing_manager",
+			requestBody:    map[string]interface{}{"name": "platform"},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				return mocks.NewMockAdminService(ctrl)
+			},
+		},
+		{
+			name:           "team_already_exists",
+			requestBody:    createTeamRequestBody{Name: "platform", Manager: "boss"},
+			expectedStatus: http.StatusConflict,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				mockService := mocks.NewMockAdminService(ctrl)
+				mockService.EXPECT().
+					CreateTeam(gomock.Any(), "platform", "boss").
+					Return(0, status.Error(codes.AlreadyExists, "team already exists"))
+
+				return mockService
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockService := tt.prepareFn(t, ctrl)
+			handler := NewAdminHandler(mockService)
+
+			writer := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(writer)
+
+			body, _ := json.Marshal(tt.requestBody)
+			c.Request = httptest.NewRequest(http.MethodPost, "/admin/teams", bytes.NewReader(body))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			handler.CreateTeam(c)
+
+			assert.Equal(t, tt.expectedStatus, writer.Code)
+			if tt.checkResponseFn != nil {
+				tt.checkResponseFn(t, writer)
+			}
+		})
+	}
+}
+
+func TestAdminHandler_FreezeFraudCase(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name           string
+		caseID         string
+		requestBody    interface{}
+		expectedStatus int
+
+		prepareFn func(t *testing.T, ctrl *gomock.Controller) domain.AdminService
+	}
+
+	tests := []testCase{
+		{
+			name:           "successful freeze",
+			caseID:         "3",
+			requestBody:    fraudCaseResolutionRequestBody{Resolution: "confirmed coin farming"},
+			expectedStatus: http.StatusOK,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				mockService := mocks.NewMockAdminService(ctrl)
+				mockService.EXPECT().
+					FreezeFraudCase(gomock.Any(), 3, "confirmed coin farming").
+					Return(nil).
+					Times(1)
+
+				return mockService
+			},
+		},
+		{
+			name:           "invalid_case_id",
+			caseID:         "abc",
+			requestBody:    fraudCaseResolutionRequestBody{Resolution: "confirmed coin farming"},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				return mocks.NewMockAdminService(ctrl)
+			},
+		},
+		{
+			name:           "missing_resolution",
+			caseID:         "3",
+			requestBody:    map[string]interface{}{},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				return mocks.NewMockAdminService(ctrl)
+			},
+		},
+		{
+			name:           "case_already_closed",
+			caseID:         "3",
+			requestBody:    fraudCaseResolutionRequestBody{Resolution: "confirmed coin farming"},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				mockService := mocks.NewMockAdminService(ctrl)
+				mockService.EXPECT().
+					FreezeFraudCase(gomock.Any(), 3, "confirmed coin farming").
+					Return(status.Error(codes.FailedPrecondition, "fraud case is already closed"))
+
+				return mockService
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockService := tt.prepareFn(t, ctrl)
+			handler := NewAdminHandler(mockService)
+
+			writer := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(writer)
+
+			body, _ := json.Marshal(tt.requestBody)
+			c.Request = httptest.NewRequest(http.MethodPost, "/admin/fraud-cases/"+tt.caseID+"/freeze", bytes.NewReader(body))
+			c.Request.Header.Set("Content-Type", "application/json")
+			c.Params = gin.Params{{Key: FraudCaseIDKey, Value: tt.caseID}}
+
+			handler.FreezeFraudCase(c)
+
+			assert.Equal(t, tt.expectedStatus, writer.Code)
+		})
+	}
+}
+
+func TestAdminHandler_FreezeAccount(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name           string
+		requestBody    interface{}
+		expectedStatus int
+
+		prepareFn func(t *testing.T, ctrl *gomock.Controller) domain.AdminService
+	}
+
+	tests := []testCase{
+		{
+			name:           "successful freeze",
+			requestBody:    accountFreezeRequestBody{Reason: "investigating coin farming"},
+			expectedStatus: http.StatusOK,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				mockService := mocks.NewMockAdminService(ctrl)
+				mockService.EXPECT().
+					FreezeAccount(gomock.Any(), "suspect", "investigating coin farming").
+					Return(nil).
+					Times(1)
+
+				return mockService
+			},
+		},
+		{
+			name:           "missing_reason",
+			requestBody:    map[string]interface{}{},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				return mocks.NewMockAdminService(ctrl)
+			},
+		},
+		{
+			name:           "user_not_found",
+			requestBody:    accountFreezeRequestBody{Reason: "investigating coin farming"},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				mockService := mocks.NewMockAdminService(ctrl)
+				mockService.EXPECT().
+					FreezeAccount(gomock.Any(), "suspect", "investigating coin farming").
+					Return(status.Error(codes.NotFound, "user not found"))
+
+				return mockService
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockService := tt.prepareFn(t, ctrl)
+			handler := NewAdminHandler(mockService)
+
+			writer := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(writer)
+
+			body, _ := json.Marshal(tt.requestBody)
+			c.Request = httptest.NewRequest(http.MethodPost, "/admin/users/suspect/freeze", bytes.NewReader(body))
+			c.Request.Header.Set("Content-Type", "application/json")
+			c.Params = gin.Params{{Key: UsernameKey, Value: "suspect"}}
+
+			handler.FreezeAccount(c)
+
+			assert.Equal(t, tt.expectedStatus, writer.Code)
+		})
+	}
+}
+
+func TestAdminHandler_CreateAuction(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name           string
+		requestBody    interface{}
+		expectedStatus int
+
+		prepareFn       func(t *testing.T, ctrl *gomock.Controller) domain.AdminService
+		checkResponseFn func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}
+
+	tests := []testCase{
+		{
+			name: "successful auction creation",
+			requestBody: createAuctionRequestBody{
+				ItemName:     "pink-hoody",
+				ReservePrice: 300,
+				StartsAt:     "2026-06-01T09:00:00Z",
+				EndsAt:       "2026-06-02T09:00:00Z",
+			},
+			expectedStatus: http.StatusOK,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				mockService := mocks.NewMockAdminService(ctrl)
+				mockService.EXPECT().
+					CreateAuction(gomock.Any(), "pink-hoody", uint32(300), "2026-06-01T09:00:00Z", "2026-06-02T09:00:00Z").
+					Return(4, nil).
+					Times(1)
+
+				return mockService
+			},
+			checkResponseFn: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				var response map[string]int
+				err := json.Unmarshal(recorder.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, 4, response["auctionId"])
+			},
+		},
+		{
+			name:           "missing_end_time",
+			requestBody:    map[string]interface{}{"type": "pink-hoody"},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				return mocks.NewMockAdminService(ctrl)
+			},
+		},
+		{
+			name: "end_before_start",
+			requestBody: createAuctionRequestBody{
+				ItemName: "pink-hoody",
+				StartsAt: "2026-06-02T09:00:00Z",
+				EndsAt:   "2026-06-01T09:00:00Z",
+			},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				mockService := mocks.NewMockAdminService(ctrl)
+				mockService.EXPECT().
+					CreateAuction(gomock.Any(), "pink-hoody", uint32(0), "2026-06-02T09:00:00Z", "2026-06-01T09:00:00Z").
+					Return(0, status.Error(codes.InvalidArgument, "end time must be after the start time"))
+
+				return mockService
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockService := tt.prepareFn(t, ctrl)
+			handler := NewAdminHandler(mockService)
+
+			writer := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(writer)
+
+			body, _ := json.Marshal(tt.requestBody)
+			c.Request = httptest.NewRequest(http.MethodPost, "/admin/auctions", bytes.NewReader(body))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			handler.CreateAuction(c)
+
+			assert.Equal(t, tt.expectedStatus, writer.Code)
+			if tt.checkResponseFn != nil {
+				tt.checkResponseFn(t, writer)
+			}
+		})
+	}
+}
+
+func TestAdminHandler_CreateRaffle(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name           string
+		requestBody    interface{}
+		expectedStatus int
+
+		prepareFn       func(t *testing.T, ctrl *gomock.Controller) domain.AdminService
+		checkResponseFn func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}
+
+	tests := []testCase{
+		{
+			name: "successful raffle creation",
+			requestBody: createRaffleRequestBody{
+				ItemName:    "pink-hoody",
+				TicketPrice: 5,
+				DrawAt:      "2026-06-07T12:00:00Z",
+			},
+			expectedStatus: http.StatusOK,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				mockService := mocks.NewMockAdminService(ctrl)
+				mockService.EXPECT().
+					CreateRaffle(gomock.Any(), "pink-hoody", uint32(5), "2026-06-07T12:00:00Z").
+					Return(6, nil).
+					Times(1)
+
+				return mockService
+			},
+			checkResponseFn: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				var response map[string]int
+				err := json.Unmarshal(recorder.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, 6, response["raffleId"])
+			},
+		},
+		{
+			name:           "missing_ticket_price",
+			requestBody:    map[string]interface{}{"type": "pink-hoody", "drawAt": "2026-06-07T12:00:00Z"},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				return mocks.NewMockAdminService(ctrl)
+			},
+		},
+		{
+			name: "draw_in_the_past",
+			requestBody: createRaffleRequestBody{
+				ItemName:    "pink-hoody",
+				TicketPrice: 5,
+				DrawAt:      "2026-01-01T12:00:00Z",
+			},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				mockService := mocks.NewMockAdminService(ctrl)
+				mockService.EXPECT().
+					CreateRaffle(gomock.Any(), "pink-hoody", uint32(5), "2026-01-01T12:00:00Z").
+					Return(0, status.Error(codes.InvalidArgument, "draw time must be in the future"))
+
+				return mockService
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockService := tt.prepareFn(t, ctrl)
+			handler := NewAdminHandler(mockService)
+
+			writer := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(writer)
+
+			body, _ := json.Marshal(tt.requestBody)
+			c.Request = httptest.NewRequest(http.MethodPost, "/admin/raffles", bytes.NewReader(body))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			handler.CreateRaffle(c)
+
+			assert.Equal(t, tt.expectedStatus, writer.Code)
+			if tt.checkResponseFn != nil {
+				tt.checkResponseFn(t, writer)
+			}
+		})
+	}
+}
+
+func TestAdminHandler_CreatePromoCode(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name           string
+		requestBody    interface{}
+		expectedStatus int
+
+		prepareFn       func(t *testing.T, ctrl *gomock.Controller) domain.AdminService
+		checkResponseFn func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}
+
+	promo := domain.PromoCode{
+		Code:           "SPRING10",
+		Kind:           "percent",
+		Value:          10,
+		MaxUsesPerUser: 1,
+		ValidUntil:     "2026-06-21T12:00:00Z",
+	}
+
+	tests := []testCase{
+		{
+			name:           "successful promo code creation",
+			requestBody:    promo,
+			expectedStatus: http.StatusOK,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				mockService := mocks.NewMockAdminService(ctrl)
+				mockService.EXPECT().
+					CreatePromoCode(gomock.Any(), promo).
+					Return(4, nil).
+					Times(1)
+
+				return mockService
+			},
+			checkResponseFn: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				var response map[string]int
+				err := json.Unmarshal(recorder.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, 4, response["promoId"])
+			},
+		},
+		{
+			name:           "unknown_kind",
+			requestBody:    map[string]interface{}{"code": "SPRING10", "kind": "bogo", "value": 1, "validUntil": "2026-06-21T12:00:00Z"},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				return mocks.NewMockAdminService(ctrl)
+			},
+		},
+		{
+			name:           "duplicate_code",
+			requestBody:    promo,
+			expectedStatus: http.StatusConflict,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				mockService := mocks.NewMockAdminService(ctrl)
+				mockService.EXPECT().
+					CreatePromoCode(gomock.Any(), promo).
+					Return(0, status.Error(codes.AlreadyExists, "promo code already exists"))
+
+				return mockService
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockService := tt.prepareFn(t, ctrl)
+			handler := NewAdminHandler(mockService)
+
+			writer := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(writer)
+
+			body, _ := json.Marshal(tt.requestBody)
+			c.Request = httptest.NewRequest(http.MethodPost, "/admin/promo-codes", bytes.NewReader(body))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			handler.CreatePromoCode(c)
+
+			assert.Equal(t, tt.expectedStatus, writer.Code)
+			if tt.checkResponseFn != nil {
+				tt.checkResponseFn(t, writer)
+			}
+		})
+	}
+}
+
+func TestAdminHandler_SchedulePriceChange(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name           string
+		requestBody    interface{}
+		expectedStatus int
+
+		prepareFn       func(t *testing.T, ctrl *gomock.Controller) domain.AdminService
+		checkResponseFn func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}
+
+	sale := schedulePriceChangeRequestBody{
+		ItemName:        "hoody",
+		DiscountPercent: 30,
+		StartsAt:        "2026-12-20T00:00:00Z",
+		EndsAt:          "2027-01-01T00:00:00Z",
+	}
+
+	tests := []testCase{
+		{
+			name:           "successful sale scheduling",
+			requestBody:    sale,
+			expectedStatus: http.StatusOK,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				mockService := mocks.NewMockAdminService(ctrl)
+				mockService.EXPECT().
+					SchedulePriceChange(gomock.Any(), "hoody", uint32(0), uint32(30), sale.StartsAt, sale.EndsAt).
+					Return(2, nil).
+					Times(1)
+
+				return mockService
+			},
+			checkResponseFn: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				var response map[string]int
+				err := json.Unmarshal(recorder.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, 2, response["scheduleId"])
+			},
+		},
+		{
+			name:           "missing_item",
+			requestBody:    map[string]interface{}{"price": 250},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				return mocks.NewMockAdminService(ctrl)
+			},
+		},
+		{
+			name:           "invalid_schedule",
+			requestBody:    sale,
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				mockService := mocks.NewMockAdminService(ctrl)
+				mockService.EXPECT().
+					SchedulePriceChange(gomock.Any(), "hoody", uint32(0), uint32(30), sale.StartsAt, sale.EndsAt).
+					Return(0, status.Error(codes.InvalidArgument, "price change must end in the future and after it starts"))
+
+				return mockService
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockService := tt.prepareFn(t, ctrl)
+			handler := NewAdminHandler(mockService)
+
+			writer := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(writer)
+
+			body, _ := json.Marshal(tt.requestBody)
+			c.Request = httptest.NewRequest(http.MethodPost, "/admin/price-schedules", bytes.NewReader(body))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			handler.SchedulePriceChange(c)
+
+			assert.Equal(t, tt.expectedStatus, writer.Code)
+			if tt.checkResponseFn != nil {
+				tt.checkResponseFn(t, writer)
+			}
+		})
+	}
+}
+
+func TestAdminHandler_CreateCategory(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name           string
+		requestBody    interface{}
+		expectedStatus int
+
+		prepareFn       func(t *testing.T, ctrl *gomock.Controller) domain.AdminService
+		checkResponseFn func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}
+
+	tests := []testCase{
+		{
+			name:           "successful category creation",
+			requestBody:    createCategoryRequestBody{Slug: "home-office", Name: "Home office"},
+			expectedStatus: http.StatusOK,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				mockService := mocks.NewMockAdminService(ctrl)
+				mockService.EXPECT().
+					CreateCategory(gomock.Any(), "home-office", "Home office").
+					Return(5, nil).
+					Times(1)
+
+				return mockService
+			},
+			checkResponseFn: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				var response map[string]int
+				err := json.Unmarshal(recorder.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, 5, response["categoryId"])
+			},
+		},
+		{
+			name:           "missing_name",
+			requestBody:    map[string]interface{}{"slug": "home-office"},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				return mocks.NewMockAdminService(ctrl)
+			},
+		},
+		{
+			name:           "existing_category",
+			requestBody:    createCategoryRequestBody{Slug: "apparel", Name: "Apparel"},
+			expectedStatus: http.StatusConflict,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				mockService := mocks.NewMockAdminService(ctrl)
+				mockService.EXPECT().
+					CreateCategory(gomock.Any(), "apparel", "Apparel").
+					Return(0, status.Error(codes.AlreadyExists, "category apparel already exists"))
+
+				return mockService
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockService := tt.prepareFn(t, ctrl)
+			handler := NewAdminHandler(mockService)
+
+			writer := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(writer)
+
+			body, _ := json.Marshal(tt.requestBody)
+			c.Request = httptest.NewRequest(http.MethodPost, "/admin/categories", bytes.NewReader(body))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			handler.CreateCategory(c)
+
+			assert.Equal(t, tt.expectedStatus, writer.Code)
+			if tt.checkResponseFn != nil {
+				tt.checkResponseFn(t, writer)
+			}
+		})
+	}
+}
+
+func TestAdminHandler_UpdateGoodDetails(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name           string
+		requestBody    interface{}
+		expectedStatus int
+
+		prepareFn func(t *testing.T, ctrl *gomock.Controller) domain.AdminService
+	}
+
+	details := domain.GoodDetails{
+		Category:    "apparel",
+		Description: "Warm hoody",
+		Images: []domain.GoodImage{
+			{URL: "https://cdn.example.com/hoody-front.png", AltText: "Hoody, front", Width: 800, Height: 800},
+		},
+	}
+
+	tests := []testCase{
+		{
+			name:           "successful update",
+			requestBody:    details,
+			expectedStatus: http.StatusOK,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				mockService := mocks.NewMockAdminService(ctrl)
+				mockService.EXPECT().
+					UpdateGoodDetails(gomock.Any(), "hoody", details).
+					Return(nil).
+					Times(1)
+
+				return mockService
+			},
+		},
+		{
+			name:           "image_without_url",
+			requestBody:    map[string]interface{}{"images": []map[string]interface{}{{"altText": "Hoody"}}},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				return mocks.NewMockAdminService(ctrl)
+			},
+		},
+		{
+			name:           "unknown_category",
+			requestBody:    details,
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				mockService := mocks.NewMockAdminService(ctrl)
+				mockService.EXPECT().
+					UpdateGoodDetails(gomock.Any(), "hoody", details).
+					Return(status.Error(codes.InvalidArgument, "category apparel not found"))
+
+				return mockService
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockService := tt.prepareFn(t, ctrl)
+			handler := NewAdminHandler(mockService)
+
+			writer := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(writer)
+
+			body, _ := json.Marshal(tt.requestBody)
+			c.Request = httptest.NewRequest(http.MethodPut, "/admin/goods/hoody", bytes.NewReader(body))
+			c.Request.Header.Set("Content-Type", "application/json")
+			c.Params = gin.Params{{Key: ItemNameKey, Value: "hoody"}}
+
+			handler.UpdateGoodDetails(c)
+
+			assert.Equal(t, tt.expectedStatus, writer.Code)
+		})
+	}
+}
+
+func TestAdminHandler_SetPurchaseLimit(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name           string
+		requestBody    interface{}
+		expectedStatus int
+
+		prepareFn func(t *testing.T, ctrl *gomock.Controller) domain.AdminService
+	}
+
+	tests := []testCase{
+		{
+			name:           "successful limit",
+			requestBody:    purchaseLimitRequestBody{MaxQuantity: 3, PeriodDays: 90},
+			expectedStatus: http.StatusOK,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				mockService := mocks.NewMockAdminService(ctrl)
+				mockService.EXPECT().
+					SetPurchaseLimit(gomock.Any(), "pink-hoody", uint32(3), uint32(90)).
+					Return(nil).
+					Times(1)
+
+				return mockService
+			},
+		},
+		{
+			name:           "invalid_body",
+			requestBody:    map[string]interface{}{"maxQuantity": -1},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				return mocks.NewMockAdminService(ctrl)
+			},
+		},
+		{
+			name:           "bundle",
+			requestBody:    purchaseLimitRequestBody{MaxQuantity: 1},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				mockService := mocks.NewMockAdminService(ctrl)
+				mockService.EXPECT().
+					SetPurchaseLimit(gomock.Any(), "pink-hoody", uint32(1), uint32(0)).
+					Return(status.Error(codes.InvalidArgument, "bundles are limited through the limits of their items"))
+
+				return mockService
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockService := tt.prepareFn(t, ctrl)
+			handler := NewAdminHandler(mockService)
+
+			writer := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(writer)
+
+			body, _ := json.Marshal(tt.requestBody)
+			c.Request = httptest.NewRequest(http.MethodPut, "/admin/goods/pink-hoody/limit", bytes.NewReader(body))
+			c.Request.Header.Set("Content-Type", "application/json")
+			c.Params = gin.Params{{Key: ItemNameKey, Value: "pink-hoody"}}
+
+			handler.SetPurchaseLimit(c)
+
+			assert.Equal(t, tt.expectedStatus, writer.Code)
+		})
+	}
+}
+
+func TestAdminHandler_MarkGoodArrived(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name           string
+		expectedStatus int
+
+		prepareFn       func(t *testing.T, ctrl *gomock.Controller) domain.AdminService
+		checkResponseFn func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}
+
+	tests := []testCase{
+		{
+			name:           "successful arrival",
+			expectedStatus: http.StatusOK,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				mockService := mocks.NewMockAdminService(ctrl)
+				mockService.EXPECT().
+					MarkGoodArrived(gomock.Any(), "umbrella").
+					Return(domain.GoodArrival{Fulfilled: 4, Pending: 1}, nil).
+					Times(1)
+
+				return mockService
+			},
+			checkResponseFn: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				var response map[string]int
+				err := json.Unmarshal(recorder.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, 4, response["fulfilled"])
+				assert.Equal(t, 1, response["pending"])
+			},
+		},
+		{
+			name:           "item_not_found",
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				mockService := mocks.NewMockAdminService(ctrl)
+				mockService.EXPECT().
+					MarkGoodArrived(gomock.Any(), "umbrella").
+					Return(domain.GoodArrival{}, status.Error(codes.InvalidArgument, "item not found"))
+
+				return mockService
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockService := tt.prepareFn(t, ctrl)
+			handler := NewAdminHandler(mockService)
+
+			writer := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(writer)
+
+			c.Request = httptest.NewRequest(http.MethodPost, "/admin/goods/umbrella/arrived", nil)
+			c.Params = gin.Params{{Key: ItemNameKey, Value: "umbrella"}}
+
+			handler.MarkGoodArrived(c)
+
+			assert.Equal(t, tt.expectedStatus, writer.Code)
+			if tt.checkResponseFn != nil {
+				tt.checkResponseFn(t, writer)
+			}
+		})
+	}
+}
+
+func TestAdminHandler_CreateWebhookEndpoint(t *testing.T) {
+	t.Parallel()
+
+	const url = "https://warehouse.example.com/hooks"
+
+	type testCase struct {
+		name           string
+		requestBody    interface{}
+		expectedStatus int
+
+		prepareFn       func(t *testing.T, ctrl *gomock.Controller) domain.AdminService
+		checkResponseFn func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}
+
+	tests := []testCase{
+		{
+			name:           "successful creation",
+			requestBody:    createWebhookEndpointRequestBody{URL: url, Events: []string{"purchase", "gift"}},
+			expectedStatus: http.StatusOK,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				mockService := mocks.NewMockAdminService(ctrl)
+				mockService.EXPECT().
+					CreateWebhookEndpoint(gomock.Any(), url, []string{"purchase", "gift"}).
+					Return(domain.WebhookEndpoint{Id: 2, URL: url, Events: []string{"purchase", "gift"}, Secret: "s3cr3t"}, nil).
+					Times(1)
+
+				return mockService
+			},
+			checkResponseFn: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				var response domain.WebhookEndpoint
+				err := json.Unmarshal(recorder.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, 2, response.Id)
+				assert.Equal(t, "s3cr3t", response.Secret)
+			},
+		},
+		{
+			name:           "missing_events",
+			requestBody:    map[string]interface{}{"url": url},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				return mocks.NewMockAdminService(ctrl)
+			},
+		},
+		{
+			name:           "unknown_event",
+			requestBody:    createWebhookEndpointRequestBody{URL: url, Events: []string{"refund"}},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				mockService := mocks.NewMockAdminService(ctrl)
+				mockService.EXPECT().
+					CreateWebhookEndpoint(gomock.Any(), url, []string{"refund"}).
+					Return(domain.WebhookEndpoint{}, status.Error(codes.InvalidArgument, "unknown webhook event: refund"))
+
+				return mockService
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockService := tt.prepareFn(t, ctrl)
+			handler := NewAdminHandler(mockService)
+
+			writer := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(writer)
+
+			body, _ := json.Marshal(tt.requestBody)
+			c.Request = httptest.NewRequest(http.MethodPost, "/admin/webhooks", bytes.NewReader(body))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			handler.CreateWebhookEndpoint(c)
+
+			assert.Equal(t, tt.expectedStatus, writer.Code)
+			if tt.checkResponseFn != nil {
+				tt.checkResponseFn(t, writer)
+			}
+		})
+	}
+}
+
+func TestAdminHandler_DeleteWebhookEndpoint(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name           string
+		webhookID      string
+		expectedStatus int
+
+		prepareFn func(t *testing.T, ctrl *gomock.Controller) domain.AdminService
+	}
+
+	tests := []testCase{
+		{
+			name:           "successful deletion",
+			webhookID:      "2",
+			expectedStatus: http.StatusOK,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				mockService := mocks.NewMockAdminService(ctrl)
+				mockService.EXPECT().DeleteWebhookEndpoint(gomock.Any(), 2).Return(nil).Times(1)
+
+				return mockService
+			},
+		},
+		{
+			name:           "invalid_id",
+			webhookID:      "abc",
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				return mocks.NewMockAdminService(ctrl)
+			},
+		},
+		{
+			name:           "webhook_not_found",
+			webhookID:      "42",
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				mockService := mocks.NewMockAdminService(ctrl)
+				mockService.EXPECT().
+					DeleteWebhookEndpoint(gomock.Any(), 42).
+					Return(status.Error(codes.NotFound, "webhook endpoint not found"))
+
+				return mockService
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockService := tt.prepareFn(t, ctrl)
+			handler := NewAdminHandler(mockService)
+
+			writer := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(writer)
+
+			c.Request = httptest.NewRequest(http.MethodDelete, "/admin/webhooks/"+tt.webhookID, nil)
+			c.Params = gin.Params{{Key: WebhookIDKey, Value: tt.webhookID}}
+
+			handler.DeleteWebhookEndpoint(c)
+
+			assert.Equal(t, tt.expectedStatus, writer.Code)
+		})
+	}
+}
+
+func TestAdminHandler_FetchEvents(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name           string
+		query          string
+		expectedStatus int
+
+		prepareFn       func(t *testing.T, ctrl *gomock.Controller) domain.AdminService
+		checkResponseFn func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}
+
+	tests := []testCase{
+		{
+			name:           "events fetched",
+			query:          "?consumer=warehouse&limit=10",
+			expectedStatus: http.StatusOK,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				mockService := mocks.NewMockAdminService(ctrl)
+				mockService.EXPECT().
+					FetchEvents(gomock.Any(), "warehouse", uint32(10)).
+					Return([]domain.DomainEvent{{Id: 40, Offset: 12, Type: "CoinsSent", UserIDs: []int{1, 2}}}, nil).
+					Times(1)
+
+				return mockService
+			},
+			checkResponseFn: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				var response struct {
+					Events []domain.DomainEvent `json:"events"`
+				}
+				err := json.Unmarshal(recorder.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Len(t, response.Events, 1)
+				assert.Equal(t, int64(12), response.Events[0].Offset)
+			},
+		},
+		{
+			name:           "invalid_limit",
+			query:          "?consumer=warehouse&limit=-1",
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				return mocks.NewMockAdminService(ctrl)
+			},
+		},
+		{
+			name:           "invalid_consumer",
+			query:          "",
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				mockService := mocks.NewMockAdminService(ctrl)
+				mockService.EXPECT().
+					FetchEvents(gomock.Any(), "", uint32(0)).
+					Return(nil, status.Error(codes.InvalidArgument, "invalid consumer name"))
+
+				return mockService
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockService := tt.prepareFn(t, ctrl)
+			handler := NewAdminHandler(mockService)
+
+			writer := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(writer)
+
+			c.Request = httptest.NewRequest(http.MethodGet, "/admin/events"+tt.query, nil)
+
+			handler.FetchEvents(c)
+
+			assert.Equal(t, tt.expectedStatus, writer.Code)
+			if tt.checkResponseFn != nil {
+				tt.checkResponseFn(t, writer)
+			}
+		})
+	}
+}
+
+func TestAdminHandler_CommitEventOffset(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name           string
+		requestBody    interface{}
+		expectedStatus int
+
+		prepareFn func(t *testing.T, ctrl *gomock.Controller) domain.AdminService
+	}
+
+	tests := []testCase{
+		{
+			name:           "offset committed",
+			requestBody:    map[string]interface{}{"offset": 12},
+			expectedStatus: http.StatusOK,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				mockService := mocks.NewMockAdminService(ctrl)
+				mockService.EXPECT().CommitEventOffset(gomock.Any(), "warehouse", int64(12)).Return(nil).Times(1)
+
+				return mockService
+			},
+		},
+		{
+			name:           "replay from the beginning",
+			requestBody:    map[string]interface{}{"offset": 0},
+			expectedStatus: http.StatusOK,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				mockService := mocks.NewMockAdminService(ctrl)
+				mockService.EXPECT().CommitEventOffset(gomock.Any(), "warehouse", int64(0)).Return(nil).Times(1)
+
+				return mockService
+			},
+		},
+		{
+			name:           "missing_offset",
+			requestBody:    map[string]interface{}{},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				return mocks.NewMockAdminService(ctrl)
+			},
+		},
+		{
+			name:           "offset_past_last_event",
+			requestBody:    map[string]interface{}{"offset": 99},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AdminService {
+				mockService := mocks.NewMockAdminService(ctrl)
+				mockService.EXPECT().
+					CommitEventOffset(gomock.Any(), "warehouse", int64(99)).
+					Return(status.Error(codes.InvalidArgument, "offset 99 is past the last event 12"))
+
+				return mockService
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockService := tt.prepareFn(t, ctrl)
+			handler := NewAdminHandler(mockService)
+
+			writer := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(writer)
+
+			body, _ := json.Marshal(tt.requestBody)
+			c.Request = httptest.NewRequest(http.MethodPut, "/admin/events/consumers/warehouse/offset", bytes.NewReader(body))
+			c.Request.Header.Set("Content-Type", "application/json")
+			c.Params = gin.Params{{Key: ConsumerKey, Value: "warehouse"}}
+
+			handler.CommitEventOffset(c)
+
+			assert.Equal(t, tt.expectedStatus, writer.Code)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/Lexv0lk/merch-store/internal/gateway/domain"
+	"github.com/gin-gonic/gin"
+)
+
+type AuditHandler struct {
+	service domain.AuditService
+}
+
+func NewAuditHandler(service domain.AuditService) *AuditHandler {
+	return &AuditHandler{
+		service: service,
+	}
+}
+
+func (h *AuditHandler) ListAuditLog(c *gin.Context) {
+	filter := domain.AuditFilter{
+		Source: c.Query("source"),
+		Actor:  c.Query("actor"),
+		Action: c.Query("action"),
+		Target: c.Query("target"),
+		From:   c.Query("from"),
+		To:     c.Query("to"),
+	}
+
+	if rawLimit := c.Query("limit"); rawLimit != "" {
+		limit, err := strconv.ParseUint(rawLimit, 10, 32)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"errors": "invalid limit"})
+			return
+		}
+
+		filter.Limit = uint32(limit)
+	}
+
+	events, err := h.service.ListAuditLog(c, filter)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"events": events})
+}
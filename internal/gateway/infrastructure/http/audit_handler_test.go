@@ -0,0 +1,132 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mocks "github.com/Lexv0lk/merch-store/gen/mocks/gateway"
+	"github.com/Lexv0lk/merch-store/internal/gateway/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuditHandler_ListAuditLog(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name           string
+		query          string
+		expectedStatus int
+
+		prepareFn       func(t *testing.T, ctrl *gomock.Controller) domain.AuditService
+		checkResponseFn func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}
+
+	tests := []testCase{
+		{
+			name:           "successful listing with filters",
+			query:          "?source=store&actor=admin&action=account-freeze&from=2026-04-01T00:00:00Z&limit=10",
+			expectedStatus: http.StatusOK,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AuditService {
+				mockService := mocks.NewMockAuditService(ctrl)
+				mockService.EXPECT().
+					ListAuditLog(gomock.Any(), domain.AuditFilter{
+						Source: "store",
+						Actor:  "admin",
+						Action: "account-freeze",
+						From:   "2026-04-01T00:00:00Z",
+						Limit:  10,
+					}).
+					Return([]domain.AuditEvent{{
+						Source: "store",
+						Actor:  "admin",
+						Action: "account-freeze",
+						Target: "user:suspect",
+						After:  json.RawMessage(`{"frozen":true}`),
+					}}, nil).
+					Times(1)
+
+				return mockService
+			},
+			checkResponseFn: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				var response struct {
+					Events []struct {
+						Target string          `json:"target"`
+						After  map[string]bool `json:"after"`
+					} `json:"events"`
+				}
+				err := json.Unmarshal(recorder.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Len(t, response.Events, 1)
+				assert.Equal(t, "user:suspect", response.Events[0].Target)
+				assert.True(t, response.Events[0].After["frozen"])
+			},
+		},
+		{
+			name:           "invalid limit",
+			query:          "?limit=many",
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AuditService {
+				return mocks.NewMockAuditService(ctrl)
+			},
+		},
+		{
+			name:           "permission_denied_error",
+			expectedStatus: http.StatusForbidden,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AuditService {
+				mockService := mocks.NewMockAuditService(ctrl)
+				mockService.EXPECT().
+					ListAuditLog(gomock.Any(), domain.AuditFilter{}).
+					Return(nil, status.Error(codes.PermissionDenied, "auditor role required"))
+
+				return mockService
+			},
+		},
+		{
+			name:           "invalid_argument_error",
+			query:          "?from=yesterday",
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.AuditService {
+				mockService := mocks.NewMockAuditService(ctrl)
+				mockService.EXPECT().
+					ListAuditLog(gomock.Any(), domain.AuditFilter{From: "yesterday"}).
+					Return(nil, status.Error(codes.InvalidArgument, "invalid time"))
+
+				return mockService
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockService := tt.prepareFn(t, ctrl)
+			handler := NewAuditHandler(mockService)
+
+			writer := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(writer)
+			c.Request = httptest.NewRequest(http.MethodGet, "/audit"+tt.query, nil)
+
+			handler.ListAuditLog(c)
+
+			assert.Equal(t, tt.expectedStatus, writer.Code)
+			if tt.checkResponseFn != nil {
+				tt.checkResponseFn(t, writer)
+			}
+		})
+	}
+}
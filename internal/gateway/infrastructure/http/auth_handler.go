@@ -10,7 +10,14 @@ import (
 )
 
 const (
-	ItemNameKey = "item"
+	ItemNameKey            = "item"
+	TeamNameKey            = "team"
+	PaymentRequestIDKey    = "requestId"
+	ScheduledTransferIDKey = "scheduleId"
+	ListingIDKey           = "listingId"
+	AuctionIDKey           = "auctionId"
+	RaffleIDKey            = "raffleId"
+	PreorderIDKey          = "preorderId"
 )
 
 type authRequestBody struct {
@@ -43,6 +50,8 @@ func (h *AuthHandler) Authenticate(c *gin.Context) {
 			switch st.Code() {
 			case codes.Unauthenticated:
 				c.JSON(http.StatusUnauthorized, gin.H{"errors": st.Message()})
+			case codes.PermissionDenied:
+				c.JSON(http.StatusForbidden, gin.H{"errors": st.Message()})
 			default:
 				c.JSON(http.StatusInternalServerError, gin.H{"errors": st.Message()})
 			}
@@ -0,0 +1,35 @@
+package http
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/audit"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	requestIDBytes     = 16
+	maxRequestIDLength = 128
+)
+
+// NewRequestIDMiddleware tags every request with an id, reusing the one sent by the client if present,
+// so audit entries written by the backend services can be correlated with a single HTTP call.
+func NewRequestIDMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(audit.RequestIDHeader)
+		if requestID == "" || len(requestID) > maxRequestIDLength {
+			requestID = generateRequestID()
+		}
+
+		c.Set(audit.RequestIDContextKey, requestID)
+		c.Header(audit.RequestIDHeader, requestID)
+		c.Next()
+	}
+}
+
+func generateRequestID() string {
+	buf := make([]byte, requestIDBytes)
+	_, _ = rand.Read(buf)
+	return hex.EncodeToString(buf)
+}
@@ -0,0 +1,66 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/audit"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRequestIDMiddleware(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name   string
+		header string
+
+		expectGenerated bool
+		expectedID      string
+	}
+
+	testCases := []testCase{
+		{
+			name:       "client request id reused",
+			header:     "req-42",
+			expectedID: "req-42",
+		},
+		{
+			name:            "missing request id generated",
+			header:          "",
+			expectGenerated: true,
+		},
+		{
+			name:            "oversized request id replaced",
+			header:          strings.Repeat("a", maxRequestIDLength+1),
+			expectGenerated: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			writer := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(writer)
+
+			c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+			c.Request.Header.Set(audit.RequestIDHeader, tt.header)
+
+			middleware := NewRequestIDMiddleware()
+			middleware(c)
+
+			requestID := c.GetString(audit.RequestIDContextKey)
+			if tt.expectGenerated {
+				assert.Len(t, requestID, requestIDBytes*2)
+			} else {
+				assert.Equal(t, tt.expectedID, requestID)
+			}
+			assert.Equal(t, requestID, writer.Header().Get(audit.RequestIDHeader))
+		})
+	}
+}
@@ -1,7 +1,9 @@
 package http
 
 import (
+	"context"
 	"net/http"
+	"strconv"
 
 	"github.com/Lexv0lk/merch-store/internal/gateway/domain"
 	"github.com/gin-gonic/gin"
@@ -9,11 +11,86 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+const (
+	promoCodeQueryKey = "promoCode"
+	categoryQueryKey  = "category"
+	variantQueryKey   = "variant"
+)
+
 type sendCoinRequestBody struct {
 	ToUsername string `json:"toUser" binding:"required"`
 	Amount     uint32 `json:"amount" binding:"required,gt=0"`
 }
 
+type sendCoinsBatchRequestBody struct {
+	Transfers []sendCoinRequestBody `json:"transfers" binding:"required,min=1,dive"`
+}
+
+type giftItemRequestBody struct {
+	ToUsername string `json:"toUser" binding:"required"`
+	Message    string `json:"message"`
+	Variant    string `json:"variant"`
+}
+
+type transferItemRequestBody struct {
+	ToUsername string `json:"toUser" binding:"required"`
+	Variant    string `json:"variant"`
+}
+
+type sendFromTeamBudgetRequestBody struct {
+	ToUsername string `json:"toUser" binding:"required"`
+	Amount     uint32 `json:"amount" binding:"required,gt=0"`
+}
+
+type createPaymentRequestRequestBody struct {
+	FromUsername string `json:"fromUser" binding:"required"`
+	Amount       uint32 `json:"amount" binding:"required,gt=0"`
+	Note         string `json:"note"`
+	ExpiryDays   uint32 `json:"expiryDays"`
+}
+
+type scheduleTransferRequestBody struct {
+	ToUsername string `json:"toUser" binding:"required"`
+	Amount     uint32 `json:"amount" binding:"required,gt=0"`
+	StartAt    string `json:"startAt"`
+	Recurrence string `json:"recurrence"`
+}
+
+type createListingRequestBody struct {
+	ItemName string `json:"type" binding:"required"`
+	Variant  string `json:"variant"`
+	Price    uint32 `json:"price" binding:"required,gt=0"`
+}
+
+type updateListingRequestBody struct {
+	Price uint32 `json:"price" binding:"required,gt=0"`
+}
+
+type searchListingsQuery struct {
+	Query    string `form:"query"`
+	Seller   string `form:"seller"`
+	MaxPrice uint32 `form:"maxPrice"`
+	Limit    uint32 `form:"limit"`
+	Offset   uint32 `form:"offset"`
+}
+
+type infoQuery struct {
+	Wishlist bool `form:"wishlist"`
+}
+
+type placeBidRequestBody struct {
+	Amount uint32 `json:"amount" binding:"required,gt=0"`
+}
+
+type buyRaffleTicketsRequestBody struct {
+	Count uint32 `json:"count" binding:"required,gt=0"`
+}
+
+type placePreorderRequestBody struct {
+	ItemName string `json:"type" binding:"required"`
+	Variant  string `json:"variant"`
+}
+
 type StoreHandler struct {
 	service domain.StoreService
 }
@@ -25,7 +102,14 @@ func NewStoreHandler(service domain.StoreService) *StoreHandler {
 }
 
 func (h *StoreHandler) GetInfo(c *gin.Context) {
-	info, err := h.service.GetUserInfo(c)
+	var query infoQuery
+
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errors": "invalid query parameters"})
+		return
+	}
+
+	info, err := h.service.GetUserInfo(c, query.Wishlist)
 	if err != nil {
 		handleGRPCError(c, err)
 		return
@@ -51,10 +135,137 @@ func (h *StoreHandler) SendCoin(c *gin.Context) {
 	c.Status(http.StatusOK)
 }
 
+func (h *StoreHandler) SendCoinsBatch(c *gin.Context) {
+	var body sendCoinsBatchRequestBody
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errors": "invalid request body"})
+		return
+	}
+
+	transfers := make([]domain.SentTransfer, 0, len(body.Transfers))
+	for _, transfer := range body.Transfers {
+		transfers = append(transfers, domain.SentTransfer{
+			To:     transfer.ToUsername,
+			Amount: transfer.Amount,
+		})
+	}
+
+	err := h.service.SendCoinsBatch(c, transfers)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
 func (h *StoreHandler) BuyItem(c *gin.Context) {
 	itemName := c.Param(ItemNameKey)
 
-	err := h.service.BuyItem(c, itemName)
+	err := h.service.BuyItem(c, itemName, c.Query(variantQueryKey), c.Query(promoCodeQueryKey))
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func (h *StoreHandler) GiftItem(c *gin.Context) {
+	var body giftItemRequestBody
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errors": "invalid request body"})
+		return
+	}
+
+	err := h.service.GiftItem(c, c.Param(ItemNameKey), body.Variant, body.ToUsername, body.Message)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func (h *StoreHandler) TransferItem(c *gin.Context) {
+	var body transferItemRequestBody
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errors": "invalid request body"})
+		return
+	}
+
+	err := h.service.TransferItem(c, c.Param(ItemNameKey), body.Variant, body.ToUsername)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func (h *StoreHandler) SendFromTeamBudget(c *gin.Context) {
+	var body sendFromTeamBudgetRequestBody
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errors": "invalid request body"})
+		return
+	}
+
+	err := h.service.SendFromTeamBudget(c, c.Param(TeamNameKey), body.ToUsername, body.Amount)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func (h *StoreHandler) CreatePaymentRequest(c *gin.Context) {
+	var body createPaymentRequestRequestBody
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errors": "invalid request body"})
+		return
+	}
+
+	requestID, err := h.service.CreatePaymentRequest(c, body.FromUsername, body.Amount, body.Note, body.ExpiryDays)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"requestId": requestID})
+}
+
+func (h *StoreHandler) ListPaymentRequests(c *gin.Context) {
+	requests, err := h.service.ListPaymentRequests(c)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"requests": requests})
+}
+
+func (h *StoreHandler) AcceptPaymentRequest(c *gin.Context) {
+	h.answerPaymentRequest(c, h.service.AcceptPaymentRequest)
+}
+
+func (h *StoreHandler) DeclinePaymentRequest(c *gin.Context) {
+	h.answerPaymentRequest(c, h.service.DeclinePaymentRequest)
+}
+
+func (h *StoreHandler) answerPaymentRequest(c *gin.Context, answerFn func(ctx context.Context, requestID int) error) {
+	requestID, err := strconv.Atoi(c.Param(PaymentRequestIDKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errors": "invalid request id"})
+		return
+	}
+
+	err = answerFn(c, requestID)
 	if err != nil {
 		handleGRPCError(c, err)
 		return
@@ -63,6 +274,344 @@ func (h *StoreHandler) BuyItem(c *gin.Context) {
 	c.Status(http.StatusOK)
 }
 
+func (h *StoreHandler) ScheduleTransfer(c *gin.Context) {
+	var body scheduleTransferRequestBody
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errors": "invalid request body"})
+		return
+	}
+
+	scheduleID, err := h.service.ScheduleTransfer(c, body.ToUsername, body.Amount, body.StartAt, body.Recurrence)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"scheduleId": scheduleID})
+}
+
+func (h *StoreHandler) ListScheduledTransfers(c *gin.Context) {
+	transfers, err := h.service.ListScheduledTransfers(c)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"transfers": transfers})
+}
+
+func (h *StoreHandler) CancelScheduledTransfer(c *gin.Context) {
+	scheduleID, err := strconv.Atoi(c.Param(ScheduledTransferIDKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errors": "invalid schedule id"})
+		return
+	}
+
+	err = h.service.CancelScheduledTransfer(c, scheduleID)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func (h *StoreHandler) CreateListing(c *gin.Context) {
+	var body createListingRequestBody
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errors": "invalid request body"})
+		return
+	}
+
+	listingID, err := h.service.CreateListing(c, body.ItemName, body.Variant, body.Price)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"listingId": listingID})
+}
+
+func (h *StoreHandler) SearchListings(c *gin.Context) {
+	var query searchListingsQuery
+
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errors": "invalid query parameters"})
+		return
+	}
+
+	listings, err := h.service.SearchListings(c, domain.ListingFilter{
+		Query:    query.Query,
+		Seller:   query.Seller,
+		MaxPrice: query.MaxPrice,
+		Limit:    query.Limit,
+		Offset:   query.Offset,
+	})
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"listings": listings})
+}
+
+func (h *StoreHandler) UpdateListing(c *gin.Context) {
+	listingID, err := strconv.Atoi(c.Param(ListingIDKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errors": "invalid listing id"})
+		return
+	}
+
+	var body updateListingRequestBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errors": "invalid request body"})
+		return
+	}
+
+	err = h.service.UpdateListing(c, listingID, body.Price)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func (h *StoreHandler) CancelListing(c *gin.Context) {
+	h.handleListing(c, h.service.CancelListing)
+}
+
+func (h *StoreHandler) BuyListing(c *gin.Context) {
+	h.handleListing(c, h.service.BuyListing)
+}
+
+func (h *StoreHandler) handleListing(c *gin.Context, handleFn func(ctx context.Context, listingID int) error) {
+	listingID, err := strconv.Atoi(c.Param(ListingIDKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errors": "invalid listing id"})
+		return
+	}
+
+	err = handleFn(c, listingID)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func (h *StoreHandler) ListAuctions(c *gin.Context) {
+	auctions, err := h.service.ListAuctions(c)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"auctions": auctions})
+}
+
+func (h *StoreHandler) PlaceBid(c *gin.Context) {
+	auctionID, err := strconv.Atoi(c.Param(AuctionIDKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errors": "invalid auction id"})
+		return
+	}
+
+	var body placeBidRequestBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errors": "invalid request body"})
+		return
+	}
+
+	err = h.service.PlaceBid(c, auctionID, body.Amount)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func (h *StoreHandler) ListRaffles(c *gin.Context) {
+	raffles, err := h.service.ListRaffles(c)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"raffles": raffles})
+}
+
+func (h *StoreHandler) BuyRaffleTickets(c *gin.Context) {
+	raffleID, err := strconv.Atoi(c.Param(RaffleIDKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errors": "invalid raffle id"})
+		return
+	}
+
+	var body buyRaffleTicketsRequestBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errors": "invalid request body"})
+		return
+	}
+
+	err = h.service.BuyRaffleTickets(c, raffleID, body.Count)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func (h *StoreHandler) AddToWishlist(c *gin.Context) {
+	err := h.service.AddToWishlist(c, c.Param(ItemNameKey))
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func (h *StoreHandler) RemoveFromWishlist(c *gin.Context) {
+	err := h.service.RemoveFromWishlist(c, c.Param(ItemNameKey))
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func (h *StoreHandler) ListWishlist(c *gin.Context) {
+	items, err := h.service.ListWishlist(c)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"items": items})
+}
+
+func (h *StoreHandler) ListWishlistEvents(c *gin.Context) {
+	events, err := h.service.ListWishlistEvents(c)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"events": events})
+}
+
+func (h *StoreHandler) ListGoods(c *gin.Context) {
+	items, err := h.service.ListGoods(c, c.Query(categoryQueryKey))
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"items": items})
+}
+
+func (h *StoreHandler) ListCategories(c *gin.Context) {
+	categories, err := h.service.ListCategories(c)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"categories": categories})
+}
+
+func (h *StoreHandler) GetPriceHistory(c *gin.Context) {
+	history, err := h.service.GetPriceHistory(c, c.Param(ItemNameKey))
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, history)
+}
+
+func (h *StoreHandler) PlacePreorder(c *gin.Context) {
+	var body placePreorderRequestBody
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errors": "invalid request body"})
+		return
+	}
+
+	preorderID, err := h.service.PlacePreorder(c, body.ItemName, body.Variant)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"preorderId": preorderID})
+}
+
+func (h *StoreHandler) ListPreorders(c *gin.Context) {
+	preorders, err := h.service.ListPreorders(c)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, preorders)
+}
+
+func (h *StoreHandler) CancelPreorder(c *gin.Context) {
+	preorderID, err := strconv.Atoi(c.Param(PreorderIDKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errors": "invalid pre-order id"})
+		return
+	}
+
+	err = h.service.CancelPreorder(c, preorderID)
+	if err != nil {
+		handleGRPCError(c, err)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// SubscribeEvents streams the user's notifications as server-sent events named after their type. Errors before
+// the first event are returned as usual, later ones end the stream.
+func (h *StoreHandler) SubscribeEvents(c *gin.Context) {
+	c.Header("Cache-Control", "no-cache")
+	c.Header("X-Accel-Buffering", "no")
+
+	ctx := requestContext{Context: c.Request.Context(), c: c}
+	err := h.service.SubscribeEvents(ctx, func(event domain.UserEvent) error {
+		c.SSEvent(event.Type, event)
+		c.Writer.Flush()
+		return nil
+	})
+	if err != nil && !c.Writer.Written() {
+		handleGRPCError(c, err)
+	}
+}
+
+// requestContext ends with the request, so the event stream closes when the client disconnects, and keeps the
+// values set on the gin context, such as the token and the request id passed on to the store.
+type requestContext struct {
+	context.Context
+	c *gin.Context
+}
+
+func (rc requestContext) Value(key any) any {
+	if value := rc.c.Value(key); value != nil {
+		return value
+	}
+
+	return rc.Context.Value(key)
+}
+
 func handleGRPCError(c *gin.Context, err error) {
 	st, ok := status.FromError(err)
 	if !ok {
@@ -73,8 +622,14 @@ func handleGRPCError(c *gin.Context, err error) {
 	switch st.Code() {
 	case codes.Unauthenticated:
 		c.JSON(http.StatusUnauthorized, gin.H{"errors": st.Message()})
+	case codes.PermissionDenied:
+		c.JSON(http.StatusForbidden, gin.H{"errors": st.Message()})
 	case codes.InvalidArgument, codes.FailedPrecondition, codes.NotFound:
 		c.JSON(http.StatusBadRequest, gin.H{"errors": st.Message()})
+	case codes.AlreadyExists:
+		c.JSON(http.StatusConflict, gin.H{"errors": st.Message()})
+	case codes.ResourceExhausted:
+		c.JSON(http.StatusTooManyRequests, gin.H{"errors": st.Message()})
 	default:
 		c.JSON(http.StatusInternalServerError, gin.H{"errors": "internal server error"})
 	}
@@ -2,6 +2,7 @@ package http
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -9,6 +10,7 @@ import (
 
 	mocks "github.com/Lexv0lk/merch-store/gen/mocks/gateway"
 	"github.com/Lexv0lk/merch-store/internal/gateway/domain"
+	"github.com/Lexv0lk/merch-store/internal/pkg/jwt"
 	"github.com/gin-gonic/gin"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -21,6 +23,7 @@ func TestStoreHandler_GetInfo(t *testing.T) {
 
 	type testCase struct {
 		name           string
+		target         string
 		expectedStatus int
 
 		prepareFn       func(t *testing.T, ctrl *gomock.Controller) domain.StoreService
@@ -45,12 +48,13 @@ func TestStoreHandler_GetInfo(t *testing.T) {
 	tests := []testCase{
 		{
 			name:           "successful get info",
+			target:         "/",
 			expectedStatus: http.StatusOK,
 
 			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
 				mockService := mocks.NewMockStoreService(ctrl)
 				mockService.EXPECT().
-					GetUserInfo(gomock.Any()).
+					GetUserInfo(gomock.Any(), false).
 					Return(expectedInfo, nil).
 					Times(1)
 
@@ -63,14 +67,48 @@ func TestStoreHandler_GetInfo(t *testing.T) {
 				assert.Equal(t, expectedInfo, response)
 			},
 		},
+		{
+			name:           "info with wishlist",
+			target:         "/?wishlist=true",
+			expectedStatus: http.StatusOK,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				info := expectedInfo
+				info.Wishlist = []domain.WishlistItem{{Name: "powerbank", Price: 200, CoinsNeeded: 100}}
+
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					GetUserInfo(gomock.Any(), true).
+					Return(info, nil).
+					Times(1)
+
+				return mockService
+			},
+			checkResponseFn: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				var response domain.UserInfo
+				err := json.Unmarshal(recorder.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, []domain.WishlistItem{{Name: "powerbank", Price: 200, CoinsNeeded: 100}}, response.Wishlist)
+			},
+		},
+		{
+			name:           "invalid_wishlist_flag",
+			target:         "/?wishlist=maybe",
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				return mocks.NewMockStoreService(ctrl)
+			},
+		},
 		{
 			name:           "not_found_error",
+			target:         "/",
 			expectedStatus: http.StatusBadRequest,
 
 			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
 				mockService := mocks.NewMockStoreService(ctrl)
 				mockService.EXPECT().
-					GetUserInfo(gomock.Any()).
+					GetUserInfo(gomock.Any(), false).
 					Return(domain.UserInfo{}, status.Error(codes.NotFound, "user not found"))
 
 				return mockService
@@ -78,12 +116,13 @@ func TestStoreHandler_GetInfo(t *testing.T) {
 		},
 		{
 			name:           "internal_server_error",
+			target:         "/",
 			expectedStatus: http.StatusInternalServerError,
 
 			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
 				mockService := mocks.NewMockStoreService(ctrl)
 				mockService.EXPECT().
-					GetUserInfo(gomock.Any()).
+					GetUserInfo(gomock.Any(), false).
 					Return(domain.UserInfo{}, status.Error(codes.Internal, "database error"))
 
 				return mockService
@@ -91,12 +130,13 @@ func TestStoreHandler_GetInfo(t *testing.T) {
 		},
 		{
 			name:           "non_grpc_error",
+			target:         "/",
 			expectedStatus: http.StatusInternalServerError,
 
 			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
 				mockService := mocks.NewMockStoreService(ctrl)
 				mockService.EXPECT().
-					GetUserInfo(gomock.Any()).
+					GetUserInfo(gomock.Any(), false).
 					Return(domain.UserInfo{}, assert.AnError)
 
 				return mockService
@@ -117,7 +157,7 @@ func TestStoreHandler_GetInfo(t *testing.T) {
 
 			writer := httptest.NewRecorder()
 			c, _ := gin.CreateTestContext(writer)
-			c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+			c.Request = httptest.NewRequest(http.MethodGet, tt.target, nil)
 
 			handler.GetInfo(c)
 
@@ -231,6 +271,38 @@ func TestStoreHandler_SendCoin(t *testing.T) {
 			},
 			expectedStatus: http.StatusBadRequest,
 		},
+		{
+			name: "permission_denied_error",
+			requestBody: sendCoinRequestBody{
+				ToUsername: "recipient",
+				Amount:     50,
+			},
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					SendCoins(gomock.Any(), "recipient", uint32(50)).
+					Return(status.Error(codes.PermissionDenied, "account is deactivated"))
+
+				return mockService
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name: "limit_exceeded_error",
+			requestBody: sendCoinRequestBody{
+				ToUsername: "recipient",
+				Amount:     50,
+			},
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					SendCoins(gomock.Any(), "recipient", uint32(50)).
+					Return(status.Error(codes.ResourceExhausted, "limit of 20 transfers per hour exceeded"))
+
+				return mockService
+			},
+			expectedStatus: http.StatusTooManyRequests,
+		},
 		{
 			name: "internal_server_error",
 			requestBody: sendCoinRequestBody{
@@ -299,6 +371,7 @@ func TestStoreHandler_BuyItem(t *testing.T) {
 	type testCase struct {
 		name           string
 		itemName       string
+		query          string
 		expectedStatus int
 
 		prepareFn       func(t *testing.T, ctrl *gomock.Controller) domain.StoreService
@@ -314,13 +387,43 @@ func TestStoreHandler_BuyItem(t *testing.T) {
 			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
 				mockService := mocks.NewMockStoreService(ctrl)
 				mockService.EXPECT().
-					BuyItem(gomock.Any(), "t-shirt").
+					BuyItem(gomock.Any(), "t-shirt", "", "").
 					Return(nil).
 					Times(1)
 
 				return mockService
 			},
 		},
+		{
+			name:           "with promo code",
+			itemName:       "t-shirt",
+			query:          "?promoCode=SPRING10",
+			expectedStatus: http.StatusOK,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					BuyItem(gomock.Any(), "t-shirt", "", "SPRING10").
+					Return(nil)
+
+				return mockService
+			},
+		},
+		{
+			name:           "with variant",
+			itemName:       "t-shirt",
+			query:          "?variant=t-shirt-m",
+			expectedStatus: http.StatusOK,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					BuyItem(gomock.Any(), "t-shirt", "t-shirt-m", "").
+					Return(nil)
+
+				return mockService
+			},
+		},
 		{
 			name:           "invalid_argument_error",
 			itemName:       "invalid-item",
@@ -329,7 +432,7 @@ func TestStoreHandler_BuyItem(t *testing.T) {
 			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
 				mockService := mocks.NewMockStoreService(ctrl)
 				mockService.EXPECT().
-					BuyItem(gomock.Any(), "invalid-item").
+					BuyItem(gomock.Any(), "invalid-item", "", "").
 					Return(status.Error(codes.InvalidArgument, "invalid item"))
 
 				return mockService
@@ -343,7 +446,7 @@ func TestStoreHandler_BuyItem(t *testing.T) {
 			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
 				mockService := mocks.NewMockStoreService(ctrl)
 				mockService.EXPECT().
-					BuyItem(gomock.Any(), "unknown-item").
+					BuyItem(gomock.Any(), "unknown-item", "", "").
 					Return(status.Error(codes.NotFound, "item not found"))
 
 				return mockService
@@ -357,7 +460,7 @@ func TestStoreHandler_BuyItem(t *testing.T) {
 			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
 				mockService := mocks.NewMockStoreService(ctrl)
 				mockService.EXPECT().
-					BuyItem(gomock.Any(), "expensive-item").
+					BuyItem(gomock.Any(), "expensive-item", "", "").
 					Return(status.Error(codes.FailedPrecondition, "insufficient funds"))
 
 				return mockService
@@ -371,7 +474,7 @@ func TestStoreHandler_BuyItem(t *testing.T) {
 			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
 				mockService := mocks.NewMockStoreService(ctrl)
 				mockService.EXPECT().
-					BuyItem(gomock.Any(), "t-shirt").
+					BuyItem(gomock.Any(), "t-shirt", "", "").
 					Return(status.Error(codes.Internal, "database error"))
 
 				return mockService
@@ -385,7 +488,7 @@ func TestStoreHandler_BuyItem(t *testing.T) {
 			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
 				mockService := mocks.NewMockStoreService(ctrl)
 				mockService.EXPECT().
-					BuyItem(gomock.Any(), "t-shirt").
+					BuyItem(gomock.Any(), "t-shirt", "", "").
 					Return(assert.AnError)
 
 				return mockService
@@ -406,7 +509,7 @@ func TestStoreHandler_BuyItem(t *testing.T) {
 
 			writer := httptest.NewRecorder()
 			c, _ := gin.CreateTestContext(writer)
-			c.Request = httptest.NewRequest(http.MethodGet, "/buy/"+tt.itemName, nil)
+			c.Request = httptest.NewRequest(http.MethodGet, "/buy/"+tt.itemName+tt.query, nil)
 			c.Params = gin.Params{{Key: ItemNameKey, Value: tt.itemName}}
 
 			handler.BuyItem(c)
@@ -418,3 +521,1691 @@ func TestStoreHandler_BuyItem(t *testing.T) {
 		})
 	}
 }
+
+func TestStoreHandler_GiftItem(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name           string
+		itemName       string
+		requestBody    interface{}
+		expectedStatus int
+
+		prepareFn func(t *testing.T, ctrl *gomock.Controller) domain.StoreService
+	}
+
+	tests := []testCase{
+		{
+			name:     "successful gift",
+			itemName: "cup",
+			requestBody: giftItemRequestBody{
+				ToUsername: "bob",
+				Message:    "happy birthday",
+			},
+			expectedStatus: http.StatusOK,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					GiftItem(gomock.Any(), "cup", "", "bob", "happy birthday").
+					Return(nil).
+					Times(1)
+
+				return mockService
+			},
+		},
+		{
+			name:     "variant gift",
+			itemName: "hoody",
+			requestBody: giftItemRequestBody{
+				ToUsername: "bob",
+				Variant:    "hoody-m",
+			},
+			expectedStatus: http.StatusOK,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					GiftItem(gomock.Any(), "hoody", "hoody-m", "bob", "").
+					Return(nil)
+
+				return mockService
+			},
+		},
+		{
+			name:     "missing recipient",
+			itemName: "cup",
+			requestBody: map[string]interface{}{
+				"message": "happy birthday",
+			},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				return mocks.NewMockStoreService(ctrl)
+			},
+		},
+		{
+			name:     "gift to self",
+			itemName: "cup",
+			requestBody: giftItemRequestBody{
+				ToUsername: "alice",
+			},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					GiftItem(gomock.Any(), "cup", "", "alice", "").
+					Return(status.Error(codes.InvalidArgument, "cannot gift an item to yourself"))
+
+				return mockService
+			},
+		},
+		{
+			name:     "insufficient balance",
+			itemName: "hoody",
+			requestBody: giftItemRequestBody{
+				ToUsername: "bob",
+			},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					GiftItem(gomock.Any(), "hoody", "", "bob", "").
+					Return(status.Error(codes.FailedPrecondition, "insufficient balance"))
+
+				return mockService
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockService := tt.prepareFn(t, ctrl)
+			handler := NewStoreHandler(mockService)
+
+			writer := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(writer)
+
+			bodyBytes, _ := json.Marshal(tt.requestBody)
+			c.Request = httptest.NewRequest(http.MethodPost, "/gift/"+tt.itemName, bytes.NewReader(bodyBytes))
+			c.Request.Header.Set("Content-Type", "application/json")
+			c.Params = gin.Params{{Key: ItemNameKey, Value: tt.itemName}}
+
+			handler.GiftItem(c)
+
+			assert.Equal(t, tt.expectedStatus, writer.Code)
+		})
+	}
+}
+
+func TestStoreHandler_TransferItem(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name           string
+		itemName       string
+		requestBody    interface{}
+		expectedStatus int
+
+		prepareFn func(t *testing.T, ctrl *gomock.Controller) domain.StoreService
+	}
+
+	tests := []testCase{
+		{
+			name:     "successful item transfer",
+			itemName: "cup",
+			requestBody: transferItemRequestBody{
+				ToUsername: "bob",
+			},
+			expectedStatus: http.StatusOK,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					TransferItem(gomock.Any(), "cup", "", "bob").
+					Return(nil).
+					Times(1)
+
+				return mockService
+			},
+		},
+		{
+			name:     "variant transfer",
+			itemName: "hoody",
+			requestBody: transferItemRequestBody{
+				ToUsername: "bob",
+				Variant:    "hoody-m",
+			},
+			expectedStatus: http.StatusOK,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					TransferItem(gomock.Any(), "hoody", "hoody-m", "bob").
+					Return(nil)
+
+				return mockService
+			},
+		},
+		{
+			name:           "missing recipient",
+			itemName:       "cup",
+			requestBody:    map[string]interface{}{},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				return mocks.NewMockStoreService(ctrl)
+			},
+		},
+		{
+			name:     "item not in inventory",
+			itemName: "hoody",
+			requestBody: transferItemRequestBody{
+				ToUsername: "bob",
+			},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					TransferItem(gomock.Any(), "hoody", "", "bob").
+					Return(status.Error(codes.FailedPrecondition, "item is not in your inventory"))
+
+				return mockService
+			},
+		},
+		{
+			name:     "account frozen",
+			itemName: "cup",
+			requestBody: transferItemRequestBody{
+				ToUsername: "bob",
+			},
+			expectedStatus: http.StatusForbidden,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					TransferItem(gomock.Any(), "cup", "", "bob").
+					Return(status.Error(codes.PermissionDenied, "account is frozen"))
+
+				return mockService
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockService := tt.prepareFn(t, ctrl)
+			handler := NewStoreHandler(mockService)
+
+			writer := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(writer)
+
+			bodyBytes, _ := json.Marshal(tt.requestBody)
+			c.Request = httptest.NewRequest(http.MethodPost, "/transferItem/"+tt.itemName, bytes.NewReader(bodyBytes))
+			c.Request.Header.Set("Content-Type", "application/json")
+			c.Params = gin.Params{{Key: ItemNameKey, Value: tt.itemName}}
+
+			handler.TransferItem(c)
+
+			assert.Equal(t, tt.expectedStatus, writer.Code)
+		})
+	}
+}
+
+func TestStoreHandler_SendFromTeamBudget(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name           string
+		teamName       string
+		requestBody    interface{}
+		expectedStatus int
+
+		prepareFn func(t *testing.T, ctrl *gomock.Controller) domain.StoreService
+	}
+
+	tests := []testCase{
+		{
+			name:     "successful team budget transfer",
+			teamName: "platform",
+			requestBody: sendFromTeamBudgetRequestBody{
+				ToUsername: "report",
+				Amount:     100,
+			},
+			expectedStatus: http.StatusOK,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					SendFromTeamBudget(gomock.Any(), "platform", "report", uint32(100)).
+					Return(nil).
+					Times(1)
+
+				return mockService
+			},
+		},
+		{
+			name:     "invalid_amount_zero",
+			teamName: "platform",
+			requestBody: map[string]interface{}{
+				"toUser": "report",
+				"amount": 0,
+			},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				return mocks.NewMockStoreService(ctrl)
+			},
+		},
+		{
+			name:     "not_team_manager",
+			teamName: "platform",
+			requestBody: sendFromTeamBudgetRequestBody{
+				ToUsername: "report",
+				Amount:     100,
+			},
+			expectedStatus: http.StatusForbidden,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					SendFromTeamBudget(gomock.Any(), "platform", "report", uint32(100)).
+					Return(status.Error(codes.PermissionDenied, "only the team manager can spend the team budget"))
+
+				return mockService
+			},
+		},
+		{
+			name:     "insufficient_team_budget",
+			teamName: "platform",
+			requestBody: sendFromTeamBudgetRequestBody{
+				ToUsername: "report",
+				Amount:     100,
+			},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					SendFromTeamBudget(gomock.Any(), "platform", "report", uint32(100)).
+					Return(status.Error(codes.FailedPrecondition, "insufficient team budget"))
+
+				return mockService
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockService := tt.prepareFn(t, ctrl)
+			handler := NewStoreHandler(mockService)
+
+			writer := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(writer)
+
+			bodyBytes, _ := json.Marshal(tt.requestBody)
+			c.Request = httptest.NewRequest(http.MethodPost, "/teams/"+tt.teamName+"/send", bytes.NewReader(bodyBytes))
+			c.Request.Header.Set("Content-Type", "application/json")
+			c.Params = gin.Params{{Key: TeamNameKey, Value: tt.teamName}}
+
+			handler.SendFromTeamBudget(c)
+
+			assert.Equal(t, tt.expectedStatus, writer.Code)
+		})
+	}
+}
+
+func TestStoreHandler_CreatePaymentRequest(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name           string
+		requestBody    interface{}
+		expectedStatus int
+
+		prepareFn func(t *testing.T, ctrl *gomock.Controller) domain.StoreService
+	}
+
+	tests := []testCase{
+		{
+			name: "successful payment request",
+			requestBody: createPaymentRequestRequestBody{
+				FromUsername: "bob",
+				Amount:       50,
+				Note:         "lunch",
+			},
+			expectedStatus: http.StatusOK,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					CreatePaymentRequest(gomock.Any(), "bob", uint32(50), "lunch", uint32(0)).
+					Return(7, nil).
+					Times(1)
+
+				return mockService
+			},
+		},
+		{
+			name: "missing_payer",
+			requestBody: map[string]interface{}{
+				"amount": 50,
+			},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				return mocks.NewMockStoreService(ctrl)
+			},
+		},
+		{
+			name: "payer_not_found",
+			requestBody: createPaymentRequestRequestBody{
+				FromUsername: "ghost",
+				Amount:       50,
+			},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					CreatePaymentRequest(gomock.Any(), "ghost", uint32(50), "", uint32(0)).
+					Return(0, status.Error(codes.NotFound, "user not found"))
+
+				return mockService
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockService := tt.prepareFn(t, ctrl)
+			handler := NewStoreHandler(mockService)
+
+			writer := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(writer)
+
+			bodyBytes, _ := json.Marshal(tt.requestBody)
+			c.Request = httptest.NewRequest(http.MethodPost, "/payment-requests", bytes.NewReader(bodyBytes))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			handler.CreatePaymentRequest(c)
+
+			assert.Equal(t, tt.expectedStatus, writer.Code)
+		})
+	}
+}
+
+func TestStoreHandler_AcceptPaymentRequest(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name           string
+		requestID      string
+		expectedStatus int
+
+		prepareFn func(t *testing.T, ctrl *gomock.Controller) domain.StoreService
+	}
+
+	tests := []testCase{
+		{
+			name:           "successful accept",
+			requestID:      "7",
+			expectedStatus: http.StatusOK,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					AcceptPaymentRequest(gomock.Any(), 7).
+					Return(nil).
+					Times(1)
+
+				return mockService
+			},
+		},
+		{
+			name:           "invalid_request_id",
+			requestID:      "abc",
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				return mocks.NewMockStoreService(ctrl)
+			},
+		},
+		{
+			name:           "request_already_closed",
+			requestID:      "7",
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					AcceptPaymentRequest(gomock.Any(), 7).
+					Return(status.Error(codes.FailedPrecondition, "payment request is no longer pending"))
+
+				return mockService
+			},
+		},
+		{
+			name:           "insufficient_balance",
+			requestID:      "7",
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					AcceptPaymentRequest(gomock.Any(), 7).
+					Return(status.Error(codes.FailedPrecondition, "insufficient balance"))
+
+				return mockService
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockService := tt.prepareFn(t, ctrl)
+			handler := NewStoreHandler(mockService)
+
+			writer := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(writer)
+
+			c.Request = httptest.NewRequest(http.MethodPost, "/payment-requests/"+tt.requestID+"/accept", nil)
+			c.Params = gin.Params{{Key: PaymentRequestIDKey, Value: tt.requestID}}
+
+			handler.AcceptPaymentRequest(c)
+
+			assert.Equal(t, tt.expectedStatus, writer.Code)
+		})
+	}
+}
+
+func TestStoreHandler_ScheduleTransfer(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name           string
+		requestBody    interface{}
+		expectedStatus int
+
+		prepareFn func(t *testing.T, ctrl *gomock.Controller) domain.StoreService
+	}
+
+	tests := []testCase{
+		{
+			name: "successful weekly schedule",
+			requestBody: scheduleTransferRequestBody{
+				ToUsername: "bob",
+				Amount:     20,
+				StartAt:    "2026-05-04T09:00:00Z",
+				Recurrence: "weekly",
+			},
+			expectedStatus: http.StatusOK,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					ScheduleTransfer(gomock.Any(), "bob", uint32(20), "2026-05-04T09:00:00Z", "weekly").
+					Return(3, nil).
+					Times(1)
+
+				return mockService
+			},
+		},
+		{
+			name: "invalid_amount_zero",
+			requestBody: map[string]interface{}{
+				"toUser": "bob",
+				"amount": 0,
+			},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				return mocks.NewMockStoreService(ctrl)
+			},
+		},
+		{
+			name: "too_many_schedules",
+			requestBody: scheduleTransferRequestBody{
+				ToUsername: "bob",
+				Amount:     20,
+			},
+			expectedStatus: http.StatusTooManyRequests,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					ScheduleTransfer(gomock.Any(), "bob", uint32(20), "", "").
+					Return(0, status.Error(codes.ResourceExhausted, "at most 20 active scheduled transfers are allowed"))
+
+				return mockService
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockService := tt.prepareFn(t, ctrl)
+			handler := NewStoreHandler(mockService)
+
+			writer := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(writer)
+
+			bodyBytes, _ := json.Marshal(tt.requestBody)
+			c.Request = httptest.NewRequest(http.MethodPost, "/scheduled-transfers", bytes.NewReader(bodyBytes))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			handler.ScheduleTransfer(c)
+
+			assert.Equal(t, tt.expectedStatus, writer.Code)
+		})
+	}
+}
+
+func TestStoreHandler_CancelScheduledTransfer(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name           string
+		scheduleID     string
+		expectedStatus int
+
+		prepareFn func(t *testing.T, ctrl *gomock.Controller) domain.StoreService
+	}
+
+	tests := []testCase{
+		{
+			name:           "successful cancel",
+			scheduleID:     "3",
+			expectedStatus: http.StatusOK,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					CancelScheduledTransfer(gomock.Any(), 3).
+					Return(nil).
+					Times(1)
+
+				return mockService
+			},
+		},
+		{
+			name:           "invalid_schedule_id",
+			scheduleID:     "abc",
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				return mocks.NewMockStoreService(ctrl)
+			},
+		},
+		{
+			name:           "schedule_not_found",
+			scheduleID:     "42",
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					CancelScheduledTransfer(gomock.Any(), 42).
+					Return(status.Error(codes.NotFound, "scheduled transfer not found"))
+
+				return mockService
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockService := tt.prepareFn(t, ctrl)
+			handler := NewStoreHandler(mockService)
+
+			writer := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(writer)
+
+			c.Request = httptest.NewRequest(http.MethodDelete, "/scheduled-transfers/"+tt.scheduleID, nil)
+			c.Params = gin.Params{{Key: ScheduledTransferIDKey, Value: tt.scheduleID}}
+
+			handler.CancelScheduledTransfer(c)
+
+			assert.Equal(t, tt.expectedStatus, writer.Code)
+		})
+	}
+}
+
+func TestStoreHandler_SendCoinsBatch(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name           string
+		requestBody    interface{}
+		expectedStatus int
+
+		prepareFn func(t *testing.T, ctrl *gomock.Controller) domain.StoreService
+	}
+
+	tests := []testCase{
+		{
+			name: "successful batch",
+			requestBody: sendCoinsBatchRequestBody{
+				Transfers: []sendCoinRequestBody{
+					{ToUsername: "bob", Amount: 50},
+					{ToUsername: "carol", Amount: 30},
+				},
+			},
+			expectedStatus: http.StatusOK,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					SendCoinsBatch(gomock.Any(), []domain.SentTransfer{{To: "bob", Amount: 50}, {To: "carol", Amount: 30}}).
+					Return(nil).
+					Times(1)
+
+				return mockService
+			},
+		},
+		{
+			name:           "empty_batch",
+			requestBody:    map[string]interface{}{"transfers": []interface{}{}},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				return mocks.NewMockStoreService(ctrl)
+			},
+		},
+		{
+			name: "invalid_amount_in_batch",
+			requestBody: map[string]interface{}{
+				"transfers": []map[string]interface{}{
+					{"toUser": "bob", "amount": 50},
+					{"toUser": "carol", "amount": 0},
+				},
+			},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				return mocks.NewMockStoreService(ctrl)
+			},
+		},
+		{
+			name: "insufficient_funds",
+			requestBody: sendCoinsBatchRequestBody{
+				Transfers: []sendCoinRequestBody{{ToUsername: "bob", Amount: 5000}},
+			},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					SendCoinsBatch(gomock.Any(), []domain.SentTransfer{{To: "bob", Amount: 5000}}).
+					Return(status.Error(codes.FailedPrecondition, "insufficient funds"))
+
+				return mockService
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockService := tt.prepareFn(t, ctrl)
+			handler := NewStoreHandler(mockService)
+
+			writer := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(writer)
+
+			bodyBytes, _ := json.Marshal(tt.requestBody)
+			c.Request = httptest.NewRequest(http.MethodPost, "/sendCoinBatch", bytes.NewReader(bodyBytes))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			handler.SendCoinsBatch(c)
+
+			assert.Equal(t, tt.expectedStatus, writer.Code)
+		})
+	}
+}
+
+func TestStoreHandler_CreateListing(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name           string
+		requestBody    interface{}
+		expectedStatus int
+
+		prepareFn func(t *testing.T, ctrl *gomock.Controller) domain.StoreService
+	}
+
+	tests := []testCase{
+		{
+			name: "successful listing",
+			requestBody: createListingRequestBody{
+				ItemName: "cup",
+				Price:    15,
+			},
+			expectedStatus: http.StatusOK,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					CreateListing(gomock.Any(), "cup", "", uint32(15)).
+					Return(7, nil).
+					Times(1)
+
+				return mockService
+			},
+		},
+		{
+			name: "variant listing",
+			requestBody: createListingRequestBody{
+				ItemName: "hoody",
+				Variant:  "hoody-m",
+				Price:    40,
+			},
+			expectedStatus: http.StatusOK,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					CreateListing(gomock.Any(), "hoody", "hoody-m", uint32(40)).
+					Return(8, nil)
+
+				return mockService
+			},
+		},
+		{
+			name: "invalid_price_zero",
+			requestBody: map[string]interface{}{
+				"type":  "cup",
+				"price": 0,
+			},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				return mocks.NewMockStoreService(ctrl)
+			},
+		},
+		{
+			name: "item_not_owned",
+			requestBody: createListingRequestBody{
+				ItemName: "cup",
+				Price:    15,
+			},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					CreateListing(gomock.Any(), "cup", "", uint32(15)).
+					Return(0, status.Error(codes.FailedPrecondition, "no unlisted item of this type in your inventory"))
+
+				return mockService
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockService := tt.prepareFn(t, ctrl)
+			handler := NewStoreHandler(mockService)
+
+			writer := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(writer)
+
+			bodyBytes, _ := json.Marshal(tt.requestBody)
+			c.Request = httptest.NewRequest(http.MethodPost, "/marketplace/listings", bytes.NewReader(bodyBytes))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			handler.CreateListing(c)
+
+			assert.Equal(t, tt.expectedStatus, writer.Code)
+		})
+	}
+}
+
+func TestStoreHandler_SearchListings(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name           string
+		query          string
+		expectedStatus int
+
+		prepareFn func(t *testing.T, ctrl *gomock.Controller) domain.StoreService
+	}
+
+	tests := []testCase{
+		{
+			name:           "successful search",
+			query:          "?query=cup&seller=alice&maxPrice=50&limit=10&offset=20",
+			expectedStatus: http.StatusOK,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					SearchListings(gomock.Any(), domain.ListingFilter{
+						Query:    "cup",
+						Seller:   "alice",
+						MaxPrice: 50,
+						Limit:    10,
+						Offset:   20,
+					}).
+					Return([]domain.Listing{{Id: 7, Item: "cup", Seller: "alice", Price: 15}}, nil).
+					Times(1)
+
+				return mockService
+			},
+		},
+		{
+			name:           "invalid_max_price",
+			query:          "?maxPrice=cheap",
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				return mocks.NewMockStoreService(ctrl)
+			},
+		},
+		{
+			name:           "unknown_seller",
+			query:          "?seller=ghost",
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					SearchListings(gomock.Any(), domain.ListingFilter{Seller: "ghost"}).
+					Return(nil, status.Error(codes.NotFound, "user not found"))
+
+				return mockService
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockService := tt.prepareFn(t, ctrl)
+			handler := NewStoreHandler(mockService)
+
+			writer := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(writer)
+
+			c.Request = httptest.NewRequest(http.MethodGet, "/marketplace/listings"+tt.query, nil)
+
+			handler.SearchListings(c)
+
+			assert.Equal(t, tt.expectedStatus, writer.Code)
+		})
+	}
+}
+
+func TestStoreHandler_UpdateListing(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name           string
+		listingID      string
+		requestBody    interface{}
+		expectedStatus int
+
+		prepareFn func(t *testing.T, ctrl *gomock.Controller) domain.StoreService
+	}
+
+	tests := []testCase{
+		{
+			name:           "successful update",
+			listingID:      "7",
+			requestBody:    updateListingRequestBody{Price: 25},
+			expectedStatus: http.StatusOK,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					UpdateListing(gomock.Any(), 7, uint32(25)).
+					Return(nil).
+					Times(1)
+
+				return mockService
+			},
+		},
+		{
+			name:           "invalid_listing_id",
+			listingID:      "abc",
+			requestBody:    updateListingRequestBody{Price: 25},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				return mocks.NewMockStoreService(ctrl)
+			},
+		},
+		{
+			name:           "missing_price",
+			listingID:      "7",
+			requestBody:    map[string]interface{}{},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				return mocks.NewMockStoreService(ctrl)
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockService := tt.prepareFn(t, ctrl)
+			handler := NewStoreHandler(mockService)
+
+			writer := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(writer)
+
+			bodyBytes, _ := json.Marshal(tt.requestBody)
+			c.Request = httptest.NewRequest(http.MethodPatch, "/marketplace/listings/"+tt.listingID, bytes.NewReader(bodyBytes))
+			c.Request.Header.Set("Content-Type", "application/json")
+			c.Params = gin.Params{{Key: ListingIDKey, Value: tt.listingID}}
+
+			handler.UpdateListing(c)
+
+			assert.Equal(t, tt.expectedStatus, writer.Code)
+		})
+	}
+}
+
+func TestStoreHandler_BuyListing(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name           string
+		listingID      string
+		expectedStatus int
+
+		prepareFn func(t *testing.T, ctrl *gomock.Controller) domain.StoreService
+	}
+
+	tests := []testCase{
+		{
+			name:           "successful buy",
+			listingID:      "7",
+			expectedStatus: http.StatusOK,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					BuyListing(gomock.Any(), 7).
+					Return(nil).
+					Times(1)
+
+				return mockService
+			},
+		},
+		{
+			name:           "invalid_listing_id",
+			listingID:      "abc",
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				return mocks.NewMockStoreService(ctrl)
+			},
+		},
+		{
+			name:           "listing_already_sold",
+			listingID:      "7",
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					BuyListing(gomock.Any(), 7).
+					Return(status.Error(codes.FailedPrecondition, "listing is already sold"))
+
+				return mockService
+			},
+		},
+		{
+			name:           "transfer_limit_exceeded",
+			listingID:      "7",
+			expectedStatus: http.StatusTooManyRequests,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					BuyListing(gomock.Any(), 7).
+					Return(status.Error(codes.ResourceExhausted, "daily outgoing cap exceeded"))
+
+				return mockService
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockService := tt.prepareFn(t, ctrl)
+			handler := NewStoreHandler(mockService)
+
+			writer := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(writer)
+
+			c.Request = httptest.NewRequest(http.MethodPost, "/marketplace/listings/"+tt.listingID+"/buy", nil)
+			c.Params = gin.Params{{Key: ListingIDKey, Value: tt.listingID}}
+
+			handler.BuyListing(c)
+
+			assert.Equal(t, tt.expectedStatus, writer.Code)
+		})
+	}
+}
+
+func TestStoreHandler_PlaceBid(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name           string
+		auctionID      string
+		requestBody    interface{}
+		expectedStatus int
+
+		prepareFn func(t *testing.T, ctrl *gomock.Controller) domain.StoreService
+	}
+
+	tests := []testCase{
+		{
+			name:           "successful bid",
+			auctionID:      "4",
+			requestBody:    placeBidRequestBody{Amount: 150},
+			expectedStatus: http.StatusOK,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					PlaceBid(gomock.Any(), 4, uint32(150)).
+					Return(nil).
+					Times(1)
+
+				return mockService
+			},
+		},
+		{
+			name:           "invalid_auction_id",
+			auctionID:      "abc",
+			requestBody:    placeBidRequestBody{Amount: 150},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				return mocks.NewMockStoreService(ctrl)
+			},
+		},
+		{
+			name:           "invalid_amount_zero",
+			auctionID:      "4",
+			requestBody:    map[string]interface{}{"amount": 0},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				return mocks.NewMockStoreService(ctrl)
+			},
+		},
+		{
+			name:           "auction_closed",
+			auctionID:      "4",
+			requestBody:    placeBidRequestBody{Amount: 150},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					PlaceBid(gomock.Any(), 4, uint32(150)).
+					Return(status.Error(codes.FailedPrecondition, "auction 4 is not accepting bids"))
+
+				return mockService
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockService := tt.prepareFn(t, ctrl)
+			handler := NewStoreHandler(mockService)
+
+			writer := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(writer)
+
+			bodyBytes, _ := json.Marshal(tt.requestBody)
+			c.Request = httptest.NewRequest(http.MethodPost, "/auctions/"+tt.auctionID+"/bids", bytes.NewReader(bodyBytes))
+			c.Request.Header.Set("Content-Type", "application/json")
+			c.Params = gin.Params{{Key: AuctionIDKey, Value: tt.auctionID}}
+
+			handler.PlaceBid(c)
+
+			assert.Equal(t, tt.expectedStatus, writer.Code)
+		})
+	}
+}
+
+func TestStoreHandler_BuyRaffleTickets(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name           string
+		raffleID       string
+		requestBody    interface{}
+		expectedStatus int
+
+		prepareFn func(t *testing.T, ctrl *gomock.Controller) domain.StoreService
+	}
+
+	tests := []testCase{
+		{
+			name:           "successful purchase",
+			raffleID:       "6",
+			requestBody:    buyRaffleTicketsRequestBody{Count: 3},
+			expectedStatus: http.StatusOK,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					BuyRaffleTickets(gomock.Any(), 6, uint32(3)).
+					Return(nil).
+					Times(1)
+
+				return mockService
+			},
+		},
+		{
+			name:           "invalid_raffle_id",
+			raffleID:       "abc",
+			requestBody:    buyRaffleTicketsRequestBody{Count: 3},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				return mocks.NewMockStoreService(ctrl)
+			},
+		},
+		{
+			name:           "invalid_count_zero",
+			raffleID:       "6",
+			requestBody:    map[string]interface{}{"count": 0},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				return mocks.NewMockStoreService(ctrl)
+			},
+		},
+		{
+			name:           "raffle_not_found",
+			raffleID:       "42",
+			requestBody:    buyRaffleTicketsRequestBody{Count: 1},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					BuyRaffleTickets(gomock.Any(), 42, uint32(1)).
+					Return(status.Error(codes.NotFound, "raffle not found"))
+
+				return mockService
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockService := tt.prepareFn(t, ctrl)
+			handler := NewStoreHandler(mockService)
+
+			writer := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(writer)
+
+			bodyBytes, _ := json.Marshal(tt.requestBody)
+			c.Request = httptest.NewRequest(http.MethodPost, "/raffles/"+tt.raffleID+"/tickets", bytes.NewReader(bodyBytes))
+			c.Request.Header.Set("Content-Type", "application/json")
+			c.Params = gin.Params{{Key: RaffleIDKey, Value: tt.raffleID}}
+
+			handler.BuyRaffleTickets(c)
+
+			assert.Equal(t, tt.expectedStatus, writer.Code)
+		})
+	}
+}
+
+func TestStoreHandler_GetPriceHistory(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name           string
+		itemName       string
+		expectedStatus int
+
+		prepareFn       func(t *testing.T, ctrl *gomock.Controller) domain.StoreService
+		checkResponseFn func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}
+
+	history := domain.PriceHistory{
+		BasePrice: 300,
+		Changes: []domain.PriceChange{
+			{Price: 210, StartsAt: "2026-12-20T00:00:00Z", EndsAt: "2027-01-01T00:00:00Z"},
+		},
+	}
+
+	tests := []testCase{
+		{
+			name:           "successful price history",
+			itemName:       "hoody",
+			expectedStatus: http.StatusOK,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					GetPriceHistory(gomock.Any(), "hoody").
+					Return(history, nil).
+					Times(1)
+
+				return mockService
+			},
+			checkResponseFn: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				var response domain.PriceHistory
+				err := json.Unmarshal(recorder.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, history, response)
+			},
+		},
+		{
+			name:           "unknown_item",
+			itemName:       "yacht",
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					GetPriceHistory(gomock.Any(), "yacht").
+					Return(domain.PriceHistory{}, status.Error(codes.NotFound, "good not found"))
+
+				return mockService
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockService := tt.prepareFn(t, ctrl)
+			handler := NewStoreHandler(mockService)
+
+			writer := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(writer)
+
+			c.Request = httptest.NewRequest(http.MethodGet, "/catalog/"+tt.itemName+"/prices", nil)
+			c.Params = gin.Params{{Key: ItemNameKey, Value: tt.itemName}}
+
+			handler.GetPriceHistory(c)
+
+			assert.Equal(t, tt.expectedStatus, writer.Code)
+			if tt.checkResponseFn != nil {
+				tt.checkResponseFn(t, writer)
+			}
+		})
+	}
+}
+
+func TestStoreHandler_PlacePreorder(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name           string
+		requestBody    interface{}
+		expectedStatus int
+
+		prepareFn func(t *testing.T, ctrl *gomock.Controller) domain.StoreService
+	}
+
+	tests := []testCase{
+		{
+			name:           "successful preorder",
+			requestBody:    placePreorderRequestBody{ItemName: "umbrella", Variant: "umbrella-red"},
+			expectedStatus: http.StatusOK,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					PlacePreorder(gomock.Any(), "umbrella", "umbrella-red").
+					Return(3, nil).
+					Times(1)
+
+				return mockService
+			},
+		},
+		{
+			name:           "missing_item",
+			requestBody:    map[string]interface{}{"variant": "umbrella-red"},
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				return mocks.NewMockStoreService(ctrl)
+			},
+		},
+		{
+			name:           "already_preordered",
+			requestBody:    placePreorderRequestBody{ItemName: "umbrella"},
+			expectedStatus: http.StatusConflict,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					PlacePreorder(gomock.Any(), "umbrella", "").
+					Return(0, status.Error(codes.AlreadyExists, "item is already pre-ordered"))
+
+				return mockService
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockService := tt.prepareFn(t, ctrl)
+			handler := NewStoreHandler(mockService)
+
+			writer := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(writer)
+
+			bodyBytes, _ := json.Marshal(tt.requestBody)
+			c.Request = httptest.NewRequest(http.MethodPost, "/preorders", bytes.NewReader(bodyBytes))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			handler.PlacePreorder(c)
+
+			assert.Equal(t, tt.expectedStatus, writer.Code)
+		})
+	}
+}
+
+func TestStoreHandler_CancelPreorder(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name           string
+		preorderID     string
+		expectedStatus int
+
+		prepareFn func(t *testing.T, ctrl *gomock.Controller) domain.StoreService
+	}
+
+	tests := []testCase{
+		{
+			name:           "successful cancel",
+			preorderID:     "3",
+			expectedStatus: http.StatusOK,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					CancelPreorder(gomock.Any(), 3).
+					Return(nil).
+					Times(1)
+
+				return mockService
+			},
+		},
+		{
+			name:           "invalid_preorder_id",
+			preorderID:     "abc",
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				return mocks.NewMockStoreService(ctrl)
+			},
+		},
+		{
+			name:           "preorder_fulfilled",
+			preorderID:     "3",
+			expectedStatus: http.StatusBadRequest,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().
+					CancelPreorder(gomock.Any(), 3).
+					Return(status.Error(codes.FailedPrecondition, "pre-order 3 is already fulfilled"))
+
+				return mockService
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockService := tt.prepareFn(t, ctrl)
+			handler := NewStoreHandler(mockService)
+
+			writer := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(writer)
+
+			c.Request = httptest.NewRequest(http.MethodDelete, "/preorders/"+tt.preorderID, nil)
+			c.Params = gin.Params{{Key: PreorderIDKey, Value: tt.preorderID}}
+
+			handler.CancelPreorder(c)
+
+			assert.Equal(t, tt.expectedStatus, writer.Code)
+		})
+	}
+}
+
+func TestStoreHandler_SubscribeEvents(t *testing.T) {
+	t.Parallel()
+
+	transfer := domain.UserEvent{
+		Type:       "transfer",
+		Data:       json.RawMessage(`{"amount":100,"fromUser":"bob"}`),
+		OccurredAt: "2026-08-10T10:00:01Z",
+	}
+
+	type testCase struct {
+		name           string
+		expectedStatus int
+
+		prepareFn       func(t *testing.T, ctrl *gomock.Controller) domain.StoreService
+		checkResponseFn func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}
+
+	tests := []testCase{
+		{
+			name:           "events streamed",
+			expectedStatus: http.StatusOK,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().SubscribeEvents(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, handle func(domain.UserEvent) error) error {
+						return handle(transfer)
+					}).Times(1)
+
+				return mockService
+			},
+			checkResponseFn: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Contains(t, recorder.Header().Get("Content-Type"), "text/event-stream")
+				assert.Equal(t, "event:transfer\n"+
+					`data:{"type":"transfer","data":{"amount":100,"fromUser":"bob"},"occurredAt":"2026-08-10T10:00:01Z"}`+
+					"\n\n", recorder.Body.String())
+			},
+		},
+		{
+			name:           "stream ended after events",
+			expectedStatus: http.StatusOK,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().SubscribeEvents(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, handle func(domain.UserEvent) error) error {
+						_ = handle(transfer)
+						return status.Error(codes.Unavailable, "store is shutting down")
+					}).Times(1)
+
+				return mockService
+			},
+		},
+		{
+			name:           "deactivated_account",
+			expectedStatus: http.StatusForbidden,
+
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) domain.StoreService {
+				mockService := mocks.NewMockStoreService(ctrl)
+				mockService.EXPECT().SubscribeEvents(gomock.Any(), gomock.Any()).
+					Return(status.Error(codes.PermissionDenied, "account is deactivated"))
+
+				return mockService
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockService := tt.prepareFn(t, ctrl)
+			handler := NewStoreHandler(mockService)
+
+			writer := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(writer)
+
+			c.Request = httptest.NewRequest(http.MethodGet, "/events", nil)
+
+			handler.SubscribeEvents(c)
+
+			assert.Equal(t, tt.expectedStatus, writer.Code)
+			if tt.checkResponseFn != nil {
+				tt.checkResponseFn(t, writer)
+			}
+		})
+	}
+}
+
+func TestStoreHandler_SubscribeEvents_ClientGone(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+
+	mockService := mocks.NewMockStoreService(ctrl)
+	mockService.EXPECT().SubscribeEvents(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, _ func(domain.UserEvent) error) error {
+			assert.Equal(t, "token", ctx.Value(jwt.TokenContextKey))
+			<-ctx.Done()
+			return ctx.Err()
+		}).Times(1)
+
+	handler := NewStoreHandler(mockService)
+
+	writer := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(writer)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	c.Request = httptest.NewRequestWithContext(ctx, http.MethodGet, "/events", nil)
+	c.Set(jwt.TokenContextKey, "token")
+
+	handler.SubscribeEvents(c)
+
+	assert.Equal(t, http.StatusInternalServerError, writer.Code)
+}
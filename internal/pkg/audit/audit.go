@@ -0,0 +1,197 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+)
+
+const (
+	ActionLogin             = "login"
+	ActionLoginFailed       = "login-failed"
+	ActionAccountDeactivate = "account-deactivate"
+	ActionAccountFreeze     = "account-freeze"
+	ActionAccountUnfreeze   = "account-unfreeze"
+	ActionTransferLimitsSet = "transfer-limits-set"
+	ActionTeamCreate        = "team-create"
+	ActionTeamMemberAdd     = "team-member-add"
+	ActionTeamTopUpSet      = "team-topup-set"
+	ActionTeamBudgetSend    = "team-budget-send"
+	ActionFraudCaseResolve  = "fraud-case-resolve"
+	ActionFraudCaseFreeze   = "fraud-case-freeze"
+	ActionAuctionCreate     = "auction-create"
+	ActionRaffleCreate      = "raffle-create"
+	ActionRaffleDraw        = "raffle-draw"
+	ActionPromoCodeCreate   = "promo-code-create"
+	ActionPriceSchedule     = "price-schedule"
+	ActionCategoryCreate    = "category-create"
+	ActionGoodUpdate        = "good-update"
+	ActionPurchaseLimitSet  = "purchase-limit-set"
+	ActionGoodAnnounce      = "good-announce"
+	ActionGoodArrive        = "good-arrive"
+	ActionWebhookCreate     = "webhook-create"
+	ActionWebhookDelete     = "webhook-delete"
+	ActionRefund            = "refund"
+	ActionGrant             = "grant"
+)
+
+// Reasons of ActionRefund events.
+const (
+	RefundReasonOutbid        = "outbid"
+	RefundReasonUnsold        = "unsold"
+	RefundReasonPurchaseLimit = "purchase limit"
+	RefundReasonCancelled     = "cancelled"
+	RefundReasonSoldOut       = "sold out"
+	RefundReasonDeactivation  = "deactivation"
+)
+
+const (
+	DefaultListLimit = 100
+	MaxListLimit     = 500
+)
+
+type Recorder interface {
+	Record(ctx context.Context, event Event) error
+	RecordInTx(ctx context.Context, executor database.Executor, event Event) error
+}
+
+type Reader interface {
+	ListEvents(ctx context.Context, filter Filter) ([]Event, error)
+}
+
+// Event is a single audit log entry. Before and After hold the changed values and are stored as JSON.
+type Event struct {
+	ActorID   int
+	Action    string
+	Target    string
+	RequestID string
+	Before    map[string]any
+	After     map[string]any
+	CreatedAt time.Time
+}
+
+// Filter narrows ListEvents down. Zero values disable the corresponding condition.
+type Filter struct {
+	ActorID int
+	Action  string
+	Target  string
+	From    time.Time
+	To      time.Time
+	Limit   int
+}
+
+// EffectiveLimit clamps the requested limit to (0, MaxListLimit], falling back to DefaultListLimit.
+func (f Filter) EffectiveLimit() int {
+	if f.Limit <= 0 {
+		return DefaultListLimit
+	} else if f.Limit > MaxListLimit {
+		return MaxListLimit
+	}
+
+	return f.Limit
+}
+
+// ParseTimeBound parses an RFC 3339 filter bound. An empty string means no bound.
+func ParseTimeBound(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q, expected RFC 3339: %w", value, err)
+	}
+
+	return t, nil
+}
+
+// EncodeValues renders before/after values as JSON for transport, an empty string stands for no values.
+func EncodeValues(values map[string]any) string {
+	if len(values) == 0 {
+		return ""
+	}
+
+	encoded, err := json.Marshal(values)
+	if err != nil {
+		return ""
+	}
+
+	return string(encoded)
+}
+
+// DecodeValues is the inverse of EncodeValues.
+func DecodeValues(encoded string) (map[string]any, error) {
+	if encoded == "" {
+		return nil, nil
+	}
+
+	var values map[string]any
+	err := json.Unmarshal([]byte(encoded), &values)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode audit values: %w", err)
+	}
+
+	return values, nil
+}
+
+// RefundEvent describes coins returned to the user, target names what they were held for.
+func RefundEvent(target string, userID int, amount uint32, reason string) Event {
+	return Event{
+		Action: ActionRefund,
+		Target: target,
+		After:  map[string]any{"userId": userID, "amount": amount, "reason": reason},
+	}
+}
+
+func UserTarget(username string) string {
+	return "user:" + username
+}
+
+func TeamTarget(teamName string) string {
+	return "team:" + teamName
+}
+
+func FraudCaseTarget(caseID int) string {
+	return "fraud-case:" + strconv.Itoa(caseID)
+}
+
+func AuctionTarget(auctionID int) string {
+	return "auction:" + strconv.Itoa(auctionID)
+}
+
+func RaffleTarget(raffleID int) string {
+	return "raffle:" + strconv.Itoa(raffleID)
+}
+
+func PreorderTarget(preorderID int) string {
+	return "preorder:" + strconv.Itoa(preorderID)
+}
+
+func GoodTarget(goodName string) string {
+	return "good:" + goodName
+}
+
+func PromoCodeTarget(code string) string {
+	return "promo-code:" + code
+}
+
+func CategoryTarget(slug string) string {
+	return "category:" + slug
+}
+
+func WebhookTarget(endpointID int) string {
+	return "webhook:" + strconv.Itoa(endpointID)
+}
+
+// TransferLimitsTarget names the limits override of the user or the default limits when username is empty.
+func TransferLimitsTarget(username string) string {
+	if username == "" {
+		return "limits:default"
+	}
+
+	return "limits:" + username
+}
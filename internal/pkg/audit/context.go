@@ -0,0 +1,86 @@
+package audit
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// RequestIDContextKey is the gin context key the gateway keeps the request id under.
+	RequestIDContextKey  = "request-id-key"
+	RequestIDMetadataKey = "x-request-id"
+	RequestIDHeader      = "X-Request-ID"
+)
+
+var (
+	requestIDKey = contextKey{name: "request_id"}
+	actorIDKey   = contextKey{name: "actor_id"}
+)
+
+type contextKey struct {
+	name string
+}
+
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey, requestID)
+}
+
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey).(string)
+	return requestID
+}
+
+// WithActor marks the user performing the request, so recorded events don't need to carry it explicitly.
+func WithActor(ctx context.Context, actorID int) context.Context {
+	return context.WithValue(ctx, actorIDKey, actorID)
+}
+
+func ActorFromContext(ctx context.Context) int {
+	actorID, _ := ctx.Value(actorIDKey).(int)
+	return actorID
+}
+
+// RequestIDInterceptor moves the request id forwarded by the gateway from the incoming metadata into the context.
+func RequestIDInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	return handler(requestIDContext(ctx), req)
+}
+
+// RequestIDStreamInterceptor moves the request id forwarded by the gateway from the incoming metadata into
+// the context of the stream.
+func RequestIDStreamInterceptor(
+	srv interface{},
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	return handler(srv, &requestIDServerStream{ServerStream: stream, ctx: requestIDContext(stream.Context())})
+}
+
+// requestIDContext returns ctx carrying the request id from its incoming metadata, if there is one.
+func requestIDContext(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if ok {
+		if ids := md.Get(RequestIDMetadataKey); len(ids) > 0 {
+			return WithRequestID(ctx, ids[0])
+		}
+	}
+
+	return ctx
+}
+
+// requestIDServerStream replaces the context of a server stream with the one carrying the request id.
+type requestIDServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *requestIDServerStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,67 @@
+package audit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestRequestIDInterceptors(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name string
+		ctx  context.Context
+
+		expectedRequestID string
+	}
+
+	tests := []testCase{
+		{
+			name:              "request id forwarded",
+			ctx:               metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIDMetadataKey, "req-1")),
+			expectedRequestID: "req-1",
+		},
+		{
+			name:              "no request id in metadata",
+			ctx:               metadata.NewIncomingContext(context.Background(), metadata.MD{}),
+			expectedRequestID: "",
+		},
+		{
+			name:              "no metadata",
+			ctx:               context.Background(),
+			expectedRequestID: "",
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var unaryRequestID string
+			unaryHandler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				unaryRequestID = RequestIDFromContext(ctx)
+				return nil, nil
+			}
+
+			_, err := RequestIDInterceptor(tt.ctx, nil, &grpc.UnaryServerInfo{}, unaryHandler)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedRequestID, unaryRequestID)
+
+			var streamRequestID string
+			streamHandler := func(srv interface{}, stream grpc.ServerStream) error {
+				streamRequestID = RequestIDFromContext(stream.Context())
+				return nil
+			}
+
+			stream := &requestIDServerStream{ctx: tt.ctx}
+			err = RequestIDStreamInterceptor(nil, stream, &grpc.StreamServerInfo{}, streamHandler)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedRequestID, streamRequestID)
+		})
+	}
+}
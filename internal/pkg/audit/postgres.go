@@ -0,0 +1,88 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+)
+
+// PostgresLog stores events in the append-only audit_log table, which exists in both the auth and the store databases.
+type PostgresLog struct {
+	queryExecuter database.QueryExecuter
+}
+
+func NewPostgresLog(queryExecuter database.QueryExecuter) *PostgresLog {
+	return &PostgresLog{
+		queryExecuter: queryExecuter,
+	}
+}
+
+func (l *PostgresLog) Record(ctx context.Context, event Event) error {
+	return l.RecordInTx(ctx, l.queryExecuter, event)
+}
+
+// RecordInTx stores the event within the caller's transaction, so it is only kept if the audited change is committed.
+// Missing actor and request id are taken from the context.
+func (l *PostgresLog) RecordInTx(ctx context.Context, executor database.Executor, event Event) error {
+	insertSQL := `INSERT INTO audit_log (actor_id, action, target, request_id, before_value, after_value)
+		VALUES ($1, $2, $3, $4, $5, $6)`
+
+	if event.ActorID == 0 {
+		event.ActorID = ActorFromContext(ctx)
+	}
+	if event.RequestID == "" {
+		event.RequestID = RequestIDFromContext(ctx)
+	}
+
+	_, err := executor.Exec(ctx, insertSQL, event.ActorID, event.Action, event.Target, event.RequestID,
+		event.Before, event.After)
+	if err != nil {
+		return fmt.Errorf("failed to record audit event %s: %w", event.Action, err)
+	}
+
+	return nil
+}
+
+func (l *PostgresLog) ListEvents(ctx context.Context, filter Filter) ([]Event, error) {
+	listSQL := `SELECT actor_id, action, target, request_id, before_value, after_value, created_at
+		FROM audit_log
+		WHERE ($1 = 0 OR actor_id = $1)
+			AND ($2 = '' OR action = $2)
+			AND ($3 = '' OR target = $3)
+			AND ($4::timestamptz IS NULL OR created_at >= $4)
+			AND ($5::timestamptz IS NULL OR created_at < $5)
+		ORDER BY created_at DESC, id DESC
+		LIMIT $6`
+
+	rows, err := l.queryExecuter.Query(ctx, listSQL, filter.ActorID, filter.Action, filter.Target,
+		nullableTime(filter.From), nullableTime(filter.To), filter.EffectiveLimit())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list audit events: %w", err)
+	}
+	defer rows.Close()
+
+	events := make([]Event, 0)
+	for rows.Next() {
+		var event Event
+
+		err := rows.Scan(&event.ActorID, &event.Action, &event.Target, &event.RequestID,
+			&event.Before, &event.After, &event.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan audit event: %w", err)
+		}
+
+		events = append(events, event)
+	}
+
+	return events, rows.Err()
+}
+
+func nullableTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+
+	return &t
+}
@@ -0,0 +1,123 @@
+package audit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresLog_Record(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name  string
+		ctx   context.Context
+		event Event
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+
+		expectingErr bool
+	}
+
+	ctx := WithRequestID(WithActor(context.Background(), 5), "req-1")
+	before := map[string]any{"frozen": false}
+	after := map[string]any{"frozen": true}
+
+	testCases := []testCase{
+		{
+			name:  "actor and request id taken from context",
+			ctx:   ctx,
+			event: Event{Action: ActionAccountFreeze, Target: UserTarget("bob"), Before: before, After: after},
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("INSERT INTO audit_log").
+					WithArgs(5, ActionAccountFreeze, "user:bob", "req-1", before, after).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+			},
+		},
+		{
+			name:  "explicit actor kept",
+			ctx:   ctx,
+			event: Event{ActorID: 9, Action: ActionLogin, Target: UserTarget("alice")},
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("INSERT INTO audit_log").
+					WithArgs(9, ActionLogin, "user:alice", "req-1", map[string]any(nil), map[string]any(nil)).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+			},
+		},
+		{
+			name:  "insert error",
+			ctx:   context.Background(),
+			event: Event{ActorID: 9, Action: ActionLogin, Target: UserTarget("alice")},
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("INSERT INTO audit_log").
+					WithArgs(9, ActionLogin, "user:alice", "", map[string]any(nil), map[string]any(nil)).
+					WillReturnError(assert.AnError)
+			},
+			expectingErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			err = NewPostgresLog(mock).Record(tt.ctx, tt.event)
+
+			if tt.expectingErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPostgresLog_ListEvents(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	from := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	createdAt := from.Add(time.Hour)
+	after := map[string]any{"sweptAmount": float64(100)}
+
+	mock.ExpectQuery("SELECT actor_id, action, target").
+		WithArgs(0, ActionAccountDeactivate, "", &from, (*time.Time)(nil), MaxListLimit).
+		WillReturnRows(pgxmock.NewRows([]string{"actor_id", "action", "target", "request_id", "before_value", "after_value", "created_at"}).
+			AddRow(1, ActionAccountDeactivate, "user:leaver", "req-1", map[string]any(nil), after, createdAt))
+
+	events, err := NewPostgresLog(mock).ListEvents(t.Context(), Filter{
+		Action: ActionAccountDeactivate,
+		From:   from,
+		Limit:  MaxListLimit + 1,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, Event{
+		ActorID:   1,
+		Action:    ActionAccountDeactivate,
+		Target:    "user:leaver",
+		RequestID: "req-1",
+		After:     after,
+		CreatedAt: createdAt,
+	}, events[0])
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -21,4 +21,9 @@ const (
 
 	EnvGrpcAuthHost  = "GRPC_AUTH_HOST"
 	EnvGrpcStoreHost = "GRPC_STORE_HOST"
+
+	EnvMarketplaceFeePercent = "MARKETPLACE_FEE_PERCENT"
+
+	EnvEventSink       = "EVENT_SINK"
+	EnvEventSinkTarget = "EVENT_SINK_TARGET"
 )
@@ -13,12 +13,18 @@ const (
 	TokenMetadataKey = "authorization"
 )
 
+const (
+	RoleEmployee = "employee"
+	RoleAdmin    = "admin"
+	RoleAuditor  = "auditor"
+)
+
 type Authenticator interface {
 	Authenticate(ctx context.Context, username, password string) (string, error)
 }
 
 type TokenIssuer interface {
-	IssueToken(secret []byte, userID int, username string, timeLimit time.Duration) (string, error)
+	IssueToken(secret []byte, userID int, username, role string, timeLimit time.Duration) (string, error)
 }
 
 type TokenParser interface {
@@ -28,6 +34,7 @@ type TokenParser interface {
 type Claims struct {
 	UserID   int    `json:"uid"`
 	Username string `json:"usr"`
+	Role     string `json:"rol"`
 	jwt.RegisteredClaims
 }
 
@@ -38,12 +45,13 @@ func NewJWTTokenIssuer() *JWTTokenIssuer {
 	return &JWTTokenIssuer{}
 }
 
-func (ti *JWTTokenIssuer) IssueToken(secret []byte, userID int, username string, timeLimit time.Duration) (string, error) {
+func (ti *JWTTokenIssuer) IssueToken(secret []byte, userID int, username, role string, timeLimit time.Duration) (string, error) {
 	now := time.Now()
 
 	claims := Claims{
 		UserID:   userID,
 		Username: username,
+		Role:     role,
 		RegisteredClaims: jwt.RegisteredClaims{
 			Subject:   strconv.FormatInt(int64(userID), 10),
 			IssuedAt:  jwt.NewNumericDate(now),
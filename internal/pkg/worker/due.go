@@ -0,0 +1,34 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ProcessDue hands every id returned by fetch to process and returns how many of them process reported as done.
+// process has to lock the item and check it is still due, as another instance may have handled it since the fetch.
+// A failing item doesn't stop the others; the errors are joined, each prefixed with the item name and id.
+func ProcessDue[ID any](ctx context.Context, name string, fetch func(ctx context.Context) ([]ID, error),
+	process func(ctx context.Context, id ID) (bool, error)) (int, error) {
+	ids, err := fetch(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	done := 0
+	var errs []error
+	for _, id := range ids {
+		ok, err := process(ctx, id)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s %v: %w", name, id, err))
+			continue
+		}
+
+		if ok {
+			done++
+		}
+	}
+
+	return done, errors.Join(errs...)
+}
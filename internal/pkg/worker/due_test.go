@@ -0,0 +1,84 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProcessDue(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name     string
+		fetchErr error
+		results  map[int]error
+		skipped  map[int]bool
+
+		expectedDone   int
+		expectedErrMsg string
+		expectedErr    error
+	}
+
+	tests := []testCase{
+		{
+			name:         "all items done",
+			results:      map[int]error{1: nil, 2: nil, 3: nil},
+			expectedDone: 3,
+		},
+		{
+			name:         "items handled elsewhere are not counted",
+			results:      map[int]error{1: nil, 2: nil, 3: nil},
+			skipped:      map[int]bool{2: true},
+			expectedDone: 2,
+		},
+		{
+			name:           "failing item doesn't stop the others",
+			results:        map[int]error{1: nil, 2: assert.AnError, 3: nil},
+			expectedDone:   2,
+			expectedErr:    assert.AnError,
+			expectedErrMsg: "auction 2: ",
+		},
+		{
+			name:        "fetch error",
+			fetchErr:    assert.AnError,
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			fetch := func(ctx context.Context) ([]int, error) {
+				if tt.fetchErr != nil {
+					return nil, tt.fetchErr
+				}
+				return []int{1, 2, 3}, nil
+			}
+
+			processed := make([]int, 0)
+			process := func(ctx context.Context, id int) (bool, error) {
+				processed = append(processed, id)
+				if err := tt.results[id]; err != nil {
+					return false, err
+				}
+				return !tt.skipped[id], nil
+			}
+
+			done, err := ProcessDue(t.Context(), "auction", fetch, process)
+
+			assert.Equal(t, tt.expectedDone, done)
+			if tt.expectedErr != nil {
+				assert.True(t, errors.Is(err, tt.expectedErr))
+				assert.Contains(t, err.Error(), tt.expectedErrMsg)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, []int{1, 2, 3}, processed)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/logging"
+)
+
+type Task func(ctx context.Context) error
+
+// RunPeriodically runs task every interval until ctx is cancelled.
+// Task errors are logged and do not stop the loop.
+func RunPeriodically(ctx context.Context, interval time.Duration, name string, task Task, logger logging.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := task(ctx); err != nil {
+				logger.Error("periodic task failed", "task", name, "error", err.Error())
+			}
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package worker
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunPeriodically(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name    string
+		taskErr error
+	}
+
+	tests := []testCase{
+		{
+			name:    "task succeeds",
+			taskErr: nil,
+		},
+		{
+			name:    "task fails and loop continues",
+			taskErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithCancel(t.Context())
+			defer cancel()
+
+			var calls atomic.Int32
+			task := func(ctx context.Context) error {
+				if calls.Add(1) >= 3 {
+					cancel()
+				}
+				return tt.taskErr
+			}
+
+			done := make(chan struct{})
+			go func() {
+				RunPeriodically(ctx, time.Millisecond, "test", task, logging.NopLogger)
+				close(done)
+			}()
+
+			select {
+			case <-done:
+			case <-time.After(time.Second):
+				t.Fatal("periodic runner did not stop after context cancellation")
+			}
+
+			assert.GreaterOrEqual(t, calls.Load(), int32(3))
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/audit"
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+)
+
+type AccountFreezeCase struct {
+	txManager         database.TxManager
+	userIDFetcher     domain.UserIDFetcher
+	balanceCreator    domain.BalanceEnsurer
+	balanceFreezer    domain.BalanceFreezer
+	freezeLogRecorder domain.FreezeLogRecorder
+	auditRecorder     audit.Recorder
+}
+
+func NewAccountFreezeCase(txManager database.TxManager,
+	userIDFetcher domain.UserIDFetcher,
+	balanceCreator domain.BalanceEnsurer,
+	balanceFreezer domain.BalanceFreezer,
+	freezeLogRecorder domain.FreezeLogRecorder,
+	auditRecorder audit.Recorder) *AccountFreezeCase {
+	return &AccountFreezeCase{
+		txManager:         txManager,
+		userIDFetcher:     userIDFetcher,
+		balanceCreator:    balanceCreator,
+		balanceFreezer:    balanceFreezer,
+		freezeLogRecorder: freezeLogRecorder,
+		auditRecorder:     auditRecorder,
+	}
+}
+
+// FreezeAccount blocks sending, receiving and purchases for the user until it is unfrozen.
+func (fc *AccountFreezeCase) FreezeAccount(ctx context.Context, adminID int, username, reason string) error {
+	return fc.setFrozen(ctx, adminID, username, reason, true)
+}
+
+func (fc *AccountFreezeCase) UnfreezeAccount(ctx context.Context, adminID int, username, reason string) error {
+	return fc.setFrozen(ctx, adminID, username, reason, false)
+}
+
+func (fc *AccountFreezeCase) setFrozen(ctx context.Context, adminID int, username, reason string, frozen bool) error {
+	if reason == "" {
+		return &domain.InvalidArgumentsError{Msg: "reason must not be empty"}
+	}
+
+	userID, err := fc.userIDFetcher.FetchUserID(ctx, username)
+	if err != nil {
+		return &domain.UserNotFoundError{Msg: fmt.Sprintf("user not found: %s", username)}
+	}
+
+	err = fc.balanceCreator.EnsureBalanceCreated(ctx, userID, domain.StartBalance)
+	if err != nil {
+		return fmt.Errorf("failed to ensure balance for user %d: %w", userID, err)
+	}
+
+	return fc.txManager.WithinTransaction(ctx, func(ctx context.Context, executor database.QueryExecuter) error {
+		if frozen {
+			err = fc.balanceFreezer.FreezeBalance(ctx, executor, userID)
+		} else {
+			err = fc.balanceFreezer.UnfreezeBalance(ctx, executor, userID)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to update freeze status for user %d: %w", userID, err)
+		}
+
+		err = fc.freezeLogRecorder.RecordFreezeChange(ctx, executor, domain.FreezeChange{
+			UserID:  userID,
+			AdminID: adminID,
+			Frozen:  frozen,
+			Reason:  reason,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record freeze change for user %d: %w", userID, err)
+		}
+
+		action := audit.ActionAccountUnfreeze
+		if frozen {
+			action = audit.ActionAccountFreeze
+		}
+
+		err = fc.auditRecorder.RecordInTx(ctx, executor, audit.Event{
+			ActorID: adminID,
+			Action:  action,
+			Target:  audit.UserTarget(username),
+			Before:  map[string]any{"frozen": !frozen},
+			After:   map[string]any{"frozen": frozen, "reason": reason},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record audit event: %w", err)
+		}
+
+		return nil
+	})
+}
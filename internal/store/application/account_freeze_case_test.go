@@ -0,0 +1,171 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	auditmocks "github.com/Lexv0lk/merch-store/gen/mocks/audit"
+	dbmocks "github.com/Lexv0lk/merch-store/gen/mocks/database"
+	storemocks "github.com/Lexv0lk/merch-store/gen/mocks/store"
+	"github.com/Lexv0lk/merch-store/internal/pkg/audit"
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountFreezeCase(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		txManager         *dbmocks.MockTxManager
+		userIDFetcher     *storemocks.MockUserIDFetcher
+		balanceCreator    *storemocks.MockBalanceEnsurer
+		balanceFreezer    *storemocks.MockBalanceFreezer
+		freezeLogRecorder *storemocks.MockFreezeLogRecorder
+		auditRecorder     *auditmocks.MockRecorder
+	}
+
+	type testCase struct {
+		name     string
+		username string
+		reason   string
+		freeze   bool
+
+		prepareFn func(t *testing.T, d *deps)
+
+		expectedErr error
+	}
+
+	executeTxFn := func(ctx context.Context, txFn database.TxFunc) error {
+		return txFn(ctx, nil)
+	}
+
+	tests := []testCase{
+		{
+			name:     "account frozen",
+			username: "suspect",
+			reason:   "investigating coin farming",
+			freeze:   true,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "suspect").Return(2, nil)
+				d.balanceCreator.EXPECT().EnsureBalanceCreated(gomock.Any(), 2, domain.StartBalance).Return(nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.balanceFreezer.EXPECT().FreezeBalance(gomock.Any(), nil, 2).Return(nil)
+				d.freezeLogRecorder.EXPECT().RecordFreezeChange(gomock.Any(), nil, domain.FreezeChange{
+					UserID: 2, AdminID: 1, Frozen: true, Reason: "investigating coin farming"}).Return(nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, audit.Event{
+					ActorID: 1,
+					Action:  audit.ActionAccountFreeze,
+					Target:  "user:suspect",
+					Before:  map[string]any{"frozen": false},
+					After:   map[string]any{"frozen": true, "reason": "investigating coin farming"},
+				}).Return(nil)
+			},
+		},
+		{
+			name:     "account unfrozen",
+			username: "suspect",
+			reason:   "investigation closed",
+			freeze:   false,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "suspect").Return(2, nil)
+				d.balanceCreator.EXPECT().EnsureBalanceCreated(gomock.Any(), 2, domain.StartBalance).Return(nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.balanceFreezer.EXPECT().UnfreezeBalance(gomock.Any(), nil, 2).Return(nil)
+				d.freezeLogRecorder.EXPECT().RecordFreezeChange(gomock.Any(), nil, domain.FreezeChange{
+					UserID: 2, AdminID: 1, Frozen: false, Reason: "investigation closed"}).Return(nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, audit.Event{
+					ActorID: 1,
+					Action:  audit.ActionAccountUnfreeze,
+					Target:  "user:suspect",
+					Before:  map[string]any{"frozen": true},
+					After:   map[string]any{"frozen": false, "reason": "investigation closed"},
+				}).Return(nil)
+			},
+		},
+		{
+			name:        "empty reason",
+			username:    "suspect",
+			reason:      "",
+			freeze:      true,
+			prepareFn:   func(t *testing.T, d *deps) {},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:     "user not found",
+			username: "ghost",
+			reason:   "investigating",
+			freeze:   true,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "ghost").Return(0, assert.AnError)
+			},
+			expectedErr: &domain.UserNotFoundError{},
+		},
+		{
+			name:     "freeze log error",
+			username: "suspect",
+			reason:   "investigating",
+			freeze:   true,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "suspect").Return(2, nil)
+				d.balanceCreator.EXPECT().EnsureBalanceCreated(gomock.Any(), 2, domain.StartBalance).Return(nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.balanceFreezer.EXPECT().FreezeBalance(gomock.Any(), nil, 2).Return(nil)
+				d.freezeLogRecorder.EXPECT().RecordFreezeChange(gomock.Any(), nil, gomock.Any()).Return(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+		{
+			name:     "audit record error",
+			username: "suspect",
+			reason:   "investigating",
+			freeze:   true,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "suspect").Return(2, nil)
+				d.balanceCreator.EXPECT().EnsureBalanceCreated(gomock.Any(), 2, domain.StartBalance).Return(nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.balanceFreezer.EXPECT().FreezeBalance(gomock.Any(), nil, 2).Return(nil)
+				d.freezeLogRecorder.EXPECT().RecordFreezeChange(gomock.Any(), nil, gomock.Any()).Return(nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, gomock.Any()).Return(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := &deps{
+				txManager:         dbmocks.NewMockTxManager(ctrl),
+				userIDFetcher:     storemocks.NewMockUserIDFetcher(ctrl),
+				balanceCreator:    storemocks.NewMockBalanceEnsurer(ctrl),
+				balanceFreezer:    storemocks.NewMockBalanceFreezer(ctrl),
+				freezeLogRecorder: storemocks.NewMockFreezeLogRecorder(ctrl),
+				auditRecorder:     auditmocks.NewMockRecorder(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			freezeCase := NewAccountFreezeCase(d.txManager, d.userIDFetcher, d.balanceCreator, d.balanceFreezer, d.freezeLogRecorder,
+				d.auditRecorder)
+
+			var err error
+			if tt.freeze {
+				err = freezeCase.FreezeAccount(t.Context(), 1, tt.username, tt.reason)
+			} else {
+				err = freezeCase.UnfreezeAccount(t.Context(), 1, tt.username, tt.reason)
+			}
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,319 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/audit"
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/pkg/worker"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+)
+
+type AuctionsCase struct {
+	txManager            database.TxManager
+	usernameGetter       domain.UsernameGetter
+	goodsRepository      domain.GoodsRepository
+	variantsRepository   domain.VariantsRepository
+	balanceLocker        domain.UserBalanceLocker
+	balanceStatusChecker domain.BalanceStatusChecker
+	auctionsRepository   domain.AuctionsRepository
+	bidsProceeder        domain.AuctionBidsProceeder
+	settler              domain.AuctionSettler
+	purchaseLimitChecker domain.PurchaseLimitChecker
+	webhookPublisher     domain.WebhookPublisher
+	eventOutbox          domain.EventOutbox
+	auditRecorder        audit.Recorder
+}
+
+func NewAuctionsCase(txManager database.TxManager,
+	usernameGetter domain.UsernameGetter,
+	goodsRepository domain.GoodsRepository,
+	variantsRepository domain.VariantsRepository,
+	balanceLocker domain.UserBalanceLocker,
+	balanceStatusChecker domain.BalanceStatusChecker,
+	auctionsRepository domain.AuctionsRepository,
+	bidsProceeder domain.AuctionBidsProceeder,
+	settler domain.AuctionSettler,
+	purchaseLimitChecker domain.PurchaseLimitChecker,
+	webhookPublisher domain.WebhookPublisher,
+	eventOutbox domain.EventOutbox,
+	auditRecorder audit.Recorder) *AuctionsCase {
+	return &AuctionsCase{
+		txManager:            txManager,
+		usernameGetter:       usernameGetter,
+		goodsRepository:      goodsRepository,
+		variantsRepository:   variantsRepository,
+		balanceLocker:        balanceLocker,
+		balanceStatusChecker: balanceStatusChecker,
+		auctionsRepository:   auctionsRepository,
+		bidsProceeder:        bidsProceeder,
+		settler:              settler,
+		purchaseLimitChecker: purchaseLimitChecker,
+		webhookPublisher:     webhookPublisher,
+		eventOutbox:          eventOutbox,
+		auditRecorder:        auditRecorder,
+	}
+}
+
+// CreateAuction puts one unit of a good up for auction between startsAt and endsAt.
+// A zero startsAt opens the auction right away.
+func (ac *AuctionsCase) CreateAuction(ctx context.Context, goodName string, reservePrice uint32, startsAt, endsAt time.Time) (int, error) {
+	now := time.Now()
+	if startsAt.IsZero() {
+		startsAt = now
+	} else if startsAt.Before(now) {
+		return 0, &domain.InvalidArgumentsError{Msg: "start time must be in the future"}
+	}
+
+	if !endsAt.After(startsAt) {
+		return 0, &domain.InvalidArgumentsError{Msg: "end time must be after the start time"}
+	} else if endsAt.Sub(startsAt) > domain.MaxAuctionDuration {
+		return 0, &domain.InvalidArgumentsError{Msg: "auction must not last longer than 30 days"}
+	}
+
+	goodInfo, err := ac.goodsRepository.GetGoodInfo(ctx, goodName)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get good info: %w", err)
+	}
+
+	if goodInfo.Bundle {
+		return 0, &domain.InvalidArgumentsError{Msg: "bundles can't be auctioned"}
+	} else if goodInfo.Upcoming {
+		return 0, &domain.InvalidArgumentsError{Msg: "item hasn't arrived yet"}
+	}
+
+	variants, err := ac.variantsRepository.ListGoodVariants(ctx, goodInfo.Id)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list variants: %w", err)
+	}
+
+	// The prize is a unit without a variant, so goods only sold as one of their variants are kept off.
+	if len(variants) > 0 {
+		return 0, &domain.InvalidArgumentsError{Msg: "items with variants can't be auctioned"}
+	}
+
+	var auctionID int
+	err = ac.txManager.WithinTransaction(ctx, func(ctx context.Context, executor database.QueryExecuter) error {
+		auctionID, err = ac.auctionsRepository.CreateAuction(ctx, executor, domain.Auction{
+			GoodID:       goodInfo.Id,
+			ReservePrice: reservePrice,
+			StartsAt:     startsAt,
+			EndsAt:       endsAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = ac.auditRecorder.RecordInTx(ctx, executor, audit.Event{
+			Action: audit.ActionAuctionCreate,
+			Target: audit.AuctionTarget(auctionID),
+			After: map[string]any{
+				"item":         goodName,
+				"reservePrice": reservePrice,
+				"startsAt":     startsAt.UTC().Format(time.RFC3339),
+				"endsAt":       endsAt.UTC().Format(time.RFC3339),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record audit event: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return auctionID, nil
+}
+
+func (ac *AuctionsCase) ListAuctions(ctx context.Context) ([]domain.NamedAuction, error) {
+	auctions, err := ac.auctionsRepository.ListOpenAuctions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bidderIDs := make([]int, 0, len(auctions))
+	for _, auction := range auctions {
+		if auction.HasBids() {
+			bidderIDs = append(bidderIDs, auction.TopBid.BidderID)
+		}
+	}
+
+	usernames := map[int]string{}
+	if len(bidderIDs) > 0 {
+		usernames, err = ac.usernameGetter.GetUsernames(ctx, bidderIDs...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get bidder usernames: %w", err)
+		}
+	}
+
+	named := make([]domain.NamedAuction, 0, len(auctions))
+	for _, auction := range auctions {
+		named = append(named, domain.NamedAuction{
+			Auction:           auction,
+			TopBidderUsername: usernames[auction.TopBid.BidderID],
+		})
+	}
+
+	return named, nil
+}
+
+// PlaceBid outbids the current top bid of a running auction. The bid is taken from the bidder's balance
+// into escrow and the outbid coins are returned to their owner in the same transaction.
+// A top bidder raising their own bid only needs to cover the difference. Bidders who can't get another unit
+// of the good under its purchase limit can't bid.
+func (ac *AuctionsCase) PlaceBid(ctx context.Context, bidderID, auctionID int, amount uint32) error {
+	if amount == 0 {
+		return &domain.InvalidArgumentsError{Msg: "bid must be positive"}
+	}
+
+	return ac.txManager.WithinTransaction(ctx, func(ctx context.Context, executor database.QueryExecuter) error {
+		auction, err := ac.bidsProceeder.LockAndGetAuction(ctx, executor, auctionID)
+		if err != nil {
+			return err
+		}
+
+		if !auction.IsAcceptingBids(time.Now()) {
+			return &domain.AuctionClosedError{Msg: fmt.Sprintf("auction %d is not accepting bids", auctionID)}
+		}
+
+		if auction.HasBids() && amount <= auction.TopBid.Amount {
+			return &domain.InvalidArgumentsError{Msg: fmt.Sprintf("bid must be higher than %d", auction.TopBid.Amount)}
+		}
+
+		balance, err := ac.balanceLocker.LockAndGetUserBalance(ctx, executor, bidderID)
+		if err != nil {
+			return fmt.Errorf("failed to lock and get balance for user %d: %w", bidderID, err)
+		}
+
+		err = checkNotFrozen(ctx, ac.balanceStatusChecker, executor, bidderID, "account is frozen")
+		if err != nil {
+			return err
+		}
+
+		if auction.TopBid.BidderID == bidderID {
+			balance += auction.TopBid.Amount
+		}
+
+		if balance < amount {
+			return &domain.InsufficientBalanceError{Msg: "insufficient balance"}
+		}
+
+		err = checkPurchaseLimits(ctx, ac.purchaseLimitChecker, executor, bidderID, map[int]uint32{auction.GoodID: 1})
+		if err != nil {
+			return err
+		}
+
+		if auction.HasBids() {
+			err = ac.refundTopBid(ctx, executor, auction, audit.RefundReasonOutbid)
+			if err != nil {
+				return err
+			}
+		}
+
+		return ac.bidsProceeder.PlaceBid(ctx, executor, auctionID, bidderID, amount)
+	})
+}
+
+// SettleDueAuctions closes every auction that has ended and returns how many items were sold.
+func (ac *AuctionsCase) SettleDueAuctions(ctx context.Context) (int, error) {
+	return worker.ProcessDue(ctx, "auction", func(ctx context.Context) ([]int, error) {
+		return ac.settler.FetchDueAuctions(ctx, time.Now(), domain.AuctionsSettleBatch)
+	}, ac.settleAuction)
+}
+
+// settleAuction gives the item to the top bidder if the reserve price is met and the item still fits the
+// bidder's purchase limit. Otherwise the auction closes unsold and the top bid, if any, is refunded.
+func (ac *AuctionsCase) settleAuction(ctx context.Context, auctionID int) (bool, error) {
+	sold := false
+
+	err := ac.txManager.WithinTransaction(ctx, func(ctx context.Context, executor database.QueryExecuter) error {
+		auction, err := ac.bidsProceeder.LockAndGetAuction(ctx, executor, auctionID)
+		if err != nil {
+			return err
+		}
+
+		// The auction could have been settled by another store instance since it was fetched.
+		if auction.Status != domain.AuctionStatusOpen || time.Now().Before(auction.EndsAt) {
+			return nil
+		}
+
+		if auction.IsReserveMet() {
+			sold, err = ac.fitsPurchaseLimit(ctx, executor, auction.TopBid.BidderID, auction.GoodID)
+			if err != nil {
+				return err
+			}
+		}
+
+		if sold {
+			return ac.awardAuction(ctx, executor, auction)
+		}
+
+		if auction.HasBids() {
+			err = ac.refundTopBid(ctx, executor, auction, audit.RefundReasonUnsold)
+			if err != nil {
+				return err
+			}
+		}
+
+		return ac.settler.CloseUnsoldAuction(ctx, executor, auctionID)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return sold, nil
+}
+
+// fitsPurchaseLimit locks the winner's balance, so their purchases wait for the award, and checks they can get
+// one more unit of the good. The winner could have got other units since the bid.
+func (ac *AuctionsCase) fitsPurchaseLimit(ctx context.Context, executor database.QueryExecuter, winnerID, goodID int) (bool, error) {
+	_, err := ac.balanceLocker.LockAndGetUserBalance(ctx, executor, winnerID)
+	if err != nil {
+		return false, fmt.Errorf("failed to lock and get balance for user %d: %w", winnerID, err)
+	}
+
+	err = checkPurchaseLimits(ctx, ac.purchaseLimitChecker, executor, winnerID, map[int]uint32{goodID: 1})
+	if errors.Is(err, &domain.PurchaseLimitError{}) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// refundTopBid returns the top bid of the auction to the bidder and records the refund in the audit log.
+func (ac *AuctionsCase) refundTopBid(ctx context.Context, executor database.Executor, auction domain.Auction,
+	reason string) error {
+	err := ac.bidsProceeder.RefundBid(ctx, executor, auction.TopBid)
+	if err != nil {
+		return fmt.Errorf("failed to refund bid %d: %w", auction.TopBid.Id, err)
+	}
+
+	err = ac.auditRecorder.RecordInTx(ctx, executor, audit.RefundEvent(audit.AuctionTarget(auction.Id),
+		auction.TopBid.BidderID, auction.TopBid.Amount, reason))
+	if err != nil {
+		return fmt.Errorf("failed to record audit event: %w", err)
+	}
+
+	return nil
+}
+
+// awardAuction gives the item to the top bidder and reports it like a store purchase paid with the winning bid.
+func (ac *AuctionsCase) awardAuction(ctx context.Context, executor database.Executor, auction domain.Auction) error {
+	err := ac.settler.AwardAuction(ctx, executor, auction)
+	if err != nil {
+		return err
+	}
+
+	err = ac.webhookPublisher.PublishWebhook(ctx, executor, domain.AuctionPurchaseWebhook(auction))
+	if err != nil {
+		return err
+	}
+
+	return ac.eventOutbox.AppendEvent(ctx, executor, domain.ItemPurchasedEvent(auction.TopBid.BidderID,
+		auction.TopBid.BidderID, auction.TopBid.Amount, domain.WebhookItem{Name: auction.GoodName, Quantity: 1}))
+}
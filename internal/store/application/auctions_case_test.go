@@ -0,0 +1,559 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	auditmocks "github.com/Lexv0lk/merch-store/gen/mocks/audit"
+	dbmocks "github.com/Lexv0lk/merch-store/gen/mocks/database"
+	storemocks "github.com/Lexv0lk/merch-store/gen/mocks/store"
+	"github.com/Lexv0lk/merch-store/internal/pkg/audit"
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuctionsCase_CreateAuction(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		txManager          *dbmocks.MockTxManager
+		goodsRepository    *storemocks.MockGoodsRepository
+		variantsRepository *storemocks.MockVariantsRepository
+		auctionsRepository *storemocks.MockAuctionsRepository
+		auditRecorder      *auditmocks.MockRecorder
+	}
+
+	type testCase struct {
+		name     string
+		startsAt time.Time
+		endsAt   time.Time
+
+		prepareFn func(t *testing.T, d *deps)
+
+		expectedID  int
+		expectedErr error
+	}
+
+	executeTxFn := func(ctx context.Context, txFn database.TxFunc) error {
+		return txFn(ctx, nil)
+	}
+
+	startsAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	endsAt := startsAt.Add(24 * time.Hour)
+
+	tests := []testCase{
+		{
+			name:     "auction created",
+			startsAt: startsAt,
+			endsAt:   endsAt,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "pink-hoody").
+					Return(domain.GoodInfo{Id: 10, Name: "pink-hoody", Price: 500}, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 10).Return([]domain.Variant{}, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.auctionsRepository.EXPECT().CreateAuction(gomock.Any(), nil, domain.Auction{
+					GoodID:       10,
+					ReservePrice: 300,
+					StartsAt:     startsAt,
+					EndsAt:       endsAt,
+				}).Return(4, nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, gomock.Any()).Return(nil)
+			},
+			expectedID: 4,
+		},
+		{
+			name:        "start in the past",
+			startsAt:    time.Now().Add(-time.Hour),
+			endsAt:      endsAt,
+			prepareFn:   func(t *testing.T, d *deps) {},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:        "end before start",
+			startsAt:    startsAt,
+			endsAt:      startsAt.Add(-time.Minute),
+			prepareFn:   func(t *testing.T, d *deps) {},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:        "too long",
+			startsAt:    startsAt,
+			endsAt:      startsAt.Add(domain.MaxAuctionDuration + time.Minute),
+			prepareFn:   func(t *testing.T, d *deps) {},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:     "unknown good",
+			startsAt: startsAt,
+			endsAt:   endsAt,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "pink-hoody").
+					Return(domain.GoodInfo{}, &domain.GoodNotFoundError{})
+			},
+			expectedErr: &domain.GoodNotFoundError{},
+		},
+		{
+			name:     "upcoming good",
+			startsAt: startsAt,
+			endsAt:   endsAt,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "pink-hoody").
+					Return(domain.GoodInfo{Id: 10, Name: "pink-hoody", Price: 500, Upcoming: true}, nil)
+			},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:     "good with variants",
+			startsAt: startsAt,
+			endsAt:   endsAt,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "pink-hoody").
+					Return(domain.GoodInfo{Id: 10, Name: "pink-hoody", Price: 500}, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 10).
+					Return([]domain.Variant{{Id: 4, GoodID: 10, SKU: "pink-hoody-m"}}, nil)
+			},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:     "audit failure",
+			startsAt: startsAt,
+			endsAt:   endsAt,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "pink-hoody").
+					Return(domain.GoodInfo{Id: 10, Name: "pink-hoody", Price: 500}, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 10).Return([]domain.Variant{}, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.auctionsRepository.EXPECT().CreateAuction(gomock.Any(), nil, gomock.Any()).Return(4, nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, gomock.Any()).Return(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := &deps{
+				txManager:          dbmocks.NewMockTxManager(ctrl),
+				goodsRepository:    storemocks.NewMockGoodsRepository(ctrl),
+				variantsRepository: storemocks.NewMockVariantsRepository(ctrl),
+				auctionsRepository: storemocks.NewMockAuctionsRepository(ctrl),
+				auditRecorder:      auditmocks.NewMockRecorder(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			auctionsCase := NewAuctionsCase(d.txManager, storemocks.NewMockUsernameGetter(ctrl),
+				d.goodsRepository, d.variantsRepository, storemocks.NewMockUserBalanceLocker(ctrl),
+				storemocks.NewMockBalanceStatusChecker(ctrl), d.auctionsRepository,
+				storemocks.NewMockAuctionBidsProceeder(ctrl), storemocks.NewMockAuctionSettler(ctrl),
+				storemocks.NewMockPurchaseLimitChecker(ctrl), storemocks.NewMockWebhookPublisher(ctrl),
+				storemocks.NewMockEventOutbox(ctrl), d.auditRecorder)
+			auctionID, err := auctionsCase.CreateAuction(t.Context(), "pink-hoody", 300, tt.startsAt, tt.endsAt)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedID, auctionID)
+			}
+		})
+	}
+}
+
+func TestAuctionsCase_PlaceBid(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		txManager            *dbmocks.MockTxManager
+		balanceLocker        *storemocks.MockUserBalanceLocker
+		balanceStatusChecker *storemocks.MockBalanceStatusChecker
+		bidsProceeder        *storemocks.MockAuctionBidsProceeder
+		purchaseLimitChecker *storemocks.MockPurchaseLimitChecker
+		auditRecorder        *auditmocks.MockRecorder
+	}
+
+	type testCase struct {
+		name   string
+		amount uint32
+
+		prepareFn func(t *testing.T, d *deps)
+
+		expectedErr error
+	}
+
+	executeTxFn := func(ctx context.Context, txFn database.TxFunc) error {
+		return txFn(ctx, nil)
+	}
+
+	running := domain.Auction{
+		Id:       4,
+		GoodID:   10,
+		Status:   domain.AuctionStatusOpen,
+		StartsAt: time.Now().Add(-time.Hour),
+		EndsAt:   time.Now().Add(time.Hour),
+	}
+	outbidBid := domain.AuctionBid{Id: 8, BidderID: 2, Amount: 100}
+	withBid := running
+	withBid.TopBid = outbidBid
+
+	tests := []testCase{
+		{
+			name:   "first bid",
+			amount: 100,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.bidsProceeder.EXPECT().LockAndGetAuction(gomock.Any(), nil, 4).Return(running, nil)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).Return(uint32(100), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).Return(false, nil)
+				d.purchaseLimitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{10}).Return(nil, nil)
+				d.bidsProceeder.EXPECT().PlaceBid(gomock.Any(), nil, 4, 1, uint32(100)).Return(nil)
+			},
+		},
+		{
+			name:   "outbid refunds previous bidder",
+			amount: 150,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.bidsProceeder.EXPECT().LockAndGetAuction(gomock.Any(), nil, 4).Return(withBid, nil)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).Return(uint32(200), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).Return(false, nil)
+				d.purchaseLimitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{10}).Return(nil, nil)
+				d.bidsProceeder.EXPECT().RefundBid(gomock.Any(), nil, outbidBid).Return(nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, audit.RefundEvent("auction:4", outbidBid.BidderID,
+					outbidBid.Amount, audit.RefundReasonOutbid)).Return(nil)
+				d.bidsProceeder.EXPECT().PlaceBid(gomock.Any(), nil, 4, 1, uint32(150)).Return(nil)
+			},
+		},
+		{
+			name:   "refund audit record error",
+			amount: 150,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.bidsProceeder.EXPECT().LockAndGetAuction(gomock.Any(), nil, 4).Return(withBid, nil)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).Return(uint32(200), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).Return(false, nil)
+				d.purchaseLimitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{10}).Return(nil, nil)
+				d.bidsProceeder.EXPECT().RefundBid(gomock.Any(), nil, outbidBid).Return(nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, gomock.Any()).Return(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+		{
+			name:   "top bidder raises with escrowed coins",
+			amount: 150,
+			prepareFn: func(t *testing.T, d *deps) {
+				ownBid := withBid
+				ownBid.TopBid.BidderID = 1
+
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.bidsProceeder.EXPECT().LockAndGetAuction(gomock.Any(), nil, 4).Return(ownBid, nil)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).Return(uint32(50), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).Return(false, nil)
+				d.purchaseLimitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{10}).Return(nil, nil)
+				d.bidsProceeder.EXPECT().RefundBid(gomock.Any(), nil, ownBid.TopBid).Return(nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, audit.RefundEvent("auction:4", 1,
+					ownBid.TopBid.Amount, audit.RefundReasonOutbid)).Return(nil)
+				d.bidsProceeder.EXPECT().PlaceBid(gomock.Any(), nil, 4, 1, uint32(150)).Return(nil)
+			},
+		},
+		{
+			name:   "insufficient balance",
+			amount: 150,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.bidsProceeder.EXPECT().LockAndGetAuction(gomock.Any(), nil, 4).Return(withBid, nil)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).Return(uint32(149), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).Return(false, nil)
+			},
+			expectedErr: &domain.InsufficientBalanceError{},
+		},
+		{
+			name:   "purchase limit reached",
+			amount: 150,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.bidsProceeder.EXPECT().LockAndGetAuction(gomock.Any(), nil, 4).Return(withBid, nil)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).Return(uint32(200), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).Return(false, nil)
+				d.purchaseLimitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{10}).
+					Return([]domain.PurchaseLimit{{GoodID: 10, GoodName: "pink-hoody", MaxQuantity: 1}}, nil)
+				d.purchaseLimitChecker.EXPECT().CountUserAcquisitions(gomock.Any(), nil, 1, 10, time.Time{}).
+					Return(uint32(1), nil)
+			},
+			expectedErr: &domain.PurchaseLimitError{},
+		},
+		{
+			name:   "bid not above the top bid",
+			amount: 100,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.bidsProceeder.EXPECT().LockAndGetAuction(gomock.Any(), nil, 4).Return(withBid, nil)
+			},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:   "bidder frozen",
+			amount: 150,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.bidsProceeder.EXPECT().LockAndGetAuction(gomock.Any(), nil, 4).Return(withBid, nil)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).Return(uint32(200), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).Return(true, nil)
+			},
+			expectedErr: &domain.AccountFrozenError{},
+		},
+		{
+			name:   "auction not started",
+			amount: 100,
+			prepareFn: func(t *testing.T, d *deps) {
+				upcoming := running
+				upcoming.StartsAt = time.Now().Add(time.Minute)
+
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.bidsProceeder.EXPECT().LockAndGetAuction(gomock.Any(), nil, 4).Return(upcoming, nil)
+			},
+			expectedErr: &domain.AuctionClosedError{},
+		},
+		{
+			name:   "auction not found",
+			amount: 100,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.bidsProceeder.EXPECT().LockAndGetAuction(gomock.Any(), nil, 4).
+					Return(domain.Auction{}, &domain.AuctionNotFoundError{})
+			},
+			expectedErr: &domain.AuctionNotFoundError{},
+		},
+		{
+			name:        "zero bid",
+			amount:      0,
+			prepareFn:   func(t *testing.T, d *deps) {},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := &deps{
+				txManager:            dbmocks.NewMockTxManager(ctrl),
+				balanceLocker:        storemocks.NewMockUserBalanceLocker(ctrl),
+				balanceStatusChecker: storemocks.NewMockBalanceStatusChecker(ctrl),
+				bidsProceeder:        storemocks.NewMockAuctionBidsProceeder(ctrl),
+				purchaseLimitChecker: storemocks.NewMockPurchaseLimitChecker(ctrl),
+				auditRecorder:        auditmocks.NewMockRecorder(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			auctionsCase := NewAuctionsCase(d.txManager, storemocks.NewMockUsernameGetter(ctrl),
+				storemocks.NewMockGoodsRepository(ctrl), storemocks.NewMockVariantsRepository(ctrl), d.balanceLocker,
+				d.balanceStatusChecker, storemocks.NewMockAuctionsRepository(ctrl), d.bidsProceeder,
+				storemocks.NewMockAuctionSettler(ctrl), d.purchaseLimitChecker, storemocks.NewMockWebhookPublisher(ctrl),
+				storemocks.NewMockEventOutbox(ctrl), d.auditRecorder)
+			err := auctionsCase.PlaceBid(t.Context(), 1, 4, tt.amount)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAuctionsCase_SettleDueAuctions(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		txManager            *dbmocks.MockTxManager
+		balanceLocker        *storemocks.MockUserBalanceLocker
+		bidsProceeder        *storemocks.MockAuctionBidsProceeder
+		settler              *storemocks.MockAuctionSettler
+		purchaseLimitChecker *storemocks.MockPurchaseLimitChecker
+		webhookPublisher     *storemocks.MockWebhookPublisher
+		eventOutbox          *storemocks.MockEventOutbox
+		auditRecorder        *auditmocks.MockRecorder
+	}
+
+	type testCase struct {
+		name string
+
+		prepareFn func(t *testing.T, d *deps)
+
+		expectedSold int
+		expectedErr  error
+	}
+
+	executeTxFn := func(ctx context.Context, txFn database.TxFunc) error {
+		return txFn(ctx, nil)
+	}
+
+	ended := domain.Auction{
+		Id:           4,
+		GoodID:       10,
+		GoodName:     "pink-hoody",
+		ReservePrice: 300,
+		Status:       domain.AuctionStatusOpen,
+		StartsAt:     time.Now().Add(-2 * time.Hour),
+		EndsAt:       time.Now().Add(-time.Minute),
+	}
+	winning := ended
+	winning.TopBid = domain.AuctionBid{Id: 8, BidderID: 2, Amount: 350}
+	belowReserve := ended
+	belowReserve.TopBid = domain.AuctionBid{Id: 9, BidderID: 3, Amount: 250}
+
+	tests := []testCase{
+		{
+			name: "item awarded to the top bidder",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.settler.EXPECT().FetchDueAuctions(gomock.Any(), gomock.Any(), domain.AuctionsSettleBatch).Return([]int{4}, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.bidsProceeder.EXPECT().LockAndGetAuction(gomock.Any(), nil, 4).Return(winning, nil)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 2).Return(uint32(0), nil)
+				d.purchaseLimitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{10}).Return(nil, nil)
+				d.settler.EXPECT().AwardAuction(gomock.Any(), nil, winning).Return(nil)
+				d.webhookPublisher.EXPECT().PublishWebhook(gomock.Any(), nil, domain.AuctionPurchaseWebhook(winning)).Return(nil)
+				d.eventOutbox.EXPECT().AppendEvent(gomock.Any(), nil, domain.ItemPurchasedEvent(2, 2, 350,
+					domain.WebhookItem{Name: "pink-hoody", Quantity: 1})).Return(nil)
+			},
+			expectedSold: 1,
+		},
+		{
+			name: "reserve not met refunds the top bid",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.settler.EXPECT().FetchDueAuctions(gomock.Any(), gomock.Any(), domain.AuctionsSettleBatch).Return([]int{4}, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.bidsProceeder.EXPECT().LockAndGetAuction(gomock.Any(), nil, 4).Return(belowReserve, nil)
+				d.bidsProceeder.EXPECT().RefundBid(gomock.Any(), nil, belowReserve.TopBid).Return(nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, audit.RefundEvent("auction:4",
+					belowReserve.TopBid.BidderID, belowReserve.TopBid.Amount, audit.RefundReasonUnsold)).Return(nil)
+				d.settler.EXPECT().CloseUnsoldAuction(gomock.Any(), nil, 4).Return(nil)
+			},
+			expectedSold: 0,
+		},
+		{
+			name: "winner over the purchase limit refunded",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.settler.EXPECT().FetchDueAuctions(gomock.Any(), gomock.Any(), domain.AuctionsSettleBatch).Return([]int{4}, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.bidsProceeder.EXPECT().LockAndGetAuction(gomock.Any(), nil, 4).Return(winning, nil)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 2).Return(uint32(0), nil)
+				d.purchaseLimitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{10}).
+					Return([]domain.PurchaseLimit{{GoodID: 10, GoodName: "pink-hoody", MaxQuantity: 1}}, nil)
+				d.purchaseLimitChecker.EXPECT().CountUserAcquisitions(gomock.Any(), nil, 2, 10, time.Time{}).
+					Return(uint32(1), nil)
+				d.bidsProceeder.EXPECT().RefundBid(gomock.Any(), nil, winning.TopBid).Return(nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, audit.RefundEvent("auction:4", 2,
+					winning.TopBid.Amount, audit.RefundReasonUnsold)).Return(nil)
+				d.settler.EXPECT().CloseUnsoldAuction(gomock.Any(), nil, 4).Return(nil)
+			},
+			expectedSold: 0,
+		},
+		{
+			name: "no bids",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.settler.EXPECT().FetchDueAuctions(gomock.Any(), gomock.Any(), domain.AuctionsSettleBatch).Return([]int{4}, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.bidsProceeder.EXPECT().LockAndGetAuction(gomock.Any(), nil, 4).Return(ended, nil)
+				d.settler.EXPECT().CloseUnsoldAuction(gomock.Any(), nil, 4).Return(nil)
+			},
+			expectedSold: 0,
+		},
+		{
+			name: "already settled by another instance",
+			prepareFn: func(t *testing.T, d *deps) {
+				settled := winning
+				settled.Status = domain.AuctionStatusSold
+
+				d.settler.EXPECT().FetchDueAuctions(gomock.Any(), gomock.Any(), domain.AuctionsSettleBatch).Return([]int{4}, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.bidsProceeder.EXPECT().LockAndGetAuction(gomock.Any(), nil, 4).Return(settled, nil)
+			},
+			expectedSold: 0,
+		},
+		{
+			name: "failing auction doesn't stop the others",
+			prepareFn: func(t *testing.T, d *deps) {
+				other := winning
+				other.Id = 5
+
+				d.settler.EXPECT().FetchDueAuctions(gomock.Any(), gomock.Any(), domain.AuctionsSettleBatch).Return([]int{4, 5}, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn).Times(2)
+				d.bidsProceeder.EXPECT().LockAndGetAuction(gomock.Any(), nil, 4).Return(winning, nil)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 2).Return(uint32(0), nil)
+				d.purchaseLimitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{10}).Return(nil, nil)
+				d.settler.EXPECT().AwardAuction(gomock.Any(), nil, winning).Return(assert.AnError)
+				d.bidsProceeder.EXPECT().LockAndGetAuction(gomock.Any(), nil, 5).Return(other, nil)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 2).Return(uint32(0), nil)
+				d.purchaseLimitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{10}).Return(nil, nil)
+				d.settler.EXPECT().AwardAuction(gomock.Any(), nil, other).Return(nil)
+				d.webhookPublisher.EXPECT().PublishWebhook(gomock.Any(), nil, gomock.Any()).Return(nil)
+				d.eventOutbox.EXPECT().AppendEvent(gomock.Any(), nil, gomock.Any()).Return(nil)
+			},
+			expectedSold: 1,
+			expectedErr:  assert.AnError,
+		},
+		{
+			name: "webhook failure rolls the award back",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.settler.EXPECT().FetchDueAuctions(gomock.Any(), gomock.Any(), domain.AuctionsSettleBatch).Return([]int{4}, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.bidsProceeder.EXPECT().LockAndGetAuction(gomock.Any(), nil, 4).Return(winning, nil)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 2).Return(uint32(0), nil)
+				d.purchaseLimitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{10}).Return(nil, nil)
+				d.settler.EXPECT().AwardAuction(gomock.Any(), nil, winning).Return(nil)
+				d.webhookPublisher.EXPECT().PublishWebhook(gomock.Any(), nil, gomock.Any()).Return(assert.AnError)
+			},
+			expectedSold: 0,
+			expectedErr:  assert.AnError,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := &deps{
+				txManager:            dbmocks.NewMockTxManager(ctrl),
+				balanceLocker:        storemocks.NewMockUserBalanceLocker(ctrl),
+				bidsProceeder:        storemocks.NewMockAuctionBidsProceeder(ctrl),
+				settler:              storemocks.NewMockAuctionSettler(ctrl),
+				purchaseLimitChecker: storemocks.NewMockPurchaseLimitChecker(ctrl),
+				webhookPublisher:     storemocks.NewMockWebhookPublisher(ctrl),
+				eventOutbox:          storemocks.NewMockEventOutbox(ctrl),
+				auditRecorder:        auditmocks.NewMockRecorder(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			auctionsCase := NewAuctionsCase(d.txManager, storemocks.NewMockUsernameGetter(ctrl),
+				storemocks.NewMockGoodsRepository(ctrl), storemocks.NewMockVariantsRepository(ctrl),
+				d.balanceLocker, storemocks.NewMockBalanceStatusChecker(ctrl), storemocks.NewMockAuctionsRepository(ctrl),
+				d.bidsProceeder, d.settler, d.purchaseLimitChecker, d.webhookPublisher, d.eventOutbox, d.auditRecorder)
+			sold, err := auctionsCase.SettleDueAuctions(t.Context())
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedSold, sold)
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/audit"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+)
+
+type AuditCase struct {
+	storeReader    audit.Reader
+	authReader     domain.AuthAuditReader
+	userIDFetcher  domain.UserIDFetcher
+	usernameGetter domain.UsernameGetter
+}
+
+func NewAuditCase(storeReader audit.Reader,
+	authReader domain.AuthAuditReader,
+	userIDFetcher domain.UserIDFetcher,
+	usernameGetter domain.UsernameGetter) *AuditCase {
+	return &AuditCase{
+		storeReader:    storeReader,
+		authReader:     authReader,
+		userIDFetcher:  userIDFetcher,
+		usernameGetter: usernameGetter,
+	}
+}
+
+// ListAuditLog returns the newest audit entries of the requested source, or of both services merged when source is empty.
+func (ac *AuditCase) ListAuditLog(ctx context.Context, source, actorUsername string, filter audit.Filter) ([]domain.AuditEntry, error) {
+	if source != "" && source != domain.AuditSourceStore && source != domain.AuditSourceAuth {
+		return nil, &domain.InvalidArgumentsError{Msg: fmt.Sprintf("unknown audit source: %s", source)}
+	}
+
+	if !filter.From.IsZero() && !filter.To.IsZero() && filter.From.After(filter.To) {
+		return nil, &domain.InvalidArgumentsError{Msg: "from must not be after to"}
+	}
+
+	if actorUsername != "" {
+		actorID, err := ac.userIDFetcher.FetchUserID(ctx, actorUsername)
+		if err != nil {
+			return nil, &domain.UserNotFoundError{Msg: fmt.Sprintf("user not found: %s", actorUsername)}
+		}
+
+		filter.ActorID = actorID
+	}
+
+	var entries []domain.AuditEntry
+
+	if source != domain.AuditSourceAuth {
+		storeEntries, err := ac.listStoreEntries(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, storeEntries...)
+	}
+
+	if source != domain.AuditSourceStore {
+		authEntries, err := ac.authReader.ListAuthEvents(ctx, filter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list auth audit events: %w", err)
+		}
+
+		entries = append(entries, authEntries...)
+	}
+
+	slices.SortStableFunc(entries, func(a, b domain.AuditEntry) int {
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+
+	if limit := filter.EffectiveLimit(); len(entries) > limit {
+		entries = entries[:limit]
+	}
+
+	return entries, nil
+}
+
+func (ac *AuditCase) listStoreEntries(ctx context.Context, filter audit.Filter) ([]domain.AuditEntry, error) {
+	events, err := ac.storeReader.ListEvents(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list store audit events: %w", err)
+	}
+
+	if len(events) == 0 {
+		return nil, nil
+	}
+
+	actorIDs := make([]int, 0, len(events))
+	for _, event := range events {
+		actorIDs = append(actorIDs, event.ActorID)
+	}
+
+	usernames, err := ac.usernameGetter.GetUsernames(ctx, actorIDs...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get actor usernames: %w", err)
+	}
+
+	entries := make([]domain.AuditEntry, 0, len(events))
+	for _, event := range events {
+		entries = append(entries, domain.AuditEntry{
+			Event:  event,
+			Source: domain.AuditSourceStore,
+			Actor:  usernames[event.ActorID],
+		})
+	}
+
+	return entries, nil
+}
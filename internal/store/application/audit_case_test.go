@@ -0,0 +1,149 @@
+package application
+
+import (
+	"testing"
+	"time"
+
+	auditmocks "github.com/Lexv0lk/merch-store/gen/mocks/audit"
+	storemocks "github.com/Lexv0lk/merch-store/gen/mocks/store"
+	"github.com/Lexv0lk/merch-store/internal/pkg/audit"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditCase_ListAuditLog(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		storeReader    *auditmocks.MockReader
+		authReader     *storemocks.MockAuthAuditReader
+		userIDFetcher  *storemocks.MockUserIDFetcher
+		usernameGetter *storemocks.MockUsernameGetter
+	}
+
+	type testCase struct {
+		name          string
+		source        string
+		actorUsername string
+		filter        audit.Filter
+
+		prepareFn func(t *testing.T, d *deps)
+
+		expectedActions []string
+		expectedErr     error
+	}
+
+	now := time.Now()
+	storeEvents := []audit.Event{
+		{ActorID: 1, Action: audit.ActionAccountFreeze, CreatedAt: now.Add(-time.Minute)},
+		{ActorID: 1, Action: audit.ActionTeamCreate, CreatedAt: now.Add(-3 * time.Minute)},
+	}
+	authEntries := []domain.AuditEntry{
+		{Event: audit.Event{ActorID: 1, Action: audit.ActionLogin, CreatedAt: now.Add(-2 * time.Minute)},
+			Source: domain.AuditSourceAuth, Actor: "admin"},
+	}
+
+	tests := []testCase{
+		{
+			name: "both sources merged newest first",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.storeReader.EXPECT().ListEvents(gomock.Any(), audit.Filter{}).Return(storeEvents, nil)
+				d.usernameGetter.EXPECT().GetUsernames(gomock.Any(), 1, 1).Return(map[int]string{1: "admin"}, nil)
+				d.authReader.EXPECT().ListAuthEvents(gomock.Any(), audit.Filter{}).Return(authEntries, nil)
+			},
+			expectedActions: []string{audit.ActionAccountFreeze, audit.ActionLogin, audit.ActionTeamCreate},
+		},
+		{
+			name:   "merged result truncated to limit",
+			filter: audit.Filter{Limit: 2},
+			prepareFn: func(t *testing.T, d *deps) {
+				d.storeReader.EXPECT().ListEvents(gomock.Any(), audit.Filter{Limit: 2}).Return(storeEvents, nil)
+				d.usernameGetter.EXPECT().GetUsernames(gomock.Any(), 1, 1).Return(map[int]string{1: "admin"}, nil)
+				d.authReader.EXPECT().ListAuthEvents(gomock.Any(), audit.Filter{Limit: 2}).Return(authEntries, nil)
+			},
+			expectedActions: []string{audit.ActionAccountFreeze, audit.ActionLogin},
+		},
+		{
+			name:          "store source filtered by actor",
+			source:        domain.AuditSourceStore,
+			actorUsername: "admin",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "admin").Return(1, nil)
+				d.storeReader.EXPECT().ListEvents(gomock.Any(), audit.Filter{ActorID: 1}).Return(storeEvents[:1], nil)
+				d.usernameGetter.EXPECT().GetUsernames(gomock.Any(), 1).Return(map[int]string{1: "admin"}, nil)
+			},
+			expectedActions: []string{audit.ActionAccountFreeze},
+		},
+		{
+			name:   "auth source only",
+			source: domain.AuditSourceAuth,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.authReader.EXPECT().ListAuthEvents(gomock.Any(), audit.Filter{}).Return(authEntries, nil)
+			},
+			expectedActions: []string{audit.ActionLogin},
+		},
+		{
+			name:        "unknown source",
+			source:      "billing",
+			prepareFn:   func(t *testing.T, d *deps) {},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:        "inverted time range",
+			filter:      audit.Filter{From: now, To: now.Add(-time.Hour)},
+			prepareFn:   func(t *testing.T, d *deps) {},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:          "actor not found",
+			actorUsername: "ghost",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "ghost").Return(0, assert.AnError)
+			},
+			expectedErr: &domain.UserNotFoundError{},
+		},
+		{
+			name:   "auth reader error",
+			source: domain.AuditSourceAuth,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.authReader.EXPECT().ListAuthEvents(gomock.Any(), audit.Filter{}).Return(nil, assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := &deps{
+				storeReader:    auditmocks.NewMockReader(ctrl),
+				authReader:     storemocks.NewMockAuthAuditReader(ctrl),
+				userIDFetcher:  storemocks.NewMockUserIDFetcher(ctrl),
+				usernameGetter: storemocks.NewMockUsernameGetter(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			auditCase := NewAuditCase(d.storeReader, d.authReader, d.userIDFetcher, d.usernameGetter)
+			entries, err := auditCase.ListAuditLog(t.Context(), tt.source, tt.actorUsername, tt.filter)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			actions := make([]string, 0, len(entries))
+			for _, entry := range entries {
+				assert.Equal(t, "admin", entry.Actor)
+				actions = append(actions, entry.Action)
+			}
+			assert.Equal(t, tt.expectedActions, actions)
+		})
+	}
+}
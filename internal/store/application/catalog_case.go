@@ -0,0 +1,343 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/audit"
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+)
+
+var categorySlugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+type CatalogCase struct {
+	txManager                database.TxManager
+	goodsRepository          domain.GoodsRepository
+	priceSchedulesRepository domain.PriceSchedulesRepository
+	variantsRepository       domain.VariantsRepository
+	categoriesRepository     domain.CategoriesRepository
+	goodDetailsUpdater       domain.GoodDetailsUpdater
+	bundlesRepository        domain.BundlesRepository
+	purchaseLimitsRepository domain.PurchaseLimitsRepository
+	auditRecorder            audit.Recorder
+}
+
+func NewCatalogCase(txManager database.TxManager, goodsRepository domain.GoodsRepository,
+	priceSchedulesRepository domain.PriceSchedulesRepository, variantsRepository domain.VariantsRepository,
+	categoriesRepository domain.CategoriesRepository, goodDetailsUpdater domain.GoodDetailsUpdater,
+	bundlesRepository domain.BundlesRepository, purchaseLimitsRepository domain.PurchaseLimitsRepository,
+	auditRecorder audit.Recorder) *CatalogCase {
+	return &CatalogCase{
+		txManager:                txManager,
+		goodsRepository:          goodsRepository,
+		priceSchedulesRepository: priceSchedulesRepository,
+		variantsRepository:       variantsRepository,
+		categoriesRepository:     categoriesRepository,
+		goodDetailsUpdater:       goodDetailsUpdater,
+		bundlesRepository:        bundlesRepository,
+		purchaseLimitsRepository: purchaseLimitsRepository,
+		auditRecorder:            auditRecorder,
+	}
+}
+
+// ListGoods returns the catalog together with the variants of each good and the components of each bundle.
+// A non-empty category slug keeps only the goods of that category.
+func (cc *CatalogCase) ListGoods(ctx context.Context, category string) ([]domain.CatalogGood, error) {
+	if category != "" {
+		_, err := cc.categoriesRepository.GetCategory(ctx, category)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	goods, err := cc.goodsRepository.ListGoods(ctx, category)
+	if err != nil {
+		return nil, err
+	}
+
+	variants, err := cc.variantsRepository.ListVariants(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	variantsByGood := make(map[int][]domain.Variant)
+	for _, variant := range variants {
+		variantsByGood[variant.GoodID] = append(variantsByGood[variant.GoodID], variant)
+	}
+
+	components, err := cc.bundlesRepository.ListComponents(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	componentsByBundle := make(map[int][]domain.BundleComponent)
+	for _, component := range components {
+		componentsByBundle[component.BundleID] = append(componentsByBundle[component.BundleID], component)
+	}
+
+	for i := range goods {
+		goods[i].Variants = variantsByGood[goods[i].Id]
+		goods[i].Components = componentsByBundle[goods[i].Id]
+	}
+
+	return goods, nil
+}
+
+func (cc *CatalogCase) GetPriceHistory(ctx context.Context, goodName string) (domain.PriceHistory, error) {
+	goodInfo, err := cc.goodsRepository.GetGoodInfo(ctx, goodName)
+	if err != nil {
+		return domain.PriceHistory{}, fmt.Errorf("failed to get good info: %w", err)
+	}
+
+	schedules, err := cc.priceSchedulesRepository.ListPriceSchedules(ctx, goodInfo.Id)
+	if err != nil {
+		return domain.PriceHistory{}, err
+	}
+
+	return domain.PriceHistory{
+		BasePrice: goodInfo.BasePrice,
+		Schedules: schedules,
+	}, nil
+}
+
+// SchedulePriceChange sets the price of a good from startsAt until endsAt. The price is given either directly
+// or as a discount percent off the base price. A zero startsAt starts the change right away, a zero endsAt
+// keeps it until a later schedule starts.
+func (cc *CatalogCase) SchedulePriceChange(ctx context.Context, goodName string, price, discountPercent uint32,
+	startsAt, endsAt time.Time) (int, error) {
+	if (price == 0) == (discountPercent == 0) {
+		return 0, &domain.InvalidArgumentsError{Msg: "either a price or a discount percent must be set"}
+	} else if discountPercent >= 100 {
+		return 0, &domain.InvalidArgumentsError{Msg: "discount percent must be between 1 and 99"}
+	}
+
+	now := time.Now()
+	if startsAt.IsZero() {
+		startsAt = now
+	}
+
+	if !endsAt.IsZero() && (!endsAt.After(startsAt) || !endsAt.After(now)) {
+		return 0, &domain.InvalidArgumentsError{Msg: "price change must end in the future and after it starts"}
+	}
+
+	goodInfo, err := cc.goodsRepository.GetGoodInfo(ctx, goodName)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get good info: %w", err)
+	}
+
+	if discountPercent != 0 {
+		price = domain.DiscountedPrice(goodInfo.BasePrice, discountPercent)
+		if price == 0 {
+			return 0, &domain.InvalidArgumentsError{Msg: "discounted price must stay positive"}
+		}
+	}
+
+	schedule := domain.PriceSchedule{
+		GoodID:   goodInfo.Id,
+		Price:    price,
+		StartsAt: startsAt,
+		EndsAt:   endsAt,
+	}
+
+	after := map[string]any{
+		"price":    price,
+		"startsAt": startsAt.UTC().Format(time.RFC3339),
+	}
+	if !endsAt.IsZero() {
+		after["endsAt"] = endsAt.UTC().Format(time.RFC3339)
+	}
+
+	var scheduleID int
+	err = cc.txManager.WithinTransaction(ctx, func(ctx context.Context, executor database.QueryExecuter) error {
+		scheduleID, err = cc.priceSchedulesRepository.CreatePriceSchedule(ctx, executor, schedule)
+		if err != nil {
+			return err
+		}
+
+		err = cc.auditRecorder.RecordInTx(ctx, executor, audit.Event{
+			Action: audit.ActionPriceSchedule,
+			Target: audit.GoodTarget(goodName),
+			Before: map[string]any{"basePrice": goodInfo.BasePrice},
+			After:  after,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record audit event: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return scheduleID, nil
+}
+
+func (cc *CatalogCase) ListCategories(ctx context.Context) ([]domain.Category, error) {
+	return cc.categoriesRepository.ListCategories(ctx)
+}
+
+// CreateCategory creates a category with a lowercase slug of letters, digits and dashes.
+func (cc *CatalogCase) CreateCategory(ctx context.Context, slug, name string) (int, error) {
+	slug = strings.ToLower(strings.TrimSpace(slug))
+	name = strings.TrimSpace(name)
+
+	if len(slug) > domain.MaxCategorySlugLength || !categorySlugPattern.MatchString(slug) {
+		return 0, &domain.InvalidArgumentsError{Msg: fmt.Sprintf(
+			"category slug must be up to %d lowercase letters, digits and dashes", domain.MaxCategorySlugLength)}
+	} else if name == "" || utf8.RuneCountInString(name) > domain.MaxCategoryNameLength {
+		return 0, &domain.InvalidArgumentsError{Msg: fmt.Sprintf(
+			"category name must be between 1 and %d characters", domain.MaxCategoryNameLength)}
+	}
+
+	var categoryID int
+	err := cc.txManager.WithinTransaction(ctx, func(ctx context.Context, executor database.QueryExecuter) error {
+		var err error
+		categoryID, err = cc.categoriesRepository.CreateCategory(ctx, executor, domain.Category{Slug: slug, Name: name})
+		if err != nil {
+			return err
+		}
+
+		err = cc.auditRecorder.RecordInTx(ctx, executor, audit.Event{
+			Action: audit.ActionCategoryCreate,
+			Target: audit.CategoryTarget(slug),
+			After:  map[string]any{"name": name},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record audit event: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return categoryID, nil
+}
+
+// UpdateGoodDetails replaces the category, description and images of a good. An empty category slug leaves
+// the good uncategorized.
+func (cc *CatalogCase) UpdateGoodDetails(ctx context.Context, goodName, category, description string,
+	images []domain.GoodImage) error {
+	err := validateGoodDetails(description, images)
+	if err != nil {
+		return err
+	}
+
+	details := domain.GoodDetails{
+		Description: description,
+		Images:      images,
+	}
+
+	if category != "" {
+		found, err := cc.categoriesRepository.GetCategory(ctx, category)
+		if err != nil {
+			return err
+		}
+
+		details.CategoryID = found.Id
+	}
+
+	goodInfo, err := cc.goodsRepository.GetGoodInfo(ctx, goodName)
+	if err != nil {
+		return fmt.Errorf("failed to get good info: %w", err)
+	}
+
+	return cc.txManager.WithinTransaction(ctx, func(ctx context.Context, executor database.QueryExecuter) error {
+		err := cc.goodDetailsUpdater.UpdateGoodDetails(ctx, executor, goodInfo.Id, details)
+		if err != nil {
+			return err
+		}
+
+		err = cc.auditRecorder.RecordInTx(ctx, executor, audit.Event{
+			Action: audit.ActionGoodUpdate,
+			Target: audit.GoodTarget(goodName),
+			After: map[string]any{
+				"category":    category,
+				"description": description,
+				"images":      len(images),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record audit event: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// SetPurchaseLimit caps the units of a good a user can get within a rolling period, or for good when the period
+// is zero. A zero maxQuantity removes the limit. Bundles are limited through the limits of their components.
+func (cc *CatalogCase) SetPurchaseLimit(ctx context.Context, goodName string, maxQuantity uint32, period time.Duration) error {
+	if period > domain.MaxPurchaseLimitPeriod {
+		return &domain.InvalidArgumentsError{Msg: fmt.Sprintf("limit period must not exceed %d days",
+			int(domain.MaxPurchaseLimitPeriod.Hours()/24))}
+	}
+
+	goodInfo, err := cc.goodsRepository.GetGoodInfo(ctx, goodName)
+	if err != nil {
+		return fmt.Errorf("failed to get good info: %w", err)
+	}
+
+	if goodInfo.Bundle {
+		return &domain.InvalidArgumentsError{Msg: "bundles are limited through the limits of their items"}
+	}
+
+	return cc.txManager.WithinTransaction(ctx, func(ctx context.Context, executor database.QueryExecuter) error {
+		if maxQuantity == 0 {
+			err = cc.purchaseLimitsRepository.RemovePurchaseLimit(ctx, executor, goodInfo.Id)
+		} else {
+			err = cc.purchaseLimitsRepository.SetPurchaseLimit(ctx, executor, domain.PurchaseLimit{
+				GoodID:      goodInfo.Id,
+				GoodName:    goodInfo.Name,
+				MaxQuantity: maxQuantity,
+				Period:      period,
+			})
+		}
+		if err != nil {
+			return err
+		}
+
+		err = cc.auditRecorder.RecordInTx(ctx, executor, audit.Event{
+			Action: audit.ActionPurchaseLimitSet,
+			Target: audit.GoodTarget(goodName),
+			After: map[string]any{
+				"maxQuantity": maxQuantity,
+				"periodDays":  int(period.Hours() / 24),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record audit event: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func validateGoodDetails(description string, images []domain.GoodImage) error {
+	if utf8.RuneCountInString(description) > domain.MaxDescriptionLength {
+		return &domain.InvalidArgumentsError{Msg: fmt.Sprintf("description must not exceed %d characters", domain.MaxDescriptionLength)}
+	} else if len(images) > domain.MaxGoodImages {
+		return &domain.InvalidArgumentsError{Msg: fmt.Sprintf("a good can have at most %d images", domain.MaxGoodImages)}
+	}
+
+	for _, image := range images {
+		imageURL, err := url.Parse(image.URL)
+		if err != nil || (imageURL.Scheme != "http" && imageURL.Scheme != "https") || imageURL.Host == "" {
+			return &domain.InvalidArgumentsError{Msg: fmt.Sprintf("image url %q must be an absolute http(s) url", image.URL)}
+		}
+
+		if utf8.RuneCountInString(image.AltText) > domain.MaxImageAltTextLength {
+			return &domain.InvalidArgumentsError{Msg: fmt.Sprintf("image alt text must not exceed %d characters", domain.MaxImageAltTextLength)}
+		}
+	}
+
+	return nil
+}
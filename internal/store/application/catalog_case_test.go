@@ -0,0 +1,685 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	auditmocks "github.com/Lexv0lk/merch-store/gen/mocks/audit"
+	dbmocks "github.com/Lexv0lk/merch-store/gen/mocks/database"
+	storemocks "github.com/Lexv0lk/merch-store/gen/mocks/store"
+	"github.com/Lexv0lk/merch-store/internal/pkg/audit"
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCatalogCase_ListGoods(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		goodsRepository    *storemocks.MockGoodsRepository
+		variantsRepository *storemocks.MockVariantsRepository
+		bundlesRepository  *storemocks.MockBundlesRepository
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	d := &deps{
+		goodsRepository:    storemocks.NewMockGoodsRepository(ctrl),
+		variantsRepository: storemocks.NewMockVariantsRepository(ctrl),
+		bundlesRepository:  storemocks.NewMockBundlesRepository(ctrl),
+	}
+
+	sizeS := domain.Variant{Id: 1, GoodID: 6, SKU: "hoody-s", Attributes: map[string]string{"size": "S"}}
+	sizeM := domain.Variant{Id: 2, GoodID: 6, SKU: "hoody-m", Attributes: map[string]string{"size": "M"}}
+
+	cups := domain.BundleComponent{BundleID: 9, GoodID: 2, GoodName: "cup", Quantity: 2}
+
+	d.goodsRepository.EXPECT().ListGoods(gomock.Any(), "").Return([]domain.CatalogGood{
+		{Id: 2, Name: "cup", Price: 20, BasePrice: 20},
+		{Id: 6, Name: "hoody", Price: 300, BasePrice: 300},
+		{Id: 9, Name: "onboarding-kit", Price: 30, BasePrice: 30},
+	}, nil)
+	d.variantsRepository.EXPECT().ListVariants(gomock.Any()).Return([]domain.Variant{sizeS, sizeM}, nil)
+	d.bundlesRepository.EXPECT().ListComponents(gomock.Any()).Return([]domain.BundleComponent{cups}, nil)
+
+	catalogCase := NewCatalogCase(dbmocks.NewMockTxManager(ctrl), d.goodsRepository,
+		storemocks.NewMockPriceSchedulesRepository(ctrl), d.variantsRepository,
+		storemocks.NewMockCategoriesRepository(ctrl), storemocks.NewMockGoodDetailsUpdater(ctrl), d.bundlesRepository,
+		storemocks.NewMockPurchaseLimitsRepository(ctrl), auditmocks.NewMockRecorder(ctrl))
+	goods, err := catalogCase.ListGoods(t.Context(), "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.CatalogGood{
+		{Id: 2, Name: "cup", Price: 20, BasePrice: 20},
+		{Id: 6, Name: "hoody", Price: 300, BasePrice: 300, Variants: []domain.Variant{sizeS, sizeM}},
+		{Id: 9, Name: "onboarding-kit", Price: 30, BasePrice: 30, Components: []domain.BundleComponent{cups}},
+	}, goods)
+}
+
+func TestCatalogCase_ListGoodsByCategory(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		goodsRepository      *storemocks.MockGoodsRepository
+		variantsRepository   *storemocks.MockVariantsRepository
+		categoriesRepository *storemocks.MockCategoriesRepository
+		bundlesRepository    *storemocks.MockBundlesRepository
+	}
+
+	type testCase struct {
+		name string
+
+		prepareFn func(d *deps)
+
+		expectedGoods []domain.CatalogGood
+		expectedErr   error
+	}
+
+	apparel := domain.Category{Id: 1, Slug: "apparel", Name: "Apparel"}
+
+	tests := []testCase{
+		{
+			name: "known category",
+			prepareFn: func(d *deps) {
+				d.categoriesRepository.EXPECT().GetCategory(gomock.Any(), "apparel").Return(apparel, nil)
+				d.goodsRepository.EXPECT().ListGoods(gomock.Any(), "apparel").Return([]domain.CatalogGood{
+					{Id: 1, Name: "t-shirt", Price: 80, BasePrice: 80, Category: "apparel"},
+				}, nil)
+				d.variantsRepository.EXPECT().ListVariants(gomock.Any()).Return(nil, nil)
+				d.bundlesRepository.EXPECT().ListComponents(gomock.Any()).Return(nil, nil)
+			},
+			expectedGoods: []domain.CatalogGood{
+				{Id: 1, Name: "t-shirt", Price: 80, BasePrice: 80, Category: "apparel"},
+			},
+		},
+		{
+			name: "unknown category",
+			prepareFn: func(d *deps) {
+				d.categoriesRepository.EXPECT().GetCategory(gomock.Any(), "apparel").
+					Return(domain.Category{}, &domain.CategoryNotFoundError{})
+			},
+			expectedErr: &domain.CategoryNotFoundError{},
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := &deps{
+				goodsRepository:      storemocks.NewMockGoodsRepository(ctrl),
+				variantsRepository:   storemocks.NewMockVariantsRepository(ctrl),
+				categoriesRepository: storemocks.NewMockCategoriesRepository(ctrl),
+				bundlesRepository:    storemocks.NewMockBundlesRepository(ctrl),
+			}
+
+			tt.prepareFn(d)
+
+			catalogCase := NewCatalogCase(dbmocks.NewMockTxManager(ctrl), d.goodsRepository,
+				storemocks.NewMockPriceSchedulesRepository(ctrl), d.variantsRepository, d.categoriesRepository,
+				storemocks.NewMockGoodDetailsUpdater(ctrl), d.bundlesRepository,
+				storemocks.NewMockPurchaseLimitsRepository(ctrl), auditmocks.NewMockRecorder(ctrl))
+			goods, err := catalogCase.ListGoods(t.Context(), "apparel")
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedGoods, goods)
+			}
+		})
+	}
+}
+
+func TestCatalogCase_SchedulePriceChange(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		txManager                *dbmocks.MockTxManager
+		goodsRepository          *storemocks.MockGoodsRepository
+		priceSchedulesRepository *storemocks.MockPriceSchedulesRepository
+		auditRecorder            *auditmocks.MockRecorder
+	}
+
+	type testCase struct {
+		name            string
+		price           uint32
+		discountPercent uint32
+		startsAt        time.Time
+		endsAt          time.Time
+
+		prepareFn func(t *testing.T, d *deps)
+
+		expectedID  int
+		expectedErr error
+	}
+
+	executeTxFn := func(ctx context.Context, txFn database.TxFunc) error {
+		return txFn(ctx, nil)
+	}
+
+	hoody := domain.GoodInfo{Id: 7, Name: "hoody", Price: 300, BasePrice: 300}
+	startsAt := time.Now().Add(24 * time.Hour)
+	endsAt := startsAt.Add(11 * 24 * time.Hour)
+
+	tests := []testCase{
+		{
+			name:            "percent off for a period",
+			discountPercent: 30,
+			startsAt:        startsAt,
+			endsAt:          endsAt,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "hoody").Return(hoody, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.priceSchedulesRepository.EXPECT().
+					CreatePriceSchedule(gomock.Any(), nil, domain.PriceSchedule{GoodID: 7, Price: 210, StartsAt: startsAt, EndsAt: endsAt}).
+					Return(2, nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ database.Executor, event audit.Event) error {
+						assert.Equal(t, audit.ActionPriceSchedule, event.Action)
+						assert.Equal(t, "good:hoody", event.Target)
+						return nil
+					})
+			},
+			expectedID: 2,
+		},
+		{
+			name:  "open-ended price starting now",
+			price: 250,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "hoody").Return(hoody, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.priceSchedulesRepository.EXPECT().CreatePriceSchedule(gomock.Any(), nil, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ database.Querier, schedule domain.PriceSchedule) (int, error) {
+						assert.Equal(t, uint32(250), schedule.Price)
+						assert.False(t, schedule.StartsAt.IsZero())
+						assert.True(t, schedule.EndsAt.IsZero())
+						return 3, nil
+					})
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, gomock.Any()).Return(nil)
+			},
+			expectedID: 3,
+		},
+		{
+			name:            "both price and discount",
+			price:           250,
+			discountPercent: 30,
+			prepareFn:       func(t *testing.T, d *deps) {},
+			expectedErr:     &domain.InvalidArgumentsError{},
+		},
+		{
+			name:        "neither price nor discount",
+			prepareFn:   func(t *testing.T, d *deps) {},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:            "full discount",
+			discountPercent: 100,
+			prepareFn:       func(t *testing.T, d *deps) {},
+			expectedErr:     &domain.InvalidArgumentsError{},
+		},
+		{
+			name:        "ends before it starts",
+			price:       250,
+			startsAt:    endsAt,
+			endsAt:      startsAt,
+			prepareFn:   func(t *testing.T, d *deps) {},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:  "unknown good",
+			price: 250,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "hoody").Return(domain.GoodInfo{}, &domain.GoodNotFoundError{})
+			},
+			expectedErr: &domain.GoodNotFoundError{},
+		},
+		{
+			name:  "audit record error rolls back the schedule",
+			price: 250,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "hoody").Return(hoody, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.priceSchedulesRepository.EXPECT().CreatePriceSchedule(gomock.Any(), nil, gomock.Any()).Return(3, nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, gomock.Any()).Return(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := &deps{
+				txManager:                dbmocks.NewMockTxManager(ctrl),
+				goodsRepository:          storemocks.NewMockGoodsRepository(ctrl),
+				priceSchedulesRepository: storemocks.NewMockPriceSchedulesRepository(ctrl),
+				auditRecorder:            auditmocks.NewMockRecorder(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			catalogCase := NewCatalogCase(d.txManager, d.goodsRepository, d.priceSchedulesRepository,
+				storemocks.NewMockVariantsRepository(ctrl), storemocks.NewMockCategoriesRepository(ctrl),
+				storemocks.NewMockGoodDetailsUpdater(ctrl), storemocks.NewMockBundlesRepository(ctrl),
+				storemocks.NewMockPurchaseLimitsRepository(ctrl), d.auditRecorder)
+			scheduleID, err := catalogCase.SchedulePriceChange(t.Context(), "hoody", tt.price, tt.discountPercent,
+				tt.startsAt, tt.endsAt)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedID, scheduleID)
+			}
+		})
+	}
+}
+
+func TestCatalogCase_GetPriceHistory(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		goodsRepository          *storemocks.MockGoodsRepository
+		priceSchedulesRepository *storemocks.MockPriceSchedulesRepository
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	d := &deps{
+		goodsRepository:          storemocks.NewMockGoodsRepository(ctrl),
+		priceSchedulesRepository: storemocks.NewMockPriceSchedulesRepository(ctrl),
+	}
+
+	startsAt := time.Date(2026, 12, 20, 0, 0, 0, 0, time.UTC)
+	schedules := []domain.PriceSchedule{{Id: 2, GoodID: 7, Price: 210, StartsAt: startsAt, EndsAt: startsAt.AddDate(0, 0, 12)}}
+
+	d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "hoody").
+		Return(domain.GoodInfo{Id: 7, Name: "hoody", Price: 210, BasePrice: 300}, nil)
+	d.priceSchedulesRepository.EXPECT().ListPriceSchedules(gomock.Any(), 7).Return(schedules, nil)
+
+	catalogCase := NewCatalogCase(dbmocks.NewMockTxManager(ctrl), d.goodsRepository, d.priceSchedulesRepository,
+		storemocks.NewMockVariantsRepository(ctrl), storemocks.NewMockCategoriesRepository(ctrl),
+		storemocks.NewMockGoodDetailsUpdater(ctrl), storemocks.NewMockBundlesRepository(ctrl),
+		storemocks.NewMockPurchaseLimitsRepository(ctrl), auditmocks.NewMockRecorder(ctrl))
+	history, err := catalogCase.GetPriceHistory(t.Context(), "hoody")
+
+	assert.NoError(t, err)
+	assert.Equal(t, domain.PriceHistory{BasePrice: 300, Schedules: schedules}, history)
+}
+
+func TestCatalogCase_CreateCategory(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		txManager            *dbmocks.MockTxManager
+		categoriesRepository *storemocks.MockCategoriesRepository
+		auditRecorder        *auditmocks.MockRecorder
+	}
+
+	type testCase struct {
+		name         string
+		slug         string
+		categoryName string
+
+		prepareFn func(t *testing.T, d *deps)
+
+		expectedID  int
+		expectedErr error
+	}
+
+	executeTxFn := func(ctx context.Context, txFn database.TxFunc) error {
+		return txFn(ctx, nil)
+	}
+
+	tests := []testCase{
+		{
+			name:         "new category",
+			slug:         " Home-Office ",
+			categoryName: "Home office",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.categoriesRepository.EXPECT().
+					CreateCategory(gomock.Any(), nil, domain.Category{Slug: "home-office", Name: "Home office"}).
+					Return(5, nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ database.Executor, event audit.Event) error {
+						assert.Equal(t, audit.ActionCategoryCreate, event.Action)
+						assert.Equal(t, "category:home-office", event.Target)
+						return nil
+					})
+			},
+			expectedID: 5,
+		},
+		{
+			name:         "slug with spaces",
+			slug:         "home office",
+			categoryName: "Home office",
+			prepareFn:    func(t *testing.T, d *deps) {},
+			expectedErr:  &domain.InvalidArgumentsError{},
+		},
+		{
+			name:         "empty name",
+			slug:         "home-office",
+			categoryName: " ",
+			prepareFn:    func(t *testing.T, d *deps) {},
+			expectedErr:  &domain.InvalidArgumentsError{},
+		},
+		{
+			name:         "existing category",
+			slug:         "apparel",
+			categoryName: "Apparel",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.categoriesRepository.EXPECT().CreateCategory(gomock.Any(), nil, gomock.Any()).
+					Return(0, &domain.CategoryExistingError{})
+			},
+			expectedErr: &domain.CategoryExistingError{},
+		},
+		{
+			name:         "audit failure",
+			slug:         "home-office",
+			categoryName: "Home office",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.categoriesRepository.EXPECT().CreateCategory(gomock.Any(), nil, gomock.Any()).Return(5, nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, gomock.Any()).Return(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := &deps{
+				txManager:            dbmocks.NewMockTxManager(ctrl),
+				categoriesRepository: storemocks.NewMockCategoriesRepository(ctrl),
+				auditRecorder:        auditmocks.NewMockRecorder(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			catalogCase := NewCatalogCase(d.txManager, storemocks.NewMockGoodsRepository(ctrl),
+				storemocks.NewMockPriceSchedulesRepository(ctrl), storemocks.NewMockVariantsRepository(ctrl),
+				d.categoriesRepository, storemocks.NewMockGoodDetailsUpdater(ctrl),
+				storemocks.NewMockBundlesRepository(ctrl), storemocks.NewMockPurchaseLimitsRepository(ctrl),
+				d.auditRecorder)
+			categoryID, err := catalogCase.CreateCategory(t.Context(), tt.slug, tt.categoryName)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedID, categoryID)
+			}
+		})
+	}
+}
+
+func TestCatalogCase_UpdateGoodDetails(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		txManager            *dbmocks.MockTxManager
+		goodsRepository      *storemocks.MockGoodsRepository
+		categoriesRepository *storemocks.MockCategoriesRepository
+		goodDetailsUpdater   *storemocks.MockGoodDetailsUpdater
+		auditRecorder        *auditmocks.MockRecorder
+	}
+
+	type testCase struct {
+		name     string
+		category string
+		images   []domain.GoodImage
+
+		prepareFn func(t *testing.T, d *deps)
+
+		expectedErr error
+	}
+
+	executeTxFn := func(ctx context.Context, txFn database.TxFunc) error {
+		return txFn(ctx, nil)
+	}
+
+	hoody := domain.GoodInfo{Id: 7, Name: "hoody", Price: 300, BasePrice: 300}
+	apparel := domain.Category{Id: 1, Slug: "apparel", Name: "Apparel"}
+	front := domain.GoodImage{URL: "https://cdn.example.com/hoody-front.png", AltText: "Hoody, front", Width: 800, Height: 800}
+
+	tests := []testCase{
+		{
+			name:     "category and images",
+			category: "apparel",
+			images:   []domain.GoodImage{front},
+			prepareFn: func(t *testing.T, d *deps) {
+				d.categoriesRepository.EXPECT().GetCategory(gomock.Any(), "apparel").Return(apparel, nil)
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "hoody").Return(hoody, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.goodDetailsUpdater.EXPECT().UpdateGoodDetails(gomock.Any(), nil, 7, domain.GoodDetails{
+					CategoryID:  1,
+					Description: "Warm hoody",
+					Images:      []domain.GoodImage{front},
+				}).Return(nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ database.Executor, event audit.Event) error {
+						assert.Equal(t, audit.ActionGoodUpdate, event.Action)
+						assert.Equal(t, "good:hoody", event.Target)
+						return nil
+					})
+			},
+		},
+		{
+			name: "uncategorized",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "hoody").Return(hoody, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.goodDetailsUpdater.EXPECT().
+					UpdateGoodDetails(gomock.Any(), nil, 7, domain.GoodDetails{Description: "Warm hoody"}).
+					Return(nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "audit failure",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "hoody").Return(hoody, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.goodDetailsUpdater.EXPECT().UpdateGoodDetails(gomock.Any(), nil, 7, gomock.Any()).Return(nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, gomock.Any()).Return(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+		{
+			name:        "relative image url",
+			images:      []domain.GoodImage{{URL: "/images/hoody.png"}},
+			prepareFn:   func(t *testing.T, d *deps) {},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:        "too many images",
+			images:      make([]domain.GoodImage, domain.MaxGoodImages+1),
+			prepareFn:   func(t *testing.T, d *deps) {},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:     "unknown category",
+			category: "toys",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.categoriesRepository.EXPECT().GetCategory(gomock.Any(), "toys").
+					Return(domain.Category{}, &domain.CategoryNotFoundError{})
+			},
+			expectedErr: &domain.CategoryNotFoundError{},
+		},
+		{
+			name: "unknown good",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "hoody").
+					Return(domain.GoodInfo{}, &domain.GoodNotFoundError{})
+			},
+			expectedErr: &domain.GoodNotFoundError{},
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := &deps{
+				txManager:            dbmocks.NewMockTxManager(ctrl),
+				goodsRepository:      storemocks.NewMockGoodsRepository(ctrl),
+				categoriesRepository: storemocks.NewMockCategoriesRepository(ctrl),
+				goodDetailsUpdater:   storemocks.NewMockGoodDetailsUpdater(ctrl),
+				auditRecorder:        auditmocks.NewMockRecorder(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			catalogCase := NewCatalogCase(d.txManager, d.goodsRepository,
+				storemocks.NewMockPriceSchedulesRepository(ctrl), storemocks.NewMockVariantsRepository(ctrl),
+				d.categoriesRepository, d.goodDetailsUpdater, storemocks.NewMockBundlesRepository(ctrl),
+				storemocks.NewMockPurchaseLimitsRepository(ctrl), d.auditRecorder)
+			err := catalogCase.UpdateGoodDetails(t.Context(), "hoody", tt.category, "Warm hoody", tt.images)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCatalogCase_SetPurchaseLimit(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		txManager                *dbmocks.MockTxManager
+		goodsRepository          *storemocks.MockGoodsRepository
+		purchaseLimitsRepository *storemocks.MockPurchaseLimitsRepository
+		auditRecorder            *auditmocks.MockRecorder
+	}
+
+	type testCase struct {
+		name        string
+		maxQuantity uint32
+		period      time.Duration
+
+		prepareFn func(t *testing.T, d *deps)
+
+		expectedErr error
+	}
+
+	executeTxFn := func(ctx context.Context, txFn database.TxFunc) error {
+		return txFn(ctx, nil)
+	}
+
+	pinkHoody := domain.GoodInfo{Id: 8, Name: "pink-hoody", Price: 500, BasePrice: 500}
+	quarter := 90 * 24 * time.Hour
+
+	tests := []testCase{
+		{
+			name:        "limit per quarter",
+			maxQuantity: 3,
+			period:      quarter,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "pink-hoody").Return(pinkHoody, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.purchaseLimitsRepository.EXPECT().SetPurchaseLimit(gomock.Any(), nil, domain.PurchaseLimit{
+					GoodID: 8, GoodName: "pink-hoody", MaxQuantity: 3, Period: quarter,
+				}).Return(nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ database.Executor, event audit.Event) error {
+						assert.Equal(t, audit.ActionPurchaseLimitSet, event.Action)
+						assert.Equal(t, "good:pink-hoody", event.Target)
+						return nil
+					})
+			},
+		},
+		{
+			name: "limit removed",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "pink-hoody").Return(pinkHoody, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.purchaseLimitsRepository.EXPECT().RemovePurchaseLimit(gomock.Any(), nil, 8).Return(nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:        "audit failure",
+			maxQuantity: 3,
+			period:      quarter,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "pink-hoody").Return(pinkHoody, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.purchaseLimitsRepository.EXPECT().SetPurchaseLimit(gomock.Any(), nil, gomock.Any()).Return(nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, gomock.Any()).Return(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+		{
+			name:        "period too long",
+			maxQuantity: 1,
+			period:      domain.MaxPurchaseLimitPeriod + 24*time.Hour,
+			prepareFn:   func(t *testing.T, d *deps) {},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:        "bundle",
+			maxQuantity: 1,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "pink-hoody").
+					Return(domain.GoodInfo{Id: 8, Name: "pink-hoody", Bundle: true}, nil)
+			},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := &deps{
+				txManager:                dbmocks.NewMockTxManager(ctrl),
+				goodsRepository:          storemocks.NewMockGoodsRepository(ctrl),
+				purchaseLimitsRepository: storemocks.NewMockPurchaseLimitsRepository(ctrl),
+				auditRecorder:            auditmocks.NewMockRecorder(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			catalogCase := NewCatalogCase(d.txManager, d.goodsRepository,
+				storemocks.NewMockPriceSchedulesRepository(ctrl), storemocks.NewMockVariantsRepository(ctrl),
+				storemocks.NewMockCategoriesRepository(ctrl), storemocks.NewMockGoodDetailsUpdater(ctrl),
+				storemocks.NewMockBundlesRepository(ctrl), d.purchaseLimitsRepository, d.auditRecorder)
+			err := catalogCase.SetPurchaseLimit(t.Context(), "pink-hoody", tt.maxQuantity, tt.period)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/audit"
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+)
+
+const deactivationSweepReason = "deactivation sweep"
+
+type DeactivationCase struct {
+	txManager          database.TxManager
+	userIDFetcher      domain.UserIDFetcher
+	userDeactivator    domain.UserDeactivator
+	balanceCreator     domain.BalanceEnsurer
+	balanceLocker      domain.UserBalanceLocker
+	balanceDeactivator domain.BalanceDeactivator
+	companyPool        domain.CompanyPool
+	preorderProceeder  domain.PreorderProceeder
+	listingsSeller     domain.ListingsSeller
+	transfersExecutor  domain.ScheduledTransfersExecutor
+	bidsProceeder      domain.AuctionBidsProceeder
+	ticketsProceeder   domain.RaffleTicketsProceeder
+	webhookPublisher   domain.WebhookPublisher
+	eventOutbox        domain.EventOutbox
+	auditRecorder      audit.Recorder
+}
+
+func NewDeactivationCase(txManager database.TxManager,
+	userIDFetcher domain.UserIDFetcher,
+	userDeactivator domain.UserDeactivator,
+	balanceCreator domain.BalanceEnsurer,
+	balanceLocker domain.UserBalanceLocker,
+	balanceDeactivator domain.BalanceDeactivator,
+	companyPool domain.CompanyPool,
+	preorderProceeder domain.PreorderProceeder,
+	listingsSeller domain.ListingsSeller,
+	transfersExecutor domain.ScheduledTransfersExecutor,
+	bidsProceeder domain.AuctionBidsProceeder,
+	ticketsProceeder domain.RaffleTicketsProceeder,
+	webhookPublisher domain.WebhookPublisher,
+	eventOutbox domain.EventOutbox,
+	auditRecorder audit.Recorder) *DeactivationCase {
+	return &DeactivationCase{
+		txManager:          txManager,
+		userIDFetcher:      userIDFetcher,
+		userDeactivator:    userDeactivator,
+		balanceCreator:     balanceCreator,
+		balanceLocker:      balanceLocker,
+		balanceDeactivator: balanceDeactivator,
+		companyPool:        companyPool,
+		preorderProceeder:  preorderProceeder,
+		listingsSeller:     listingsSeller,
+		transfersExecutor:  transfersExecutor,
+		bidsProceeder:      bidsProceeder,
+		ticketsProceeder:   ticketsProceeder,
+		webhookPublisher:   webhookPublisher,
+		eventOutbox:        eventOutbox,
+		auditRecorder:      auditRecorder,
+	}
+}
+
+// DeactivateUser blocks the user in auth and store and optionally sweeps the remaining balance into the company pool.
+// Active listings and scheduled transfers of the user are cancelled first, while held bids, tickets in open raffles
+// and pending pre-orders are refunded, so their coins are returned to the balance before it is swept.
+func (dc *DeactivationCase) DeactivateUser(ctx context.Context, username string, sweepBalance bool) (uint32, error) {
+	userID, err := dc.userIDFetcher.FetchUserID(ctx, username)
+	if err != nil {
+		return 0, &domain.UserNotFoundError{Msg: fmt.Sprintf("user not found: %s", username)}
+	}
+
+	err = dc.balanceCreator.EnsureBalanceCreated(ctx, userID, domain.StartBalance)
+	if err != nil {
+		return 0, fmt.Errorf("failed to ensure balance for user %d: %w", userID, err)
+	}
+
+	var swept uint32
+	err = dc.txManager.WithinTransaction(ctx, func(ctx context.Context, executor database.QueryExecuter) error {
+		// Listings, scheduled transfers and auctions are locked before the balance, raffles and pre-orders after it,
+		// the same order purchases, scheduled runs, bids and ticket sales lock them in.
+		cancelledListings, err := dc.listingsSeller.CancelSellerListings(ctx, executor, userID)
+		if err != nil {
+			return err
+		}
+
+		cancelledTransfers, err := dc.transfersExecutor.CancelSenderScheduledTransfers(ctx, executor, userID)
+		if err != nil {
+			return err
+		}
+
+		refundedBids, err := dc.bidsProceeder.RefundUserBids(ctx, executor, userID)
+		if err != nil {
+			return err
+		}
+
+		for _, bid := range refundedBids {
+			err = dc.recordRefund(ctx, executor, userID, username, bid.Amount)
+			if err != nil {
+				return err
+			}
+		}
+
+		balance, err := dc.balanceLocker.LockAndGetUserBalance(ctx, executor, userID)
+		if err != nil {
+			return fmt.Errorf("failed to lock and get balance for user %d: %w", userID, err)
+		}
+
+		preorders, err := dc.preorderProceeder.LockUserPendingPreorders(ctx, executor, userID)
+		if err != nil {
+			return err
+		}
+
+		for _, preorder := range preorders {
+			err = cancelPreorder(ctx, executor, dc.preorderProceeder, dc.webhookPublisher, dc.eventOutbox,
+				dc.auditRecorder, preorder, audit.RefundReasonDeactivation)
+			if err != nil {
+				return err
+			}
+
+			balance += preorder.Price
+		}
+
+		refundedTickets, err := dc.ticketsProceeder.RefundUserTickets(ctx, executor, userID)
+		if err != nil {
+			return err
+		}
+
+		if refundedTickets > 0 {
+			err = dc.recordRefund(ctx, executor, userID, username, refundedTickets)
+			if err != nil {
+				return err
+			}
+		}
+
+		balance += refundedTickets
+
+		err = dc.balanceDeactivator.DeactivateBalance(ctx, executor, userID)
+		if err != nil {
+			return fmt.Errorf("failed to deactivate balance for user %d: %w", userID, err)
+		}
+
+		if sweepBalance && balance > 0 {
+			err = dc.companyPool.TransferToPool(ctx, executor, userID, balance, deactivationSweepReason)
+			if err != nil {
+				return fmt.Errorf("failed to sweep balance to company pool: %w", err)
+			}
+
+			swept = balance
+		}
+
+		err = dc.auditRecorder.RecordInTx(ctx, executor, audit.Event{
+			Action: audit.ActionAccountDeactivate,
+			Target: audit.UserTarget(username),
+			Before: map[string]any{"balance": balance},
+			After: map[string]any{
+				"sweptAmount":                 swept,
+				"cancelledPreorders":          len(preorders),
+				"cancelledListings":           cancelledListings,
+				"cancelledScheduledTransfers": cancelledTransfers,
+				"refundedBids":                len(refundedBids),
+				"refundedTicketsAmount":       refundedTickets,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record audit event: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	// Auth is only called once the store changes are committed, so a failed store transaction never leaves a user
+	// locked out with the balance still active. If auth fails, repeating the deactivation completes it.
+	err = dc.userDeactivator.DeactivateUser(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to deactivate user %d: %w", userID, err)
+	}
+
+	return swept, nil
+}
+
+// recordRefund records coins of a bid or raffle tickets returned to the deactivated user. The user is the target,
+// as the refunds of a deactivation are made for the account rather than for a single auction or raffle.
+func (dc *DeactivationCase) recordRefund(ctx context.Context, executor database.Executor, userID int, username string,
+	amount uint32) error {
+	err := dc.auditRecorder.RecordInTx(ctx, executor, audit.RefundEvent(audit.UserTarget(username), userID, amount,
+		audit.RefundReasonDeactivation))
+	if err != nil {
+		return fmt.Errorf("failed to record audit event: %w", err)
+	}
+
+	return nil
+}
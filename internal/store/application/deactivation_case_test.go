@@ -0,0 +1,386 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	auditmocks "github.com/Lexv0lk/merch-store/gen/mocks/audit"
+	dbmocks "github.com/Lexv0lk/merch-store/gen/mocks/database"
+	storemocks "github.com/Lexv0lk/merch-store/gen/mocks/store"
+	"github.com/Lexv0lk/merch-store/internal/pkg/audit"
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeactivationCase_DeactivateUser(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		txManager          *dbmocks.MockTxManager
+		userIDFetcher      *storemocks.MockUserIDFetcher
+		userDeactivator    *storemocks.MockUserDeactivator
+		balanceCreator     *storemocks.MockBalanceEnsurer
+		balanceLocker      *storemocks.MockUserBalanceLocker
+		balanceDeactivator *storemocks.MockBalanceDeactivator
+		companyPool        *storemocks.MockCompanyPool
+		preorderProceeder  *storemocks.MockPreorderProceeder
+		listingsSeller     *storemocks.MockListingsSeller
+		transfersExecutor  *storemocks.MockScheduledTransfersExecutor
+		bidsProceeder      *storemocks.MockAuctionBidsProceeder
+		ticketsProceeder   *storemocks.MockRaffleTicketsProceeder
+		webhookPublisher   *storemocks.MockWebhookPublisher
+		eventOutbox        *storemocks.MockEventOutbox
+		auditRecorder      *auditmocks.MockRecorder
+	}
+
+	type testCase struct {
+		name         string
+		username     string
+		sweepBalance bool
+
+		prepareFn func(t *testing.T, d *deps)
+
+		expectedSwept uint32
+		expectedErr   error
+	}
+
+	executeTxFn := func(ctx context.Context, txFn database.TxFunc) error {
+		return txFn(ctx, nil)
+	}
+
+	prepareUntilTx := func(d *deps) {
+		d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "leaver").
+			Return(7, nil)
+		d.balanceCreator.EXPECT().EnsureBalanceCreated(gomock.Any(), 7, domain.StartBalance).
+			Return(nil)
+		d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(executeTxFn)
+	}
+
+	prepareNothingToRefund := func(d *deps) {
+		d.listingsSeller.EXPECT().CancelSellerListings(gomock.Any(), nil, 7).
+			Return(0, nil)
+		d.transfersExecutor.EXPECT().CancelSenderScheduledTransfers(gomock.Any(), nil, 7).
+			Return(0, nil)
+		d.bidsProceeder.EXPECT().RefundUserBids(gomock.Any(), nil, 7).
+			Return([]domain.AuctionBid{}, nil)
+		d.ticketsProceeder.EXPECT().RefundUserTickets(gomock.Any(), nil, 7).
+			Return(uint32(0), nil)
+	}
+
+	nothingRefunded := func(swept uint32, cancelledPreorders int) map[string]any {
+		return map[string]any{
+			"sweptAmount":                 swept,
+			"cancelledPreorders":          cancelledPreorders,
+			"cancelledListings":           0,
+			"cancelledScheduledTransfers": 0,
+			"refundedBids":                0,
+			"refundedTicketsAmount":       uint32(0),
+		}
+	}
+
+	preorder := domain.Preorder{Id: 3, UserID: 7, GoodID: 12, GoodName: "umbrella", Price: 200,
+		Status: domain.PreorderStatusPending}
+
+	tests := []testCase{
+		{
+			name:         "deactivation with sweep",
+			username:     "leaver",
+			sweepBalance: true,
+			prepareFn: func(t *testing.T, d *deps) {
+				prepareUntilTx(d)
+				prepareNothingToRefund(d)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 7).
+					Return(uint32(450), nil)
+				d.preorderProceeder.EXPECT().LockUserPendingPreorders(gomock.Any(), nil, 7).
+					Return([]domain.Preorder{}, nil)
+				d.balanceDeactivator.EXPECT().DeactivateBalance(gomock.Any(), nil, 7).
+					Return(nil)
+				d.companyPool.EXPECT().TransferToPool(gomock.Any(), nil, 7, uint32(450), deactivationSweepReason).
+					Return(nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, audit.Event{
+					Action: audit.ActionAccountDeactivate,
+					Target: "user:leaver",
+					Before: map[string]any{"balance": uint32(450)},
+					After:  nothingRefunded(450, 0),
+				}).Return(nil)
+				d.userDeactivator.EXPECT().DeactivateUser(gomock.Any(), 7).
+					Return(nil)
+			},
+			expectedSwept: 450,
+		},
+		{
+			name:         "pending preorders cancelled before sweep",
+			username:     "leaver",
+			sweepBalance: true,
+			prepareFn: func(t *testing.T, d *deps) {
+				prepareUntilTx(d)
+				prepareNothingToRefund(d)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 7).
+					Return(uint32(450), nil)
+				d.preorderProceeder.EXPECT().LockUserPendingPreorders(gomock.Any(), nil, 7).
+					Return([]domain.Preorder{preorder}, nil)
+				d.preorderProceeder.EXPECT().CancelPreorder(gomock.Any(), nil, preorder).
+					Return(nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil,
+					audit.RefundEvent("preorder:3", 7, 200, audit.RefundReasonDeactivation)).
+					Return(nil)
+				d.webhookPublisher.EXPECT().PublishWebhook(gomock.Any(), nil, domain.CancellationWebhook(preorder)).
+					Return(nil)
+				d.eventOutbox.EXPECT().AppendEvent(gomock.Any(), nil,
+					domain.PreorderStatusChangedEvent(preorder, domain.PreorderStatusCancelled)).
+					Return(nil)
+				d.balanceDeactivator.EXPECT().DeactivateBalance(gomock.Any(), nil, 7).
+					Return(nil)
+				d.companyPool.EXPECT().TransferToPool(gomock.Any(), nil, 7, uint32(650), deactivationSweepReason).
+					Return(nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, audit.Event{
+					Action: audit.ActionAccountDeactivate,
+					Target: "user:leaver",
+					Before: map[string]any{"balance": uint32(650)},
+					After:  nothingRefunded(650, 1),
+				}).Return(nil)
+				d.userDeactivator.EXPECT().DeactivateUser(gomock.Any(), 7).
+					Return(nil)
+			},
+			expectedSwept: 650,
+		},
+		{
+			name:         "listings and scheduled transfers cancelled and bids and tickets refunded before sweep",
+			username:     "leaver",
+			sweepBalance: true,
+			prepareFn: func(t *testing.T, d *deps) {
+				prepareUntilTx(d)
+				d.listingsSeller.EXPECT().CancelSellerListings(gomock.Any(), nil, 7).
+					Return(2, nil)
+				d.transfersExecutor.EXPECT().CancelSenderScheduledTransfers(gomock.Any(), nil, 7).
+					Return(1, nil)
+				d.bidsProceeder.EXPECT().RefundUserBids(gomock.Any(), nil, 7).
+					Return([]domain.AuctionBid{{Id: 4, BidderID: 7, Amount: 100}}, nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil,
+					audit.RefundEvent("user:leaver", 7, 100, audit.RefundReasonDeactivation)).
+					Return(nil)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 7).
+					Return(uint32(550), nil)
+				d.preorderProceeder.EXPECT().LockUserPendingPreorders(gomock.Any(), nil, 7).
+					Return([]domain.Preorder{}, nil)
+				d.ticketsProceeder.EXPECT().RefundUserTickets(gomock.Any(), nil, 7).
+					Return(uint32(30), nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil,
+					audit.RefundEvent("user:leaver", 7, 30, audit.RefundReasonDeactivation)).
+					Return(nil)
+				d.balanceDeactivator.EXPECT().DeactivateBalance(gomock.Any(), nil, 7).
+					Return(nil)
+				d.companyPool.EXPECT().TransferToPool(gomock.Any(), nil, 7, uint32(580), deactivationSweepReason).
+					Return(nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, audit.Event{
+					Action: audit.ActionAccountDeactivate,
+					Target: "user:leaver",
+					Before: map[string]any{"balance": uint32(580)},
+					After: map[string]any{
+						"sweptAmount":                 uint32(580),
+						"cancelledPreorders":          0,
+						"cancelledListings":           2,
+						"cancelledScheduledTransfers": 1,
+						"refundedBids":                1,
+						"refundedTicketsAmount":       uint32(30),
+					},
+				}).Return(nil)
+				d.userDeactivator.EXPECT().DeactivateUser(gomock.Any(), 7).
+					Return(nil)
+			},
+			expectedSwept: 580,
+		},
+		{
+			name:     "listing cancellation error",
+			username: "leaver",
+			prepareFn: func(t *testing.T, d *deps) {
+				prepareUntilTx(d)
+				d.listingsSeller.EXPECT().CancelSellerListings(gomock.Any(), nil, 7).
+					Return(0, assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+		{
+			name:     "bid refund error",
+			username: "leaver",
+			prepareFn: func(t *testing.T, d *deps) {
+				prepareUntilTx(d)
+				d.listingsSeller.EXPECT().CancelSellerListings(gomock.Any(), nil, 7).
+					Return(0, nil)
+				d.transfersExecutor.EXPECT().CancelSenderScheduledTransfers(gomock.Any(), nil, 7).
+					Return(0, nil)
+				d.bidsProceeder.EXPECT().RefundUserBids(gomock.Any(), nil, 7).
+					Return(nil, assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+		{
+			name:         "deactivation without sweep",
+			username:     "leaver",
+			sweepBalance: false,
+			prepareFn: func(t *testing.T, d *deps) {
+				prepareUntilTx(d)
+				prepareNothingToRefund(d)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 7).
+					Return(uint32(450), nil)
+				d.preorderProceeder.EXPECT().LockUserPendingPreorders(gomock.Any(), nil, 7).
+					Return([]domain.Preorder{}, nil)
+				d.balanceDeactivator.EXPECT().DeactivateBalance(gomock.Any(), nil, 7).
+					Return(nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, audit.Event{
+					Action: audit.ActionAccountDeactivate,
+					Target: "user:leaver",
+					Before: map[string]any{"balance": uint32(450)},
+					After:  nothingRefunded(0, 0),
+				}).Return(nil)
+				d.userDeactivator.EXPECT().DeactivateUser(gomock.Any(), 7).
+					Return(nil)
+			},
+			expectedSwept: 0,
+		},
+		{
+			name:         "sweep of empty balance is skipped",
+			username:     "leaver",
+			sweepBalance: true,
+			prepareFn: func(t *testing.T, d *deps) {
+				prepareUntilTx(d)
+				prepareNothingToRefund(d)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 7).
+					Return(uint32(0), nil)
+				d.preorderProceeder.EXPECT().LockUserPendingPreorders(gomock.Any(), nil, 7).
+					Return([]domain.Preorder{}, nil)
+				d.balanceDeactivator.EXPECT().DeactivateBalance(gomock.Any(), nil, 7).
+					Return(nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, gomock.Any()).
+					Return(nil)
+				d.userDeactivator.EXPECT().DeactivateUser(gomock.Any(), 7).
+					Return(nil)
+			},
+			expectedSwept: 0,
+		},
+		{
+			name:     "user not found",
+			username: "ghost",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "ghost").
+					Return(0, assert.AnError)
+			},
+			expectedErr: &domain.UserNotFoundError{},
+		},
+		{
+			name:     "auth deactivation error after commit",
+			username: "leaver",
+			prepareFn: func(t *testing.T, d *deps) {
+				prepareUntilTx(d)
+				prepareNothingToRefund(d)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 7).
+					Return(uint32(450), nil)
+				d.preorderProceeder.EXPECT().LockUserPendingPreorders(gomock.Any(), nil, 7).
+					Return([]domain.Preorder{}, nil)
+				d.balanceDeactivator.EXPECT().DeactivateBalance(gomock.Any(), nil, 7).
+					Return(nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, gomock.Any()).
+					Return(nil)
+				d.userDeactivator.EXPECT().DeactivateUser(gomock.Any(), 7).
+					Return(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+		{
+			name:         "pool transfer error",
+			username:     "leaver",
+			sweepBalance: true,
+			prepareFn: func(t *testing.T, d *deps) {
+				prepareUntilTx(d)
+				prepareNothingToRefund(d)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 7).
+					Return(uint32(450), nil)
+				d.preorderProceeder.EXPECT().LockUserPendingPreorders(gomock.Any(), nil, 7).
+					Return([]domain.Preorder{}, nil)
+				d.balanceDeactivator.EXPECT().DeactivateBalance(gomock.Any(), nil, 7).
+					Return(nil)
+				d.companyPool.EXPECT().TransferToPool(gomock.Any(), nil, 7, uint32(450), deactivationSweepReason).
+					Return(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+		{
+			name:         "audit record error",
+			username:     "leaver",
+			sweepBalance: false,
+			prepareFn: func(t *testing.T, d *deps) {
+				prepareUntilTx(d)
+				prepareNothingToRefund(d)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 7).
+					Return(uint32(450), nil)
+				d.preorderProceeder.EXPECT().LockUserPendingPreorders(gomock.Any(), nil, 7).
+					Return([]domain.Preorder{}, nil)
+				d.balanceDeactivator.EXPECT().DeactivateBalance(gomock.Any(), nil, 7).
+					Return(nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, gomock.Any()).
+					Return(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+		{
+			name:         "balance deactivation error",
+			username:     "leaver",
+			sweepBalance: true,
+			prepareFn: func(t *testing.T, d *deps) {
+				prepareUntilTx(d)
+				prepareNothingToRefund(d)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 7).
+					Return(uint32(450), nil)
+				d.preorderProceeder.EXPECT().LockUserPendingPreorders(gomock.Any(), nil, 7).
+					Return([]domain.Preorder{}, nil)
+				d.balanceDeactivator.EXPECT().DeactivateBalance(gomock.Any(), nil, 7).
+					Return(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := &deps{
+				txManager:          dbmocks.NewMockTxManager(ctrl),
+				userIDFetcher:      storemocks.NewMockUserIDFetcher(ctrl),
+				userDeactivator:    storemocks.NewMockUserDeactivator(ctrl),
+				balanceCreator:     storemocks.NewMockBalanceEnsurer(ctrl),
+				balanceLocker:      storemocks.NewMockUserBalanceLocker(ctrl),
+				balanceDeactivator: storemocks.NewMockBalanceDeactivator(ctrl),
+				companyPool:        storemocks.NewMockCompanyPool(ctrl),
+				preorderProceeder:  storemocks.NewMockPreorderProceeder(ctrl),
+				listingsSeller:     storemocks.NewMockListingsSeller(ctrl),
+				transfersExecutor:  storemocks.NewMockScheduledTransfersExecutor(ctrl),
+				bidsProceeder:      storemocks.NewMockAuctionBidsProceeder(ctrl),
+				ticketsProceeder:   storemocks.NewMockRaffleTicketsProceeder(ctrl),
+				webhookPublisher:   storemocks.NewMockWebhookPublisher(ctrl),
+				eventOutbox:        storemocks.NewMockEventOutbox(ctrl),
+				auditRecorder:      auditmocks.NewMockRecorder(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			deactivationCase := NewDeactivationCase(d.txManager, d.userIDFetcher, d.userDeactivator, d.balanceCreator,
+				d.balanceLocker, d.balanceDeactivator, d.companyPool, d.preorderProceeder, d.listingsSeller,
+				d.transfersExecutor, d.bidsProceeder, d.ticketsProceeder, d.webhookPublisher, d.eventOutbox, d.auditRecorder)
+			swept, err := deactivationCase.DeactivateUser(t.Context(), tt.username, tt.sweepBalance)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedSwept, swept)
+			}
+		})
+	}
+}
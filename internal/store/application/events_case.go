@@ -0,0 +1,116 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+)
+
+type EventsCase struct {
+	txManager           database.TxManager
+	eventLog            domain.EventLog
+	consumersRepository domain.EventConsumersRepository
+	sink                domain.EventSink
+}
+
+// NewEventsCase creates the case relaying events to the sink. A nil sink relays the events to the event log only.
+func NewEventsCase(txManager database.TxManager,
+	eventLog domain.EventLog,
+	consumersRepository domain.EventConsumersRepository,
+	sink domain.EventSink) *EventsCase {
+	return &EventsCase{
+		txManager:           txManager,
+		eventLog:            eventLog,
+		consumersRepository: consumersRepository,
+		sink:                sink,
+	}
+}
+
+// RelayEvents moves the outbox events to the event log in batches and publishes every batch to the sink, returning
+// how many events were relayed.
+func (ec *EventsCase) RelayEvents(ctx context.Context) (int, error) {
+	relayed := 0
+	for {
+		batch, err := ec.relayBatch(ctx)
+		relayed += batch
+		if err != nil || batch < domain.EventRelayBatch {
+			return relayed, err
+		}
+	}
+}
+
+// relayBatch moves a batch under the relay lock, so only one store instance relays at a time and the offsets
+// follow the order the events are relayed in. A batch the sink fails to take is rolled back and published again
+// on the next run.
+func (ec *EventsCase) relayBatch(ctx context.Context) (int, error) {
+	relayed := 0
+
+	err := ec.txManager.WithinTransaction(ctx, func(ctx context.Context, executor database.QueryExecuter) error {
+		locked, err := ec.eventLog.TryLockRelay(ctx, executor)
+		if err != nil || !locked {
+			return err
+		}
+
+		events, err := ec.eventLog.MoveOutboxEvents(ctx, executor, domain.EventRelayBatch)
+		if err != nil {
+			return err
+		}
+
+		if len(events) > 0 && ec.sink != nil {
+			err = ec.sink.PublishEvents(ctx, events)
+			if err != nil {
+				return fmt.Errorf("failed to publish events: %w", err)
+			}
+		}
+
+		relayed = len(events)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return relayed, nil
+}
+
+// FetchEvents returns up to limit events after the offset committed by the consumer. The events are returned again
+// until the consumer commits an offset past them, so each of them is delivered at least once.
+func (ec *EventsCase) FetchEvents(ctx context.Context, consumer string, limit int) ([]domain.DomainEvent, error) {
+	err := domain.ValidateEventConsumer(consumer)
+	if err != nil {
+		return nil, err
+	}
+
+	offset, err := ec.consumersRepository.GetConsumerOffset(ctx, consumer)
+	if err != nil {
+		return nil, err
+	}
+
+	return ec.eventLog.FetchEvents(ctx, offset, domain.EventsLimit(limit))
+}
+
+// CommitEventOffset records that the consumer processed the events up to the offset. Committing a lower offset than
+// before replays the events after it.
+func (ec *EventsCase) CommitEventOffset(ctx context.Context, consumer string, offset int64) error {
+	err := domain.ValidateEventConsumer(consumer)
+	if err != nil {
+		return err
+	}
+
+	if offset < 0 {
+		return &domain.InvalidArgumentsError{Msg: "offset must not be negative"}
+	}
+
+	lastOffset, err := ec.eventLog.GetLastEventOffset(ctx)
+	if err != nil {
+		return err
+	}
+
+	if offset > lastOffset {
+		return &domain.InvalidArgumentsError{Msg: fmt.Sprintf("offset %d is past the last event %d", offset, lastOffset)}
+	}
+
+	return ec.consumersRepository.CommitConsumerOffset(ctx, consumer, offset)
+}
@@ -0,0 +1,291 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	dbmocks "github.com/Lexv0lk/merch-store/gen/mocks/database"
+	storemocks "github.com/Lexv0lk/merch-store/gen/mocks/store"
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventsCase_RelayEvents(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		txManager *dbmocks.MockTxManager
+		eventLog  *storemocks.MockEventLog
+		sink      *storemocks.MockEventSink
+	}
+
+	executeTxFn := func(ctx context.Context, txFn database.TxFunc) error {
+		return txFn(ctx, nil)
+	}
+
+	events := []domain.DomainEvent{
+		{Id: 38, Offset: 11, Type: domain.EventBalanceCreated},
+		{Id: 40, Offset: 12, Type: domain.EventCoinsSent},
+	}
+	fullBatch := make([]domain.DomainEvent, domain.EventRelayBatch)
+
+	type testCase struct {
+		name string
+
+		prepareFn func(t *testing.T, d *deps)
+
+		expectedRelayed int
+		expectedErr     bool
+	}
+
+	tests := []testCase{
+		{
+			name: "events relayed",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.eventLog.EXPECT().TryLockRelay(gomock.Any(), nil).Return(true, nil)
+				d.eventLog.EXPECT().MoveOutboxEvents(gomock.Any(), nil, domain.EventRelayBatch).Return(events, nil)
+				d.sink.EXPECT().PublishEvents(gomock.Any(), events).Return(nil)
+			},
+			expectedRelayed: 2,
+		},
+		{
+			name: "full batch is followed by the next one",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn).Times(2)
+				d.eventLog.EXPECT().TryLockRelay(gomock.Any(), nil).Return(true, nil).Times(2)
+				gomock.InOrder(
+					d.eventLog.EXPECT().MoveOutboxEvents(gomock.Any(), nil, domain.EventRelayBatch).Return(fullBatch, nil),
+					d.eventLog.EXPECT().MoveOutboxEvents(gomock.Any(), nil, domain.EventRelayBatch).Return(events, nil),
+				)
+				d.sink.EXPECT().PublishEvents(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+			},
+			expectedRelayed: domain.EventRelayBatch + 2,
+		},
+		{
+			name: "nothing to relay",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.eventLog.EXPECT().TryLockRelay(gomock.Any(), nil).Return(true, nil)
+				d.eventLog.EXPECT().MoveOutboxEvents(gomock.Any(), nil, domain.EventRelayBatch).Return([]domain.DomainEvent{}, nil)
+			},
+		},
+		{
+			name: "another instance is relaying",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.eventLog.EXPECT().TryLockRelay(gomock.Any(), nil).Return(false, nil)
+			},
+		},
+		{
+			name: "sink failure rolls the batch back",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.eventLog.EXPECT().TryLockRelay(gomock.Any(), nil).Return(true, nil)
+				d.eventLog.EXPECT().MoveOutboxEvents(gomock.Any(), nil, domain.EventRelayBatch).Return(events, nil)
+				d.sink.EXPECT().PublishEvents(gomock.Any(), events).Return(errors.New("connection refused"))
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			d := &deps{
+				txManager: dbmocks.NewMockTxManager(ctrl),
+				eventLog:  storemocks.NewMockEventLog(ctrl),
+				sink:      storemocks.NewMockEventSink(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			eventsCase := NewEventsCase(d.txManager, d.eventLog, storemocks.NewMockEventConsumersRepository(ctrl),
+				d.sink)
+			relayed, err := eventsCase.RelayEvents(t.Context())
+
+			if tt.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedRelayed, relayed)
+		})
+	}
+}
+
+func TestEventsCase_RelayEvents_WithoutSink(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		txManager *dbmocks.MockTxManager
+		eventLog  *storemocks.MockEventLog
+	}
+
+	ctrl := gomock.NewController(t)
+	d := &deps{
+		txManager: dbmocks.NewMockTxManager(ctrl),
+		eventLog:  storemocks.NewMockEventLog(ctrl),
+	}
+
+	d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, txFn database.TxFunc) error {
+			return txFn(ctx, nil)
+		})
+	d.eventLog.EXPECT().TryLockRelay(gomock.Any(), nil).Return(true, nil)
+	d.eventLog.EXPECT().MoveOutboxEvents(gomock.Any(), nil, domain.EventRelayBatch).
+		Return([]domain.DomainEvent{{Id: 38, Offset: 11}}, nil)
+
+	relayed, err := NewEventsCase(d.txManager, d.eventLog, storemocks.NewMockEventConsumersRepository(ctrl), nil).RelayEvents(t.Context())
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, relayed)
+}
+
+func TestEventsCase_FetchEvents(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		eventLog            *storemocks.MockEventLog
+		consumersRepository *storemocks.MockEventConsumersRepository
+	}
+
+	events := []domain.DomainEvent{{Id: 40, Offset: 12, Type: domain.EventCoinsSent}}
+
+	type testCase struct {
+		name     string
+		consumer string
+		limit    int
+
+		prepareFn func(t *testing.T, d *deps)
+
+		expectedEvents []domain.DomainEvent
+		expectedErr    error
+	}
+
+	tests := []testCase{
+		{
+			name:     "events after the committed offset",
+			consumer: "warehouse",
+			limit:    10,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.consumersRepository.EXPECT().GetConsumerOffset(gomock.Any(), "warehouse").Return(int64(11), nil)
+				d.eventLog.EXPECT().FetchEvents(gomock.Any(), int64(11), 10).Return(events, nil)
+			},
+			expectedEvents: events,
+		},
+		{
+			name:     "default limit",
+			consumer: "warehouse",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.consumersRepository.EXPECT().GetConsumerOffset(gomock.Any(), "warehouse").Return(int64(0), nil)
+				d.eventLog.EXPECT().FetchEvents(gomock.Any(), int64(0), domain.DefaultEventsLimit).Return(events, nil)
+			},
+			expectedEvents: events,
+		},
+		{
+			name:        "invalid consumer",
+			consumer:    "ware house",
+			prepareFn:   func(t *testing.T, d *deps) {},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			d := &deps{
+				eventLog:            storemocks.NewMockEventLog(ctrl),
+				consumersRepository: storemocks.NewMockEventConsumersRepository(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			eventsCase := NewEventsCase(dbmocks.NewMockTxManager(ctrl), d.eventLog, d.consumersRepository,
+				storemocks.NewMockEventSink(ctrl))
+			fetched, err := eventsCase.FetchEvents(t.Context(), tt.consumer, tt.limit)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedEvents, fetched)
+			}
+		})
+	}
+}
+
+func TestEventsCase_CommitEventOffset(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		eventLog            *storemocks.MockEventLog
+		consumersRepository *storemocks.MockEventConsumersRepository
+	}
+
+	type testCase struct {
+		name   string
+		offset int64
+
+		prepareFn func(t *testing.T, d *deps)
+
+		expectedErr error
+	}
+
+	tests := []testCase{
+		{
+			name:   "offset committed",
+			offset: 12,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.eventLog.EXPECT().GetLastEventOffset(gomock.Any()).Return(int64(12), nil)
+				d.consumersRepository.EXPECT().CommitConsumerOffset(gomock.Any(), "warehouse", int64(12)).Return(nil)
+			},
+		},
+		{
+			name:   "offset past the last event",
+			offset: 13,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.eventLog.EXPECT().GetLastEventOffset(gomock.Any()).Return(int64(12), nil)
+			},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:        "negative offset",
+			offset:      -1,
+			prepareFn:   func(t *testing.T, d *deps) {},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			d := &deps{
+				eventLog:            storemocks.NewMockEventLog(ctrl),
+				consumersRepository: storemocks.NewMockEventConsumersRepository(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			eventsCase := NewEventsCase(dbmocks.NewMockTxManager(ctrl), d.eventLog, d.consumersRepository,
+				storemocks.NewMockEventSink(ctrl))
+			err := eventsCase.CommitEventOffset(t.Context(), "warehouse", tt.offset)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
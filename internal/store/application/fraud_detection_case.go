@@ -0,0 +1,201 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/audit"
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+)
+
+const (
+	circularLookback     = 24 * time.Hour
+	circularMinTransfers = 4
+	burstLookback        = 10 * time.Minute
+	burstMinTransfers    = 15
+	drainLookback        = 24 * time.Hour
+	drainNewAccountAge   = 72 * time.Hour
+	drainMinSenders      = 3
+)
+
+type FraudDetectionCase struct {
+	txManager         database.TxManager
+	detector          domain.FraudDetector
+	casesRepo         domain.FraudCasesRepository
+	balanceFreezer    domain.BalanceFreezer
+	freezeLogRecorder domain.FreezeLogRecorder
+	usernameGetter    domain.UsernameGetter
+	auditRecorder     audit.Recorder
+}
+
+func NewFraudDetectionCase(txManager database.TxManager,
+	detector domain.FraudDetector,
+	casesRepo domain.FraudCasesRepository,
+	balanceFreezer domain.BalanceFreezer,
+	freezeLogRecorder domain.FreezeLogRecorder,
+	usernameGetter domain.UsernameGetter,
+	auditRecorder audit.Recorder) *FraudDetectionCase {
+	return &FraudDetectionCase{
+		txManager:         txManager,
+		detector:          detector,
+		casesRepo:         casesRepo,
+		balanceFreezer:    balanceFreezer,
+		freezeLogRecorder: freezeLogRecorder,
+		usernameGetter:    usernameGetter,
+		auditRecorder:     auditRecorder,
+	}
+}
+
+// Analyze scans recent transfers for suspicious patterns and records new fraud cases.
+func (fc *FraudDetectionCase) Analyze(ctx context.Context) (int, error) {
+	now := time.Now()
+
+	circular, err := fc.detector.DetectCircularTransfers(ctx, now.Add(-circularLookback), circularMinTransfers)
+	if err != nil {
+		return 0, err
+	}
+
+	bursts, err := fc.detector.DetectTransferBursts(ctx, now.Add(-burstLookback), burstMinTransfers)
+	if err != nil {
+		return 0, err
+	}
+
+	drains, err := fc.detector.DetectNewAccountDrains(ctx, now.Add(-drainLookback), now.Add(-drainNewAccountAge), drainMinSenders)
+	if err != nil {
+		return 0, err
+	}
+
+	activities := make([]domain.SuspiciousActivity, 0, len(circular)+len(bursts)+len(drains))
+	activities = append(activities, circular...)
+	activities = append(activities, bursts...)
+	activities = append(activities, drains...)
+
+	if len(activities) == 0 {
+		return 0, nil
+	}
+
+	for i := range activities {
+		activities[i].DetectedAt = now
+	}
+
+	return fc.casesRepo.SaveFraudCases(ctx, activities)
+}
+
+func (fc *FraudDetectionCase) ListCases(ctx context.Context, status string) ([]domain.NamedFraudCase, error) {
+	switch status {
+	case "", domain.FraudCaseStatusOpen, domain.FraudCaseStatusResolved, domain.FraudCaseStatusFrozen:
+	default:
+		return nil, &domain.InvalidArgumentsError{Msg: fmt.Sprintf("unknown fraud case status: %s", status)}
+	}
+
+	cases, err := fc.casesRepo.ListFraudCases(ctx, status)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]int, 0)
+	for _, fraudCase := range cases {
+		userIDs = append(userIDs, fraudCase.UserIDs...)
+	}
+
+	usernames := map[int]string{}
+	if len(userIDs) > 0 {
+		usernames, err = fc.usernameGetter.GetUsernames(ctx, userIDs...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get usernames: %w", err)
+		}
+	}
+
+	namedCases := make([]domain.NamedFraudCase, 0, len(cases))
+	for _, fraudCase := range cases {
+		names := make([]string, 0, len(fraudCase.UserIDs))
+		for _, id := range fraudCase.UserIDs {
+			names = append(names, usernames[id])
+		}
+
+		namedCases = append(namedCases, domain.NamedFraudCase{
+			FraudCase: fraudCase,
+			Usernames: names,
+		})
+	}
+
+	return namedCases, nil
+}
+
+func (fc *FraudDetectionCase) ResolveCase(ctx context.Context, caseID int, resolution string) error {
+	return fc.closeCase(ctx, caseID, resolution, domain.FraudCaseStatusResolved, nil)
+}
+
+// FreezeCaseAccounts freezes every account involved in the case and closes it.
+func (fc *FraudDetectionCase) FreezeCaseAccounts(ctx context.Context, adminID, caseID int, resolution string) error {
+	return fc.closeCase(ctx, caseID, resolution, domain.FraudCaseStatusFrozen,
+		func(ctx context.Context, executor database.QueryExecuter, fraudCase domain.FraudCase) error {
+			for _, userID := range fraudCase.UserIDs {
+				err := fc.balanceFreezer.FreezeBalance(ctx, executor, userID)
+				if err != nil {
+					return fmt.Errorf("failed to freeze balance for user %d: %w", userID, err)
+				}
+
+				err = fc.freezeLogRecorder.RecordFreezeChange(ctx, executor, domain.FreezeChange{
+					UserID:  userID,
+					AdminID: adminID,
+					Frozen:  true,
+					Reason:  fmt.Sprintf("fraud case %d: %s", caseID, resolution),
+				})
+				if err != nil {
+					return fmt.Errorf("failed to record freeze of user %d: %w", userID, err)
+				}
+			}
+
+			return nil
+		})
+}
+
+func (fc *FraudDetectionCase) closeCase(ctx context.Context, caseID int, resolution, status string,
+	beforeClose func(ctx context.Context, executor database.QueryExecuter, fraudCase domain.FraudCase) error) error {
+	if resolution == "" {
+		return &domain.InvalidArgumentsError{Msg: "resolution must not be empty"}
+	}
+
+	return fc.txManager.WithinTransaction(ctx, func(ctx context.Context, executor database.QueryExecuter) error {
+		fraudCase, err := fc.casesRepo.LockAndGetFraudCase(ctx, executor, caseID)
+		if err != nil {
+			return fmt.Errorf("failed to lock fraud case %d: %w", caseID, err)
+		}
+
+		if fraudCase.Status != domain.FraudCaseStatusOpen {
+			return &domain.FraudCaseClosedError{Msg: fmt.Sprintf("fraud case %d is already %s", caseID, fraudCase.Status)}
+		}
+
+		if beforeClose != nil {
+			err = beforeClose(ctx, executor, fraudCase)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = fc.casesRepo.CloseFraudCase(ctx, executor, caseID, status, resolution)
+		if err != nil {
+			return fmt.Errorf("failed to close fraud case %d: %w", caseID, err)
+		}
+
+		action := audit.ActionFraudCaseResolve
+		if status == domain.FraudCaseStatusFrozen {
+			action = audit.ActionFraudCaseFreeze
+		}
+
+		err = fc.auditRecorder.RecordInTx(ctx, executor, audit.Event{
+			Action: action,
+			Target: audit.FraudCaseTarget(caseID),
+			Before: map[string]any{"status": fraudCase.Status},
+			After:  map[string]any{"status": status, "resolution": resolution, "userIDs": fraudCase.UserIDs},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record audit event: %w", err)
+		}
+
+		return nil
+	})
+}
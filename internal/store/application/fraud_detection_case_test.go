@@ -0,0 +1,371 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	auditmocks "github.com/Lexv0lk/merch-store/gen/mocks/audit"
+	dbmocks "github.com/Lexv0lk/merch-store/gen/mocks/database"
+	storemocks "github.com/Lexv0lk/merch-store/gen/mocks/store"
+	"github.com/Lexv0lk/merch-store/internal/pkg/audit"
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFraudDetectionCase_Analyze(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		detector  *storemocks.MockFraudDetector
+		casesRepo *storemocks.MockFraudCasesRepository
+	}
+
+	type testCase struct {
+		name string
+
+		prepareFn func(t *testing.T, d *deps)
+
+		expectedFlagged int
+		expectedErr     error
+	}
+
+	circular := domain.SuspiciousActivity{Pattern: domain.FraudPatternCircular, UserIDs: []int{1, 2}}
+	drain := domain.SuspiciousActivity{Pattern: domain.FraudPatternDrain, UserIDs: []int{3, 4, 5, 6}}
+
+	tests := []testCase{
+		{
+			name: "cases flagged",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.detector.EXPECT().DetectCircularTransfers(gomock.Any(), gomock.Any(), circularMinTransfers).
+					Return([]domain.SuspiciousActivity{circular}, nil)
+				d.detector.EXPECT().DetectTransferBursts(gomock.Any(), gomock.Any(), burstMinTransfers).
+					Return(nil, nil)
+				d.detector.EXPECT().DetectNewAccountDrains(gomock.Any(), gomock.Any(), gomock.Any(), drainMinSenders).
+					Return([]domain.SuspiciousActivity{drain}, nil)
+				d.casesRepo.EXPECT().SaveFraudCases(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, activities []domain.SuspiciousActivity) (int, error) {
+						assert.Len(t, activities, 2)
+						for _, activity := range activities {
+							assert.False(t, activity.DetectedAt.IsZero())
+						}
+						return 2, nil
+					})
+			},
+			expectedFlagged: 2,
+		},
+		{
+			name: "nothing suspicious",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.detector.EXPECT().DetectCircularTransfers(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				d.detector.EXPECT().DetectTransferBursts(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				d.detector.EXPECT().DetectNewAccountDrains(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			expectedFlagged: 0,
+		},
+		{
+			name: "detector error",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.detector.EXPECT().DetectCircularTransfers(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := &deps{
+				detector:  storemocks.NewMockFraudDetector(ctrl),
+				casesRepo: storemocks.NewMockFraudCasesRepository(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			fraudDetectionCase := NewFraudDetectionCase(dbmocks.NewMockTxManager(ctrl), d.detector, d.casesRepo,
+				storemocks.NewMockBalanceFreezer(ctrl), storemocks.NewMockFreezeLogRecorder(ctrl),
+				storemocks.NewMockUsernameGetter(ctrl), auditmocks.NewMockRecorder(ctrl))
+			flagged, err := fraudDetectionCase.Analyze(t.Context())
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedFlagged, flagged)
+			}
+		})
+	}
+}
+
+func TestFraudDetectionCase_ListCases(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		casesRepo      *storemocks.MockFraudCasesRepository
+		usernameGetter *storemocks.MockUsernameGetter
+	}
+
+	type testCase struct {
+		name   string
+		status string
+
+		prepareFn func(t *testing.T, d *deps)
+
+		expectedCases []domain.NamedFraudCase
+		expectedErr   error
+	}
+
+	createdAt := time.Date(2026, 3, 29, 12, 0, 0, 0, time.UTC)
+	fraudCase := domain.FraudCase{Id: 1, Pattern: domain.FraudPatternCircular, UserIDs: []int{1, 2},
+		Status: domain.FraudCaseStatusOpen, CreatedAt: createdAt}
+
+	tests := []testCase{
+		{
+			name:   "cases listed with usernames",
+			status: domain.FraudCaseStatusOpen,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.casesRepo.EXPECT().ListFraudCases(gomock.Any(), domain.FraudCaseStatusOpen).
+					Return([]domain.FraudCase{fraudCase}, nil)
+				d.usernameGetter.EXPECT().GetUsernames(gomock.Any(), 1, 2).
+					Return(map[int]string{1: "alice", 2: "bob"}, nil)
+			},
+			expectedCases: []domain.NamedFraudCase{{FraudCase: fraudCase, Usernames: []string{"alice", "bob"}}},
+		},
+		{
+			name:   "no cases",
+			status: "",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.casesRepo.EXPECT().ListFraudCases(gomock.Any(), "").Return([]domain.FraudCase{}, nil)
+			},
+			expectedCases: []domain.NamedFraudCase{},
+		},
+		{
+			name:        "unknown status",
+			status:      "pending",
+			prepareFn:   func(t *testing.T, d *deps) {},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:   "usernames error",
+			status: domain.FraudCaseStatusOpen,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.casesRepo.EXPECT().ListFraudCases(gomock.Any(), domain.FraudCaseStatusOpen).
+					Return([]domain.FraudCase{fraudCase}, nil)
+				d.usernameGetter.EXPECT().GetUsernames(gomock.Any(), 1, 2).Return(nil, assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := &deps{
+				casesRepo:      storemocks.NewMockFraudCasesRepository(ctrl),
+				usernameGetter: storemocks.NewMockUsernameGetter(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			fraudDetectionCase := NewFraudDetectionCase(dbmocks.NewMockTxManager(ctrl),
+				storemocks.NewMockFraudDetector(ctrl), d.casesRepo, storemocks.NewMockBalanceFreezer(ctrl),
+				storemocks.NewMockFreezeLogRecorder(ctrl), d.usernameGetter, auditmocks.NewMockRecorder(ctrl))
+			cases, err := fraudDetectionCase.ListCases(t.Context(), tt.status)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedCases, cases)
+			}
+		})
+	}
+}
+
+func TestFraudDetectionCase_FreezeCaseAccounts(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		txManager         *dbmocks.MockTxManager
+		casesRepo         *storemocks.MockFraudCasesRepository
+		balanceFreezer    *storemocks.MockBalanceFreezer
+		freezeLogRecorder *storemocks.MockFreezeLogRecorder
+		auditRecorder     *auditmocks.MockRecorder
+	}
+
+	type testCase struct {
+		name       string
+		caseID     int
+		resolution string
+
+		prepareFn func(t *testing.T, d *deps)
+
+		expectedErr error
+	}
+
+	executeTxFn := func(ctx context.Context, txFn database.TxFunc) error {
+		return txFn(ctx, nil)
+	}
+
+	openCase := domain.FraudCase{Id: 1, UserIDs: []int{1, 2}, Status: domain.FraudCaseStatusOpen}
+
+	tests := []testCase{
+		{
+			name:       "accounts frozen",
+			caseID:     1,
+			resolution: "confirmed coin farming",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.casesRepo.EXPECT().LockAndGetFraudCase(gomock.Any(), nil, 1).Return(openCase, nil)
+				d.balanceFreezer.EXPECT().FreezeBalance(gomock.Any(), nil, 1).Return(nil)
+				d.balanceFreezer.EXPECT().FreezeBalance(gomock.Any(), nil, 2).Return(nil)
+				d.freezeLogRecorder.EXPECT().RecordFreezeChange(gomock.Any(), nil, domain.FreezeChange{
+					UserID: 1, AdminID: 99, Frozen: true, Reason: "fraud case 1: confirmed coin farming"}).Return(nil)
+				d.freezeLogRecorder.EXPECT().RecordFreezeChange(gomock.Any(), nil, domain.FreezeChange{
+					UserID: 2, AdminID: 99, Frozen: true, Reason: "fraud case 1: confirmed coin farming"}).Return(nil)
+				d.casesRepo.EXPECT().CloseFraudCase(gomock.Any(), nil, 1, domain.FraudCaseStatusFrozen, "confirmed coin farming").
+					Return(nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, audit.Event{
+					Action: audit.ActionFraudCaseFreeze,
+					Target: "fraud-case:1",
+					Before: map[string]any{"status": domain.FraudCaseStatusOpen},
+					After: map[string]any{"status": domain.FraudCaseStatusFrozen, "resolution": "confirmed coin farming",
+						"userIDs": []int{1, 2}},
+				}).Return(nil)
+			},
+		},
+		{
+			name:        "empty resolution",
+			caseID:      1,
+			resolution:  "",
+			prepareFn:   func(t *testing.T, d *deps) {},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:       "case not found",
+			caseID:     42,
+			resolution: "confirmed",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.casesRepo.EXPECT().LockAndGetFraudCase(gomock.Any(), nil, 42).
+					Return(domain.FraudCase{}, &domain.FraudCaseNotFoundError{})
+			},
+			expectedErr: &domain.FraudCaseNotFoundError{},
+		},
+		{
+			name:       "case already closed",
+			caseID:     1,
+			resolution: "confirmed",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.casesRepo.EXPECT().LockAndGetFraudCase(gomock.Any(), nil, 1).
+					Return(domain.FraudCase{Id: 1, Status: domain.FraudCaseStatusResolved}, nil)
+			},
+			expectedErr: &domain.FraudCaseClosedError{},
+		},
+		{
+			name:       "audit record error",
+			caseID:     1,
+			resolution: "confirmed",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.casesRepo.EXPECT().LockAndGetFraudCase(gomock.Any(), nil, 1).Return(openCase, nil)
+				d.balanceFreezer.EXPECT().FreezeBalance(gomock.Any(), nil, gomock.Any()).Return(nil).Times(2)
+				d.freezeLogRecorder.EXPECT().RecordFreezeChange(gomock.Any(), nil, gomock.Any()).Return(nil).Times(2)
+				d.casesRepo.EXPECT().CloseFraudCase(gomock.Any(), nil, 1, domain.FraudCaseStatusFrozen, "confirmed").
+					Return(nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, gomock.Any()).Return(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+		{
+			name:       "freeze error",
+			caseID:     1,
+			resolution: "confirmed",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.casesRepo.EXPECT().LockAndGetFraudCase(gomock.Any(), nil, 1).Return(openCase, nil)
+				d.balanceFreezer.EXPECT().FreezeBalance(gomock.Any(), nil, 1).Return(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := &deps{
+				txManager:         dbmocks.NewMockTxManager(ctrl),
+				casesRepo:         storemocks.NewMockFraudCasesRepository(ctrl),
+				balanceFreezer:    storemocks.NewMockBalanceFreezer(ctrl),
+				freezeLogRecorder: storemocks.NewMockFreezeLogRecorder(ctrl),
+				auditRecorder:     auditmocks.NewMockRecorder(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			fraudDetectionCase := NewFraudDetectionCase(d.txManager, storemocks.NewMockFraudDetector(ctrl), d.casesRepo,
+				d.balanceFreezer, d.freezeLogRecorder, storemocks.NewMockUsernameGetter(ctrl), d.auditRecorder)
+			err := fraudDetectionCase.FreezeCaseAccounts(t.Context(), 99, tt.caseID, tt.resolution)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFraudDetectionCase_ResolveCase(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		txManager     *dbmocks.MockTxManager
+		casesRepo     *storemocks.MockFraudCasesRepository
+		auditRecorder *auditmocks.MockRecorder
+	}
+
+	ctrl := gomock.NewController(t)
+	d := &deps{
+		txManager:     dbmocks.NewMockTxManager(ctrl),
+		casesRepo:     storemocks.NewMockFraudCasesRepository(ctrl),
+		auditRecorder: auditmocks.NewMockRecorder(ctrl),
+	}
+
+	d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, txFn database.TxFunc) error {
+			return txFn(ctx, nil)
+		})
+	d.casesRepo.EXPECT().LockAndGetFraudCase(gomock.Any(), nil, 1).
+		Return(domain.FraudCase{Id: 1, UserIDs: []int{1, 2}, Status: domain.FraudCaseStatusOpen}, nil)
+	d.casesRepo.EXPECT().CloseFraudCase(gomock.Any(), nil, 1, domain.FraudCaseStatusResolved, "friends splitting a bill").
+		Return(nil)
+	d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, executor database.Executor, event audit.Event) error {
+			assert.Equal(t, audit.ActionFraudCaseResolve, event.Action)
+			assert.Equal(t, "fraud-case:1", event.Target)
+			return nil
+		})
+
+	fraudDetectionCase := NewFraudDetectionCase(d.txManager, storemocks.NewMockFraudDetector(ctrl), d.casesRepo,
+		storemocks.NewMockBalanceFreezer(ctrl), storemocks.NewMockFreezeLogRecorder(ctrl),
+		storemocks.NewMockUsernameGetter(ctrl), d.auditRecorder)
+	err := fraudDetectionCase.ResolveCase(t.Context(), 1, "friends splitting a bill")
+	assert.NoError(t, err)
+}
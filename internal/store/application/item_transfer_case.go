@@ -0,0 +1,117 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+)
+
+type ItemTransferCase struct {
+	txManager             database.TxManager
+	userIDFetcher         domain.UserIDFetcher
+	goodsRepository       domain.GoodsRepository
+	variantsRepository    domain.VariantsRepository
+	balanceLocker         domain.UserBalanceLocker
+	balanceCreator        domain.BalanceEnsurer
+	balanceStatusChecker  domain.BalanceStatusChecker
+	itemTransferProceeder domain.ItemTransferProceeder
+	purchaseLimitChecker  domain.PurchaseLimitChecker
+}
+
+func NewItemTransferCase(txManager database.TxManager,
+	userIDFetcher domain.UserIDFetcher,
+	goodsRepository domain.GoodsRepository,
+	variantsRepository domain.VariantsRepository,
+	balanceLocker domain.UserBalanceLocker,
+	balanceCreator domain.BalanceEnsurer,
+	balanceStatusChecker domain.BalanceStatusChecker,
+	itemTransferProceeder domain.ItemTransferProceeder,
+	purchaseLimitChecker domain.PurchaseLimitChecker) *ItemTransferCase {
+	return &ItemTransferCase{
+		txManager:             txManager,
+		userIDFetcher:         userIDFetcher,
+		goodsRepository:       goodsRepository,
+		variantsRepository:    variantsRepository,
+		balanceLocker:         balanceLocker,
+		balanceCreator:        balanceCreator,
+		balanceStatusChecker:  balanceStatusChecker,
+		itemTransferProceeder: itemTransferProceeder,
+		purchaseLimitChecker:  purchaseLimitChecker,
+	}
+}
+
+// TransferItem moves one unit of a good in the given variant from the sender's inventory to the recipient's.
+// An empty variantSKU moves a unit without a variant. No coins change hands, but the unit counts towards
+// the recipient's purchase limits.
+func (ic *ItemTransferCase) TransferItem(ctx context.Context, fromUserID int, goodName, variantSKU, toUsername string) error {
+	toUserID, err := ic.userIDFetcher.FetchUserID(ctx, toUsername)
+	if err != nil {
+		return &domain.UserNotFoundError{Msg: fmt.Sprintf("user not found: %s", toUsername)}
+	}
+
+	if toUserID == fromUserID {
+		return &domain.InvalidArgumentsError{Msg: "from_user must differ from to_user"}
+	}
+
+	goodInfo, err := ic.goodsRepository.GetGoodInfo(ctx, goodName)
+	if err != nil {
+		return fmt.Errorf("failed to get good info: %w", err)
+	}
+
+	variants, err := ic.variantsRepository.ListGoodVariants(ctx, goodInfo.Id)
+	if err != nil {
+		return fmt.Errorf("failed to list variants: %w", err)
+	}
+
+	variant, err := domain.OwnedVariant(variants, variantSKU)
+	if err != nil {
+		return err
+	}
+
+	err = ic.balanceCreator.EnsureBalanceCreated(ctx, toUserID, domain.StartBalance)
+	if err != nil {
+		return fmt.Errorf("failed to ensure balance for user %d: %w", toUserID, err)
+	}
+
+	return ic.txManager.WithinTransaction(ctx, func(ctx context.Context, executor database.QueryExecuter) error {
+		// The sender's balance row serializes the item transfers with freezes of the account, the recipient's one
+		// with the recipient's other acquisitions under their purchase limits.
+		_, err := lockBalances(ctx, ic.balanceLocker, executor, fromUserID, toUserID)
+		if err != nil {
+			return err
+		}
+
+		err = checkNotFrozen(ctx, ic.balanceStatusChecker, executor, fromUserID, "account is frozen")
+		if err != nil {
+			return err
+		}
+
+		isActive, err := ic.balanceStatusChecker.IsBalanceActive(ctx, executor, toUserID)
+		if err != nil {
+			return fmt.Errorf("failed to check balance status for user %d: %w", toUserID, err)
+		}
+
+		if !isActive {
+			return &domain.UserDeactivatedError{Msg: fmt.Sprintf("user %s is deactivated", toUsername)}
+		}
+
+		err = checkNotFrozen(ctx, ic.balanceStatusChecker, executor, toUserID, fmt.Sprintf("recipient %s is frozen", toUsername))
+		if err != nil {
+			return err
+		}
+
+		err = checkPurchaseLimits(ctx, ic.purchaseLimitChecker, executor, toUserID, map[int]uint32{goodInfo.Id: 1})
+		if err != nil {
+			return err
+		}
+
+		err = ic.itemTransferProceeder.ProceedItemTransfer(ctx, executor, fromUserID, toUserID, goodInfo.Id, variant.Id)
+		if err != nil {
+			return fmt.Errorf("failed to transfer item: %w", err)
+		}
+
+		return nil
+	})
+}
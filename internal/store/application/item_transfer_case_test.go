@@ -0,0 +1,218 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	dbmocks "github.com/Lexv0lk/merch-store/gen/mocks/database"
+	storemocks "github.com/Lexv0lk/merch-store/gen/mocks/store"
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestItemTransferCase_TransferItem(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		txManager             *dbmocks.MockTxManager
+		userIDFetcher         *storemocks.MockUserIDFetcher
+		goodsRepository       *storemocks.MockGoodsRepository
+		variantsRepository    *storemocks.MockVariantsRepository
+		balanceLocker         *storemocks.MockUserBalanceLocker
+		balanceCreator        *storemocks.MockBalanceEnsurer
+		balanceStatusChecker  *storemocks.MockBalanceStatusChecker
+		itemTransferProceeder *storemocks.MockItemTransferProceeder
+		purchaseLimitChecker  *storemocks.MockPurchaseLimitChecker
+	}
+
+	type testCase struct {
+		name       string
+		variant    string
+		toUsername string
+
+		prepareFn func(t *testing.T, d *deps)
+
+		expectedErr error
+	}
+
+	executeTxFn := func(ctx context.Context, txFn database.TxFunc) error {
+		return txFn(ctx, nil)
+	}
+
+	cup := domain.GoodInfo{Id: 10, Name: "cup", Price: 20}
+	cupSizes := []domain.Variant{{Id: 4, GoodID: 10, SKU: "cup-l"}}
+
+	prepareUntilTx := func(d *deps) {
+		d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "receiver").Return(2, nil)
+		d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "cup").Return(cup, nil)
+		d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 10).Return(cupSizes, nil)
+		d.balanceCreator.EXPECT().EnsureBalanceCreated(gomock.Any(), 2, domain.StartBalance).Return(nil)
+		d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+		d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).Return(uint32(100), nil)
+		d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 2).Return(uint32(50), nil)
+	}
+
+	tests := []testCase{
+		{
+			name:       "successful item transfer",
+			toUsername: "receiver",
+			prepareFn: func(t *testing.T, d *deps) {
+				prepareUntilTx(d)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).Return(false, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 2).Return(true, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 2).Return(false, nil)
+				d.purchaseLimitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{10}).
+					Return([]domain.PurchaseLimit{}, nil)
+				d.itemTransferProceeder.EXPECT().ProceedItemTransfer(gomock.Any(), nil, 1, 2, 10, 0).Return(nil)
+			},
+		},
+		{
+			name:       "variant transfer",
+			variant:    "cup-l",
+			toUsername: "receiver",
+			prepareFn: func(t *testing.T, d *deps) {
+				prepareUntilTx(d)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).Return(false, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 2).Return(true, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 2).Return(false, nil)
+				d.purchaseLimitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{10}).
+					Return([]domain.PurchaseLimit{}, nil)
+				d.itemTransferProceeder.EXPECT().ProceedItemTransfer(gomock.Any(), nil, 1, 2, 10, 4).Return(nil)
+			},
+		},
+		{
+			name:       "unknown variant",
+			variant:    "cup-xl",
+			toUsername: "receiver",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "receiver").Return(2, nil)
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "cup").Return(cup, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 10).Return(cupSizes, nil)
+			},
+			expectedErr: &domain.VariantNotFoundError{},
+		},
+		{
+			name:       "item not in inventory",
+			toUsername: "receiver",
+			prepareFn: func(t *testing.T, d *deps) {
+				prepareUntilTx(d)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).Return(false, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 2).Return(true, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 2).Return(false, nil)
+				d.purchaseLimitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{10}).
+					Return([]domain.PurchaseLimit{}, nil)
+				d.itemTransferProceeder.EXPECT().ProceedItemTransfer(gomock.Any(), nil, 1, 2, 10, 0).
+					Return(&domain.ItemNotOwnedError{})
+			},
+			expectedErr: &domain.ItemNotOwnedError{},
+		},
+		{
+			name:       "recipient over purchase limit",
+			toUsername: "receiver",
+			prepareFn: func(t *testing.T, d *deps) {
+				prepareUntilTx(d)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).Return(false, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 2).Return(true, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 2).Return(false, nil)
+				d.purchaseLimitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{10}).
+					Return([]domain.PurchaseLimit{{GoodID: 10, GoodName: "cup", MaxQuantity: 1}}, nil)
+				d.purchaseLimitChecker.EXPECT().CountUserAcquisitions(gomock.Any(), nil, 2, 10, time.Time{}).
+					Return(uint32(1), nil)
+			},
+			expectedErr: &domain.PurchaseLimitError{},
+		},
+		{
+			name:       "sender frozen",
+			toUsername: "receiver",
+			prepareFn: func(t *testing.T, d *deps) {
+				prepareUntilTx(d)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).Return(true, nil)
+			},
+			expectedErr: &domain.AccountFrozenError{},
+		},
+		{
+			name:       "recipient deactivated",
+			toUsername: "receiver",
+			prepareFn: func(t *testing.T, d *deps) {
+				prepareUntilTx(d)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).Return(false, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 2).Return(false, nil)
+			},
+			expectedErr: &domain.UserDeactivatedError{},
+		},
+		{
+			name:       "recipient frozen",
+			toUsername: "receiver",
+			prepareFn: func(t *testing.T, d *deps) {
+				prepareUntilTx(d)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).Return(false, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 2).Return(true, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 2).Return(true, nil)
+			},
+			expectedErr: &domain.AccountFrozenError{},
+		},
+		{
+			name:       "unknown good",
+			toUsername: "receiver",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "receiver").Return(2, nil)
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "cup").
+					Return(domain.GoodInfo{}, &domain.GoodNotFoundError{})
+			},
+			expectedErr: &domain.GoodNotFoundError{},
+		},
+		{
+			name:       "recipient not found",
+			toUsername: "ghost",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "ghost").Return(0, assert.AnError)
+			},
+			expectedErr: &domain.UserNotFoundError{},
+		},
+		{
+			name:       "transfer to self",
+			toUsername: "me",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "me").Return(1, nil)
+			},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := &deps{
+				txManager:             dbmocks.NewMockTxManager(ctrl),
+				userIDFetcher:         storemocks.NewMockUserIDFetcher(ctrl),
+				goodsRepository:       storemocks.NewMockGoodsRepository(ctrl),
+				variantsRepository:    storemocks.NewMockVariantsRepository(ctrl),
+				balanceLocker:         storemocks.NewMockUserBalanceLocker(ctrl),
+				balanceCreator:        storemocks.NewMockBalanceEnsurer(ctrl),
+				balanceStatusChecker:  storemocks.NewMockBalanceStatusChecker(ctrl),
+				itemTransferProceeder: storemocks.NewMockItemTransferProceeder(ctrl),
+				purchaseLimitChecker:  storemocks.NewMockPurchaseLimitChecker(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			itemTransferCase := NewItemTransferCase(d.txManager, d.userIDFetcher, d.goodsRepository,
+				d.variantsRepository, d.balanceLocker, d.balanceCreator, d.balanceStatusChecker, d.itemTransferProceeder,
+				d.purchaseLimitChecker)
+			err := itemTransferCase.TransferItem(t.Context(), 1, "cup", tt.variant, tt.toUsername)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,254 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+)
+
+const marketplaceFeeReason = "marketplace fee"
+
+type MarketplaceCase struct {
+	txManager             database.TxManager
+	userIDFetcher         domain.UserIDFetcher
+	usernameGetter        domain.UsernameGetter
+	goodsRepository       domain.GoodsRepository
+	variantsRepository    domain.VariantsRepository
+	balanceLocker         domain.UserBalanceLocker
+	balanceStatusChecker  domain.BalanceStatusChecker
+	listingsRepository    domain.ListingsRepository
+	listingsSeller        domain.ListingsSeller
+	inventoryCounter      domain.InventoryCounter
+	itemTransferProceeder domain.ItemTransferProceeder
+	purchaseLimitChecker  domain.PurchaseLimitChecker
+	companyPool           domain.CompanyPool
+	sendCoinsCase         *SendCoinsCase
+	feePercent            uint32
+}
+
+func NewMarketplaceCase(txManager database.TxManager,
+	userIDFetcher domain.UserIDFetcher,
+	usernameGetter domain.UsernameGetter,
+	goodsRepository domain.GoodsRepository,
+	variantsRepository domain.VariantsRepository,
+	balanceLocker domain.UserBalanceLocker,
+	balanceStatusChecker domain.BalanceStatusChecker,
+	listingsRepository domain.ListingsRepository,
+	listingsSeller domain.ListingsSeller,
+	inventoryCounter domain.InventoryCounter,
+	itemTransferProceeder domain.ItemTransferProceeder,
+	purchaseLimitChecker domain.PurchaseLimitChecker,
+	companyPool domain.CompanyPool,
+	sendCoinsCase *SendCoinsCase,
+	feePercent uint32) *MarketplaceCase {
+	return &MarketplaceCase{
+		txManager:             txManager,
+		userIDFetcher:         userIDFetcher,
+		usernameGetter:        usernameGetter,
+		goodsRepository:       goodsRepository,
+		variantsRepository:    variantsRepository,
+		balanceLocker:         balanceLocker,
+		balanceStatusChecker:  balanceStatusChecker,
+		listingsRepository:    listingsRepository,
+		listingsSeller:        listingsSeller,
+		inventoryCounter:      inventoryCounter,
+		itemTransferProceeder: itemTransferProceeder,
+		purchaseLimitChecker:  purchaseLimitChecker,
+		companyPool:           companyPool,
+		sendCoinsCase:         sendCoinsCase,
+		feePercent:            min(feePercent, domain.MaxMarketplaceFeePercent),
+	}
+}
+
+// CreateListing puts one unit of an owned good in the given variant up for sale, an empty variantSKU offering
+// a unit without a variant. A seller can't have more active listings of a variant than units of it
+// in the inventory.
+func (mc *MarketplaceCase) CreateListing(ctx context.Context, sellerID int, goodName, variantSKU string, price uint32) (int, error) {
+	if price == 0 {
+		return 0, &domain.InvalidArgumentsError{Msg: "price must be positive"}
+	}
+
+	goodInfo, err := mc.goodsRepository.GetGoodInfo(ctx, goodName)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get good info: %w", err)
+	}
+
+	variants, err := mc.variantsRepository.ListGoodVariants(ctx, goodInfo.Id)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list variants: %w", err)
+	}
+
+	variant, err := domain.OwnedVariant(variants, variantSKU)
+	if err != nil {
+		return 0, err
+	}
+
+	var listingID int
+	err = mc.txManager.WithinTransaction(ctx, func(ctx context.Context, executor database.QueryExecuter) error {
+		// The seller's balance row serializes concurrent listings of the same seller.
+		_, err := mc.balanceLocker.LockAndGetUserBalance(ctx, executor, sellerID)
+		if err != nil {
+			return fmt.Errorf("failed to lock and get balance for user %d: %w", sellerID, err)
+		}
+
+		err = checkNotFrozen(ctx, mc.balanceStatusChecker, executor, sellerID, "account is frozen")
+		if err != nil {
+			return err
+		}
+
+		owned, err := mc.inventoryCounter.CountOwnedItems(ctx, executor, sellerID, goodInfo.Id, variant.Id)
+		if err != nil {
+			return err
+		}
+
+		listed, err := mc.listingsRepository.CountActiveListings(ctx, executor, sellerID, goodInfo.Id, variant.Id)
+		if err != nil {
+			return err
+		}
+
+		if listed >= owned {
+			return &domain.ItemNotOwnedError{Msg: fmt.Sprintf("no unlisted %s in the inventory", goodName)}
+		}
+
+		listingID, err = mc.listingsRepository.CreateListing(ctx, executor, domain.Listing{
+			SellerID:  sellerID,
+			GoodID:    goodInfo.Id,
+			VariantID: variant.Id,
+			Price:     price,
+		})
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return listingID, nil
+}
+
+func (mc *MarketplaceCase) UpdateListingPrice(ctx context.Context, sellerID, listingID int, price uint32) error {
+	if price == 0 {
+		return &domain.InvalidArgumentsError{Msg: "price must be positive"}
+	}
+
+	return mc.listingsRepository.UpdateListingPrice(ctx, sellerID, listingID, price)
+}
+
+func (mc *MarketplaceCase) CancelListing(ctx context.Context, sellerID, listingID int) error {
+	return mc.listingsRepository.CancelListing(ctx, sellerID, listingID)
+}
+
+// SearchListings browses the active listings. A non-empty sellerUsername limits the result to that seller.
+func (mc *MarketplaceCase) SearchListings(ctx context.Context, filter domain.ListingFilter, sellerUsername string) ([]domain.NamedListing, error) {
+	if sellerUsername != "" {
+		sellerID, err := mc.userIDFetcher.FetchUserID(ctx, sellerUsername)
+		if err != nil {
+			return nil, &domain.UserNotFoundError{Msg: fmt.Sprintf("user not found: %s", sellerUsername)}
+		}
+
+		filter.SellerID = sellerID
+	}
+
+	listings, err := mc.listingsRepository.SearchListings(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(listings) == 0 {
+		return []domain.NamedListing{}, nil
+	}
+
+	sellerIDs := make([]int, 0, len(listings))
+	for _, listing := range listings {
+		sellerIDs = append(sellerIDs, listing.SellerID)
+	}
+
+	usernames, err := mc.usernameGetter.GetUsernames(ctx, sellerIDs...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get seller usernames: %w", err)
+	}
+
+	named := make([]domain.NamedListing, 0, len(listings))
+	for _, listing := range listings {
+		named = append(named, domain.NamedListing{
+			Listing:        listing,
+			SellerUsername: usernames[listing.SellerID],
+		})
+	}
+
+	return named, nil
+}
+
+// BuyListing pays the seller with a regular coin transfer and moves the item to the buyer in one transaction,
+// within the buyer's purchase limits. The marketplace fee is then passed from the seller to the company pool. A listing whose seller no longer
+// owns the item is cancelled instead.
+func (mc *MarketplaceCase) BuyListing(ctx context.Context, buyerID, listingID int) error {
+	unavailable := false
+
+	err := mc.txManager.WithinTransaction(ctx, func(ctx context.Context, executor database.QueryExecuter) error {
+		listing, err := mc.listingsSeller.LockAndGetListing(ctx, executor, listingID)
+		if err != nil {
+			return fmt.Errorf("failed to lock listing %d: %w", listingID, err)
+		}
+
+		if listing.Status != domain.ListingStatusActive {
+			return &domain.ListingClosedError{Msg: fmt.Sprintf("listing %d is already %s", listingID, listing.Status)}
+		}
+
+		if listing.SellerID == buyerID {
+			return &domain.InvalidArgumentsError{Msg: "cannot buy your own listing"}
+		}
+
+		owned, err := mc.inventoryCounter.CountOwnedItems(ctx, executor, listing.SellerID, listing.GoodID, listing.VariantID)
+		if err != nil {
+			return err
+		}
+
+		if owned == 0 {
+			unavailable = true
+			return mc.listingsSeller.CloseListing(ctx, executor, listingID, domain.ListingStatusCancelled)
+		}
+
+		sellerUsername, err := mc.usernameGetter.GetUsername(ctx, listing.SellerID)
+		if err != nil {
+			return fmt.Errorf("failed to get username of user %d: %w", listing.SellerID, err)
+		}
+
+		err = mc.sendCoinsCase.proceedPayment(ctx, executor, buyerID, listing.SellerID, sellerUsername, listing.Price)
+		if err != nil {
+			return err
+		}
+
+		// The buyer's balance is locked by the payment, so the limit check is serialized with their other purchases.
+		err = checkPurchaseLimits(ctx, mc.purchaseLimitChecker, executor, buyerID, map[int]uint32{listing.GoodID: 1})
+		if err != nil {
+			return err
+		}
+
+		fee := domain.MarketplaceFee(listing.Price, mc.feePercent)
+		if fee > 0 {
+			err = mc.companyPool.TransferToPool(ctx, executor, listing.SellerID, fee, marketplaceFeeReason)
+			if err != nil {
+				return fmt.Errorf("failed to collect marketplace fee: %w", err)
+			}
+		}
+
+		err = mc.itemTransferProceeder.ProceedItemTransfer(ctx, executor, listing.SellerID, buyerID, listing.GoodID,
+			listing.VariantID)
+		if err != nil {
+			return fmt.Errorf("failed to transfer item: %w", err)
+		}
+
+		return mc.listingsSeller.MarkListingSold(ctx, executor, listingID, buyerID, fee)
+	})
+	if err != nil {
+		return err
+	}
+
+	if unavailable {
+		return &domain.ListingClosedError{Msg: fmt.Sprintf("listing %d is no longer available", listingID)}
+	}
+
+	return nil
+}
@@ -0,0 +1,487 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	dbmocks "github.com/Lexv0lk/merch-store/gen/mocks/database"
+	storemocks "github.com/Lexv0lk/merch-store/gen/mocks/store"
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarketplaceCase_CreateListing(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		txManager            *dbmocks.MockTxManager
+		goodsRepository      *storemocks.MockGoodsRepository
+		variantsRepository   *storemocks.MockVariantsRepository
+		balanceLocker        *storemocks.MockUserBalanceLocker
+		balanceStatusChecker *storemocks.MockBalanceStatusChecker
+		listingsRepository   *storemocks.MockListingsRepository
+		inventoryCounter     *storemocks.MockInventoryCounter
+	}
+
+	type testCase struct {
+		name    string
+		variant string
+		price   uint32
+
+		prepareFn func(t *testing.T, d *deps)
+
+		expectedID  int
+		expectedErr error
+	}
+
+	executeTxFn := func(ctx context.Context, txFn database.TxFunc) error {
+		return txFn(ctx, nil)
+	}
+
+	cup := domain.GoodInfo{Id: 10, Name: "cup", Price: 20}
+	cupSizes := []domain.Variant{{Id: 4, GoodID: 10, SKU: "cup-l"}}
+
+	tests := []testCase{
+		{
+			name:  "listing created",
+			price: 15,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "cup").Return(cup, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 10).Return(cupSizes, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).Return(uint32(100), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).Return(false, nil)
+				d.inventoryCounter.EXPECT().CountOwnedItems(gomock.Any(), nil, 1, 10, 0).Return(2, nil)
+				d.listingsRepository.EXPECT().CountActiveListings(gomock.Any(), nil, 1, 10, 0).Return(1, nil)
+				d.listingsRepository.EXPECT().CreateListing(gomock.Any(), nil, domain.Listing{SellerID: 1, GoodID: 10, Price: 15}).
+					Return(7, nil)
+			},
+			expectedID: 7,
+		},
+		{
+			name:    "variant listed",
+			variant: "cup-l",
+			price:   25,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "cup").Return(cup, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 10).Return(cupSizes, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).Return(uint32(100), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).Return(false, nil)
+				d.inventoryCounter.EXPECT().CountOwnedItems(gomock.Any(), nil, 1, 10, 4).Return(1, nil)
+				d.listingsRepository.EXPECT().CountActiveListings(gomock.Any(), nil, 1, 10, 4).Return(0, nil)
+				d.listingsRepository.EXPECT().CreateListing(gomock.Any(), nil,
+					domain.Listing{SellerID: 1, GoodID: 10, VariantID: 4, Price: 25}).Return(8, nil)
+			},
+			expectedID: 8,
+		},
+		{
+			name:    "unknown variant",
+			variant: "cup-xl",
+			price:   25,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "cup").Return(cup, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 10).Return(cupSizes, nil)
+			},
+			expectedErr: &domain.VariantNotFoundError{},
+		},
+		{
+			name:  "every unit already listed",
+			price: 15,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "cup").Return(cup, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 10).Return(cupSizes, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).Return(uint32(100), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).Return(false, nil)
+				d.inventoryCounter.EXPECT().CountOwnedItems(gomock.Any(), nil, 1, 10, 0).Return(1, nil)
+				d.listingsRepository.EXPECT().CountActiveListings(gomock.Any(), nil, 1, 10, 0).Return(1, nil)
+			},
+			expectedErr: &domain.ItemNotOwnedError{},
+		},
+		{
+			name:  "seller frozen",
+			price: 15,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "cup").Return(cup, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 10).Return(cupSizes, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).Return(uint32(100), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).Return(true, nil)
+			},
+			expectedErr: &domain.AccountFrozenError{},
+		},
+		{
+			name:  "unknown good",
+			price: 15,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "cup").
+					Return(domain.GoodInfo{}, &domain.GoodNotFoundError{})
+			},
+			expectedErr: &domain.GoodNotFoundError{},
+		},
+		{
+			name:        "zero price",
+			price:       0,
+			prepareFn:   func(t *testing.T, d *deps) {},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := &deps{
+				txManager:            dbmocks.NewMockTxManager(ctrl),
+				goodsRepository:      storemocks.NewMockGoodsRepository(ctrl),
+				variantsRepository:   storemocks.NewMockVariantsRepository(ctrl),
+				balanceLocker:        storemocks.NewMockUserBalanceLocker(ctrl),
+				balanceStatusChecker: storemocks.NewMockBalanceStatusChecker(ctrl),
+				listingsRepository:   storemocks.NewMockListingsRepository(ctrl),
+				inventoryCounter:     storemocks.NewMockInventoryCounter(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			sendCoinsCase := NewSendCoinsCase(d.txManager, storemocks.NewMockUserIDFetcher(ctrl), d.balanceLocker,
+				storemocks.NewMockBalanceEnsurer(ctrl), d.balanceStatusChecker,
+				storemocks.NewMockTransferLimitsProvider(ctrl), storemocks.NewMockTransferStatsFetcher(ctrl),
+				storemocks.NewMockTransactionProceeder(ctrl), storemocks.NewMockEventOutbox(ctrl))
+
+			marketplaceCase := NewMarketplaceCase(d.txManager, storemocks.NewMockUserIDFetcher(ctrl),
+				storemocks.NewMockUsernameGetter(ctrl), d.goodsRepository, d.variantsRepository, d.balanceLocker,
+				d.balanceStatusChecker, d.listingsRepository, storemocks.NewMockListingsSeller(ctrl), d.inventoryCounter,
+				storemocks.NewMockItemTransferProceeder(ctrl), storemocks.NewMockPurchaseLimitChecker(ctrl),
+				storemocks.NewMockCompanyPool(ctrl), sendCoinsCase, 0)
+			listingID, err := marketplaceCase.CreateListing(t.Context(), 1, "cup", tt.variant, tt.price)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedID, listingID)
+			}
+		})
+	}
+}
+
+func TestMarketplaceCase_SearchListings(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		userIDFetcher      *storemocks.MockUserIDFetcher
+		usernameGetter     *storemocks.MockUsernameGetter
+		listingsRepository *storemocks.MockListingsRepository
+	}
+
+	type testCase struct {
+		name           string
+		filter         domain.ListingFilter
+		sellerUsername string
+
+		prepareFn func(t *testing.T, d *deps)
+
+		expectedListings []domain.NamedListing
+		expectedErr      error
+	}
+
+	cupListing := domain.Listing{Id: 7, SellerID: 2, GoodID: 10, GoodName: "cup", Price: 15, Status: domain.ListingStatusActive}
+
+	tests := []testCase{
+		{
+			name:   "listings with seller names",
+			filter: domain.ListingFilter{Query: "cup"},
+			prepareFn: func(t *testing.T, d *deps) {
+				d.listingsRepository.EXPECT().SearchListings(gomock.Any(), domain.ListingFilter{Query: "cup"}).
+					Return([]domain.Listing{cupListing}, nil)
+				d.usernameGetter.EXPECT().GetUsernames(gomock.Any(), 2).Return(map[int]string{2: "seller"}, nil)
+			},
+			expectedListings: []domain.NamedListing{{Listing: cupListing, SellerUsername: "seller"}},
+		},
+		{
+			name:           "filtered by seller",
+			sellerUsername: "seller",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "seller").Return(2, nil)
+				d.listingsRepository.EXPECT().SearchListings(gomock.Any(), domain.ListingFilter{SellerID: 2}).
+					Return([]domain.Listing{}, nil)
+			},
+			expectedListings: []domain.NamedListing{},
+		},
+		{
+			name:           "unknown seller",
+			sellerUsername: "ghost",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "ghost").Return(0, assert.AnError)
+			},
+			expectedErr: &domain.UserNotFoundError{},
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := &deps{
+				userIDFetcher:      storemocks.NewMockUserIDFetcher(ctrl),
+				usernameGetter:     storemocks.NewMockUsernameGetter(ctrl),
+				listingsRepository: storemocks.NewMockListingsRepository(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			sendCoinsCase := NewSendCoinsCase(dbmocks.NewMockTxManager(ctrl), d.userIDFetcher,
+				storemocks.NewMockUserBalanceLocker(ctrl), storemocks.NewMockBalanceEnsurer(ctrl),
+				storemocks.NewMockBalanceStatusChecker(ctrl), storemocks.NewMockTransferLimitsProvider(ctrl),
+				storemocks.NewMockTransferStatsFetcher(ctrl), storemocks.NewMockTransactionProceeder(ctrl),
+				storemocks.NewMockEventOutbox(ctrl))
+
+			marketplaceCase := NewMarketplaceCase(dbmocks.NewMockTxManager(ctrl), d.userIDFetcher, d.usernameGetter,
+				storemocks.NewMockGoodsRepository(ctrl), storemocks.NewMockVariantsRepository(ctrl),
+				storemocks.NewMockUserBalanceLocker(ctrl), storemocks.NewMockBalanceStatusChecker(ctrl), d.listingsRepository,
+				storemocks.NewMockListingsSeller(ctrl), storemocks.NewMockInventoryCounter(ctrl),
+				storemocks.NewMockItemTransferProceeder(ctrl), storemocks.NewMockPurchaseLimitChecker(ctrl),
+				storemocks.NewMockCompanyPool(ctrl), sendCoinsCase, 0)
+			listings, err := marketplaceCase.SearchListings(t.Context(), tt.filter, tt.sellerUsername)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedListings, listings)
+			}
+		})
+	}
+}
+
+func TestMarketplaceCase_BuyListing(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		txManager             *dbmocks.MockTxManager
+		usernameGetter        *storemocks.MockUsernameGetter
+		balanceLocker         *storemocks.MockUserBalanceLocker
+		balanceStatusChecker  *storemocks.MockBalanceStatusChecker
+		listingsSeller        *storemocks.MockListingsSeller
+		inventoryCounter      *storemocks.MockInventoryCounter
+		itemTransferProceeder *storemocks.MockItemTransferProceeder
+		purchaseLimitChecker  *storemocks.MockPurchaseLimitChecker
+		companyPool           *storemocks.MockCompanyPool
+		limitsProvider        *storemocks.MockTransferLimitsProvider
+		statsFetcher          *storemocks.MockTransferStatsFetcher
+		transactionProceeder  *storemocks.MockTransactionProceeder
+		eventOutbox           *storemocks.MockEventOutbox
+	}
+
+	type testCase struct {
+		name       string
+		feePercent uint32
+
+		prepareFn func(t *testing.T, d *deps)
+
+		expectedErr error
+	}
+
+	executeTxFn := func(ctx context.Context, txFn database.TxFunc) error {
+		return txFn(ctx, nil)
+	}
+
+	active := domain.Listing{Id: 7, SellerID: 2, GoodID: 10, GoodName: "cup", Price: 200, Status: domain.ListingStatusActive}
+
+	expectPayment := func(d *deps, price uint32) {
+		d.usernameGetter.EXPECT().GetUsername(gomock.Any(), 2).Return("seller", nil)
+		d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).Return(uint32(1000), nil)
+		d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 1).Return(true, nil)
+		d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).Return(false, nil)
+		d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 2).Return(true, nil)
+		d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 2).Return(false, nil)
+		d.transactionProceeder.EXPECT().ProceedTransaction(gomock.Any(), nil, price, 1, 2).Return(nil)
+		d.eventOutbox.EXPECT().AppendEvent(gomock.Any(), nil, domain.CoinsSentEvent(1, 2, price)).Return(nil)
+	}
+
+	tests := []testCase{
+		{
+			name:       "listing bought with fee",
+			feePercent: 5,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.listingsSeller.EXPECT().LockAndGetListing(gomock.Any(), nil, 7).Return(active, nil)
+				d.inventoryCounter.EXPECT().CountOwnedItems(gomock.Any(), nil, 2, 10, 0).Return(1, nil)
+				expectPayment(d, 200)
+				d.purchaseLimitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{10}).
+					Return([]domain.PurchaseLimit{}, nil)
+				d.companyPool.EXPECT().TransferToPool(gomock.Any(), nil, 2, uint32(10), marketplaceFeeReason).Return(nil)
+				d.itemTransferProceeder.EXPECT().ProceedItemTransfer(gomock.Any(), nil, 2, 1, 10, 0).Return(nil)
+				d.listingsSeller.EXPECT().MarkListingSold(gomock.Any(), nil, 7, 1, uint32(10)).Return(nil)
+			},
+		},
+		{
+			name:       "listing bought without fee",
+			feePercent: 0,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.listingsSeller.EXPECT().LockAndGetListing(gomock.Any(), nil, 7).Return(active, nil)
+				d.inventoryCounter.EXPECT().CountOwnedItems(gomock.Any(), nil, 2, 10, 0).Return(1, nil)
+				expectPayment(d, 200)
+				d.purchaseLimitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{10}).
+					Return([]domain.PurchaseLimit{}, nil)
+				d.itemTransferProceeder.EXPECT().ProceedItemTransfer(gomock.Any(), nil, 2, 1, 10, 0).Return(nil)
+				d.listingsSeller.EXPECT().MarkListingSold(gomock.Any(), nil, 7, 1, uint32(0)).Return(nil)
+			},
+		},
+		{
+			name:       "listing priced over the transfer limits bought",
+			feePercent: 0,
+			prepareFn: func(t *testing.T, d *deps) {
+				pricey := active
+				pricey.Price = 900
+
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.listingsSeller.EXPECT().LockAndGetListing(gomock.Any(), nil, 7).Return(pricey, nil)
+				d.inventoryCounter.EXPECT().CountOwnedItems(gomock.Any(), nil, 2, 10, 0).Return(1, nil)
+				expectPayment(d, 900)
+				d.purchaseLimitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{10}).
+					Return([]domain.PurchaseLimit{}, nil)
+				d.itemTransferProceeder.EXPECT().ProceedItemTransfer(gomock.Any(), nil, 2, 1, 10, 0).Return(nil)
+				d.listingsSeller.EXPECT().MarkListingSold(gomock.Any(), nil, 7, 1, uint32(0)).Return(nil)
+			},
+		},
+		{
+			name:       "variant listing bought",
+			feePercent: 0,
+			prepareFn: func(t *testing.T, d *deps) {
+				variant := active
+				variant.VariantID = 4
+				variant.Variant = "cup-l"
+
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.listingsSeller.EXPECT().LockAndGetListing(gomock.Any(), nil, 7).Return(variant, nil)
+				d.inventoryCounter.EXPECT().CountOwnedItems(gomock.Any(), nil, 2, 10, 4).Return(1, nil)
+				expectPayment(d, 200)
+				d.purchaseLimitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{10}).
+					Return([]domain.PurchaseLimit{}, nil)
+				d.itemTransferProceeder.EXPECT().ProceedItemTransfer(gomock.Any(), nil, 2, 1, 10, 4).Return(nil)
+				d.listingsSeller.EXPECT().MarkListingSold(gomock.Any(), nil, 7, 1, uint32(0)).Return(nil)
+			},
+		},
+		{
+			name: "buyer over purchase limit",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.listingsSeller.EXPECT().LockAndGetListing(gomock.Any(), nil, 7).Return(active, nil)
+				d.inventoryCounter.EXPECT().CountOwnedItems(gomock.Any(), nil, 2, 10, 0).Return(1, nil)
+				expectPayment(d, 200)
+				d.purchaseLimitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{10}).
+					Return([]domain.PurchaseLimit{{GoodID: 10, GoodName: "cup", MaxQuantity: 1}}, nil)
+				d.purchaseLimitChecker.EXPECT().CountUserAcquisitions(gomock.Any(), nil, 1, 10, time.Time{}).
+					Return(uint32(1), nil)
+			},
+			expectedErr: &domain.PurchaseLimitError{},
+		},
+		{
+			name: "seller no longer owns the item",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.listingsSeller.EXPECT().LockAndGetListing(gomock.Any(), nil, 7).Return(active, nil)
+				d.inventoryCounter.EXPECT().CountOwnedItems(gomock.Any(), nil, 2, 10, 0).Return(0, nil)
+				d.listingsSeller.EXPECT().CloseListing(gomock.Any(), nil, 7, domain.ListingStatusCancelled).Return(nil)
+			},
+			expectedErr: &domain.ListingClosedError{},
+		},
+		{
+			name: "insufficient balance",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.listingsSeller.EXPECT().LockAndGetListing(gomock.Any(), nil, 7).Return(active, nil)
+				d.inventoryCounter.EXPECT().CountOwnedItems(gomock.Any(), nil, 2, 10, 0).Return(1, nil)
+				d.usernameGetter.EXPECT().GetUsername(gomock.Any(), 2).Return("seller", nil)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).Return(uint32(100), nil)
+			},
+			expectedErr: &domain.InsufficientBalanceError{},
+		},
+		{
+			name: "own listing",
+			prepareFn: func(t *testing.T, d *deps) {
+				own := active
+				own.SellerID = 1
+
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.listingsSeller.EXPECT().LockAndGetListing(gomock.Any(), nil, 7).Return(own, nil)
+			},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name: "listing already sold",
+			prepareFn: func(t *testing.T, d *deps) {
+				sold := active
+				sold.Status = domain.ListingStatusSold
+
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.listingsSeller.EXPECT().LockAndGetListing(gomock.Any(), nil, 7).Return(sold, nil)
+			},
+			expectedErr: &domain.ListingClosedError{},
+		},
+		{
+			name: "listing not found",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.listingsSeller.EXPECT().LockAndGetListing(gomock.Any(), nil, 7).
+					Return(domain.Listing{}, &domain.ListingNotFoundError{})
+			},
+			expectedErr: &domain.ListingNotFoundError{},
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := &deps{
+				txManager:             dbmocks.NewMockTxManager(ctrl),
+				usernameGetter:        storemocks.NewMockUsernameGetter(ctrl),
+				balanceLocker:         storemocks.NewMockUserBalanceLocker(ctrl),
+				balanceStatusChecker:  storemocks.NewMockBalanceStatusChecker(ctrl),
+				listingsSeller:        storemocks.NewMockListingsSeller(ctrl),
+				inventoryCounter:      storemocks.NewMockInventoryCounter(ctrl),
+				itemTransferProceeder: storemocks.NewMockItemTransferProceeder(ctrl),
+				purchaseLimitChecker:  storemocks.NewMockPurchaseLimitChecker(ctrl),
+				companyPool:           storemocks.NewMockCompanyPool(ctrl),
+				limitsProvider:        storemocks.NewMockTransferLimitsProvider(ctrl),
+				statsFetcher:          storemocks.NewMockTransferStatsFetcher(ctrl),
+				transactionProceeder:  storemocks.NewMockTransactionProceeder(ctrl),
+				eventOutbox:           storemocks.NewMockEventOutbox(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			sendCoinsCase := NewSendCoinsCase(d.txManager, storemocks.NewMockUserIDFetcher(ctrl), d.balanceLocker,
+				storemocks.NewMockBalanceEnsurer(ctrl), d.balanceStatusChecker, d.limitsProvider, d.statsFetcher,
+				d.transactionProceeder, d.eventOutbox)
+
+			marketplaceCase := NewMarketplaceCase(d.txManager, storemocks.NewMockUserIDFetcher(ctrl), d.usernameGetter,
+				storemocks.NewMockGoodsRepository(ctrl), storemocks.NewMockVariantsRepository(ctrl), d.balanceLocker,
+				d.balanceStatusChecker, storemocks.NewMockListingsRepository(ctrl), d.listingsSeller, d.inventoryCounter,
+				d.itemTransferProceeder, d.purchaseLimitChecker, d.companyPool, sendCoinsCase, tt.feePercent)
+			err := marketplaceCase.BuyListing(t.Context(), 1, 7)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,280 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+)
+
+type NotificationsCase struct {
+	eventLog       domain.EventLog
+	balanceFetcher domain.BalanceFetcher
+	usernameGetter domain.UsernameGetter
+
+	mu            sync.Mutex
+	polling       bool
+	offset        int64
+	subscriptions map[int]map[*subscription]struct{}
+}
+
+// NewNotificationsCase creates the case pushing changes to subscribed users. The events reach the subscribers
+// once PollEvents is run.
+func NewNotificationsCase(eventLog domain.EventLog,
+	balanceFetcher domain.BalanceFetcher,
+	usernameGetter domain.UsernameGetter) *NotificationsCase {
+	return &NotificationsCase{
+		eventLog:       eventLog,
+		balanceFetcher: balanceFetcher,
+		usernameGetter: usernameGetter,
+		subscriptions:  make(map[int]map[*subscription]struct{}),
+	}
+}
+
+// subscription is the position of a subscriber: the last event passed to it, the events it hasn't handled yet
+// and the last balance it got. The offset and the events are guarded by the case's mutex.
+type subscription struct {
+	userID  int
+	offset  int64
+	events  []domain.DomainEvent
+	ready   chan struct{}
+	balance uint32
+}
+
+// Subscribe sends the user's current balance and then, until the context is done or send fails, the changes
+// recorded after the subscription: coins received from other users, status updates of the user's pre-orders and
+// the new balance whenever an event concerning the user changed it.
+func (nc *NotificationsCase) Subscribe(ctx context.Context, userID int, send func(domain.Notification) error) error {
+	sub, err := nc.subscribe(ctx, userID)
+	if err != nil {
+		return err
+	}
+	defer nc.unsubscribe(sub)
+
+	sub.balance, err = nc.balanceFetcher.FetchUserBalance(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch balance: %w", err)
+	}
+
+	err = send(domain.BalanceNotification(sub.balance, time.Now()))
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-sub.ready:
+			err = nc.notify(ctx, sub, nc.takeEvents(sub), send)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// PollEvents fetches the events recorded since the last poll once for all the subscribers of the store instance
+// and passes each subscriber the events concerning its user. Nothing is fetched while no one is subscribed.
+func (nc *NotificationsCase) PollEvents(ctx context.Context) error {
+	nc.mu.Lock()
+	polling, offset := nc.polling, nc.offset
+	nc.mu.Unlock()
+
+	if !polling {
+		return nil
+	}
+
+	for {
+		events, err := nc.eventLog.FetchEvents(ctx, offset, domain.EventRelayBatch)
+		if err != nil {
+			return err
+		}
+
+		if len(events) == 0 {
+			return nil
+		}
+
+		nc.dispatch(events)
+		if len(events) < domain.EventRelayBatch {
+			return nil
+		}
+
+		offset = events[len(events)-1].Offset
+	}
+}
+
+// subscribe registers the subscriber. The first subscriber starts the polling from the last event, later ones
+// start from the event the polling reached, so no event is passed twice or skipped.
+func (nc *NotificationsCase) subscribe(ctx context.Context, userID int) (*subscription, error) {
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+
+	if !nc.polling {
+		offset, err := nc.eventLog.GetLastEventOffset(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		nc.offset = offset
+		nc.polling = true
+	}
+
+	sub := &subscription{userID: userID, offset: nc.offset, ready: make(chan struct{}, 1)}
+	if nc.subscriptions[userID] == nil {
+		nc.subscriptions[userID] = make(map[*subscription]struct{})
+	}
+	nc.subscriptions[userID][sub] = struct{}{}
+
+	return sub, nil
+}
+
+// unsubscribe removes the subscriber and stops the polling once the last one is gone.
+func (nc *NotificationsCase) unsubscribe(sub *subscription) {
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+
+	delete(nc.subscriptions[sub.userID], sub)
+	if len(nc.subscriptions[sub.userID]) == 0 {
+		delete(nc.subscriptions, sub.userID)
+	}
+
+	if len(nc.subscriptions) == 0 {
+		nc.polling = false
+	}
+}
+
+// dispatch queues the events for the subscribers of the users they concern, skipping the ones a subscriber got
+// already, and wakes up the subscribers without blocking the polling.
+func (nc *NotificationsCase) dispatch(events []domain.DomainEvent) {
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+
+	if !nc.polling {
+		return
+	}
+
+	woken := make(map[*subscription]struct{})
+	for _, event := range events {
+		for _, userID := range event.UserIDs {
+			for sub := range nc.subscriptions[userID] {
+				if event.Offset <= sub.offset {
+					continue
+				}
+
+				sub.events = append(sub.events, event)
+				sub.offset = event.Offset
+				woken[sub] = struct{}{}
+			}
+		}
+	}
+
+	for sub := range woken {
+		select {
+		case sub.ready <- struct{}{}:
+		default:
+		}
+	}
+
+	nc.offset = max(nc.offset, events[len(events)-1].Offset)
+}
+
+func (nc *NotificationsCase) takeEvents(sub *subscription) []domain.DomainEvent {
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+
+	events := sub.events
+	sub.events = nil
+
+	return events
+}
+
+// notify sends the notifications of the events, followed by the balance if it changed.
+func (nc *NotificationsCase) notify(ctx context.Context,
+	sub *subscription,
+	events []domain.DomainEvent,
+	send func(domain.Notification) error) error {
+	notifications, err := nc.eventNotifications(ctx, sub.userID, events)
+	if err != nil {
+		return err
+	}
+
+	for _, notification := range notifications {
+		err = send(notification)
+		if err != nil {
+			return err
+		}
+	}
+
+	balance, err := nc.balanceFetcher.FetchUserBalance(ctx, sub.userID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch balance: %w", err)
+	}
+
+	if balance != sub.balance {
+		sub.balance = balance
+		return send(domain.BalanceNotification(balance, time.Now()))
+	}
+
+	return nil
+}
+
+// eventNotifications keeps the incoming coin transfers and the pre-order updates of the user's events, resolving
+// the senders' usernames.
+func (nc *NotificationsCase) eventNotifications(ctx context.Context, userID int, events []domain.DomainEvent) ([]domain.Notification, error) {
+	senderIDs := make([]int, 0)
+	for _, event := range events {
+		if event.Type == domain.EventCoinsSent && eventInt(event.Data, "toUserId") == userID {
+			senderIDs = append(senderIDs, eventInt(event.Data, "fromUserId"))
+		}
+	}
+
+	var senders map[int]string
+	if len(senderIDs) > 0 {
+		var err error
+		senders, err = nc.usernameGetter.GetUsernames(ctx, senderIDs...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get sender usernames: %w", err)
+		}
+	}
+
+	notifications := make([]domain.Notification, 0, len(events))
+	for _, event := range events {
+		switch event.Type {
+		case domain.EventCoinsSent:
+			if eventInt(event.Data, "toUserId") != userID {
+				continue
+			}
+
+			notifications = append(notifications, domain.TransferNotification(
+				senders[eventInt(event.Data, "fromUserId")], uint32(eventInt(event.Data, "amount")), event.OccurredAt))
+		case domain.EventPreorderStatusChanged:
+			notifications = append(notifications, domain.OrderNotification(eventInt(event.Data, "preorderId"),
+				eventString(event.Data, "item"), eventString(event.Data, "variant"),
+				eventString(event.Data, "status"), event.OccurredAt))
+		}
+	}
+
+	return notifications, nil
+}
+
+// eventInt reads a number of the event data, decoded from JSON as float64 once the event is stored.
+func eventInt(data map[string]any, key string) int {
+	switch value := data[key].(type) {
+	case float64:
+		return int(value)
+	case int:
+		return value
+	case uint32:
+		return int(value)
+	default:
+		return 0
+	}
+}
+
+func eventString(data map[string]any, key string) string {
+	value, _ := data[key].(string)
+	return value
+}
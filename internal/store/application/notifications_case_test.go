@@ -0,0 +1,254 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	storemocks "github.com/Lexv0lk/merch-store/gen/mocks/store"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationsCase_Subscribe(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		eventLog       *storemocks.MockEventLog
+		balanceFetcher *storemocks.MockBalanceFetcher
+		usernameGetter *storemocks.MockUsernameGetter
+	}
+
+	ctrl := gomock.NewController(t)
+	d := &deps{
+		eventLog:       storemocks.NewMockEventLog(ctrl),
+		balanceFetcher: storemocks.NewMockBalanceFetcher(ctrl),
+		usernameGetter: storemocks.NewMockUsernameGetter(ctrl),
+	}
+
+	occurredAt := time.Date(2026, 8, 10, 10, 0, 0, 0, time.UTC)
+	events := []domain.DomainEvent{
+		{Offset: 12, Type: domain.EventCoinsSent, UserIDs: []int{2, 1}, OccurredAt: occurredAt,
+			Data: map[string]any{"fromUserId": float64(2), "toUserId": float64(1), "amount": float64(100)}},
+		{Offset: 13, Type: domain.EventCoinsSent, UserIDs: []int{1, 3}, OccurredAt: occurredAt,
+			Data: map[string]any{"fromUserId": float64(1), "toUserId": float64(3), "amount": float64(30)}},
+		{Offset: 14, Type: domain.EventPreorderStatusChanged, UserIDs: []int{1}, OccurredAt: occurredAt,
+			Data: map[string]any{"preorderId": float64(3), "item": "umbrella", "variant": "", "status": "fulfilled"}},
+		{Offset: 15, Type: domain.EventItemPurchased, UserIDs: []int{1}, OccurredAt: occurredAt,
+			Data: map[string]any{"userId": float64(1), "buyerId": float64(1), "price": float64(200)}},
+		{Offset: 16, Type: domain.EventCoinsSent, UserIDs: []int{4, 5}, OccurredAt: occurredAt,
+			Data: map[string]any{"fromUserId": float64(4), "toUserId": float64(5), "amount": float64(500)}},
+	}
+
+	gomock.InOrder(
+		d.eventLog.EXPECT().GetLastEventOffset(gomock.Any()).Return(int64(11), nil),
+		d.balanceFetcher.EXPECT().FetchUserBalance(gomock.Any(), 1).Return(uint32(1000), nil),
+		d.eventLog.EXPECT().FetchEvents(gomock.Any(), int64(11), domain.EventRelayBatch).Return(events, nil),
+		d.usernameGetter.EXPECT().GetUsernames(gomock.Any(), 2).Return(map[int]string{2: "bob"}, nil),
+		d.balanceFetcher.EXPECT().FetchUserBalance(gomock.Any(), 1).Return(uint32(1070), nil),
+	)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	notificationsCase := NewNotificationsCase(d.eventLog, d.balanceFetcher, d.usernameGetter)
+	notifications := make(chan domain.Notification, 4)
+	done := make(chan error, 1)
+	go func() {
+		done <- notificationsCase.Subscribe(ctx, 1, func(notification domain.Notification) error {
+			notifications <- notification
+			return nil
+		})
+	}()
+
+	balance := <-notifications
+	assert.Equal(t, domain.NotificationBalance, balance.Type)
+	assert.Equal(t, map[string]any{"balance": uint32(1000)}, balance.Data)
+
+	require.NoError(t, notificationsCase.PollEvents(ctx))
+
+	assert.Equal(t, domain.TransferNotification("bob", 100, occurredAt), <-notifications)
+	assert.Equal(t, domain.OrderNotification(3, "umbrella", "", "fulfilled", occurredAt), <-notifications)
+	assert.Equal(t, map[string]any{"balance": uint32(1070)}, (<-notifications).Data)
+
+	cancel()
+	require.NoError(t, <-done)
+	assert.Empty(t, notificationsCase.subscriptions)
+}
+
+func TestNotificationsCase_Subscribe_Errors(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		eventLog       *storemocks.MockEventLog
+		balanceFetcher *storemocks.MockBalanceFetcher
+	}
+
+	sendErr := errors.New("stream closed")
+
+	type testCase struct {
+		name string
+
+		prepareFn func(t *testing.T, d *deps)
+		sendErr   error
+
+		expectedErr error
+	}
+
+	tests := []testCase{
+		{
+			name: "event log failure",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.eventLog.EXPECT().GetLastEventOffset(gomock.Any()).Return(int64(0), assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+		{
+			name: "balance not found",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.eventLog.EXPECT().GetLastEventOffset(gomock.Any()).Return(int64(11), nil)
+				d.balanceFetcher.EXPECT().FetchUserBalance(gomock.Any(), 1).Return(uint32(0), &domain.UserNotFoundError{})
+			},
+			expectedErr: &domain.UserNotFoundError{},
+		},
+		{
+			name: "subscriber gone",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.eventLog.EXPECT().GetLastEventOffset(gomock.Any()).Return(int64(11), nil)
+				d.balanceFetcher.EXPECT().FetchUserBalance(gomock.Any(), 1).Return(uint32(1000), nil)
+			},
+			sendErr:     sendErr,
+			expectedErr: sendErr,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			d := &deps{
+				eventLog:       storemocks.NewMockEventLog(ctrl),
+				balanceFetcher: storemocks.NewMockBalanceFetcher(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			notificationsCase := NewNotificationsCase(d.eventLog, d.balanceFetcher, storemocks.NewMockUsernameGetter(ctrl))
+			err := notificationsCase.Subscribe(t.Context(), 1, func(domain.Notification) error {
+				return tt.sendErr
+			})
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.Empty(t, notificationsCase.subscriptions)
+			assert.False(t, notificationsCase.polling)
+		})
+	}
+}
+
+func TestNotificationsCase_PollEvents(t *testing.T) {
+	t.Parallel()
+
+	coinsSent := func(offset int64, fromUserID, toUserID int) domain.DomainEvent {
+		return domain.DomainEvent{Offset: offset, Type: domain.EventCoinsSent, UserIDs: []int{fromUserID, toUserID}}
+	}
+
+	fullBatch := make([]domain.DomainEvent, 0, domain.EventRelayBatch)
+	for i := range domain.EventRelayBatch {
+		fullBatch = append(fullBatch, coinsSent(int64(11+i), 3, 4))
+	}
+
+	type testCase struct {
+		name string
+
+		subscribers []int
+		prepareFn   func(t *testing.T, eventLog *storemocks.MockEventLog)
+
+		expectedOffsets []int64
+		expectedEvents  []int
+		expectedErr     error
+	}
+
+	tests := []testCase{
+		{
+			name: "no subscribers",
+			prepareFn: func(t *testing.T, eventLog *storemocks.MockEventLog) {
+			},
+		},
+		{
+			name:        "events passed to the subscribers of their users",
+			subscribers: []int{1, 1, 2},
+			prepareFn: func(t *testing.T, eventLog *storemocks.MockEventLog) {
+				eventLog.EXPECT().GetLastEventOffset(gomock.Any()).Return(int64(10), nil)
+				eventLog.EXPECT().FetchEvents(gomock.Any(), int64(10), domain.EventRelayBatch).
+					Return([]domain.DomainEvent{coinsSent(11, 1, 3), coinsSent(12, 3, 4), coinsSent(13, 2, 1)}, nil)
+			},
+			expectedOffsets: []int64{13, 13, 13},
+			expectedEvents:  []int{2, 2, 1},
+		},
+		{
+			name:        "full batches fetched until the last event",
+			subscribers: []int{4},
+			prepareFn: func(t *testing.T, eventLog *storemocks.MockEventLog) {
+				eventLog.EXPECT().GetLastEventOffset(gomock.Any()).Return(int64(10), nil)
+				eventLog.EXPECT().FetchEvents(gomock.Any(), int64(10), domain.EventRelayBatch).Return(fullBatch, nil)
+				eventLog.EXPECT().FetchEvents(gomock.Any(), int64(110), domain.EventRelayBatch).
+					Return([]domain.DomainEvent{}, nil)
+			},
+			expectedOffsets: []int64{110},
+			expectedEvents:  []int{100},
+		},
+		{
+			name:        "event log failure",
+			subscribers: []int{1},
+			prepareFn: func(t *testing.T, eventLog *storemocks.MockEventLog) {
+				eventLog.EXPECT().GetLastEventOffset(gomock.Any()).Return(int64(10), nil)
+				eventLog.EXPECT().FetchEvents(gomock.Any(), int64(10), domain.EventRelayBatch).Return(nil, assert.AnError)
+			},
+			expectedOffsets: []int64{10},
+			expectedEvents:  []int{0},
+			expectedErr:     assert.AnError,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			eventLog := storemocks.NewMockEventLog(ctrl)
+
+			tt.prepareFn(t, eventLog)
+
+			notificationsCase := NewNotificationsCase(eventLog, storemocks.NewMockBalanceFetcher(ctrl),
+				storemocks.NewMockUsernameGetter(ctrl))
+
+			subs := make([]*subscription, 0, len(tt.subscribers))
+			for _, userID := range tt.subscribers {
+				sub, err := notificationsCase.subscribe(t.Context(), userID)
+				require.NoError(t, err)
+				subs = append(subs, sub)
+			}
+
+			err := notificationsCase.PollEvents(t.Context())
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			for i, sub := range subs {
+				assert.Equal(t, tt.expectedOffsets[i], sub.offset)
+				assert.Len(t, sub.events, tt.expectedEvents[i])
+				for _, event := range sub.events {
+					assert.Contains(t, event.UserIDs, sub.userID)
+				}
+				assert.Len(t, sub.ready, min(tt.expectedEvents[i], 1))
+			}
+		})
+	}
+}
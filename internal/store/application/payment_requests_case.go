@@ -0,0 +1,163 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+)
+
+type PaymentRequestsCase struct {
+	txManager      database.TxManager
+	userIDFetcher  domain.UserIDFetcher
+	usernameGetter domain.UsernameGetter
+	balanceCreator domain.BalanceEnsurer
+	requestsRepo   domain.PaymentRequestsRepository
+	sendCoinsCase  *SendCoinsCase
+}
+
+func NewPaymentRequestsCase(txManager database.TxManager,
+	userIDFetcher domain.UserIDFetcher,
+	usernameGetter domain.UsernameGetter,
+	balanceCreator domain.BalanceEnsurer,
+	requestsRepo domain.PaymentRequestsRepository,
+	sendCoinsCase *SendCoinsCase) *PaymentRequestsCase {
+	return &PaymentRequestsCase{
+		txManager:      txManager,
+		userIDFetcher:  userIDFetcher,
+		usernameGetter: usernameGetter,
+		balanceCreator: balanceCreator,
+		requestsRepo:   requestsRepo,
+		sendCoinsCase:  sendCoinsCase,
+	}
+}
+
+// CreatePaymentRequest asks payerUsername to send amount coins to the requester.
+// The request expires after expiryDays, or after the default period when expiryDays is zero.
+func (pc *PaymentRequestsCase) CreatePaymentRequest(ctx context.Context, requesterID int, payerUsername string,
+	amount uint32, note string, expiryDays uint32) (int, error) {
+	if amount == 0 {
+		return 0, &domain.InvalidArgumentsError{Msg: "amount must be positive"}
+	}
+
+	if utf8.RuneCountInString(note) > domain.MaxPaymentRequestNoteLength {
+		return 0, &domain.InvalidArgumentsError{Msg: fmt.Sprintf("note must not exceed %d characters", domain.MaxPaymentRequestNoteLength)}
+	}
+
+	if expiryDays == 0 {
+		expiryDays = domain.DefaultPaymentRequestExpiryDays
+	} else if expiryDays > domain.MaxPaymentRequestExpiryDays {
+		return 0, &domain.InvalidArgumentsError{Msg: fmt.Sprintf("expiry must not exceed %d days", domain.MaxPaymentRequestExpiryDays)}
+	}
+
+	payerID, err := pc.userIDFetcher.FetchUserID(ctx, payerUsername)
+	if err != nil {
+		return 0, &domain.UserNotFoundError{Msg: fmt.Sprintf("user not found: %s", payerUsername)}
+	}
+
+	if payerID == requesterID {
+		return 0, &domain.InvalidArgumentsError{Msg: "payer must differ from requester"}
+	}
+
+	err = pc.balanceCreator.EnsureBalanceCreated(ctx, payerID, domain.StartBalance)
+	if err != nil {
+		return 0, fmt.Errorf("failed to ensure balance for user %d: %w", payerID, err)
+	}
+
+	return pc.requestsRepo.CreatePaymentRequest(ctx, domain.PaymentRequest{
+		RequesterID: requesterID,
+		PayerID:     payerID,
+		Amount:      amount,
+		Note:        note,
+		ExpiresAt:   time.Now().Add(time.Duration(expiryDays) * 24 * time.Hour),
+	})
+}
+
+// ListPendingPaymentRequests returns the unexpired requests the user still has to answer.
+func (pc *PaymentRequestsCase) ListPendingPaymentRequests(ctx context.Context, payerID int) ([]domain.NamedPaymentRequest, error) {
+	requests, err := pc.requestsRepo.ListPendingPaymentRequests(ctx, payerID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(requests) == 0 {
+		return []domain.NamedPaymentRequest{}, nil
+	}
+
+	requesterIDs := make([]int, 0, len(requests))
+	for _, request := range requests {
+		requesterIDs = append(requesterIDs, request.RequesterID)
+	}
+
+	usernames, err := pc.usernameGetter.GetUsernames(ctx, requesterIDs...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get requester usernames: %w", err)
+	}
+
+	named := make([]domain.NamedPaymentRequest, 0, len(requests))
+	for _, request := range requests {
+		named = append(named, domain.NamedPaymentRequest{
+			PaymentRequest:    request,
+			RequesterUsername: usernames[request.RequesterID],
+		})
+	}
+
+	return named, nil
+}
+
+// AcceptPaymentRequest pays the request with a regular coin transfer from the payer to the requester.
+func (pc *PaymentRequestsCase) AcceptPaymentRequest(ctx context.Context, payerID, requestID int) error {
+	return pc.answerRequest(ctx, payerID, requestID, domain.PaymentRequestStatusAccepted,
+		func(ctx context.Context, executor database.QueryExecuter, request domain.PaymentRequest) error {
+			requesterUsername, err := pc.usernameGetter.GetUsername(ctx, request.RequesterID)
+			if err != nil {
+				return fmt.Errorf("failed to get username of user %d: %w", request.RequesterID, err)
+			}
+
+			return pc.sendCoinsCase.proceedTransfer(ctx, executor, payerID, request.RequesterID, requesterUsername, request.Amount)
+		})
+}
+
+func (pc *PaymentRequestsCase) DeclinePaymentRequest(ctx context.Context, payerID, requestID int) error {
+	return pc.answerRequest(ctx, payerID, requestID, domain.PaymentRequestStatusDeclined, nil)
+}
+
+func (pc *PaymentRequestsCase) answerRequest(ctx context.Context, payerID, requestID int, status string,
+	beforeClose func(ctx context.Context, executor database.QueryExecuter, request domain.PaymentRequest) error) error {
+	return pc.txManager.WithinTransaction(ctx, func(ctx context.Context, executor database.QueryExecuter) error {
+		request, err := pc.requestsRepo.LockAndGetPaymentRequest(ctx, executor, requestID)
+		if err != nil {
+			return fmt.Errorf("failed to lock payment request %d: %w", requestID, err)
+		}
+
+		// Requests addressed to someone else are reported as missing, so their existence isn't leaked.
+		if request.PayerID != payerID {
+			return &domain.PaymentRequestNotFoundError{Msg: fmt.Sprintf("payment request %d not found", requestID)}
+		}
+
+		if request.Status != domain.PaymentRequestStatusPending {
+			return &domain.PaymentRequestClosedError{Msg: fmt.Sprintf("payment request %d is already %s", requestID, request.Status)}
+		}
+
+		if request.IsExpired(time.Now()) {
+			return &domain.PaymentRequestClosedError{Msg: fmt.Sprintf("payment request %d has expired", requestID)}
+		}
+
+		if beforeClose != nil {
+			err = beforeClose(ctx, executor, request)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = pc.requestsRepo.ClosePaymentRequest(ctx, executor, requestID, status)
+		if err != nil {
+			return fmt.Errorf("failed to close payment request %d: %w", requestID, err)
+		}
+
+		return nil
+	})
+}
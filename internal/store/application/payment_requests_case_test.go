@@ -0,0 +1,372 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	dbmocks "github.com/Lexv0lk/merch-store/gen/mocks/database"
+	storemocks "github.com/Lexv0lk/merch-store/gen/mocks/store"
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaymentRequestsCase_CreatePaymentRequest(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		userIDFetcher  *storemocks.MockUserIDFetcher
+		balanceCreator *storemocks.MockBalanceEnsurer
+		requestsRepo   *storemocks.MockPaymentRequestsRepository
+	}
+
+	type testCase struct {
+		name          string
+		payerUsername string
+		amount        uint32
+		note          string
+		expiryDays    uint32
+
+		prepareFn func(t *testing.T, d *deps)
+
+		expectedID     int
+		expectedExpiry time.Duration
+		expectedErr    error
+	}
+
+	tests := []testCase{
+		{
+			name:          "request created with default expiry",
+			payerUsername: "payer",
+			amount:        150,
+			note:          "team lunch",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "payer").Return(2, nil)
+				d.balanceCreator.EXPECT().EnsureBalanceCreated(gomock.Any(), 2, domain.StartBalance).Return(nil)
+				d.requestsRepo.EXPECT().CreatePaymentRequest(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, request domain.PaymentRequest) (int, error) {
+						assert.Equal(t, 1, request.RequesterID)
+						assert.Equal(t, 2, request.PayerID)
+						assert.Equal(t, uint32(150), request.Amount)
+						assert.Equal(t, "team lunch", request.Note)
+						assert.WithinDuration(t, time.Now().Add(domain.DefaultPaymentRequestExpiryDays*24*time.Hour),
+							request.ExpiresAt, time.Minute)
+						return 5, nil
+					})
+			},
+			expectedID: 5,
+		},
+		{
+			name:          "request created with custom expiry",
+			payerUsername: "payer",
+			amount:        150,
+			expiryDays:    2,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "payer").Return(2, nil)
+				d.balanceCreator.EXPECT().EnsureBalanceCreated(gomock.Any(), 2, domain.StartBalance).Return(nil)
+				d.requestsRepo.EXPECT().CreatePaymentRequest(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, request domain.PaymentRequest) (int, error) {
+						assert.WithinDuration(t, time.Now().Add(48*time.Hour), request.ExpiresAt, time.Minute)
+						return 6, nil
+					})
+			},
+			expectedID: 6,
+		},
+		{
+			name:          "zero amount",
+			payerUsername: "payer",
+			amount:        0,
+			prepareFn:     func(t *testing.T, d *deps) {},
+			expectedErr:   &domain.InvalidArgumentsError{},
+		},
+		{
+			name:          "expiry too long",
+			payerUsername: "payer",
+			amount:        150,
+			expiryDays:    domain.MaxPaymentRequestExpiryDays + 1,
+			prepareFn:     func(t *testing.T, d *deps) {},
+			expectedErr:   &domain.InvalidArgumentsError{},
+		},
+		{
+			name:          "payer not found",
+			payerUsername: "ghost",
+			amount:        150,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "ghost").Return(0, assert.AnError)
+			},
+			expectedErr: &domain.UserNotFoundError{},
+		},
+		{
+			name:          "request to self",
+			payerUsername: "me",
+			amount:        150,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "me").Return(1, nil)
+			},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := &deps{
+				userIDFetcher:  storemocks.NewMockUserIDFetcher(ctrl),
+				balanceCreator: storemocks.NewMockBalanceEnsurer(ctrl),
+				requestsRepo:   storemocks.NewMockPaymentRequestsRepository(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			sendCoinsCase := NewSendCoinsCase(dbmocks.NewMockTxManager(ctrl), d.userIDFetcher,
+				storemocks.NewMockUserBalanceLocker(ctrl), d.balanceCreator,
+				storemocks.NewMockBalanceStatusChecker(ctrl), storemocks.NewMockTransferLimitsProvider(ctrl),
+				storemocks.NewMockTransferStatsFetcher(ctrl), storemocks.NewMockTransactionProceeder(ctrl),
+				storemocks.NewMockEventOutbox(ctrl))
+
+			paymentRequestsCase := NewPaymentRequestsCase(dbmocks.NewMockTxManager(ctrl), d.userIDFetcher,
+				storemocks.NewMockUsernameGetter(ctrl), d.balanceCreator, d.requestsRepo, sendCoinsCase)
+			requestID, err := paymentRequestsCase.CreatePaymentRequest(t.Context(), 1, tt.payerUsername, tt.amount, tt.note, tt.expiryDays)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedID, requestID)
+			}
+		})
+	}
+}
+
+func TestPaymentRequestsCase_ListPendingPaymentRequests(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		usernameGetter *storemocks.MockUsernameGetter
+		requestsRepo   *storemocks.MockPaymentRequestsRepository
+	}
+
+	ctrl := gomock.NewController(t)
+	d := &deps{
+		usernameGetter: storemocks.NewMockUsernameGetter(ctrl),
+		requestsRepo:   storemocks.NewMockPaymentRequestsRepository(ctrl),
+	}
+
+	request := domain.PaymentRequest{Id: 5, RequesterID: 1, PayerID: 2, Amount: 150, Status: domain.PaymentRequestStatusPending}
+	d.requestsRepo.EXPECT().ListPendingPaymentRequests(gomock.Any(), 2, gomock.Any()).
+		Return([]domain.PaymentRequest{request}, nil)
+	d.usernameGetter.EXPECT().GetUsernames(gomock.Any(), 1).Return(map[int]string{1: "requester"}, nil)
+
+	sendCoinsCase := NewSendCoinsCase(dbmocks.NewMockTxManager(ctrl), storemocks.NewMockUserIDFetcher(ctrl),
+		storemocks.NewMockUserBalanceLocker(ctrl), storemocks.NewMockBalanceEnsurer(ctrl),
+		storemocks.NewMockBalanceStatusChecker(ctrl), storemocks.NewMockTransferLimitsProvider(ctrl),
+		storemocks.NewMockTransferStatsFetcher(ctrl), storemocks.NewMockTransactionProceeder(ctrl),
+		storemocks.NewMockEventOutbox(ctrl))
+
+	paymentRequestsCase := NewPaymentRequestsCase(dbmocks.NewMockTxManager(ctrl), storemocks.NewMockUserIDFetcher(ctrl),
+		d.usernameGetter, storemocks.NewMockBalanceEnsurer(ctrl), d.requestsRepo, sendCoinsCase)
+	requests, err := paymentRequestsCase.ListPendingPaymentRequests(t.Context(), 2)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.NamedPaymentRequest{{PaymentRequest: request, RequesterUsername: "requester"}}, requests)
+}
+
+func TestPaymentRequestsCase_AcceptPaymentRequest(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		txManager            *dbmocks.MockTxManager
+		usernameGetter       *storemocks.MockUsernameGetter
+		requestsRepo         *storemocks.MockPaymentRequestsRepository
+		balanceLocker        *storemocks.MockUserBalanceLocker
+		balanceStatusChecker *storemocks.MockBalanceStatusChecker
+		limitsProvider       *storemocks.MockTransferLimitsProvider
+		statsFetcher         *storemocks.MockTransferStatsFetcher
+		transactionProceeder *storemocks.MockTransactionProceeder
+		eventOutbox          *storemocks.MockEventOutbox
+	}
+
+	type testCase struct {
+		name      string
+		payerID   int
+		requestID int
+
+		prepareFn func(t *testing.T, d *deps)
+
+		expectedErr error
+	}
+
+	executeTxFn := func(ctx context.Context, txFn database.TxFunc) error {
+		return txFn(ctx, nil)
+	}
+
+	limits := domain.TransferLimits{
+		MaxSingleTransfer:           500,
+		DailyOutgoingCap:            1000,
+		MaxTransfersPerHour:         20,
+		MaxReceivedFromSenderPerDay: 500,
+	}
+	pending := domain.PaymentRequest{Id: 5, RequesterID: 1, PayerID: 2, Amount: 150,
+		Status: domain.PaymentRequestStatusPending, ExpiresAt: time.Now().Add(time.Hour)}
+
+	tests := []testCase{
+		{
+			name:      "request paid",
+			payerID:   2,
+			requestID: 5,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.requestsRepo.EXPECT().LockAndGetPaymentRequest(gomock.Any(), nil, 5).Return(pending, nil)
+				d.usernameGetter.EXPECT().GetUsername(gomock.Any(), 1).Return("requester", nil)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 2).Return(uint32(1000), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 2).Return(true, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 2).Return(false, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 1).Return(true, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).Return(false, nil)
+				d.statsFetcher.EXPECT().FetchTransferStats(gomock.Any(), nil, 2, 1).Return(domain.TransferStats{}, nil)
+				d.limitsProvider.EXPECT().GetTransferLimits(gomock.Any(), nil, 2).Return(limits, nil)
+				d.limitsProvider.EXPECT().GetTransferLimits(gomock.Any(), nil, 1).Return(limits, nil)
+				d.transactionProceeder.EXPECT().ProceedTransaction(gomock.Any(), nil, uint32(150), 2, 1).Return(nil)
+				d.eventOutbox.EXPECT().AppendEvent(gomock.Any(), nil, domain.CoinsSentEvent(2, 1, 150)).Return(nil)
+				d.requestsRepo.EXPECT().ClosePaymentRequest(gomock.Any(), nil, 5, domain.PaymentRequestStatusAccepted).Return(nil)
+			},
+		},
+		{
+			name:      "insufficient balance keeps request pending",
+			payerID:   2,
+			requestID: 5,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.requestsRepo.EXPECT().LockAndGetPaymentRequest(gomock.Any(), nil, 5).Return(pending, nil)
+				d.usernameGetter.EXPECT().GetUsername(gomock.Any(), 1).Return("requester", nil)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 2).Return(uint32(100), nil)
+			},
+			expectedErr: &domain.InsufficientBalanceError{},
+		},
+		{
+			name:      "request addressed to someone else",
+			payerID:   3,
+			requestID: 5,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.requestsRepo.EXPECT().LockAndGetPaymentRequest(gomock.Any(), nil, 5).Return(pending, nil)
+			},
+			expectedErr: &domain.PaymentRequestNotFoundError{},
+		},
+		{
+			name:      "request already declined",
+			payerID:   2,
+			requestID: 5,
+			prepareFn: func(t *testing.T, d *deps) {
+				declined := pending
+				declined.Status = domain.PaymentRequestStatusDeclined
+
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.requestsRepo.EXPECT().LockAndGetPaymentRequest(gomock.Any(), nil, 5).Return(declined, nil)
+			},
+			expectedErr: &domain.PaymentRequestClosedError{},
+		},
+		{
+			name:      "request expired",
+			payerID:   2,
+			requestID: 5,
+			prepareFn: func(t *testing.T, d *deps) {
+				expired := pending
+				expired.ExpiresAt = time.Now().Add(-time.Hour)
+
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.requestsRepo.EXPECT().LockAndGetPaymentRequest(gomock.Any(), nil, 5).Return(expired, nil)
+			},
+			expectedErr: &domain.PaymentRequestClosedError{},
+		},
+		{
+			name:      "request not found",
+			payerID:   2,
+			requestID: 42,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.requestsRepo.EXPECT().LockAndGetPaymentRequest(gomock.Any(), nil, 42).
+					Return(domain.PaymentRequest{}, &domain.PaymentRequestNotFoundError{})
+			},
+			expectedErr: &domain.PaymentRequestNotFoundError{},
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := &deps{
+				txManager:            dbmocks.NewMockTxManager(ctrl),
+				usernameGetter:       storemocks.NewMockUsernameGetter(ctrl),
+				requestsRepo:         storemocks.NewMockPaymentRequestsRepository(ctrl),
+				balanceLocker:        storemocks.NewMockUserBalanceLocker(ctrl),
+				balanceStatusChecker: storemocks.NewMockBalanceStatusChecker(ctrl),
+				limitsProvider:       storemocks.NewMockTransferLimitsProvider(ctrl),
+				statsFetcher:         storemocks.NewMockTransferStatsFetcher(ctrl),
+				transactionProceeder: storemocks.NewMockTransactionProceeder(ctrl),
+				eventOutbox:          storemocks.NewMockEventOutbox(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			sendCoinsCase := NewSendCoinsCase(d.txManager, storemocks.NewMockUserIDFetcher(ctrl), d.balanceLocker,
+				storemocks.NewMockBalanceEnsurer(ctrl), d.balanceStatusChecker, d.limitsProvider, d.statsFetcher,
+				d.transactionProceeder, d.eventOutbox)
+
+			paymentRequestsCase := NewPaymentRequestsCase(d.txManager, storemocks.NewMockUserIDFetcher(ctrl),
+				d.usernameGetter, storemocks.NewMockBalanceEnsurer(ctrl), d.requestsRepo, sendCoinsCase)
+			err := paymentRequestsCase.AcceptPaymentRequest(t.Context(), tt.payerID, tt.requestID)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPaymentRequestsCase_DeclinePaymentRequest(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		txManager    *dbmocks.MockTxManager
+		requestsRepo *storemocks.MockPaymentRequestsRepository
+	}
+
+	ctrl := gomock.NewController(t)
+	d := &deps{
+		txManager:    dbmocks.NewMockTxManager(ctrl),
+		requestsRepo: storemocks.NewMockPaymentRequestsRepository(ctrl),
+	}
+
+	d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, txFn database.TxFunc) error {
+			return txFn(ctx, nil)
+		})
+	d.requestsRepo.EXPECT().LockAndGetPaymentRequest(gomock.Any(), nil, 5).
+		Return(domain.PaymentRequest{Id: 5, RequesterID: 1, PayerID: 2, Amount: 150,
+			Status: domain.PaymentRequestStatusPending, ExpiresAt: time.Now().Add(time.Hour)}, nil)
+	d.requestsRepo.EXPECT().ClosePaymentRequest(gomock.Any(), nil, 5, domain.PaymentRequestStatusDeclined).Return(nil)
+
+	sendCoinsCase := NewSendCoinsCase(d.txManager, storemocks.NewMockUserIDFetcher(ctrl),
+		storemocks.NewMockUserBalanceLocker(ctrl), storemocks.NewMockBalanceEnsurer(ctrl),
+		storemocks.NewMockBalanceStatusChecker(ctrl), storemocks.NewMockTransferLimitsProvider(ctrl),
+		storemocks.NewMockTransferStatsFetcher(ctrl), storemocks.NewMockTransactionProceeder(ctrl),
+		storemocks.NewMockEventOutbox(ctrl))
+
+	paymentRequestsCase := NewPaymentRequestsCase(d.txManager, storemocks.NewMockUserIDFetcher(ctrl),
+		storemocks.NewMockUsernameGetter(ctrl), storemocks.NewMockBalanceEnsurer(ctrl), d.requestsRepo, sendCoinsCase)
+	err := paymentRequestsCase.DeclinePaymentRequest(t.Context(), 2, 5)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,360 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/audit"
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+)
+
+type PreordersCase struct {
+	txManager            database.TxManager
+	goodsRepository      domain.GoodsRepository
+	availabilityUpdater  domain.GoodAvailabilityUpdater
+	preordersRepository  domain.PreordersRepository
+	preorderProceeder    domain.PreorderProceeder
+	balanceLocker        domain.UserBalanceLocker
+	balanceStatusChecker domain.BalanceStatusChecker
+	stockKeeper          domain.StockKeeper
+	purchaseCase         *PurchaseCase
+	stockWatcher         domain.WishlistStockWatcher
+	webhookPublisher     domain.WebhookPublisher
+	eventOutbox          domain.EventOutbox
+	auditRecorder        audit.Recorder
+}
+
+func NewPreordersCase(txManager database.TxManager,
+	goodsRepository domain.GoodsRepository,
+	availabilityUpdater domain.GoodAvailabilityUpdater,
+	preordersRepository domain.PreordersRepository,
+	preorderProceeder domain.PreorderProceeder,
+	balanceLocker domain.UserBalanceLocker,
+	balanceStatusChecker domain.BalanceStatusChecker,
+	stockKeeper domain.StockKeeper,
+	purchaseCase *PurchaseCase,
+	stockWatcher domain.WishlistStockWatcher,
+	webhookPublisher domain.WebhookPublisher,
+	eventOutbox domain.EventOutbox,
+	auditRecorder audit.Recorder) *PreordersCase {
+	return &PreordersCase{
+		txManager:            txManager,
+		goodsRepository:      goodsRepository,
+		availabilityUpdater:  availabilityUpdater,
+		preordersRepository:  preordersRepository,
+		preorderProceeder:    preorderProceeder,
+		balanceLocker:        balanceLocker,
+		balanceStatusChecker: balanceStatusChecker,
+		stockKeeper:          stockKeeper,
+		purchaseCase:         purchaseCase,
+		stockWatcher:         stockWatcher,
+		webhookPublisher:     webhookPublisher,
+		eventOutbox:          eventOutbox,
+		auditRecorder:        auditRecorder,
+	}
+}
+
+// AnnounceGood marks a good as upcoming. Until it is marked as arrived, it can only be pre-ordered.
+func (pc *PreordersCase) AnnounceGood(ctx context.Context, goodName string) error {
+	goodInfo, err := pc.goodsRepository.GetGoodInfo(ctx, goodName)
+	if err != nil {
+		return fmt.Errorf("failed to get good info: %w", err)
+	}
+
+	if goodInfo.Bundle {
+		return &domain.InvalidArgumentsError{Msg: "bundles can't be pre-ordered"}
+	}
+
+	return pc.txManager.WithinTransaction(ctx, func(ctx context.Context, executor database.QueryExecuter) error {
+		err := pc.availabilityUpdater.SetGoodUpcoming(ctx, executor, goodInfo.Id, true)
+		if err != nil {
+			return err
+		}
+
+		err = pc.auditRecorder.RecordInTx(ctx, executor, audit.Event{
+			Action: audit.ActionGoodAnnounce,
+			Target: audit.GoodTarget(goodName),
+			Before: map[string]any{"upcoming": goodInfo.Upcoming},
+			After:  map[string]any{"upcoming": true},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record audit event: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// PlacePreorder pre-orders one unit of an upcoming good, as the variant with the variantSKU for goods with variants.
+// The price goes through the same balance and purchase limit checks as a store purchase and is held from the
+// user's balance until the good arrives or the pre-order is cancelled.
+func (pc *PreordersCase) PlacePreorder(ctx context.Context, userID int, goodName, variantSKU string) (int, error) {
+	goodInfo, err := pc.goodsRepository.GetGoodInfo(ctx, goodName)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get good info: %w", err)
+	}
+
+	if !goodInfo.Upcoming {
+		return 0, &domain.InvalidArgumentsError{Msg: "item is not upcoming, buy it instead"}
+	}
+
+	goodInfo, err = pc.purchaseCase.pickVariant(ctx, goodInfo, variantSKU)
+	if err != nil {
+		return 0, err
+	}
+
+	var preorderID int
+	err = pc.txManager.WithinTransaction(ctx, func(ctx context.Context, executor database.QueryExecuter) error {
+		// The good is locked before the balance, the order MarkGoodArrived takes them in.
+		upcoming, err := pc.preorderProceeder.LockUpcomingGood(ctx, executor, goodInfo.Id)
+		if err != nil {
+			return err
+		}
+
+		if !upcoming {
+			return &domain.InvalidArgumentsError{Msg: "item has arrived, buy it instead"}
+		}
+
+		balance, err := pc.balanceLocker.LockAndGetUserBalance(ctx, executor, userID)
+		if err != nil {
+			return fmt.Errorf("failed to lock and get user balance: %w", err)
+		}
+
+		if balance < goodInfo.Price {
+			return &domain.InsufficientBalanceError{Msg: "insufficient balance"}
+		}
+
+		err = checkNotFrozen(ctx, pc.balanceStatusChecker, executor, userID, "account is frozen")
+		if err != nil {
+			return err
+		}
+
+		isActive, err := pc.balanceStatusChecker.IsBalanceActive(ctx, executor, userID)
+		if err != nil {
+			return fmt.Errorf("failed to check balance status for user %d: %w", userID, err)
+		}
+
+		if !isActive {
+			return &domain.UserDeactivatedError{Msg: "account is deactivated"}
+		}
+
+		err = checkPurchaseLimits(ctx, pc.purchaseCase.purchaseLimitChecker, executor, userID,
+			map[int]uint32{goodInfo.Id: 1})
+		if err != nil {
+			return err
+		}
+
+		preorder := domain.Preorder{
+			UserID:    userID,
+			GoodID:    goodInfo.Id,
+			GoodName:  goodInfo.Name,
+			VariantID: goodInfo.VariantID,
+			Variant:   goodInfo.Variant,
+			Price:     goodInfo.Price,
+		}
+		preorderID, err = pc.preorderProceeder.PlacePreorder(ctx, executor, preorder)
+		if err != nil {
+			return err
+		}
+
+		preorder.Id = preorderID
+		return pc.eventOutbox.AppendEvent(ctx, executor,
+			domain.PreorderStatusChangedEvent(preorder, domain.PreorderStatusPending))
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return preorderID, nil
+}
+
+// ListPreorders returns the user's pre-orders, the latest first, together with the coins held for the pending ones.
+func (pc *PreordersCase) ListPreorders(ctx context.Context, userID int) ([]domain.Preorder, uint32, error) {
+	preorders, err := pc.preordersRepository.ListUserPreorders(ctx, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	held, err := pc.preordersRepository.FetchHeldCoins(ctx, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return preorders, held, nil
+}
+
+// CancelPreorder cancels a pending pre-order of the user, returns its held price to the balance, publishes
+// a cancellation webhook and appends a PreorderStatusChanged event.
+func (pc *PreordersCase) CancelPreorder(ctx context.Context, userID, preorderID int) error {
+	return pc.txManager.WithinTransaction(ctx, func(ctx context.Context, executor database.QueryExecuter) error {
+		// The balance is locked before the pre-order, the order MarkGoodArrived and deactivation take them in.
+		_, err := pc.balanceLocker.LockAndGetUserBalance(ctx, executor, userID)
+		if err != nil {
+			return fmt.Errorf("failed to lock and get user balance: %w", err)
+		}
+
+		preorder, err := pc.preorderProceeder.LockAndGetPreorder(ctx, executor, preorderID)
+		if err != nil {
+			return err
+		}
+
+		if preorder.UserID != userID {
+			return &domain.PreorderNotFoundError{Msg: fmt.Sprintf("pre-order %d not found", preorderID)}
+		} else if preorder.Status != domain.PreorderStatusPending {
+			return &domain.PreorderClosedError{Msg: fmt.Sprintf("pre-order %d is already %s", preorderID, preorder.Status)}
+		}
+
+		return cancelPreorder(ctx, executor, pc.preorderProceeder, pc.webhookPublisher, pc.eventOutbox,
+			pc.auditRecorder, preorder, audit.RefundReasonCancelled)
+	})
+}
+
+// MarkGoodArrived ends the pre-order period of a good, so it is sold as usual, and fulfills its pending pre-orders
+// in the order they were placed, spending their held coins. A purchase webhook is published and ItemPurchased and
+// PreorderStatusChanged events are appended for each of them. Users wishing for the good get a back-in-stock event.
+// Pre-orders of users who reached the good's purchase limit since placing them are cancelled and their held coins
+// returned, and so are pre-orders of variants that ran out of stock, as variant stock isn't refilled. Pre-orders of
+// frozen or deactivated users stay pending and are fulfilled by marking the good as arrived again once the account
+// is usable.
+// It returns how many pre-orders were fulfilled and how many are still pending.
+func (pc *PreordersCase) MarkGoodArrived(ctx context.Context, goodName string) (int, int, error) {
+	goodInfo, err := pc.goodsRepository.GetGoodInfo(ctx, goodName)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get good info: %w", err)
+	}
+
+	fulfilled, cancelled, pending := 0, 0, 0
+	err = pc.txManager.WithinTransaction(ctx, func(ctx context.Context, executor database.QueryExecuter) error {
+		fulfilled, cancelled, pending = 0, 0, 0
+
+		// Locks are taken in the good, balances, pre-orders order. Placing a pre-order locks the good before the
+		// balance, and cancelling one locks the balance before the pre-order, so none of them can deadlock.
+		err := pc.availabilityUpdater.SetGoodUpcoming(ctx, executor, goodInfo.Id, false)
+		if err != nil {
+			return err
+		}
+
+		if goodInfo.Upcoming {
+			err = pc.stockWatcher.RecordBackInStock(ctx, executor, goodInfo.Id)
+			if err != nil {
+				return err
+			}
+		}
+
+		receivable, err := pc.preorderProceeder.LockPreorderBalances(ctx, executor, goodInfo.Id)
+		if err != nil {
+			return err
+		}
+
+		preorders, err := pc.preorderProceeder.LockPendingPreorders(ctx, executor, goodInfo.Id)
+		if err != nil {
+			return err
+		}
+
+		for _, preorder := range preorders {
+			if !receivable[preorder.UserID] {
+				pending++
+				continue
+			}
+
+			// Held coins don't count towards the purchase limit, so it is checked again before spending them.
+			err := checkPurchaseLimits(ctx, pc.purchaseCase.purchaseLimitChecker, executor, preorder.UserID,
+				map[int]uint32{preorder.GoodID: 1})
+			if errors.Is(err, &domain.PurchaseLimitError{}) {
+				err = cancelPreorder(ctx, executor, pc.preorderProceeder, pc.webhookPublisher, pc.eventOutbox,
+					pc.auditRecorder, preorder, audit.RefundReasonPurchaseLimit)
+				if err != nil {
+					return err
+				}
+
+				cancelled++
+				continue
+			} else if err != nil {
+				return err
+			}
+
+			if preorder.VariantID != 0 {
+				err := pc.stockKeeper.TakeFromStock(ctx, executor, preorder.VariantID, 1)
+				if errors.Is(err, &domain.OutOfStockError{}) {
+					err = cancelPreorder(ctx, executor, pc.preorderProceeder, pc.webhookPublisher, pc.eventOutbox,
+						pc.auditRecorder, preorder, audit.RefundReasonSoldOut)
+					if err != nil {
+						return err
+					}
+
+					cancelled++
+					continue
+				} else if err != nil {
+					return err
+				}
+			}
+
+			err = pc.preorderProceeder.FulfillPreorder(ctx, executor, preorder)
+			if err != nil {
+				return fmt.Errorf("failed to fulfill preorder %d: %w", preorder.Id, err)
+			}
+
+			err = pc.webhookPublisher.PublishWebhook(ctx, executor, domain.PreorderPurchaseWebhook(preorder))
+			if err != nil {
+				return err
+			}
+
+			err = pc.eventOutbox.AppendEvent(ctx, executor, domain.ItemPurchasedEvent(preorder.UserID, preorder.UserID,
+				preorder.Price, domain.WebhookItem{Name: preorder.GoodName, Variant: preorder.Variant, Quantity: 1}))
+			if err != nil {
+				return err
+			}
+
+			err = pc.eventOutbox.AppendEvent(ctx, executor,
+				domain.PreorderStatusChangedEvent(preorder, domain.PreorderStatusFulfilled))
+			if err != nil {
+				return err
+			}
+
+			fulfilled++
+		}
+
+		err = pc.auditRecorder.RecordInTx(ctx, executor, audit.Event{
+			Action: audit.ActionGoodArrive,
+			Target: audit.GoodTarget(goodName),
+			Before: map[string]any{"upcoming": goodInfo.Upcoming},
+			After:  map[string]any{"fulfilled": fulfilled, "cancelled": cancelled, "pending": pending},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record audit event: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return fulfilled, pending, nil
+}
+
+// cancelPreorder returns the held price of a pending pre-order to the user's balance, records the refund with
+// reason in the audit log, publishes a cancellation webhook and appends a PreorderStatusChanged event.
+func cancelPreorder(ctx context.Context, executor database.QueryExecuter, proceeder domain.PreorderProceeder,
+	webhookPublisher domain.WebhookPublisher, eventOutbox domain.EventOutbox, auditRecorder audit.Recorder,
+	preorder domain.Preorder, reason string) error {
+	err := proceeder.CancelPreorder(ctx, executor, preorder)
+	if err != nil {
+		return fmt.Errorf("failed to cancel preorder %d: %w", preorder.Id, err)
+	}
+
+	err = auditRecorder.RecordInTx(ctx, executor, audit.RefundEvent(audit.PreorderTarget(preorder.Id), preorder.UserID,
+		preorder.Price, reason))
+	if err != nil {
+		return fmt.Errorf("failed to record audit event: %w", err)
+	}
+
+	err = webhookPublisher.PublishWebhook(ctx, executor, domain.CancellationWebhook(preorder))
+	if err != nil {
+		return err
+	}
+
+	return eventOutbox.AppendEvent(ctx, executor, domain.PreorderStatusChangedEvent(preorder, domain.PreorderStatusCancelled))
+}
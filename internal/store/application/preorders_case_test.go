@@ -0,0 +1,531 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	auditmocks "github.com/Lexv0lk/merch-store/gen/mocks/audit"
+	dbmocks "github.com/Lexv0lk/merch-store/gen/mocks/database"
+	storemocks "github.com/Lexv0lk/merch-store/gen/mocks/store"
+	"github.com/Lexv0lk/merch-store/internal/pkg/audit"
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreordersCase_PlacePreorder(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		txManager            *dbmocks.MockTxManager
+		goodsRepository      *storemocks.MockGoodsRepository
+		balanceLocker        *storemocks.MockUserBalanceLocker
+		balanceStatusChecker *storemocks.MockBalanceStatusChecker
+		variantsRepository   *storemocks.MockVariantsRepository
+		purchaseLimitChecker *storemocks.MockPurchaseLimitChecker
+		eventOutbox          *storemocks.MockEventOutbox
+		preorderProceeder    *storemocks.MockPreorderProceeder
+	}
+
+	type testCase struct {
+		name       string
+		variantSKU string
+
+		prepareFn func(t *testing.T, d *deps)
+
+		expectedID  int
+		expectedErr error
+	}
+
+	executeTxFn := func(ctx context.Context, txFn database.TxFunc) error {
+		return txFn(ctx, nil)
+	}
+
+	umbrella := domain.GoodInfo{Id: 12, Name: "umbrella", Price: 200, BasePrice: 200, Upcoming: true}
+	stock := uint32(5)
+	variants := []domain.Variant{{Id: 4, GoodID: 12, SKU: "umbrella-red", PriceDelta: 20, Stock: &stock}}
+
+	tests := []testCase{
+		{
+			name: "preorder placed",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "umbrella").Return(umbrella, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 12).Return([]domain.Variant{}, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.preorderProceeder.EXPECT().LockUpcomingGood(gomock.Any(), nil, 12).Return(true, nil)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).Return(uint32(1000), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).Return(false, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 1).Return(true, nil)
+				d.purchaseLimitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{12}).Return(nil, nil)
+				placed := domain.Preorder{UserID: 1, GoodID: 12, GoodName: "umbrella", Price: 200}
+				d.preorderProceeder.EXPECT().PlacePreorder(gomock.Any(), nil, placed).Return(3, nil)
+				placed.Id = 3
+				d.eventOutbox.EXPECT().AppendEvent(gomock.Any(), nil,
+					domain.PreorderStatusChangedEvent(placed, domain.PreorderStatusPending)).Return(nil)
+			},
+			expectedID: 3,
+		},
+		{
+			name:       "variant preordered at its price",
+			variantSKU: "umbrella-red",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "umbrella").Return(umbrella, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 12).Return(variants, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.preorderProceeder.EXPECT().LockUpcomingGood(gomock.Any(), nil, 12).Return(true, nil)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).Return(uint32(1000), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).Return(false, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 1).Return(true, nil)
+				d.purchaseLimitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{12}).Return(nil, nil)
+				placed := domain.Preorder{UserID: 1, GoodID: 12, GoodName: "umbrella", VariantID: 4,
+					Variant: "umbrella-red", Price: 220}
+				d.preorderProceeder.EXPECT().PlacePreorder(gomock.Any(), nil, placed).Return(3, nil)
+				placed.Id = 3
+				d.eventOutbox.EXPECT().AppendEvent(gomock.Any(), nil,
+					domain.PreorderStatusChangedEvent(placed, domain.PreorderStatusPending)).Return(nil)
+			},
+			expectedID: 3,
+		},
+		{
+			name: "good not upcoming",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "umbrella").
+					Return(domain.GoodInfo{Id: 12, Name: "umbrella", Price: 200}, nil)
+			},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name: "good arrived while ordering",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "umbrella").Return(umbrella, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 12).Return([]domain.Variant{}, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.preorderProceeder.EXPECT().LockUpcomingGood(gomock.Any(), nil, 12).Return(false, nil)
+			},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name: "insufficient balance",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "umbrella").Return(umbrella, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 12).Return([]domain.Variant{}, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.preorderProceeder.EXPECT().LockUpcomingGood(gomock.Any(), nil, 12).Return(true, nil)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).Return(uint32(100), nil)
+			},
+			expectedErr: &domain.InsufficientBalanceError{},
+		},
+		{
+			name: "deactivated account",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "umbrella").Return(umbrella, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 12).Return([]domain.Variant{}, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.preorderProceeder.EXPECT().LockUpcomingGood(gomock.Any(), nil, 12).Return(true, nil)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).Return(uint32(1000), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).Return(false, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 1).Return(false, nil)
+			},
+			expectedErr: &domain.UserDeactivatedError{},
+		},
+		{
+			name: "purchase limit reached",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "umbrella").Return(umbrella, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 12).Return([]domain.Variant{}, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.preorderProceeder.EXPECT().LockUpcomingGood(gomock.Any(), nil, 12).Return(true, nil)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).Return(uint32(1000), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).Return(false, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 1).Return(true, nil)
+				d.purchaseLimitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{12}).
+					Return([]domain.PurchaseLimit{{GoodID: 12, GoodName: "umbrella", MaxQuantity: 1}}, nil)
+				d.purchaseLimitChecker.EXPECT().CountUserAcquisitions(gomock.Any(), nil, 1, 12, gomock.Any()).
+					Return(uint32(1), nil)
+			},
+			expectedErr: &domain.PurchaseLimitError{},
+		},
+		{
+			name: "already preordered",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "umbrella").Return(umbrella, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 12).Return([]domain.Variant{}, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.preorderProceeder.EXPECT().LockUpcomingGood(gomock.Any(), nil, 12).Return(true, nil)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).Return(uint32(1000), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).Return(false, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 1).Return(true, nil)
+				d.purchaseLimitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{12}).Return(nil, nil)
+				d.preorderProceeder.EXPECT().PlacePreorder(gomock.Any(), nil, gomock.Any()).
+					Return(0, &domain.PreorderExistingError{})
+			},
+			expectedErr: &domain.PreorderExistingError{},
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := &deps{
+				txManager:            dbmocks.NewMockTxManager(ctrl),
+				goodsRepository:      storemocks.NewMockGoodsRepository(ctrl),
+				balanceLocker:        storemocks.NewMockUserBalanceLocker(ctrl),
+				balanceStatusChecker: storemocks.NewMockBalanceStatusChecker(ctrl),
+				variantsRepository:   storemocks.NewMockVariantsRepository(ctrl),
+				purchaseLimitChecker: storemocks.NewMockPurchaseLimitChecker(ctrl),
+				eventOutbox:          storemocks.NewMockEventOutbox(ctrl),
+				preorderProceeder:    storemocks.NewMockPreorderProceeder(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			purchaseCase := newTestPurchaseCase(ctrl, d.txManager, d.balanceLocker, d.balanceStatusChecker,
+				d.variantsRepository, d.purchaseLimitChecker)
+
+			preordersCase := NewPreordersCase(d.txManager, d.goodsRepository,
+				storemocks.NewMockGoodAvailabilityUpdater(ctrl), storemocks.NewMockPreordersRepository(ctrl),
+				d.preorderProceeder, d.balanceLocker, d.balanceStatusChecker, storemocks.NewMockStockKeeper(ctrl),
+				purchaseCase, storemocks.NewMockWishlistStockWatcher(ctrl), storemocks.NewMockWebhookPublisher(ctrl),
+				d.eventOutbox, auditmocks.NewMockRecorder(ctrl))
+			preorderID, err := preordersCase.PlacePreorder(t.Context(), 1, "umbrella", tt.variantSKU)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedID, preorderID)
+			}
+		})
+	}
+}
+
+func TestPreordersCase_CancelPreorder(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		txManager         *dbmocks.MockTxManager
+		balanceLocker     *storemocks.MockUserBalanceLocker
+		webhookPublisher  *storemocks.MockWebhookPublisher
+		eventOutbox       *storemocks.MockEventOutbox
+		preorderProceeder *storemocks.MockPreorderProceeder
+		auditRecorder     *auditmocks.MockRecorder
+	}
+
+	type testCase struct {
+		name string
+
+		prepareFn func(t *testing.T, d *deps)
+
+		expectedErr error
+	}
+
+	executeTxFn := func(ctx context.Context, txFn database.TxFunc) error {
+		return txFn(ctx, nil)
+	}
+
+	pending := domain.Preorder{Id: 3, UserID: 1, GoodID: 12, Price: 200, Status: domain.PreorderStatusPending}
+	fulfilled := pending
+	fulfilled.Status = domain.PreorderStatusFulfilled
+	foreign := pending
+	foreign.UserID = 2
+
+	tests := []testCase{
+		{
+			name: "preorder cancelled",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).Return(uint32(800), nil)
+				d.preorderProceeder.EXPECT().LockAndGetPreorder(gomock.Any(), nil, 3).Return(pending, nil)
+				d.preorderProceeder.EXPECT().CancelPreorder(gomock.Any(), nil, pending).Return(nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil,
+					audit.RefundEvent("preorder:3", 1, 200, audit.RefundReasonCancelled)).Return(nil)
+				d.webhookPublisher.EXPECT().PublishWebhook(gomock.Any(), nil, domain.CancellationWebhook(pending)).Return(nil)
+				d.eventOutbox.EXPECT().AppendEvent(gomock.Any(), nil,
+					domain.PreorderStatusChangedEvent(pending, domain.PreorderStatusCancelled)).Return(nil)
+			},
+		},
+		{
+			name: "preorder of another user",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).Return(uint32(800), nil)
+				d.preorderProceeder.EXPECT().LockAndGetPreorder(gomock.Any(), nil, 3).Return(foreign, nil)
+			},
+			expectedErr: &domain.PreorderNotFoundError{},
+		},
+		{
+			name: "preorder already fulfilled",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).Return(uint32(800), nil)
+				d.preorderProceeder.EXPECT().LockAndGetPreorder(gomock.Any(), nil, 3).Return(fulfilled, nil)
+			},
+			expectedErr: &domain.PreorderClosedError{},
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := &deps{
+				txManager:         dbmocks.NewMockTxManager(ctrl),
+				balanceLocker:     storemocks.NewMockUserBalanceLocker(ctrl),
+				webhookPublisher:  storemocks.NewMockWebhookPublisher(ctrl),
+				eventOutbox:       storemocks.NewMockEventOutbox(ctrl),
+				preorderProceeder: storemocks.NewMockPreorderProceeder(ctrl),
+				auditRecorder:     auditmocks.NewMockRecorder(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			purchaseCase := newTestPurchaseCase(ctrl, d.txManager, storemocks.NewMockUserBalanceLocker(ctrl),
+				storemocks.NewMockBalanceStatusChecker(ctrl), storemocks.NewMockVariantsRepository(ctrl),
+				storemocks.NewMockPurchaseLimitChecker(ctrl))
+
+			preordersCase := NewPreordersCase(d.txManager, storemocks.NewMockGoodsRepository(ctrl),
+				storemocks.NewMockGoodAvailabilityUpdater(ctrl), storemocks.NewMockPreordersRepository(ctrl),
+				d.preorderProceeder, d.balanceLocker, storemocks.NewMockBalanceStatusChecker(ctrl),
+				storemocks.NewMockStockKeeper(ctrl), purchaseCase, storemocks.NewMockWishlistStockWatcher(ctrl),
+				d.webhookPublisher, d.eventOutbox, d.auditRecorder)
+			err := preordersCase.CancelPreorder(t.Context(), 1, 3)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPreordersCase_MarkGoodArrived(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		txManager           *dbmocks.MockTxManager
+		goodsRepository     *storemocks.MockGoodsRepository
+		stockKeeper         *storemocks.MockStockKeeper
+		stockWatcher        *storemocks.MockWishlistStockWatcher
+		webhookPublisher    *storemocks.MockWebhookPublisher
+		eventOutbox         *storemocks.MockEventOutbox
+		availabilityUpdater *storemocks.MockGoodAvailabilityUpdater
+		preorderProceeder   *storemocks.MockPreorderProceeder
+		limitChecker        *storemocks.MockPurchaseLimitChecker
+		auditRecorder       *auditmocks.MockRecorder
+	}
+
+	type testCase struct {
+		name string
+
+		prepareFn func(t *testing.T, d *deps)
+
+		expectedFulfilled int
+		expectedPending   int
+		expectedErr       error
+	}
+
+	executeTxFn := func(ctx context.Context, txFn database.TxFunc) error {
+		return txFn(ctx, nil)
+	}
+
+	umbrella := domain.GoodInfo{Id: 12, Name: "umbrella", Price: 200, BasePrice: 200, Upcoming: true}
+	plain := domain.Preorder{Id: 3, UserID: 1, GoodID: 12, Price: 200, Status: domain.PreorderStatusPending}
+	red := domain.Preorder{Id: 4, UserID: 2, GoodID: 12, VariantID: 4, Price: 220, Status: domain.PreorderStatusPending}
+	lastRed := domain.Preorder{Id: 5, UserID: 3, GoodID: 12, VariantID: 4, Price: 220, Status: domain.PreorderStatusPending}
+
+	purchasedEvent := func(preorder domain.Preorder) domain.DomainEvent {
+		return domain.ItemPurchasedEvent(preorder.UserID, preorder.UserID, preorder.Price,
+			domain.WebhookItem{Name: preorder.GoodName, Variant: preorder.Variant, Quantity: 1})
+	}
+	fulfilledEvent := func(preorder domain.Preorder) domain.DomainEvent {
+		return domain.PreorderStatusChangedEvent(preorder, domain.PreorderStatusFulfilled)
+	}
+
+	tests := []testCase{
+		{
+			name: "pending preorders fulfilled in order",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "umbrella").Return(umbrella, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.availabilityUpdater.EXPECT().SetGoodUpcoming(gomock.Any(), nil, 12, false).Return(nil)
+				d.stockWatcher.EXPECT().RecordBackInStock(gomock.Any(), nil, 12).Return(nil)
+				d.preorderProceeder.EXPECT().LockPreorderBalances(gomock.Any(), nil, 12).
+					Return(map[int]bool{1: true, 2: true}, nil)
+				d.preorderProceeder.EXPECT().LockPendingPreorders(gomock.Any(), nil, 12).
+					Return([]domain.Preorder{plain, red}, nil)
+				d.limitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{12}).Return(nil, nil).Times(2)
+				gomock.InOrder(
+					d.preorderProceeder.EXPECT().FulfillPreorder(gomock.Any(), nil, plain).Return(nil),
+					d.webhookPublisher.EXPECT().PublishWebhook(gomock.Any(), nil, domain.PreorderPurchaseWebhook(plain)).Return(nil),
+					d.eventOutbox.EXPECT().AppendEvent(gomock.Any(), nil, purchasedEvent(plain)).Return(nil),
+					d.eventOutbox.EXPECT().AppendEvent(gomock.Any(), nil, fulfilledEvent(plain)).Return(nil),
+					d.stockKeeper.EXPECT().TakeFromStock(gomock.Any(), nil, 4, uint32(1)).Return(nil),
+					d.preorderProceeder.EXPECT().FulfillPreorder(gomock.Any(), nil, red).Return(nil),
+					d.webhookPublisher.EXPECT().PublishWebhook(gomock.Any(), nil, domain.PreorderPurchaseWebhook(red)).Return(nil),
+					d.eventOutbox.EXPECT().AppendEvent(gomock.Any(), nil, purchasedEvent(red)).Return(nil),
+					d.eventOutbox.EXPECT().AppendEvent(gomock.Any(), nil, fulfilledEvent(red)).Return(nil),
+				)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ database.Executor, event audit.Event) error {
+						assert.Equal(t, audit.ActionGoodArrive, event.Action)
+						assert.Equal(t, "good:umbrella", event.Target)
+						return nil
+					})
+			},
+			expectedFulfilled: 2,
+		},
+		{
+			name: "out of stock preorders cancelled",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "umbrella").Return(umbrella, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.availabilityUpdater.EXPECT().SetGoodUpcoming(gomock.Any(), nil, 12, false).Return(nil)
+				d.stockWatcher.EXPECT().RecordBackInStock(gomock.Any(), nil, 12).Return(nil)
+				d.preorderProceeder.EXPECT().LockPreorderBalances(gomock.Any(), nil, 12).
+					Return(map[int]bool{2: true, 3: true}, nil)
+				d.preorderProceeder.EXPECT().LockPendingPreorders(gomock.Any(), nil, 12).
+					Return([]domain.Preorder{red, lastRed}, nil)
+				d.limitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{12}).Return(nil, nil).Times(2)
+				gomock.InOrder(
+					d.stockKeeper.EXPECT().TakeFromStock(gomock.Any(), nil, 4, uint32(1)).Return(nil),
+					d.preorderProceeder.EXPECT().FulfillPreorder(gomock.Any(), nil, red).Return(nil),
+					d.webhookPublisher.EXPECT().PublishWebhook(gomock.Any(), nil, domain.PreorderPurchaseWebhook(red)).Return(nil),
+					d.eventOutbox.EXPECT().AppendEvent(gomock.Any(), nil, purchasedEvent(red)).Return(nil),
+					d.eventOutbox.EXPECT().AppendEvent(gomock.Any(), nil, fulfilledEvent(red)).Return(nil),
+					d.stockKeeper.EXPECT().TakeFromStock(gomock.Any(), nil, 4, uint32(1)).
+						Return(&domain.OutOfStockError{}),
+					d.preorderProceeder.EXPECT().CancelPreorder(gomock.Any(), nil, lastRed).Return(nil),
+					d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, audit.RefundEvent(audit.PreorderTarget(lastRed.Id),
+						lastRed.UserID, lastRed.Price, audit.RefundReasonSoldOut)).Return(nil),
+					d.webhookPublisher.EXPECT().PublishWebhook(gomock.Any(), nil, domain.CancellationWebhook(lastRed)).Return(nil),
+					d.eventOutbox.EXPECT().AppendEvent(gomock.Any(), nil,
+						domain.PreorderStatusChangedEvent(lastRed, domain.PreorderStatusCancelled)).Return(nil),
+				)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ database.Executor, event audit.Event) error {
+						assert.Equal(t, map[string]any{"fulfilled": 1, "cancelled": 1, "pending": 0}, event.After)
+						return nil
+					})
+			},
+			expectedFulfilled: 1,
+		},
+		{
+			name: "preorders over the purchase limit cancelled",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "umbrella").Return(umbrella, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.availabilityUpdater.EXPECT().SetGoodUpcoming(gomock.Any(), nil, 12, false).Return(nil)
+				d.stockWatcher.EXPECT().RecordBackInStock(gomock.Any(), nil, 12).Return(nil)
+				d.preorderProceeder.EXPECT().LockPreorderBalances(gomock.Any(), nil, 12).
+					Return(map[int]bool{1: true, 2: true}, nil)
+				d.preorderProceeder.EXPECT().LockPendingPreorders(gomock.Any(), nil, 12).
+					Return([]domain.Preorder{plain, red}, nil)
+				d.limitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{12}).
+					Return([]domain.PurchaseLimit{{GoodID: 12, GoodName: "umbrella", MaxQuantity: 1}}, nil).Times(2)
+				d.limitChecker.EXPECT().CountUserAcquisitions(gomock.Any(), nil, 1, 12, gomock.Any()).Return(uint32(1), nil)
+				d.limitChecker.EXPECT().CountUserAcquisitions(gomock.Any(), nil, 2, 12, gomock.Any()).Return(uint32(0), nil)
+				gomock.InOrder(
+					d.preorderProceeder.EXPECT().CancelPreorder(gomock.Any(), nil, plain).Return(nil),
+					d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, audit.RefundEvent(audit.PreorderTarget(plain.Id),
+						plain.UserID, plain.Price, audit.RefundReasonPurchaseLimit)).Return(nil),
+					d.webhookPublisher.EXPECT().PublishWebhook(gomock.Any(), nil, domain.CancellationWebhook(plain)).Return(nil),
+					d.eventOutbox.EXPECT().AppendEvent(gomock.Any(), nil,
+						domain.PreorderStatusChangedEvent(plain, domain.PreorderStatusCancelled)).Return(nil),
+					d.stockKeeper.EXPECT().TakeFromStock(gomock.Any(), nil, 4, uint32(1)).Return(nil),
+					d.preorderProceeder.EXPECT().FulfillPreorder(gomock.Any(), nil, red).Return(nil),
+					d.webhookPublisher.EXPECT().PublishWebhook(gomock.Any(), nil, domain.PreorderPurchaseWebhook(red)).Return(nil),
+					d.eventOutbox.EXPECT().AppendEvent(gomock.Any(), nil, purchasedEvent(red)).Return(nil),
+					d.eventOutbox.EXPECT().AppendEvent(gomock.Any(), nil, fulfilledEvent(red)).Return(nil),
+				)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ database.Executor, event audit.Event) error {
+						assert.Equal(t, map[string]any{"fulfilled": 1, "cancelled": 1, "pending": 0}, event.After)
+						return nil
+					})
+			},
+			expectedFulfilled: 1,
+		},
+		{
+			name: "preorders of frozen or deactivated users stay pending",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "umbrella").Return(umbrella, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.availabilityUpdater.EXPECT().SetGoodUpcoming(gomock.Any(), nil, 12, false).Return(nil)
+				d.stockWatcher.EXPECT().RecordBackInStock(gomock.Any(), nil, 12).Return(nil)
+				d.preorderProceeder.EXPECT().LockPreorderBalances(gomock.Any(), nil, 12).
+					Return(map[int]bool{1: false, 2: true}, nil)
+				d.preorderProceeder.EXPECT().LockPendingPreorders(gomock.Any(), nil, 12).
+					Return([]domain.Preorder{plain, red}, nil)
+				d.limitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{12}).Return(nil, nil)
+				gomock.InOrder(
+					d.stockKeeper.EXPECT().TakeFromStock(gomock.Any(), nil, 4, uint32(1)).Return(nil),
+					d.preorderProceeder.EXPECT().FulfillPreorder(gomock.Any(), nil, red).Return(nil),
+					d.webhookPublisher.EXPECT().PublishWebhook(gomock.Any(), nil, domain.PreorderPurchaseWebhook(red)).Return(nil),
+					d.eventOutbox.EXPECT().AppendEvent(gomock.Any(), nil, purchasedEvent(red)).Return(nil),
+					d.eventOutbox.EXPECT().AppendEvent(gomock.Any(), nil, fulfilledEvent(red)).Return(nil),
+				)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, gomock.Any()).Return(nil)
+			},
+			expectedFulfilled: 1,
+			expectedPending:   1,
+		},
+		{
+			name: "unknown good",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "umbrella").
+					Return(domain.GoodInfo{}, &domain.GoodNotFoundError{})
+			},
+			expectedErr: &domain.GoodNotFoundError{},
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := &deps{
+				txManager:           dbmocks.NewMockTxManager(ctrl),
+				goodsRepository:     storemocks.NewMockGoodsRepository(ctrl),
+				stockKeeper:         storemocks.NewMockStockKeeper(ctrl),
+				stockWatcher:        storemocks.NewMockWishlistStockWatcher(ctrl),
+				webhookPublisher:    storemocks.NewMockWebhookPublisher(ctrl),
+				eventOutbox:         storemocks.NewMockEventOutbox(ctrl),
+				availabilityUpdater: storemocks.NewMockGoodAvailabilityUpdater(ctrl),
+				preorderProceeder:   storemocks.NewMockPreorderProceeder(ctrl),
+				limitChecker:        storemocks.NewMockPurchaseLimitChecker(ctrl),
+				auditRecorder:       auditmocks.NewMockRecorder(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			purchaseCase := newTestPurchaseCase(ctrl, d.txManager, storemocks.NewMockUserBalanceLocker(ctrl),
+				storemocks.NewMockBalanceStatusChecker(ctrl), storemocks.NewMockVariantsRepository(ctrl), d.limitChecker)
+
+			preordersCase := NewPreordersCase(d.txManager, d.goodsRepository, d.availabilityUpdater,
+				storemocks.NewMockPreordersRepository(ctrl), d.preorderProceeder,
+				storemocks.NewMockUserBalanceLocker(ctrl), storemocks.NewMockBalanceStatusChecker(ctrl), d.stockKeeper,
+				purchaseCase, d.stockWatcher, d.webhookPublisher, d.eventOutbox, d.auditRecorder)
+			fulfilled, pending, err := preordersCase.MarkGoodArrived(t.Context(), "umbrella")
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedFulfilled, fulfilled)
+				assert.Equal(t, tt.expectedPending, pending)
+			}
+		})
+	}
+}
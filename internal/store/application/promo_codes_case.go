@@ -0,0 +1,106 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/audit"
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+)
+
+type PromoCodesCase struct {
+	txManager            database.TxManager
+	goodsRepository      domain.GoodsRepository
+	promoCodesRepository domain.PromoCodesRepository
+	auditRecorder        audit.Recorder
+}
+
+func NewPromoCodesCase(txManager database.TxManager,
+	goodsRepository domain.GoodsRepository,
+	promoCodesRepository domain.PromoCodesRepository,
+	auditRecorder audit.Recorder) *PromoCodesCase {
+	return &PromoCodesCase{
+		txManager:            txManager,
+		goodsRepository:      goodsRepository,
+		promoCodesRepository: promoCodesRepository,
+		auditRecorder:        auditRecorder,
+	}
+}
+
+// CreatePromoCode creates a promo code for goodName, or for the whole catalog when goodName is empty.
+// A zero ValidFrom makes the code valid right away.
+func (pc *PromoCodesCase) CreatePromoCode(ctx context.Context, promo domain.PromoCode, goodName string) (int, error) {
+	promo.Code = domain.NormalizePromoCode(promo.Code)
+	if promo.Code == "" {
+		return 0, &domain.InvalidArgumentsError{Msg: "promo code must not be empty"}
+	} else if utf8.RuneCountInString(promo.Code) > domain.MaxPromoCodeLength {
+		return 0, &domain.InvalidArgumentsError{Msg: fmt.Sprintf("promo code must not exceed %d characters", domain.MaxPromoCodeLength)}
+	}
+
+	switch promo.Kind {
+	case domain.PromoKindPercent:
+		if promo.Value == 0 || promo.Value > 100 {
+			return 0, &domain.InvalidArgumentsError{Msg: "percent discount must be between 1 and 100"}
+		}
+	case domain.PromoKindFixed:
+		if promo.Value == 0 {
+			return 0, &domain.InvalidArgumentsError{Msg: "fixed discount must be positive"}
+		}
+	default:
+		return 0, &domain.InvalidArgumentsError{Msg: fmt.Sprintf("unknown promo kind %q", promo.Kind)}
+	}
+
+	now := time.Now()
+	if promo.ValidFrom.IsZero() {
+		promo.ValidFrom = now
+	}
+
+	if !promo.ValidUntil.After(promo.ValidFrom) || !promo.ValidUntil.After(now) {
+		return 0, &domain.InvalidArgumentsError{Msg: "promo code must stay valid until a future time after its start"}
+	}
+
+	if goodName != "" {
+		goodInfo, err := pc.goodsRepository.GetGoodInfo(ctx, goodName)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get good info: %w", err)
+		}
+
+		promo.GoodID = goodInfo.Id
+	}
+
+	var promoID int
+	err := pc.txManager.WithinTransaction(ctx, func(ctx context.Context, executor database.QueryExecuter) error {
+		var err error
+		promoID, err = pc.promoCodesRepository.CreatePromoCode(ctx, executor, promo)
+		if err != nil {
+			return err
+		}
+
+		err = pc.auditRecorder.RecordInTx(ctx, executor, audit.Event{
+			Action: audit.ActionPromoCodeCreate,
+			Target: audit.PromoCodeTarget(promo.Code),
+			After: map[string]any{
+				"kind":           promo.Kind,
+				"value":          promo.Value,
+				"item":           goodName,
+				"maxUses":        promo.MaxUses,
+				"maxUsesPerUser": promo.MaxUsesPerUser,
+				"validFrom":      promo.ValidFrom.UTC().Format(time.RFC3339),
+				"validUntil":     promo.ValidUntil.UTC().Format(time.RFC3339),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record audit event: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return promoID, nil
+}
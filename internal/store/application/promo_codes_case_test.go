@@ -0,0 +1,157 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	auditmocks "github.com/Lexv0lk/merch-store/gen/mocks/audit"
+	dbmocks "github.com/Lexv0lk/merch-store/gen/mocks/database"
+	storemocks "github.com/Lexv0lk/merch-store/gen/mocks/store"
+	"github.com/Lexv0lk/merch-store/internal/pkg/audit"
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPromoCodesCase_CreatePromoCode(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		txManager            *dbmocks.MockTxManager
+		goodsRepository      *storemocks.MockGoodsRepository
+		promoCodesRepository *storemocks.MockPromoCodesRepository
+		auditRecorder        *auditmocks.MockRecorder
+	}
+
+	type testCase struct {
+		name     string
+		promo    domain.PromoCode
+		goodName string
+
+		prepareFn func(t *testing.T, d *deps)
+
+		expectedID  int
+		expectedErr error
+	}
+
+	executeTxFn := func(ctx context.Context, txFn database.TxFunc) error {
+		return txFn(ctx, nil)
+	}
+
+	validUntil := time.Now().Add(7 * 24 * time.Hour)
+
+	tests := []testCase{
+		{
+			name:     "catalog-wide code",
+			promo:    domain.PromoCode{Code: " spring10 ", Kind: domain.PromoKindPercent, Value: 10, ValidUntil: validUntil},
+			goodName: "",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.promoCodesRepository.EXPECT().CreatePromoCode(gomock.Any(), nil, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ database.Querier, promo domain.PromoCode) (int, error) {
+						assert.Equal(t, "SPRING10", promo.Code)
+						assert.Equal(t, 0, promo.GoodID)
+						assert.False(t, promo.ValidFrom.IsZero())
+						return 4, nil
+					})
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ database.Executor, event audit.Event) error {
+						assert.Equal(t, audit.ActionPromoCodeCreate, event.Action)
+						assert.Equal(t, "promo-code:SPRING10", event.Target)
+						return nil
+					})
+			},
+			expectedID: 4,
+		},
+		{
+			name:     "code for a single good",
+			promo:    domain.PromoCode{Code: "CUP5", Kind: domain.PromoKindFixed, Value: 5, ValidUntil: validUntil},
+			goodName: "cup",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "cup").Return(domain.GoodInfo{Id: 10, Name: "cup", Price: 20}, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.promoCodesRepository.EXPECT().CreatePromoCode(gomock.Any(), nil, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ database.Querier, promo domain.PromoCode) (int, error) {
+						assert.Equal(t, 10, promo.GoodID)
+						return 5, nil
+					})
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, gomock.Any()).Return(nil)
+			},
+			expectedID: 5,
+		},
+		{
+			name:        "percent above 100",
+			promo:       domain.PromoCode{Code: "ALL", Kind: domain.PromoKindPercent, Value: 150, ValidUntil: validUntil},
+			prepareFn:   func(t *testing.T, d *deps) {},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:        "unknown kind",
+			promo:       domain.PromoCode{Code: "ALL", Kind: "bogo", Value: 1, ValidUntil: validUntil},
+			prepareFn:   func(t *testing.T, d *deps) {},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:        "empty code",
+			promo:       domain.PromoCode{Code: "  ", Kind: domain.PromoKindFixed, Value: 1, ValidUntil: validUntil},
+			prepareFn:   func(t *testing.T, d *deps) {},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:        "already expired",
+			promo:       domain.PromoCode{Code: "OLD", Kind: domain.PromoKindFixed, Value: 1, ValidUntil: time.Now().Add(-time.Hour)},
+			prepareFn:   func(t *testing.T, d *deps) {},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:  "duplicate code",
+			promo: domain.PromoCode{Code: "SPRING10", Kind: domain.PromoKindPercent, Value: 10, ValidUntil: validUntil},
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.promoCodesRepository.EXPECT().CreatePromoCode(gomock.Any(), nil, gomock.Any()).
+					Return(0, &domain.PromoCodeExistingError{})
+			},
+			expectedErr: &domain.PromoCodeExistingError{},
+		},
+		{
+			name:  "audit failure",
+			promo: domain.PromoCode{Code: "SPRING10", Kind: domain.PromoKindPercent, Value: 10, ValidUntil: validUntil},
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.promoCodesRepository.EXPECT().CreatePromoCode(gomock.Any(), nil, gomock.Any()).Return(4, nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, gomock.Any()).Return(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := &deps{
+				txManager:            dbmocks.NewMockTxManager(ctrl),
+				goodsRepository:      storemocks.NewMockGoodsRepository(ctrl),
+				promoCodesRepository: storemocks.NewMockPromoCodesRepository(ctrl),
+				auditRecorder:        auditmocks.NewMockRecorder(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			promoCodesCase := NewPromoCodesCase(d.txManager, d.goodsRepository, d.promoCodesRepository, d.auditRecorder)
+			promoID, err := promoCodesCase.CreatePromoCode(t.Context(), tt.promo, tt.goodName)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedID, promoID)
+			}
+		})
+	}
+}
@@ -3,49 +3,405 @@ package application
 import (
 	"context"
 	"fmt"
+	"slices"
+	"time"
+	"unicode/utf8"
 
 	"github.com/Lexv0lk/merch-store/internal/pkg/database"
 	"github.com/Lexv0lk/merch-store/internal/store/domain"
 )
 
 type PurchaseCase struct {
-	goodsRepository domain.GoodsRepository
-	balanceLocker   domain.UserBalanceLocker
-	purchaser       domain.Purchaser
-	txManager       database.TxManager
+	goodsRepository      domain.GoodsRepository
+	balanceLocker        domain.UserBalanceLocker
+	balanceStatusChecker domain.BalanceStatusChecker
+	purchaser            domain.Purchaser
+	txManager            database.TxManager
+	userIDFetcher        domain.UserIDFetcher
+	balanceCreator       domain.BalanceEnsurer
+	promoCodesRepository domain.PromoCodesRepository
+	promoRedeemer        domain.PromoRedeemer
+	variantsRepository   domain.VariantsRepository
+	stockKeeper          domain.StockKeeper
+	bundlesRepository    domain.BundlesRepository
+	purchaseLimitChecker domain.PurchaseLimitChecker
+	webhookPublisher     domain.WebhookPublisher
+	eventOutbox          domain.EventOutbox
 }
 
 func NewPurchaseCase(goodsRepository domain.GoodsRepository, balanceLocker domain.UserBalanceLocker,
-	purchaser domain.Purchaser, txManager database.TxManager) *PurchaseCase {
+	balanceStatusChecker domain.BalanceStatusChecker, purchaser domain.Purchaser, txManager database.TxManager,
+	userIDFetcher domain.UserIDFetcher, balanceCreator domain.BalanceEnsurer,
+	promoCodesRepository domain.PromoCodesRepository, promoRedeemer domain.PromoRedeemer,
+	variantsRepository domain.VariantsRepository, stockKeeper domain.StockKeeper,
+	bundlesRepository domain.BundlesRepository, purchaseLimitChecker domain.PurchaseLimitChecker,
+	webhookPublisher domain.WebhookPublisher, eventOutbox domain.EventOutbox) *PurchaseCase {
 	return &PurchaseCase{
-		goodsRepository: goodsRepository,
-		balanceLocker:   balanceLocker,
-		purchaser:       purchaser,
-		txManager:       txManager,
+		goodsRepository:      goodsRepository,
+		balanceLocker:        balanceLocker,
+		balanceStatusChecker: balanceStatusChecker,
+		purchaser:            purchaser,
+		txManager:            txManager,
+		userIDFetcher:        userIDFetcher,
+		balanceCreator:       balanceCreator,
+		promoCodesRepository: promoCodesRepository,
+		promoRedeemer:        promoRedeemer,
+		variantsRepository:   variantsRepository,
+		stockKeeper:          stockKeeper,
+		bundlesRepository:    bundlesRepository,
+		purchaseLimitChecker: purchaseLimitChecker,
+		webhookPublisher:     webhookPublisher,
+		eventOutbox:          eventOutbox,
 	}
 }
 
-func (pc *PurchaseCase) BuyItem(ctx context.Context, userId int, goodName string) error {
+// BuyItem buys a good for the user. Goods with variants are bought as the variant with the variantSKU, which
+// sets the price and is taken from stock. A bundle is charged its own price once, and its components land in
+// the inventory; the variantSKU then picks the variant of the component that has variants. A non-empty
+// promoCode discounts the price, and its redemption is recorded in the purchase transaction.
+func (pc *PurchaseCase) BuyItem(ctx context.Context, userId int, goodName, variantSKU, promoCode string) error {
 	goodInfo, err := pc.goodsRepository.GetGoodInfo(ctx, goodName)
 	if err != nil {
 		return fmt.Errorf("failed to get good info: %w", err)
 	}
 
+	if goodInfo.Upcoming {
+		return &domain.InvalidArgumentsError{Msg: "item hasn't arrived yet, pre-order it instead"}
+	}
+
+	var process func(ctx context.Context, executor database.QueryExecuter, good domain.GoodInfo) error
+	if goodInfo.Bundle {
+		components, err := pc.pickBundleVariants(ctx, goodInfo.Id, variantSKU)
+		if err != nil {
+			return err
+		}
+
+		process = func(ctx context.Context, executor database.QueryExecuter, good domain.GoodInfo) error {
+			return pc.processBundlePurchase(ctx, executor, userId, good, components)
+		}
+	} else {
+		goodInfo, err = pc.pickVariant(ctx, goodInfo, variantSKU)
+		if err != nil {
+			return err
+		}
+
+		process = func(ctx context.Context, executor database.QueryExecuter, good domain.GoodInfo) error {
+			return pc.processPurchase(ctx, executor, userId, good)
+		}
+	}
+
+	if promoCode == "" {
+		return pc.purchase(ctx, userId, goodInfo.Price, func(ctx context.Context, executor database.QueryExecuter) error {
+			return process(ctx, executor, goodInfo)
+		})
+	}
+
+	promoCode = domain.NormalizePromoCode(promoCode)
+	promo, err := pc.promoCodesRepository.GetPromoCode(ctx, promoCode)
+	if err != nil {
+		return err
+	}
+
+	err = promo.CheckRedeemable(goodInfo.Id, time.Now())
+	if err != nil {
+		return err
+	}
+
+	discount := promo.Discount(goodInfo.Price)
+	discounted := goodInfo
+	discounted.Price -= discount
+
+	return pc.purchase(ctx, userId, discounted.Price, func(ctx context.Context, executor database.QueryExecuter) error {
+		err := pc.redeemPromoCode(ctx, executor, userId, goodInfo.Id, promoCode, discount)
+		if err != nil {
+			return err
+		}
+
+		return process(ctx, executor, discounted)
+	})
+}
+
+// GiftItem buys a good, as the variant with the variantSKU for goods with variants, for another user. The buyer
+// pays, the good lands in the recipient's inventory together with the buyer and the optional message. A gift
+// webhook is published for the recipient, and an ItemPurchased event is appended for both of them.
+func (pc *PurchaseCase) GiftItem(ctx context.Context, buyerID int, goodName, variantSKU, recipientUsername, message string) error {
+	if utf8.RuneCountInString(message) > domain.MaxGiftMessageLength {
+		return &domain.InvalidArgumentsError{Msg: fmt.Sprintf("message must not exceed %d characters", domain.MaxGiftMessageLength)}
+	}
+
+	recipientID, err := pc.userIDFetcher.FetchUserID(ctx, recipientUsername)
+	if err != nil {
+		return &domain.UserNotFoundError{Msg: fmt.Sprintf("user not found: %s", recipientUsername)}
+	}
+
+	if recipientID == buyerID {
+		return &domain.InvalidArgumentsError{Msg: "gift recipient must differ from the buyer"}
+	}
+
+	goodInfo, err := pc.goodsRepository.GetGoodInfo(ctx, goodName)
+	if err != nil {
+		return fmt.Errorf("failed to get good info: %w", err)
+	}
+
+	if goodInfo.Bundle {
+		return &domain.InvalidArgumentsError{Msg: "bundles can't be gifted"}
+	} else if goodInfo.Upcoming {
+		return &domain.InvalidArgumentsError{Msg: "item hasn't arrived yet"}
+	}
+
+	goodInfo, err = pc.pickVariant(ctx, goodInfo, variantSKU)
+	if err != nil {
+		return err
+	}
+
+	err = pc.balanceCreator.EnsureBalanceCreated(ctx, recipientID, domain.StartBalance)
+	if err != nil {
+		return fmt.Errorf("failed to ensure balance for user %d: %w", recipientID, err)
+	}
+
 	return pc.txManager.WithinTransaction(ctx, func(ctx context.Context, executor database.QueryExecuter) error {
-		balance, err := pc.balanceLocker.LockAndGetUserBalance(ctx, executor, userId)
+		// The recipient's balance row serializes the gift with the recipient's own purchases under their limits.
+		err := pc.lockBuyer(ctx, executor, buyerID, goodInfo.Price, recipientID)
+		if err != nil {
+			return err
+		}
+
+		isActive, err := pc.balanceStatusChecker.IsBalanceActive(ctx, executor, recipientID)
+		if err != nil {
+			return fmt.Errorf("failed to check balance status for user %d: %w", recipientID, err)
+		}
+
+		if !isActive {
+			return &domain.UserDeactivatedError{Msg: fmt.Sprintf("user %s is deactivated", recipientUsername)}
+		}
+
+		err = checkPurchaseLimits(ctx, pc.purchaseLimitChecker, executor, recipientID, map[int]uint32{goodInfo.Id: 1})
+		if err != nil {
+			return err
+		}
+
+		if goodInfo.VariantID != 0 {
+			err := pc.stockKeeper.TakeFromStock(ctx, executor, goodInfo.VariantID, 1)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = pc.purchaser.ProcessGift(ctx, executor, buyerID, recipientID, goodInfo, message)
 		if err != nil {
-			return fmt.Errorf("failed to lock and get user balance: %w", err)
+			return fmt.Errorf("failed to process gift: %w", err)
 		}
 
-		if balance < goodInfo.Price {
-			return &domain.InsufficientBalanceError{Msg: "insufficient balance"}
+		item := domain.WebhookItem{Name: goodInfo.Name, Variant: goodInfo.Variant, Quantity: 1}
+		err = pc.webhookPublisher.PublishWebhook(ctx, executor, domain.GiftWebhook(buyerID, recipientID,
+			goodInfo.Price, message, item))
+		if err != nil {
+			return err
 		}
 
-		err = pc.purchaser.ProcessPurchase(ctx, executor, userId, goodInfo)
+		return pc.eventOutbox.AppendEvent(ctx, executor, domain.ItemPurchasedEvent(buyerID, recipientID, goodInfo.Price, item))
+	})
+}
+
+// purchase locks the buyer's balance, checks it covers the price and isn't frozen, then runs process
+// in the same transaction.
+func (pc *PurchaseCase) purchase(ctx context.Context, buyerID int, price uint32,
+	process func(ctx context.Context, executor database.QueryExecuter) error) error {
+	return pc.txManager.WithinTransaction(ctx, func(ctx context.Context, executor database.QueryExecuter) error {
+		err := pc.lockBuyer(ctx, executor, buyerID, price)
 		if err != nil {
-			return fmt.Errorf("failed to process purchase: %w", err)
+			return err
 		}
 
-		return nil
+		return process(ctx, executor)
 	})
 }
+
+// lockBuyer locks the balances of the buyer and the other users in user ID order, then checks the buyer's
+// balance covers the price and isn't frozen.
+func (pc *PurchaseCase) lockBuyer(ctx context.Context, executor database.QueryExecuter, buyerID int, price uint32,
+	otherUserIDs ...int) error {
+	balances, err := lockBalances(ctx, pc.balanceLocker, executor, append([]int{buyerID}, otherUserIDs...)...)
+	if err != nil {
+		return err
+	}
+
+	if balances[buyerID] < price {
+		return &domain.InsufficientBalanceError{Msg: "insufficient balance"}
+	}
+
+	return checkNotFrozen(ctx, pc.balanceStatusChecker, executor, buyerID, "account is frozen")
+}
+
+// pickVariant applies the variant with the given SKU to the good info. Goods without variants are returned as is.
+func (pc *PurchaseCase) pickVariant(ctx context.Context, goodInfo domain.GoodInfo, sku string) (domain.GoodInfo, error) {
+	variants, err := pc.variantsRepository.ListGoodVariants(ctx, goodInfo.Id)
+	if err != nil {
+		return domain.GoodInfo{}, fmt.Errorf("failed to list good variants: %w", err)
+	}
+
+	variant, err := domain.PickVariant(variants, sku)
+	if err != nil {
+		return domain.GoodInfo{}, err
+	}
+
+	if variant.Id != 0 {
+		goodInfo.Price = variant.Price(goodInfo.Price)
+		goodInfo.VariantID = variant.Id
+		goodInfo.Variant = variant.SKU
+	}
+
+	return goodInfo, nil
+}
+
+// pickBundleVariants lists the components of the bundle and picks the variant with the given SKU for the
+// component that has variants.
+func (pc *PurchaseCase) pickBundleVariants(ctx context.Context, bundleID int, sku string) ([]domain.BundleComponent, error) {
+	components, err := pc.bundlesRepository.ListBundleComponents(ctx, bundleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list bundle components: %w", err)
+	}
+
+	variantsByGood := make(map[int][]domain.Variant, len(components))
+	for _, component := range components {
+		variants, err := pc.variantsRepository.ListGoodVariants(ctx, component.GoodID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list good variants: %w", err)
+		}
+
+		variantsByGood[component.GoodID] = variants
+	}
+
+	return domain.PickBundleVariants(components, variantsByGood, sku)
+}
+
+// processBundlePurchase checks the purchase limits of the components, takes their picked variants from stock,
+// charges the bundle price, puts the components into the user's inventory, publishes a purchase webhook and
+// appends an ItemPurchased event.
+func (pc *PurchaseCase) processBundlePurchase(ctx context.Context, executor database.QueryExecuter, userID int,
+	bundle domain.GoodInfo, components []domain.BundleComponent) error {
+	quantities := make(map[int]uint32, len(components))
+	for _, component := range components {
+		quantities[component.GoodID] += component.Quantity
+	}
+
+	err := checkPurchaseLimits(ctx, pc.purchaseLimitChecker, executor, userID, quantities)
+	if err != nil {
+		return err
+	}
+
+	for _, component := range components {
+		if component.VariantID != 0 {
+			err := pc.stockKeeper.TakeFromStock(ctx, executor, component.VariantID, component.Quantity)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	err = pc.purchaser.ProcessBundlePurchase(ctx, executor, userID, bundle, components)
+	if err != nil {
+		return fmt.Errorf("failed to process bundle purchase: %w", err)
+	}
+
+	err = pc.webhookPublisher.PublishWebhook(ctx, executor, domain.BundlePurchaseWebhook(userID, bundle, components))
+	if err != nil {
+		return err
+	}
+
+	return pc.eventOutbox.AppendEvent(ctx, executor, domain.ItemPurchasedEvent(userID, userID, bundle.Price,
+		domain.BundleItems(components)...))
+}
+
+// processPurchase checks the purchase limit of the good, takes the picked variant, if any, from stock, puts
+// the good into the user's inventory, publishes a purchase webhook and appends an ItemPurchased event.
+func (pc *PurchaseCase) processPurchase(ctx context.Context, executor database.QueryExecuter, userID int, goodInfo domain.GoodInfo) error {
+	err := checkPurchaseLimits(ctx, pc.purchaseLimitChecker, executor, userID, map[int]uint32{goodInfo.Id: 1})
+	if err != nil {
+		return err
+	}
+
+	if goodInfo.VariantID != 0 {
+		err := pc.stockKeeper.TakeFromStock(ctx, executor, goodInfo.VariantID, 1)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = pc.purchaser.ProcessPurchase(ctx, executor, userID, goodInfo)
+	if err != nil {
+		return fmt.Errorf("failed to process purchase: %w", err)
+	}
+
+	item := domain.WebhookItem{Name: goodInfo.Name, Variant: goodInfo.Variant, Quantity: 1}
+	err = pc.webhookPublisher.PublishWebhook(ctx, executor, domain.PurchaseWebhook(userID, goodInfo.Price, item))
+	if err != nil {
+		return err
+	}
+
+	return pc.eventOutbox.AppendEvent(ctx, executor, domain.ItemPurchasedEvent(userID, userID, goodInfo.Price, item))
+}
+
+// checkPurchaseLimits checks that the user may get the quantities of goods, keyed by good ID, on top of the units
+// they got within the limit windows. It must run under the user's balance lock, so concurrent purchases, gifts
+// and transfers to the user can't slip past a limit together.
+func checkPurchaseLimits(ctx context.Context, limitChecker domain.PurchaseLimitChecker, querier database.Querier,
+	userID int, quantities map[int]uint32) error {
+	goodIDs := make([]int, 0, len(quantities))
+	for goodID := range quantities {
+		goodIDs = append(goodIDs, goodID)
+	}
+	slices.Sort(goodIDs)
+
+	limits, err := limitChecker.ListPurchaseLimits(ctx, querier, goodIDs)
+	if err != nil {
+		return fmt.Errorf("failed to list purchase limits: %w", err)
+	}
+
+	now := time.Now()
+	for _, limit := range limits {
+		acquired, err := limitChecker.CountUserAcquisitions(ctx, querier, userID, limit.GoodID, limit.WindowStart(now))
+		if err != nil {
+			return fmt.Errorf("failed to count user acquisitions: %w", err)
+		}
+
+		err = limit.Check(acquired, quantities[limit.GoodID])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// redeemPromoCode locks the promo code, checks it again together with the user's cap and records the redemption.
+func (pc *PurchaseCase) redeemPromoCode(ctx context.Context, executor database.QueryExecuter, userID, goodID int,
+	code string, discount uint32) error {
+	promo, err := pc.promoRedeemer.LockAndGetPromoCode(ctx, executor, code)
+	if err != nil {
+		return err
+	}
+
+	err = promo.CheckRedeemable(goodID, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if promo.MaxUsesPerUser != 0 {
+		used, err := pc.promoRedeemer.CountUserRedemptions(ctx, executor, promo.Id, userID)
+		if err != nil {
+			return err
+		}
+
+		if used >= promo.MaxUsesPerUser {
+			return &domain.PromoCodeUnusableError{Msg: "promo code has been used up by this user"}
+		}
+	}
+
+	err = pc.promoRedeemer.RecordRedemption(ctx, executor, promo.Id, userID, goodID, discount)
+	if err != nil {
+		return fmt.Errorf("failed to redeem promo code: %w", err)
+	}
+
+	return nil
+}
@@ -2,7 +2,9 @@ package application
 
 import (
 	"context"
+	"strings"
 	"testing"
+	"time"
 
 	dbmocks "github.com/Lexv0lk/merch-store/gen/mocks/database"
 	storemocks "github.com/Lexv0lk/merch-store/gen/mocks/store"
@@ -16,16 +18,24 @@ func TestPurchaseCase_BuyItem(t *testing.T) {
 	t.Parallel()
 
 	type deps struct {
-		goodsRepository *storemocks.MockGoodsRepository
-		balanceLocker   *storemocks.MockUserBalanceLocker
-		purchaser       *storemocks.MockPurchaser
-		txManager       *dbmocks.MockTxManager
+		goodsRepository      *storemocks.MockGoodsRepository
+		balanceLocker        *storemocks.MockUserBalanceLocker
+		balanceStatusChecker *storemocks.MockBalanceStatusChecker
+		purchaser            *storemocks.MockPurchaser
+		txManager            *dbmocks.MockTxManager
+		variantsRepository   *storemocks.MockVariantsRepository
+		stockKeeper          *storemocks.MockStockKeeper
+		bundlesRepository    *storemocks.MockBundlesRepository
+		purchaseLimitChecker *storemocks.MockPurchaseLimitChecker
+		webhookPublisher     *storemocks.MockWebhookPublisher
+		eventOutbox          *storemocks.MockEventOutbox
 	}
 
 	type testCase struct {
 		name     string
 		userId   int
 		goodName string
+		variant  string
 
 		prepareFn func(t *testing.T, d *deps)
 
@@ -37,6 +47,17 @@ func TestPurchaseCase_BuyItem(t *testing.T) {
 		return txFn(ctx, nil)
 	}
 
+	sizes := []domain.Variant{
+		{Id: 3, GoodID: 10, SKU: "t-shirt-l", Attributes: map[string]string{"size": "L"}},
+		{Id: 4, GoodID: 10, SKU: "t-shirt-xl", Attributes: map[string]string{"size": "XL"}, PriceDelta: 10},
+	}
+
+	kit := domain.GoodInfo{Id: 30, Name: "onboarding-kit", Price: 90, BasePrice: 90, Bundle: true}
+	kitComponents := []domain.BundleComponent{
+		{BundleID: 30, GoodID: 2, GoodName: "cup", Quantity: 2},
+		{BundleID: 30, GoodID: 10, GoodName: "t-shirt", Quantity: 1},
+	}
+
 	tests := []testCase{
 		{
 			name:     "successful purchase",
@@ -45,15 +66,227 @@ func TestPurchaseCase_BuyItem(t *testing.T) {
 			prepareFn: func(t *testing.T, d *deps) {
 				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "t-shirt").
 					Return(domain.GoodInfo{Id: 10, Name: "t-shirt", Price: 80}, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 10).Return(nil, nil)
 				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).
 					DoAndReturn(executeTxFn)
 				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).
 					Return(uint32(100), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).
+					Return(false, nil)
+				d.purchaseLimitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{10}).Return(nil, nil)
 				d.purchaser.EXPECT().ProcessPurchase(gomock.Any(), nil, 1, domain.GoodInfo{Id: 10, Name: "t-shirt", Price: 80}).
 					Return(nil)
+				d.webhookPublisher.EXPECT().PublishWebhook(gomock.Any(), nil,
+					domain.PurchaseWebhook(1, 80, domain.WebhookItem{Name: "t-shirt", Quantity: 1})).Return(nil)
+				d.eventOutbox.EXPECT().AppendEvent(gomock.Any(), nil,
+					domain.ItemPurchasedEvent(1, 1, 80, domain.WebhookItem{Name: "t-shirt", Quantity: 1})).Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:     "successful purchase of a variant",
+			userId:   1,
+			goodName: "t-shirt",
+			variant:  "t-shirt-xl",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "t-shirt").
+					Return(domain.GoodInfo{Id: 10, Name: "t-shirt", Price: 80}, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 10).Return(sizes, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(executeTxFn)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).
+					Return(uint32(100), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).
+					Return(false, nil)
+				d.purchaseLimitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{10}).Return(nil, nil)
+				d.stockKeeper.EXPECT().TakeFromStock(gomock.Any(), nil, 4, uint32(1)).Return(nil)
+				d.purchaser.EXPECT().ProcessPurchase(gomock.Any(), nil, 1, domain.GoodInfo{Id: 10, Name: "t-shirt", Price: 90, VariantID: 4, Variant: "t-shirt-xl"}).
+					Return(nil)
+				d.webhookPublisher.EXPECT().PublishWebhook(gomock.Any(), nil, domain.PurchaseWebhook(1, 90,
+					domain.WebhookItem{Name: "t-shirt", Variant: "t-shirt-xl", Quantity: 1})).Return(nil)
+				d.eventOutbox.EXPECT().AppendEvent(gomock.Any(), nil, domain.ItemPurchasedEvent(1, 1, 90,
+					domain.WebhookItem{Name: "t-shirt", Variant: "t-shirt-xl", Quantity: 1})).Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:     "variant not picked",
+			userId:   1,
+			goodName: "t-shirt",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "t-shirt").
+					Return(domain.GoodInfo{Id: 10, Name: "t-shirt", Price: 80}, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 10).Return(sizes, nil)
+			},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:     "unknown variant",
+			userId:   1,
+			goodName: "t-shirt",
+			variant:  "t-shirt-xxl",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "t-shirt").
+					Return(domain.GoodInfo{Id: 10, Name: "t-shirt", Price: 80}, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 10).Return(sizes, nil)
+			},
+			expectedErr: &domain.VariantNotFoundError{},
+		},
+		{
+			name:     "variant out of stock",
+			userId:   1,
+			goodName: "t-shirt",
+			variant:  "t-shirt-l",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "t-shirt").
+					Return(domain.GoodInfo{Id: 10, Name: "t-shirt", Price: 80}, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 10).Return(sizes, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(executeTxFn)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).
+					Return(uint32(100), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).
+					Return(false, nil)
+				d.purchaseLimitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{10}).Return(nil, nil)
+				d.stockKeeper.EXPECT().TakeFromStock(gomock.Any(), nil, 3, uint32(1)).
+					Return(&domain.OutOfStockError{Msg: "variant is out of stock"})
+			},
+			expectedErr: &domain.OutOfStockError{},
+		},
+		{
+			name:     "successful purchase of a bundle",
+			userId:   1,
+			goodName: "onboarding-kit",
+			variant:  "t-shirt-xl",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "onboarding-kit").Return(kit, nil)
+				d.bundlesRepository.EXPECT().ListBundleComponents(gomock.Any(), 30).Return(kitComponents, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 2).Return(nil, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 10).Return(sizes, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(executeTxFn)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).
+					Return(uint32(100), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).
+					Return(false, nil)
+				d.purchaseLimitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{2, 10}).Return(nil, nil)
+				d.stockKeeper.EXPECT().TakeFromStock(gomock.Any(), nil, 4, uint32(1)).Return(nil)
+				d.purchaser.EXPECT().ProcessBundlePurchase(gomock.Any(), nil, 1, kit, []domain.BundleComponent{
+					{BundleID: 30, GoodID: 2, GoodName: "cup", Quantity: 2},
+					{BundleID: 30, GoodID: 10, GoodName: "t-shirt", Quantity: 1, VariantID: 4, Variant: "t-shirt-xl"},
+				}).Return(nil)
+				d.webhookPublisher.EXPECT().PublishWebhook(gomock.Any(), nil, domain.WebhookEvent{
+					Type: domain.WebhookEventPurchase,
+					Data: map[string]any{
+						"userId": 1,
+						"price":  uint32(90),
+						"bundle": "onboarding-kit",
+						"items": []map[string]any{
+							{"name": "cup", "variant": "", "quantity": uint32(2)},
+							{"name": "t-shirt", "variant": "t-shirt-xl", "quantity": uint32(1)},
+						},
+					},
+				}).Return(nil)
+				d.eventOutbox.EXPECT().AppendEvent(gomock.Any(), nil, domain.ItemPurchasedEvent(1, 1, 90,
+					domain.WebhookItem{Name: "cup", Quantity: 2},
+					domain.WebhookItem{Name: "t-shirt", Variant: "t-shirt-xl", Quantity: 1})).Return(nil)
 			},
 			expectedErr: nil,
 		},
+		{
+			name:     "bundle without a variant picked",
+			userId:   1,
+			goodName: "onboarding-kit",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "onboarding-kit").Return(kit, nil)
+				d.bundlesRepository.EXPECT().ListBundleComponents(gomock.Any(), 30).Return(kitComponents, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 2).Return(nil, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 10).Return(sizes, nil)
+			},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:     "upcoming good",
+			userId:   1,
+			goodName: "umbrella",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "umbrella").
+					Return(domain.GoodInfo{Id: 12, Name: "umbrella", Price: 200, Upcoming: true}, nil)
+			},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:     "bundle component out of stock",
+			userId:   1,
+			goodName: "onboarding-kit",
+			variant:  "t-shirt-l",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "onboarding-kit").Return(kit, nil)
+				d.bundlesRepository.EXPECT().ListBundleComponents(gomock.Any(), 30).Return(kitComponents, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 2).Return(nil, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 10).Return(sizes, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(executeTxFn)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).
+					Return(uint32(100), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).
+					Return(false, nil)
+				d.purchaseLimitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{2, 10}).Return(nil, nil)
+				d.stockKeeper.EXPECT().TakeFromStock(gomock.Any(), nil, 3, uint32(1)).
+					Return(&domain.OutOfStockError{Msg: "variant is out of stock"})
+			},
+			expectedErr: &domain.OutOfStockError{},
+		},
+		{
+			name:     "limit per person reached",
+			userId:   1,
+			goodName: "t-shirt",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "t-shirt").
+					Return(domain.GoodInfo{Id: 10, Name: "t-shirt", Price: 80}, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 10).Return(nil, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(executeTxFn)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).
+					Return(uint32(100), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).
+					Return(false, nil)
+				d.purchaseLimitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{10}).
+					Return([]domain.PurchaseLimit{{GoodID: 10, GoodName: "t-shirt", MaxQuantity: 1}}, nil)
+				d.purchaseLimitChecker.EXPECT().CountUserAcquisitions(gomock.Any(), nil, 1, 10, time.Time{}).
+					Return(uint32(1), nil)
+			},
+			expectedErr: &domain.PurchaseLimitError{},
+		},
+		{
+			name:     "within the limit per period",
+			userId:   1,
+			goodName: "t-shirt",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "t-shirt").
+					Return(domain.GoodInfo{Id: 10, Name: "t-shirt", Price: 80}, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 10).Return(nil, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(executeTxFn)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).
+					Return(uint32(100), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).
+					Return(false, nil)
+				d.purchaseLimitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{10}).
+					Return([]domain.PurchaseLimit{{GoodID: 10, GoodName: "t-shirt", MaxQuantity: 3, Period: 90 * 24 * time.Hour}}, nil)
+				d.purchaseLimitChecker.EXPECT().CountUserAcquisitions(gomock.Any(), nil, 1, 10, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ database.Querier, _, _ int, since time.Time) (uint32, error) {
+						assert.WithinDuration(t, time.Now().Add(-90*24*time.Hour), since, time.Minute)
+						return 2, nil
+					})
+				d.purchaser.EXPECT().ProcessPurchase(gomock.Any(), nil, 1, domain.GoodInfo{Id: 10, Name: "t-shirt", Price: 80}).
+					Return(nil)
+				d.webhookPublisher.EXPECT().PublishWebhook(gomock.Any(), nil,
+					domain.PurchaseWebhook(1, 80, domain.WebhookItem{Name: "t-shirt", Quantity: 1})).Return(nil)
+				d.eventOutbox.EXPECT().AppendEvent(gomock.Any(), nil,
+					domain.ItemPurchasedEvent(1, 1, 80, domain.WebhookItem{Name: "t-shirt", Quantity: 1})).Return(nil)
+			},
+		},
 		{
 			name:     "good not found",
 			userId:   1,
@@ -71,6 +304,7 @@ func TestPurchaseCase_BuyItem(t *testing.T) {
 			prepareFn: func(t *testing.T, d *deps) {
 				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "t-shirt").
 					Return(domain.GoodInfo{Id: 10, Name: "t-shirt", Price: 80}, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 10).Return(nil, nil)
 				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).
 					DoAndReturn(executeTxFn)
 				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 999).
@@ -85,6 +319,7 @@ func TestPurchaseCase_BuyItem(t *testing.T) {
 			prepareFn: func(t *testing.T, d *deps) {
 				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "expensive-item").
 					Return(domain.GoodInfo{Id: 20, Name: "expensive-item", Price: 200}, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 20).Return(nil, nil)
 				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).
 					DoAndReturn(executeTxFn)
 				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).
@@ -92,6 +327,23 @@ func TestPurchaseCase_BuyItem(t *testing.T) {
 			},
 			expectedErr: &domain.InsufficientBalanceError{},
 		},
+		{
+			name:     "frozen account",
+			userId:   1,
+			goodName: "t-shirt",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "t-shirt").
+					Return(domain.GoodInfo{Id: 10, Name: "t-shirt", Price: 80}, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 10).Return(nil, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(executeTxFn)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).
+					Return(uint32(100), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).
+					Return(true, nil)
+			},
+			expectedErr: &domain.AccountFrozenError{},
+		},
 		{
 			name:     "process purchase error",
 			userId:   1,
@@ -99,10 +351,14 @@ func TestPurchaseCase_BuyItem(t *testing.T) {
 			prepareFn: func(t *testing.T, d *deps) {
 				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "t-shirt").
 					Return(domain.GoodInfo{Id: 10, Name: "t-shirt", Price: 80}, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 10).Return(nil, nil)
 				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).
 					DoAndReturn(executeTxFn)
 				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).
 					Return(uint32(100), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).
+					Return(false, nil)
+				d.purchaseLimitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{10}).Return(nil, nil)
 				d.purchaser.EXPECT().ProcessPurchase(gomock.Any(), nil, 1, domain.GoodInfo{Id: 10, Name: "t-shirt", Price: 80}).
 					Return(assert.AnError)
 			},
@@ -128,16 +384,428 @@ func TestPurchaseCase_BuyItem(t *testing.T) {
 			defer ctrl.Finish()
 
 			d := &deps{
-				goodsRepository: storemocks.NewMockGoodsRepository(ctrl),
-				balanceLocker:   storemocks.NewMockUserBalanceLocker(ctrl),
-				purchaser:       storemocks.NewMockPurchaser(ctrl),
-				txManager:       dbmocks.NewMockTxManager(ctrl),
+				goodsRepository:      storemocks.NewMockGoodsRepository(ctrl),
+				balanceLocker:        storemocks.NewMockUserBalanceLocker(ctrl),
+				balanceStatusChecker: storemocks.NewMockBalanceStatusChecker(ctrl),
+				purchaser:            storemocks.NewMockPurchaser(ctrl),
+				txManager:            dbmocks.NewMockTxManager(ctrl),
+				variantsRepository:   storemocks.NewMockVariantsRepository(ctrl),
+				stockKeeper:          storemocks.NewMockStockKeeper(ctrl),
+				bundlesRepository:    storemocks.NewMockBundlesRepository(ctrl),
+				purchaseLimitChecker: storemocks.NewMockPurchaseLimitChecker(ctrl),
+				webhookPublisher:     storemocks.NewMockWebhookPublisher(ctrl),
+				eventOutbox:          storemocks.NewMockEventOutbox(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			purchaseCase := NewPurchaseCase(d.goodsRepository, d.balanceLocker, d.balanceStatusChecker, d.purchaser, d.txManager,
+				storemocks.NewMockUserIDFetcher(ctrl), storemocks.NewMockBalanceEnsurer(ctrl),
+				storemocks.NewMockPromoCodesRepository(ctrl), storemocks.NewMockPromoRedeemer(ctrl), d.variantsRepository,
+				d.stockKeeper, d.bundlesRepository, d.purchaseLimitChecker, d.webhookPublisher, d.eventOutbox)
+			err := purchaseCase.BuyItem(t.Context(), tt.userId, tt.goodName, tt.variant, "")
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPurchaseCase_BuyItemWithPromoCode(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		goodsRepository      *storemocks.MockGoodsRepository
+		balanceLocker        *storemocks.MockUserBalanceLocker
+		balanceStatusChecker *storemocks.MockBalanceStatusChecker
+		purchaser            *storemocks.MockPurchaser
+		txManager            *dbmocks.MockTxManager
+		promoCodesRepository *storemocks.MockPromoCodesRepository
+		promoRedeemer        *storemocks.MockPromoRedeemer
+		variantsRepository   *storemocks.MockVariantsRepository
+		purchaseLimitChecker *storemocks.MockPurchaseLimitChecker
+		webhookPublisher     *storemocks.MockWebhookPublisher
+		eventOutbox          *storemocks.MockEventOutbox
+	}
+
+	type testCase struct {
+		name string
+
+		prepareFn func(t *testing.T, d *deps)
+
+		expectedErr error
+	}
+
+	executeTxFn := func(ctx context.Context, txFn database.TxFunc) error {
+		return txFn(ctx, nil)
+	}
+
+	tshirt := domain.GoodInfo{Id: 10, Name: "t-shirt", Price: 80}
+	discounted := domain.GoodInfo{Id: 10, Name: "t-shirt", Price: 60}
+	now := time.Now()
+	promo := domain.PromoCode{
+		Id:             3,
+		Code:           "SPRING25",
+		Kind:           domain.PromoKindPercent,
+		Value:          25,
+		MaxUsesPerUser: 1,
+		ValidFrom:      now.Add(-time.Hour),
+		ValidUntil:     now.Add(time.Hour),
+	}
+
+	tests := []testCase{
+		{
+			name: "discounted purchase",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "t-shirt").Return(tshirt, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 10).Return(nil, nil)
+				d.promoCodesRepository.EXPECT().GetPromoCode(gomock.Any(), "SPRING25").Return(promo, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).Return(uint32(60), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).Return(false, nil)
+				d.promoRedeemer.EXPECT().LockAndGetPromoCode(gomock.Any(), nil, "SPRING25").Return(promo, nil)
+				d.promoRedeemer.EXPECT().CountUserRedemptions(gomock.Any(), nil, 3, 1).Return(uint32(0), nil)
+				d.promoRedeemer.EXPECT().RecordRedemption(gomock.Any(), nil, 3, 1, 10, uint32(20)).Return(nil)
+				d.purchaseLimitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{10}).Return(nil, nil)
+				d.purchaser.EXPECT().ProcessPurchase(gomock.Any(), nil, 1, discounted).Return(nil)
+				d.webhookPublisher.EXPECT().PublishWebhook(gomock.Any(), nil,
+					domain.PurchaseWebhook(1, 60, domain.WebhookItem{Name: "t-shirt", Quantity: 1})).Return(nil)
+				d.eventOutbox.EXPECT().AppendEvent(gomock.Any(), nil,
+					domain.ItemPurchasedEvent(1, 1, 60, domain.WebhookItem{Name: "t-shirt", Quantity: 1})).Return(nil)
+			},
+		},
+		{
+			name: "unknown code",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "t-shirt").Return(tshirt, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 10).Return(nil, nil)
+				d.promoCodesRepository.EXPECT().GetPromoCode(gomock.Any(), "SPRING25").
+					Return(domain.PromoCode{}, &domain.PromoCodeNotFoundError{})
+			},
+			expectedErr: &domain.PromoCodeNotFoundError{},
+		},
+		{
+			name: "code for another item",
+			prepareFn: func(t *testing.T, d *deps) {
+				otherItem := promo
+				otherItem.GoodID = 11
+
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "t-shirt").Return(tshirt, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 10).Return(nil, nil)
+				d.promoCodesRepository.EXPECT().GetPromoCode(gomock.Any(), "SPRING25").Return(otherItem, nil)
+			},
+			expectedErr: &domain.PromoCodeUnusableError{},
+		},
+		{
+			name: "used up by the user",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "t-shirt").Return(tshirt, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 10).Return(nil, nil)
+				d.promoCodesRepository.EXPECT().GetPromoCode(gomock.Any(), "SPRING25").Return(promo, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).Return(uint32(100), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).Return(false, nil)
+				d.promoRedeemer.EXPECT().LockAndGetPromoCode(gomock.Any(), nil, "SPRING25").Return(promo, nil)
+				d.promoRedeemer.EXPECT().CountUserRedemptions(gomock.Any(), nil, 3, 1).Return(uint32(1), nil)
+			},
+			expectedErr: &domain.PromoCodeUnusableError{},
+		},
+		{
+			name: "used up while waiting for the lock",
+			prepareFn: func(t *testing.T, d *deps) {
+				usedUp := promo
+				usedUp.MaxUses = 10
+				usedUp.Uses = 10
+
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "t-shirt").Return(tshirt, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 10).Return(nil, nil)
+				d.promoCodesRepository.EXPECT().GetPromoCode(gomock.Any(), "SPRING25").Return(promo, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).Return(uint32(100), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).Return(false, nil)
+				d.promoRedeemer.EXPECT().LockAndGetPromoCode(gomock.Any(), nil, "SPRING25").Return(usedUp, nil)
+			},
+			expectedErr: &domain.PromoCodeUnusableError{},
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := &deps{
+				goodsRepository:      storemocks.NewMockGoodsRepository(ctrl),
+				balanceLocker:        storemocks.NewMockUserBalanceLocker(ctrl),
+				balanceStatusChecker: storemocks.NewMockBalanceStatusChecker(ctrl),
+				purchaser:            storemocks.NewMockPurchaser(ctrl),
+				txManager:            dbmocks.NewMockTxManager(ctrl),
+				promoCodesRepository: storemocks.NewMockPromoCodesRepository(ctrl),
+				promoRedeemer:        storemocks.NewMockPromoRedeemer(ctrl),
+				variantsRepository:   storemocks.NewMockVariantsRepository(ctrl),
+				purchaseLimitChecker: storemocks.NewMockPurchaseLimitChecker(ctrl),
+				webhookPublisher:     storemocks.NewMockWebhookPublisher(ctrl),
+				eventOutbox:          storemocks.NewMockEventOutbox(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			purchaseCase := NewPurchaseCase(d.goodsRepository, d.balanceLocker, d.balanceStatusChecker, d.purchaser,
+				d.txManager, storemocks.NewMockUserIDFetcher(ctrl), storemocks.NewMockBalanceEnsurer(ctrl),
+				d.promoCodesRepository, d.promoRedeemer, d.variantsRepository, storemocks.NewMockStockKeeper(ctrl),
+				storemocks.NewMockBundlesRepository(ctrl), d.purchaseLimitChecker, d.webhookPublisher, d.eventOutbox)
+			err := purchaseCase.BuyItem(t.Context(), 1, "t-shirt", "", " spring25 ")
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPurchaseCase_GiftItem(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		goodsRepository      *storemocks.MockGoodsRepository
+		balanceLocker        *storemocks.MockUserBalanceLocker
+		balanceStatusChecker *storemocks.MockBalanceStatusChecker
+		purchaser            *storemocks.MockPurchaser
+		txManager            *dbmocks.MockTxManager
+		userIDFetcher        *storemocks.MockUserIDFetcher
+		balanceCreator       *storemocks.MockBalanceEnsurer
+		purchaseLimitChecker *storemocks.MockPurchaseLimitChecker
+		variantsRepository   *storemocks.MockVariantsRepository
+		stockKeeper          *storemocks.MockStockKeeper
+		webhookPublisher     *storemocks.MockWebhookPublisher
+		eventOutbox          *storemocks.MockEventOutbox
+	}
+
+	type testCase struct {
+		name       string
+		variantSKU string
+		recipient  string
+		message    string
+
+		prepareFn func(t *testing.T, d *deps)
+
+		expectedErr error
+	}
+
+	executeTxFn := func(ctx context.Context, txFn database.TxFunc) error {
+		return txFn(ctx, nil)
+	}
+
+	tshirt := domain.GoodInfo{Id: 10, Name: "t-shirt", Price: 80}
+	variants := []domain.Variant{{Id: 4, GoodID: 10, SKU: "t-shirt-m", PriceDelta: 10}}
+
+	tests := []testCase{
+		{
+			name:      "successful gift",
+			recipient: "colleague",
+			message:   "happy birthday",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "colleague").Return(2, nil)
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "t-shirt").Return(tshirt, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 10).Return([]domain.Variant{}, nil)
+				d.balanceCreator.EXPECT().EnsureBalanceCreated(gomock.Any(), 2, domain.StartBalance).Return(nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).Return(uint32(100), nil)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 2).Return(uint32(100), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).Return(false, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 2).Return(true, nil)
+				d.purchaseLimitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{10}).Return(nil, nil)
+				d.purchaser.EXPECT().ProcessGift(gomock.Any(), nil, 1, 2, tshirt, "happy birthday").Return(nil)
+				d.webhookPublisher.EXPECT().PublishWebhook(gomock.Any(), nil, domain.GiftWebhook(1, 2, 80, "happy birthday",
+					domain.WebhookItem{Name: "t-shirt", Quantity: 1})).Return(nil)
+				d.eventOutbox.EXPECT().AppendEvent(gomock.Any(), nil,
+					domain.ItemPurchasedEvent(1, 2, 80, domain.WebhookItem{Name: "t-shirt", Quantity: 1})).Return(nil)
+			},
+		},
+		{
+			name:       "variant gift",
+			variantSKU: "t-shirt-m",
+			recipient:  "colleague",
+			prepareFn: func(t *testing.T, d *deps) {
+				gifted := domain.GoodInfo{Id: 10, Name: "t-shirt", Price: 90, VariantID: 4, Variant: "t-shirt-m"}
+				item := domain.WebhookItem{Name: "t-shirt", Variant: "t-shirt-m", Quantity: 1}
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "colleague").Return(2, nil)
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "t-shirt").Return(tshirt, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 10).Return(variants, nil)
+				d.balanceCreator.EXPECT().EnsureBalanceCreated(gomock.Any(), 2, domain.StartBalance).Return(nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).Return(uint32(100), nil)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 2).Return(uint32(100), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).Return(false, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 2).Return(true, nil)
+				d.purchaseLimitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{10}).Return(nil, nil)
+				d.stockKeeper.EXPECT().TakeFromStock(gomock.Any(), nil, 4, uint32(1)).Return(nil)
+				d.purchaser.EXPECT().ProcessGift(gomock.Any(), nil, 1, 2, gifted, "").Return(nil)
+				d.webhookPublisher.EXPECT().PublishWebhook(gomock.Any(), nil, domain.GiftWebhook(1, 2, 90, "", item)).Return(nil)
+				d.eventOutbox.EXPECT().AppendEvent(gomock.Any(), nil, domain.ItemPurchasedEvent(1, 2, 90, item)).Return(nil)
+			},
+		},
+		{
+			name:       "variant sold out",
+			variantSKU: "t-shirt-m",
+			recipient:  "colleague",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "colleague").Return(2, nil)
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "t-shirt").Return(tshirt, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 10).Return(variants, nil)
+				d.balanceCreator.EXPECT().EnsureBalanceCreated(gomock.Any(), 2, domain.StartBalance).Return(nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).Return(uint32(100), nil)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 2).Return(uint32(100), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).Return(false, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 2).Return(true, nil)
+				d.purchaseLimitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{10}).Return(nil, nil)
+				d.stockKeeper.EXPECT().TakeFromStock(gomock.Any(), nil, 4, uint32(1)).Return(&domain.OutOfStockError{})
+			},
+			expectedErr: &domain.OutOfStockError{},
+		},
+		{
+			name:      "variant not picked",
+			recipient: "colleague",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "colleague").Return(2, nil)
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "t-shirt").Return(tshirt, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 10).Return(variants, nil)
+			},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:      "insufficient balance",
+			recipient: "colleague",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "colleague").Return(2, nil)
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "t-shirt").Return(tshirt, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 10).Return([]domain.Variant{}, nil)
+				d.balanceCreator.EXPECT().EnsureBalanceCreated(gomock.Any(), 2, domain.StartBalance).Return(nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).Return(uint32(50), nil)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 2).Return(uint32(100), nil)
+			},
+			expectedErr: &domain.InsufficientBalanceError{},
+		},
+		{
+			name:      "recipient reached the limit",
+			recipient: "colleague",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "colleague").Return(2, nil)
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "t-shirt").Return(tshirt, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 10).Return([]domain.Variant{}, nil)
+				d.balanceCreator.EXPECT().EnsureBalanceCreated(gomock.Any(), 2, domain.StartBalance).Return(nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).Return(uint32(100), nil)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 2).Return(uint32(100), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).Return(false, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 2).Return(true, nil)
+				d.purchaseLimitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{10}).
+					Return([]domain.PurchaseLimit{{GoodID: 10, GoodName: "t-shirt", MaxQuantity: 1}}, nil)
+				d.purchaseLimitChecker.EXPECT().CountUserAcquisitions(gomock.Any(), nil, 2, 10, time.Time{}).
+					Return(uint32(1), nil)
+			},
+			expectedErr: &domain.PurchaseLimitError{},
+		},
+		{
+			name:      "bundle",
+			recipient: "colleague",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "colleague").Return(2, nil)
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "t-shirt").
+					Return(domain.GoodInfo{Id: 30, Name: "t-shirt", Price: 90, Bundle: true}, nil)
+			},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:      "upcoming good",
+			recipient: "colleague",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "colleague").Return(2, nil)
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "t-shirt").
+					Return(domain.GoodInfo{Id: 10, Name: "t-shirt", Price: 80, Upcoming: true}, nil)
+			},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:      "deactivated recipient",
+			recipient: "leaver",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "leaver").Return(2, nil)
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "t-shirt").Return(tshirt, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 10).Return([]domain.Variant{}, nil)
+				d.balanceCreator.EXPECT().EnsureBalanceCreated(gomock.Any(), 2, domain.StartBalance).Return(nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).Return(uint32(100), nil)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 2).Return(uint32(100), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).Return(false, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 2).Return(false, nil)
+			},
+			expectedErr: &domain.UserDeactivatedError{},
+		},
+		{
+			name:      "recipient not found",
+			recipient: "ghost",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "ghost").Return(0, assert.AnError)
+			},
+			expectedErr: &domain.UserNotFoundError{},
+		},
+		{
+			name:      "gift to self",
+			recipient: "me",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "me").Return(1, nil)
+			},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:        "message too long",
+			recipient:   "colleague",
+			message:     strings.Repeat("a", domain.MaxGiftMessageLength+1),
+			prepareFn:   func(t *testing.T, d *deps) {},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := &deps{
+				goodsRepository:      storemocks.NewMockGoodsRepository(ctrl),
+				balanceLocker:        storemocks.NewMockUserBalanceLocker(ctrl),
+				balanceStatusChecker: storemocks.NewMockBalanceStatusChecker(ctrl),
+				purchaser:            storemocks.NewMockPurchaser(ctrl),
+				txManager:            dbmocks.NewMockTxManager(ctrl),
+				userIDFetcher:        storemocks.NewMockUserIDFetcher(ctrl),
+				balanceCreator:       storemocks.NewMockBalanceEnsurer(ctrl),
+				purchaseLimitChecker: storemocks.NewMockPurchaseLimitChecker(ctrl),
+				variantsRepository:   storemocks.NewMockVariantsRepository(ctrl),
+				stockKeeper:          storemocks.NewMockStockKeeper(ctrl),
+				webhookPublisher:     storemocks.NewMockWebhookPublisher(ctrl),
+				eventOutbox:          storemocks.NewMockEventOutbox(ctrl),
 			}
 
 			tt.prepareFn(t, d)
 
-			purchaseCase := NewPurchaseCase(d.goodsRepository, d.balanceLocker, d.purchaser, d.txManager)
-			err := purchaseCase.BuyItem(t.Context(), tt.userId, tt.goodName)
+			purchaseCase := NewPurchaseCase(d.goodsRepository, d.balanceLocker, d.balanceStatusChecker, d.purchaser,
+				d.txManager, d.userIDFetcher, d.balanceCreator, storemocks.NewMockPromoCodesRepository(ctrl),
+				storemocks.NewMockPromoRedeemer(ctrl), d.variantsRepository, d.stockKeeper,
+				storemocks.NewMockBundlesRepository(ctrl), d.purchaseLimitChecker,
+				d.webhookPublisher, d.eventOutbox)
+			err := purchaseCase.GiftItem(t.Context(), 1, "t-shirt", tt.variantSKU, tt.recipient, tt.message)
 
 			if tt.expectedErr != nil {
 				assert.ErrorIs(t, err, tt.expectedErr)
@@ -147,3 +815,16 @@ func TestPurchaseCase_BuyItem(t *testing.T) {
 		})
 	}
 }
+
+// newTestPurchaseCase builds the PurchaseCase other cases charge their buyers through. It takes the mocks of the
+// shared purchase flow; the other dependencies are mocks expecting no calls.
+func newTestPurchaseCase(ctrl *gomock.Controller, txManager database.TxManager, balanceLocker domain.UserBalanceLocker,
+	balanceStatusChecker domain.BalanceStatusChecker, variantsRepository domain.VariantsRepository,
+	purchaseLimitChecker domain.PurchaseLimitChecker) *PurchaseCase {
+	return NewPurchaseCase(storemocks.NewMockGoodsRepository(ctrl), balanceLocker, balanceStatusChecker,
+		storemocks.NewMockPurchaser(ctrl), txManager, storemocks.NewMockUserIDFetcher(ctrl),
+		storemocks.NewMockBalanceEnsurer(ctrl), storemocks.NewMockPromoCodesRepository(ctrl),
+		storemocks.NewMockPromoRedeemer(ctrl), variantsRepository, storemocks.NewMockStockKeeper(ctrl),
+		storemocks.NewMockBundlesRepository(ctrl), purchaseLimitChecker, storemocks.NewMockWebhookPublisher(ctrl),
+		storemocks.NewMockEventOutbox(ctrl))
+}
@@ -0,0 +1,301 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/audit"
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/pkg/worker"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+)
+
+type RafflesCase struct {
+	txManager          database.TxManager
+	goodsRepository    domain.GoodsRepository
+	variantsRepository domain.VariantsRepository
+	rafflesRepository  domain.RafflesRepository
+	ticketsProceeder   domain.RaffleTicketsProceeder
+	drawer             domain.RaffleDrawer
+	purchaseCase       *PurchaseCase
+	webhookPublisher   domain.WebhookPublisher
+	eventOutbox        domain.EventOutbox
+	auditRecorder      audit.Recorder
+}
+
+func NewRafflesCase(txManager database.TxManager,
+	goodsRepository domain.GoodsRepository,
+	variantsRepository domain.VariantsRepository,
+	rafflesRepository domain.RafflesRepository,
+	ticketsProceeder domain.RaffleTicketsProceeder,
+	drawer domain.RaffleDrawer,
+	purchaseCase *PurchaseCase,
+	webhookPublisher domain.WebhookPublisher,
+	eventOutbox domain.EventOutbox,
+	auditRecorder audit.Recorder) *RafflesCase {
+	return &RafflesCase{
+		txManager:          txManager,
+		goodsRepository:    goodsRepository,
+		variantsRepository: variantsRepository,
+		rafflesRepository:  rafflesRepository,
+		ticketsProceeder:   ticketsProceeder,
+		drawer:             drawer,
+		purchaseCase:       purchaseCase,
+		webhookPublisher:   webhookPublisher,
+		eventOutbox:        eventOutbox,
+		auditRecorder:      auditRecorder,
+	}
+}
+
+// CreateRaffle raffles one unit of a good off at drawAt. Tickets are on sale until then.
+func (rc *RafflesCase) CreateRaffle(ctx context.Context, goodName string, ticketPrice uint32, drawAt time.Time) (int, error) {
+	if ticketPrice == 0 {
+		return 0, &domain.InvalidArgumentsError{Msg: "ticket price must be positive"}
+	}
+
+	now := time.Now()
+	if !drawAt.After(now) {
+		return 0, &domain.InvalidArgumentsError{Msg: "draw time must be in the future"}
+	} else if drawAt.Sub(now) > domain.MaxRaffleDuration {
+		return 0, &domain.InvalidArgumentsError{Msg: "draw must not be later than 30 days from now"}
+	}
+
+	goodInfo, err := rc.goodsRepository.GetGoodInfo(ctx, goodName)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get good info: %w", err)
+	}
+
+	if goodInfo.Bundle {
+		return 0, &domain.InvalidArgumentsError{Msg: "bundles can't be raffled"}
+	} else if goodInfo.Upcoming {
+		return 0, &domain.InvalidArgumentsError{Msg: "item hasn't arrived yet"}
+	}
+
+	variants, err := rc.variantsRepository.ListGoodVariants(ctx, goodInfo.Id)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list variants: %w", err)
+	}
+
+	// The prize is a unit without a variant, so goods only sold as one of their variants are kept off.
+	if len(variants) > 0 {
+		return 0, &domain.InvalidArgumentsError{Msg: "items with variants can't be raffled"}
+	}
+
+	var raffleID int
+	err = rc.txManager.WithinTransaction(ctx, func(ctx context.Context, executor database.QueryExecuter) error {
+		raffleID, err = rc.rafflesRepository.CreateRaffle(ctx, executor, domain.Raffle{
+			GoodID:      goodInfo.Id,
+			TicketPrice: ticketPrice,
+			DrawAt:      drawAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = rc.auditRecorder.RecordInTx(ctx, executor, audit.Event{
+			Action: audit.ActionRaffleCreate,
+			Target: audit.RaffleTarget(raffleID),
+			After: map[string]any{
+				"item":        goodName,
+				"ticketPrice": ticketPrice,
+				"drawAt":      drawAt.UTC().Format(time.RFC3339),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record audit event: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return raffleID, nil
+}
+
+func (rc *RafflesCase) ListRaffles(ctx context.Context) ([]domain.Raffle, error) {
+	return rc.rafflesRepository.ListOpenRaffles(ctx)
+}
+
+// BuyTickets sells count tickets of a raffle to the user. The payment goes through the same balance checks
+// as a store purchase, and the raffle is locked so no tickets are sold once it is being drawn. Users who can't
+// get another unit of the good under its purchase limit can't buy tickets.
+func (rc *RafflesCase) BuyTickets(ctx context.Context, userID, raffleID int, count uint32) error {
+	if count == 0 {
+		return &domain.InvalidArgumentsError{Msg: "ticket count must be positive"}
+	} else if count > domain.MaxRaffleTicketsPerPurchase {
+		return &domain.InvalidArgumentsError{Msg: fmt.Sprintf("at most %d tickets can be bought at once", domain.MaxRaffleTicketsPerPurchase)}
+	}
+
+	raffle, err := rc.rafflesRepository.GetRaffle(ctx, raffleID)
+	if err != nil {
+		return err
+	}
+
+	if !raffle.IsSellingTickets(time.Now()) {
+		return &domain.RaffleClosedError{Msg: fmt.Sprintf("raffle %d is not selling tickets", raffleID)}
+	}
+
+	totalPrice := uint64(raffle.TicketPrice) * uint64(count)
+	if totalPrice > math.MaxUint32 {
+		return &domain.InsufficientBalanceError{Msg: "insufficient balance"}
+	}
+
+	return rc.purchaseCase.purchase(ctx, userID, uint32(totalPrice), func(ctx context.Context, executor database.QueryExecuter) error {
+		raffle, err := rc.ticketsProceeder.LockAndGetRaffle(ctx, executor, raffleID)
+		if err != nil {
+			return err
+		}
+
+		if !raffle.IsSellingTickets(time.Now()) {
+			return &domain.RaffleClosedError{Msg: fmt.Sprintf("raffle %d is not selling tickets", raffleID)}
+		}
+
+		err = checkPurchaseLimits(ctx, rc.purchaseCase.purchaseLimitChecker, executor, userID,
+			map[int]uint32{raffle.GoodID: 1})
+		if err != nil {
+			return err
+		}
+
+		err = rc.ticketsProceeder.IssueTickets(ctx, executor, raffleID, userID, count, uint32(totalPrice))
+		if err != nil {
+			return fmt.Errorf("failed to issue tickets: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// DrawDueRaffles draws every raffle whose draw time has come and returns how many items were won.
+func (rc *RafflesCase) DrawDueRaffles(ctx context.Context) (int, error) {
+	return worker.ProcessDue(ctx, "raffle", func(ctx context.Context) ([]int, error) {
+		return rc.drawer.FetchDueRaffles(ctx, time.Now(), domain.RafflesDrawBatch)
+	}, rc.drawRaffle)
+}
+
+// drawRaffle gives the item to the owner of a ticket picked with a fresh random seed. The seed is stored
+// with the raffle and in the audit log. A raffle left without tickets is voided.
+func (rc *RafflesCase) drawRaffle(ctx context.Context, raffleID int) (bool, error) {
+	drawn := false
+
+	err := rc.txManager.WithinTransaction(ctx, func(ctx context.Context, executor database.QueryExecuter) error {
+		raffle, err := rc.ticketsProceeder.LockAndGetRaffle(ctx, executor, raffleID)
+		if err != nil {
+			return err
+		}
+
+		// The raffle could have been drawn by another store instance since it was fetched.
+		if raffle.Status != domain.RaffleStatusOpen || time.Now().Before(raffle.DrawAt) {
+			return nil
+		}
+
+		tickets, err := rc.drawer.FetchTickets(ctx, executor, raffleID)
+		if err != nil {
+			return fmt.Errorf("failed to fetch tickets: %w", err)
+		}
+
+		seed, err := domain.NewRaffleSeed()
+		if err != nil {
+			return err
+		}
+
+		draw, tickets, err := rc.pickWinner(ctx, executor, raffle, seed, tickets)
+		if err != nil {
+			return err
+		}
+
+		if len(tickets) == 0 {
+			return rc.drawer.CloseVoidRaffle(ctx, executor, raffleID)
+		}
+
+		err = rc.drawer.CompleteDraw(ctx, executor, raffle, draw)
+		if err != nil {
+			return fmt.Errorf("failed to complete draw: %w", err)
+		}
+
+		// The prize is reported like a store purchase paid with the winner's tickets.
+		spent := raffle.SpentBy(tickets, draw.WinnerID)
+		err = rc.webhookPublisher.PublishWebhook(ctx, executor, domain.RafflePurchaseWebhook(raffle, draw.WinnerID, spent))
+		if err != nil {
+			return err
+		}
+
+		err = rc.eventOutbox.AppendEvent(ctx, executor, domain.ItemPurchasedEvent(draw.WinnerID, draw.WinnerID, spent,
+			domain.WebhookItem{Name: raffle.GoodName, Quantity: 1}))
+		if err != nil {
+			return err
+		}
+
+		err = rc.auditRecorder.RecordInTx(ctx, executor, audit.Event{
+			Action: audit.ActionRaffleDraw,
+			Target: audit.RaffleTarget(raffleID),
+			After: map[string]any{
+				"seed":          draw.Seed,
+				"tickets":       len(tickets),
+				"winningTicket": draw.TicketID,
+				"winnerId":      draw.WinnerID,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record audit event: %w", err)
+		}
+
+		drawn = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return drawn, nil
+}
+
+// pickWinner draws the winning ticket with the seed. A winner who got units of the good up to its purchase limit
+// since buying the tickets gets them refunded, and the ticket is drawn again out of the tickets left, so the
+// winner can still be recomputed from the seed. It returns the tickets left, none if every owner was refunded.
+func (rc *RafflesCase) pickWinner(ctx context.Context, executor database.QueryExecuter, raffle domain.Raffle,
+	seed string, tickets []domain.RaffleTicket) (domain.RaffleDraw, []domain.RaffleTicket, error) {
+	for len(tickets) > 0 {
+		index, err := domain.DrawWinningTicket(seed, len(tickets))
+		if err != nil {
+			return domain.RaffleDraw{}, nil, err
+		}
+
+		draw := domain.RaffleDraw{
+			Seed:     seed,
+			TicketID: tickets[index].Id,
+			WinnerID: tickets[index].UserID,
+		}
+
+		err = checkPurchaseLimits(ctx, rc.purchaseCase.purchaseLimitChecker, executor, draw.WinnerID,
+			map[int]uint32{raffle.GoodID: 1})
+		if !errors.Is(err, &domain.PurchaseLimitError{}) {
+			return draw, tickets, err
+		}
+
+		err = rc.drawer.RefundTickets(ctx, executor, raffle, draw.WinnerID)
+		if err != nil {
+			return domain.RaffleDraw{}, nil, fmt.Errorf("failed to refund tickets: %w", err)
+		}
+
+		err = rc.auditRecorder.RecordInTx(ctx, executor, audit.RefundEvent(audit.RaffleTarget(raffle.Id), draw.WinnerID,
+			raffle.SpentBy(tickets, draw.WinnerID), audit.RefundReasonPurchaseLimit))
+		if err != nil {
+			return domain.RaffleDraw{}, nil, fmt.Errorf("failed to record audit event: %w", err)
+		}
+
+		left := make([]domain.RaffleTicket, 0, len(tickets))
+		for _, ticket := range tickets {
+			if ticket.UserID != draw.WinnerID {
+				left = append(left, ticket)
+			}
+		}
+		tickets = left
+	}
+
+	return domain.RaffleDraw{}, tickets, nil
+}
@@ -0,0 +1,547 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	auditmocks "github.com/Lexv0lk/merch-store/gen/mocks/audit"
+	dbmocks "github.com/Lexv0lk/merch-store/gen/mocks/database"
+	storemocks "github.com/Lexv0lk/merch-store/gen/mocks/store"
+	"github.com/Lexv0lk/merch-store/internal/pkg/audit"
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRafflesCase_CreateRaffle(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		txManager          *dbmocks.MockTxManager
+		goodsRepository    *storemocks.MockGoodsRepository
+		variantsRepository *storemocks.MockVariantsRepository
+		rafflesRepository  *storemocks.MockRafflesRepository
+		auditRecorder      *auditmocks.MockRecorder
+	}
+
+	type testCase struct {
+		name        string
+		ticketPrice uint32
+		drawAt      time.Time
+
+		prepareFn func(t *testing.T, d *deps)
+
+		expectedID  int
+		expectedErr error
+	}
+
+	executeTxFn := func(ctx context.Context, txFn database.TxFunc) error {
+		return txFn(ctx, nil)
+	}
+
+	cup := domain.GoodInfo{Id: 10, Name: "cup", Price: 20}
+
+	tests := []testCase{
+		{
+			name:        "raffle created",
+			ticketPrice: 5,
+			drawAt:      time.Now().Add(24 * time.Hour),
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "cup").Return(cup, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 10).Return([]domain.Variant{}, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.rafflesRepository.EXPECT().CreateRaffle(gomock.Any(), nil, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ database.Querier, raffle domain.Raffle) (int, error) {
+						assert.Equal(t, 10, raffle.GoodID)
+						assert.Equal(t, uint32(5), raffle.TicketPrice)
+						return 3, nil
+					})
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ database.Executor, event audit.Event) error {
+						assert.Equal(t, audit.ActionRaffleCreate, event.Action)
+						assert.Equal(t, "raffle:3", event.Target)
+						return nil
+					})
+			},
+			expectedID: 3,
+		},
+		{
+			name:        "zero ticket price",
+			ticketPrice: 0,
+			drawAt:      time.Now().Add(24 * time.Hour),
+			prepareFn:   func(t *testing.T, d *deps) {},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:        "draw time in the past",
+			ticketPrice: 5,
+			drawAt:      time.Now().Add(-time.Hour),
+			prepareFn:   func(t *testing.T, d *deps) {},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:        "draw too far ahead",
+			ticketPrice: 5,
+			drawAt:      time.Now().Add(domain.MaxRaffleDuration + time.Hour),
+			prepareFn:   func(t *testing.T, d *deps) {},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:        "unknown good",
+			ticketPrice: 5,
+			drawAt:      time.Now().Add(24 * time.Hour),
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "cup").Return(domain.GoodInfo{}, &domain.GoodNotFoundError{})
+			},
+			expectedErr: &domain.GoodNotFoundError{},
+		},
+		{
+			name:        "upcoming good",
+			ticketPrice: 5,
+			drawAt:      time.Now().Add(24 * time.Hour),
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "cup").
+					Return(domain.GoodInfo{Id: 10, Name: "cup", Price: 20, Upcoming: true}, nil)
+			},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:        "good with variants",
+			ticketPrice: 5,
+			drawAt:      time.Now().Add(24 * time.Hour),
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "cup").Return(cup, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 10).
+					Return([]domain.Variant{{Id: 4, GoodID: 10, SKU: "cup-l"}}, nil)
+			},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:        "audit failure",
+			ticketPrice: 5,
+			drawAt:      time.Now().Add(24 * time.Hour),
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "cup").Return(cup, nil)
+				d.variantsRepository.EXPECT().ListGoodVariants(gomock.Any(), 10).Return([]domain.Variant{}, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.rafflesRepository.EXPECT().CreateRaffle(gomock.Any(), nil, gomock.Any()).Return(3, nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, gomock.Any()).Return(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := &deps{
+				txManager:          dbmocks.NewMockTxManager(ctrl),
+				goodsRepository:    storemocks.NewMockGoodsRepository(ctrl),
+				variantsRepository: storemocks.NewMockVariantsRepository(ctrl),
+				rafflesRepository:  storemocks.NewMockRafflesRepository(ctrl),
+				auditRecorder:      auditmocks.NewMockRecorder(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			purchaseCase := newTestPurchaseCase(ctrl, dbmocks.NewMockTxManager(ctrl),
+				storemocks.NewMockUserBalanceLocker(ctrl), storemocks.NewMockBalanceStatusChecker(ctrl),
+				storemocks.NewMockVariantsRepository(ctrl), storemocks.NewMockPurchaseLimitChecker(ctrl))
+
+			rafflesCase := NewRafflesCase(d.txManager, d.goodsRepository, d.variantsRepository, d.rafflesRepository,
+				storemocks.NewMockRaffleTicketsProceeder(ctrl), storemocks.NewMockRaffleDrawer(ctrl), purchaseCase,
+				storemocks.NewMockWebhookPublisher(ctrl), storemocks.NewMockEventOutbox(ctrl), d.auditRecorder)
+			raffleID, err := rafflesCase.CreateRaffle(t.Context(), "cup", tt.ticketPrice, tt.drawAt)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedID, raffleID)
+			}
+		})
+	}
+}
+
+func TestRafflesCase_BuyTickets(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		txManager            *dbmocks.MockTxManager
+		balanceLocker        *storemocks.MockUserBalanceLocker
+		balanceStatusChecker *storemocks.MockBalanceStatusChecker
+		rafflesRepository    *storemocks.MockRafflesRepository
+		ticketsProceeder     *storemocks.MockRaffleTicketsProceeder
+		purchaseLimitChecker *storemocks.MockPurchaseLimitChecker
+	}
+
+	type testCase struct {
+		name  string
+		count uint32
+
+		prepareFn func(t *testing.T, d *deps)
+
+		expectedErr error
+	}
+
+	executeTxFn := func(ctx context.Context, txFn database.TxFunc) error {
+		return txFn(ctx, nil)
+	}
+
+	open := domain.Raffle{
+		Id:          6,
+		GoodID:      10,
+		TicketPrice: 5,
+		Status:      domain.RaffleStatusOpen,
+		DrawAt:      time.Now().Add(time.Hour),
+	}
+	drawn := open
+	drawn.Status = domain.RaffleStatusDrawn
+
+	tests := []testCase{
+		{
+			name:  "tickets bought",
+			count: 3,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.rafflesRepository.EXPECT().GetRaffle(gomock.Any(), 6).Return(open, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).Return(uint32(100), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).Return(false, nil)
+				d.ticketsProceeder.EXPECT().LockAndGetRaffle(gomock.Any(), nil, 6).Return(open, nil)
+				d.purchaseLimitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{10}).Return(nil, nil)
+				d.ticketsProceeder.EXPECT().IssueTickets(gomock.Any(), nil, 6, 1, uint32(3), uint32(15)).Return(nil)
+			},
+		},
+		{
+			name:        "zero tickets",
+			count:       0,
+			prepareFn:   func(t *testing.T, d *deps) {},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:        "too many tickets at once",
+			count:       domain.MaxRaffleTicketsPerPurchase + 1,
+			prepareFn:   func(t *testing.T, d *deps) {},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:  "raffle not found",
+			count: 1,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.rafflesRepository.EXPECT().GetRaffle(gomock.Any(), 6).Return(domain.Raffle{}, &domain.RaffleNotFoundError{})
+			},
+			expectedErr: &domain.RaffleNotFoundError{},
+		},
+		{
+			name:  "raffle already drawn",
+			count: 1,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.rafflesRepository.EXPECT().GetRaffle(gomock.Any(), 6).Return(drawn, nil)
+			},
+			expectedErr: &domain.RaffleClosedError{},
+		},
+		{
+			name:  "raffle drawn while buying",
+			count: 1,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.rafflesRepository.EXPECT().GetRaffle(gomock.Any(), 6).Return(open, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).Return(uint32(100), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).Return(false, nil)
+				d.ticketsProceeder.EXPECT().LockAndGetRaffle(gomock.Any(), nil, 6).Return(drawn, nil)
+			},
+			expectedErr: &domain.RaffleClosedError{},
+		},
+		{
+			name:  "purchase limit reached",
+			count: 1,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.rafflesRepository.EXPECT().GetRaffle(gomock.Any(), 6).Return(open, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).Return(uint32(100), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).Return(false, nil)
+				d.ticketsProceeder.EXPECT().LockAndGetRaffle(gomock.Any(), nil, 6).Return(open, nil)
+				d.purchaseLimitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{10}).
+					Return([]domain.PurchaseLimit{{GoodID: 10, GoodName: "cup", MaxQuantity: 1}}, nil)
+				d.purchaseLimitChecker.EXPECT().CountUserAcquisitions(gomock.Any(), nil, 1, 10, time.Time{}).
+					Return(uint32(1), nil)
+			},
+			expectedErr: &domain.PurchaseLimitError{},
+		},
+		{
+			name:  "insufficient balance",
+			count: 3,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.rafflesRepository.EXPECT().GetRaffle(gomock.Any(), 6).Return(open, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).Return(uint32(10), nil)
+			},
+			expectedErr: &domain.InsufficientBalanceError{},
+		},
+		{
+			name:  "buyer frozen",
+			count: 3,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.rafflesRepository.EXPECT().GetRaffle(gomock.Any(), 6).Return(open, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).Return(uint32(100), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).Return(true, nil)
+			},
+			expectedErr: &domain.AccountFrozenError{},
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := &deps{
+				txManager:            dbmocks.NewMockTxManager(ctrl),
+				balanceLocker:        storemocks.NewMockUserBalanceLocker(ctrl),
+				balanceStatusChecker: storemocks.NewMockBalanceStatusChecker(ctrl),
+				rafflesRepository:    storemocks.NewMockRafflesRepository(ctrl),
+				ticketsProceeder:     storemocks.NewMockRaffleTicketsProceeder(ctrl),
+				purchaseLimitChecker: storemocks.NewMockPurchaseLimitChecker(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			purchaseCase := newTestPurchaseCase(ctrl, d.txManager, d.balanceLocker, d.balanceStatusChecker,
+				storemocks.NewMockVariantsRepository(ctrl), d.purchaseLimitChecker)
+
+			rafflesCase := NewRafflesCase(d.txManager, storemocks.NewMockGoodsRepository(ctrl),
+				storemocks.NewMockVariantsRepository(ctrl), d.rafflesRepository, d.ticketsProceeder,
+				storemocks.NewMockRaffleDrawer(ctrl), purchaseCase, storemocks.NewMockWebhookPublisher(ctrl),
+				storemocks.NewMockEventOutbox(ctrl), auditmocks.NewMockRecorder(ctrl))
+			err := rafflesCase.BuyTickets(t.Context(), 1, 6, tt.count)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRafflesCase_DrawDueRaffles(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		txManager            *dbmocks.MockTxManager
+		ticketsProceeder     *storemocks.MockRaffleTicketsProceeder
+		drawer               *storemocks.MockRaffleDrawer
+		purchaseLimitChecker *storemocks.MockPurchaseLimitChecker
+		webhookPublisher     *storemocks.MockWebhookPublisher
+		eventOutbox          *storemocks.MockEventOutbox
+		auditRecorder        *auditmocks.MockRecorder
+	}
+
+	type testCase struct {
+		name string
+
+		prepareFn func(t *testing.T, d *deps)
+
+		expectedDrawn int
+		expectedErr   error
+	}
+
+	executeTxFn := func(ctx context.Context, txFn database.TxFunc) error {
+		return txFn(ctx, nil)
+	}
+
+	due := domain.Raffle{
+		Id:          6,
+		GoodID:      10,
+		GoodName:    "cup",
+		TicketPrice: 5,
+		Status:      domain.RaffleStatusOpen,
+		DrawAt:      time.Now().Add(-time.Minute),
+	}
+	tickets := []domain.RaffleTicket{{Id: 11, UserID: 2}, {Id: 12, UserID: 3}, {Id: 13, UserID: 2}}
+
+	tests := []testCase{
+		{
+			name: "winner drawn from the tickets",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.drawer.EXPECT().FetchDueRaffles(gomock.Any(), gomock.Any(), domain.RafflesDrawBatch).Return([]int{6}, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.ticketsProceeder.EXPECT().LockAndGetRaffle(gomock.Any(), nil, 6).Return(due, nil)
+				d.drawer.EXPECT().FetchTickets(gomock.Any(), nil, 6).Return(tickets, nil)
+				d.purchaseLimitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{10}).Return(nil, nil)
+
+				var completed domain.RaffleDraw
+				d.drawer.EXPECT().CompleteDraw(gomock.Any(), nil, due, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ database.Executor, _ domain.Raffle, draw domain.RaffleDraw) error {
+						index, err := domain.DrawWinningTicket(draw.Seed, len(tickets))
+						require.NoError(t, err)
+						assert.Equal(t, tickets[index].Id, draw.TicketID)
+						assert.Equal(t, tickets[index].UserID, draw.WinnerID)
+
+						completed = draw
+						return nil
+					})
+				d.webhookPublisher.EXPECT().PublishWebhook(gomock.Any(), nil, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ database.Executor, event domain.WebhookEvent) error {
+						assert.Equal(t, domain.RafflePurchaseWebhook(due, completed.WinnerID,
+							due.SpentBy(tickets, completed.WinnerID)), event)
+						return nil
+					})
+				d.eventOutbox.EXPECT().AppendEvent(gomock.Any(), nil, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ database.Executor, event domain.DomainEvent) error {
+						assert.Equal(t, domain.EventItemPurchased, event.Type)
+						assert.Equal(t, []int{completed.WinnerID}, event.UserIDs)
+						return nil
+					})
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ database.Executor, event audit.Event) error {
+						assert.Equal(t, audit.ActionRaffleDraw, event.Action)
+						assert.Equal(t, "raffle:6", event.Target)
+						assert.Equal(t, completed.Seed, event.After["seed"])
+						return nil
+					})
+			},
+			expectedDrawn: 1,
+		},
+		{
+			name: "winner over the purchase limit refunded and drawn again",
+			prepareFn: func(t *testing.T, d *deps) {
+				limits := []domain.PurchaseLimit{{GoodID: 10, GoodName: "cup", MaxQuantity: 1}}
+
+				d.drawer.EXPECT().FetchDueRaffles(gomock.Any(), gomock.Any(), domain.RafflesDrawBatch).Return([]int{6}, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.ticketsProceeder.EXPECT().LockAndGetRaffle(gomock.Any(), nil, 6).Return(due, nil)
+				d.drawer.EXPECT().FetchTickets(gomock.Any(), nil, 6).Return(tickets, nil)
+				d.purchaseLimitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{10}).Return(limits, nil).
+					MinTimes(1).MaxTimes(2)
+				d.purchaseLimitChecker.EXPECT().CountUserAcquisitions(gomock.Any(), nil, 2, 10, time.Time{}).
+					Return(uint32(1), nil).MaxTimes(1)
+				d.drawer.EXPECT().RefundTickets(gomock.Any(), nil, due, 2).Return(nil).MaxTimes(1)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil,
+					audit.RefundEvent("raffle:6", 2, 10, audit.RefundReasonPurchaseLimit)).Return(nil).MaxTimes(1)
+				d.purchaseLimitChecker.EXPECT().CountUserAcquisitions(gomock.Any(), nil, 3, 10, time.Time{}).
+					Return(uint32(0), nil)
+				d.drawer.EXPECT().CompleteDraw(gomock.Any(), nil, due, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ database.Executor, _ domain.Raffle, draw domain.RaffleDraw) error {
+						assert.Equal(t, domain.RaffleDraw{Seed: draw.Seed, TicketID: 12, WinnerID: 3}, draw)
+						return nil
+					})
+				d.webhookPublisher.EXPECT().PublishWebhook(gomock.Any(), nil, domain.RafflePurchaseWebhook(due, 3, 5)).
+					Return(nil)
+				d.eventOutbox.EXPECT().AppendEvent(gomock.Any(), nil, gomock.Any()).Return(nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, gomock.Any()).Return(nil)
+			},
+			expectedDrawn: 1,
+		},
+		{
+			name: "every owner over the purchase limit voids the raffle",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.drawer.EXPECT().FetchDueRaffles(gomock.Any(), gomock.Any(), domain.RafflesDrawBatch).Return([]int{6}, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.ticketsProceeder.EXPECT().LockAndGetRaffle(gomock.Any(), nil, 6).Return(due, nil)
+				d.drawer.EXPECT().FetchTickets(gomock.Any(), nil, 6).Return(tickets[:1], nil)
+				d.purchaseLimitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{10}).
+					Return([]domain.PurchaseLimit{{GoodID: 10, GoodName: "cup", MaxQuantity: 1}}, nil)
+				d.purchaseLimitChecker.EXPECT().CountUserAcquisitions(gomock.Any(), nil, 2, 10, time.Time{}).
+					Return(uint32(1), nil)
+				d.drawer.EXPECT().RefundTickets(gomock.Any(), nil, due, 2).Return(nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil,
+					audit.RefundEvent("raffle:6", 2, 5, audit.RefundReasonPurchaseLimit)).Return(nil)
+				d.drawer.EXPECT().CloseVoidRaffle(gomock.Any(), nil, 6).Return(nil)
+			},
+			expectedDrawn: 0,
+		},
+		{
+			name: "no tickets sold voids the raffle",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.drawer.EXPECT().FetchDueRaffles(gomock.Any(), gomock.Any(), domain.RafflesDrawBatch).Return([]int{6}, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.ticketsProceeder.EXPECT().LockAndGetRaffle(gomock.Any(), nil, 6).Return(due, nil)
+				d.drawer.EXPECT().FetchTickets(gomock.Any(), nil, 6).Return([]domain.RaffleTicket{}, nil)
+				d.drawer.EXPECT().CloseVoidRaffle(gomock.Any(), nil, 6).Return(nil)
+			},
+			expectedDrawn: 0,
+		},
+		{
+			name: "already drawn by another instance",
+			prepareFn: func(t *testing.T, d *deps) {
+				drawn := due
+				drawn.Status = domain.RaffleStatusDrawn
+
+				d.drawer.EXPECT().FetchDueRaffles(gomock.Any(), gomock.Any(), domain.RafflesDrawBatch).Return([]int{6}, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.ticketsProceeder.EXPECT().LockAndGetRaffle(gomock.Any(), nil, 6).Return(drawn, nil)
+			},
+			expectedDrawn: 0,
+		},
+		{
+			name: "failing raffle doesn't stop the others",
+			prepareFn: func(t *testing.T, d *deps) {
+				other := due
+				other.Id = 7
+
+				d.drawer.EXPECT().FetchDueRaffles(gomock.Any(), gomock.Any(), domain.RafflesDrawBatch).Return([]int{6, 7}, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn).Times(2)
+				d.ticketsProceeder.EXPECT().LockAndGetRaffle(gomock.Any(), nil, 6).Return(due, nil)
+				d.drawer.EXPECT().FetchTickets(gomock.Any(), nil, 6).Return(nil, assert.AnError)
+				d.ticketsProceeder.EXPECT().LockAndGetRaffle(gomock.Any(), nil, 7).Return(other, nil)
+				d.drawer.EXPECT().FetchTickets(gomock.Any(), nil, 7).Return(tickets[:1], nil)
+				d.purchaseLimitChecker.EXPECT().ListPurchaseLimits(gomock.Any(), nil, []int{10}).Return(nil, nil)
+				d.drawer.EXPECT().CompleteDraw(gomock.Any(), nil, other, gomock.Any()).Return(nil)
+				d.webhookPublisher.EXPECT().PublishWebhook(gomock.Any(), nil,
+					domain.RafflePurchaseWebhook(other, 2, 5)).Return(nil)
+				d.eventOutbox.EXPECT().AppendEvent(gomock.Any(), nil, gomock.Any()).Return(nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, gomock.Any()).Return(nil)
+			},
+			expectedDrawn: 1,
+			expectedErr:   assert.AnError,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := &deps{
+				txManager:            dbmocks.NewMockTxManager(ctrl),
+				ticketsProceeder:     storemocks.NewMockRaffleTicketsProceeder(ctrl),
+				drawer:               storemocks.NewMockRaffleDrawer(ctrl),
+				purchaseLimitChecker: storemocks.NewMockPurchaseLimitChecker(ctrl),
+				webhookPublisher:     storemocks.NewMockWebhookPublisher(ctrl),
+				eventOutbox:          storemocks.NewMockEventOutbox(ctrl),
+				auditRecorder:        auditmocks.NewMockRecorder(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			purchaseCase := newTestPurchaseCase(ctrl, d.txManager, storemocks.NewMockUserBalanceLocker(ctrl),
+				storemocks.NewMockBalanceStatusChecker(ctrl), storemocks.NewMockVariantsRepository(ctrl),
+				d.purchaseLimitChecker)
+
+			rafflesCase := NewRafflesCase(d.txManager, storemocks.NewMockGoodsRepository(ctrl),
+				storemocks.NewMockVariantsRepository(ctrl), storemocks.NewMockRafflesRepository(ctrl), d.ticketsProceeder,
+				d.drawer, purchaseCase, d.webhookPublisher, d.eventOutbox, d.auditRecorder)
+			drawn, err := rafflesCase.DrawDueRaffles(t.Context())
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedDrawn, drawn)
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/pkg/worker"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+)
+
+type ScheduledTransfersCase struct {
+	txManager      database.TxManager
+	userIDFetcher  domain.UserIDFetcher
+	usernameGetter domain.UsernameGetter
+	balanceCreator domain.BalanceEnsurer
+	transfersRepo  domain.ScheduledTransfersRepository
+	executor       domain.ScheduledTransfersExecutor
+	sendCoinsCase  *SendCoinsCase
+}
+
+func NewScheduledTransfersCase(txManager database.TxManager,
+	userIDFetcher domain.UserIDFetcher,
+	usernameGetter domain.UsernameGetter,
+	balanceCreator domain.BalanceEnsurer,
+	transfersRepo domain.ScheduledTransfersRepository,
+	executor domain.ScheduledTransfersExecutor,
+	sendCoinsCase *SendCoinsCase) *ScheduledTransfersCase {
+	return &ScheduledTransfersCase{
+		txManager:      txManager,
+		userIDFetcher:  userIDFetcher,
+		usernameGetter: usernameGetter,
+		balanceCreator: balanceCreator,
+		transfersRepo:  transfersRepo,
+		executor:       executor,
+		sendCoinsCase:  sendCoinsCase,
+	}
+}
+
+// ScheduleTransfer schedules a transfer of amount coins to toUsername at startAt, repeating it according to recurrence.
+// A zero startAt schedules the first run right away, an empty recurrence means a one-off transfer.
+func (sc *ScheduledTransfersCase) ScheduleTransfer(ctx context.Context, senderID int, toUsername string, amount uint32,
+	startAt time.Time, recurrence string) (int, error) {
+	if amount == 0 {
+		return 0, &domain.InvalidArgumentsError{Msg: "amount must be positive"}
+	}
+
+	if recurrence == "" {
+		recurrence = domain.RecurrenceOnce
+	} else if !domain.IsValidRecurrence(recurrence) {
+		return 0, &domain.InvalidArgumentsError{Msg: fmt.Sprintf("unknown recurrence: %s", recurrence)}
+	}
+
+	now := time.Now()
+	if startAt.IsZero() {
+		startAt = now
+	} else if startAt.Before(now) {
+		return 0, &domain.InvalidArgumentsError{Msg: "start time must be in the future"}
+	} else if startAt.After(now.Add(domain.MaxScheduledTransferLeadTime)) {
+		return 0, &domain.InvalidArgumentsError{Msg: "start time must be within a year"}
+	}
+
+	recipientID, err := sc.userIDFetcher.FetchUserID(ctx, toUsername)
+	if err != nil {
+		return 0, &domain.UserNotFoundError{Msg: fmt.Sprintf("user not found: %s", toUsername)}
+	}
+
+	if recipientID == senderID {
+		return 0, &domain.InvalidArgumentsError{Msg: "from_user must differ from to_user"}
+	}
+
+	activeCount, err := sc.transfersRepo.CountActiveScheduledTransfers(ctx, senderID)
+	if err != nil {
+		return 0, err
+	}
+
+	if activeCount >= domain.MaxActiveScheduledTransfers {
+		return 0, &domain.LimitExceededError{Msg: fmt.Sprintf("at most %d active scheduled transfers are allowed", domain.MaxActiveScheduledTransfers)}
+	}
+
+	err = sc.balanceCreator.EnsureBalanceCreated(ctx, recipientID, domain.StartBalance)
+	if err != nil {
+		return 0, fmt.Errorf("failed to ensure balance for user %d: %w", recipientID, err)
+	}
+
+	return sc.transfersRepo.CreateScheduledTransfer(ctx, domain.ScheduledTransfer{
+		SenderID:    senderID,
+		RecipientID: recipientID,
+		Amount:      amount,
+		Recurrence:  recurrence,
+		StartsAt:    startAt,
+		NextRunAt:   startAt,
+	})
+}
+
+func (sc *ScheduledTransfersCase) ListScheduledTransfers(ctx context.Context, senderID int) ([]domain.NamedScheduledTransfer, error) {
+	transfers, err := sc.transfersRepo.ListScheduledTransfers(ctx, senderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(transfers) == 0 {
+		return []domain.NamedScheduledTransfer{}, nil
+	}
+
+	recipientIDs := make([]int, 0, len(transfers))
+	for _, transfer := range transfers {
+		recipientIDs = append(recipientIDs, transfer.RecipientID)
+	}
+
+	usernames, err := sc.usernameGetter.GetUsernames(ctx, recipientIDs...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recipient usernames: %w", err)
+	}
+
+	named := make([]domain.NamedScheduledTransfer, 0, len(transfers))
+	for _, transfer := range transfers {
+		named = append(named, domain.NamedScheduledTransfer{
+			ScheduledTransfer: transfer,
+			RecipientUsername: usernames[transfer.RecipientID],
+		})
+	}
+
+	return named, nil
+}
+
+func (sc *ScheduledTransfersCase) CancelScheduledTransfer(ctx context.Context, senderID, transferID int) error {
+	return sc.transfersRepo.CancelScheduledTransfer(ctx, senderID, transferID)
+}
+
+// ExecuteDueTransfers runs every transfer whose attempt is due and returns how many of them moved coins.
+func (sc *ScheduledTransfersCase) ExecuteDueTransfers(ctx context.Context) (int, error) {
+	return worker.ProcessDue(ctx, "scheduled transfer", func(ctx context.Context) ([]int, error) {
+		return sc.executor.FetchDueScheduledTransfers(ctx, time.Now(), domain.ScheduledTransfersExecuteBatch)
+	}, sc.executeTransfer)
+}
+
+// executeTransfer runs a single scheduled transfer with the regular transfer checks.
+// An insufficient balance is retried later, up to MaxScheduledTransferAttempts times. Any other rejection,
+// or running out of attempts, skips the occurrence: a one-off transfer fails, a recurring one moves to its next run.
+func (sc *ScheduledTransfersCase) executeTransfer(ctx context.Context, transferID int) (bool, error) {
+	executed := false
+
+	err := sc.txManager.WithinTransaction(ctx, func(ctx context.Context, executor database.QueryExecuter) error {
+		transfer, err := sc.executor.LockAndGetScheduledTransfer(ctx, executor, transferID)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+
+		// The transfer could have been cancelled or run by another store instance since it was fetched.
+		if transfer.Status != domain.ScheduledTransferStatusActive || transfer.NextAttemptAt.After(now) {
+			return nil
+		}
+
+		recipientUsername, err := sc.usernameGetter.GetUsername(ctx, transfer.RecipientID)
+		if err != nil {
+			return fmt.Errorf("failed to get username of user %d: %w", transfer.RecipientID, err)
+		}
+
+		transferErr := sc.sendCoinsCase.proceedTransfer(ctx, executor, transfer.SenderID, transfer.RecipientID,
+			recipientUsername, transfer.Amount)
+		switch {
+		case transferErr == nil:
+			transfer.LastError = ""
+			transfer.Advance(now, domain.ScheduledTransferStatusCompleted)
+			executed = true
+		case errors.Is(transferErr, &domain.InsufficientBalanceError{}) && transfer.Attempts+1 < domain.MaxScheduledTransferAttempts:
+			transfer.Attempts++
+			transfer.LastError = transferErr.Error()
+			transfer.NextAttemptAt = now.Add(domain.ScheduledTransferRetryDelay)
+		case isTransferRejection(transferErr):
+			transfer.LastError = transferErr.Error()
+			transfer.Advance(now, domain.ScheduledTransferStatusFailed)
+		default:
+			return transferErr
+		}
+
+		return sc.executor.UpdateScheduledTransfer(ctx, executor, transfer)
+	})
+
+	return executed, err
+}
+
+// isTransferRejection reports whether err is a business rule rejecting the transfer rather than an internal failure.
+func isTransferRejection(err error) bool {
+	return errors.Is(err, &domain.InsufficientBalanceError{}) ||
+		errors.Is(err, &domain.UserDeactivatedError{}) ||
+		errors.Is(err, &domain.AccountFrozenError{}) ||
+		errors.Is(err, &domain.LimitExceededError{})
+}
@@ -0,0 +1,371 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	dbmocks "github.com/Lexv0lk/merch-store/gen/mocks/database"
+	storemocks "github.com/Lexv0lk/merch-store/gen/mocks/store"
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduledTransfersCase_ScheduleTransfer(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		userIDFetcher  *storemocks.MockUserIDFetcher
+		balanceCreator *storemocks.MockBalanceEnsurer
+		transfersRepo  *storemocks.MockScheduledTransfersRepository
+	}
+
+	startAt := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+
+	type testCase struct {
+		name       string
+		toUsername string
+		amount     uint32
+		startAt    time.Time
+		recurrence string
+
+		prepareFn func(t *testing.T, d *deps)
+
+		expectedID  int
+		expectedErr error
+	}
+
+	tests := []testCase{
+		{
+			name:       "weekly transfer scheduled",
+			toUsername: "recipient",
+			amount:     50,
+			startAt:    startAt,
+			recurrence: domain.RecurrenceWeekly,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "recipient").Return(2, nil)
+				d.transfersRepo.EXPECT().CountActiveScheduledTransfers(gomock.Any(), 1).Return(0, nil)
+				d.balanceCreator.EXPECT().EnsureBalanceCreated(gomock.Any(), 2, domain.StartBalance).Return(nil)
+				d.transfersRepo.EXPECT().CreateScheduledTransfer(gomock.Any(), domain.ScheduledTransfer{
+					SenderID:    1,
+					RecipientID: 2,
+					Amount:      50,
+					Recurrence:  domain.RecurrenceWeekly,
+					StartsAt:    startAt,
+					NextRunAt:   startAt,
+				}).Return(3, nil)
+			},
+			expectedID: 3,
+		},
+		{
+			name:       "one-off transfer scheduled right away",
+			toUsername: "recipient",
+			amount:     50,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "recipient").Return(2, nil)
+				d.transfersRepo.EXPECT().CountActiveScheduledTransfers(gomock.Any(), 1).Return(0, nil)
+				d.balanceCreator.EXPECT().EnsureBalanceCreated(gomock.Any(), 2, domain.StartBalance).Return(nil)
+				d.transfersRepo.EXPECT().CreateScheduledTransfer(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, transfer domain.ScheduledTransfer) (int, error) {
+						assert.Equal(t, domain.RecurrenceOnce, transfer.Recurrence)
+						assert.WithinDuration(t, time.Now(), transfer.NextRunAt, time.Minute)
+						return 4, nil
+					})
+			},
+			expectedID: 4,
+		},
+		{
+			name:        "unknown recurrence",
+			toUsername:  "recipient",
+			amount:      50,
+			recurrence:  "daily",
+			prepareFn:   func(t *testing.T, d *deps) {},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:        "start time in the past",
+			toUsername:  "recipient",
+			amount:      50,
+			startAt:     time.Now().Add(-time.Hour),
+			prepareFn:   func(t *testing.T, d *deps) {},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:       "transfer to self",
+			toUsername: "sender",
+			amount:     50,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "sender").Return(1, nil)
+			},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:       "too many active transfers",
+			toUsername: "recipient",
+			amount:     50,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "recipient").Return(2, nil)
+				d.transfersRepo.EXPECT().CountActiveScheduledTransfers(gomock.Any(), 1).
+					Return(domain.MaxActiveScheduledTransfers, nil)
+			},
+			expectedErr: &domain.LimitExceededError{},
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			d := &deps{
+				userIDFetcher:  storemocks.NewMockUserIDFetcher(ctrl),
+				balanceCreator: storemocks.NewMockBalanceEnsurer(ctrl),
+				transfersRepo:  storemocks.NewMockScheduledTransfersRepository(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			sendCoinsCase := NewSendCoinsCase(dbmocks.NewMockTxManager(ctrl), d.userIDFetcher,
+				storemocks.NewMockUserBalanceLocker(ctrl), d.balanceCreator,
+				storemocks.NewMockBalanceStatusChecker(ctrl), storemocks.NewMockTransferLimitsProvider(ctrl),
+				storemocks.NewMockTransferStatsFetcher(ctrl), storemocks.NewMockTransactionProceeder(ctrl),
+				storemocks.NewMockEventOutbox(ctrl))
+
+			scheduledTransfersCase := NewScheduledTransfersCase(dbmocks.NewMockTxManager(ctrl), d.userIDFetcher,
+				storemocks.NewMockUsernameGetter(ctrl), d.balanceCreator, d.transfersRepo,
+				storemocks.NewMockScheduledTransfersExecutor(ctrl), sendCoinsCase)
+			transferID, err := scheduledTransfersCase.ScheduleTransfer(t.Context(), 1, tt.toUsername, tt.amount, tt.startAt, tt.recurrence)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedID, transferID)
+			}
+		})
+	}
+}
+
+func TestScheduledTransfersCase_ExecuteDueTransfers(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		txManager            *dbmocks.MockTxManager
+		usernameGetter       *storemocks.MockUsernameGetter
+		executor             *storemocks.MockScheduledTransfersExecutor
+		balanceLocker        *storemocks.MockUserBalanceLocker
+		balanceStatusChecker *storemocks.MockBalanceStatusChecker
+		limitsProvider       *storemocks.MockTransferLimitsProvider
+		statsFetcher         *storemocks.MockTransferStatsFetcher
+		transactionProceeder *storemocks.MockTransactionProceeder
+		eventOutbox          *storemocks.MockEventOutbox
+	}
+
+	type testCase struct {
+		name string
+
+		prepareFn func(t *testing.T, d *deps)
+
+		expectedExecuted int
+		expectedErr      bool
+	}
+
+	executeTxFn := func(ctx context.Context, txFn database.TxFunc) error {
+		return txFn(ctx, nil)
+	}
+
+	limits := domain.TransferLimits{
+		MaxSingleTransfer:           500,
+		DailyOutgoingCap:            1000,
+		MaxTransfersPerHour:         20,
+		MaxReceivedFromSenderPerDay: 500,
+	}
+	runAt := time.Now().Add(-time.Minute).Truncate(time.Second)
+	weekly := domain.ScheduledTransfer{Id: 3, SenderID: 1, RecipientID: 2, Amount: 50,
+		Recurrence: domain.RecurrenceWeekly, Status: domain.ScheduledTransferStatusActive,
+		StartsAt: runAt, NextRunAt: runAt, NextAttemptAt: runAt}
+
+	tests := []testCase{
+		{
+			name: "transfer executed and moved to next week",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.executor.EXPECT().FetchDueScheduledTransfers(gomock.Any(), gomock.Any(), domain.ScheduledTransfersExecuteBatch).
+					Return([]int{3}, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.executor.EXPECT().LockAndGetScheduledTransfer(gomock.Any(), nil, 3).Return(weekly, nil)
+				d.usernameGetter.EXPECT().GetUsername(gomock.Any(), 2).Return("recipient", nil)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).Return(uint32(1000), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 1).Return(true, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).Return(false, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 2).Return(true, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 2).Return(false, nil)
+				d.statsFetcher.EXPECT().FetchTransferStats(gomock.Any(), nil, 1, 2).Return(domain.TransferStats{}, nil)
+				d.limitsProvider.EXPECT().GetTransferLimits(gomock.Any(), nil, 1).Return(limits, nil)
+				d.limitsProvider.EXPECT().GetTransferLimits(gomock.Any(), nil, 2).Return(limits, nil)
+				d.transactionProceeder.EXPECT().ProceedTransaction(gomock.Any(), nil, uint32(50), 1, 2).Return(nil)
+				d.eventOutbox.EXPECT().AppendEvent(gomock.Any(), nil, domain.CoinsSentEvent(1, 2, 50)).Return(nil)
+
+				next := weekly
+				next.NextRunAt = runAt.AddDate(0, 0, 7)
+				next.NextAttemptAt = next.NextRunAt
+				d.executor.EXPECT().UpdateScheduledTransfer(gomock.Any(), nil, next).Return(nil)
+			},
+			expectedExecuted: 1,
+		},
+		{
+			name: "insufficient balance is retried later",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.executor.EXPECT().FetchDueScheduledTransfers(gomock.Any(), gomock.Any(), domain.ScheduledTransfersExecuteBatch).
+					Return([]int{3}, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.executor.EXPECT().LockAndGetScheduledTransfer(gomock.Any(), nil, 3).Return(weekly, nil)
+				d.usernameGetter.EXPECT().GetUsername(gomock.Any(), 2).Return("recipient", nil)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).Return(uint32(10), nil)
+				d.executor.EXPECT().UpdateScheduledTransfer(gomock.Any(), nil, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, executor database.Executor, transfer domain.ScheduledTransfer) error {
+						assert.Equal(t, domain.ScheduledTransferStatusActive, transfer.Status)
+						assert.Equal(t, 1, transfer.Attempts)
+						assert.Equal(t, runAt, transfer.NextRunAt)
+						assert.WithinDuration(t, time.Now().Add(domain.ScheduledTransferRetryDelay), transfer.NextAttemptAt, time.Minute)
+						assert.NotEmpty(t, transfer.LastError)
+						return nil
+					})
+			},
+		},
+		{
+			name: "one-off transfer fails after last attempt",
+			prepareFn: func(t *testing.T, d *deps) {
+				once := weekly
+				once.Recurrence = domain.RecurrenceOnce
+				once.Attempts = domain.MaxScheduledTransferAttempts - 1
+
+				d.executor.EXPECT().FetchDueScheduledTransfers(gomock.Any(), gomock.Any(), domain.ScheduledTransfersExecuteBatch).
+					Return([]int{3}, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.executor.EXPECT().LockAndGetScheduledTransfer(gomock.Any(), nil, 3).Return(once, nil)
+				d.usernameGetter.EXPECT().GetUsername(gomock.Any(), 2).Return("recipient", nil)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).Return(uint32(10), nil)
+				d.executor.EXPECT().UpdateScheduledTransfer(gomock.Any(), nil, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, executor database.Executor, transfer domain.ScheduledTransfer) error {
+						assert.Equal(t, domain.ScheduledTransferStatusFailed, transfer.Status)
+						assert.Equal(t, 0, transfer.Attempts)
+						return nil
+					})
+			},
+		},
+		{
+			name: "deactivated sender is rejected and the run skipped",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.executor.EXPECT().FetchDueScheduledTransfers(gomock.Any(), gomock.Any(), domain.ScheduledTransfersExecuteBatch).
+					Return([]int{3}, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.executor.EXPECT().LockAndGetScheduledTransfer(gomock.Any(), nil, 3).Return(weekly, nil)
+				d.usernameGetter.EXPECT().GetUsername(gomock.Any(), 2).Return("recipient", nil)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).Return(uint32(1000), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 1).Return(false, nil)
+				d.executor.EXPECT().UpdateScheduledTransfer(gomock.Any(), nil, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, executor database.Executor, transfer domain.ScheduledTransfer) error {
+						assert.Equal(t, runAt.AddDate(0, 0, 7), transfer.NextRunAt)
+						assert.NotEmpty(t, transfer.LastError)
+						return nil
+					})
+			},
+		},
+		{
+			name: "cancelled transfer is skipped",
+			prepareFn: func(t *testing.T, d *deps) {
+				cancelled := weekly
+				cancelled.Status = domain.ScheduledTransferStatusCancelled
+
+				d.executor.EXPECT().FetchDueScheduledTransfers(gomock.Any(), gomock.Any(), domain.ScheduledTransfersExecuteBatch).
+					Return([]int{3}, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.executor.EXPECT().LockAndGetScheduledTransfer(gomock.Any(), nil, 3).Return(cancelled, nil)
+			},
+		},
+		{
+			name: "internal error does not stop other transfers",
+			prepareFn: func(t *testing.T, d *deps) {
+				cancelled := weekly
+				cancelled.Id = 4
+				cancelled.Status = domain.ScheduledTransferStatusCancelled
+
+				d.executor.EXPECT().FetchDueScheduledTransfers(gomock.Any(), gomock.Any(), domain.ScheduledTransfersExecuteBatch).
+					Return([]int{3, 4}, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn).Times(2)
+				d.executor.EXPECT().LockAndGetScheduledTransfer(gomock.Any(), nil, 3).Return(domain.ScheduledTransfer{}, errors.New("db error"))
+				d.executor.EXPECT().LockAndGetScheduledTransfer(gomock.Any(), nil, 4).Return(cancelled, nil)
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			d := &deps{
+				txManager:            dbmocks.NewMockTxManager(ctrl),
+				usernameGetter:       storemocks.NewMockUsernameGetter(ctrl),
+				executor:             storemocks.NewMockScheduledTransfersExecutor(ctrl),
+				balanceLocker:        storemocks.NewMockUserBalanceLocker(ctrl),
+				balanceStatusChecker: storemocks.NewMockBalanceStatusChecker(ctrl),
+				limitsProvider:       storemocks.NewMockTransferLimitsProvider(ctrl),
+				statsFetcher:         storemocks.NewMockTransferStatsFetcher(ctrl),
+				transactionProceeder: storemocks.NewMockTransactionProceeder(ctrl),
+				eventOutbox:          storemocks.NewMockEventOutbox(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			sendCoinsCase := NewSendCoinsCase(d.txManager, storemocks.NewMockUserIDFetcher(ctrl), d.balanceLocker,
+				storemocks.NewMockBalanceEnsurer(ctrl), d.balanceStatusChecker, d.limitsProvider, d.statsFetcher,
+				d.transactionProceeder, d.eventOutbox)
+
+			scheduledTransfersCase := NewScheduledTransfersCase(d.txManager, storemocks.NewMockUserIDFetcher(ctrl),
+				d.usernameGetter, storemocks.NewMockBalanceEnsurer(ctrl),
+				storemocks.NewMockScheduledTransfersRepository(ctrl), d.executor, sendCoinsCase)
+			executed, err := scheduledTransfersCase.ExecuteDueTransfers(t.Context())
+
+			if tt.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedExecuted, executed)
+		})
+	}
+}
+
+func TestScheduledTransfersCase_CancelScheduledTransfer(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		transfersRepo *storemocks.MockScheduledTransfersRepository
+	}
+
+	ctrl := gomock.NewController(t)
+	d := &deps{
+		transfersRepo: storemocks.NewMockScheduledTransfersRepository(ctrl),
+	}
+
+	d.transfersRepo.EXPECT().CancelScheduledTransfer(gomock.Any(), 1, 3).
+		Return(&domain.ScheduledTransferNotFoundError{Msg: "scheduled transfer 3 not found"})
+
+	sendCoinsCase := NewSendCoinsCase(dbmocks.NewMockTxManager(ctrl), storemocks.NewMockUserIDFetcher(ctrl),
+		storemocks.NewMockUserBalanceLocker(ctrl), storemocks.NewMockBalanceEnsurer(ctrl),
+		storemocks.NewMockBalanceStatusChecker(ctrl), storemocks.NewMockTransferLimitsProvider(ctrl),
+		storemocks.NewMockTransferStatsFetcher(ctrl), storemocks.NewMockTransactionProceeder(ctrl),
+		storemocks.NewMockEventOutbox(ctrl))
+
+	scheduledTransfersCase := NewScheduledTransfersCase(dbmocks.NewMockTxManager(ctrl),
+		storemocks.NewMockUserIDFetcher(ctrl), storemocks.NewMockUsernameGetter(ctrl),
+		storemocks.NewMockBalanceEnsurer(ctrl), d.transfersRepo, storemocks.NewMockScheduledTransfersExecutor(ctrl),
+		sendCoinsCase)
+	err := scheduledTransfersCase.CancelScheduledTransfer(t.Context(), 1, 3)
+	assert.ErrorIs(t, err, &domain.ScheduledTransferNotFoundError{})
+}
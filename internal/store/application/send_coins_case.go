@@ -3,6 +3,9 @@ package application
 import (
 	"context"
 	"fmt"
+	"math"
+	"slices"
+	"strings"
 
 	"github.com/Lexv0lk/merch-store/internal/pkg/database"
 	"github.com/Lexv0lk/merch-store/internal/store/domain"
@@ -14,19 +17,31 @@ type SendCoinsCase struct {
 	transactionProceeder domain.TransactionProceeder
 	balanceLocker        domain.UserBalanceLocker
 	balanceCreator       domain.BalanceEnsurer
+	balanceStatusChecker domain.BalanceStatusChecker
+	limitsProvider       domain.TransferLimitsProvider
+	statsFetcher         domain.TransferStatsFetcher
+	eventOutbox          domain.EventOutbox
 }
 
 func NewSendCoinsCase(txManager database.TxManager,
 	userIDFetcher domain.UserIDFetcher,
 	balanceLocker domain.UserBalanceLocker,
 	balanceCreator domain.BalanceEnsurer,
-	transactionProceeder domain.TransactionProceeder) *SendCoinsCase {
+	balanceStatusChecker domain.BalanceStatusChecker,
+	limitsProvider domain.TransferLimitsProvider,
+	statsFetcher domain.TransferStatsFetcher,
+	transactionProceeder domain.TransactionProceeder,
+	eventOutbox domain.EventOutbox) *SendCoinsCase {
 	return &SendCoinsCase{
 		txManager:            txManager,
 		userIDFetcher:        userIDFetcher,
 		transactionProceeder: transactionProceeder,
 		balanceLocker:        balanceLocker,
 		balanceCreator:       balanceCreator,
+		balanceStatusChecker: balanceStatusChecker,
+		limitsProvider:       limitsProvider,
+		statsFetcher:         statsFetcher,
+		eventOutbox:          eventOutbox,
 	}
 }
 
@@ -46,20 +61,274 @@ func (sc *SendCoinsCase) SendCoins(ctx context.Context, fromUserID int, toUserna
 	}
 
 	return sc.txManager.WithinTransaction(ctx, func(ctx context.Context, executor database.QueryExecuter) error {
-		fromUserBalance, err := sc.balanceLocker.LockAndGetUserBalance(ctx, executor, fromUserID)
+		return sc.proceedTransfer(ctx, executor, fromUserID, toUserID, toUsername, amount)
+	})
+}
+
+// SendCoinsBatch sends coins to several users at once. The recipients are resolved with a single lookup
+// and every transfer is made in one transaction, so either all of them go through or none does.
+func (sc *SendCoinsCase) SendCoinsBatch(ctx context.Context, fromUserID int, transfers []domain.CoinTransfer) error {
+	total, err := validateBatch(transfers)
+	if err != nil {
+		return err
+	}
+
+	usernames := make([]string, 0, len(transfers))
+	for _, transfer := range transfers {
+		usernames = append(usernames, transfer.ToUsername)
+	}
+
+	userIDs, err := sc.userIDFetcher.FetchUserIDs(ctx, usernames...)
+	if err != nil {
+		return fmt.Errorf("failed to fetch user ids: %w", err)
+	}
+
+	missing := make([]string, 0)
+	for _, username := range usernames {
+		if _, ok := userIDs[username]; !ok {
+			missing = append(missing, username)
+		}
+	}
+
+	if len(missing) > 0 {
+		return &domain.UserNotFoundError{Msg: fmt.Sprintf("users not found: %s", strings.Join(missing, ", "))}
+	}
+
+	for _, username := range usernames {
+		if userIDs[username] == fromUserID {
+			return &domain.InvalidArgumentsError{Msg: "from_user must differ from to_user"}
+		}
+
+		err = sc.balanceCreator.EnsureBalanceCreated(ctx, userIDs[username], domain.StartBalance)
 		if err != nil {
-			return fmt.Errorf("failed to lock and get balance for user %d: %w", fromUserID, err)
+			return fmt.Errorf("failed to ensure balance for user %d: %w", userIDs[username], err)
 		}
+	}
+
+	ordered := slices.Clone(transfers)
+	slices.SortFunc(ordered, func(a, b domain.CoinTransfer) int {
+		return userIDs[a.ToUsername] - userIDs[b.ToUsername]
+	})
+
+	balanceUserIDs := make([]int, 0, len(ordered)+1)
+	balanceUserIDs = append(balanceUserIDs, fromUserID)
+	for _, transfer := range ordered {
+		balanceUserIDs = append(balanceUserIDs, userIDs[transfer.ToUsername])
+	}
 
-		if fromUserBalance < amount {
-			return &domain.InsufficientBalanceError{Msg: fmt.Sprintf("user %d has insufficient balance", fromUserID)}
+	return sc.txManager.WithinTransaction(ctx, func(ctx context.Context, executor database.QueryExecuter) error {
+		// The sender's and the recipients' balances are all locked up front in user ID order, so concurrent batches
+		// sharing any of the users can't deadlock.
+		balances, err := lockBalances(ctx, sc.balanceLocker, executor, balanceUserIDs...)
+		if err != nil {
+			return err
 		}
 
-		err = sc.transactionProceeder.ProceedTransaction(ctx, executor, amount, fromUserID, toUserID)
+		err = sc.checkSender(ctx, executor, fromUserID, balances[fromUserID], total)
 		if err != nil {
-			return fmt.Errorf("failed to proceed transaction: %w", err)
+			return err
+		}
+
+		// The limits of each transfer are checked against the ones already made in this batch.
+		for _, transfer := range ordered {
+			err = sc.proceedRecipientTransfer(ctx, executor, fromUserID, userIDs[transfer.ToUsername],
+				transfer.ToUsername, transfer.Amount)
+			if err != nil {
+				return err
+			}
 		}
 
 		return nil
 	})
 }
+
+// validateBatch checks the shape of a batch transfer and returns its total amount.
+func validateBatch(transfers []domain.CoinTransfer) (uint32, error) {
+	if len(transfers) == 0 {
+		return 0, &domain.InvalidArgumentsError{Msg: "at least one transfer is required"}
+	}
+
+	if len(transfers) > domain.MaxBatchTransfers {
+		return 0, &domain.InvalidArgumentsError{Msg: fmt.Sprintf("at most %d transfers are allowed in a batch", domain.MaxBatchTransfers)}
+	}
+
+	var total uint64
+	seen := make(map[string]struct{}, len(transfers))
+	for _, transfer := range transfers {
+		if transfer.Amount == 0 {
+			return 0, &domain.InvalidArgumentsError{Msg: fmt.Sprintf("amount for %s must be positive", transfer.ToUsername)}
+		}
+
+		if _, ok := seen[transfer.ToUsername]; ok {
+			return 0, &domain.InvalidArgumentsError{Msg: fmt.Sprintf("duplicate recipient: %s", transfer.ToUsername)}
+		}
+		seen[transfer.ToUsername] = struct{}{}
+
+		total += uint64(transfer.Amount)
+	}
+
+	if total > math.MaxUint32 {
+		return 0, &domain.InvalidArgumentsError{Msg: "total amount is too large"}
+	}
+
+	return uint32(total), nil
+}
+
+// proceedTransfer runs every transfer check and moves the coins within an already opened transaction.
+func (sc *SendCoinsCase) proceedTransfer(ctx context.Context, executor database.QueryExecuter,
+	fromUserID, toUserID int, toUsername string, amount uint32) error {
+	err := sc.lockSender(ctx, executor, fromUserID, amount)
+	if err != nil {
+		return err
+	}
+
+	return sc.proceedRecipientTransfer(ctx, executor, fromUserID, toUserID, toUsername, amount)
+}
+
+// proceedPayment moves the coins of a payment for goods within an already opened transaction. It runs the same
+// balance and account checks as a transfer, but the velocity limits don't apply, as they cap gifting coins rather
+// than paying for an item.
+func (sc *SendCoinsCase) proceedPayment(ctx context.Context, executor database.QueryExecuter,
+	fromUserID, toUserID int, toUsername string, amount uint32) error {
+	err := sc.lockSender(ctx, executor, fromUserID, amount)
+	if err != nil {
+		return err
+	}
+
+	err = sc.checkRecipient(ctx, executor, toUserID, toUsername)
+	if err != nil {
+		return err
+	}
+
+	return sc.moveCoins(ctx, executor, fromUserID, toUserID, amount)
+}
+
+// lockSender locks the sender's balance and checks it covers amount and the account is active and not frozen.
+func (sc *SendCoinsCase) lockSender(ctx context.Context, executor database.QueryExecuter, fromUserID int, amount uint32) error {
+	fromUserBalance, err := sc.balanceLocker.LockAndGetUserBalance(ctx, executor, fromUserID)
+	if err != nil {
+		return fmt.Errorf("failed to lock and get balance for user %d: %w", fromUserID, err)
+	}
+
+	return sc.checkSender(ctx, executor, fromUserID, fromUserBalance, amount)
+}
+
+// checkSender checks the sender's locked balance covers amount and the account is active and not frozen.
+func (sc *SendCoinsCase) checkSender(ctx context.Context, querier database.Querier, fromUserID int,
+	fromUserBalance, amount uint32) error {
+	if fromUserBalance < amount {
+		return &domain.InsufficientBalanceError{Msg: fmt.Sprintf("user %d has insufficient balance", fromUserID)}
+	}
+
+	isActive, err := sc.balanceStatusChecker.IsBalanceActive(ctx, querier, fromUserID)
+	if err != nil {
+		return fmt.Errorf("failed to check balance status for user %d: %w", fromUserID, err)
+	}
+
+	if !isActive {
+		return &domain.UserDeactivatedError{Msg: fmt.Sprintf("user %d is deactivated", fromUserID)}
+	}
+
+	return checkNotFrozen(ctx, sc.balanceStatusChecker, querier, fromUserID, "account is frozen")
+}
+
+// proceedRecipientTransfer checks the recipient and the transfer limits, then moves the coins and appends
+// a CoinsSent event. The sender's balance must already be locked.
+func (sc *SendCoinsCase) proceedRecipientTransfer(ctx context.Context, executor database.QueryExecuter,
+	fromUserID, toUserID int, toUsername string, amount uint32) error {
+	err := sc.checkRecipient(ctx, executor, toUserID, toUsername)
+	if err != nil {
+		return err
+	}
+
+	err = sc.checkLimits(ctx, executor, fromUserID, toUserID, amount)
+	if err != nil {
+		return err
+	}
+
+	return sc.moveCoins(ctx, executor, fromUserID, toUserID, amount)
+}
+
+// checkRecipient checks the recipient's account is active and not frozen.
+func (sc *SendCoinsCase) checkRecipient(ctx context.Context, querier database.Querier, toUserID int, toUsername string) error {
+	isActive, err := sc.balanceStatusChecker.IsBalanceActive(ctx, querier, toUserID)
+	if err != nil {
+		return fmt.Errorf("failed to check balance status for user %d: %w", toUserID, err)
+	}
+
+	if !isActive {
+		return &domain.UserDeactivatedError{Msg: fmt.Sprintf("user %s is deactivated", toUsername)}
+	}
+
+	return checkNotFrozen(ctx, sc.balanceStatusChecker, querier, toUserID, fmt.Sprintf("recipient %s is frozen", toUsername))
+}
+
+// moveCoins moves the coins and appends a CoinsSent event.
+func (sc *SendCoinsCase) moveCoins(ctx context.Context, executor database.QueryExecuter, fromUserID, toUserID int,
+	amount uint32) error {
+	err := sc.transactionProceeder.ProceedTransaction(ctx, executor, amount, fromUserID, toUserID)
+	if err != nil {
+		return fmt.Errorf("failed to proceed transaction: %w", err)
+	}
+
+	return sc.eventOutbox.AppendEvent(ctx, executor, domain.CoinsSentEvent(fromUserID, toUserID, amount))
+}
+
+func (sc *SendCoinsCase) checkLimits(ctx context.Context, querier database.Querier, fromUserID, toUserID int, amount uint32) error {
+	stats, err := sc.statsFetcher.FetchTransferStats(ctx, querier, fromUserID, toUserID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch transfer stats for user %d: %w", fromUserID, err)
+	}
+
+	senderLimits, err := sc.limitsProvider.GetTransferLimits(ctx, querier, fromUserID)
+	if err != nil {
+		return fmt.Errorf("failed to get transfer limits for user %d: %w", fromUserID, err)
+	}
+
+	err = senderLimits.CheckOutgoing(amount, stats)
+	if err != nil {
+		return err
+	}
+
+	recipientLimits, err := sc.limitsProvider.GetTransferLimits(ctx, querier, toUserID)
+	if err != nil {
+		return fmt.Errorf("failed to get transfer limits for user %d: %w", toUserID, err)
+	}
+
+	return recipientLimits.CheckIncoming(amount, stats)
+}
+
+// checkNotFrozen fails with AccountFrozenError carrying msg if the user's balance is frozen.
+func checkNotFrozen(ctx context.Context, statusChecker domain.BalanceStatusChecker, querier database.Querier, userID int, msg string) error {
+	isFrozen, err := statusChecker.IsBalanceFrozen(ctx, querier, userID)
+	if err != nil {
+		return fmt.Errorf("failed to check freeze status for user %d: %w", userID, err)
+	}
+
+	if isFrozen {
+		return &domain.AccountFrozenError{Msg: msg}
+	}
+
+	return nil
+}
+
+// lockBalances locks the balances of the users in user ID order, so transactions locking the same users can't
+// deadlock, and returns them keyed by user ID.
+func lockBalances(ctx context.Context, balanceLocker domain.UserBalanceLocker, querier database.Querier,
+	userIDs ...int) (map[int]uint32, error) {
+	sorted := slices.Clone(userIDs)
+	slices.Sort(sorted)
+	sorted = slices.Compact(sorted)
+
+	balances := make(map[int]uint32, len(sorted))
+	for _, userID := range sorted {
+		balance, err := balanceLocker.LockAndGetUserBalance(ctx, querier, userID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to lock and get balance for user %d: %w", userID, err)
+		}
+
+		balances[userID] = balance
+	}
+
+	return balances, nil
+}
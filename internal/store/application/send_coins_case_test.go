@@ -20,7 +20,11 @@ func TestSendCoinsCase_SendCoins(t *testing.T) {
 		userIDFetcher        *storemocks.MockUserIDFetcher
 		balanceLocker        *storemocks.MockUserBalanceLocker
 		balanceCreator       *storemocks.MockBalanceEnsurer
+		balanceStatusChecker *storemocks.MockBalanceStatusChecker
+		limitsProvider       *storemocks.MockTransferLimitsProvider
+		statsFetcher         *storemocks.MockTransferStatsFetcher
 		transactionProceeder *storemocks.MockTransactionProceeder
+		eventOutbox          *storemocks.MockEventOutbox
 	}
 
 	type testCase struct {
@@ -38,6 +42,13 @@ func TestSendCoinsCase_SendCoins(t *testing.T) {
 		return txFn(ctx, nil)
 	}
 
+	defaultLimits := domain.TransferLimits{
+		MaxSingleTransfer:           500,
+		DailyOutgoingCap:            1000,
+		MaxTransfersPerHour:         20,
+		MaxReceivedFromSenderPerDay: 500,
+	}
+
 	tests := []testCase{
 		{
 			name:       "successful transfer",
@@ -53,8 +64,23 @@ func TestSendCoinsCase_SendCoins(t *testing.T) {
 					DoAndReturn(executeTxFn)
 				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).
 					Return(uint32(500), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 1).
+					Return(true, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).
+					Return(false, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 2).
+					Return(true, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 2).
+					Return(false, nil)
+				d.statsFetcher.EXPECT().FetchTransferStats(gomock.Any(), nil, 1, 2).
+					Return(domain.TransferStats{}, nil)
+				d.limitsProvider.EXPECT().GetTransferLimits(gomock.Any(), nil, 1).
+					Return(defaultLimits, nil)
+				d.limitsProvider.EXPECT().GetTransferLimits(gomock.Any(), nil, 2).
+					Return(defaultLimits, nil)
 				d.transactionProceeder.EXPECT().ProceedTransaction(gomock.Any(), nil, uint32(100), 1, 2).
 					Return(nil)
+				d.eventOutbox.EXPECT().AppendEvent(gomock.Any(), nil, domain.CoinsSentEvent(1, 2, 100)).Return(nil)
 			},
 			expectedErr: nil,
 		},
@@ -86,6 +112,204 @@ func TestSendCoinsCase_SendCoins(t *testing.T) {
 			},
 			expectedErr: &domain.InsufficientBalanceError{},
 		},
+		{
+			name:       "recipient deactivated",
+			fromUserID: 1,
+			toUsername: "leaver",
+			amount:     100,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "leaver").
+					Return(2, nil)
+				d.balanceCreator.EXPECT().EnsureBalanceCreated(gomock.Any(), 2, domain.StartBalance).
+					Return(nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(executeTxFn)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).
+					Return(uint32(500), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 1).
+					Return(true, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).
+					Return(false, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 2).
+					Return(false, nil)
+			},
+			expectedErr: &domain.UserDeactivatedError{},
+		},
+		{
+			name:       "sender deactivated",
+			fromUserID: 1,
+			toUsername: "receiver",
+			amount:     100,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "receiver").
+					Return(2, nil)
+				d.balanceCreator.EXPECT().EnsureBalanceCreated(gomock.Any(), 2, domain.StartBalance).
+					Return(nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(executeTxFn)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).
+					Return(uint32(500), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 1).
+					Return(false, nil)
+			},
+			expectedErr: &domain.UserDeactivatedError{},
+		},
+		{
+			name:       "sender frozen",
+			fromUserID: 1,
+			toUsername: "receiver",
+			amount:     100,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "receiver").
+					Return(2, nil)
+				d.balanceCreator.EXPECT().EnsureBalanceCreated(gomock.Any(), 2, domain.StartBalance).
+					Return(nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(executeTxFn)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).
+					Return(uint32(500), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 1).
+					Return(true, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).
+					Return(true, nil)
+			},
+			expectedErr: &domain.AccountFrozenError{},
+		},
+		{
+			name:       "recipient frozen",
+			fromUserID: 1,
+			toUsername: "suspect",
+			amount:     100,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "suspect").
+					Return(2, nil)
+				d.balanceCreator.EXPECT().EnsureBalanceCreated(gomock.Any(), 2, domain.StartBalance).
+					Return(nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(executeTxFn)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).
+					Return(uint32(500), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 1).
+					Return(true, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).
+					Return(false, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 2).
+					Return(true, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 2).
+					Return(true, nil)
+			},
+			expectedErr: &domain.AccountFrozenError{},
+		},
+		{
+			name:       "recipient status check error",
+			fromUserID: 1,
+			toUsername: "receiver",
+			amount:     100,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "receiver").
+					Return(2, nil)
+				d.balanceCreator.EXPECT().EnsureBalanceCreated(gomock.Any(), 2, domain.StartBalance).
+					Return(nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(executeTxFn)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).
+					Return(uint32(500), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 1).
+					Return(true, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).
+					Return(false, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 2).
+					Return(false, assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+		{
+			name:       "single transfer limit exceeded",
+			fromUserID: 1,
+			toUsername: "receiver",
+			amount:     600,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "receiver").
+					Return(2, nil)
+				d.balanceCreator.EXPECT().EnsureBalanceCreated(gomock.Any(), 2, domain.StartBalance).
+					Return(nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(executeTxFn)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).
+					Return(uint32(1000), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 1).
+					Return(true, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).
+					Return(false, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 2).
+					Return(true, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 2).
+					Return(false, nil)
+				d.statsFetcher.EXPECT().FetchTransferStats(gomock.Any(), nil, 1, 2).
+					Return(domain.TransferStats{}, nil)
+				d.limitsProvider.EXPECT().GetTransferLimits(gomock.Any(), nil, 1).
+					Return(defaultLimits, nil)
+			},
+			expectedErr: &domain.LimitExceededError{},
+		},
+		{
+			name:       "recipient limit from single sender exceeded",
+			fromUserID: 1,
+			toUsername: "receiver",
+			amount:     100,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "receiver").
+					Return(2, nil)
+				d.balanceCreator.EXPECT().EnsureBalanceCreated(gomock.Any(), 2, domain.StartBalance).
+					Return(nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(executeTxFn)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).
+					Return(uint32(500), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 1).
+					Return(true, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).
+					Return(false, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 2).
+					Return(true, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 2).
+					Return(false, nil)
+				d.statsFetcher.EXPECT().FetchTransferStats(gomock.Any(), nil, 1, 2).
+					Return(domain.TransferStats{SentLastDay: 450, ReceivedFromSenderLastDay: 450}, nil)
+				d.limitsProvider.EXPECT().GetTransferLimits(gomock.Any(), nil, 1).
+					Return(domain.TransferLimits{}, nil)
+				d.limitsProvider.EXPECT().GetTransferLimits(gomock.Any(), nil, 2).
+					Return(defaultLimits, nil)
+			},
+			expectedErr: &domain.LimitExceededError{},
+		},
+		{
+			name:       "transfer stats error",
+			fromUserID: 1,
+			toUsername: "receiver",
+			amount:     100,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "receiver").
+					Return(2, nil)
+				d.balanceCreator.EXPECT().EnsureBalanceCreated(gomock.Any(), 2, domain.StartBalance).
+					Return(nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(executeTxFn)
+				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).
+					Return(uint32(500), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 1).
+					Return(true, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).
+					Return(false, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 2).
+					Return(true, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 2).
+					Return(false, nil)
+				d.statsFetcher.EXPECT().FetchTransferStats(gomock.Any(), nil, 1, 2).
+					Return(domain.TransferStats{}, assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
 		{
 			name:       "user not found",
 			fromUserID: 1,
@@ -111,6 +335,20 @@ func TestSendCoinsCase_SendCoins(t *testing.T) {
 					DoAndReturn(executeTxFn)
 				d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).
 					Return(uint32(500), nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 1).
+					Return(true, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).
+					Return(false, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 2).
+					Return(true, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 2).
+					Return(false, nil)
+				d.statsFetcher.EXPECT().FetchTransferStats(gomock.Any(), nil, 1, 2).
+					Return(domain.TransferStats{}, nil)
+				d.limitsProvider.EXPECT().GetTransferLimits(gomock.Any(), nil, 1).
+					Return(defaultLimits, nil)
+				d.limitsProvider.EXPECT().GetTransferLimits(gomock.Any(), nil, 2).
+					Return(defaultLimits, nil)
 				d.transactionProceeder.EXPECT().ProceedTransaction(gomock.Any(), nil, uint32(100), 1, 2).
 					Return(assert.AnError)
 			},
@@ -143,12 +381,17 @@ func TestSendCoinsCase_SendCoins(t *testing.T) {
 				userIDFetcher:        storemocks.NewMockUserIDFetcher(ctrl),
 				balanceLocker:        storemocks.NewMockUserBalanceLocker(ctrl),
 				balanceCreator:       storemocks.NewMockBalanceEnsurer(ctrl),
+				balanceStatusChecker: storemocks.NewMockBalanceStatusChecker(ctrl),
+				limitsProvider:       storemocks.NewMockTransferLimitsProvider(ctrl),
+				statsFetcher:         storemocks.NewMockTransferStatsFetcher(ctrl),
 				transactionProceeder: storemocks.NewMockTransactionProceeder(ctrl),
+				eventOutbox:          storemocks.NewMockEventOutbox(ctrl),
 			}
 
 			tt.prepareFn(t, d)
 
-			sendCoinsCase := NewSendCoinsCase(d.txManager, d.userIDFetcher, d.balanceLocker, d.balanceCreator, d.transactionProceeder)
+			sendCoinsCase := NewSendCoinsCase(d.txManager, d.userIDFetcher, d.balanceLocker, d.balanceCreator,
+				d.balanceStatusChecker, d.limitsProvider, d.statsFetcher, d.transactionProceeder, d.eventOutbox)
 			err := sendCoinsCase.SendCoins(t.Context(), tt.fromUserID, tt.toUsername, tt.amount)
 
 			if tt.expectedErr != nil {
@@ -159,3 +402,159 @@ func TestSendCoinsCase_SendCoins(t *testing.T) {
 		})
 	}
 }
+
+func TestSendCoinsCase_SendCoinsBatch(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		txManager            *dbmocks.MockTxManager
+		userIDFetcher        *storemocks.MockUserIDFetcher
+		balanceLocker        *storemocks.MockUserBalanceLocker
+		balanceCreator       *storemocks.MockBalanceEnsurer
+		balanceStatusChecker *storemocks.MockBalanceStatusChecker
+		limitsProvider       *storemocks.MockTransferLimitsProvider
+		statsFetcher         *storemocks.MockTransferStatsFetcher
+		transactionProceeder *storemocks.MockTransactionProceeder
+		eventOutbox          *storemocks.MockEventOutbox
+	}
+
+	type testCase struct {
+		name      string
+		transfers []domain.CoinTransfer
+
+		prepareFn func(t *testing.T, d *deps)
+
+		expectedErr error
+	}
+
+	executeTxFn := func(ctx context.Context, txFn database.TxFunc) error {
+		return txFn(ctx, nil)
+	}
+
+	defaultLimits := domain.TransferLimits{
+		MaxSingleTransfer:           500,
+		DailyOutgoingCap:            1000,
+		MaxTransfersPerHour:         20,
+		MaxReceivedFromSenderPerDay: 500,
+	}
+
+	expectRecipientTransfer := func(d *deps, toUserID int, amount uint32) *gomock.Call {
+		d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, toUserID).Return(true, nil)
+		d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, toUserID).Return(false, nil)
+		d.statsFetcher.EXPECT().FetchTransferStats(gomock.Any(), nil, 1, toUserID).Return(domain.TransferStats{}, nil)
+		d.limitsProvider.EXPECT().GetTransferLimits(gomock.Any(), nil, 1).Return(defaultLimits, nil)
+		d.limitsProvider.EXPECT().GetTransferLimits(gomock.Any(), nil, toUserID).Return(defaultLimits, nil)
+		d.transactionProceeder.EXPECT().ProceedTransaction(gomock.Any(), nil, amount, 1, toUserID).Return(nil)
+		return d.eventOutbox.EXPECT().AppendEvent(gomock.Any(), nil, domain.CoinsSentEvent(1, toUserID, amount)).Return(nil)
+	}
+
+	tests := []testCase{
+		{
+			name:      "all transfers made in recipient id order",
+			transfers: []domain.CoinTransfer{{ToUsername: "carol", Amount: 50}, {ToUsername: "bob", Amount: 100}},
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserIDs(gomock.Any(), "carol", "bob").
+					Return(map[string]int{"bob": 2, "carol": 3}, nil)
+				d.balanceCreator.EXPECT().EnsureBalanceCreated(gomock.Any(), 2, domain.StartBalance).Return(nil)
+				d.balanceCreator.EXPECT().EnsureBalanceCreated(gomock.Any(), 3, domain.StartBalance).Return(nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				gomock.InOrder(
+					d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).Return(uint32(150), nil),
+					d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 2).Return(uint32(1000), nil),
+					d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 3).Return(uint32(1000), nil),
+				)
+				d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 1).Return(true, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 1).Return(false, nil)
+				gomock.InOrder(
+					expectRecipientTransfer(d, 2, 100),
+					expectRecipientTransfer(d, 3, 50),
+				)
+			},
+		},
+		{
+			name:      "total exceeds balance",
+			transfers: []domain.CoinTransfer{{ToUsername: "bob", Amount: 100}, {ToUsername: "carol", Amount: 100}},
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserIDs(gomock.Any(), "bob", "carol").
+					Return(map[string]int{"bob": 2, "carol": 3}, nil)
+				d.balanceCreator.EXPECT().EnsureBalanceCreated(gomock.Any(), gomock.Any(), domain.StartBalance).
+					Return(nil).Times(2)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				gomock.InOrder(
+					d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 1).Return(uint32(150), nil),
+					d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 2).Return(uint32(1000), nil),
+					d.balanceLocker.EXPECT().LockAndGetUserBalance(gomock.Any(), nil, 3).Return(uint32(1000), nil),
+				)
+			},
+			expectedErr: &domain.InsufficientBalanceError{},
+		},
+		{
+			name:      "unknown recipient",
+			transfers: []domain.CoinTransfer{{ToUsername: "bob", Amount: 100}, {ToUsername: "ghost", Amount: 100}},
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserIDs(gomock.Any(), "bob", "ghost").
+					Return(map[string]int{"bob": 2}, nil)
+			},
+			expectedErr: &domain.UserNotFoundError{},
+		},
+		{
+			name:      "sender among recipients",
+			transfers: []domain.CoinTransfer{{ToUsername: "me", Amount: 100}},
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserIDs(gomock.Any(), "me").Return(map[string]int{"me": 1}, nil)
+			},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:        "duplicate recipient",
+			transfers:   []domain.CoinTransfer{{ToUsername: "bob", Amount: 100}, {ToUsername: "bob", Amount: 50}},
+			prepareFn:   func(t *testing.T, d *deps) {},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:        "zero amount",
+			transfers:   []domain.CoinTransfer{{ToUsername: "bob", Amount: 0}},
+			prepareFn:   func(t *testing.T, d *deps) {},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:        "empty batch",
+			transfers:   nil,
+			prepareFn:   func(t *testing.T, d *deps) {},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := &deps{
+				txManager:            dbmocks.NewMockTxManager(ctrl),
+				userIDFetcher:        storemocks.NewMockUserIDFetcher(ctrl),
+				balanceLocker:        storemocks.NewMockUserBalanceLocker(ctrl),
+				balanceCreator:       storemocks.NewMockBalanceEnsurer(ctrl),
+				balanceStatusChecker: storemocks.NewMockBalanceStatusChecker(ctrl),
+				limitsProvider:       storemocks.NewMockTransferLimitsProvider(ctrl),
+				statsFetcher:         storemocks.NewMockTransferStatsFetcher(ctrl),
+				transactionProceeder: storemocks.NewMockTransactionProceeder(ctrl),
+				eventOutbox:          storemocks.NewMockEventOutbox(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			sendCoinsCase := NewSendCoinsCase(d.txManager, d.userIDFetcher, d.balanceLocker, d.balanceCreator,
+				d.balanceStatusChecker, d.limitsProvider, d.statsFetcher, d.transactionProceeder, d.eventOutbox)
+			err := sendCoinsCase.SendCoinsBatch(t.Context(), 1, tt.transfers)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
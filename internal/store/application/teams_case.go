@@ -0,0 +1,248 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/audit"
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+)
+
+type TeamsCase struct {
+	txManager             database.TxManager
+	userIDFetcher         domain.UserIDFetcher
+	balanceCreator        domain.BalanceEnsurer
+	balanceStatusChecker  domain.BalanceStatusChecker
+	teamsRepository       domain.TeamsRepository
+	teamBudgetLocker      domain.TeamBudgetLocker
+	teamMembershipChecker domain.TeamMembershipChecker
+	teamBudgetProceeder   domain.TeamBudgetProceeder
+	teamBudgetTopUpper    domain.TeamBudgetTopUpper
+	eventOutbox           domain.EventOutbox
+	auditRecorder         audit.Recorder
+}
+
+func NewTeamsCase(txManager database.TxManager,
+	userIDFetcher domain.UserIDFetcher,
+	balanceCreator domain.BalanceEnsurer,
+	balanceStatusChecker domain.BalanceStatusChecker,
+	teamsRepository domain.TeamsRepository,
+	teamBudgetLocker domain.TeamBudgetLocker,
+	teamMembershipChecker domain.TeamMembershipChecker,
+	teamBudgetProceeder domain.TeamBudgetProceeder,
+	teamBudgetTopUpper domain.TeamBudgetTopUpper,
+	eventOutbox domain.EventOutbox,
+	auditRecorder audit.Recorder) *TeamsCase {
+	return &TeamsCase{
+		txManager:             txManager,
+		userIDFetcher:         userIDFetcher,
+		balanceCreator:        balanceCreator,
+		balanceStatusChecker:  balanceStatusChecker,
+		teamsRepository:       teamsRepository,
+		teamBudgetLocker:      teamBudgetLocker,
+		teamMembershipChecker: teamMembershipChecker,
+		teamBudgetProceeder:   teamBudgetProceeder,
+		teamBudgetTopUpper:    teamBudgetTopUpper,
+		eventOutbox:           eventOutbox,
+		auditRecorder:         auditRecorder,
+	}
+}
+
+func (tc *TeamsCase) CreateTeam(ctx context.Context, name, managerUsername string) (int, error) {
+	if name == "" {
+		return 0, &domain.InvalidArgumentsError{Msg: "team name must not be empty"}
+	}
+
+	managerID, err := tc.fetchUserWithBalance(ctx, managerUsername)
+	if err != nil {
+		return 0, err
+	}
+
+	var teamID int
+	err = tc.txManager.WithinTransaction(ctx, func(ctx context.Context, executor database.QueryExecuter) error {
+		teamID, err = tc.teamsRepository.CreateTeam(ctx, executor, name, managerID)
+		if err != nil {
+			return err
+		}
+
+		err = tc.auditRecorder.RecordInTx(ctx, executor, audit.Event{
+			Action: audit.ActionTeamCreate,
+			Target: audit.TeamTarget(name),
+			After:  map[string]any{"teamID": teamID, "manager": managerUsername},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record audit event: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return teamID, nil
+}
+
+func (tc *TeamsCase) AddTeamMember(ctx context.Context, teamName, username string) error {
+	userID, err := tc.fetchUserWithBalance(ctx, username)
+	if err != nil {
+		return err
+	}
+
+	return tc.txManager.WithinTransaction(ctx, func(ctx context.Context, executor database.QueryExecuter) error {
+		err := tc.teamsRepository.AddTeamMember(ctx, executor, teamName, userID)
+		if err != nil {
+			return err
+		}
+
+		err = tc.auditRecorder.RecordInTx(ctx, executor, audit.Event{
+			Action: audit.ActionTeamMemberAdd,
+			Target: audit.TeamTarget(teamName),
+			After:  map[string]any{"member": username},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record audit event: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (tc *TeamsCase) SetBudgetTopUp(ctx context.Context, teamName string, amount uint32, period time.Duration) error {
+	if amount > 0 && period < time.Hour {
+		return &domain.InvalidArgumentsError{Msg: "top-up period must be at least one hour"}
+	}
+
+	return tc.txManager.WithinTransaction(ctx, func(ctx context.Context, executor database.QueryExecuter) error {
+		err := tc.teamsRepository.SetBudgetTopUp(ctx, executor, teamName, amount, period)
+		if err != nil {
+			return err
+		}
+
+		err = tc.auditRecorder.RecordInTx(ctx, executor, audit.Event{
+			Action: audit.ActionTeamTopUpSet,
+			Target: audit.TeamTarget(teamName),
+			After:  map[string]any{"amount": amount, "periodHours": int(period.Hours())},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record audit event: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// SendFromTeamBudget rewards a team member from the team budget. Only the team manager may spend it.
+func (tc *TeamsCase) SendFromTeamBudget(ctx context.Context, managerID int, teamName, toUsername string, amount uint32) error {
+	toUserID, err := tc.fetchUserWithBalance(ctx, toUsername)
+	if err != nil {
+		return err
+	}
+
+	return tc.txManager.WithinTransaction(ctx, func(ctx context.Context, executor database.QueryExecuter) error {
+		team, err := tc.teamBudgetLocker.LockAndGetTeam(ctx, executor, teamName)
+		if err != nil {
+			return fmt.Errorf("failed to lock and get team %s: %w", teamName, err)
+		}
+
+		if team.ManagerID != managerID {
+			return &domain.NotTeamManagerError{Msg: fmt.Sprintf("user %d is not the manager of team %s", managerID, teamName)}
+		}
+
+		if team.Budget < amount {
+			return &domain.InsufficientBalanceError{Msg: fmt.Sprintf("team %s has insufficient budget", teamName)}
+		}
+
+		isMember, err := tc.teamMembershipChecker.IsTeamMember(ctx, executor, team.Id, toUserID)
+		if err != nil {
+			return fmt.Errorf("failed to check membership of user %d: %w", toUserID, err)
+		}
+
+		if !isMember {
+			return &domain.NotTeamMemberError{Msg: fmt.Sprintf("user %s is not a member of team %s", toUsername, teamName)}
+		}
+
+		isActive, err := tc.balanceStatusChecker.IsBalanceActive(ctx, executor, toUserID)
+		if err != nil {
+			return fmt.Errorf("failed to check balance status for user %d: %w", toUserID, err)
+		}
+
+		if !isActive {
+			return &domain.UserDeactivatedError{Msg: fmt.Sprintf("user %s is deactivated", toUsername)}
+		}
+
+		err = checkNotFrozen(ctx, tc.balanceStatusChecker, executor, toUserID, fmt.Sprintf("recipient %s is frozen", toUsername))
+		if err != nil {
+			return err
+		}
+
+		err = tc.teamBudgetProceeder.ProceedTeamTransfer(ctx, executor, team.Id, amount, managerID, toUserID)
+		if err != nil {
+			return fmt.Errorf("failed to proceed team transfer: %w", err)
+		}
+
+		err = tc.eventOutbox.AppendEvent(ctx, executor, domain.CoinsSentEvent(managerID, toUserID, amount))
+		if err != nil {
+			return err
+		}
+
+		err = tc.auditRecorder.RecordInTx(ctx, executor, audit.Event{
+			ActorID: managerID,
+			Action:  audit.ActionTeamBudgetSend,
+			Target:  audit.TeamTarget(teamName),
+			Before:  map[string]any{"budget": team.Budget},
+			After:   map[string]any{"budget": team.Budget - amount, "recipient": toUsername, "amount": amount},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record audit event: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// TopUpBudgets credits the due team top-ups, recording each as a grant, and returns how many teams were topped up.
+func (tc *TeamsCase) TopUpBudgets(ctx context.Context) (int, error) {
+	toppedUp := 0
+	err := tc.txManager.WithinTransaction(ctx, func(ctx context.Context, executor database.QueryExecuter) error {
+		topUps, err := tc.teamBudgetTopUpper.TopUpDueBudgets(ctx, executor)
+		if err != nil {
+			return err
+		}
+
+		for _, topUp := range topUps {
+			err = tc.auditRecorder.RecordInTx(ctx, executor, audit.Event{
+				Action: audit.ActionGrant,
+				Target: audit.TeamTarget(topUp.TeamName),
+				After:  map[string]any{"amount": topUp.Amount},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to record audit event: %w", err)
+			}
+		}
+
+		toppedUp = len(topUps)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return toppedUp, nil
+}
+
+func (tc *TeamsCase) fetchUserWithBalance(ctx context.Context, username string) (int, error) {
+	userID, err := tc.userIDFetcher.FetchUserID(ctx, username)
+	if err != nil {
+		return 0, &domain.UserNotFoundError{Msg: fmt.Sprintf("user not found: %s", username)}
+	}
+
+	err = tc.balanceCreator.EnsureBalanceCreated(ctx, userID, domain.StartBalance)
+	if err != nil {
+		return 0, fmt.Errorf("failed to ensure balance for user %d: %w", userID, err)
+	}
+
+	return userID, nil
+}
@@ -0,0 +1,534 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	auditmocks "github.com/Lexv0lk/merch-store/gen/mocks/audit"
+	dbmocks "github.com/Lexv0lk/merch-store/gen/mocks/database"
+	storemocks "github.com/Lexv0lk/merch-store/gen/mocks/store"
+	"github.com/Lexv0lk/merch-store/internal/pkg/audit"
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTeamsCase_SendFromTeamBudget(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		txManager             *dbmocks.MockTxManager
+		userIDFetcher         *storemocks.MockUserIDFetcher
+		balanceCreator        *storemocks.MockBalanceEnsurer
+		balanceStatusChecker  *storemocks.MockBalanceStatusChecker
+		teamBudgetLocker      *storemocks.MockTeamBudgetLocker
+		teamMembershipChecker *storemocks.MockTeamMembershipChecker
+		teamBudgetProceeder   *storemocks.MockTeamBudgetProceeder
+		eventOutbox           *storemocks.MockEventOutbox
+		auditRecorder         *auditmocks.MockRecorder
+	}
+
+	type testCase struct {
+		name       string
+		managerID  int
+		teamName   string
+		toUsername string
+		amount     uint32
+
+		prepareFn func(t *testing.T, d *deps)
+
+		expectedErr error
+	}
+
+	executeTxFn := func(ctx context.Context, txFn database.TxFunc) error {
+		return txFn(ctx, nil)
+	}
+
+	team := domain.TeamInfo{Id: 7, Name: "platform", ManagerID: 1, Budget: 500}
+
+	tests := []testCase{
+		{
+			name:       "successful reward",
+			managerID:  1,
+			teamName:   "platform",
+			toUsername: "report",
+			amount:     100,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "report").Return(2, nil)
+				d.balanceCreator.EXPECT().EnsureBalanceCreated(gomock.Any(), 2, domain.StartBalance).Return(nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.teamBudgetLocker.EXPECT().LockAndGetTeam(gomock.Any(), nil, "platform").Return(team, nil)
+				d.teamMembershipChecker.EXPECT().IsTeamMember(gomock.Any(), nil, 7, 2).Return(true, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 2).Return(true, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 2).Return(false, nil)
+				d.teamBudgetProceeder.EXPECT().ProceedTeamTransfer(gomock.Any(), nil, 7, uint32(100), 1, 2).Return(nil)
+				d.eventOutbox.EXPECT().AppendEvent(gomock.Any(), nil, domain.CoinsSentEvent(1, 2, 100)).Return(nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, audit.Event{
+					ActorID: 1,
+					Action:  audit.ActionTeamBudgetSend,
+					Target:  "team:platform",
+					Before:  map[string]any{"budget": uint32(500)},
+					After:   map[string]any{"budget": uint32(400), "recipient": "report", "amount": uint32(100)},
+				}).Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:       "recipient not found",
+			managerID:  1,
+			teamName:   "platform",
+			toUsername: "ghost",
+			amount:     100,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "ghost").Return(0, assert.AnError)
+			},
+			expectedErr: &domain.UserNotFoundError{},
+		},
+		{
+			name:       "team not found",
+			managerID:  1,
+			teamName:   "unknown",
+			toUsername: "report",
+			amount:     100,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "report").Return(2, nil)
+				d.balanceCreator.EXPECT().EnsureBalanceCreated(gomock.Any(), 2, domain.StartBalance).Return(nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.teamBudgetLocker.EXPECT().LockAndGetTeam(gomock.Any(), nil, "unknown").
+					Return(domain.TeamInfo{}, &domain.TeamNotFoundError{})
+			},
+			expectedErr: &domain.TeamNotFoundError{},
+		},
+		{
+			name:       "caller is not the manager",
+			managerID:  3,
+			teamName:   "platform",
+			toUsername: "report",
+			amount:     100,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "report").Return(2, nil)
+				d.balanceCreator.EXPECT().EnsureBalanceCreated(gomock.Any(), 2, domain.StartBalance).Return(nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.teamBudgetLocker.EXPECT().LockAndGetTeam(gomock.Any(), nil, "platform").Return(team, nil)
+			},
+			expectedErr: &domain.NotTeamManagerError{},
+		},
+		{
+			name:       "insufficient team budget",
+			managerID:  1,
+			teamName:   "platform",
+			toUsername: "report",
+			amount:     1000,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "report").Return(2, nil)
+				d.balanceCreator.EXPECT().EnsureBalanceCreated(gomock.Any(), 2, domain.StartBalance).Return(nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.teamBudgetLocker.EXPECT().LockAndGetTeam(gomock.Any(), nil, "platform").Return(team, nil)
+			},
+			expectedErr: &domain.InsufficientBalanceError{},
+		},
+		{
+			name:       "recipient is not a team member",
+			managerID:  1,
+			teamName:   "platform",
+			toUsername: "outsider",
+			amount:     100,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "outsider").Return(5, nil)
+				d.balanceCreator.EXPECT().EnsureBalanceCreated(gomock.Any(), 5, domain.StartBalance).Return(nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.teamBudgetLocker.EXPECT().LockAndGetTeam(gomock.Any(), nil, "platform").Return(team, nil)
+				d.teamMembershipChecker.EXPECT().IsTeamMember(gomock.Any(), nil, 7, 5).Return(false, nil)
+			},
+			expectedErr: &domain.NotTeamMemberError{},
+		},
+		{
+			name:       "recipient deactivated",
+			managerID:  1,
+			teamName:   "platform",
+			toUsername: "leaver",
+			amount:     100,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "leaver").Return(4, nil)
+				d.balanceCreator.EXPECT().EnsureBalanceCreated(gomock.Any(), 4, domain.StartBalance).Return(nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.teamBudgetLocker.EXPECT().LockAndGetTeam(gomock.Any(), nil, "platform").Return(team, nil)
+				d.teamMembershipChecker.EXPECT().IsTeamMember(gomock.Any(), nil, 7, 4).Return(true, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 4).Return(false, nil)
+			},
+			expectedErr: &domain.UserDeactivatedError{},
+		},
+		{
+			name:       "proceed team transfer error",
+			managerID:  1,
+			teamName:   "platform",
+			toUsername: "report",
+			amount:     100,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "report").Return(2, nil)
+				d.balanceCreator.EXPECT().EnsureBalanceCreated(gomock.Any(), 2, domain.StartBalance).Return(nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.teamBudgetLocker.EXPECT().LockAndGetTeam(gomock.Any(), nil, "platform").Return(team, nil)
+				d.teamMembershipChecker.EXPECT().IsTeamMember(gomock.Any(), nil, 7, 2).Return(true, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceActive(gomock.Any(), nil, 2).Return(true, nil)
+				d.balanceStatusChecker.EXPECT().IsBalanceFrozen(gomock.Any(), nil, 2).Return(false, nil)
+				d.teamBudgetProceeder.EXPECT().ProceedTeamTransfer(gomock.Any(), nil, 7, uint32(100), 1, 2).Return(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := &deps{
+				txManager:             dbmocks.NewMockTxManager(ctrl),
+				userIDFetcher:         storemocks.NewMockUserIDFetcher(ctrl),
+				balanceCreator:        storemocks.NewMockBalanceEnsurer(ctrl),
+				balanceStatusChecker:  storemocks.NewMockBalanceStatusChecker(ctrl),
+				teamBudgetLocker:      storemocks.NewMockTeamBudgetLocker(ctrl),
+				teamMembershipChecker: storemocks.NewMockTeamMembershipChecker(ctrl),
+				teamBudgetProceeder:   storemocks.NewMockTeamBudgetProceeder(ctrl),
+				eventOutbox:           storemocks.NewMockEventOutbox(ctrl),
+				auditRecorder:         auditmocks.NewMockRecorder(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			teamsCase := NewTeamsCase(d.txManager, d.userIDFetcher, d.balanceCreator, d.balanceStatusChecker,
+				storemocks.NewMockTeamsRepository(ctrl), d.teamBudgetLocker, d.teamMembershipChecker,
+				d.teamBudgetProceeder, storemocks.NewMockTeamBudgetTopUpper(ctrl), d.eventOutbox, d.auditRecorder)
+			err := teamsCase.SendFromTeamBudget(t.Context(), tt.managerID, tt.teamName, tt.toUsername, tt.amount)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTeamsCase_CreateTeam(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		txManager       *dbmocks.MockTxManager
+		userIDFetcher   *storemocks.MockUserIDFetcher
+		balanceCreator  *storemocks.MockBalanceEnsurer
+		teamsRepository *storemocks.MockTeamsRepository
+		auditRecorder   *auditmocks.MockRecorder
+	}
+
+	type testCase struct {
+		name            string
+		teamName        string
+		managerUsername string
+
+		prepareFn func(t *testing.T, d *deps)
+
+		expectedID  int
+		expectedErr error
+	}
+
+	executeTxFn := func(ctx context.Context, txFn database.TxFunc) error {
+		return txFn(ctx, nil)
+	}
+
+	tests := []testCase{
+		{
+			name:            "team created",
+			teamName:        "platform",
+			managerUsername: "boss",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "boss").Return(1, nil)
+				d.balanceCreator.EXPECT().EnsureBalanceCreated(gomock.Any(), 1, domain.StartBalance).Return(nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.teamsRepository.EXPECT().CreateTeam(gomock.Any(), nil, "platform", 1).Return(7, nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, audit.Event{
+					Action: audit.ActionTeamCreate,
+					Target: "team:platform",
+					After:  map[string]any{"teamID": 7, "manager": "boss"},
+				}).Return(nil)
+			},
+			expectedID: 7,
+		},
+		{
+			name:            "audit record error",
+			teamName:        "platform",
+			managerUsername: "boss",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "boss").Return(1, nil)
+				d.balanceCreator.EXPECT().EnsureBalanceCreated(gomock.Any(), 1, domain.StartBalance).Return(nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.teamsRepository.EXPECT().CreateTeam(gomock.Any(), nil, "platform", 1).Return(7, nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, gomock.Any()).Return(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+		{
+			name:            "empty team name",
+			teamName:        "",
+			managerUsername: "boss",
+			prepareFn:       func(t *testing.T, d *deps) {},
+			expectedErr:     &domain.InvalidArgumentsError{},
+		},
+		{
+			name:            "manager not found",
+			teamName:        "platform",
+			managerUsername: "ghost",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "ghost").Return(0, assert.AnError)
+			},
+			expectedErr: &domain.UserNotFoundError{},
+		},
+		{
+			name:            "team already exists",
+			teamName:        "platform",
+			managerUsername: "boss",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "boss").Return(1, nil)
+				d.balanceCreator.EXPECT().EnsureBalanceCreated(gomock.Any(), 1, domain.StartBalance).Return(nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.teamsRepository.EXPECT().CreateTeam(gomock.Any(), nil, "platform", 1).Return(0, &domain.TeamExistingError{})
+			},
+			expectedErr: &domain.TeamExistingError{},
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := &deps{
+				txManager:       dbmocks.NewMockTxManager(ctrl),
+				userIDFetcher:   storemocks.NewMockUserIDFetcher(ctrl),
+				balanceCreator:  storemocks.NewMockBalanceEnsurer(ctrl),
+				teamsRepository: storemocks.NewMockTeamsRepository(ctrl),
+				auditRecorder:   auditmocks.NewMockRecorder(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			teamsCase := NewTeamsCase(d.txManager, d.userIDFetcher, d.balanceCreator,
+				storemocks.NewMockBalanceStatusChecker(ctrl), d.teamsRepository,
+				storemocks.NewMockTeamBudgetLocker(ctrl), storemocks.NewMockTeamMembershipChecker(ctrl),
+				storemocks.NewMockTeamBudgetProceeder(ctrl), storemocks.NewMockTeamBudgetTopUpper(ctrl),
+				storemocks.NewMockEventOutbox(ctrl), d.auditRecorder)
+			teamID, err := teamsCase.CreateTeam(t.Context(), tt.teamName, tt.managerUsername)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedID, teamID)
+			}
+		})
+	}
+}
+
+func TestTeamsCase_SetBudgetTopUp(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		txManager       *dbmocks.MockTxManager
+		teamsRepository *storemocks.MockTeamsRepository
+		auditRecorder   *auditmocks.MockRecorder
+	}
+
+	type testCase struct {
+		name     string
+		teamName string
+		amount   uint32
+		period   time.Duration
+
+		prepareFn func(t *testing.T, d *deps)
+
+		expectedErr error
+	}
+
+	executeTxFn := func(ctx context.Context, txFn database.TxFunc) error {
+		return txFn(ctx, nil)
+	}
+
+	tests := []testCase{
+		{
+			name:     "top-up configured",
+			teamName: "platform",
+			amount:   500,
+			period:   24 * time.Hour,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.teamsRepository.EXPECT().SetBudgetTopUp(gomock.Any(), nil, "platform", uint32(500), 24*time.Hour).Return(nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, audit.Event{
+					Action: audit.ActionTeamTopUpSet,
+					Target: "team:platform",
+					After:  map[string]any{"amount": uint32(500), "periodHours": 24},
+				}).Return(nil)
+			},
+		},
+		{
+			name:     "top-up disabled",
+			teamName: "platform",
+			amount:   0,
+			period:   0,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.teamsRepository.EXPECT().SetBudgetTopUp(gomock.Any(), nil, "platform", uint32(0), time.Duration(0)).Return(nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:        "period too short",
+			teamName:    "platform",
+			amount:      500,
+			period:      time.Minute,
+			prepareFn:   func(t *testing.T, d *deps) {},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:     "team not found",
+			teamName: "unknown",
+			amount:   500,
+			period:   24 * time.Hour,
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.teamsRepository.EXPECT().SetBudgetTopUp(gomock.Any(), nil, "unknown", uint32(500), 24*time.Hour).
+					Return(&domain.TeamNotFoundError{})
+			},
+			expectedErr: &domain.TeamNotFoundError{},
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := &deps{
+				txManager:       dbmocks.NewMockTxManager(ctrl),
+				teamsRepository: storemocks.NewMockTeamsRepository(ctrl),
+				auditRecorder:   auditmocks.NewMockRecorder(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			teamsCase := NewTeamsCase(d.txManager, storemocks.NewMockUserIDFetcher(ctrl),
+				storemocks.NewMockBalanceEnsurer(ctrl), storemocks.NewMockBalanceStatusChecker(ctrl), d.teamsRepository,
+				storemocks.NewMockTeamBudgetLocker(ctrl), storemocks.NewMockTeamMembershipChecker(ctrl),
+				storemocks.NewMockTeamBudgetProceeder(ctrl), storemocks.NewMockTeamBudgetTopUpper(ctrl),
+				storemocks.NewMockEventOutbox(ctrl), d.auditRecorder)
+			err := teamsCase.SetBudgetTopUp(t.Context(), tt.teamName, tt.amount, tt.period)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTeamsCase_TopUpBudgets(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		txManager     *dbmocks.MockTxManager
+		topUpper      *storemocks.MockTeamBudgetTopUpper
+		auditRecorder *auditmocks.MockRecorder
+	}
+
+	type testCase struct {
+		name string
+
+		prepareFn func(t *testing.T, d *deps)
+
+		expectedToppedUp int
+		expectedErr      error
+	}
+
+	executeTxFn := func(ctx context.Context, txFn database.TxFunc) error {
+		return txFn(ctx, nil)
+	}
+
+	tests := []testCase{
+		{
+			name: "top-ups recorded as grants",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.topUpper.EXPECT().TopUpDueBudgets(gomock.Any(), nil).
+					Return([]domain.TeamTopUp{{TeamName: "design", Amount: 200}, {TeamName: "platform", Amount: 500}}, nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, audit.Event{
+					Action: audit.ActionGrant,
+					Target: "team:design",
+					After:  map[string]any{"amount": uint32(200)},
+				}).Return(nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, audit.Event{
+					Action: audit.ActionGrant,
+					Target: "team:platform",
+					After:  map[string]any{"amount": uint32(500)},
+				}).Return(nil)
+			},
+			expectedToppedUp: 2,
+		},
+		{
+			name: "nothing due",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.topUpper.EXPECT().TopUpDueBudgets(gomock.Any(), nil).Return([]domain.TeamTopUp{}, nil)
+			},
+		},
+		{
+			name: "audit record error",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.topUpper.EXPECT().TopUpDueBudgets(gomock.Any(), nil).
+					Return([]domain.TeamTopUp{{TeamName: "design", Amount: 200}}, nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, gomock.Any()).Return(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := &deps{
+				txManager:     dbmocks.NewMockTxManager(ctrl),
+				topUpper:      storemocks.NewMockTeamBudgetTopUpper(ctrl),
+				auditRecorder: auditmocks.NewMockRecorder(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			teamsCase := NewTeamsCase(d.txManager, storemocks.NewMockUserIDFetcher(ctrl),
+				storemocks.NewMockBalanceEnsurer(ctrl), storemocks.NewMockBalanceStatusChecker(ctrl),
+				storemocks.NewMockTeamsRepository(ctrl), storemocks.NewMockTeamBudgetLocker(ctrl),
+				storemocks.NewMockTeamMembershipChecker(ctrl), storemocks.NewMockTeamBudgetProceeder(ctrl), d.topUpper,
+				storemocks.NewMockEventOutbox(ctrl), d.auditRecorder)
+			toppedUp, err := teamsCase.TopUpBudgets(t.Context())
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedToppedUp, toppedUp)
+			}
+		})
+	}
+}
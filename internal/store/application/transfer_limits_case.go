@@ -0,0 +1,77 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/audit"
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+)
+
+type TransferLimitsCase struct {
+	txManager      database.TxManager
+	userIDFetcher  domain.UserIDFetcher
+	balanceCreator domain.BalanceEnsurer
+	limitsRepo     domain.TransferLimitsRepository
+	auditRecorder  audit.Recorder
+}
+
+func NewTransferLimitsCase(txManager database.TxManager,
+	userIDFetcher domain.UserIDFetcher,
+	balanceCreator domain.BalanceEnsurer,
+	limitsRepo domain.TransferLimitsRepository,
+	auditRecorder audit.Recorder) *TransferLimitsCase {
+	return &TransferLimitsCase{
+		txManager:      txManager,
+		userIDFetcher:  userIDFetcher,
+		balanceCreator: balanceCreator,
+		limitsRepo:     limitsRepo,
+		auditRecorder:  auditRecorder,
+	}
+}
+
+// SetTransferLimits updates the default limits when username is empty and the user's override otherwise.
+func (tc *TransferLimitsCase) SetTransferLimits(ctx context.Context, username string, limits domain.TransferLimits) error {
+	userID := 0
+	if username != "" {
+		var err error
+		userID, err = tc.userIDFetcher.FetchUserID(ctx, username)
+		if err != nil {
+			return &domain.UserNotFoundError{Msg: fmt.Sprintf("user not found: %s", username)}
+		}
+
+		err = tc.balanceCreator.EnsureBalanceCreated(ctx, userID, domain.StartBalance)
+		if err != nil {
+			return fmt.Errorf("failed to ensure balance for user %d: %w", userID, err)
+		}
+	}
+
+	return tc.txManager.WithinTransaction(ctx, func(ctx context.Context, executor database.QueryExecuter) error {
+		var err error
+		if username == "" {
+			err = tc.limitsRepo.SetDefaultTransferLimits(ctx, executor, limits)
+		} else {
+			err = tc.limitsRepo.SetUserTransferLimits(ctx, executor, userID, limits)
+		}
+		if err != nil {
+			return err
+		}
+
+		err = tc.auditRecorder.RecordInTx(ctx, executor, audit.Event{
+			Action: audit.ActionTransferLimitsSet,
+			Target: audit.TransferLimitsTarget(username),
+			After: map[string]any{
+				"maxSingleTransfer":           limits.MaxSingleTransfer,
+				"dailyOutgoingCap":            limits.DailyOutgoingCap,
+				"maxTransfersPerHour":         limits.MaxTransfersPerHour,
+				"maxReceivedFromSenderPerDay": limits.MaxReceivedFromSenderPerDay,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record audit event: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,158 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	auditmocks "github.com/Lexv0lk/merch-store/gen/mocks/audit"
+	dbmocks "github.com/Lexv0lk/merch-store/gen/mocks/database"
+	storemocks "github.com/Lexv0lk/merch-store/gen/mocks/store"
+	"github.com/Lexv0lk/merch-store/internal/pkg/audit"
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransferLimitsCase_SetTransferLimits(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		txManager      *dbmocks.MockTxManager
+		userIDFetcher  *storemocks.MockUserIDFetcher
+		balanceCreator *storemocks.MockBalanceEnsurer
+		limitsRepo     *storemocks.MockTransferLimitsRepository
+		auditRecorder  *auditmocks.MockRecorder
+	}
+
+	type testCase struct {
+		name     string
+		username string
+
+		prepareFn func(t *testing.T, d *deps)
+
+		expectedErr error
+	}
+
+	limits := domain.TransferLimits{
+		MaxSingleTransfer:           300,
+		DailyOutgoingCap:            800,
+		MaxTransfersPerHour:         10,
+		MaxReceivedFromSenderPerDay: 0,
+	}
+
+	limitsEvent := func(target string) audit.Event {
+		return audit.Event{
+			Action: audit.ActionTransferLimitsSet,
+			Target: target,
+			After: map[string]any{
+				"maxSingleTransfer":           uint32(300),
+				"dailyOutgoingCap":            uint32(800),
+				"maxTransfersPerHour":         uint32(10),
+				"maxReceivedFromSenderPerDay": uint32(0),
+			},
+		}
+	}
+
+	executeTxFn := func(ctx context.Context, txFn database.TxFunc) error {
+		return txFn(ctx, nil)
+	}
+
+	tests := []testCase{
+		{
+			name:     "default limits set",
+			username: "",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.limitsRepo.EXPECT().SetDefaultTransferLimits(gomock.Any(), nil, limits).Return(nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, limitsEvent("limits:default")).Return(nil)
+			},
+		},
+		{
+			name:     "user limits set",
+			username: "bob",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "bob").Return(2, nil)
+				d.balanceCreator.EXPECT().EnsureBalanceCreated(gomock.Any(), 2, domain.StartBalance).Return(nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				gomock.InOrder(
+					d.limitsRepo.EXPECT().SetUserTransferLimits(gomock.Any(), nil, 2, limits).Return(nil),
+					d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, limitsEvent("limits:bob")).Return(nil),
+				)
+			},
+		},
+		{
+			name:     "unknown user",
+			username: "ghost",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "ghost").Return(0, assert.AnError)
+			},
+			expectedErr: &domain.UserNotFoundError{},
+		},
+		{
+			name:     "balance creation error",
+			username: "bob",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "bob").Return(2, nil)
+				d.balanceCreator.EXPECT().EnsureBalanceCreated(gomock.Any(), 2, domain.StartBalance).
+					Return(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+		{
+			name:     "repository error skips the audit entry",
+			username: "",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.limitsRepo.EXPECT().SetDefaultTransferLimits(gomock.Any(), nil, limits).Return(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+		{
+			name:     "audit record error rolls back the limits",
+			username: "bob",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.userIDFetcher.EXPECT().FetchUserID(gomock.Any(), "bob").Return(2, nil)
+				d.balanceCreator.EXPECT().EnsureBalanceCreated(gomock.Any(), 2, domain.StartBalance).Return(nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, txFn database.TxFunc) error {
+						err := txFn(ctx, nil)
+						assert.ErrorIs(t, err, assert.AnError)
+						return err
+					})
+				d.limitsRepo.EXPECT().SetUserTransferLimits(gomock.Any(), nil, 2, limits).Return(nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, gomock.Any()).Return(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := &deps{
+				txManager:      dbmocks.NewMockTxManager(ctrl),
+				userIDFetcher:  storemocks.NewMockUserIDFetcher(ctrl),
+				balanceCreator: storemocks.NewMockBalanceEnsurer(ctrl),
+				limitsRepo:     storemocks.NewMockTransferLimitsRepository(ctrl),
+				auditRecorder:  auditmocks.NewMockRecorder(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			transferLimitsCase := NewTransferLimitsCase(d.txManager, d.userIDFetcher, d.balanceCreator, d.limitsRepo,
+				d.auditRecorder)
+			err := transferLimitsCase.SetTransferLimits(t.Context(), tt.username, limits)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -22,12 +22,16 @@ func NewUserInfoCase(userRepository domain.UserInfoRepository, usernameGetter do
 	}
 }
 
-func (uic *UserInfoCase) GetUserInfo(ctx context.Context, userId int) (domain.TotalUserInfo, error) {
+// GetUserInfo collects the user's balance, inventory and histories. The wishlist is only fetched on request.
+func (uic *UserInfoCase) GetUserInfo(ctx context.Context, userId int, withWishlist bool) (domain.TotalUserInfo, error) {
 	group, groupCtx := errgroup.WithContext(ctx)
 
 	var mainInfo domain.MainUserInfo
 	var purchases map[domain.Good]uint32
+	var gifts []domain.NamedGift
 	var transfers domain.NamedTransferHistory
+	var itemTransfers domain.NamedItemTransferHistory
+	var wishlist []domain.WishlistItem
 
 	group.Go(func() error {
 		var err error
@@ -56,6 +60,16 @@ func (uic *UserInfoCase) GetUserInfo(ctx context.Context, userId int) (domain.To
 		return err
 	})
 
+	group.Go(func() error {
+		rawGifts, err := uic.userRepository.FetchUserGifts(groupCtx, userId)
+		if err != nil {
+			return err
+		}
+
+		gifts, err = convertToNamedGifts(groupCtx, rawGifts, uic.usernameGetter)
+		return err
+	})
+
 	group.Go(func() error {
 		rawTransfers, err := uic.userRepository.FetchUserCoinTransfers(groupCtx, userId)
 		if err != nil {
@@ -66,6 +80,24 @@ func (uic *UserInfoCase) GetUserInfo(ctx context.Context, userId int) (domain.To
 		return err
 	})
 
+	group.Go(func() error {
+		rawItemTransfers, err := uic.userRepository.FetchUserItemTransfers(groupCtx, userId)
+		if err != nil {
+			return err
+		}
+
+		itemTransfers, err = convertToNamedItemTransferHistory(groupCtx, rawItemTransfers, uic.usernameGetter)
+		return err
+	})
+
+	if withWishlist {
+		group.Go(func() error {
+			var err error
+			wishlist, err = uic.userRepository.FetchUserWishlist(groupCtx, userId)
+			return err
+		})
+	}
+
 	err := group.Wait()
 	if err != nil {
 		return domain.TotalUserInfo{}, err
@@ -75,7 +107,10 @@ func (uic *UserInfoCase) GetUserInfo(ctx context.Context, userId int) (domain.To
 		Username:            mainInfo.Username,
 		Balance:             mainInfo.Balance,
 		Goods:               purchases,
+		Gifts:               gifts,
 		CoinTransferHistory: transfers,
+		ItemTransferHistory: itemTransfers,
+		Wishlist:            wishlist,
 	}, nil
 }
 
@@ -107,6 +142,74 @@ func convertToNamedTransferHistory(ctx context.Context, tf domain.TransferHistor
 	return namedTF, nil
 }
 
+func convertToNamedGifts(ctx context.Context, gifts []domain.Gift, usernameGetter domain.UsernameGetter) ([]domain.NamedGift, error) {
+	if len(gifts) == 0 {
+		return []domain.NamedGift{}, nil
+	}
+
+	senderIDs := make([]int, 0, len(gifts))
+	for _, gift := range gifts {
+		senderIDs = append(senderIDs, gift.FromUserID)
+	}
+
+	usernames, err := usernameGetter.GetUsernames(ctx, senderIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	namedGifts := make([]domain.NamedGift, 0, len(gifts))
+	for _, gift := range gifts {
+		namedGifts = append(namedGifts, domain.NamedGift{
+			GoodName:     gift.GoodName,
+			FromUsername: usernames[gift.FromUserID],
+			Message:      gift.Message,
+		})
+	}
+
+	return namedGifts, nil
+}
+
+func convertToNamedItemTransferHistory(ctx context.Context, history domain.ItemTransferHistory,
+	usernameGetter domain.UsernameGetter) (domain.NamedItemTransferHistory, error) {
+	namedHistory := domain.NamedItemTransferHistory{
+		IncomingTransfers:  make([]domain.NamedItemTransfer, 0, len(history.IncomingTransfers)),
+		OutcomingTransfers: make([]domain.NamedItemTransfer, 0, len(history.OutcomingTransfers)),
+	}
+
+	if len(history.IncomingTransfers) == 0 && len(history.OutcomingTransfers) == 0 {
+		return namedHistory, nil
+	}
+
+	userIDs := make([]int, 0, len(history.IncomingTransfers)+len(history.OutcomingTransfers))
+	for _, transfer := range history.IncomingTransfers {
+		userIDs = append(userIDs, transfer.TargetID)
+	}
+	for _, transfer := range history.OutcomingTransfers {
+		userIDs = append(userIDs, transfer.TargetID)
+	}
+
+	usernames, err := usernameGetter.GetUsernames(ctx, userIDs...)
+	if err != nil {
+		return domain.NamedItemTransferHistory{}, err
+	}
+
+	for _, transfer := range history.IncomingTransfers {
+		namedHistory.IncomingTransfers = append(namedHistory.IncomingTransfers, domain.NamedItemTransfer{
+			TargetUsername: usernames[transfer.TargetID],
+			GoodName:       transfer.GoodName,
+		})
+	}
+
+	for _, transfer := range history.OutcomingTransfers {
+		namedHistory.OutcomingTransfers = append(namedHistory.OutcomingTransfers, domain.NamedItemTransfer{
+			TargetUsername: usernames[transfer.TargetID],
+			GoodName:       transfer.GoodName,
+		})
+	}
+
+	return namedHistory, nil
+}
+
 func extractUserIDs(tf domain.TransferHistory) []int {
 	userIDSet := make(map[int]struct{})
 	for _, transfer := range tf.IncomingTransfers {
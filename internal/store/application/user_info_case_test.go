@@ -15,8 +15,9 @@ func TestUserInfoCase_GetUserInfo(t *testing.T) {
 	t.Parallel()
 
 	type testCase struct {
-		name   string
-		userId int
+		name         string
+		userId       int
+		withWishlist bool
 
 		prepareFn func(t *testing.T, ctrl *gomock.Controller) (domain.UserInfoRepository, domain.UsernameGetter, logging.Logger)
 
@@ -39,6 +40,19 @@ func TestUserInfoCase_GetUserInfo(t *testing.T) {
 					{Name: "t-shirt"}: 2,
 					{Name: "cup"}:     1,
 				}, nil)
+				infoRepository.EXPECT().FetchUserGifts(gomock.Any(), 1).Return([]domain.Gift{
+					{GoodName: "cup", FromUserID: 30, Message: "happy birthday"},
+				}, nil)
+				usernameGetter.EXPECT().GetUsernames(gomock.Any(), 30).Return(map[int]string{30: "colleague"}, nil)
+				infoRepository.EXPECT().FetchUserItemTransfers(gomock.Any(), 1).Return(domain.ItemTransferHistory{
+					IncomingTransfers: []domain.ItemTransfer{
+						{TargetID: 40, GoodName: "pen"},
+					},
+					OutcomingTransfers: []domain.ItemTransfer{
+						{TargetID: 50, GoodName: "t-shirt"},
+					},
+				}, nil)
+				usernameGetter.EXPECT().GetUsernames(gomock.Any(), 40, 50).Return(map[int]string{40: "peer", 50: "newbie"}, nil)
 				infoRepository.EXPECT().FetchUserCoinTransfers(gomock.Any(), 1).Return(domain.TransferHistory{
 					IncomingTransfers: []domain.DirectTransfer{
 						{TargetID: 10, Amount: 50},
@@ -61,6 +75,9 @@ func TestUserInfoCase_GetUserInfo(t *testing.T) {
 					{Name: "t-shirt"}: 2,
 					{Name: "cup"}:     1,
 				},
+				Gifts: []domain.NamedGift{
+					{GoodName: "cup", FromUsername: "colleague", Message: "happy birthday"},
+				},
 				CoinTransferHistory: domain.NamedTransferHistory{
 					IncomingTransfers: []domain.NamedDirectTransfer{
 						{TargetUsername: "sender1", Amount: 50},
@@ -69,9 +86,57 @@ func TestUserInfoCase_GetUserInfo(t *testing.T) {
 						{TargetUsername: "receiver1", Amount: 100},
 					},
 				},
+				ItemTransferHistory: domain.NamedItemTransferHistory{
+					IncomingTransfers: []domain.NamedItemTransfer{
+						{TargetUsername: "peer", GoodName: "pen"},
+					},
+					OutcomingTransfers: []domain.NamedItemTransfer{
+						{TargetUsername: "newbie", GoodName: "t-shirt"},
+					},
+				},
 			},
 			expectedErr: nil,
 		},
+		{
+			name:         "wishlist on request",
+			userId:       2,
+			withWishlist: true,
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) (domain.UserInfoRepository, domain.UsernameGetter, logging.Logger) {
+				infoRepository := storemocks.NewMockUserInfoRepository(ctrl)
+				usernameGetter := storemocks.NewMockUsernameGetter(ctrl)
+				logger := loggingmocks.NewMockLogger(ctrl)
+
+				usernameGetter.EXPECT().GetUsername(gomock.Any(), 2).Return("newuser", nil)
+				infoRepository.EXPECT().FetchUserBalance(gomock.Any(), 2).Return(uint32(500), nil)
+				infoRepository.EXPECT().FetchUserPurchases(gomock.Any(), 2).Return(map[domain.Good]uint32{}, nil)
+				infoRepository.EXPECT().FetchUserGifts(gomock.Any(), 2).Return([]domain.Gift{}, nil)
+				infoRepository.EXPECT().FetchUserItemTransfers(gomock.Any(), 2).Return(domain.ItemTransferHistory{}, nil)
+				infoRepository.EXPECT().FetchUserCoinTransfers(gomock.Any(), 2).Return(domain.TransferHistory{}, nil)
+				infoRepository.EXPECT().FetchUserWishlist(gomock.Any(), 2).Return([]domain.WishlistItem{
+					{GoodName: "powerbank", Price: 200},
+				}, nil)
+				usernameGetter.EXPECT().GetUsernames(gomock.Any()).Return(map[int]string{}, nil)
+
+				return infoRepository, usernameGetter, logger
+			},
+			expectedUserInfo: domain.TotalUserInfo{
+				Username: "newuser",
+				Balance:  500,
+				Goods:    map[domain.Good]uint32{},
+				Gifts:    []domain.NamedGift{},
+				CoinTransferHistory: domain.NamedTransferHistory{
+					IncomingTransfers:  []domain.NamedDirectTransfer{},
+					OutcomingTransfers: []domain.NamedDirectTransfer{},
+				},
+				ItemTransferHistory: domain.NamedItemTransferHistory{
+					IncomingTransfers:  []domain.NamedItemTransfer{},
+					OutcomingTransfers: []domain.NamedItemTransfer{},
+				},
+				Wishlist: []domain.WishlistItem{
+					{GoodName: "powerbank", Price: 200},
+				},
+			},
+		},
 		{
 			name:   "user not found",
 			userId: 999,
@@ -82,6 +147,8 @@ func TestUserInfoCase_GetUserInfo(t *testing.T) {
 
 				usernameGetter.EXPECT().GetUsername(gomock.Any(), 999).Return("", &domain.UserNotFoundError{Msg: "user not found"})
 				infoRepository.EXPECT().FetchUserPurchases(gomock.Any(), 999).Return(nil, nil).AnyTimes()
+				infoRepository.EXPECT().FetchUserGifts(gomock.Any(), 999).Return([]domain.Gift{}, nil).AnyTimes()
+				infoRepository.EXPECT().FetchUserItemTransfers(gomock.Any(), 999).Return(domain.ItemTransferHistory{}, nil).AnyTimes()
 				infoRepository.EXPECT().FetchUserCoinTransfers(gomock.Any(), 999).Return(domain.TransferHistory{}, nil).AnyTimes()
 				usernameGetter.EXPECT().GetUsernames(gomock.Any(), gomock.Any()).Return(map[int]string{}, nil).AnyTimes()
 
@@ -101,6 +168,8 @@ func TestUserInfoCase_GetUserInfo(t *testing.T) {
 				usernameGetter.EXPECT().GetUsername(gomock.Any(), 1).Return("testuser", nil).AnyTimes()
 				infoRepository.EXPECT().FetchUserBalance(gomock.Any(), 1).Return(uint32(1000), nil).AnyTimes()
 				infoRepository.EXPECT().FetchUserPurchases(gomock.Any(), 1).Return(nil, assert.AnError)
+				infoRepository.EXPECT().FetchUserGifts(gomock.Any(), 1).Return([]domain.Gift{}, nil).AnyTimes()
+				infoRepository.EXPECT().FetchUserItemTransfers(gomock.Any(), 1).Return(domain.ItemTransferHistory{}, nil).AnyTimes()
 				infoRepository.EXPECT().FetchUserCoinTransfers(gomock.Any(), 1).Return(domain.TransferHistory{}, nil).AnyTimes()
 				usernameGetter.EXPECT().GetUsernames(gomock.Any(), gomock.Any()).Return(map[int]string{}, nil).AnyTimes()
 
@@ -120,6 +189,8 @@ func TestUserInfoCase_GetUserInfo(t *testing.T) {
 				usernameGetter.EXPECT().GetUsername(gomock.Any(), 1).Return("testuser", nil).AnyTimes()
 				infoRepository.EXPECT().FetchUserBalance(gomock.Any(), 1).Return(uint32(1000), nil).AnyTimes()
 				infoRepository.EXPECT().FetchUserPurchases(gomock.Any(), 1).Return(map[domain.Good]uint32{}, nil).AnyTimes()
+				infoRepository.EXPECT().FetchUserGifts(gomock.Any(), 1).Return([]domain.Gift{}, nil).AnyTimes()
+				infoRepository.EXPECT().FetchUserItemTransfers(gomock.Any(), 1).Return(domain.ItemTransferHistory{}, nil).AnyTimes()
 				infoRepository.EXPECT().FetchUserCoinTransfers(gomock.Any(), 1).Return(domain.TransferHistory{}, assert.AnError)
 
 				return infoRepository, usernameGetter, logger
@@ -138,6 +209,8 @@ func TestUserInfoCase_GetUserInfo(t *testing.T) {
 				usernameGetter.EXPECT().GetUsername(gomock.Any(), 2).Return("newuser", nil)
 				infoRepository.EXPECT().FetchUserBalance(gomock.Any(), 2).Return(uint32(500), nil)
 				infoRepository.EXPECT().FetchUserPurchases(gomock.Any(), 2).Return(map[domain.Good]uint32{}, nil)
+				infoRepository.EXPECT().FetchUserGifts(gomock.Any(), 2).Return([]domain.Gift{}, nil)
+				infoRepository.EXPECT().FetchUserItemTransfers(gomock.Any(), 2).Return(domain.ItemTransferHistory{}, nil)
 				infoRepository.EXPECT().FetchUserCoinTransfers(gomock.Any(), 2).Return(domain.TransferHistory{
 					IncomingTransfers:  []domain.DirectTransfer{},
 					OutcomingTransfers: []domain.DirectTransfer{},
@@ -150,10 +223,15 @@ func TestUserInfoCase_GetUserInfo(t *testing.T) {
 				Username: "newuser",
 				Balance:  500,
 				Goods:    map[domain.Good]uint32{},
+				Gifts:    []domain.NamedGift{},
 				CoinTransferHistory: domain.NamedTransferHistory{
 					IncomingTransfers:  []domain.NamedDirectTransfer{},
 					OutcomingTransfers: []domain.NamedDirectTransfer{},
 				},
+				ItemTransferHistory: domain.NamedItemTransferHistory{
+					IncomingTransfers:  []domain.NamedItemTransfer{},
+					OutcomingTransfers: []domain.NamedItemTransfer{},
+				},
 			},
 			expectedErr: nil,
 		},
@@ -169,7 +247,7 @@ func TestUserInfoCase_GetUserInfo(t *testing.T) {
 			infoFetcher, usernameGetter, logger := tt.prepareFn(t, ctrl)
 			userInfoCase := NewUserInfoCase(infoFetcher, usernameGetter, logger)
 
-			userInfo, err := userInfoCase.GetUserInfo(t.Context(), tt.userId)
+			userInfo, err := userInfoCase.GetUserInfo(t.Context(), tt.userId, tt.withWishlist)
 
 			if tt.expectedErr != nil {
 				assert.ErrorIs(t, err, tt.expectedErr)
@@ -0,0 +1,171 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/audit"
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/pkg/worker"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+)
+
+type WebhooksCase struct {
+	txManager           database.TxManager
+	endpointsRepository domain.WebhookEndpointsRepository
+	deliveryQueue       domain.WebhookDeliveryQueue
+	sender              domain.WebhookSender
+	auditRecorder       audit.Recorder
+}
+
+func NewWebhooksCase(txManager database.TxManager,
+	endpointsRepository domain.WebhookEndpointsRepository,
+	deliveryQueue domain.WebhookDeliveryQueue,
+	sender domain.WebhookSender,
+	auditRecorder audit.Recorder) *WebhooksCase {
+	return &WebhooksCase{
+		txManager:           txManager,
+		endpointsRepository: endpointsRepository,
+		deliveryQueue:       deliveryQueue,
+		sender:              sender,
+		auditRecorder:       auditRecorder,
+	}
+}
+
+// CreateWebhookEndpoint registers an endpoint for the given event types and returns it with its generated secret.
+// The secret isn't shown afterwards.
+func (wc *WebhooksCase) CreateWebhookEndpoint(ctx context.Context, url string, events []string) (domain.WebhookEndpoint, error) {
+	err := domain.ValidateWebhookURL(url)
+	if err != nil {
+		return domain.WebhookEndpoint{}, err
+	}
+
+	if len(events) == 0 {
+		return domain.WebhookEndpoint{}, &domain.InvalidArgumentsError{Msg: "at least one event is required"}
+	}
+
+	for _, event := range events {
+		if !domain.IsValidWebhookEvent(event) {
+			return domain.WebhookEndpoint{}, &domain.InvalidArgumentsError{Msg: fmt.Sprintf("unknown webhook event: %s", event)}
+		}
+	}
+
+	events = slices.Clone(events)
+	slices.Sort(events)
+	events = slices.Compact(events)
+
+	count, err := wc.endpointsRepository.CountWebhookEndpoints(ctx)
+	if err != nil {
+		return domain.WebhookEndpoint{}, err
+	}
+
+	if count >= domain.MaxWebhookEndpoints {
+		return domain.WebhookEndpoint{}, &domain.LimitExceededError{Msg: fmt.Sprintf("at most %d webhook endpoints are allowed", domain.MaxWebhookEndpoints)}
+	}
+
+	secret, err := domain.NewWebhookSecret()
+	if err != nil {
+		return domain.WebhookEndpoint{}, err
+	}
+
+	endpoint := domain.WebhookEndpoint{URL: url, Secret: secret, Events: events}
+	err = wc.txManager.WithinTransaction(ctx, func(ctx context.Context, executor database.QueryExecuter) error {
+		endpoint.Id, err = wc.endpointsRepository.CreateWebhookEndpoint(ctx, executor, endpoint)
+		if err != nil {
+			return err
+		}
+
+		err = wc.auditRecorder.RecordInTx(ctx, executor, audit.Event{
+			Action: audit.ActionWebhookCreate,
+			Target: audit.WebhookTarget(endpoint.Id),
+			After:  map[string]any{"url": url, "events": events},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record audit event: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return domain.WebhookEndpoint{}, err
+	}
+
+	return endpoint, nil
+}
+
+func (wc *WebhooksCase) ListWebhookEndpoints(ctx context.Context) ([]domain.WebhookEndpoint, error) {
+	return wc.endpointsRepository.ListWebhookEndpoints(ctx)
+}
+
+// DeleteWebhookEndpoint removes the endpoint and drops the deliveries still queued for it.
+func (wc *WebhooksCase) DeleteWebhookEndpoint(ctx context.Context, endpointID int) error {
+	return wc.txManager.WithinTransaction(ctx, func(ctx context.Context, executor database.QueryExecuter) error {
+		err := wc.endpointsRepository.DeleteWebhookEndpoint(ctx, executor, endpointID)
+		if err != nil {
+			return err
+		}
+
+		err = wc.auditRecorder.RecordInTx(ctx, executor, audit.Event{
+			Action: audit.ActionWebhookDelete,
+			Target: audit.WebhookTarget(endpointID),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record audit event: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// ListWebhookDeadLetters returns the latest deliveries that ran out of attempts.
+func (wc *WebhooksCase) ListWebhookDeadLetters(ctx context.Context) ([]domain.WebhookDelivery, error) {
+	return wc.endpointsRepository.ListWebhookDeadLetters(ctx, domain.DefaultDeadLetterLimit)
+}
+
+// DeliverDueWebhooks sends every delivery whose attempt is due and returns how many of them were delivered.
+func (wc *WebhooksCase) DeliverDueWebhooks(ctx context.Context) (int, error) {
+	return worker.ProcessDue(ctx, "webhook delivery", func(ctx context.Context) ([]int, error) {
+		return wc.deliveryQueue.FetchDueWebhookDeliveries(ctx, time.Now(), domain.WebhookDeliveryBatch)
+	}, wc.deliver)
+}
+
+// deliver sends a single delivery while holding its lock. A failed attempt is retried after WebhookRetryDelay,
+// the delivery moves to the dead letters once it has failed MaxWebhookAttempts times.
+func (wc *WebhooksCase) deliver(ctx context.Context, deliveryID int) (bool, error) {
+	delivered := false
+
+	err := wc.txManager.WithinTransaction(ctx, func(ctx context.Context, executor database.QueryExecuter) error {
+		delivery, err := wc.deliveryQueue.LockAndGetWebhookDelivery(ctx, executor, deliveryID)
+		if errors.Is(err, &domain.WebhookDeliveryNotFoundError{}) {
+			// The delivery was sent or is being sent by another store instance since it was fetched.
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		if delivery.NextAttemptAt.After(now) {
+			return nil
+		}
+
+		sendErr := wc.sender.SendWebhook(ctx, delivery)
+		if sendErr == nil {
+			delivered = true
+			return wc.deliveryQueue.CompleteWebhookDelivery(ctx, executor, delivery.Id)
+		}
+
+		delivery.Attempts++
+		delivery.LastError = sendErr.Error()
+		if delivery.Attempts >= domain.MaxWebhookAttempts {
+			return wc.deliveryQueue.MoveWebhookToDeadLetters(ctx, executor, delivery)
+		}
+
+		delivery.NextAttemptAt = now.Add(domain.WebhookRetryDelay(delivery.Attempts))
+		return wc.deliveryQueue.ScheduleWebhookRetry(ctx, executor, delivery)
+	})
+
+	return delivered, err
+}
@@ -0,0 +1,355 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	auditmocks "github.com/Lexv0lk/merch-store/gen/mocks/audit"
+	dbmocks "github.com/Lexv0lk/merch-store/gen/mocks/database"
+	storemocks "github.com/Lexv0lk/merch-store/gen/mocks/store"
+	"github.com/Lexv0lk/merch-store/internal/pkg/audit"
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhooksCase_CreateWebhookEndpoint(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		txManager           *dbmocks.MockTxManager
+		endpointsRepository *storemocks.MockWebhookEndpointsRepository
+		auditRecorder       *auditmocks.MockRecorder
+	}
+
+	const url = "https://warehouse.example.com/hooks/merch"
+
+	executeTxFn := func(ctx context.Context, txFn database.TxFunc) error {
+		return txFn(ctx, nil)
+	}
+
+	type testCase struct {
+		name   string
+		url    string
+		events []string
+
+		prepareFn func(t *testing.T, d *deps)
+
+		expectedErr error
+	}
+
+	tests := []testCase{
+		{
+			name:   "endpoint created with deduplicated events",
+			url:    url,
+			events: []string{domain.WebhookEventPurchase, domain.WebhookEventGift, domain.WebhookEventPurchase},
+			prepareFn: func(t *testing.T, d *deps) {
+				d.endpointsRepository.EXPECT().CountWebhookEndpoints(gomock.Any()).Return(1, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.endpointsRepository.EXPECT().CreateWebhookEndpoint(gomock.Any(), nil, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ database.Querier, endpoint domain.WebhookEndpoint) (int, error) {
+						assert.Equal(t, url, endpoint.URL)
+						assert.Equal(t, []string{domain.WebhookEventGift, domain.WebhookEventPurchase}, endpoint.Events)
+						assert.Len(t, endpoint.Secret, 64)
+						return 2, nil
+					})
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ database.Executor, event audit.Event) error {
+						assert.Equal(t, audit.ActionWebhookCreate, event.Action)
+						assert.Equal(t, "webhook:2", event.Target)
+						assert.NotContains(t, event.After, "secret")
+						return nil
+					})
+			},
+		},
+		{
+			name:        "invalid url",
+			url:         "warehouse.example.com",
+			events:      []string{domain.WebhookEventPurchase},
+			prepareFn:   func(t *testing.T, d *deps) {},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:        "no events",
+			url:         url,
+			prepareFn:   func(t *testing.T, d *deps) {},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:        "unknown event",
+			url:         url,
+			events:      []string{"refund"},
+			prepareFn:   func(t *testing.T, d *deps) {},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+		{
+			name:   "too many endpoints",
+			url:    url,
+			events: []string{domain.WebhookEventPurchase},
+			prepareFn: func(t *testing.T, d *deps) {
+				d.endpointsRepository.EXPECT().CountWebhookEndpoints(gomock.Any()).Return(domain.MaxWebhookEndpoints, nil)
+			},
+			expectedErr: &domain.LimitExceededError{},
+		},
+		{
+			name:   "audit failure",
+			url:    url,
+			events: []string{domain.WebhookEventPurchase},
+			prepareFn: func(t *testing.T, d *deps) {
+				d.endpointsRepository.EXPECT().CountWebhookEndpoints(gomock.Any()).Return(1, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.endpointsRepository.EXPECT().CreateWebhookEndpoint(gomock.Any(), nil, gomock.Any()).Return(2, nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, gomock.Any()).Return(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			d := &deps{
+				txManager:           dbmocks.NewMockTxManager(ctrl),
+				endpointsRepository: storemocks.NewMockWebhookEndpointsRepository(ctrl),
+				auditRecorder:       auditmocks.NewMockRecorder(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			webhooksCase := NewWebhooksCase(d.txManager, d.endpointsRepository,
+				storemocks.NewMockWebhookDeliveryQueue(ctrl), storemocks.NewMockWebhookSender(ctrl), d.auditRecorder)
+			endpoint, err := webhooksCase.CreateWebhookEndpoint(t.Context(), tt.url, tt.events)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, 2, endpoint.Id)
+				assert.NotEmpty(t, endpoint.Secret)
+			}
+		})
+	}
+}
+
+func TestWebhooksCase_DeleteWebhookEndpoint(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		txManager           *dbmocks.MockTxManager
+		endpointsRepository *storemocks.MockWebhookEndpointsRepository
+		auditRecorder       *auditmocks.MockRecorder
+	}
+
+	type testCase struct {
+		name string
+
+		prepareFn func(t *testing.T, d *deps)
+
+		expectedErr error
+	}
+
+	executeTxFn := func(ctx context.Context, txFn database.TxFunc) error {
+		return txFn(ctx, nil)
+	}
+
+	tests := []testCase{
+		{
+			name: "endpoint deleted",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.endpointsRepository.EXPECT().DeleteWebhookEndpoint(gomock.Any(), nil, 2).Return(nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ database.Executor, event audit.Event) error {
+						assert.Equal(t, audit.ActionWebhookDelete, event.Action)
+						assert.Equal(t, "webhook:2", event.Target)
+						return nil
+					})
+			},
+		},
+		{
+			name: "unknown endpoint",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.endpointsRepository.EXPECT().DeleteWebhookEndpoint(gomock.Any(), nil, 2).
+					Return(&domain.WebhookEndpointNotFoundError{})
+			},
+			expectedErr: &domain.WebhookEndpointNotFoundError{},
+		},
+		{
+			name: "audit failure",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.endpointsRepository.EXPECT().DeleteWebhookEndpoint(gomock.Any(), nil, 2).Return(nil)
+				d.auditRecorder.EXPECT().RecordInTx(gomock.Any(), nil, gomock.Any()).Return(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			d := &deps{
+				txManager:           dbmocks.NewMockTxManager(ctrl),
+				endpointsRepository: storemocks.NewMockWebhookEndpointsRepository(ctrl),
+				auditRecorder:       auditmocks.NewMockRecorder(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			webhooksCase := NewWebhooksCase(d.txManager, d.endpointsRepository,
+				storemocks.NewMockWebhookDeliveryQueue(ctrl), storemocks.NewMockWebhookSender(ctrl), d.auditRecorder)
+			err := webhooksCase.DeleteWebhookEndpoint(t.Context(), 2)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestWebhooksCase_DeliverDueWebhooks(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		txManager     *dbmocks.MockTxManager
+		deliveryQueue *storemocks.MockWebhookDeliveryQueue
+		sender        *storemocks.MockWebhookSender
+	}
+
+	type testCase struct {
+		name string
+
+		prepareFn func(t *testing.T, d *deps)
+
+		expectedDelivered int
+		expectedErr       bool
+	}
+
+	executeTxFn := func(ctx context.Context, txFn database.TxFunc) error {
+		return txFn(ctx, nil)
+	}
+
+	due := time.Now().Add(-time.Minute).Truncate(time.Second)
+	delivery := domain.WebhookDelivery{Id: 7, EndpointID: 2, URL: "https://warehouse.example.com/hooks",
+		Secret: "secret", Event: domain.WebhookEventPurchase, Data: map[string]any{"userId": float64(1)},
+		NextAttemptAt: due, CreatedAt: due}
+
+	tests := []testCase{
+		{
+			name: "webhook delivered",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.deliveryQueue.EXPECT().FetchDueWebhookDeliveries(gomock.Any(), gomock.Any(), domain.WebhookDeliveryBatch).
+					Return([]int{7}, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.deliveryQueue.EXPECT().LockAndGetWebhookDelivery(gomock.Any(), nil, 7).Return(delivery, nil)
+				d.sender.EXPECT().SendWebhook(gomock.Any(), delivery).Return(nil)
+				d.deliveryQueue.EXPECT().CompleteWebhookDelivery(gomock.Any(), nil, 7).Return(nil)
+			},
+			expectedDelivered: 1,
+		},
+		{
+			name: "failed attempt is retried with backoff",
+			prepareFn: func(t *testing.T, d *deps) {
+				retried := delivery
+				retried.Attempts = 2
+
+				d.deliveryQueue.EXPECT().FetchDueWebhookDeliveries(gomock.Any(), gomock.Any(), domain.WebhookDeliveryBatch).
+					Return([]int{7}, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.deliveryQueue.EXPECT().LockAndGetWebhookDelivery(gomock.Any(), nil, 7).Return(retried, nil)
+				d.sender.EXPECT().SendWebhook(gomock.Any(), retried).Return(errors.New("endpoint responded with status 503"))
+				d.deliveryQueue.EXPECT().ScheduleWebhookRetry(gomock.Any(), nil, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ database.Executor, scheduled domain.WebhookDelivery) error {
+						assert.Equal(t, 3, scheduled.Attempts)
+						assert.Equal(t, "endpoint responded with status 503", scheduled.LastError)
+						assert.WithinDuration(t, time.Now().Add(4*time.Minute), scheduled.NextAttemptAt, time.Minute)
+						return nil
+					})
+			},
+		},
+		{
+			name: "last failed attempt moves the delivery to dead letters",
+			prepareFn: func(t *testing.T, d *deps) {
+				exhausted := delivery
+				exhausted.Attempts = domain.MaxWebhookAttempts - 1
+
+				d.deliveryQueue.EXPECT().FetchDueWebhookDeliveries(gomock.Any(), gomock.Any(), domain.WebhookDeliveryBatch).
+					Return([]int{7}, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.deliveryQueue.EXPECT().LockAndGetWebhookDelivery(gomock.Any(), nil, 7).Return(exhausted, nil)
+				d.sender.EXPECT().SendWebhook(gomock.Any(), exhausted).Return(errors.New("connection refused"))
+				d.deliveryQueue.EXPECT().MoveWebhookToDeadLetters(gomock.Any(), nil, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ database.Executor, dead domain.WebhookDelivery) error {
+						assert.Equal(t, domain.MaxWebhookAttempts, dead.Attempts)
+						assert.Equal(t, "connection refused", dead.LastError)
+						return nil
+					})
+			},
+		},
+		{
+			name: "delivery taken by another instance is skipped",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.deliveryQueue.EXPECT().FetchDueWebhookDeliveries(gomock.Any(), gomock.Any(), domain.WebhookDeliveryBatch).
+					Return([]int{7}, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.deliveryQueue.EXPECT().LockAndGetWebhookDelivery(gomock.Any(), nil, 7).
+					Return(domain.WebhookDelivery{}, &domain.WebhookDeliveryNotFoundError{})
+			},
+		},
+		{
+			name: "internal error does not stop other deliveries",
+			prepareFn: func(t *testing.T, d *deps) {
+				other := delivery
+				other.Id = 8
+
+				d.deliveryQueue.EXPECT().FetchDueWebhookDeliveries(gomock.Any(), gomock.Any(), domain.WebhookDeliveryBatch).
+					Return([]int{7, 8}, nil)
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn).Times(2)
+				d.deliveryQueue.EXPECT().LockAndGetWebhookDelivery(gomock.Any(), nil, 7).
+					Return(domain.WebhookDelivery{}, errors.New("db error"))
+				d.deliveryQueue.EXPECT().LockAndGetWebhookDelivery(gomock.Any(), nil, 8).Return(other, nil)
+				d.sender.EXPECT().SendWebhook(gomock.Any(), other).Return(nil)
+				d.deliveryQueue.EXPECT().CompleteWebhookDelivery(gomock.Any(), nil, 8).Return(nil)
+			},
+			expectedDelivered: 1,
+			expectedErr:       true,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			d := &deps{
+				txManager:     dbmocks.NewMockTxManager(ctrl),
+				deliveryQueue: storemocks.NewMockWebhookDeliveryQueue(ctrl),
+				sender:        storemocks.NewMockWebhookSender(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			webhooksCase := NewWebhooksCase(d.txManager, storemocks.NewMockWebhookEndpointsRepository(ctrl),
+				d.deliveryQueue, d.sender, auditmocks.NewMockRecorder(ctrl))
+			delivered, err := webhooksCase.DeliverDueWebhooks(t.Context())
+
+			if tt.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedDelivered, delivered)
+		})
+	}
+}
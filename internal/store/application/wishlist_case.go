@@ -0,0 +1,114 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+)
+
+type WishlistCase struct {
+	txManager          database.TxManager
+	goodsRepository    domain.GoodsRepository
+	userRepository     domain.UserInfoRepository
+	wishlistRepository domain.WishlistRepository
+	priceWatcher       domain.WishlistPriceWatcher
+}
+
+func NewWishlistCase(txManager database.TxManager,
+	goodsRepository domain.GoodsRepository,
+	userRepository domain.UserInfoRepository,
+	wishlistRepository domain.WishlistRepository,
+	priceWatcher domain.WishlistPriceWatcher) *WishlistCase {
+	return &WishlistCase{
+		txManager:          txManager,
+		goodsRepository:    goodsRepository,
+		userRepository:     userRepository,
+		wishlistRepository: wishlistRepository,
+		priceWatcher:       priceWatcher,
+	}
+}
+
+func (wc *WishlistCase) AddItem(ctx context.Context, userID int, goodName string) error {
+	goodInfo, err := wc.goodsRepository.GetGoodInfo(ctx, goodName)
+	if err != nil {
+		return fmt.Errorf("failed to get good info: %w", err)
+	}
+
+	count, err := wc.wishlistRepository.CountWishlistItems(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if count >= domain.MaxWishlistSize {
+		return &domain.InvalidArgumentsError{Msg: fmt.Sprintf("wishlist must not hold more than %d items", domain.MaxWishlistSize)}
+	}
+
+	return wc.wishlistRepository.AddWishlistItem(ctx, userID, goodInfo)
+}
+
+func (wc *WishlistCase) RemoveItem(ctx context.Context, userID int, goodName string) error {
+	goodInfo, err := wc.goodsRepository.GetGoodInfo(ctx, goodName)
+	if err != nil {
+		return fmt.Errorf("failed to get good info: %w", err)
+	}
+
+	return wc.wishlistRepository.RemoveWishlistItem(ctx, userID, goodInfo.Id)
+}
+
+// ListItems returns the wished goods together with the user's balance, so callers can tell how many coins
+// are still needed for each of them.
+func (wc *WishlistCase) ListItems(ctx context.Context, userID int) ([]domain.WishlistItem, uint32, error) {
+	balance, err := wc.userRepository.FetchUserBalance(ctx, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	items, err := wc.userRepository.FetchUserWishlist(ctx, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return items, balance, nil
+}
+
+func (wc *WishlistCase) ListEvents(ctx context.Context, userID int) ([]domain.WishlistEvent, error) {
+	return wc.wishlistRepository.ListWishlistEvents(ctx, userID, domain.WishlistEventsLimit)
+}
+
+// WatchPrices raises a price-drop event for every wishlist entry whose good got cheaper since it was last seen
+// and returns how many events were raised. Price increases only move the seen price up.
+func (wc *WishlistCase) WatchPrices(ctx context.Context) (int, error) {
+	drops := 0
+
+	err := wc.txManager.WithinTransaction(ctx, func(ctx context.Context, executor database.QueryExecuter) error {
+		changes, err := wc.priceWatcher.FetchPriceChanges(ctx, executor, domain.WishlistWatchBatch)
+		if err != nil {
+			return err
+		}
+
+		for _, change := range changes {
+			if change.IsDrop() {
+				err = wc.priceWatcher.RecordWishlistEvent(ctx, executor, change, domain.WishlistEventPriceDrop)
+				if err != nil {
+					return err
+				}
+
+				drops++
+			}
+
+			err = wc.priceWatcher.UpdateSeenPrice(ctx, executor, change)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return drops, nil
+}
@@ -0,0 +1,172 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	dbmocks "github.com/Lexv0lk/merch-store/gen/mocks/database"
+	storemocks "github.com/Lexv0lk/merch-store/gen/mocks/store"
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWishlistCase_AddItem(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		goodsRepository    *storemocks.MockGoodsRepository
+		wishlistRepository *storemocks.MockWishlistRepository
+	}
+
+	type testCase struct {
+		name string
+
+		prepareFn func(t *testing.T, d *deps)
+
+		expectedErr error
+	}
+
+	powerbank := domain.GoodInfo{Id: 5, Name: "powerbank", Price: 200}
+
+	tests := []testCase{
+		{
+			name: "item added",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "powerbank").Return(powerbank, nil)
+				d.wishlistRepository.EXPECT().CountWishlistItems(gomock.Any(), 1).Return(3, nil)
+				d.wishlistRepository.EXPECT().AddWishlistItem(gomock.Any(), 1, powerbank).Return(nil)
+			},
+		},
+		{
+			name: "unknown good",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "powerbank").Return(domain.GoodInfo{}, &domain.GoodNotFoundError{})
+			},
+			expectedErr: &domain.GoodNotFoundError{},
+		},
+		{
+			name: "wishlist full",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.goodsRepository.EXPECT().GetGoodInfo(gomock.Any(), "powerbank").Return(powerbank, nil)
+				d.wishlistRepository.EXPECT().CountWishlistItems(gomock.Any(), 1).Return(domain.MaxWishlistSize, nil)
+			},
+			expectedErr: &domain.InvalidArgumentsError{},
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := &deps{
+				goodsRepository:    storemocks.NewMockGoodsRepository(ctrl),
+				wishlistRepository: storemocks.NewMockWishlistRepository(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			wishlistCase := NewWishlistCase(dbmocks.NewMockTxManager(ctrl), d.goodsRepository,
+				storemocks.NewMockUserInfoRepository(ctrl), d.wishlistRepository,
+				storemocks.NewMockWishlistPriceWatcher(ctrl))
+			err := wishlistCase.AddItem(t.Context(), 1, "powerbank")
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestWishlistCase_WatchPrices(t *testing.T) {
+	t.Parallel()
+
+	type deps struct {
+		txManager    *dbmocks.MockTxManager
+		priceWatcher *storemocks.MockWishlistPriceWatcher
+	}
+
+	type testCase struct {
+		name string
+
+		prepareFn func(t *testing.T, d *deps)
+
+		expectedDrops int
+		expectedErr   error
+	}
+
+	executeTxFn := func(ctx context.Context, txFn database.TxFunc) error {
+		return txFn(ctx, nil)
+	}
+
+	drop := domain.WishlistPriceChange{UserID: 1, GoodID: 5, OldPrice: 200, NewPrice: 150}
+	rise := domain.WishlistPriceChange{UserID: 2, GoodID: 5, OldPrice: 100, NewPrice: 150}
+
+	tests := []testCase{
+		{
+			name: "only drops raise events",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.priceWatcher.EXPECT().FetchPriceChanges(gomock.Any(), nil, domain.WishlistWatchBatch).
+					Return([]domain.WishlistPriceChange{drop, rise}, nil)
+				d.priceWatcher.EXPECT().RecordWishlistEvent(gomock.Any(), nil, drop, domain.WishlistEventPriceDrop).Return(nil)
+				d.priceWatcher.EXPECT().UpdateSeenPrice(gomock.Any(), nil, drop).Return(nil)
+				d.priceWatcher.EXPECT().UpdateSeenPrice(gomock.Any(), nil, rise).Return(nil)
+			},
+			expectedDrops: 1,
+		},
+		{
+			name: "no price changes",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.priceWatcher.EXPECT().FetchPriceChanges(gomock.Any(), nil, domain.WishlistWatchBatch).
+					Return([]domain.WishlistPriceChange{}, nil)
+			},
+			expectedDrops: 0,
+		},
+		{
+			name: "failed to record event",
+			prepareFn: func(t *testing.T, d *deps) {
+				d.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(executeTxFn)
+				d.priceWatcher.EXPECT().FetchPriceChanges(gomock.Any(), nil, domain.WishlistWatchBatch).
+					Return([]domain.WishlistPriceChange{drop}, nil)
+				d.priceWatcher.EXPECT().RecordWishlistEvent(gomock.Any(), nil, drop, domain.WishlistEventPriceDrop).
+					Return(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := &deps{
+				txManager:    dbmocks.NewMockTxManager(ctrl),
+				priceWatcher: storemocks.NewMockWishlistPriceWatcher(ctrl),
+			}
+
+			tt.prepareFn(t, d)
+
+			wishlistCase := NewWishlistCase(d.txManager, storemocks.NewMockGoodsRepository(ctrl),
+				storemocks.NewMockUserInfoRepository(ctrl), storemocks.NewMockWishlistRepository(ctrl), d.priceWatcher)
+			drops, err := wishlistCase.WatchPrices(t.Context())
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedDrops, drops)
+		})
+	}
+}
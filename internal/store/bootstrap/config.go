@@ -3,8 +3,11 @@ package bootstrap
 import "github.com/Lexv0lk/merch-store/internal/pkg/database"
 
 type StoreConfig struct {
-	DbSettings   database.PostgresSettings
-	JwtSecret    string
-	GrpcAuthHost string
-	GrpcAuthPort string
+	DbSettings            database.PostgresSettings
+	JwtSecret             string
+	GrpcAuthHost          string
+	GrpcAuthPort          string
+	MarketplaceFeePercent uint32
+	EventSink             string
+	EventSinkTarget       string
 }
@@ -4,20 +4,40 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"time"
 
 	merchapi "github.com/Lexv0lk/merch-store/gen/merch/v1"
+	"github.com/Lexv0lk/merch-store/internal/pkg/audit"
 	"github.com/Lexv0lk/merch-store/internal/pkg/database"
 	"github.com/Lexv0lk/merch-store/internal/pkg/jwt"
 	"github.com/Lexv0lk/merch-store/internal/pkg/logging"
+	"github.com/Lexv0lk/merch-store/internal/pkg/worker"
 	"github.com/Lexv0lk/merch-store/internal/store/application"
 	"github.com/Lexv0lk/merch-store/internal/store/domain"
 	grpcwrap "github.com/Lexv0lk/merch-store/internal/store/grpc"
+	"github.com/Lexv0lk/merch-store/internal/store/infrastructure/events"
 	"github.com/Lexv0lk/merch-store/internal/store/infrastructure/postgres"
+	"github.com/Lexv0lk/merch-store/internal/store/infrastructure/webhooks"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
+const (
+	teamTopUpInterval          = time.Minute
+	fraudAnalysisInterval      = 5 * time.Minute
+	scheduledTransfersInterval = time.Minute
+	auctionSettlementInterval  = time.Minute
+	raffleDrawInterval         = time.Minute
+	wishlistPriceWatchInterval = 5 * time.Minute
+	webhookDeliveryInterval    = 15 * time.Second
+	eventRelayInterval         = time.Second
+	notificationsPollInterval  = time.Second
+
+	webhookSendTimeout = 10 * time.Second
+	shutdownTimeout    = 5 * time.Second
+)
+
 type StoreApp struct {
 	cfg    StoreConfig
 	logger logging.Logger
@@ -37,12 +57,19 @@ func (a *StoreApp) Run(ctx context.Context, grpcLis net.Listener) error {
 	logger := a.logger
 	dbURL := a.cfg.DbSettings.GetURL()
 
+	eventSink, err := events.NewSink(a.cfg.EventSink, a.cfg.EventSinkTarget)
+	if err != nil {
+		return fmt.Errorf("failed to create event sink: %w", err)
+	}
+
 	dbpool, err := pgxpool.New(ctx, dbURL)
 	if err != nil {
 		return fmt.Errorf("failed to connect to database: %w", err)
 	}
 
-	grpcAuthConn, err := grpc.NewClient(a.cfg.GrpcAuthHost+a.cfg.GrpcAuthPort, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	grpcAuthConn, err := grpc.NewClient(a.cfg.GrpcAuthHost+a.cfg.GrpcAuthPort,
+		grpc.WithUnaryInterceptor(grpcwrap.NewJWTTokenInterceptor),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
 		return fmt.Errorf("failed to connect to auth grpc server: %w", err)
 	}
@@ -58,22 +85,176 @@ func (a *StoreApp) Run(ctx context.Context, grpcLis net.Listener) error {
 	balancesRepository := postgres.NewBalancesRepository(dbpool)
 	userInfoRepository := postgres.NewUserInfoRepository(dbpool, logger)
 	transactionProceeder := postgres.NewTransactionProceeder()
+	itemTransferProceeder := postgres.NewItemTransferProceeder()
+	companyPool := postgres.NewCompanyPoolRepository()
+	teamsRepository := postgres.NewTeamsRepository(dbpool)
+	teamBudgetProceeder := postgres.NewTeamBudgetProceeder()
+	transferLimitsRepository := postgres.NewTransferLimitsRepository()
+	fraudRepository := postgres.NewFraudRepository(dbpool)
+	auditLog := audit.NewPostgresLog(dbpool)
+	paymentRequestsRepository := postgres.NewPaymentRequestsRepository(dbpool)
+	scheduledTransfersRepository := postgres.NewScheduledTransfersRepository(dbpool)
+	listingsRepository := postgres.NewListingsRepository(dbpool)
+	inventoryRepository := postgres.NewInventoryRepository()
+	auctionsRepository := postgres.NewAuctionsRepository(dbpool)
+	rafflesRepository := postgres.NewRafflesRepository(dbpool)
+	wishlistRepository := postgres.NewWishlistRepository(dbpool)
+	promoCodesRepository := postgres.NewPromoCodesRepository(dbpool)
+	priceSchedulesRepository := postgres.NewPriceSchedulesRepository(dbpool)
+	variantsRepository := postgres.NewVariantsRepository(dbpool)
+	categoriesRepository := postgres.NewCategoriesRepository(dbpool)
+	bundlesRepository := postgres.NewBundlesRepository(dbpool)
+	purchaseLimitsRepository := postgres.NewPurchaseLimitsRepository()
+	preordersRepository := postgres.NewPreordersRepository(dbpool)
+	webhooksRepository := postgres.NewWebhooksRepository(dbpool)
+	webhookSender := webhooks.NewHTTPSender(webhookSendTimeout)
+	eventsRepository := postgres.NewEventsRepository(dbpool)
 
-	purchaseCase := application.NewPurchaseCase(goodsRepository, balancesRepository, purchaseHandler, txManager)
-	sendCoinsCase := application.NewSendCoinsCase(txManager, authService, balancesRepository, balancesRepository, transactionProceeder)
+	purchaseCase := application.NewPurchaseCase(goodsRepository, balancesRepository, balancesRepository,
+		purchaseHandler, txManager, authService, balancesRepository, promoCodesRepository, promoCodesRepository,
+		variantsRepository, variantsRepository, bundlesRepository, purchaseLimitsRepository, webhooksRepository,
+		eventsRepository)
+	itemTransferCase := application.NewItemTransferCase(txManager, authService, goodsRepository, variantsRepository,
+		balancesRepository, balancesRepository, balancesRepository, itemTransferProceeder, purchaseLimitsRepository)
+	sendCoinsCase := application.NewSendCoinsCase(txManager, authService, balancesRepository, balancesRepository,
+		balancesRepository, transferLimitsRepository, transferLimitsRepository, transactionProceeder,
+		eventsRepository)
+	paymentRequestsCase := application.NewPaymentRequestsCase(txManager, authService, authService, balancesRepository,
+		paymentRequestsRepository, sendCoinsCase)
+	scheduledTransfersCase := application.NewScheduledTransfersCase(txManager, authService, authService,
+		balancesRepository, scheduledTransfersRepository, scheduledTransfersRepository, sendCoinsCase)
+	marketplaceCase := application.NewMarketplaceCase(txManager, authService, authService, goodsRepository,
+		variantsRepository, balancesRepository, balancesRepository, listingsRepository, listingsRepository,
+		inventoryRepository, itemTransferProceeder, purchaseLimitsRepository, companyPool, sendCoinsCase,
+		a.cfg.MarketplaceFeePercent)
+	auctionsCase := application.NewAuctionsCase(txManager, authService, goodsRepository, variantsRepository,
+		balancesRepository, balancesRepository, auctionsRepository, auctionsRepository, auctionsRepository,
+		purchaseLimitsRepository, webhooksRepository, eventsRepository, auditLog)
+	rafflesCase := application.NewRafflesCase(txManager, goodsRepository, variantsRepository, rafflesRepository,
+		rafflesRepository, rafflesRepository, purchaseCase, webhooksRepository, eventsRepository, auditLog)
+	wishlistCase := application.NewWishlistCase(txManager, goodsRepository, userInfoRepository, wishlistRepository,
+		wishlistRepository)
+	promoCodesCase := application.NewPromoCodesCase(txManager, goodsRepository, promoCodesRepository, auditLog)
+	catalogCase := application.NewCatalogCase(txManager, goodsRepository, priceSchedulesRepository, variantsRepository,
+		categoriesRepository, goodsRepository, bundlesRepository, purchaseLimitsRepository, auditLog)
+	preordersCase := application.NewPreordersCase(txManager, goodsRepository, goodsRepository, preordersRepository,
+		preordersRepository, balancesRepository, balancesRepository, variantsRepository, purchaseCase, wishlistRepository,
+		webhooksRepository, eventsRepository, auditLog)
+	webhooksCase := application.NewWebhooksCase(txManager, webhooksRepository, webhooksRepository, webhookSender, auditLog)
+	eventsCase := application.NewEventsCase(txManager, eventsRepository, eventsRepository, eventSink)
+	notificationsCase := application.NewNotificationsCase(eventsRepository, userInfoRepository, authService)
 	userInfoCase := application.NewUserInfoCase(userInfoRepository, authService, logger)
+	deactivationCase := application.NewDeactivationCase(txManager, authService, authService, balancesRepository,
+		balancesRepository, balancesRepository, companyPool, preordersRepository, listingsRepository,
+		scheduledTransfersRepository, auctionsRepository, rafflesRepository, webhooksRepository, eventsRepository, auditLog)
+	teamsCase := application.NewTeamsCase(txManager, authService, balancesRepository, balancesRepository,
+		teamsRepository, teamsRepository, teamsRepository, teamBudgetProceeder, teamsRepository, eventsRepository, auditLog)
+	transferLimitsCase := application.NewTransferLimitsCase(txManager, authService, balancesRepository,
+		transferLimitsRepository, auditLog)
+	fraudDetectionCase := application.NewFraudDetectionCase(txManager, fraudRepository, fraudRepository,
+		balancesRepository, balancesRepository, authService, auditLog)
+	accountFreezeCase := application.NewAccountFreezeCase(txManager, authService, balancesRepository,
+		balancesRepository, balancesRepository, auditLog)
+	auditCase := application.NewAuditCase(auditLog, authService, authService, authService)
 
 	server := createGRPCServer(
 		purchaseCase,
+		itemTransferCase,
 		sendCoinsCase,
 		userInfoCase,
+		deactivationCase,
+		teamsCase,
+		paymentRequestsCase,
+		scheduledTransfersCase,
+		marketplaceCase,
+		auctionsCase,
+		rafflesCase,
+		wishlistCase,
+		promoCodesCase,
+		catalogCase,
+		preordersCase,
+		notificationsCase,
+		webhooksCase,
+		eventsCase,
+		transferLimitsCase,
+		fraudDetectionCase,
+		accountFreezeCase,
+		auditCase,
 		logger,
 		jwt.NewJWTTokenParser(),
 		a.cfg.JwtSecret,
 		balancesRepository,
+		balancesRepository,
+		dbpool,
 	)
 	a.server = server
 
+	go worker.RunPeriodically(ctx, teamTopUpInterval, "team budget top-up", func(ctx context.Context) error {
+		toppedUp, err := teamsCase.TopUpBudgets(ctx)
+		if toppedUp > 0 {
+			logger.Info("team budgets topped up", "teams", toppedUp)
+		}
+		return err
+	}, logger)
+
+	go worker.RunPeriodically(ctx, fraudAnalysisInterval, "fraud analysis", func(ctx context.Context) error {
+		flagged, err := fraudDetectionCase.Analyze(ctx)
+		if flagged > 0 {
+			logger.Warn("new fraud cases flagged", "cases", flagged)
+		}
+		return err
+	}, logger)
+
+	go worker.RunPeriodically(ctx, scheduledTransfersInterval, "scheduled transfers", func(ctx context.Context) error {
+		executed, err := scheduledTransfersCase.ExecuteDueTransfers(ctx)
+		if executed > 0 {
+			logger.Info("scheduled transfers executed", "transfers", executed)
+		}
+		return err
+	}, logger)
+
+	go worker.RunPeriodically(ctx, auctionSettlementInterval, "auction settlement", func(ctx context.Context) error {
+		sold, err := auctionsCase.SettleDueAuctions(ctx)
+		if sold > 0 {
+			logger.Info("auctions settled", "sold", sold)
+		}
+		return err
+	}, logger)
+
+	go worker.RunPeriodically(ctx, raffleDrawInterval, "raffle draw", func(ctx context.Context) error {
+		drawn, err := rafflesCase.DrawDueRaffles(ctx)
+		if drawn > 0 {
+			logger.Info("raffles drawn", "raffles", drawn)
+		}
+		return err
+	}, logger)
+
+	go worker.RunPeriodically(ctx, wishlistPriceWatchInterval, "wishlist price watch", func(ctx context.Context) error {
+		drops, err := wishlistCase.WatchPrices(ctx)
+		if drops > 0 {
+			logger.Info("wishlist price drops found", "events", drops)
+		}
+		return err
+	}, logger)
+
+	go worker.RunPeriodically(ctx, webhookDeliveryInterval, "webhook delivery", func(ctx context.Context) error {
+		delivered, err := webhooksCase.DeliverDueWebhooks(ctx)
+		if delivered > 0 {
+			logger.Info("webhooks delivered", "deliveries", delivered)
+		}
+		return err
+	}, logger)
+
+	go worker.RunPeriodically(ctx, eventRelayInterval, "event relay", func(ctx context.Context) error {
+		relayed, err := eventsCase.RelayEvents(ctx)
+		if relayed > 0 {
+			logger.Info("events relayed", "events", relayed)
+		}
+		return err
+	}, logger)
+
+	go worker.RunPeriodically(ctx, notificationsPollInterval, "notifications poll", notificationsCase.PollEvents, logger)
+
 	errChan := make(chan error, 1)
 	go func() {
 		logger.Info("starting gRPC server", "port", grpcLis.Addr().(*net.TCPAddr).Port)
@@ -100,30 +281,79 @@ func (a *StoreApp) Shutdown() {
 	}
 
 	a.logger.Info("shutting down gRPC server")
-	a.server.GracefulStop()
+
+	// Event subscriptions stay open until the clients leave, so they are cut after the timeout.
+	stopped := make(chan struct{})
+	go func() {
+		a.server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(shutdownTimeout):
+		a.server.Stop()
+	}
+
 	a.dbpool.Close()
 	a.logger.Info("gRPC server stopped")
 }
 
 func createGRPCServer(
 	purchaseCase *application.PurchaseCase,
+	itemTransferCase *application.ItemTransferCase,
 	sendCoinsCase *application.SendCoinsCase,
 	userInfoCase *application.UserInfoCase,
+	deactivationCase *application.DeactivationCase,
+	teamsCase *application.TeamsCase,
+	paymentRequestsCase *application.PaymentRequestsCase,
+	scheduledTransfersCase *application.ScheduledTransfersCase,
+	marketplaceCase *application.MarketplaceCase,
+	auctionsCase *application.AuctionsCase,
+	rafflesCase *application.RafflesCase,
+	wishlistCase *application.WishlistCase,
+	promoCodesCase *application.PromoCodesCase,
+	catalogCase *application.CatalogCase,
+	preordersCase *application.PreordersCase,
+	notificationsCase *application.NotificationsCase,
+	webhooksCase *application.WebhooksCase,
+	eventsCase *application.EventsCase,
+	transferLimitsCase *application.TransferLimitsCase,
+	fraudDetectionCase *application.FraudDetectionCase,
+	accountFreezeCase *application.AccountFreezeCase,
+	auditCase *application.AuditCase,
 	logger logging.Logger,
 	tokenParser jwt.TokenParser,
 	secretKey string,
 	balanceEnsurer domain.BalanceEnsurer,
+	balanceStatusChecker domain.BalanceStatusChecker,
+	querier database.Querier,
 ) *grpc.Server {
 	authInterceptorFabric := grpcwrap.NewAuthInterceptorFabric(secretKey, tokenParser, logger)
-	balanceInterceptorFabric := grpcwrap.NewBalanceInterceptorFabric(balanceEnsurer, logger)
+	roleInterceptorFabric := grpcwrap.NewRoleInterceptorFabric(logger)
+	balanceInterceptorFabric := grpcwrap.NewBalanceInterceptorFabric(balanceEnsurer, balanceStatusChecker, querier, logger)
 
 	grpcServer := grpc.NewServer(
-		grpc.ChainUnaryInterceptor(authInterceptorFabric.GetInterceptor(),
+		grpc.ChainUnaryInterceptor(audit.RequestIDInterceptor,
+			authInterceptorFabric.GetInterceptor(),
+			roleInterceptorFabric.GetInterceptor(),
 			balanceInterceptorFabric.GetInterceptor()),
+		grpc.ChainStreamInterceptor(audit.RequestIDStreamInterceptor,
+			authInterceptorFabric.GetStreamInterceptor(),
+			roleInterceptorFabric.GetStreamInterceptor(),
+			balanceInterceptorFabric.GetStreamInterceptor()),
 	)
-	storeServer := grpcwrap.NewStoreServerGRPC(purchaseCase, sendCoinsCase, userInfoCase, logger)
+	storeServer := grpcwrap.NewStoreServerGRPC(purchaseCase, itemTransferCase, sendCoinsCase, userInfoCase, teamsCase,
+		paymentRequestsCase, scheduledTransfersCase, marketplaceCase, auctionsCase, rafflesCase, wishlistCase, catalogCase,
+		preordersCase, notificationsCase, logger)
+	adminServer := grpcwrap.NewAdminServerGRPC(deactivationCase, teamsCase, transferLimitsCase,
+		fraudDetectionCase, accountFreezeCase, auctionsCase, rafflesCase, promoCodesCase, catalogCase, preordersCase,
+		webhooksCase, eventsCase, logger)
+	auditServer := grpcwrap.NewAuditServerGRPC(auditCase, logger)
 
 	merchapi.RegisterMerchStoreServiceServer(grpcServer, storeServer)
+	merchapi.RegisterMerchAdminServiceServer(grpcServer, adminServer)
+	merchapi.RegisterMerchAuditServiceServer(grpcServer, auditServer)
 
 	return grpcServer
 }
@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+)
+
+const (
+	AuctionStatusOpen   = "open"
+	AuctionStatusSold   = "sold"
+	AuctionStatusUnsold = "unsold"
+
+	BidStatusHeld     = "held"
+	BidStatusRefunded = "refunded"
+	BidStatusWon      = "won"
+
+	MaxAuctionDuration  = 30 * 24 * time.Hour
+	AuctionsSettleBatch = 100
+)
+
+type AuctionsRepository interface {
+	CreateAuction(ctx context.Context, querier database.Querier, auction Auction) (int, error)
+	ListOpenAuctions(ctx context.Context) ([]Auction, error)
+}
+
+// AuctionBidsProceeder keeps the coins of the highest bid in escrow: placing a bid takes them from the bidder's
+// balance and refunding it gives them back.
+type AuctionBidsProceeder interface {
+	LockAndGetAuction(ctx context.Context, querier database.Querier, auctionID int) (Auction, error)
+	PlaceBid(ctx context.Context, executor database.Executor, auctionID, bidderID int, amount uint32) error
+	RefundBid(ctx context.Context, executor database.Executor, bid AuctionBid) error
+	// RefundUserBids locks the auctions the user holds the top bid of, refunds those bids and returns them.
+	RefundUserBids(ctx context.Context, executor database.QueryExecuter, userID int) ([]AuctionBid, error)
+}
+
+type AuctionSettler interface {
+	FetchDueAuctions(ctx context.Context, now time.Time, limit int) ([]int, error)
+	AwardAuction(ctx context.Context, executor database.Executor, auction Auction) error
+	CloseUnsoldAuction(ctx context.Context, executor database.Executor, auctionID int) error
+}
+
+// Auction sells a single item to the highest bidder at EndsAt. The item is only sold
+// if the highest bid reaches ReservePrice. TopBid is empty until the first bid.
+type Auction struct {
+	Id           int
+	GoodID       int
+	GoodName     string
+	ReservePrice uint32
+	StartsAt     time.Time
+	EndsAt       time.Time
+	Status       string
+	TopBid       AuctionBid
+}
+
+type AuctionBid struct {
+	Id       int
+	BidderID int
+	Amount   uint32
+}
+
+func (a Auction) HasBids() bool {
+	return a.TopBid.Id != 0
+}
+
+func (a Auction) IsAcceptingBids(now time.Time) bool {
+	return a.Status == AuctionStatusOpen && !now.Before(a.StartsAt) && now.Before(a.EndsAt)
+}
+
+func (a Auction) IsReserveMet() bool {
+	return a.HasBids() && a.TopBid.Amount >= a.ReservePrice
+}
+
+type NamedAuction struct {
+	Auction
+	TopBidderUsername string
+}
@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuction_IsAcceptingBids(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 5, 24, 12, 0, 0, 0, time.UTC)
+
+	type testCase struct {
+		name     string
+		auction  Auction
+		expected bool
+	}
+
+	tests := []testCase{
+		{
+			name:     "running auction",
+			auction:  Auction{Status: AuctionStatusOpen, StartsAt: now.Add(-time.Hour), EndsAt: now.Add(time.Hour)},
+			expected: true,
+		},
+		{
+			name:     "starts right now",
+			auction:  Auction{Status: AuctionStatusOpen, StartsAt: now, EndsAt: now.Add(time.Hour)},
+			expected: true,
+		},
+		{
+			name:     "not started yet",
+			auction:  Auction{Status: AuctionStatusOpen, StartsAt: now.Add(time.Minute), EndsAt: now.Add(time.Hour)},
+			expected: false,
+		},
+		{
+			name:     "ended but not settled",
+			auction:  Auction{Status: AuctionStatusOpen, StartsAt: now.Add(-time.Hour), EndsAt: now},
+			expected: false,
+		},
+		{
+			name:     "settled",
+			auction:  Auction{Status: AuctionStatusSold, StartsAt: now.Add(-time.Hour), EndsAt: now.Add(time.Hour)},
+			expected: false,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, tt.auction.IsAcceptingBids(now))
+		})
+	}
+}
+
+func TestAuction_IsReserveMet(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name     string
+		auction  Auction
+		expected bool
+	}
+
+	tests := []testCase{
+		{
+			name:     "no bids",
+			auction:  Auction{ReservePrice: 0},
+			expected: false,
+		},
+		{
+			name:     "bid below reserve",
+			auction:  Auction{ReservePrice: 500, TopBid: AuctionBid{Id: 1, BidderID: 2, Amount: 499}},
+			expected: false,
+		},
+		{
+			name:     "bid equal to reserve",
+			auction:  Auction{ReservePrice: 500, TopBid: AuctionBid{Id: 1, BidderID: 2, Amount: 500}},
+			expected: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, tt.auction.IsReserveMet())
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/audit"
+)
+
+const (
+	AuditSourceStore = "store"
+	AuditSourceAuth  = "auth"
+)
+
+type AuthAuditReader interface {
+	ListAuthEvents(ctx context.Context, filter audit.Filter) ([]AuditEntry, error)
+}
+
+// AuditEntry is an audit event enriched with the service it was recorded by and the actor's username.
+type AuditEntry struct {
+	audit.Event
+	Source string
+	Actor  string
+}
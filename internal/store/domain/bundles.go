@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+)
+
+// BundlesRepository reads the components of bundles, goods that are sold as a set of other goods.
+type BundlesRepository interface {
+	ListBundleComponents(ctx context.Context, bundleID int) ([]BundleComponent, error)
+	ListComponents(ctx context.Context) ([]BundleComponent, error)
+}
+
+// BundleComponent is Quantity units of a good contained in a bundle. VariantID and Variant, its SKU, are set
+// once a variant of the good is picked for a purchase.
+type BundleComponent struct {
+	BundleID  int
+	GoodID    int
+	GoodName  string
+	Quantity  uint32
+	VariantID int
+	Variant   string
+}
+
+// PickBundleVariants picks the variant with the given SKU for every component whose good has variants.
+// variantsByGood holds the variants of the component goods. A bundle is bought with a single SKU, so it
+// works for bundles with at most one good that has variants.
+func PickBundleVariants(components []BundleComponent, variantsByGood map[int][]Variant, sku string) ([]BundleComponent, error) {
+	picked := make([]BundleComponent, 0, len(components))
+	skuUsed := false
+
+	for _, component := range components {
+		variants := variantsByGood[component.GoodID]
+		if len(variants) > 0 {
+			variant, err := PickVariant(variants, sku)
+			if err != nil {
+				return nil, err
+			}
+
+			component.VariantID = variant.Id
+			component.Variant = variant.SKU
+			skuUsed = true
+		}
+
+		picked = append(picked, component)
+	}
+
+	if sku != "" && !skuUsed {
+		return nil, &VariantNotFoundError{Msg: fmt.Sprintf("bundle has no variant %s", sku)}
+	}
+
+	return picked, nil
+}
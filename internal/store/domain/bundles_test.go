@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPickBundleVariants(t *testing.T) {
+	t.Parallel()
+
+	components := []BundleComponent{
+		{BundleID: 30, GoodID: 2, GoodName: "cup", Quantity: 1},
+		{BundleID: 30, GoodID: 10, GoodName: "t-shirt", Quantity: 1},
+	}
+	sizes := map[int][]Variant{
+		10: {
+			{Id: 3, GoodID: 10, SKU: "t-shirt-m"},
+			{Id: 4, GoodID: 10, SKU: "t-shirt-l"},
+		},
+	}
+
+	type testCase struct {
+		name           string
+		variantsByGood map[int][]Variant
+		sku            string
+
+		expected    []BundleComponent
+		expectedErr error
+	}
+
+	tests := []testCase{
+		{
+			name:           "variant picked",
+			variantsByGood: sizes,
+			sku:            "t-shirt-l",
+			expected: []BundleComponent{
+				{BundleID: 30, GoodID: 2, GoodName: "cup", Quantity: 1},
+				{BundleID: 30, GoodID: 10, GoodName: "t-shirt", Quantity: 1, VariantID: 4, Variant: "t-shirt-l"},
+			},
+		},
+		{
+			name:           "variant missing",
+			variantsByGood: sizes,
+			expectedErr:    &InvalidArgumentsError{},
+		},
+		{
+			name:     "bundle without variants",
+			expected: components,
+		},
+		{
+			name:        "variant of a bundle without variants",
+			sku:         "t-shirt-l",
+			expectedErr: &VariantNotFoundError{},
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			picked, err := PickBundleVariants(components, tt.variantsByGood, tt.sku)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, picked)
+			}
+		})
+	}
+}
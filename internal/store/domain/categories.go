@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+)
+
+const (
+	MaxCategorySlugLength = 32
+	MaxCategoryNameLength = 64
+	MaxDescriptionLength  = 2000
+	MaxGoodImages         = 10
+	MaxImageAltTextLength = 200
+)
+
+// CategoriesRepository keeps the categories the catalog is filtered by.
+type CategoriesRepository interface {
+	CreateCategory(ctx context.Context, querier database.Querier, category Category) (int, error)
+	GetCategory(ctx context.Context, slug string) (Category, error)
+	ListCategories(ctx context.Context) ([]Category, error)
+}
+
+// GoodDetailsUpdater replaces the storefront details of a good.
+type GoodDetailsUpdater interface {
+	UpdateGoodDetails(ctx context.Context, executor database.Executor, goodID int, details GoodDetails) error
+}
+
+// Category groups goods in the catalog. Slug identifies the category in filters, Name is shown to users.
+type Category struct {
+	Id   int
+	Slug string
+	Name string
+}
+
+// GoodDetails is what the storefront renders besides the name and price of a good. A zero CategoryID leaves
+// the good uncategorized.
+type GoodDetails struct {
+	CategoryID  int
+	Description string
+	Images      []GoodImage
+}
+
+// GoodImage references an image of a good hosted elsewhere. Width and Height are in pixels, zero when unknown.
+type GoodImage struct {
+	URL     string
+	AltText string
+	Width   uint32
+	Height  uint32
+}
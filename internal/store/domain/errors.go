@@ -101,3 +101,564 @@ func (e *BalanceExistingError) Is(target error) bool {
 }
 
 //endregion
+
+//region UserDeactivatedError
+
+type UserDeactivatedError struct {
+	Msg string
+}
+
+func (e *UserDeactivatedError) Error() string {
+	return e.Msg
+}
+
+func (e *UserDeactivatedError) Is(target error) bool {
+	_, ok := target.(*UserDeactivatedError)
+	return ok
+}
+
+//endregion
+
+//region TeamNotFoundError
+
+type TeamNotFoundError struct {
+	Msg string
+}
+
+func (e *TeamNotFoundError) Error() string {
+	return e.Msg
+}
+
+func (e *TeamNotFoundError) Is(target error) bool {
+	_, ok := target.(*TeamNotFoundError)
+	return ok
+}
+
+//endregion
+
+//region TeamExistingError
+
+type TeamExistingError struct {
+	Msg string
+}
+
+func (e *TeamExistingError) Error() string {
+	return e.Msg
+}
+
+func (e *TeamExistingError) Is(target error) bool {
+	_, ok := target.(*TeamExistingError)
+	return ok
+}
+
+//endregion
+
+//region NotTeamManagerError
+
+type NotTeamManagerError struct {
+	Msg string
+}
+
+func (e *NotTeamManagerError) Error() string {
+	return e.Msg
+}
+
+func (e *NotTeamManagerError) Is(target error) bool {
+	_, ok := target.(*NotTeamManagerError)
+	return ok
+}
+
+//endregion
+
+//region NotTeamMemberError
+
+type NotTeamMemberError struct {
+	Msg string
+}
+
+func (e *NotTeamMemberError) Error() string {
+	return e.Msg
+}
+
+func (e *NotTeamMemberError) Is(target error) bool {
+	_, ok := target.(*NotTeamMemberError)
+	return ok
+}
+
+//endregion
+
+//region LimitExceededError
+
+type LimitExceededError struct {
+	Msg string
+}
+
+func (e *LimitExceededError) Error() string {
+	return e.Msg
+}
+
+func (e *LimitExceededError) Is(target error) bool {
+	_, ok := target.(*LimitExceededError)
+	return ok
+}
+
+//endregion
+
+//region FraudCaseNotFoundError
+
+type FraudCaseNotFoundError struct {
+	Msg string
+}
+
+func (e *FraudCaseNotFoundError) Error() string {
+	return e.Msg
+}
+
+func (e *FraudCaseNotFoundError) Is(target error) bool {
+	_, ok := target.(*FraudCaseNotFoundError)
+	return ok
+}
+
+//endregion
+
+//region FraudCaseClosedError
+
+type FraudCaseClosedError struct {
+	Msg string
+}
+
+func (e *FraudCaseClosedError) Error() string {
+	return e.Msg
+}
+
+func (e *FraudCaseClosedError) Is(target error) bool {
+	_, ok := target.(*FraudCaseClosedError)
+	return ok
+}
+
+//endregion
+
+//region AccountFrozenError
+
+type AccountFrozenError struct {
+	Msg string
+}
+
+func (e *AccountFrozenError) Error() string {
+	return e.Msg
+}
+
+func (e *AccountFrozenError) Is(target error) bool {
+	_, ok := target.(*AccountFrozenError)
+	return ok
+}
+
+//endregion
+
+//region PaymentRequestNotFoundError
+
+type PaymentRequestNotFoundError struct {
+	Msg string
+}
+
+func (e *PaymentRequestNotFoundError) Error() string {
+	return e.Msg
+}
+
+func (e *PaymentRequestNotFoundError) Is(target error) bool {
+	_, ok := target.(*PaymentRequestNotFoundError)
+	return ok
+}
+
+//endregion
+
+//region PaymentRequestClosedError
+
+type PaymentRequestClosedError struct {
+	Msg string
+}
+
+func (e *PaymentRequestClosedError) Error() string {
+	return e.Msg
+}
+
+func (e *PaymentRequestClosedError) Is(target error) bool {
+	_, ok := target.(*PaymentRequestClosedError)
+	return ok
+}
+
+//endregion
+
+//region ScheduledTransferNotFoundError
+
+type ScheduledTransferNotFoundError struct {
+	Msg string
+}
+
+func (e *ScheduledTransferNotFoundError) Error() string {
+	return e.Msg
+}
+
+func (e *ScheduledTransferNotFoundError) Is(target error) bool {
+	_, ok := target.(*ScheduledTransferNotFoundError)
+	return ok
+}
+
+//endregion
+
+//region ItemNotOwnedError
+
+type ItemNotOwnedError struct {
+	Msg string
+}
+
+func (e *ItemNotOwnedError) Error() string {
+	return e.Msg
+}
+
+func (e *ItemNotOwnedError) Is(target error) bool {
+	_, ok := target.(*ItemNotOwnedError)
+	return ok
+}
+
+//endregion
+
+//region ListingNotFoundError
+
+type ListingNotFoundError struct {
+	Msg string
+}
+
+func (e *ListingNotFoundError) Error() string {
+	return e.Msg
+}
+
+func (e *ListingNotFoundError) Is(target error) bool {
+	_, ok := target.(*ListingNotFoundError)
+	return ok
+}
+
+//endregion
+
+//region ListingClosedError
+
+type ListingClosedError struct {
+	Msg string
+}
+
+func (e *ListingClosedError) Error() string {
+	return e.Msg
+}
+
+func (e *ListingClosedError) Is(target error) bool {
+	_, ok := target.(*ListingClosedError)
+	return ok
+}
+
+//endregion
+
+//region AuctionNotFoundError
+
+type AuctionNotFoundError struct {
+	Msg string
+}
+
+func (e *AuctionNotFoundError) Error() string {
+	return e.Msg
+}
+
+func (e *AuctionNotFoundError) Is(target error) bool {
+	_, ok := target.(*AuctionNotFoundError)
+	return ok
+}
+
+//endregion
+
+//region AuctionClosedError
+
+type AuctionClosedError struct {
+	Msg string
+}
+
+func (e *AuctionClosedError) Error() string {
+	return e.Msg
+}
+
+func (e *AuctionClosedError) Is(target error) bool {
+	_, ok := target.(*AuctionClosedError)
+	return ok
+}
+
+//endregion
+
+//region RaffleNotFoundError
+
+type RaffleNotFoundError struct {
+	Msg string
+}
+
+func (e *RaffleNotFoundError) Error() string {
+	return e.Msg
+}
+
+func (e *RaffleNotFoundError) Is(target error) bool {
+	_, ok := target.(*RaffleNotFoundError)
+	return ok
+}
+
+//endregion
+
+//region RaffleClosedError
+
+type RaffleClosedError struct {
+	Msg string
+}
+
+func (e *RaffleClosedError) Error() string {
+	return e.Msg
+}
+
+func (e *RaffleClosedError) Is(target error) bool {
+	_, ok := target.(*RaffleClosedError)
+	return ok
+}
+
+//endregion
+
+//region WishlistItemNotFoundError
+
+type WishlistItemNotFoundError struct {
+	Msg string
+}
+
+func (e *WishlistItemNotFoundError) Error() string {
+	return e.Msg
+}
+
+func (e *WishlistItemNotFoundError) Is(target error) bool {
+	_, ok := target.(*WishlistItemNotFoundError)
+	return ok
+}
+
+//endregion
+
+//region PromoCodeNotFoundError
+
+type PromoCodeNotFoundError struct {
+	Msg string
+}
+
+func (e *PromoCodeNotFoundError) Error() string {
+	return e.Msg
+}
+
+func (e *PromoCodeNotFoundError) Is(target error) bool {
+	_, ok := target.(*PromoCodeNotFoundError)
+	return ok
+}
+
+//endregion
+
+//region PromoCodeExistingError
+
+type PromoCodeExistingError struct {
+	Msg string
+}
+
+func (e *PromoCodeExistingError) Error() string {
+	return e.Msg
+}
+
+func (e *PromoCodeExistingError) Is(target error) bool {
+	_, ok := target.(*PromoCodeExistingError)
+	return ok
+}
+
+//endregion
+
+//region PromoCodeUnusableError
+
+type PromoCodeUnusableError struct {
+	Msg string
+}
+
+func (e *PromoCodeUnusableError) Error() string {
+	return e.Msg
+}
+
+func (e *PromoCodeUnusableError) Is(target error) bool {
+	_, ok := target.(*PromoCodeUnusableError)
+	return ok
+}
+
+//endregion
+
+//region VariantNotFoundError
+
+type VariantNotFoundError struct {
+	Msg string
+}
+
+func (e *VariantNotFoundError) Error() string {
+	return e.Msg
+}
+
+func (e *VariantNotFoundError) Is(target error) bool {
+	_, ok := target.(*VariantNotFoundError)
+	return ok
+}
+
+//endregion
+
+//region OutOfStockError
+
+type OutOfStockError struct {
+	Msg string
+}
+
+func (e *OutOfStockError) Error() string {
+	return e.Msg
+}
+
+func (e *OutOfStockError) Is(target error) bool {
+	_, ok := target.(*OutOfStockError)
+	return ok
+}
+
+//endregion
+
+//region CategoryNotFoundError
+
+type CategoryNotFoundError struct {
+	Msg string
+}
+
+func (e *CategoryNotFoundError) Error() string {
+	return e.Msg
+}
+
+func (e *CategoryNotFoundError) Is(target error) bool {
+	_, ok := target.(*CategoryNotFoundError)
+	return ok
+}
+
+//endregion
+
+//region CategoryExistingError
+
+type CategoryExistingError struct {
+	Msg string
+}
+
+func (e *CategoryExistingError) Error() string {
+	return e.Msg
+}
+
+func (e *CategoryExistingError) Is(target error) bool {
+	_, ok := target.(*CategoryExistingError)
+	return ok
+}
+
+//endregion
+
+//region PurchaseLimitError
+
+type PurchaseLimitError struct {
+	Msg string
+}
+
+func (e *PurchaseLimitError) Error() string {
+	return e.Msg
+}
+
+func (e *PurchaseLimitError) Is(target error) bool {
+	_, ok := target.(*PurchaseLimitError)
+	return ok
+}
+
+//endregion
+
+//region PreorderNotFoundError
+
+type PreorderNotFoundError struct {
+	Msg string
+}
+
+func (e *PreorderNotFoundError) Error() string {
+	return e.Msg
+}
+
+func (e *PreorderNotFoundError) Is(target error) bool {
+	_, ok := target.(*PreorderNotFoundError)
+	return ok
+}
+
+//endregion
+
+//region PreorderExistingError
+
+type PreorderExistingError struct {
+	Msg string
+}
+
+func (e *PreorderExistingError) Error() string {
+	return e.Msg
+}
+
+func (e *PreorderExistingError) Is(target error) bool {
+	_, ok := target.(*PreorderExistingError)
+	return ok
+}
+
+//endregion
+
+//region PreorderClosedError
+
+type PreorderClosedError struct {
+	Msg string
+}
+
+func (e *PreorderClosedError) Error() string {
+	return e.Msg
+}
+
+func (e *PreorderClosedError) Is(target error) bool {
+	_, ok := target.(*PreorderClosedError)
+	return ok
+}
+
+//endregion
+
+//region WebhookEndpointNotFoundError
+
+type WebhookEndpointNotFoundError struct {
+	Msg string
+}
+
+func (e *WebhookEndpointNotFoundError) Error() string {
+	return e.Msg
+}
+
+func (e *WebhookEndpointNotFoundError) Is(target error) bool {
+	_, ok := target.(*WebhookEndpointNotFoundError)
+	return ok
+}
+
+//endregion
+
+//region WebhookDeliveryNotFoundError
+
+type WebhookDeliveryNotFoundError struct {
+	Msg string
+}
+
+func (e *WebhookDeliveryNotFoundError) Error() string {
+	return e.Msg
+}
+
+func (e *WebhookDeliveryNotFoundError) Is(target error) bool {
+	_, ok := target.(*WebhookDeliveryNotFoundError)
+	return ok
+}
+
+//endregion
@@ -0,0 +1,144 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+)
+
+const (
+	EventCoinsSent      = "CoinsSent"
+	EventItemPurchased  = "ItemPurchased"
+	EventBalanceCreated = "BalanceCreated"
+
+	EventPreorderStatusChanged = "PreorderStatusChanged"
+
+	EventRelayBatch    = 100
+	DefaultEventsLimit = 100
+	MaxEventsLimit     = 1000
+)
+
+var eventConsumerPattern = regexp.MustCompile(`^[A-Za-z0-9._-]{1,64}$`)
+
+// EventOutbox keeps the events of changes until the relay moves them to the event log. It runs in the
+// transaction of the change the event reports, so an event is written exactly when the change is committed.
+type EventOutbox interface {
+	AppendEvent(ctx context.Context, executor database.Executor, event DomainEvent) error
+}
+
+// EventLog is the stream of relayed events ordered by their offsets.
+type EventLog interface {
+	// TryLockRelay takes the relay lock until the end of the transaction. It returns false if another store
+	// instance holds it.
+	TryLockRelay(ctx context.Context, querier database.Querier) (bool, error)
+	// MoveOutboxEvents moves up to limit of the oldest outbox events to the log and returns them with their offsets.
+	MoveOutboxEvents(ctx context.Context, querier database.Querier, limit int) ([]DomainEvent, error)
+	FetchEvents(ctx context.Context, afterOffset int64, limit int) ([]DomainEvent, error)
+	GetLastEventOffset(ctx context.Context) (int64, error)
+}
+
+// EventConsumersRepository keeps the offset each consumer of the event log has processed events up to.
+type EventConsumersRepository interface {
+	GetConsumerOffset(ctx context.Context, consumer string) (int64, error)
+	CommitConsumerOffset(ctx context.Context, consumer string, offset int64) error
+}
+
+// EventSink publishes relayed events to an external system. Events are published again if the relay fails to
+// record them as relayed, so receivers should drop repeated ids.
+type EventSink interface {
+	PublishEvents(ctx context.Context, events []DomainEvent) error
+}
+
+// DomainEvent is a committed change of the store. Id stays the same if the event is published again, Offset is
+// its position in the event log and is set once the event is relayed. UserIDs lists the users the event concerns.
+type DomainEvent struct {
+	Id         int64
+	Offset     int64
+	Type       string
+	UserIDs    []int
+	Data       map[string]any
+	OccurredAt time.Time
+}
+
+// ValidateEventConsumer checks that the consumer name is 1 to 64 letters, digits, dots, dashes or underscores.
+func ValidateEventConsumer(consumer string) error {
+	if !eventConsumerPattern.MatchString(consumer) {
+		return &InvalidArgumentsError{Msg: fmt.Sprintf("invalid event consumer: %q", consumer)}
+	}
+
+	return nil
+}
+
+// EventsLimit clamps the requested limit to (0, MaxEventsLimit], falling back to DefaultEventsLimit.
+func EventsLimit(limit int) int {
+	if limit <= 0 {
+		return DefaultEventsLimit
+	} else if limit > MaxEventsLimit {
+		return MaxEventsLimit
+	}
+
+	return limit
+}
+
+// CoinsSentEvent reports coins moved from one balance to another.
+func CoinsSentEvent(fromUserID, toUserID int, amount uint32) DomainEvent {
+	return DomainEvent{
+		Type:    EventCoinsSent,
+		UserIDs: []int{fromUserID, toUserID},
+		Data: map[string]any{
+			"fromUserId": fromUserID,
+			"toUserId":   toUserID,
+			"amount":     amount,
+		},
+	}
+}
+
+// ItemPurchasedEvent reports items bought by the buyer for the price. The items went to the owner, who differs
+// from the buyer for gifts.
+func ItemPurchasedEvent(buyerID, ownerID int, price uint32, items ...WebhookItem) DomainEvent {
+	userIDs := []int{buyerID}
+	if ownerID != buyerID {
+		userIDs = append(userIDs, ownerID)
+	}
+
+	return DomainEvent{
+		Type:    EventItemPurchased,
+		UserIDs: userIDs,
+		Data: map[string]any{
+			"userId":  ownerID,
+			"buyerId": buyerID,
+			"price":   price,
+			"items":   webhookItemsData(items),
+		},
+	}
+}
+
+// BalanceCreatedEvent reports a balance opened with the start value.
+func BalanceCreatedEvent(userID int, balance uint32) DomainEvent {
+	return DomainEvent{
+		Type:    EventBalanceCreated,
+		UserIDs: []int{userID},
+		Data: map[string]any{
+			"userId":  userID,
+			"balance": balance,
+		},
+	}
+}
+
+// PreorderStatusChangedEvent reports a pre-order placed, cancelled or fulfilled, with the status it moved to.
+func PreorderStatusChangedEvent(preorder Preorder, status string) DomainEvent {
+	return DomainEvent{
+		Type:    EventPreorderStatusChanged,
+		UserIDs: []int{preorder.UserID},
+		Data: map[string]any{
+			"userId":     preorder.UserID,
+			"preorderId": preorder.Id,
+			"item":       preorder.GoodName,
+			"variant":    preorder.Variant,
+			"status":     status,
+		},
+	}
+}
@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateEventConsumer(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name     string
+		consumer string
+
+		expectedErr bool
+	}
+
+	tests := []testCase{
+		{
+			name:     "valid consumer",
+			consumer: "warehouse.sync-v2_eu",
+		},
+		{
+			name:        "empty consumer",
+			expectedErr: true,
+		},
+		{
+			name:        "too long consumer",
+			consumer:    strings.Repeat("a", 65),
+			expectedErr: true,
+		},
+		{
+			name:        "forbidden characters",
+			consumer:    "warehouse sync",
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateEventConsumer(tt.consumer)
+
+			if tt.expectedErr {
+				assert.ErrorIs(t, err, &InvalidArgumentsError{})
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestEventsLimit(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, DefaultEventsLimit, EventsLimit(0))
+	assert.Equal(t, 10, EventsLimit(10))
+	assert.Equal(t, MaxEventsLimit, EventsLimit(MaxEventsLimit+1))
+}
+
+func TestItemPurchasedEvent(t *testing.T) {
+	t.Parallel()
+
+	purchase := ItemPurchasedEvent(1, 1, 500, WebhookItem{Name: "hoody", Variant: "hoody-m", Quantity: 1})
+	assert.Equal(t, EventItemPurchased, purchase.Type)
+	assert.Equal(t, []int{1}, purchase.UserIDs)
+	assert.Equal(t, map[string]any{
+		"userId":  1,
+		"buyerId": 1,
+		"price":   uint32(500),
+		"items":   []map[string]any{{"name": "hoody", "variant": "hoody-m", "quantity": uint32(1)}},
+	}, purchase.Data)
+
+	gift := ItemPurchasedEvent(1, 2, 10, WebhookItem{Name: "cup", Quantity: 1})
+	assert.Equal(t, []int{1, 2}, gift.UserIDs)
+	assert.Equal(t, 2, gift.Data["userId"])
+}
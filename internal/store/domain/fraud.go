@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+)
+
+const (
+	FraudPatternCircular = "circular-transfers"
+	FraudPatternBurst    = "transfer-burst"
+	FraudPatternDrain    = "new-account-drain"
+
+	FraudCaseStatusOpen     = "open"
+	FraudCaseStatusResolved = "resolved"
+	FraudCaseStatusFrozen   = "frozen"
+)
+
+type FraudDetector interface {
+	DetectCircularTransfers(ctx context.Context, since time.Time, minTransfers int) ([]SuspiciousActivity, error)
+	DetectTransferBursts(ctx context.Context, since time.Time, minTransfers int) ([]SuspiciousActivity, error)
+	DetectNewAccountDrains(ctx context.Context, since, createdAfter time.Time, minSenders int) ([]SuspiciousActivity, error)
+}
+
+type FraudCasesRepository interface {
+	SaveFraudCases(ctx context.Context, activities []SuspiciousActivity) (int, error)
+	ListFraudCases(ctx context.Context, status string) ([]FraudCase, error)
+	LockAndGetFraudCase(ctx context.Context, querier database.Querier, caseID int) (FraudCase, error)
+	CloseFraudCase(ctx context.Context, executor database.Executor, caseID int, status, resolution string) error
+}
+
+type SuspiciousActivity struct {
+	Pattern    string
+	UserIDs    []int
+	Details    string
+	DetectedAt time.Time
+}
+
+// SubjectID is the user the activity centres on: the sender of a burst, the recipient of a drain and the lowest id
+// of the users passing coins around in a loop.
+func (a SuspiciousActivity) SubjectID() int {
+	if len(a.UserIDs) == 0 {
+		return 0
+	}
+
+	if a.Pattern == FraudPatternCircular {
+		return slices.Min(a.UserIDs)
+	}
+
+	return a.UserIDs[0]
+}
+
+// Fingerprint identifies the activity by its pattern, subject user and day, so a subject is flagged for a pattern at
+// most once a day even when other users join the activity later that day.
+func (a SuspiciousActivity) Fingerprint() string {
+	return fmt.Sprintf("%s:%d:%s", a.Pattern, a.SubjectID(), a.DetectedAt.UTC().Format(time.DateOnly))
+}
+
+type FraudCase struct {
+	Id         int
+	Pattern    string
+	UserIDs    []int
+	Details    string
+	Status     string
+	Resolution string
+	CreatedAt  time.Time
+}
+
+type NamedFraudCase struct {
+	FraudCase
+	Usernames []string
+}
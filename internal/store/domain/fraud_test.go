@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuspiciousActivity_Fingerprint(t *testing.T) {
+	t.Parallel()
+
+	detectedAt := time.Date(2026, 3, 29, 23, 30, 0, 0, time.UTC)
+
+	first := SuspiciousActivity{Pattern: FraudPatternCircular, UserIDs: []int{3, 1, 2}, DetectedAt: detectedAt}
+	sameDay := SuspiciousActivity{Pattern: FraudPatternCircular, UserIDs: []int{1, 2, 3}, DetectedAt: detectedAt.Add(20 * time.Minute)}
+	nextDay := SuspiciousActivity{Pattern: FraudPatternCircular, UserIDs: []int{1, 2, 3}, DetectedAt: detectedAt.Add(time.Hour)}
+	otherPattern := SuspiciousActivity{Pattern: FraudPatternBurst, UserIDs: []int{1, 2, 3}, DetectedAt: detectedAt}
+	moreUsers := SuspiciousActivity{Pattern: FraudPatternCircular, UserIDs: []int{1, 4, 5}, DetectedAt: detectedAt}
+
+	assert.Equal(t, "circular-transfers:1:2026-03-29", first.Fingerprint())
+	assert.Equal(t, []int{3, 1, 2}, first.UserIDs)
+	assert.Equal(t, first.Fingerprint(), sameDay.Fingerprint())
+	assert.NotEqual(t, first.Fingerprint(), nextDay.Fingerprint())
+	assert.NotEqual(t, first.Fingerprint(), otherPattern.Fingerprint())
+	assert.Equal(t, first.Fingerprint(), moreUsers.Fingerprint())
+}
+
+func TestSuspiciousActivity_SubjectID(t *testing.T) {
+	t.Parallel()
+
+	drain := SuspiciousActivity{Pattern: FraudPatternDrain, UserIDs: []int{9, 2, 3}}
+	burst := SuspiciousActivity{Pattern: FraudPatternBurst, UserIDs: []int{4}}
+	loop := SuspiciousActivity{Pattern: FraudPatternCircular, UserIDs: []int{5, 2, 7}}
+
+	assert.Equal(t, 9, drain.SubjectID())
+	assert.Equal(t, 4, burst.SubjectID())
+	assert.Equal(t, 2, loop.SubjectID())
+}
@@ -2,14 +2,66 @@ package domain
 
 import (
 	"context"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
 )
 
+const MaxGiftMessageLength = 200
+
+// GoodsRepository returns goods at their effective price, which takes active price schedules into account.
 type GoodsRepository interface {
 	GetGoodInfo(ctx context.Context, goodName string) (GoodInfo, error)
+	ListGoods(ctx context.Context, category string) ([]CatalogGood, error)
+}
+
+type ItemTransferProceeder interface {
+	ProceedItemTransfer(ctx context.Context, executor database.Executor, fromUserID, toUserID, goodID, variantID int) error
 }
 
+// GoodInfo holds the effective Price of a good, BasePrice is the price without schedules. VariantID and
+// Variant, its SKU, are set once a variant of the good is picked for a purchase. Bundle is set for goods made
+// of other goods, Upcoming for goods announced ahead of their arrival, which can only be pre-ordered.
 type GoodInfo struct {
-	Id    int
-	Name  string
-	Price uint32
+	Id        int
+	Name      string
+	Price     uint32
+	BasePrice uint32
+	VariantID int
+	Variant   string
+	Bundle    bool
+	Upcoming  bool
+}
+
+// Gift is an item bought by one user for another.
+type Gift struct {
+	GoodName   string
+	FromUserID int
+	Message    string
+}
+
+type NamedGift struct {
+	GoodName     string
+	FromUsername string
+	Message      string
+}
+
+type ItemTransferHistory struct {
+	IncomingTransfers  []ItemTransfer
+	OutcomingTransfers []ItemTransfer
+}
+
+type NamedItemTransferHistory struct {
+	IncomingTransfers  []NamedItemTransfer
+	OutcomingTransfers []NamedItemTransfer
+}
+
+// ItemTransfer is a unit of a good passed from one user's inventory to another's.
+type ItemTransfer struct {
+	TargetID int
+	GoodName string
+}
+
+type NamedItemTransfer struct {
+	TargetUsername string
+	GoodName       string
 }
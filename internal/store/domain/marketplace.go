@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+)
+
+const (
+	ListingStatusActive    = "active"
+	ListingStatusSold      = "sold"
+	ListingStatusCancelled = "cancelled"
+
+	MaxMarketplaceFeePercent = 100
+	DefaultListingsLimit     = 50
+	MaxListingsLimit         = 200
+)
+
+type ListingsRepository interface {
+	CreateListing(ctx context.Context, querier database.Querier, listing Listing) (int, error)
+	CountActiveListings(ctx context.Context, querier database.Querier, sellerID, goodID, variantID int) (int, error)
+	UpdateListingPrice(ctx context.Context, sellerID, listingID int, price uint32) error
+	CancelListing(ctx context.Context, sellerID, listingID int) error
+	SearchListings(ctx context.Context, filter ListingFilter) ([]Listing, error)
+}
+
+type ListingsSeller interface {
+	LockAndGetListing(ctx context.Context, querier database.Querier, listingID int) (Listing, error)
+	CloseListing(ctx context.Context, executor database.Executor, listingID int, status string) error
+	MarkListingSold(ctx context.Context, executor database.Executor, listingID, buyerID int, fee uint32) error
+	// CancelSellerListings cancels every active listing of the seller and returns how many were cancelled.
+	CancelSellerListings(ctx context.Context, executor database.Executor, sellerID int) (int, error)
+}
+
+type InventoryCounter interface {
+	CountOwnedItems(ctx context.Context, querier database.Querier, userID, goodID, variantID int) (int, error)
+}
+
+// Listing offers one unit of a good from the seller's inventory for Price coins. A zero VariantID offers
+// a unit without a variant.
+type Listing struct {
+	Id        int
+	SellerID  int
+	GoodID    int
+	GoodName  string
+	VariantID int
+	Variant   string
+	Price     uint32
+	Status    string
+	CreatedAt time.Time
+}
+
+type NamedListing struct {
+	Listing
+	SellerUsername string
+}
+
+// ListingFilter narrows SearchListings down to active listings. Zero values disable the corresponding condition,
+// Query matches a part of the good name.
+type ListingFilter struct {
+	Query    string
+	SellerID int
+	MaxPrice uint32
+	Limit    int
+	Offset   int
+}
+
+// EffectiveLimit clamps the requested limit to (0, MaxListingsLimit], falling back to DefaultListingsLimit.
+func (f ListingFilter) EffectiveLimit() int {
+	if f.Limit <= 0 {
+		return DefaultListingsLimit
+	} else if f.Limit > MaxListingsLimit {
+		return MaxListingsLimit
+	}
+
+	return f.Limit
+}
+
+// MarketplaceFee is the part of the price the seller pays to the company pool, rounded down.
+func MarketplaceFee(price, feePercent uint32) uint32 {
+	return uint32(uint64(price) * uint64(feePercent) / 100)
+}
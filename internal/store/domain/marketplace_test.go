@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarketplaceFee(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name       string
+		price      uint32
+		feePercent uint32
+
+		expectedFee uint32
+	}
+
+	tests := []testCase{
+		{name: "no fee", price: 200, feePercent: 0, expectedFee: 0},
+		{name: "exact percent", price: 200, feePercent: 5, expectedFee: 10},
+		{name: "rounded down", price: 30, feePercent: 5, expectedFee: 1},
+		{name: "whole price", price: 200, feePercent: 100, expectedFee: 200},
+		{name: "large price does not overflow", price: 4_000_000_000, feePercent: 50, expectedFee: 2_000_000_000},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expectedFee, MarketplaceFee(tt.price, tt.feePercent))
+		})
+	}
+}
+
+func TestListingFilter_EffectiveLimit(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, DefaultListingsLimit, ListingFilter{}.EffectiveLimit())
+	assert.Equal(t, 10, ListingFilter{Limit: 10}.EffectiveLimit())
+	assert.Equal(t, MaxListingsLimit, ListingFilter{Limit: MaxListingsLimit + 1}.EffectiveLimit())
+}
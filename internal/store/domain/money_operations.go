@@ -6,10 +6,25 @@ import (
 	"github.com/Lexv0lk/merch-store/internal/pkg/database"
 )
 
+// MaxBatchTransfers caps the number of recipients of a single batch transfer.
+const MaxBatchTransfers = 50
+
 type TransactionProceeder interface {
 	ProceedTransaction(ctx context.Context, executor database.Executor, amount uint32, fromUserID, toUserID int) error
 }
 
+type CompanyPool interface {
+	TransferToPool(ctx context.Context, executor database.Executor, fromUserID int, amount uint32, reason string) error
+}
+
 type Purchaser interface {
 	ProcessPurchase(ctx context.Context, executor database.Executor, userId int, good GoodInfo) error
+	ProcessGift(ctx context.Context, executor database.Executor, buyerID, recipientID int, good GoodInfo, message string) error
+	ProcessBundlePurchase(ctx context.Context, executor database.Executor, userID int, bundle GoodInfo, components []BundleComponent) error
+}
+
+// CoinTransfer is a single recipient of a batch transfer.
+type CoinTransfer struct {
+	ToUsername string
+	Amount     uint32
 }
@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	NotificationBalance  = "balance"
+	NotificationTransfer = "transfer"
+	NotificationOrder    = "order"
+)
+
+type BalanceFetcher interface {
+	FetchUserBalance(ctx context.Context, userId int) (uint32, error)
+}
+
+// Notification is a change pushed to a subscribed user: a new balance, coins received from another user or
+// a status update of a pre-order.
+type Notification struct {
+	Type       string
+	Data       map[string]any
+	OccurredAt time.Time
+}
+
+func BalanceNotification(balance uint32, at time.Time) Notification {
+	return Notification{
+		Type:       NotificationBalance,
+		Data:       map[string]any{"balance": balance},
+		OccurredAt: at,
+	}
+}
+
+func TransferNotification(fromUsername string, amount uint32, at time.Time) Notification {
+	return Notification{
+		Type:       NotificationTransfer,
+		Data:       map[string]any{"fromUser": fromUsername, "amount": amount},
+		OccurredAt: at,
+	}
+}
+
+func OrderNotification(preorderID int, item, variant, status string, at time.Time) Notification {
+	return Notification{
+		Type: NotificationOrder,
+		Data: map[string]any{
+			"preorderId": preorderID,
+			"item":       item,
+			"variant":    variant,
+			"status":     status,
+		},
+		OccurredAt: at,
+	}
+}
@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+)
+
+const (
+	PaymentRequestStatusPending  = "pending"
+	PaymentRequestStatusAccepted = "accepted"
+	PaymentRequestStatusDeclined = "declined"
+
+	DefaultPaymentRequestExpiryDays = 7
+	MaxPaymentRequestExpiryDays     = 30
+	MaxPaymentRequestNoteLength     = 200
+)
+
+type PaymentRequestsRepository interface {
+	CreatePaymentRequest(ctx context.Context, request PaymentRequest) (int, error)
+	ListPendingPaymentRequests(ctx context.Context, payerID int, now time.Time) ([]PaymentRequest, error)
+	LockAndGetPaymentRequest(ctx context.Context, querier database.Querier, requestID int) (PaymentRequest, error)
+	ClosePaymentRequest(ctx context.Context, executor database.Executor, requestID int, status string) error
+}
+
+// PaymentRequest asks the payer to send coins to the requester. It stays pending until it is answered or expires.
+type PaymentRequest struct {
+	Id          int
+	RequesterID int
+	PayerID     int
+	Amount      uint32
+	Note        string
+	Status      string
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+}
+
+func (r PaymentRequest) IsExpired(now time.Time) bool {
+	return !now.Before(r.ExpiresAt)
+}
+
+type NamedPaymentRequest struct {
+	PaymentRequest
+	RequesterUsername string
+}
@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+)
+
+const (
+	PreorderStatusPending   = "pending"
+	PreorderStatusFulfilled = "fulfilled"
+	PreorderStatusCancelled = "cancelled"
+)
+
+type PreordersRepository interface {
+	ListUserPreorders(ctx context.Context, userID int) ([]Preorder, error)
+	FetchHeldCoins(ctx context.Context, userID int) (uint32, error)
+}
+
+// PreorderProceeder keeps the coins of pending pre-orders held: they leave the spendable balance when a pre-order
+// is placed and either go back to it on cancellation or are spent on fulfillment.
+type PreorderProceeder interface {
+	LockUpcomingGood(ctx context.Context, querier database.Querier, goodID int) (bool, error)
+	PlacePreorder(ctx context.Context, executor database.QueryExecuter, preorder Preorder) (int, error)
+	LockAndGetPreorder(ctx context.Context, querier database.Querier, preorderID int) (Preorder, error)
+	LockPendingPreorders(ctx context.Context, querier database.Querier, goodID int) ([]Preorder, error)
+	LockUserPendingPreorders(ctx context.Context, querier database.Querier, userID int) ([]Preorder, error)
+	LockPreorderBalances(ctx context.Context, querier database.Querier, goodID int) (map[int]bool, error)
+	CancelPreorder(ctx context.Context, executor database.Executor, preorder Preorder) error
+	FulfillPreorder(ctx context.Context, executor database.Executor, preorder Preorder) error
+}
+
+// GoodAvailabilityUpdater announces goods as upcoming, open for pre-orders only, and marks them as arrived.
+type GoodAvailabilityUpdater interface {
+	SetGoodUpcoming(ctx context.Context, executor database.Executor, goodID int, upcoming bool) error
+}
+
+// Preorder is a unit of an upcoming good ordered ahead of its arrival. Price is the amount held from the user's
+// balance at order time, VariantID and Variant are set for goods with variants. ResolvedAt is set once the
+// pre-order is fulfilled or cancelled.
+type Preorder struct {
+	Id         int
+	UserID     int
+	GoodID     int
+	GoodName   string
+	VariantID  int
+	Variant    string
+	Price      uint32
+	Status     string
+	CreatedAt  time.Time
+	ResolvedAt time.Time
+}
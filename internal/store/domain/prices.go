@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+)
+
+// PriceSchedulesRepository keeps the scheduled price changes of goods. Schedules are never deleted,
+// so together with the base price they make up the price history of a good.
+type PriceSchedulesRepository interface {
+	CreatePriceSchedule(ctx context.Context, querier database.Querier, schedule PriceSchedule) (int, error)
+	ListPriceSchedules(ctx context.Context, goodID int) ([]PriceSchedule, error)
+}
+
+// PriceSchedule overrides the base price of a good from StartsAt until EndsAt. A zero EndsAt keeps the price
+// until a later schedule starts. When schedules overlap, the one that started last wins.
+type PriceSchedule struct {
+	Id       int
+	GoodID   int
+	Price    uint32
+	StartsAt time.Time
+	EndsAt   time.Time
+}
+
+// CatalogGood is a good at its effective price with its storefront details. SaleEndsAt is set while
+// a time-boxed schedule is active, Category is the slug of the good's category, empty when it has none.
+// Components are set for bundles. Upcoming goods are open for pre-orders only.
+type CatalogGood struct {
+	Id          int
+	Name        string
+	Price       uint32
+	BasePrice   uint32
+	SaleEndsAt  time.Time
+	Category    string
+	Description string
+	Images      []GoodImage
+	Variants    []Variant
+	Components  []BundleComponent
+	Upcoming    bool
+}
+
+type PriceHistory struct {
+	BasePrice uint32
+	Schedules []PriceSchedule
+}
+
+// DiscountedPrice takes percent off the base price, rounding the result down.
+func DiscountedPrice(basePrice, percent uint32) uint32 {
+	return uint32(uint64(basePrice) * uint64(100-min(percent, 100)) / 100)
+}
@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiscountedPrice(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name      string
+		basePrice uint32
+		percent   uint32
+		expected  uint32
+	}
+
+	tests := []testCase{
+		{
+			name:      "thirty percent off",
+			basePrice: 300,
+			percent:   30,
+			expected:  210,
+		},
+		{
+			name:      "rounds down",
+			basePrice: 15,
+			percent:   10,
+			expected:  13,
+		},
+		{
+			name:      "no discount",
+			basePrice: 300,
+			percent:   0,
+			expected:  300,
+		},
+		{
+			name:      "discount above 100 percent",
+			basePrice: 300,
+			percent:   150,
+			expected:  0,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, DiscountedPrice(tt.basePrice, tt.percent))
+		})
+	}
+}
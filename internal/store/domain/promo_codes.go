@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+)
+
+const (
+	PromoKindPercent = "percent"
+	PromoKindFixed   = "fixed"
+
+	MaxPromoCodeLength = 32
+)
+
+type PromoCodesRepository interface {
+	CreatePromoCode(ctx context.Context, querier database.Querier, promo PromoCode) (int, error)
+	GetPromoCode(ctx context.Context, code string) (PromoCode, error)
+}
+
+// PromoRedeemer checks the usage caps of a promo code and records its redemptions. The code is locked
+// for the rest of the transaction, so concurrent purchases can't exceed the caps.
+type PromoRedeemer interface {
+	LockAndGetPromoCode(ctx context.Context, querier database.Querier, code string) (PromoCode, error)
+	CountUserRedemptions(ctx context.Context, querier database.Querier, promoID, userID int) (uint32, error)
+	RecordRedemption(ctx context.Context, executor database.Executor, promoID, userID, goodID int, discount uint32) error
+}
+
+// PromoCode discounts purchases between ValidFrom and ValidUntil. A zero GoodID applies the code to the whole
+// catalog, zero caps mean no cap.
+type PromoCode struct {
+	Id             int
+	Code           string
+	Kind           string
+	Value          uint32
+	GoodID         int
+	MaxUses        uint32
+	MaxUsesPerUser uint32
+	ValidFrom      time.Time
+	ValidUntil     time.Time
+	Uses           uint32
+}
+
+// NormalizePromoCode makes codes case-insensitive.
+func NormalizePromoCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// Discount returns how many coins the code takes off the price. The price never goes below zero.
+func (p PromoCode) Discount(price uint32) uint32 {
+	switch p.Kind {
+	case PromoKindPercent:
+		return uint32(uint64(price) * uint64(min(p.Value, 100)) / 100)
+	case PromoKindFixed:
+		return min(p.Value, price)
+	default:
+		return 0
+	}
+}
+
+// CheckRedeemable reports why the code can't be used for the good at the given time, if it can't.
+// Per-user caps are checked separately, as they depend on the user's redemptions.
+func (p PromoCode) CheckRedeemable(goodID int, now time.Time) error {
+	switch {
+	case now.Before(p.ValidFrom):
+		return &PromoCodeUnusableError{Msg: "promo code is not valid yet"}
+	case !now.Before(p.ValidUntil):
+		return &PromoCodeUnusableError{Msg: "promo code has expired"}
+	case p.GoodID != 0 && p.GoodID != goodID:
+		return &PromoCodeUnusableError{Msg: "promo code does not apply to this item"}
+	case p.MaxUses != 0 && p.Uses >= p.MaxUses:
+		return &PromoCodeUnusableError{Msg: "promo code has been used up"}
+	default:
+		return nil
+	}
+}
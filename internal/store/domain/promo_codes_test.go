@@ -0,0 +1,121 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPromoCode_Discount(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name     string
+		promo    PromoCode
+		price    uint32
+		expected uint32
+	}
+
+	tests := []testCase{
+		{
+			name:     "percent rounds down",
+			promo:    PromoCode{Kind: PromoKindPercent, Value: 15},
+			price:    90,
+			expected: 13,
+		},
+		{
+			name:     "full percent",
+			promo:    PromoCode{Kind: PromoKindPercent, Value: 100},
+			price:    90,
+			expected: 90,
+		},
+		{
+			name:     "fixed",
+			promo:    PromoCode{Kind: PromoKindFixed, Value: 30},
+			price:    90,
+			expected: 30,
+		},
+		{
+			name:     "fixed above the price",
+			promo:    PromoCode{Kind: PromoKindFixed, Value: 500},
+			price:    90,
+			expected: 90,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, tt.promo.Discount(tt.price))
+		})
+	}
+}
+
+func TestPromoCode_CheckRedeemable(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 6, 14, 12, 0, 0, 0, time.UTC)
+	valid := PromoCode{ValidFrom: now.Add(-time.Hour), ValidUntil: now.Add(time.Hour)}
+
+	type testCase struct {
+		name   string
+		modify func(p *PromoCode)
+
+		expectedErr bool
+	}
+
+	tests := []testCase{
+		{
+			name:   "catalog-wide code",
+			modify: func(p *PromoCode) {},
+		},
+		{
+			name:   "code for the good",
+			modify: func(p *PromoCode) { p.GoodID = 10 },
+		},
+		{
+			name:        "code for another good",
+			modify:      func(p *PromoCode) { p.GoodID = 11 },
+			expectedErr: true,
+		},
+		{
+			name:        "not valid yet",
+			modify:      func(p *PromoCode) { p.ValidFrom = now.Add(time.Minute) },
+			expectedErr: true,
+		},
+		{
+			name:        "expired",
+			modify:      func(p *PromoCode) { p.ValidUntil = now },
+			expectedErr: true,
+		},
+		{
+			name:   "uses left",
+			modify: func(p *PromoCode) { p.MaxUses, p.Uses = 5, 4 },
+		},
+		{
+			name:        "used up",
+			modify:      func(p *PromoCode) { p.MaxUses, p.Uses = 5, 5 },
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			promo := valid
+			tt.modify(&promo)
+
+			err := promo.CheckRedeemable(10, now)
+
+			if tt.expectedErr {
+				assert.ErrorIs(t, err, &PromoCodeUnusableError{})
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+)
+
+const MaxPurchaseLimitPeriod = 365 * 24 * time.Hour
+
+// PurchaseLimitsRepository keeps the per-user purchase limits of goods, changed in the transaction of their
+// audit entry.
+type PurchaseLimitsRepository interface {
+	SetPurchaseLimit(ctx context.Context, executor database.Executor, limit PurchaseLimit) error
+	RemovePurchaseLimit(ctx context.Context, executor database.Executor, goodID int) error
+}
+
+// PurchaseLimitChecker reads the limits of goods and the units a user got within a limit window, in the
+// transaction handing the good to the user.
+type PurchaseLimitChecker interface {
+	ListPurchaseLimits(ctx context.Context, querier database.Querier, goodIDs []int) ([]PurchaseLimit, error)
+	CountUserAcquisitions(ctx context.Context, querier database.Querier, userID, goodID int, since time.Time) (uint32, error)
+}
+
+// PurchaseLimit caps the units of a good a user can get within a rolling Period. A zero Period caps them
+// for good.
+type PurchaseLimit struct {
+	GoodID      int
+	GoodName    string
+	MaxQuantity uint32
+	Period      time.Duration
+}
+
+// WindowStart returns the start of the window ending at now, the zero time for limits without a period.
+func (l PurchaseLimit) WindowStart(now time.Time) time.Time {
+	if l.Period == 0 {
+		return time.Time{}
+	}
+
+	return now.Add(-l.Period)
+}
+
+// Check fails with a PurchaseLimitError when quantity more units on top of the acquired ones exceed the limit.
+func (l PurchaseLimit) Check(acquired, quantity uint32) error {
+	if uint64(acquired)+uint64(quantity) <= uint64(l.MaxQuantity) {
+		return nil
+	}
+
+	if l.Period == 0 {
+		return &PurchaseLimitError{Msg: fmt.Sprintf("purchase limit reached: at most %d %s per person", l.MaxQuantity, l.GoodName)}
+	}
+
+	return &PurchaseLimitError{Msg: fmt.Sprintf("purchase limit reached: at most %d %s per %d days",
+		l.MaxQuantity, l.GoodName, int(l.Period/(24*time.Hour)))}
+}
@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPurchaseLimit_Check(t *testing.T) {
+	t.Parallel()
+
+	perPerson := PurchaseLimit{GoodID: 8, GoodName: "pink-hoody", MaxQuantity: 1}
+	perQuarter := PurchaseLimit{GoodID: 2, GoodName: "cup", MaxQuantity: 3, Period: 90 * 24 * time.Hour}
+
+	assert.NoError(t, perPerson.Check(0, 1))
+	assert.ErrorIs(t, perPerson.Check(1, 1), &PurchaseLimitError{})
+	assert.NoError(t, perQuarter.Check(2, 1))
+	assert.ErrorIs(t, perQuarter.Check(2, 2), &PurchaseLimitError{})
+	assert.EqualError(t, perQuarter.Check(3, 1), "purchase limit reached: at most 3 cup per 90 days")
+}
+
+func TestPurchaseLimit_WindowStart(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	assert.True(t, PurchaseLimit{MaxQuantity: 1}.WindowStart(now).IsZero())
+	assert.Equal(t, now.AddDate(0, 0, -90), PurchaseLimit{MaxQuantity: 3, Period: 90 * 24 * time.Hour}.WindowStart(now))
+}
@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+)
+
+const (
+	RaffleStatusOpen  = "open"
+	RaffleStatusDrawn = "drawn"
+	RaffleStatusVoid  = "void"
+
+	MaxRaffleDuration           = 30 * 24 * time.Hour
+	MaxRaffleTicketsPerPurchase = 100
+	RafflesDrawBatch            = 100
+
+	raffleSeedSize = 32
+)
+
+type RafflesRepository interface {
+	CreateRaffle(ctx context.Context, querier database.Querier, raffle Raffle) (int, error)
+	GetRaffle(ctx context.Context, raffleID int) (Raffle, error)
+	ListOpenRaffles(ctx context.Context) ([]Raffle, error)
+}
+
+// RaffleTicketsProceeder sells raffle tickets. The coins spent on tickets are only returned to owners who are
+// refunded before the draw.
+type RaffleTicketsProceeder interface {
+	LockAndGetRaffle(ctx context.Context, querier database.Querier, raffleID int) (Raffle, error)
+	IssueTickets(ctx context.Context, executor database.Executor, raffleID, userID int, count, totalPrice uint32) error
+	// RefundUserTickets locks the open raffles the user has tickets in, refunds those tickets and returns the
+	// coins given back.
+	RefundUserTickets(ctx context.Context, executor database.QueryExecuter, userID int) (uint32, error)
+}
+
+type RaffleDrawer interface {
+	FetchDueRaffles(ctx context.Context, now time.Time, limit int) ([]int, error)
+	FetchTickets(ctx context.Context, querier database.Querier, raffleID int) ([]RaffleTicket, error)
+	CompleteDraw(ctx context.Context, executor database.Executor, raffle Raffle, draw RaffleDraw) error
+	// RefundTickets takes the user's tickets out of the raffle and gives back the coins spent on them.
+	RefundTickets(ctx context.Context, executor database.Executor, raffle Raffle, userID int) error
+	CloseVoidRaffle(ctx context.Context, executor database.Executor, raffleID int) error
+}
+
+// Raffle gives a single item to the owner of a randomly drawn ticket at DrawAt.
+type Raffle struct {
+	Id          int
+	GoodID      int
+	GoodName    string
+	TicketPrice uint32
+	DrawAt      time.Time
+	Status      string
+	TicketsSold uint32
+}
+
+type RaffleTicket struct {
+	Id     int
+	UserID int
+}
+
+// RaffleDraw is the outcome of a draw. Seed is kept with the raffle, so the winner can be recomputed
+// from it and the tickets ordered by id.
+type RaffleDraw struct {
+	Seed     string
+	TicketID int
+	WinnerID int
+}
+
+func (r Raffle) IsSellingTickets(now time.Time) bool {
+	return r.Status == RaffleStatusOpen && now.Before(r.DrawAt)
+}
+
+// SpentBy returns the coins the user spent on the tickets, capped at math.MaxUint32.
+func (r Raffle) SpentBy(tickets []RaffleTicket, userID int) uint32 {
+	var spent uint64
+	for _, ticket := range tickets {
+		if ticket.UserID == userID {
+			spent += uint64(r.TicketPrice)
+		}
+	}
+
+	return uint32(min(spent, math.MaxUint32))
+}
+
+// NewRaffleSeed returns a hex encoded random seed for a draw.
+func NewRaffleSeed() (string, error) {
+	seed := make([]byte, raffleSeedSize)
+	_, err := rand.Read(seed)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate raffle seed: %w", err)
+	}
+
+	return hex.EncodeToString(seed), nil
+}
+
+// DrawWinningTicket picks the index of the winning ticket out of ticketsCount tickets ordered by id.
+// The index is the SHA-256 of the seed modulo ticketsCount, so the same seed always yields the same winner.
+func DrawWinningTicket(seed string, ticketsCount int) (int, error) {
+	if ticketsCount <= 0 {
+		return 0, fmt.Errorf("no tickets to draw from")
+	}
+
+	seedBytes, err := hex.DecodeString(seed)
+	if err != nil {
+		return 0, fmt.Errorf("invalid raffle seed: %w", err)
+	}
+
+	sum := sha256.Sum256(seedBytes)
+	index := new(big.Int).Mod(new(big.Int).SetBytes(sum[:]), big.NewInt(int64(ticketsCount)))
+
+	return int(index.Int64()), nil
+}
@@ -0,0 +1,139 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRaffle_IsSellingTickets(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 5, 31, 12, 0, 0, 0, time.UTC)
+
+	type testCase struct {
+		name     string
+		raffle   Raffle
+		expected bool
+	}
+
+	tests := []testCase{
+		{
+			name:     "before the draw",
+			raffle:   Raffle{Status: RaffleStatusOpen, DrawAt: now.Add(time.Hour)},
+			expected: true,
+		},
+		{
+			name:     "draw time reached but not drawn",
+			raffle:   Raffle{Status: RaffleStatusOpen, DrawAt: now},
+			expected: false,
+		},
+		{
+			name:     "already drawn",
+			raffle:   Raffle{Status: RaffleStatusDrawn, DrawAt: now.Add(time.Hour)},
+			expected: false,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, tt.raffle.IsSellingTickets(now))
+		})
+	}
+}
+
+func TestRaffle_SpentBy(t *testing.T) {
+	t.Parallel()
+
+	raffle := Raffle{TicketPrice: 5}
+	tickets := []RaffleTicket{{Id: 11, UserID: 2}, {Id: 12, UserID: 3}, {Id: 13, UserID: 2}}
+
+	assert.Equal(t, uint32(10), raffle.SpentBy(tickets, 2))
+	assert.Equal(t, uint32(5), raffle.SpentBy(tickets, 3))
+	assert.Equal(t, uint32(0), raffle.SpentBy(tickets, 4))
+}
+
+func TestDrawWinningTicket(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name         string
+		seed         string
+		ticketsCount int
+
+		expectedIndex int
+		expectedErr   bool
+	}
+
+	tests := []testCase{
+		{
+			name:          "zero seed out of three tickets",
+			seed:          strings.Repeat("00", 32),
+			ticketsCount:  3,
+			expectedIndex: 2,
+		},
+		{
+			name:          "zero seed out of seven tickets",
+			seed:          strings.Repeat("00", 32),
+			ticketsCount:  7,
+			expectedIndex: 4,
+		},
+		{
+			name:          "other seed out of three tickets",
+			seed:          strings.Repeat("ff", 32),
+			ticketsCount:  3,
+			expectedIndex: 1,
+		},
+		{
+			name:          "single ticket always wins",
+			seed:          strings.Repeat("ff", 32),
+			ticketsCount:  1,
+			expectedIndex: 0,
+		},
+		{
+			name:         "no tickets",
+			seed:         strings.Repeat("00", 32),
+			ticketsCount: 0,
+			expectedErr:  true,
+		},
+		{
+			name:         "seed is not hex",
+			seed:         "not-a-seed",
+			ticketsCount: 3,
+			expectedErr:  true,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			index, err := DrawWinningTicket(tt.seed, tt.ticketsCount)
+
+			if tt.expectedErr {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedIndex, index)
+			}
+		})
+	}
+}
+
+func TestNewRaffleSeed(t *testing.T) {
+	t.Parallel()
+
+	seed, err := NewRaffleSeed()
+	require.NoError(t, err)
+	assert.Len(t, seed, 64)
+
+	other, err := NewRaffleSeed()
+	require.NoError(t, err)
+	assert.NotEqual(t, seed, other)
+}
@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+)
+
+const (
+	ScheduledTransferStatusActive    = "active"
+	ScheduledTransferStatusCompleted = "completed"
+	ScheduledTransferStatusCancelled = "cancelled"
+	ScheduledTransferStatusFailed    = "failed"
+
+	RecurrenceOnce    = "once"
+	RecurrenceWeekly  = "weekly"
+	RecurrenceMonthly = "monthly"
+
+	MaxScheduledTransferAttempts   = 3
+	ScheduledTransferRetryDelay    = time.Hour
+	MaxScheduledTransferLeadTime   = 365 * 24 * time.Hour
+	MaxActiveScheduledTransfers    = 20
+	ScheduledTransfersExecuteBatch = 100
+)
+
+type ScheduledTransfersRepository interface {
+	CreateScheduledTransfer(ctx context.Context, transfer ScheduledTransfer) (int, error)
+	CountActiveScheduledTransfers(ctx context.Context, senderID int) (int, error)
+	ListScheduledTransfers(ctx context.Context, senderID int) ([]ScheduledTransfer, error)
+	CancelScheduledTransfer(ctx context.Context, senderID, transferID int) error
+}
+
+type ScheduledTransfersExecutor interface {
+	FetchDueScheduledTransfers(ctx context.Context, now time.Time, limit int) ([]int, error)
+	LockAndGetScheduledTransfer(ctx context.Context, querier database.Querier, transferID int) (ScheduledTransfer, error)
+	UpdateScheduledTransfer(ctx context.Context, executor database.Executor, transfer ScheduledTransfer) error
+	// CancelSenderScheduledTransfers cancels every active transfer of the sender and returns how many were cancelled.
+	CancelSenderScheduledTransfers(ctx context.Context, executor database.Executor, senderID int) (int, error)
+}
+
+// ScheduledTransfer is a coin transfer the store executes on its own at NextRunAt and, for recurring
+// transfers, every week or month after that. Occurrences are counted from StartsAt, the first run.
+// NextAttemptAt is pushed back while a run is being retried.
+type ScheduledTransfer struct {
+	Id            int
+	SenderID      int
+	RecipientID   int
+	Amount        uint32
+	Recurrence    string
+	Status        string
+	StartsAt      time.Time
+	NextRunAt     time.Time
+	NextAttemptAt time.Time
+	Attempts      int
+	LastError     string
+	CreatedAt     time.Time
+}
+
+func IsValidRecurrence(recurrence string) bool {
+	switch recurrence {
+	case RecurrenceOnce, RecurrenceWeekly, RecurrenceMonthly:
+		return true
+	default:
+		return false
+	}
+}
+
+// Advance moves a recurring transfer to its first occurrence after now and resets the retry state.
+// Occurrences missed while the store was down are skipped rather than executed in a burst.
+// A one-off transfer is closed with finalStatus instead.
+func (t *ScheduledTransfer) Advance(now time.Time, finalStatus string) {
+	t.Attempts = 0
+
+	if t.Recurrence == RecurrenceOnce {
+		t.Status = finalStatus
+		return
+	}
+
+	for n := 1; !t.NextRunAt.After(now); n++ {
+		if t.Recurrence == RecurrenceWeekly {
+			t.NextRunAt = t.StartsAt.AddDate(0, 0, 7*n)
+		} else {
+			t.NextRunAt = addMonths(t.StartsAt, n)
+		}
+	}
+	t.NextAttemptAt = t.NextRunAt
+}
+
+// addMonths moves the time by the number of months, keeping its day of month unless the target month is
+// shorter. Then the last day of that month is taken, so a transfer starting on Jan 31 runs on Feb 28 and Mar 31.
+func addMonths(anchor time.Time, months int) time.Time {
+	year, month, day := anchor.Date()
+	firstOfMonth := time.Date(year, month+time.Month(months), 1, anchor.Hour(), anchor.Minute(), anchor.Second(),
+		anchor.Nanosecond(), anchor.Location())
+	lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+
+	return firstOfMonth.AddDate(0, 0, min(day, lastDay)-1)
+}
+
+type NamedScheduledTransfer struct {
+	ScheduledTransfer
+	RecipientUsername string
+}
@@ -0,0 +1,95 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduledTransfer_Advance(t *testing.T) {
+	t.Parallel()
+
+	runAt := time.Date(2026, 4, 27, 9, 0, 0, 0, time.UTC)
+	endOfMonth := time.Date(2026, 1, 31, 9, 0, 0, 0, time.UTC)
+
+	type testCase struct {
+		name        string
+		transfer    ScheduledTransfer
+		now         time.Time
+		finalStatus string
+
+		expectedStatus    string
+		expectedNextRunAt time.Time
+	}
+
+	tests := []testCase{
+		{
+			name:              "one-off transfer is closed",
+			transfer:          ScheduledTransfer{Recurrence: RecurrenceOnce, Status: ScheduledTransferStatusActive, NextRunAt: runAt, Attempts: 2},
+			now:               runAt.Add(time.Minute),
+			finalStatus:       ScheduledTransferStatusCompleted,
+			expectedStatus:    ScheduledTransferStatusCompleted,
+			expectedNextRunAt: runAt,
+		},
+		{
+			name:              "weekly transfer moves to next week",
+			transfer:          ScheduledTransfer{Recurrence: RecurrenceWeekly, Status: ScheduledTransferStatusActive, StartsAt: runAt, NextRunAt: runAt, Attempts: 2},
+			now:               runAt.Add(2 * time.Hour),
+			finalStatus:       ScheduledTransferStatusFailed,
+			expectedStatus:    ScheduledTransferStatusActive,
+			expectedNextRunAt: runAt.AddDate(0, 0, 7),
+		},
+		{
+			name:              "monthly transfer moves to next month",
+			transfer:          ScheduledTransfer{Recurrence: RecurrenceMonthly, Status: ScheduledTransferStatusActive, StartsAt: runAt, NextRunAt: runAt},
+			now:               runAt,
+			finalStatus:       ScheduledTransferStatusCompleted,
+			expectedStatus:    ScheduledTransferStatusActive,
+			expectedNextRunAt: time.Date(2026, 5, 27, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:              "missed occurrences are skipped",
+			transfer:          ScheduledTransfer{Recurrence: RecurrenceWeekly, Status: ScheduledTransferStatusActive, StartsAt: runAt, NextRunAt: runAt},
+			now:               runAt.AddDate(0, 0, 20),
+			finalStatus:       ScheduledTransferStatusCompleted,
+			expectedStatus:    ScheduledTransferStatusActive,
+			expectedNextRunAt: runAt.AddDate(0, 0, 21),
+		},
+		{
+			name: "monthly transfer is clamped to the end of a shorter month",
+			transfer: ScheduledTransfer{Recurrence: RecurrenceMonthly, Status: ScheduledTransferStatusActive,
+				StartsAt: endOfMonth, NextRunAt: endOfMonth},
+			now:               endOfMonth,
+			finalStatus:       ScheduledTransferStatusCompleted,
+			expectedStatus:    ScheduledTransferStatusActive,
+			expectedNextRunAt: time.Date(2026, 2, 28, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "monthly transfer returns to its day after a shorter month",
+			transfer: ScheduledTransfer{Recurrence: RecurrenceMonthly, Status: ScheduledTransferStatusActive,
+				StartsAt: endOfMonth, NextRunAt: time.Date(2026, 2, 28, 9, 0, 0, 0, time.UTC)},
+			now:               time.Date(2026, 2, 28, 9, 0, 0, 0, time.UTC),
+			finalStatus:       ScheduledTransferStatusCompleted,
+			expectedStatus:    ScheduledTransferStatusActive,
+			expectedNextRunAt: time.Date(2026, 3, 31, 9, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			transfer := tt.transfer
+			transfer.Advance(tt.now, tt.finalStatus)
+
+			assert.Equal(t, tt.expectedStatus, transfer.Status)
+			assert.Equal(t, tt.expectedNextRunAt, transfer.NextRunAt)
+			assert.Equal(t, 0, transfer.Attempts)
+			if transfer.Status == ScheduledTransferStatusActive {
+				assert.Equal(t, tt.expectedNextRunAt, transfer.NextAttemptAt)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+)
+
+// TeamsRepository changes teams in the transaction of their audit entry.
+type TeamsRepository interface {
+	CreateTeam(ctx context.Context, querier database.Querier, name string, managerID int) (int, error)
+	AddTeamMember(ctx context.Context, executor database.QueryExecuter, teamName string, userID int) error
+	SetBudgetTopUp(ctx context.Context, executor database.Executor, teamName string, amount uint32, period time.Duration) error
+}
+
+type TeamBudgetLocker interface {
+	LockAndGetTeam(ctx context.Context, querier database.Querier, teamName string) (TeamInfo, error)
+}
+
+type TeamMembershipChecker interface {
+	IsTeamMember(ctx context.Context, querier database.Querier, teamID, userID int) (bool, error)
+}
+
+type TeamBudgetProceeder interface {
+	// ProceedTeamTransfer moves coins from the team budget to the member and records it as a transfer sent by
+	// the manager on behalf of the team.
+	ProceedTeamTransfer(ctx context.Context, executor database.Executor, teamID int, amount uint32, managerID, toUserID int) error
+}
+
+type TeamBudgetTopUpper interface {
+	TopUpDueBudgets(ctx context.Context, querier database.Querier) ([]TeamTopUp, error)
+}
+
+// TeamTopUp is a periodic top-up credited to the team budget.
+type TeamTopUp struct {
+	TeamName string
+	Amount   uint32
+}
+
+type TeamInfo struct {
+	Id        int
+	Name      string
+	ManagerID int
+	Budget    uint32
+}
@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+)
+
+type TransferLimitsProvider interface {
+	GetTransferLimits(ctx context.Context, querier database.Querier, userId int) (TransferLimits, error)
+}
+
+type TransferStatsFetcher interface {
+	FetchTransferStats(ctx context.Context, querier database.Querier, fromUserID, toUserID int) (TransferStats, error)
+}
+
+type TransferLimitsRepository interface {
+	SetDefaultTransferLimits(ctx context.Context, executor database.Executor, limits TransferLimits) error
+	SetUserTransferLimits(ctx context.Context, executor database.Executor, userId int, limits TransferLimits) error
+}
+
+// TransferLimits holds the rules applied to outgoing transfers. A zero value disables the rule.
+type TransferLimits struct {
+	MaxSingleTransfer           uint32
+	DailyOutgoingCap            uint32
+	MaxTransfersPerHour         uint32
+	MaxReceivedFromSenderPerDay uint32
+}
+
+// TransferStats describes the sender's recent activity over rolling windows.
+type TransferStats struct {
+	SentLastDay               uint32
+	TransfersLastHour         uint32
+	ReceivedFromSenderLastDay uint32
+}
+
+// CheckOutgoing applies the sender-side rules.
+func (l TransferLimits) CheckOutgoing(amount uint32, stats TransferStats) error {
+	switch {
+	case l.MaxSingleTransfer > 0 && amount > l.MaxSingleTransfer:
+		return &LimitExceededError{Msg: fmt.Sprintf("single transfer limit of %d exceeded", l.MaxSingleTransfer)}
+	case l.DailyOutgoingCap > 0 && uint64(stats.SentLastDay)+uint64(amount) > uint64(l.DailyOutgoingCap):
+		return &LimitExceededError{Msg: fmt.Sprintf("daily outgoing limit of %d exceeded", l.DailyOutgoingCap)}
+	case l.MaxTransfersPerHour > 0 && stats.TransfersLastHour >= l.MaxTransfersPerHour:
+		return &LimitExceededError{Msg: fmt.Sprintf("limit of %d transfers per hour exceeded", l.MaxTransfersPerHour)}
+	default:
+		return nil
+	}
+}
+
+// CheckIncoming applies the recipient-side rules.
+func (l TransferLimits) CheckIncoming(amount uint32, stats TransferStats) error {
+	if l.MaxReceivedFromSenderPerDay > 0 &&
+		uint64(stats.ReceivedFromSenderLastDay)+uint64(amount) > uint64(l.MaxReceivedFromSenderPerDay) {
+		return &LimitExceededError{Msg: fmt.Sprintf("recipient daily limit of %d from a single sender exceeded", l.MaxReceivedFromSenderPerDay)}
+	}
+
+	return nil
+}
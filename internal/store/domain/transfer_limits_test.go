@@ -0,0 +1,126 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransferLimits_CheckOutgoing(t *testing.T) {
+	t.Parallel()
+
+	limits := TransferLimits{
+		MaxSingleTransfer:   500,
+		DailyOutgoingCap:    1000,
+		MaxTransfersPerHour: 3,
+	}
+
+	type testCase struct {
+		name   string
+		limits TransferLimits
+		amount uint32
+		stats  TransferStats
+
+		expectedErr error
+	}
+
+	tests := []testCase{
+		{
+			name:   "within limits",
+			limits: limits,
+			amount: 500,
+			stats:  TransferStats{SentLastDay: 500, TransfersLastHour: 2},
+		},
+		{
+			name:        "single transfer too large",
+			limits:      limits,
+			amount:      501,
+			expectedErr: &LimitExceededError{},
+		},
+		{
+			name:        "daily cap reached",
+			limits:      limits,
+			amount:      100,
+			stats:       TransferStats{SentLastDay: 950},
+			expectedErr: &LimitExceededError{},
+		},
+		{
+			name:        "too many transfers per hour",
+			limits:      limits,
+			amount:      10,
+			stats:       TransferStats{TransfersLastHour: 3},
+			expectedErr: &LimitExceededError{},
+		},
+		{
+			name:   "zero limits disable rules",
+			limits: TransferLimits{},
+			amount: 100000,
+			stats:  TransferStats{SentLastDay: 100000, TransfersLastHour: 1000},
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.limits.CheckOutgoing(tt.amount, tt.stats)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTransferLimits_CheckIncoming(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name   string
+		limits TransferLimits
+		amount uint32
+		stats  TransferStats
+
+		expectedErr error
+	}
+
+	tests := []testCase{
+		{
+			name:   "within limit",
+			limits: TransferLimits{MaxReceivedFromSenderPerDay: 500},
+			amount: 100,
+			stats:  TransferStats{ReceivedFromSenderLastDay: 400},
+		},
+		{
+			name:        "limit from single sender exceeded",
+			limits:      TransferLimits{MaxReceivedFromSenderPerDay: 500},
+			amount:      101,
+			stats:       TransferStats{ReceivedFromSenderLastDay: 400},
+			expectedErr: &LimitExceededError{},
+		},
+		{
+			name:   "rule disabled",
+			limits: TransferLimits{},
+			amount: 1000,
+			stats:  TransferStats{ReceivedFromSenderLastDay: 1000},
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.limits.CheckIncoming(tt.amount, tt.stats)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
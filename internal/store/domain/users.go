@@ -16,10 +16,31 @@ type UserBalanceLocker interface {
 	LockAndGetUserBalance(ctx context.Context, querier database.Querier, userId int) (uint32, error)
 }
 
+type BalanceStatusChecker interface {
+	IsBalanceActive(ctx context.Context, querier database.Querier, userId int) (bool, error)
+	IsBalanceFrozen(ctx context.Context, querier database.Querier, userId int) (bool, error)
+}
+
+type BalanceDeactivator interface {
+	DeactivateBalance(ctx context.Context, executor database.Executor, userId int) error
+}
+
+type BalanceFreezer interface {
+	FreezeBalance(ctx context.Context, executor database.Executor, userId int) error
+	UnfreezeBalance(ctx context.Context, executor database.Executor, userId int) error
+}
+
+type FreezeLogRecorder interface {
+	RecordFreezeChange(ctx context.Context, executor database.Executor, change FreezeChange) error
+}
+
 type UserInfoRepository interface {
 	FetchUserBalance(ctx context.Context, userId int) (uint32, error)
 	FetchUserPurchases(ctx context.Context, userId int) (map[Good]uint32, error)
 	FetchUserCoinTransfers(ctx context.Context, userId int) (TransferHistory, error)
+	FetchUserGifts(ctx context.Context, userId int) ([]Gift, error)
+	FetchUserItemTransfers(ctx context.Context, userId int) (ItemTransferHistory, error)
+	FetchUserWishlist(ctx context.Context, userId int) ([]WishlistItem, error)
 }
 
 type UsernameGetter interface {
@@ -29,6 +50,11 @@ type UsernameGetter interface {
 
 type UserIDFetcher interface {
 	FetchUserID(ctx context.Context, username string) (int, error)
+	FetchUserIDs(ctx context.Context, usernames ...string) (map[string]int, error)
+}
+
+type UserDeactivator interface {
+	DeactivateUser(ctx context.Context, userId int) error
 }
 
 type UserInfo struct {
@@ -37,6 +63,14 @@ type UserInfo struct {
 	Balance  uint32
 }
 
+// FreezeChange is an audit log entry of an admin freezing or unfreezing a balance.
+type FreezeChange struct {
+	UserID  int
+	AdminID int
+	Frozen  bool
+	Reason  string
+}
+
 type MainUserInfo struct {
 	Username string
 	Balance  uint32
@@ -46,11 +80,16 @@ type TotalUserInfo struct {
 	Username            string
 	Balance             uint32
 	Goods               map[Good]uint32
+	Gifts               []NamedGift
 	CoinTransferHistory NamedTransferHistory
+	ItemTransferHistory NamedItemTransferHistory
+	Wishlist            []WishlistItem
 }
 
+// Good is an inventory entry. Variant is the SKU of the bought variant, empty for goods bought without one.
 type Good struct {
-	Name string
+	Name    string
+	Variant string
 }
 
 type TransferHistory struct {
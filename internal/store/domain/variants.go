@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+)
+
+// VariantsRepository reads the variants of goods, e.g. the sizes of clothing.
+type VariantsRepository interface {
+	ListGoodVariants(ctx context.Context, goodID int) ([]Variant, error)
+	ListVariants(ctx context.Context) ([]Variant, error)
+}
+
+// StockKeeper takes bought units out of the variant stock in the purchase transaction. Taking from a variant
+// without tracked stock always succeeds.
+type StockKeeper interface {
+	TakeFromStock(ctx context.Context, executor database.Executor, variantID int, quantity uint32) error
+}
+
+// Variant is a stock keeping unit of a good. PriceDelta is added to the effective price of the good.
+// A nil Stock means the stock of the variant isn't tracked.
+type Variant struct {
+	Id         int
+	GoodID     int
+	SKU        string
+	Attributes map[string]string
+	PriceDelta int32
+	Stock      *uint32
+}
+
+// Price applies the variant's delta to the good price, never going below zero.
+func (v Variant) Price(goodPrice uint32) uint32 {
+	price := int64(goodPrice) + int64(v.PriceDelta)
+	if price < 0 {
+		return 0
+	}
+
+	return uint32(price)
+}
+
+// PickVariant finds the variant with the given SKU. A good with variants can only be bought as one of them,
+// a good without variants only without one, in which case the zero Variant is returned.
+func PickVariant(variants []Variant, sku string) (Variant, error) {
+	if len(variants) == 0 {
+		if sku != "" {
+			return Variant{}, &VariantNotFoundError{Msg: "item has no variants"}
+		}
+
+		return Variant{}, nil
+	}
+
+	if sku == "" {
+		skus := make([]string, 0, len(variants))
+		for _, variant := range variants {
+			skus = append(skus, variant.SKU)
+		}
+
+		return Variant{}, &InvalidArgumentsError{Msg: fmt.Sprintf("variant must be one of: %s", strings.Join(skus, ", "))}
+	}
+
+	for _, variant := range variants {
+		if variant.SKU == sku {
+			return variant, nil
+		}
+	}
+
+	return Variant{}, &VariantNotFoundError{Msg: fmt.Sprintf("variant %s not found", sku)}
+}
+
+// OwnedVariant finds the variant of owned units with the given SKU. An empty SKU stands for the units
+// without a variant, e.g. the ones won in auctions or bought before the good got variants.
+func OwnedVariant(variants []Variant, sku string) (Variant, error) {
+	if sku == "" {
+		return Variant{}, nil
+	}
+
+	for _, variant := range variants {
+		if variant.SKU == sku {
+			return variant, nil
+		}
+	}
+
+	return Variant{}, &VariantNotFoundError{Msg: fmt.Sprintf("variant %s not found", sku)}
+}
@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPickVariant(t *testing.T) {
+	t.Parallel()
+
+	sizes := []Variant{
+		{Id: 1, GoodID: 6, SKU: "hoody-s"},
+		{Id: 2, GoodID: 6, SKU: "hoody-m"},
+	}
+
+	type testCase struct {
+		name     string
+		variants []Variant
+		sku      string
+
+		expected    Variant
+		expectedErr error
+	}
+
+	tests := []testCase{
+		{
+			name:     "variant picked",
+			variants: sizes,
+			sku:      "hoody-m",
+			expected: sizes[1],
+		},
+		{
+			name:        "variant missing",
+			variants:    sizes,
+			expectedErr: &InvalidArgumentsError{},
+		},
+		{
+			name:        "unknown variant",
+			variants:    sizes,
+			sku:         "hoody-xxl",
+			expectedErr: &VariantNotFoundError{},
+		},
+		{
+			name: "good without variants",
+		},
+		{
+			name:        "variant of a good without variants",
+			sku:         "cup-s",
+			expectedErr: &VariantNotFoundError{},
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			variant, err := PickVariant(tt.variants, tt.sku)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, variant)
+			}
+		})
+	}
+}
+
+func TestOwnedVariant(t *testing.T) {
+	t.Parallel()
+
+	sizes := []Variant{
+		{Id: 1, GoodID: 6, SKU: "hoody-s"},
+		{Id: 2, GoodID: 6, SKU: "hoody-m"},
+	}
+
+	variant, err := OwnedVariant(sizes, "hoody-s")
+	assert.NoError(t, err)
+	assert.Equal(t, sizes[0], variant)
+
+	variant, err = OwnedVariant(sizes, "")
+	assert.NoError(t, err)
+	assert.Equal(t, Variant{}, variant)
+
+	_, err = OwnedVariant(sizes, "hoody-xxl")
+	assert.ErrorIs(t, err, &VariantNotFoundError{})
+
+	_, err = OwnedVariant(nil, "cup-s")
+	assert.ErrorIs(t, err, &VariantNotFoundError{})
+}
+
+func TestVariant_Price(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, uint32(90), Variant{PriceDelta: 10}.Price(80))
+	assert.Equal(t, uint32(70), Variant{PriceDelta: -10}.Price(80))
+	assert.Equal(t, uint32(0), Variant{PriceDelta: -100}.Price(80))
+}
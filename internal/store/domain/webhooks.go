@@ -0,0 +1,255 @@
+package domain
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+)
+
+const (
+	WebhookEventPurchase     = "purchase"
+	WebhookEventGift         = "gift"
+	WebhookEventCancellation = "cancellation"
+
+	MaxWebhookAttempts     = 8
+	WebhookRetryBaseDelay  = time.Minute
+	WebhookDeliveryBatch   = 100
+	MaxWebhookEndpoints    = 20
+	MaxWebhookURLLength    = 2048
+	DefaultDeadLetterLimit = 100
+
+	webhookSecretSize = 32
+)
+
+// WebhookEndpointsRepository keeps the endpoints webhooks are delivered to and the deliveries that ran out of attempts.
+type WebhookEndpointsRepository interface {
+	CreateWebhookEndpoint(ctx context.Context, querier database.Querier, endpoint WebhookEndpoint) (int, error)
+	CountWebhookEndpoints(ctx context.Context) (int, error)
+	ListWebhookEndpoints(ctx context.Context) ([]WebhookEndpoint, error)
+	DeleteWebhookEndpoint(ctx context.Context, executor database.Executor, endpointID int) error
+	ListWebhookDeadLetters(ctx context.Context, limit int) ([]WebhookDelivery, error)
+}
+
+// WebhookPublisher queues a delivery of the event to every endpoint subscribed to its type. It runs in the
+// transaction of the change the event reports, so a webhook is only sent for committed changes.
+type WebhookPublisher interface {
+	PublishWebhook(ctx context.Context, executor database.Executor, event WebhookEvent) error
+}
+
+// WebhookDeliveryQueue holds the queued deliveries. A delivery leaves the queue once it is delivered or, after
+// MaxWebhookAttempts failed attempts, is moved to the dead letters.
+type WebhookDeliveryQueue interface {
+	FetchDueWebhookDeliveries(ctx context.Context, now time.Time, limit int) ([]int, error)
+	LockAndGetWebhookDelivery(ctx context.Context, querier database.Querier, deliveryID int) (WebhookDelivery, error)
+	CompleteWebhookDelivery(ctx context.Context, executor database.Executor, deliveryID int) error
+	ScheduleWebhookRetry(ctx context.Context, executor database.Executor, delivery WebhookDelivery) error
+	MoveWebhookToDeadLetters(ctx context.Context, executor database.Executor, delivery WebhookDelivery) error
+}
+
+// WebhookSender posts a delivery to its endpoint. Any error, including a non-2xx response, fails the attempt.
+type WebhookSender interface {
+	SendWebhook(ctx context.Context, delivery WebhookDelivery) error
+}
+
+// WebhookEndpoint receives the events listed in Events. Secret signs the payloads and is only shown on creation.
+type WebhookEndpoint struct {
+	Id        int
+	URL       string
+	Secret    string
+	Events    []string
+	CreatedAt time.Time
+}
+
+// WebhookEvent is a change reported to the endpoints subscribed to Type. Data is sent as JSON.
+type WebhookEvent struct {
+	Type string
+	Data map[string]any
+}
+
+// WebhookDelivery is an event queued for a single endpoint. LastError holds the reason of the last failed attempt.
+// FailedAt is set for dead letters.
+type WebhookDelivery struct {
+	Id            int
+	EndpointID    int
+	URL           string
+	Secret        string
+	Event         string
+	Data          map[string]any
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	CreatedAt     time.Time
+	FailedAt      time.Time
+}
+
+// WebhookItem is a good to be shipped, as the variant with the Variant SKU for goods with variants.
+type WebhookItem struct {
+	Name     string
+	Variant  string
+	Quantity uint32
+}
+
+func IsValidWebhookEvent(event string) bool {
+	switch event {
+	case WebhookEventPurchase, WebhookEventGift, WebhookEventCancellation:
+		return true
+	default:
+		return false
+	}
+}
+
+// ValidateWebhookURL checks that rawURL is an absolute http or https URL.
+func ValidateWebhookURL(rawURL string) error {
+	if len(rawURL) > MaxWebhookURLLength {
+		return &InvalidArgumentsError{Msg: fmt.Sprintf("url must not exceed %d characters", MaxWebhookURLLength)}
+	}
+
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return &InvalidArgumentsError{Msg: fmt.Sprintf("invalid webhook url: %s", rawURL)}
+	}
+
+	return nil
+}
+
+func NewWebhookSecret() (string, error) {
+	secret := make([]byte, webhookSecretSize)
+	_, err := rand.Read(secret)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+
+	return hex.EncodeToString(secret), nil
+}
+
+// SignWebhook returns the hex encoded HMAC-SHA256 of "<timestamp>.<body>" keyed with the endpoint secret.
+// Signing the timestamp together with the body lets receivers reject replayed requests.
+func SignWebhook(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// WebhookRetryDelay is the delay before the next attempt after attempts failed ones. It doubles with every
+// failure, so MaxWebhookAttempts attempts span a bit over two hours.
+func WebhookRetryDelay(attempts int) time.Duration {
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	return WebhookRetryBaseDelay << (attempts - 1)
+}
+
+// PurchaseWebhook reports items bought by the user for the price.
+func PurchaseWebhook(userID int, price uint32, items ...WebhookItem) WebhookEvent {
+	return WebhookEvent{
+		Type: WebhookEventPurchase,
+		Data: map[string]any{
+			"userId": userID,
+			"price":  price,
+			"items":  webhookItemsData(items),
+		},
+	}
+}
+
+// BundlePurchaseWebhook reports a bought bundle with its components as the items.
+func BundlePurchaseWebhook(userID int, bundle GoodInfo, components []BundleComponent) WebhookEvent {
+	event := PurchaseWebhook(userID, bundle.Price, BundleItems(components)...)
+	event.Data["bundle"] = bundle.Name
+
+	return event
+}
+
+// PreorderPurchaseWebhook reports a fulfilled pre-order as a purchase of its good.
+func PreorderPurchaseWebhook(preorder Preorder) WebhookEvent {
+	event := PurchaseWebhook(preorder.UserID, preorder.Price, WebhookItem{
+		Name:     preorder.GoodName,
+		Variant:  preorder.Variant,
+		Quantity: 1,
+	})
+	event.Data["preorderId"] = preorder.Id
+
+	return event
+}
+
+// AuctionPurchaseWebhook reports an auctioned item as bought by the top bidder for the winning bid.
+func AuctionPurchaseWebhook(auction Auction) WebhookEvent {
+	event := PurchaseWebhook(auction.TopBid.BidderID, auction.TopBid.Amount,
+		WebhookItem{Name: auction.GoodName, Quantity: 1})
+	event.Data["auctionId"] = auction.Id
+
+	return event
+}
+
+// RafflePurchaseWebhook reports a raffled item as bought by the winner for the coins they spent on tickets.
+func RafflePurchaseWebhook(raffle Raffle, winnerID int, spent uint32) WebhookEvent {
+	event := PurchaseWebhook(winnerID, spent, WebhookItem{Name: raffle.GoodName, Quantity: 1})
+	event.Data["raffleId"] = raffle.Id
+
+	return event
+}
+
+// GiftWebhook reports an item bought by the buyer for the recipient, who is the one to receive it.
+func GiftWebhook(buyerID, recipientID int, price uint32, message string, item WebhookItem) WebhookEvent {
+	return WebhookEvent{
+		Type: WebhookEventGift,
+		Data: map[string]any{
+			"userId":     recipientID,
+			"fromUserId": buyerID,
+			"price":      price,
+			"message":    message,
+			"items":      webhookItemsData([]WebhookItem{item}),
+		},
+	}
+}
+
+// CancellationWebhook reports a cancelled pre-order, whose good won't have to be shipped.
+func CancellationWebhook(preorder Preorder) WebhookEvent {
+	return WebhookEvent{
+		Type: WebhookEventCancellation,
+		Data: map[string]any{
+			"userId":     preorder.UserID,
+			"preorderId": preorder.Id,
+			"price":      preorder.Price,
+			"items": webhookItemsData([]WebhookItem{{
+				Name:     preorder.GoodName,
+				Variant:  preorder.Variant,
+				Quantity: 1,
+			}}),
+		},
+	}
+}
+
+// BundleItems returns the components of a bundle as the items to be shipped.
+func BundleItems(components []BundleComponent) []WebhookItem {
+	items := make([]WebhookItem, 0, len(components))
+	for _, component := range components {
+		items = append(items, WebhookItem{Name: component.GoodName, Variant: component.Variant, Quantity: component.Quantity})
+	}
+
+	return items
+}
+
+func webhookItemsData(items []WebhookItem) []map[string]any {
+	data := make([]map[string]any, 0, len(items))
+	for _, item := range items {
+		data = append(data, map[string]any{
+			"name":     item.Name,
+			"variant":  item.Variant,
+			"quantity": item.Quantity,
+		})
+	}
+
+	return data
+}
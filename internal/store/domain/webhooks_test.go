@@ -0,0 +1,144 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignWebhook(t *testing.T) {
+	t.Parallel()
+
+	body := []byte(`{"event":"purchase"}`)
+	signature := SignWebhook("secret", 1785672000, body)
+
+	assert.Equal(t, "59d447bfd9686546f99c94828411635b8a6153752845726a329c04199b837f8a", signature)
+	assert.NotEqual(t, signature, SignWebhook("secret", 1785672001, body))
+	assert.NotEqual(t, signature, SignWebhook("other", 1785672000, body))
+}
+
+func TestWebhookRetryDelay(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name     string
+		attempts int
+		expected time.Duration
+	}
+
+	tests := []testCase{
+		{
+			name:     "after the first attempt",
+			attempts: 1,
+			expected: time.Minute,
+		},
+		{
+			name:     "doubles with every attempt",
+			attempts: 4,
+			expected: 8 * time.Minute,
+		},
+		{
+			name:     "before the last attempt",
+			attempts: MaxWebhookAttempts - 1,
+			expected: 64 * time.Minute,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, WebhookRetryDelay(tt.attempts))
+		})
+	}
+}
+
+func TestValidateWebhookURL(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name        string
+		url         string
+		expectedErr bool
+	}
+
+	tests := []testCase{
+		{
+			name: "https url",
+			url:  "https://warehouse.example.com/hooks/merch",
+		},
+		{
+			name: "local http url",
+			url:  "http://localhost:9000/hooks",
+		},
+		{
+			name:        "unsupported scheme",
+			url:         "ftp://warehouse.example.com/hooks",
+			expectedErr: true,
+		},
+		{
+			name:        "relative url",
+			url:         "/hooks/merch",
+			expectedErr: true,
+		},
+		{
+			name:        "too long",
+			url:         "https://warehouse.example.com/" + strings.Repeat("a", MaxWebhookURLLength),
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateWebhookURL(tt.url)
+			if tt.expectedErr {
+				assert.ErrorIs(t, err, &InvalidArgumentsError{})
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPreorderPurchaseWebhook(t *testing.T) {
+	t.Parallel()
+
+	event := PreorderPurchaseWebhook(Preorder{Id: 3, UserID: 1, GoodID: 12, GoodName: "umbrella", VariantID: 4,
+		Variant: "umbrella-red", Price: 220})
+
+	assert.Equal(t, WebhookEvent{
+		Type: WebhookEventPurchase,
+		Data: map[string]any{
+			"userId":     1,
+			"price":      uint32(220),
+			"preorderId": 3,
+			"items": []map[string]any{
+				{"name": "umbrella", "variant": "umbrella-red", "quantity": uint32(1)},
+			},
+		},
+	}, event)
+}
+
+func TestAuctionPurchaseWebhook(t *testing.T) {
+	t.Parallel()
+
+	event := AuctionPurchaseWebhook(Auction{Id: 4, GoodID: 10, GoodName: "pink-hoody",
+		TopBid: AuctionBid{Id: 8, BidderID: 2, Amount: 350}})
+
+	assert.Equal(t, WebhookEvent{
+		Type: WebhookEventPurchase,
+		Data: map[string]any{
+			"userId":    2,
+			"price":     uint32(350),
+			"auctionId": 4,
+			"items": []map[string]any{
+				{"name": "pink-hoody", "variant": "", "quantity": uint32(1)},
+			},
+		},
+	}, event)
+}
@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+)
+
+const (
+	WishlistEventPriceDrop   = "price-drop"
+	WishlistEventBackInStock = "back-in-stock"
+
+	MaxWishlistSize     = 50
+	WishlistEventsLimit = 50
+	WishlistWatchBatch  = 500
+)
+
+type WishlistRepository interface {
+	AddWishlistItem(ctx context.Context, userID int, good GoodInfo) error
+	RemoveWishlistItem(ctx context.Context, userID, goodID int) error
+	CountWishlistItems(ctx context.Context, userID int) (int, error)
+	ListWishlistEvents(ctx context.Context, userID, limit int) ([]WishlistEvent, error)
+}
+
+// WishlistPriceWatcher compares the price a wishlist entry was last seen at with the current price of the good.
+type WishlistPriceWatcher interface {
+	FetchPriceChanges(ctx context.Context, querier database.Querier, limit int) ([]WishlistPriceChange, error)
+	RecordWishlistEvent(ctx context.Context, executor database.Executor, change WishlistPriceChange, kind string) error
+	UpdateSeenPrice(ctx context.Context, executor database.Executor, change WishlistPriceChange) error
+}
+
+// WishlistStockWatcher tells the users wishing for a good that it can be bought, once it arrives.
+type WishlistStockWatcher interface {
+	RecordBackInStock(ctx context.Context, executor database.Executor, goodID int) error
+}
+
+type WishlistItem struct {
+	GoodName string
+	Price    uint32
+	AddedAt  time.Time
+}
+
+// CoinsNeeded returns how many coins are missing to buy the item with the given balance.
+func (w WishlistItem) CoinsNeeded(balance uint32) uint32 {
+	if balance >= w.Price {
+		return 0
+	}
+
+	return w.Price - balance
+}
+
+type WishlistEvent struct {
+	Kind      string
+	GoodName  string
+	OldPrice  uint32
+	NewPrice  uint32
+	CreatedAt time.Time
+}
+
+type WishlistPriceChange struct {
+	UserID   int
+	GoodID   int
+	OldPrice uint32
+	NewPrice uint32
+}
+
+func (c WishlistPriceChange) IsDrop() bool {
+	return c.NewPrice < c.OldPrice
+}
@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWishlistItem_CoinsNeeded(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name     string
+		price    uint32
+		balance  uint32
+		expected uint32
+	}
+
+	tests := []testCase{
+		{name: "balance below the price", price: 200, balance: 150, expected: 50},
+		{name: "balance equals the price", price: 200, balance: 200, expected: 0},
+		{name: "balance above the price", price: 200, balance: 1000, expected: 0},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			item := WishlistItem{Price: tt.price}
+			assert.Equal(t, tt.expected, item.CoinsNeeded(tt.balance))
+		})
+	}
+}
@@ -0,0 +1,657 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	merchapi "github.com/Lexv0lk/merch-store/gen/merch/v1"
+	"github.com/Lexv0lk/merch-store/internal/pkg/audit"
+	"github.com/Lexv0lk/merch-store/internal/pkg/logging"
+	"github.com/Lexv0lk/merch-store/internal/store/application"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type AdminServerGRPC struct {
+	merchapi.UnimplementedMerchAdminServiceServer
+	deactivationCase *application.DeactivationCase
+	teamsCase        *application.TeamsCase
+	limitsCase       *application.TransferLimitsCase
+	fraudCase        *application.FraudDetectionCase
+	freezeCase       *application.AccountFreezeCase
+	auctionsCase     *application.AuctionsCase
+	rafflesCase      *application.RafflesCase
+	promoCodesCase   *application.PromoCodesCase
+	catalogCase      *application.CatalogCase
+	preordersCase    *application.PreordersCase
+	webhooksCase     *application.WebhooksCase
+	eventsCase       *application.EventsCase
+
+	logger logging.Logger
+}
+
+func NewAdminServerGRPC(
+	deactivationCase *application.DeactivationCase,
+	teamsCase *application.TeamsCase,
+	limitsCase *application.TransferLimitsCase,
+	fraudCase *application.FraudDetectionCase,
+	freezeCase *application.AccountFreezeCase,
+	auctionsCase *application.AuctionsCase,
+	rafflesCase *application.RafflesCase,
+	promoCodesCase *application.PromoCodesCase,
+	catalogCase *application.CatalogCase,
+	preordersCase *application.PreordersCase,
+	webhooksCase *application.WebhooksCase,
+	eventsCase *application.EventsCase,
+	logger logging.Logger,
+) *AdminServerGRPC {
+	return &AdminServerGRPC{
+		deactivationCase: deactivationCase,
+		teamsCase:        teamsCase,
+		limitsCase:       limitsCase,
+		fraudCase:        fraudCase,
+		freezeCase:       freezeCase,
+		auctionsCase:     auctionsCase,
+		rafflesCase:      rafflesCase,
+		promoCodesCase:   promoCodesCase,
+		catalogCase:      catalogCase,
+		preordersCase:    preordersCase,
+		webhooksCase:     webhooksCase,
+		eventsCase:       eventsCase,
+		logger:           logger,
+	}
+}
+
+func (s *AdminServerGRPC) DeactivateAccount(ctx context.Context, req *merchapi.DeactivateAccountRequest) (*merchapi.DeactivateAccountResponse, error) {
+	swept, err := s.deactivationCase.DeactivateUser(ctx, req.Username, req.SweepBalance)
+	if err != nil {
+		s.logger.Error("failed to deactivate account", "error", err.Error())
+		switch {
+		case errors.Is(err, &domain.UserNotFoundError{}):
+			return nil, status.Error(codes.NotFound, "user not found")
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &merchapi.DeactivateAccountResponse{
+		Success:     true,
+		SweptAmount: swept,
+	}, nil
+}
+
+func (s *AdminServerGRPC) CreateTeam(ctx context.Context, req *merchapi.CreateTeamRequest) (*merchapi.CreateTeamResponse, error) {
+	teamID, err := s.teamsCase.CreateTeam(ctx, req.Name, req.ManagerUsername)
+	if err != nil {
+		s.logger.Error("failed to create team", "error", err.Error())
+		switch {
+		case errors.Is(err, &domain.InvalidArgumentsError{}):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, &domain.UserNotFoundError{}):
+			return nil, status.Error(codes.NotFound, "user not found")
+		case errors.Is(err, &domain.TeamExistingError{}):
+			return nil, status.Error(codes.AlreadyExists, "team already exists")
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &merchapi.CreateTeamResponse{
+		TeamID: int32(teamID),
+	}, nil
+}
+
+func (s *AdminServerGRPC) AddTeamMember(ctx context.Context, req *merchapi.AddTeamMemberRequest) (*merchapi.AddTeamMemberResponse, error) {
+	err := s.teamsCase.AddTeamMember(ctx, req.TeamName, req.Username)
+	if err != nil {
+		s.logger.Error("failed to add team member", "error", err.Error())
+		switch {
+		case errors.Is(err, &domain.UserNotFoundError{}):
+			return nil, status.Error(codes.NotFound, "user not found")
+		case errors.Is(err, &domain.TeamNotFoundError{}):
+			return nil, status.Error(codes.NotFound, "team not found")
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &merchapi.AddTeamMemberResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *AdminServerGRPC) SetTeamBudgetTopUp(ctx context.Context, req *merchapi.SetTeamBudgetTopUpRequest) (*merchapi.SetTeamBudgetTopUpResponse, error) {
+	period := time.Duration(req.PeriodHours) * time.Hour
+
+	err := s.teamsCase.SetBudgetTopUp(ctx, req.TeamName, req.Amount, period)
+	if err != nil {
+		s.logger.Error("failed to set team budget top-up", "error", err.Error())
+		switch {
+		case errors.Is(err, &domain.InvalidArgumentsError{}):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, &domain.TeamNotFoundError{}):
+			return nil, status.Error(codes.NotFound, "team not found")
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &merchapi.SetTeamBudgetTopUpResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *AdminServerGRPC) SetTransferLimits(ctx context.Context, req *merchapi.SetTransferLimitsRequest) (*merchapi.SetTransferLimitsResponse, error) {
+	limits := domain.TransferLimits{
+		MaxSingleTransfer:           req.MaxSingleTransfer,
+		DailyOutgoingCap:            req.DailyOutgoingCap,
+		MaxTransfersPerHour:         req.MaxTransfersPerHour,
+		MaxReceivedFromSenderPerDay: req.MaxReceivedFromSenderPerDay,
+	}
+
+	err := s.limitsCase.SetTransferLimits(ctx, req.Username, limits)
+	if err != nil {
+		s.logger.Error("failed to set transfer limits", "error", err.Error())
+		switch {
+		case errors.Is(err, &domain.UserNotFoundError{}):
+			return nil, status.Error(codes.NotFound, "user not found")
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &merchapi.SetTransferLimitsResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *AdminServerGRPC) ListFraudCases(ctx context.Context, req *merchapi.ListFraudCasesRequest) (*merchapi.ListFraudCasesResponse, error) {
+	cases, err := s.fraudCase.ListCases(ctx, req.Status)
+	if err != nil {
+		s.logger.Error("failed to list fraud cases", "error", err.Error())
+		switch {
+		case errors.Is(err, &domain.InvalidArgumentsError{}):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	resp := &merchapi.ListFraudCasesResponse{
+		Cases: make([]*merchapi.FraudCaseInfo, 0, len(cases)),
+	}
+	for _, fraudCase := range cases {
+		resp.Cases = append(resp.Cases, &merchapi.FraudCaseInfo{
+			Id:         int32(fraudCase.Id),
+			Pattern:    fraudCase.Pattern,
+			Usernames:  fraudCase.Usernames,
+			Details:    fraudCase.Details,
+			Status:     fraudCase.Status,
+			Resolution: fraudCase.Resolution,
+			CreatedAt:  fraudCase.CreatedAt.UTC().Format(time.RFC3339),
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *AdminServerGRPC) ResolveFraudCase(ctx context.Context, req *merchapi.ResolveFraudCaseRequest) (*merchapi.ResolveFraudCaseResponse, error) {
+	err := s.fraudCase.ResolveCase(ctx, int(req.CaseID), req.Resolution)
+	if err != nil {
+		s.logger.Error("failed to resolve fraud case", "error", err.Error())
+		return nil, fraudCaseStatusError(err)
+	}
+
+	return &merchapi.ResolveFraudCaseResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *AdminServerGRPC) FreezeFraudCase(ctx context.Context, req *merchapi.FreezeFraudCaseRequest) (*merchapi.FreezeFraudCaseResponse, error) {
+	adminID, err := retrieveUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.fraudCase.FreezeCaseAccounts(ctx, adminID, int(req.CaseID), req.Resolution)
+	if err != nil {
+		s.logger.Error("failed to freeze fraud case accounts", "error", err.Error())
+		return nil, fraudCaseStatusError(err)
+	}
+
+	return &merchapi.FreezeFraudCaseResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *AdminServerGRPC) FreezeAccount(ctx context.Context, req *merchapi.FreezeAccountRequest) (*merchapi.FreezeAccountResponse, error) {
+	adminID, err := retrieveUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.freezeCase.FreezeAccount(ctx, adminID, req.Username, req.Reason)
+	if err != nil {
+		s.logger.Error("failed to freeze account", "error", err.Error())
+		return nil, accountFreezeStatusError(err)
+	}
+
+	return &merchapi.FreezeAccountResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *AdminServerGRPC) UnfreezeAccount(ctx context.Context, req *merchapi.UnfreezeAccountRequest) (*merchapi.UnfreezeAccountResponse, error) {
+	adminID, err := retrieveUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.freezeCase.UnfreezeAccount(ctx, adminID, req.Username, req.Reason)
+	if err != nil {
+		s.logger.Error("failed to unfreeze account", "error", err.Error())
+		return nil, accountFreezeStatusError(err)
+	}
+
+	return &merchapi.UnfreezeAccountResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *AdminServerGRPC) CreateAuction(ctx context.Context, req *merchapi.CreateAuctionRequest) (*merchapi.CreateAuctionResponse, error) {
+	var startsAt time.Time
+	var err error
+	if req.StartsAt != "" {
+		startsAt, err = time.Parse(time.RFC3339, req.StartsAt)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "start time must be in RFC 3339 format")
+		}
+	}
+
+	endsAt, err := time.Parse(time.RFC3339, req.EndsAt)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "end time must be in RFC 3339 format")
+	}
+
+	auctionID, err := s.auctionsCase.CreateAuction(ctx, req.ItemName, req.ReservePrice, startsAt, endsAt)
+	if err != nil {
+		s.logger.Error("failed to create auction", "error", err.Error())
+		switch {
+		case errors.Is(err, &domain.InvalidArgumentsError{}):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, &domain.GoodNotFoundError{}):
+			return nil, status.Error(codes.InvalidArgument, "item not found")
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &merchapi.CreateAuctionResponse{
+		AuctionID: int32(auctionID),
+	}, nil
+}
+
+func (s *AdminServerGRPC) CreateRaffle(ctx context.Context, req *merchapi.CreateRaffleRequest) (*merchapi.CreateRaffleResponse, error) {
+	drawAt, err := time.Parse(time.RFC3339, req.DrawAt)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "draw time must be in RFC 3339 format")
+	}
+
+	raffleID, err := s.rafflesCase.CreateRaffle(ctx, req.ItemName, req.TicketPrice, drawAt)
+	if err != nil {
+		s.logger.Error("failed to create raffle", "error", err.Error())
+		switch {
+		case errors.Is(err, &domain.InvalidArgumentsError{}):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, &domain.GoodNotFoundError{}):
+			return nil, status.Error(codes.InvalidArgument, "item not found")
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &merchapi.CreateRaffleResponse{
+		RaffleID: int32(raffleID),
+	}, nil
+}
+
+func (s *AdminServerGRPC) CreatePromoCode(ctx context.Context, req *merchapi.CreatePromoCodeRequest) (*merchapi.CreatePromoCodeResponse, error) {
+	var validFrom time.Time
+	if req.ValidFrom != "" {
+		var err error
+		validFrom, err = time.Parse(time.RFC3339, req.ValidFrom)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "start time must be in RFC 3339 format")
+		}
+	}
+
+	validUntil, err := time.Parse(time.RFC3339, req.ValidUntil)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "end time must be in RFC 3339 format")
+	}
+
+	promoID, err := s.promoCodesCase.CreatePromoCode(ctx, domain.PromoCode{
+		Code:           req.Code,
+		Kind:           req.Kind,
+		Value:          req.Value,
+		MaxUses:        req.MaxUses,
+		MaxUsesPerUser: req.MaxUsesPerUser,
+		ValidFrom:      validFrom,
+		ValidUntil:     validUntil,
+	}, req.ItemName)
+	if err != nil {
+		s.logger.Error("failed to create promo code", "error", err.Error())
+		switch {
+		case errors.Is(err, &domain.InvalidArgumentsError{}):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, &domain.GoodNotFoundError{}):
+			return nil, status.Error(codes.InvalidArgument, "item not found")
+		case errors.Is(err, &domain.PromoCodeExistingError{}):
+			return nil, status.Error(codes.AlreadyExists, "promo code already exists")
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &merchapi.CreatePromoCodeResponse{
+		PromoID: int32(promoID),
+	}, nil
+}
+
+func (s *AdminServerGRPC) SchedulePriceChange(ctx context.Context, req *merchapi.SchedulePriceChangeRequest) (*merchapi.SchedulePriceChangeResponse, error) {
+	var startsAt, endsAt time.Time
+	var err error
+	if req.StartsAt != "" {
+		startsAt, err = time.Parse(time.RFC3339, req.StartsAt)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "start time must be in RFC 3339 format")
+		}
+	}
+
+	if req.EndsAt != "" {
+		endsAt, err = time.Parse(time.RFC3339, req.EndsAt)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "end time must be in RFC 3339 format")
+		}
+	}
+
+	scheduleID, err := s.catalogCase.SchedulePriceChange(ctx, req.ItemName, req.Price, req.DiscountPercent, startsAt, endsAt)
+	if err != nil {
+		s.logger.Error("failed to schedule price change", "error", err.Error())
+		switch {
+		case errors.Is(err, &domain.InvalidArgumentsError{}):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, &domain.GoodNotFoundError{}):
+			return nil, status.Error(codes.InvalidArgument, "item not found")
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &merchapi.SchedulePriceChangeResponse{
+		ScheduleID: int32(scheduleID),
+	}, nil
+}
+
+func (s *AdminServerGRPC) CreateCategory(ctx context.Context, req *merchapi.CreateCategoryRequest) (*merchapi.CreateCategoryResponse, error) {
+	categoryID, err := s.catalogCase.CreateCategory(ctx, req.Slug, req.Name)
+	if err != nil {
+		s.logger.Error("failed to create category", "error", err.Error())
+		switch {
+		case errors.Is(err, &domain.InvalidArgumentsError{}):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, &domain.CategoryExistingError{}):
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &merchapi.CreateCategoryResponse{
+		CategoryID: int32(categoryID),
+	}, nil
+}
+
+func (s *AdminServerGRPC) UpdateGoodDetails(ctx context.Context, req *merchapi.UpdateGoodDetailsRequest) (*merchapi.UpdateGoodDetailsResponse, error) {
+	images := make([]domain.GoodImage, 0, len(req.Images))
+	for _, image := range req.Images {
+		images = append(images, domain.GoodImage{
+			URL:     image.Url,
+			AltText: image.AltText,
+			Width:   image.Width,
+			Height:  image.Height,
+		})
+	}
+
+	err := s.catalogCase.UpdateGoodDetails(ctx, req.ItemName, req.Category, req.Description, images)
+	if err != nil {
+		s.logger.Error("failed to update good details", "error", err.Error())
+		switch {
+		case errors.Is(err, &domain.InvalidArgumentsError{}):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, &domain.GoodNotFoundError{}):
+			return nil, status.Error(codes.InvalidArgument, "item not found")
+		case errors.Is(err, &domain.CategoryNotFoundError{}):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &merchapi.UpdateGoodDetailsResponse{}, nil
+}
+
+func accountFreezeStatusError(err error) error {
+	switch {
+	case errors.Is(err, &domain.InvalidArgumentsError{}):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, &domain.UserNotFoundError{}):
+		return status.Error(codes.NotFound, "user not found")
+	default:
+		return status.Error(codes.Internal, "internal error")
+	}
+}
+
+func fraudCaseStatusError(err error) error {
+	switch {
+	case errors.Is(err, &domain.InvalidArgumentsError{}):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, &domain.FraudCaseNotFoundError{}):
+		return status.Error(codes.NotFound, "fraud case not found")
+	case errors.Is(err, &domain.FraudCaseClosedError{}):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, "internal error")
+	}
+}
+
+func (s *AdminServerGRPC) SetPurchaseLimit(ctx context.Context, req *merchapi.SetPurchaseLimitRequest) (*merchapi.SetPurchaseLimitResponse, error) {
+	period := time.Duration(req.PeriodDays) * 24 * time.Hour
+
+	err := s.catalogCase.SetPurchaseLimit(ctx, req.ItemName, req.MaxQuantity, period)
+	if err != nil {
+		s.logger.Error("failed to set purchase limit", "error", err.Error())
+		switch {
+		case errors.Is(err, &domain.InvalidArgumentsError{}):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, &domain.GoodNotFoundError{}):
+			return nil, status.Error(codes.InvalidArgument, "item not found")
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &merchapi.SetPurchaseLimitResponse{}, nil
+}
+
+func (s *AdminServerGRPC) AnnounceGood(ctx context.Context, req *merchapi.AnnounceGoodRequest) (*merchapi.AnnounceGoodResponse, error) {
+	err := s.preordersCase.AnnounceGood(ctx, req.ItemName)
+	if err != nil {
+		s.logger.Error("failed to announce good", "error", err.Error())
+		switch {
+		case errors.Is(err, &domain.InvalidArgumentsError{}):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, &domain.GoodNotFoundError{}):
+			return nil, status.Error(codes.InvalidArgument, "item not found")
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &merchapi.AnnounceGoodResponse{}, nil
+}
+
+func (s *AdminServerGRPC) MarkGoodArrived(ctx context.Context, req *merchapi.MarkGoodArrivedRequest) (*merchapi.MarkGoodArrivedResponse, error) {
+	fulfilled, pending, err := s.preordersCase.MarkGoodArrived(ctx, req.ItemName)
+	if err != nil {
+		s.logger.Error("failed to mark good as arrived", "error", err.Error())
+		switch {
+		case errors.Is(err, &domain.GoodNotFoundError{}):
+			return nil, status.Error(codes.InvalidArgument, "item not found")
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &merchapi.MarkGoodArrivedResponse{
+		Fulfilled: int32(fulfilled),
+		Pending:   int32(pending),
+	}, nil
+}
+
+func (s *AdminServerGRPC) CreateWebhookEndpoint(ctx context.Context, req *merchapi.CreateWebhookEndpointRequest) (*merchapi.CreateWebhookEndpointResponse, error) {
+	endpoint, err := s.webhooksCase.CreateWebhookEndpoint(ctx, req.Url, req.Events)
+	if err != nil {
+		s.logger.Error("failed to create webhook endpoint", "error", err.Error())
+		switch {
+		case errors.Is(err, &domain.InvalidArgumentsError{}):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, &domain.LimitExceededError{}):
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &merchapi.CreateWebhookEndpointResponse{
+		Id:     int32(endpoint.Id),
+		Secret: endpoint.Secret,
+	}, nil
+}
+
+func (s *AdminServerGRPC) ListWebhookEndpoints(ctx context.Context, req *merchapi.ListWebhookEndpointsRequest) (*merchapi.ListWebhookEndpointsResponse, error) {
+	endpoints, err := s.webhooksCase.ListWebhookEndpoints(ctx)
+	if err != nil {
+		s.logger.Error("failed to list webhook endpoints", "error", err.Error())
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	resp := &merchapi.ListWebhookEndpointsResponse{
+		Endpoints: make([]*merchapi.WebhookEndpointInfo, 0, len(endpoints)),
+	}
+	for _, endpoint := range endpoints {
+		resp.Endpoints = append(resp.Endpoints, &merchapi.WebhookEndpointInfo{
+			Id:        int32(endpoint.Id),
+			Url:       endpoint.URL,
+			Events:    endpoint.Events,
+			CreatedAt: endpoint.CreatedAt.UTC().Format(time.RFC3339),
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *AdminServerGRPC) DeleteWebhookEndpoint(ctx context.Context, req *merchapi.DeleteWebhookEndpointRequest) (*merchapi.DeleteWebhookEndpointResponse, error) {
+	err := s.webhooksCase.DeleteWebhookEndpoint(ctx, int(req.Id))
+	if err != nil {
+		s.logger.Error("failed to delete webhook endpoint", "error", err.Error())
+		switch {
+		case errors.Is(err, &domain.WebhookEndpointNotFoundError{}):
+			return nil, status.Error(codes.NotFound, "webhook endpoint not found")
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &merchapi.DeleteWebhookEndpointResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *AdminServerGRPC) ListWebhookDeadLetters(ctx context.Context, req *merchapi.ListWebhookDeadLettersRequest) (*merchapi.ListWebhookDeadLettersResponse, error) {
+	deadLetters, err := s.webhooksCase.ListWebhookDeadLetters(ctx)
+	if err != nil {
+		s.logger.Error("failed to list webhook dead letters", "error", err.Error())
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	resp := &merchapi.ListWebhookDeadLettersResponse{
+		DeadLetters: make([]*merchapi.WebhookDeadLetterInfo, 0, len(deadLetters)),
+	}
+	for _, deadLetter := range deadLetters {
+		resp.DeadLetters = append(resp.DeadLetters, &merchapi.WebhookDeadLetterInfo{
+			Id:         int32(deadLetter.Id),
+			EndpointId: int32(deadLetter.EndpointID),
+			Url:        deadLetter.URL,
+			Event:      deadLetter.Event,
+			Data:       audit.EncodeValues(deadLetter.Data),
+			Attempts:   int32(deadLetter.Attempts),
+			LastError:  deadLetter.LastError,
+			CreatedAt:  deadLetter.CreatedAt.UTC().Format(time.RFC3339),
+			FailedAt:   deadLetter.FailedAt.UTC().Format(time.RFC3339),
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *AdminServerGRPC) FetchEvents(ctx context.Context, req *merchapi.FetchEventsRequest) (*merchapi.FetchEventsResponse, error) {
+	events, err := s.eventsCase.FetchEvents(ctx, req.Consumer, int(req.Limit))
+	if err != nil {
+		s.logger.Error("failed to fetch events", "error", err.Error())
+		switch {
+		case errors.Is(err, &domain.InvalidArgumentsError{}):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	resp := &merchapi.FetchEventsResponse{
+		Events: make([]*merchapi.DomainEventInfo, 0, len(events)),
+	}
+	for _, event := range events {
+		userIDs := make([]int32, 0, len(event.UserIDs))
+		for _, userID := range event.UserIDs {
+			userIDs = append(userIDs, int32(userID))
+		}
+
+		resp.Events = append(resp.Events, &merchapi.DomainEventInfo{
+			Id:         event.Id,
+			Offset:     event.Offset,
+			Type:       event.Type,
+			UserIds:    userIDs,
+			Data:       audit.EncodeValues(event.Data),
+			OccurredAt: event.OccurredAt.UTC().Format(time.RFC3339Nano),
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *AdminServerGRPC) CommitEventOffset(ctx context.Context, req *merchapi.CommitEventOffsetRequest) (*merchapi.CommitEventOffsetResponse, error) {
+	err := s.eventsCase.CommitEventOffset(ctx, req.Consumer, req.Offset)
+	if err != nil {
+		s.logger.Error("failed to commit event offset", "error", err.Error())
+		switch {
+		case errors.Is(err, &domain.InvalidArgumentsError{}):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &merchapi.CommitEventOffsetResponse{Success: true}, nil
+}
@@ -0,0 +1,79 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	merchapi "github.com/Lexv0lk/merch-store/gen/merch/v1"
+	"github.com/Lexv0lk/merch-store/internal/pkg/audit"
+	"github.com/Lexv0lk/merch-store/internal/pkg/logging"
+	"github.com/Lexv0lk/merch-store/internal/store/application"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type AuditServerGRPC struct {
+	merchapi.UnimplementedMerchAuditServiceServer
+	auditCase *application.AuditCase
+
+	logger logging.Logger
+}
+
+func NewAuditServerGRPC(auditCase *application.AuditCase, logger logging.Logger) *AuditServerGRPC {
+	return &AuditServerGRPC{
+		auditCase: auditCase,
+		logger:    logger,
+	}
+}
+
+func (s *AuditServerGRPC) ListAuditLog(ctx context.Context, req *merchapi.ListAuditLogRequest) (*merchapi.ListAuditLogResponse, error) {
+	from, err := audit.ParseTimeBound(req.From)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	to, err := audit.ParseTimeBound(req.To)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	entries, err := s.auditCase.ListAuditLog(ctx, req.Source, req.Actor, audit.Filter{
+		Action: req.Action,
+		Target: req.Target,
+		From:   from,
+		To:     to,
+		Limit:  int(req.Limit),
+	})
+	if err != nil {
+		s.logger.Error("failed to list audit log", "error", err.Error())
+		switch {
+		case errors.Is(err, &domain.InvalidArgumentsError{}):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, &domain.UserNotFoundError{}):
+			return nil, status.Error(codes.NotFound, "user not found")
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	resp := &merchapi.ListAuditLogResponse{
+		Events: make([]*merchapi.AuditEvent, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		resp.Events = append(resp.Events, &merchapi.AuditEvent{
+			Source:    entry.Source,
+			ActorID:   int32(entry.ActorID),
+			Actor:     entry.Actor,
+			Action:    entry.Action,
+			Target:    entry.Target,
+			RequestID: entry.RequestID,
+			Before:    audit.EncodeValues(entry.Before),
+			After:     audit.EncodeValues(entry.After),
+			CreatedAt: entry.CreatedAt.UTC().Format(time.RFC3339),
+		})
+	}
+
+	return resp, nil
+}
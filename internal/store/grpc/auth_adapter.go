@@ -2,8 +2,12 @@ package grpc
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	merchapi "github.com/Lexv0lk/merch-store/gen/merch/v1"
+	"github.com/Lexv0lk/merch-store/internal/pkg/audit"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
 )
 
 type AuthAdapter struct {
@@ -66,3 +70,106 @@ func (a *AuthAdapter) FetchUserID(ctx context.Context, username string) (int, er
 
 	return int(resp.UserID), nil
 }
+
+func (a *AuthAdapter) FetchUserIDs(ctx context.Context, usernames ...string) (map[string]int, error) {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	req := &merchapi.GetUserIDsRequest{
+		Usernames: usernames,
+	}
+
+	resp, err := a.client.GetUserIDs(limitCtx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	convertedIDs := make(map[string]int, len(resp.UserIDs))
+	for username, id := range resp.UserIDs {
+		convertedIDs[username] = int(id)
+	}
+
+	return convertedIDs, nil
+}
+
+func (a *AuthAdapter) DeactivateUser(ctx context.Context, userID int) error {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	req := &merchapi.DeactivateUserRequest{
+		UserID: int32(userID),
+	}
+
+	_, err := a.client.DeactivateUser(limitCtx, req)
+	return err
+}
+
+func (a *AuthAdapter) ListAuthEvents(ctx context.Context, filter audit.Filter) ([]domain.AuditEntry, error) {
+	limitCtx, cancel := context.WithTimeout(ctx, contextTimeLimit)
+	defer cancel()
+
+	req := &merchapi.ListAuditEventsRequest{
+		ActorID: int32(filter.ActorID),
+		Action:  filter.Action,
+		Target:  filter.Target,
+		From:    formatTimeBound(filter.From),
+		To:      formatTimeBound(filter.To),
+		Limit:   uint32(filter.EffectiveLimit()),
+	}
+
+	resp, err := a.client.ListAuditEvents(limitCtx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]domain.AuditEntry, 0, len(resp.Events))
+	for _, event := range resp.Events {
+		entry, err := convertAuthAuditEvent(event)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+func convertAuthAuditEvent(event *merchapi.AuditEvent) (domain.AuditEntry, error) {
+	createdAt, err := time.Parse(time.RFC3339, event.CreatedAt)
+	if err != nil {
+		return domain.AuditEntry{}, fmt.Errorf("invalid audit event time %q: %w", event.CreatedAt, err)
+	}
+
+	before, err := audit.DecodeValues(event.Before)
+	if err != nil {
+		return domain.AuditEntry{}, err
+	}
+
+	after, err := audit.DecodeValues(event.After)
+	if err != nil {
+		return domain.AuditEntry{}, err
+	}
+
+	return domain.AuditEntry{
+		Event: audit.Event{
+			ActorID:   int(event.ActorID),
+			Action:    event.Action,
+			Target:    event.Target,
+			RequestID: event.RequestID,
+			Before:    before,
+			After:     after,
+			CreatedAt: createdAt,
+		},
+		Source: event.Source,
+		Actor:  event.Actor,
+	}, nil
+}
+
+func formatTimeBound(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
@@ -3,6 +3,7 @@ package grpc
 import (
 	"context"
 
+	"github.com/Lexv0lk/merch-store/internal/pkg/audit"
 	"github.com/Lexv0lk/merch-store/internal/pkg/jwt"
 	"github.com/Lexv0lk/merch-store/internal/pkg/logging"
 	"google.golang.org/grpc"
@@ -36,22 +37,50 @@ func (i *AuthInterceptorFabric) GetInterceptor() grpc.UnaryServerInterceptor {
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
-		userToken, err := getUserToken(ctx)
+		newCtx, err := i.authenticate(ctx)
 		if err != nil {
-			i.logger.Error("failed to get user token", "error", err.Error())
-			return nil, status.Error(codes.Internal, "internal error")
+			return nil, err
 		}
 
-		userClaims, err := i.tokenParser.ParseToken([]byte(i.secretKey), userToken)
+		return handler(newCtx, req)
+	}
+}
+
+func (i *AuthInterceptorFabric) GetStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		newCtx, err := i.authenticate(stream.Context())
 		if err != nil {
-			i.logger.Error("failed to parse user token", "error", err.Error())
-			return nil, status.Error(codes.Unauthenticated, "invalid token")
+			return err
 		}
 
-		newCtx := context.WithValue(ctx, userIdContextKey, userClaims.UserID)
+		return handler(srv, &contextServerStream{ServerStream: stream, ctx: newCtx})
+	}
+}
+
+// authenticate parses the caller's token and returns the context carrying the caller's id and role.
+func (i *AuthInterceptorFabric) authenticate(ctx context.Context) (context.Context, error) {
+	userToken, err := getUserToken(ctx)
+	if err != nil {
+		i.logger.Error("failed to get user token", "error", err.Error())
+		return nil, status.Error(codes.Internal, "internal error")
+	}
 
-		return handler(newCtx, req)
+	userClaims, err := i.tokenParser.ParseToken([]byte(i.secretKey), userToken)
+	if err != nil {
+		i.logger.Error("failed to parse user token", "error", err.Error())
+		return nil, status.Error(codes.Unauthenticated, "invalid token")
 	}
+
+	newCtx := context.WithValue(ctx, userIdContextKey, userClaims.UserID)
+	newCtx = context.WithValue(newCtx, userRoleContextKey, userClaims.Role)
+	newCtx = audit.WithActor(newCtx, userClaims.UserID)
+
+	return newCtx, nil
 }
 
 func getUserToken(ctx context.Context) (string, error) {
@@ -11,6 +11,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
@@ -23,8 +24,9 @@ func TestAuthInterceptorFabric_GetInterceptor(t *testing.T) {
 		name      string
 		secretKey string
 
-		expectedUserID  int
-		expectedErrCode codes.Code
+		expectedUserID   int
+		expectedUserRole string
+		expectedErrCode  codes.Code
 
 		prepareCtx func(t *testing.T) context.Context
 		prepareFn  func(t *testing.T, ctrl *gomock.Controller) (logging.Logger, jwt.TokenParser)
@@ -45,11 +47,12 @@ func TestAuthInterceptorFabric_GetInterceptor(t *testing.T) {
 				tokenParser := jwtmocks.NewMockTokenParser(ctrl)
 				tokenParser.EXPECT().
 					ParseToken([]byte("secret"), "valid_token").
-					Return(&jwt.Claims{UserID: 1, Username: "testuser"}, nil)
+					Return(&jwt.Claims{UserID: 1, Username: "testuser", Role: jwt.RoleAdmin}, nil)
 				return logger, tokenParser
 			},
-			expectedUserID:  1,
-			expectedErrCode: codes.OK,
+			expectedUserID:   1,
+			expectedUserRole: jwt.RoleAdmin,
+			expectedErrCode:  codes.OK,
 		},
 		{
 			name:      "missing metadata",
@@ -122,6 +125,80 @@ func TestAuthInterceptorFabric_GetInterceptor(t *testing.T) {
 				require.True(t, ok)
 
 				assert.Equal(t, tt.expectedUserID, resUser)
+				assert.Equal(t, tt.expectedUserRole, resultCtx.Value(userRoleContextKey))
+			}
+		})
+	}
+}
+
+func TestAuthInterceptorFabric_GetStreamInterceptor(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name  string
+		token string
+
+		prepareFn func(t *testing.T, ctrl *gomock.Controller) (logging.Logger, jwt.TokenParser)
+
+		expectedErrCode codes.Code
+	}
+
+	tests := []testCase{
+		{
+			name:  "stream authenticated",
+			token: "valid_token",
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) (logging.Logger, jwt.TokenParser) {
+				t.Helper()
+				tokenParser := jwtmocks.NewMockTokenParser(ctrl)
+				tokenParser.EXPECT().
+					ParseToken([]byte("secret"), "valid_token").
+					Return(&jwt.Claims{UserID: 1, Username: "testuser", Role: jwt.RoleEmployee}, nil)
+				return logmocks.NewMockLogger(ctrl), tokenParser
+			},
+			expectedErrCode: codes.OK,
+		},
+		{
+			name:  "invalid token",
+			token: "invalid_token",
+			prepareFn: func(t *testing.T, ctrl *gomock.Controller) (logging.Logger, jwt.TokenParser) {
+				t.Helper()
+				logger := logmocks.NewMockLogger(ctrl)
+				logger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any())
+				tokenParser := jwtmocks.NewMockTokenParser(ctrl)
+				tokenParser.EXPECT().
+					ParseToken([]byte("secret"), "invalid_token").
+					Return(nil, assert.AnError)
+				return logger, tokenParser
+			},
+			expectedErrCode: codes.Unauthenticated,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			logger, tokenParser := tt.prepareFn(t, ctrl)
+			fabric := NewAuthInterceptorFabric("secret", tokenParser, logger)
+
+			md := metadata.New(map[string]string{jwt.TokenMetadataKey: tt.token})
+			stream := &contextServerStream{ctx: metadata.NewIncomingContext(context.Background(), md)}
+
+			var resultCtx context.Context
+			handler := func(srv interface{}, stream grpc.ServerStream) error {
+				resultCtx = stream.Context()
+				return nil
+			}
+
+			err := fabric.GetStreamInterceptor()(nil, stream, &grpc.StreamServerInfo{}, handler)
+
+			assert.Equal(t, tt.expectedErrCode, status.Code(err))
+			if tt.expectedErrCode == codes.OK {
+				require.NotNil(t, resultCtx)
+				assert.Equal(t, 1, resultCtx.Value(userIdContextKey))
+				assert.Equal(t, jwt.RoleEmployee, resultCtx.Value(userRoleContextKey))
 			}
 		})
 	}
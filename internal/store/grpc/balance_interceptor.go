@@ -3,6 +3,7 @@ package grpc
 import (
 	"context"
 
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
 	"github.com/Lexv0lk/merch-store/internal/pkg/logging"
 	"github.com/Lexv0lk/merch-store/internal/store/domain"
 	"google.golang.org/grpc"
@@ -11,17 +12,23 @@ import (
 )
 
 type BalanceInterceptorFabric struct {
-	balanceEnsurer domain.BalanceEnsurer
-	logger         logging.Logger
+	balanceEnsurer       domain.BalanceEnsurer
+	balanceStatusChecker domain.BalanceStatusChecker
+	querier              database.Querier
+	logger               logging.Logger
 }
 
 func NewBalanceInterceptorFabric(
 	balanceEnsurer domain.BalanceEnsurer,
+	balanceStatusChecker domain.BalanceStatusChecker,
+	querier database.Querier,
 	logger logging.Logger,
 ) *BalanceInterceptorFabric {
 	return &BalanceInterceptorFabric{
-		balanceEnsurer: balanceEnsurer,
-		logger:         logger,
+		balanceEnsurer:       balanceEnsurer,
+		balanceStatusChecker: balanceStatusChecker,
+		querier:              querier,
+		logger:               logger,
 	}
 }
 
@@ -32,17 +39,53 @@ func (i *BalanceInterceptorFabric) GetInterceptor() grpc.UnaryServerInterceptor
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
-		userID, ok := ctx.Value(userIdContextKey).(int)
-		if !ok {
-			return nil, status.Error(codes.Internal, "user id not found in context")
+		err := i.checkBalance(ctx)
+		if err != nil {
+			return nil, err
 		}
 
-		err := i.balanceEnsurer.EnsureBalanceCreated(ctx, userID, domain.StartBalance)
+		return handler(ctx, req)
+	}
+}
+
+func (i *BalanceInterceptorFabric) GetStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		err := i.checkBalance(stream.Context())
 		if err != nil {
-			i.logger.Error("failed to ensure balance", "error", err.Error())
-			return nil, status.Error(codes.Internal, "internal error")
+			return err
 		}
 
-		return handler(ctx, req)
+		return handler(srv, stream)
 	}
 }
+
+// checkBalance creates the caller's balance on the first call and rejects callers with a deactivated balance.
+func (i *BalanceInterceptorFabric) checkBalance(ctx context.Context) error {
+	userID, ok := ctx.Value(userIdContextKey).(int)
+	if !ok {
+		return status.Error(codes.Internal, "user id not found in context")
+	}
+
+	err := i.balanceEnsurer.EnsureBalanceCreated(ctx, userID, domain.StartBalance)
+	if err != nil {
+		i.logger.Error("failed to ensure balance", "error", err.Error())
+		return status.Error(codes.Internal, "internal error")
+	}
+
+	isActive, err := i.balanceStatusChecker.IsBalanceActive(ctx, i.querier, userID)
+	if err != nil {
+		i.logger.Error("failed to check balance status", "error", err.Error())
+		return status.Error(codes.Internal, "internal error")
+	}
+
+	if !isActive {
+		return status.Error(codes.PermissionDenied, "account is deactivated")
+	}
+
+	return nil
+}
@@ -1,11 +1,29 @@
 package grpc
 
-import "time"
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+)
 
 const contextTimeLimit = 500 * time.Millisecond
 
-var userIdContextKey = contextKey{name: "user_id"}
+var (
+	userIdContextKey   = contextKey{name: "user_id"}
+	userRoleContextKey = contextKey{name: "user_role"}
+)
 
 type contextKey struct {
 	name string
 }
+
+// contextServerStream replaces the context of a server stream, so stream interceptors can pass values to the handler.
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextServerStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,27 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/jwt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// NewJWTTokenInterceptor passes the token of the caller the store serves on to the auth service, which checks
+// the caller's role for its restricted methods.
+func NewJWTTokenInterceptor(
+	ctx context.Context,
+	method string,
+	req, reply interface{},
+	cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if tokens := md.Get(jwt.TokenMetadataKey); len(tokens) > 0 {
+		ctx = metadata.AppendToOutgoingContext(ctx, jwt.TokenMetadataKey, tokens[0])
+	}
+
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
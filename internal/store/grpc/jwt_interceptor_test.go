@@ -0,0 +1,54 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/jwt"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestNewJWTTokenInterceptor(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name string
+		ctx  context.Context
+
+		expectedTokens []string
+	}
+
+	tests := []testCase{
+		{
+			name:           "caller token passed on",
+			ctx:            metadata.NewIncomingContext(context.Background(), metadata.Pairs(jwt.TokenMetadataKey, "token")),
+			expectedTokens: []string{"token"},
+		},
+		{
+			name: "background call without caller",
+			ctx:  context.Background(),
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var tokens []string
+			invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn,
+				opts ...grpc.CallOption) error {
+				md, _ := metadata.FromOutgoingContext(ctx)
+				tokens = md.Get(jwt.TokenMetadataKey)
+				return nil
+			}
+
+			err := NewJWTTokenInterceptor(tt.ctx, "/merch.v1.AuthService/DeactivateUser", nil, nil, nil, invoker)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedTokens, tokens)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	merchapi "github.com/Lexv0lk/merch-store/gen/merch/v1"
+	"github.com/Lexv0lk/merch-store/internal/pkg/jwt"
+	"github.com/Lexv0lk/merch-store/internal/pkg/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// requiredRoles maps service method prefixes to the role a caller must have to invoke them.
+var requiredRoles = map[string]string{
+	"/" + merchapi.MerchAdminService_ServiceDesc.ServiceName + "/": jwt.RoleAdmin,
+	"/" + merchapi.MerchAuditService_ServiceDesc.ServiceName + "/": jwt.RoleAuditor,
+}
+
+type RoleInterceptorFabric struct {
+	logger logging.Logger
+}
+
+func NewRoleInterceptorFabric(logger logging.Logger) *RoleInterceptorFabric {
+	return &RoleInterceptorFabric{
+		logger: logger,
+	}
+}
+
+func (i *RoleInterceptorFabric) GetInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		err := i.checkRole(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func (i *RoleInterceptorFabric) GetStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		err := i.checkRole(stream.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, stream)
+	}
+}
+
+func (i *RoleInterceptorFabric) checkRole(ctx context.Context, fullMethod string) error {
+	requiredRole, found := roleForMethod(fullMethod)
+	if !found {
+		return nil
+	}
+
+	role, _ := ctx.Value(userRoleContextKey).(string)
+	if role != requiredRole {
+		i.logger.Warn("user without required role tried to call restricted method", "method", fullMethod)
+		return status.Errorf(codes.PermissionDenied, "%s role required", requiredRole)
+	}
+
+	return nil
+}
+
+func roleForMethod(fullMethod string) (string, bool) {
+	for prefix, role := range requiredRoles {
+		if strings.HasPrefix(fullMethod, prefix) {
+			return role, true
+		}
+	}
+
+	return "", false
+}
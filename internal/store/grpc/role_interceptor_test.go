@@ -0,0 +1,106 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	merchapi "github.com/Lexv0lk/merch-store/gen/merch/v1"
+	logmocks "github.com/Lexv0lk/merch-store/gen/mocks/logging"
+	"github.com/Lexv0lk/merch-store/internal/pkg/jwt"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRoleInterceptorFabric_GetInterceptor(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name   string
+		method string
+		role   string
+
+		expectWarn      bool
+		expectedErrCode codes.Code
+	}
+
+	tests := []testCase{
+		{
+			name:            "admin calls admin method",
+			method:          merchapi.MerchAdminService_DeactivateAccount_FullMethodName,
+			role:            jwt.RoleAdmin,
+			expectedErrCode: codes.OK,
+		},
+		{
+			name:            "employee calls admin method",
+			method:          merchapi.MerchAdminService_DeactivateAccount_FullMethodName,
+			role:            jwt.RoleEmployee,
+			expectWarn:      true,
+			expectedErrCode: codes.PermissionDenied,
+		},
+		{
+			name:            "missing role calls admin method",
+			method:          merchapi.MerchAdminService_DeactivateAccount_FullMethodName,
+			expectWarn:      true,
+			expectedErrCode: codes.PermissionDenied,
+		},
+		{
+			name:            "auditor calls admin method",
+			method:          merchapi.MerchAdminService_DeactivateAccount_FullMethodName,
+			role:            jwt.RoleAuditor,
+			expectWarn:      true,
+			expectedErrCode: codes.PermissionDenied,
+		},
+		{
+			name:            "auditor calls audit method",
+			method:          merchapi.MerchAuditService_ListAuditLog_FullMethodName,
+			role:            jwt.RoleAuditor,
+			expectedErrCode: codes.OK,
+		},
+		{
+			name:            "admin calls audit method",
+			method:          merchapi.MerchAuditService_ListAuditLog_FullMethodName,
+			role:            jwt.RoleAdmin,
+			expectWarn:      true,
+			expectedErrCode: codes.PermissionDenied,
+		},
+		{
+			name:            "employee calls store method",
+			method:          merchapi.MerchStoreService_SendCoins_FullMethodName,
+			role:            jwt.RoleEmployee,
+			expectedErrCode: codes.OK,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			logger := logmocks.NewMockLogger(ctrl)
+			if tt.expectWarn {
+				logger.EXPECT().Warn(gomock.Any(), gomock.Any(), gomock.Any())
+			}
+
+			ctx := context.Background()
+			if tt.role != "" {
+				ctx = context.WithValue(ctx, userRoleContextKey, tt.role)
+			}
+
+			handlerCalled := false
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				handlerCalled = true
+				return nil, nil
+			}
+
+			fabric := NewRoleInterceptorFabric(logger)
+			_, err := fabric.GetInterceptor()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+
+			assert.Equal(t, tt.expectedErrCode, status.Code(err))
+			assert.Equal(t, tt.expectedErrCode == codes.OK, handlerCalled)
+		})
+	}
+}
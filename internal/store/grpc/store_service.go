@@ -3,11 +3,14 @@ package grpc
 import (
 	"context"
 	"errors"
+	"time"
 
 	merchapi "github.com/Lexv0lk/merch-store/gen/merch/v1"
+	"github.com/Lexv0lk/merch-store/internal/pkg/audit"
 	"github.com/Lexv0lk/merch-store/internal/pkg/logging"
 	"github.com/Lexv0lk/merch-store/internal/store/application"
 	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -15,34 +18,67 @@ import (
 type StoreServerGRPC struct {
 	merchapi.UnimplementedMerchStoreServiceServer
 
-	purchaseCase  *application.PurchaseCase
-	sendCoinsCase *application.SendCoinsCase
-	userInfoCase  *application.UserInfoCase
+	purchaseCase        *application.PurchaseCase
+	itemTransferCase    *application.ItemTransferCase
+	sendCoinsCase       *application.SendCoinsCase
+	userInfoCase        *application.UserInfoCase
+	teamsCase           *application.TeamsCase
+	paymentRequestsCase *application.PaymentRequestsCase
+	scheduledCase       *application.ScheduledTransfersCase
+	marketplaceCase     *application.MarketplaceCase
+	auctionsCase        *application.AuctionsCase
+	rafflesCase         *application.RafflesCase
+	wishlistCase        *application.WishlistCase
+	catalogCase         *application.CatalogCase
+	preordersCase       *application.PreordersCase
+	notificationsCase   *application.NotificationsCase
 
 	logger logging.Logger
 }
 
 func NewStoreServerGRPC(
 	purchaseCase *application.PurchaseCase,
+	itemTransferCase *application.ItemTransferCase,
 	sendCoinsCase *application.SendCoinsCase,
 	userInfoCase *application.UserInfoCase,
+	teamsCase *application.TeamsCase,
+	paymentRequestsCase *application.PaymentRequestsCase,
+	scheduledCase *application.ScheduledTransfersCase,
+	marketplaceCase *application.MarketplaceCase,
+	auctionsCase *application.AuctionsCase,
+	rafflesCase *application.RafflesCase,
+	wishlistCase *application.WishlistCase,
+	catalogCase *application.CatalogCase,
+	preordersCase *application.PreordersCase,
+	notificationsCase *application.NotificationsCase,
 	logger logging.Logger,
 ) *StoreServerGRPC {
 	return &StoreServerGRPC{
-		purchaseCase:  purchaseCase,
-		sendCoinsCase: sendCoinsCase,
-		userInfoCase:  userInfoCase,
-		logger:        logger,
+		purchaseCase:        purchaseCase,
+		itemTransferCase:    itemTransferCase,
+		sendCoinsCase:       sendCoinsCase,
+		userInfoCase:        userInfoCase,
+		teamsCase:           teamsCase,
+		paymentRequestsCase: paymentRequestsCase,
+		scheduledCase:       scheduledCase,
+		marketplaceCase:     marketplaceCase,
+		auctionsCase:        auctionsCase,
+		rafflesCase:         rafflesCase,
+		wishlistCase:        wishlistCase,
+		catalogCase:         catalogCase,
+		preordersCase:       preordersCase,
+		notificationsCase:   notificationsCase,
+		logger:              logger,
 	}
 }
 
-func (s *StoreServerGRPC) GetUserInfo(ctx context.Context, _ *merchapi.GetUserInfoRequest) (*merchapi.GetUserInfoResponse, error) {
+func (s *StoreServerGRPC) GetUserInfo(ctx context.Context, req *merchapi.GetUserInfoRequest) (*merchapi.GetUserInfoResponse, error) {
 	userID, err := retrieveUserID(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	userInfo, err := s.userInfoCase.GetUserInfo(ctx, userID)
+	userInfo, err := s.userInfoCase.GetUserInfo(ctx, userID, req.IncludeWishlist)
 	if err != nil {
 		s.logger.Error("failed to get user info", "error", err.Error())
 
@@ -65,62 +101,997 @@ func (s *StoreServerGRPC) SendCoins(ctx context.Context, req *merchapi.SendCoins
 	err = s.sendCoinsCase.SendCoins(ctx, userID, req.ToUsername, req.Amount)
 	if err != nil {
 		s.logger.Error("failed to send coins", "error", err.Error())
+		return nil, transferStatusError(err)
+	}
+
+	return &merchapi.SendCoinsResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *StoreServerGRPC) SendCoinsBatch(ctx context.Context, req *merchapi.SendCoinsBatchRequest) (*merchapi.SendCoinsBatchResponse, error) {
+	userID, err := retrieveUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	transfers := make([]domain.CoinTransfer, 0, len(req.Transfers))
+	for _, transfer := range req.Transfers {
+		transfers = append(transfers, domain.CoinTransfer{
+			ToUsername: transfer.ToUsername,
+			Amount:     transfer.Amount,
+		})
+	}
+
+	err = s.sendCoinsCase.SendCoinsBatch(ctx, userID, transfers)
+	if err != nil {
+		s.logger.Error("failed to send coins batch", "error", err.Error())
+
+		if errors.Is(err, &domain.UserNotFoundError{}) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		return nil, transferStatusError(err)
+	}
+
+	return &merchapi.SendCoinsBatchResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *StoreServerGRPC) BuyItem(ctx context.Context, req *merchapi.BuyItemRequest) (*merchapi.BuyItemResponse, error) {
+	userID, err := retrieveUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.purchaseCase.BuyItem(ctx, userID, req.ItemName, req.Variant, req.PromoCode)
+	if err != nil {
+		s.logger.Error("failed to purchase item", "error", err.Error())
+
+		switch {
+		case errors.Is(err, &domain.GoodNotFoundError{}):
+			return nil, status.Error(codes.InvalidArgument, "item not found")
+		case errors.Is(err, &domain.VariantNotFoundError{}), errors.Is(err, &domain.InvalidArgumentsError{}):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, &domain.OutOfStockError{}), errors.Is(err, &domain.PurchaseLimitError{}):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, &domain.PromoCodeNotFoundError{}):
+			return nil, status.Error(codes.InvalidArgument, "promo code not found")
+		case errors.Is(err, &domain.PromoCodeUnusableError{}):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, &domain.InsufficientBalanceError{}):
+			return nil, status.Error(codes.FailedPrecondition, "insufficient funds")
+		case errors.Is(err, &domain.UserNotFoundError{}):
+			return nil, status.Error(codes.NotFound, "user not found")
+		case errors.Is(err, &domain.AccountFrozenError{}):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &merchapi.BuyItemResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *StoreServerGRPC) GiftItem(ctx context.Context, req *merchapi.GiftItemRequest) (*merchapi.GiftItemResponse, error) {
+	userID, err := retrieveUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.purchaseCase.GiftItem(ctx, userID, req.ItemName, req.Variant, req.ToUsername, req.Message)
+	if err != nil {
+		s.logger.Error("failed to gift item", "error", err.Error())
+
+		switch {
+		case errors.Is(err, &domain.GoodNotFoundError{}):
+			return nil, status.Error(codes.InvalidArgument, "item not found")
+		case errors.Is(err, &domain.VariantNotFoundError{}), errors.Is(err, &domain.InvalidArgumentsError{}):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, &domain.InsufficientBalanceError{}):
+			return nil, status.Error(codes.FailedPrecondition, "insufficient funds")
+		case errors.Is(err, &domain.UserDeactivatedError{}):
+			return nil, status.Error(codes.FailedPrecondition, "recipient is deactivated")
+		case errors.Is(err, &domain.OutOfStockError{}), errors.Is(err, &domain.PurchaseLimitError{}):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, &domain.UserNotFoundError{}):
+			return nil, status.Error(codes.NotFound, "user not found")
+		case errors.Is(err, &domain.AccountFrozenError{}):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &merchapi.GiftItemResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *StoreServerGRPC) TransferItem(ctx context.Context, req *merchapi.TransferItemRequest) (*merchapi.TransferItemResponse, error) {
+	userID, err := retrieveUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.itemTransferCase.TransferItem(ctx, userID, req.ItemName, req.Variant, req.ToUsername)
+	if err != nil {
+		s.logger.Error("failed to transfer item", "error", err.Error())
+
+		switch {
+		case errors.Is(err, &domain.GoodNotFoundError{}):
+			return nil, status.Error(codes.InvalidArgument, "item not found")
+		case errors.Is(err, &domain.VariantNotFoundError{}), errors.Is(err, &domain.InvalidArgumentsError{}):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, &domain.ItemNotOwnedError{}):
+			return nil, status.Error(codes.FailedPrecondition, "item is not in your inventory")
+		case errors.Is(err, &domain.PurchaseLimitError{}):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, &domain.UserDeactivatedError{}):
+			return nil, status.Error(codes.FailedPrecondition, "recipient is deactivated")
+		case errors.Is(err, &domain.UserNotFoundError{}):
+			return nil, status.Error(codes.NotFound, "user not found")
+		case errors.Is(err, &domain.AccountFrozenError{}):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &merchapi.TransferItemResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *StoreServerGRPC) SendFromTeamBudget(ctx context.Context, req *merchapi.SendFromTeamBudgetRequest) (*merchapi.SendFromTeamBudgetResponse, error) {
+	userID, err := retrieveUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Amount == 0 {
+		return nil, status.Error(codes.InvalidArgument, "amount must be positive")
+	}
+
+	err = s.teamsCase.SendFromTeamBudget(ctx, userID, req.TeamName, req.ToUsername, req.Amount)
+	if err != nil {
+		s.logger.Error("failed to send coins from team budget", "error", err.Error())
+
+		switch {
+		case errors.Is(err, &domain.TeamNotFoundError{}):
+			return nil, status.Error(codes.NotFound, "team not found")
+		case errors.Is(err, &domain.UserNotFoundError{}):
+			return nil, status.Error(codes.NotFound, "user not found")
+		case errors.Is(err, &domain.NotTeamManagerError{}):
+			return nil, status.Error(codes.PermissionDenied, "only the team manager can spend the team budget")
+		case errors.Is(err, &domain.NotTeamMemberError{}):
+			return nil, status.Error(codes.FailedPrecondition, "recipient is not a team member")
+		case errors.Is(err, &domain.InsufficientBalanceError{}):
+			return nil, status.Error(codes.FailedPrecondition, "insufficient team budget")
+		case errors.Is(err, &domain.UserDeactivatedError{}):
+			return nil, status.Error(codes.FailedPrecondition, "recipient is deactivated")
+		case errors.Is(err, &domain.AccountFrozenError{}):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &merchapi.SendFromTeamBudgetResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *StoreServerGRPC) CreatePaymentRequest(ctx context.Context, req *merchapi.CreatePaymentRequestRequest) (*merchapi.CreatePaymentRequestResponse, error) {
+	userID, err := retrieveUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	requestID, err := s.paymentRequestsCase.CreatePaymentRequest(ctx, userID, req.PayerUsername, req.Amount, req.Note, req.ExpiryDays)
+	if err != nil {
+		s.logger.Error("failed to create payment request", "error", err.Error())
+
+		switch {
+		case errors.Is(err, &domain.InvalidArgumentsError{}):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, &domain.UserNotFoundError{}):
+			return nil, status.Error(codes.NotFound, "user not found")
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &merchapi.CreatePaymentRequestResponse{
+		RequestID: int32(requestID),
+	}, nil
+}
+
+func (s *StoreServerGRPC) ListPaymentRequests(ctx context.Context, _ *merchapi.ListPaymentRequestsRequest) (*merchapi.ListPaymentRequestsResponse, error) {
+	userID, err := retrieveUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	requests, err := s.paymentRequestsCase.ListPendingPaymentRequests(ctx, userID)
+	if err != nil {
+		s.logger.Error("failed to list payment requests", "error", err.Error())
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	resp := &merchapi.ListPaymentRequestsResponse{
+		Requests: make([]*merchapi.PaymentRequestInfo, 0, len(requests)),
+	}
+	for _, request := range requests {
+		resp.Requests = append(resp.Requests, &merchapi.PaymentRequestInfo{
+			Id:           int32(request.Id),
+			FromUsername: request.RequesterUsername,
+			Amount:       request.Amount,
+			Note:         request.Note,
+			CreatedAt:    request.CreatedAt.UTC().Format(time.RFC3339),
+			ExpiresAt:    request.ExpiresAt.UTC().Format(time.RFC3339),
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *StoreServerGRPC) AcceptPaymentRequest(ctx context.Context, req *merchapi.AcceptPaymentRequestRequest) (*merchapi.AcceptPaymentRequestResponse, error) {
+	userID, err := retrieveUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.paymentRequestsCase.AcceptPaymentRequest(ctx, userID, int(req.RequestID))
+	if err != nil {
+		s.logger.Error("failed to accept payment request", "error", err.Error())
+		return nil, paymentRequestStatusError(err)
+	}
+
+	return &merchapi.AcceptPaymentRequestResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *StoreServerGRPC) DeclinePaymentRequest(ctx context.Context, req *merchapi.DeclinePaymentRequestRequest) (*merchapi.DeclinePaymentRequestResponse, error) {
+	userID, err := retrieveUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.paymentRequestsCase.DeclinePaymentRequest(ctx, userID, int(req.RequestID))
+	if err != nil {
+		s.logger.Error("failed to decline payment request", "error", err.Error())
+		return nil, paymentRequestStatusError(err)
+	}
+
+	return &merchapi.DeclinePaymentRequestResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *StoreServerGRPC) ScheduleTransfer(ctx context.Context, req *merchapi.ScheduleTransferRequest) (*merchapi.ScheduleTransferResponse, error) {
+	userID, err := retrieveUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var startAt time.Time
+	if req.StartAt != "" {
+		startAt, err = time.Parse(time.RFC3339, req.StartAt)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "start time must be in RFC 3339 format")
+		}
+	}
+
+	scheduleID, err := s.scheduledCase.ScheduleTransfer(ctx, userID, req.ToUsername, req.Amount, startAt, req.Recurrence)
+	if err != nil {
+		s.logger.Error("failed to schedule transfer", "error", err.Error())
+		return nil, transferStatusError(err)
+	}
+
+	return &merchapi.ScheduleTransferResponse{
+		ScheduleID: int32(scheduleID),
+	}, nil
+}
+
+func (s *StoreServerGRPC) ListScheduledTransfers(ctx context.Context, _ *merchapi.ListScheduledTransfersRequest) (*merchapi.ListScheduledTransfersResponse, error) {
+	userID, err := retrieveUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	transfers, err := s.scheduledCase.ListScheduledTransfers(ctx, userID)
+	if err != nil {
+		s.logger.Error("failed to list scheduled transfers", "error", err.Error())
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	resp := &merchapi.ListScheduledTransfersResponse{
+		Transfers: make([]*merchapi.ScheduledTransferInfo, 0, len(transfers)),
+	}
+	for _, transfer := range transfers {
+		resp.Transfers = append(resp.Transfers, &merchapi.ScheduledTransferInfo{
+			Id:         int32(transfer.Id),
+			ToUsername: transfer.RecipientUsername,
+			Amount:     transfer.Amount,
+			Recurrence: transfer.Recurrence,
+			Status:     transfer.Status,
+			NextRunAt:  transfer.NextAttemptAt.UTC().Format(time.RFC3339),
+			Attempts:   int32(transfer.Attempts),
+			LastError:  transfer.LastError,
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *StoreServerGRPC) CancelScheduledTransfer(ctx context.Context, req *merchapi.CancelScheduledTransferRequest) (*merchapi.CancelScheduledTransferResponse, error) {
+	userID, err := retrieveUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.scheduledCase.CancelScheduledTransfer(ctx, userID, int(req.ScheduleID))
+	if err != nil {
+		s.logger.Error("failed to cancel scheduled transfer", "error", err.Error())
+
+		if errors.Is(err, &domain.ScheduledTransferNotFoundError{}) {
+			return nil, status.Error(codes.NotFound, "scheduled transfer not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &merchapi.CancelScheduledTransferResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *StoreServerGRPC) CreateListing(ctx context.Context, req *merchapi.CreateListingRequest) (*merchapi.CreateListingResponse, error) {
+	userID, err := retrieveUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	listingID, err := s.marketplaceCase.CreateListing(ctx, userID, req.ItemName, req.Variant, req.Price)
+	if err != nil {
+		s.logger.Error("failed to create listing", "error", err.Error())
+
+		switch {
+		case errors.Is(err, &domain.GoodNotFoundError{}):
+			return nil, status.Error(codes.InvalidArgument, "item not found")
+		case errors.Is(err, &domain.VariantNotFoundError{}), errors.Is(err, &domain.InvalidArgumentsError{}):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, &domain.ItemNotOwnedError{}):
+			return nil, status.Error(codes.FailedPrecondition, "no unlisted item of this type in your inventory")
+		case errors.Is(err, &domain.AccountFrozenError{}):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &merchapi.CreateListingResponse{
+		ListingID: int32(listingID),
+	}, nil
+}
+
+func (s *StoreServerGRPC) UpdateListing(ctx context.Context, req *merchapi.UpdateListingRequest) (*merchapi.UpdateListingResponse, error) {
+	userID, err := retrieveUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.marketplaceCase.UpdateListingPrice(ctx, userID, int(req.ListingID), req.Price)
+	if err != nil {
+		s.logger.Error("failed to update listing", "error", err.Error())
+		return nil, listingStatusError(err)
+	}
+
+	return &merchapi.UpdateListingResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *StoreServerGRPC) CancelListing(ctx context.Context, req *merchapi.CancelListingRequest) (*merchapi.CancelListingResponse, error) {
+	userID, err := retrieveUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.marketplaceCase.CancelListing(ctx, userID, int(req.ListingID))
+	if err != nil {
+		s.logger.Error("failed to cancel listing", "error", err.Error())
+		return nil, listingStatusError(err)
+	}
+
+	return &merchapi.CancelListingResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *StoreServerGRPC) SearchListings(ctx context.Context, req *merchapi.SearchListingsRequest) (*merchapi.SearchListingsResponse, error) {
+	filter := domain.ListingFilter{
+		Query:    req.Query,
+		MaxPrice: req.MaxPrice,
+		Limit:    int(req.Limit),
+		Offset:   int(req.Offset),
+	}
+
+	listings, err := s.marketplaceCase.SearchListings(ctx, filter, req.SellerUsername)
+	if err != nil {
+		s.logger.Error("failed to search listings", "error", err.Error())
+
+		if errors.Is(err, &domain.UserNotFoundError{}) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	resp := &merchapi.SearchListingsResponse{
+		Listings: make([]*merchapi.ListingInfo, 0, len(listings)),
+	}
+	for _, listing := range listings {
+		resp.Listings = append(resp.Listings, &merchapi.ListingInfo{
+			Id:             int32(listing.Id),
+			ItemName:       listing.GoodName,
+			Variant:        listing.Variant,
+			SellerUsername: listing.SellerUsername,
+			Price:          listing.Price,
+			CreatedAt:      listing.CreatedAt.UTC().Format(time.RFC3339),
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *StoreServerGRPC) BuyListing(ctx context.Context, req *merchapi.BuyListingRequest) (*merchapi.BuyListingResponse, error) {
+	userID, err := retrieveUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.marketplaceCase.BuyListing(ctx, userID, int(req.ListingID))
+	if err != nil {
+		s.logger.Error("failed to buy listing", "error", err.Error())
+		return nil, listingStatusError(err)
+	}
+
+	return &merchapi.BuyListingResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *StoreServerGRPC) ListAuctions(ctx context.Context, _ *merchapi.ListAuctionsRequest) (*merchapi.ListAuctionsResponse, error) {
+	auctions, err := s.auctionsCase.ListAuctions(ctx)
+	if err != nil {
+		s.logger.Error("failed to list auctions", "error", err.Error())
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	resp := &merchapi.ListAuctionsResponse{
+		Auctions: make([]*merchapi.AuctionInfo, 0, len(auctions)),
+	}
+	for _, auction := range auctions {
+		resp.Auctions = append(resp.Auctions, &merchapi.AuctionInfo{
+			Id:                int32(auction.Id),
+			ItemName:          auction.GoodName,
+			StartsAt:          auction.StartsAt.UTC().Format(time.RFC3339),
+			EndsAt:            auction.EndsAt.UTC().Format(time.RFC3339),
+			TopBid:            auction.TopBid.Amount,
+			TopBidderUsername: auction.TopBidderUsername,
+			ReserveMet:        auction.IsReserveMet(),
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *StoreServerGRPC) PlaceBid(ctx context.Context, req *merchapi.PlaceBidRequest) (*merchapi.PlaceBidResponse, error) {
+	userID, err := retrieveUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.auctionsCase.PlaceBid(ctx, userID, int(req.AuctionID), req.Amount)
+	if err != nil {
+		s.logger.Error("failed to place bid", "error", err.Error())
 
 		switch {
+		case errors.Is(err, &domain.AuctionNotFoundError{}):
+			return nil, status.Error(codes.NotFound, "auction not found")
+		case errors.Is(err, &domain.AuctionClosedError{}), errors.Is(err, &domain.PurchaseLimitError{}):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
 		case errors.Is(err, &domain.InvalidArgumentsError{}):
 			return nil, status.Error(codes.InvalidArgument, err.Error())
-		case errors.Is(err, &domain.UserNotFoundError{}):
-			return nil, status.Error(codes.NotFound, "user not found")
 		case errors.Is(err, &domain.InsufficientBalanceError{}):
 			return nil, status.Error(codes.FailedPrecondition, "insufficient funds")
+		case errors.Is(err, &domain.AccountFrozenError{}):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
 		default:
 			return nil, status.Error(codes.Internal, "internal error")
 		}
 	}
 
-	return &merchapi.SendCoinsResponse{
+	return &merchapi.PlaceBidResponse{
 		Success: true,
 	}, nil
 }
 
-func (s *StoreServerGRPC) BuyItem(ctx context.Context, req *merchapi.BuyItemRequest) (*merchapi.BuyItemResponse, error) {
+func (s *StoreServerGRPC) ListRaffles(ctx context.Context, _ *merchapi.ListRafflesRequest) (*merchapi.ListRafflesResponse, error) {
+	raffles, err := s.rafflesCase.ListRaffles(ctx)
+	if err != nil {
+		s.logger.Error("failed to list raffles", "error", err.Error())
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	resp := &merchapi.ListRafflesResponse{
+		Raffles: make([]*merchapi.RaffleInfo, 0, len(raffles)),
+	}
+	for _, raffle := range raffles {
+		resp.Raffles = append(resp.Raffles, &merchapi.RaffleInfo{
+			Id:          int32(raffle.Id),
+			ItemName:    raffle.GoodName,
+			TicketPrice: raffle.TicketPrice,
+			DrawAt:      raffle.DrawAt.UTC().Format(time.RFC3339),
+			TicketsSold: raffle.TicketsSold,
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *StoreServerGRPC) BuyRaffleTickets(ctx context.Context, req *merchapi.BuyRaffleTicketsRequest) (*merchapi.BuyRaffleTicketsResponse, error) {
 	userID, err := retrieveUserID(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	err = s.purchaseCase.BuyItem(ctx, userID, req.ItemName)
+	err = s.rafflesCase.BuyTickets(ctx, userID, int(req.RaffleID), req.Count)
 	if err != nil {
-		s.logger.Error("failed to purchase item", "error", err.Error())
+		s.logger.Error("failed to buy raffle tickets", "error", err.Error())
+
+		switch {
+		case errors.Is(err, &domain.RaffleNotFoundError{}):
+			return nil, status.Error(codes.NotFound, "raffle not found")
+		case errors.Is(err, &domain.RaffleClosedError{}), errors.Is(err, &domain.PurchaseLimitError{}):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, &domain.InvalidArgumentsError{}):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, &domain.InsufficientBalanceError{}):
+			return nil, status.Error(codes.FailedPrecondition, "insufficient funds")
+		case errors.Is(err, &domain.AccountFrozenError{}):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &merchapi.BuyRaffleTicketsResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *StoreServerGRPC) AddToWishlist(ctx context.Context, req *merchapi.AddToWishlistRequest) (*merchapi.AddToWishlistResponse, error) {
+	userID, err := retrieveUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.wishlistCase.AddItem(ctx, userID, req.ItemName)
+	if err != nil {
+		s.logger.Error("failed to add wishlist item", "error", err.Error())
+		return nil, wishlistStatusError(err)
+	}
+
+	return &merchapi.AddToWishlistResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *StoreServerGRPC) RemoveFromWishlist(ctx context.Context, req *merchapi.RemoveFromWishlistRequest) (*merchapi.RemoveFromWishlistResponse, error) {
+	userID, err := retrieveUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.wishlistCase.RemoveItem(ctx, userID, req.ItemName)
+	if err != nil {
+		s.logger.Error("failed to remove wishlist item", "error", err.Error())
+		return nil, wishlistStatusError(err)
+	}
+
+	return &merchapi.RemoveFromWishlistResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *StoreServerGRPC) ListWishlist(ctx context.Context, _ *merchapi.ListWishlistRequest) (*merchapi.ListWishlistResponse, error) {
+	userID, err := retrieveUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items, balance, err := s.wishlistCase.ListItems(ctx, userID)
+	if err != nil {
+		s.logger.Error("failed to list wishlist", "error", err.Error())
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &merchapi.ListWishlistResponse{
+		Items: convertToWishlistItems(items, balance),
+	}, nil
+}
+
+func (s *StoreServerGRPC) ListWishlistEvents(ctx context.Context, _ *merchapi.ListWishlistEventsRequest) (*merchapi.ListWishlistEventsResponse, error) {
+	userID, err := retrieveUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := s.wishlistCase.ListEvents(ctx, userID)
+	if err != nil {
+		s.logger.Error("failed to list wishlist events", "error", err.Error())
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	resp := &merchapi.ListWishlistEventsResponse{
+		Events: make([]*merchapi.WishlistEvent, 0, len(events)),
+	}
+	for _, event := range events {
+		resp.Events = append(resp.Events, &merchapi.WishlistEvent{
+			Kind:      event.Kind,
+			ItemName:  event.GoodName,
+			OldPrice:  event.OldPrice,
+			NewPrice:  event.NewPrice,
+			CreatedAt: event.CreatedAt.UTC().Format(time.RFC3339),
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *StoreServerGRPC) ListGoods(ctx context.Context, req *merchapi.ListGoodsRequest) (*merchapi.ListGoodsResponse, error) {
+	goods, err := s.catalogCase.ListGoods(ctx, req.Category)
+	if err != nil {
+		s.logger.Error("failed to list goods", "error", err.Error())
+		switch {
+		case errors.Is(err, &domain.CategoryNotFoundError{}):
+			return nil, status.Error(codes.NotFound, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	resp := &merchapi.ListGoodsResponse{
+		Items: make([]*merchapi.CatalogItem, 0, len(goods)),
+	}
+	for _, good := range goods {
+		item := &merchapi.CatalogItem{
+			Name:        good.Name,
+			Price:       good.Price,
+			BasePrice:   good.BasePrice,
+			SaleEndsAt:  formatTimeBound(good.SaleEndsAt.UTC()),
+			Variants:    make([]*merchapi.CatalogVariant, 0, len(good.Variants)),
+			Category:    good.Category,
+			Description: good.Description,
+			Images:      make([]*merchapi.GoodImage, 0, len(good.Images)),
+			Components:  make([]*merchapi.BundleComponent, 0, len(good.Components)),
+			Upcoming:    good.Upcoming,
+		}
+
+		for _, component := range good.Components {
+			item.Components = append(item.Components, &merchapi.BundleComponent{
+				ItemName: component.GoodName,
+				Quantity: component.Quantity,
+			})
+		}
+
+		for _, image := range good.Images {
+			item.Images = append(item.Images, &merchapi.GoodImage{
+				Url:     image.URL,
+				AltText: image.AltText,
+				Width:   image.Width,
+				Height:  image.Height,
+			})
+		}
+
+		for _, variant := range good.Variants {
+			catalogVariant := &merchapi.CatalogVariant{
+				Sku:        variant.SKU,
+				Attributes: variant.Attributes,
+				Price:      variant.Price(good.Price),
+			}
+			if variant.Stock != nil {
+				catalogVariant.StockTracked = true
+				catalogVariant.Stock = *variant.Stock
+			}
+
+			item.Variants = append(item.Variants, catalogVariant)
+		}
+
+		resp.Items = append(resp.Items, item)
+	}
+
+	return resp, nil
+}
+
+func (s *StoreServerGRPC) ListCategories(ctx context.Context, _ *merchapi.ListCategoriesRequest) (*merchapi.ListCategoriesResponse, error) {
+	categories, err := s.catalogCase.ListCategories(ctx)
+	if err != nil {
+		s.logger.Error("failed to list categories", "error", err.Error())
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	resp := &merchapi.ListCategoriesResponse{
+		Categories: make([]*merchapi.CategoryInfo, 0, len(categories)),
+	}
+	for _, category := range categories {
+		resp.Categories = append(resp.Categories, &merchapi.CategoryInfo{
+			Slug: category.Slug,
+			Name: category.Name,
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *StoreServerGRPC) GetPriceHistory(ctx context.Context, req *merchapi.GetPriceHistoryRequest) (*merchapi.GetPriceHistoryResponse, error) {
+	history, err := s.catalogCase.GetPriceHistory(ctx, req.ItemName)
+	if err != nil {
+		s.logger.Error("failed to get price history", "error", err.Error())
+		switch {
+		case errors.Is(err, &domain.GoodNotFoundError{}):
+			return nil, status.Error(codes.NotFound, "item not found")
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	resp := &merchapi.GetPriceHistoryResponse{
+		BasePrice: history.BasePrice,
+		Changes:   make([]*merchapi.PriceChange, 0, len(history.Schedules)),
+	}
+	for _, schedule := range history.Schedules {
+		resp.Changes = append(resp.Changes, &merchapi.PriceChange{
+			Price:    schedule.Price,
+			StartsAt: schedule.StartsAt.UTC().Format(time.RFC3339),
+			EndsAt:   formatTimeBound(schedule.EndsAt.UTC()),
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *StoreServerGRPC) PlacePreorder(ctx context.Context, req *merchapi.PlacePreorderRequest) (*merchapi.PlacePreorderResponse, error) {
+	userID, err := retrieveUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	preorderID, err := s.preordersCase.PlacePreorder(ctx, userID, req.ItemName, req.Variant)
+	if err != nil {
+		s.logger.Error("failed to place preorder", "error", err.Error())
 
 		switch {
 		case errors.Is(err, &domain.GoodNotFoundError{}):
 			return nil, status.Error(codes.InvalidArgument, "item not found")
+		case errors.Is(err, &domain.VariantNotFoundError{}), errors.Is(err, &domain.InvalidArgumentsError{}):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, &domain.PurchaseLimitError{}):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, &domain.PreorderExistingError{}):
+			return nil, status.Error(codes.AlreadyExists, err.Error())
 		case errors.Is(err, &domain.InsufficientBalanceError{}):
 			return nil, status.Error(codes.FailedPrecondition, "insufficient funds")
 		case errors.Is(err, &domain.UserNotFoundError{}):
 			return nil, status.Error(codes.NotFound, "user not found")
+		case errors.Is(err, &domain.AccountFrozenError{}):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
 		default:
 			return nil, status.Error(codes.Internal, "internal error")
 		}
 	}
 
-	return &merchapi.BuyItemResponse{
+	return &merchapi.PlacePreorderResponse{
+		PreorderID: int32(preorderID),
+	}, nil
+}
+
+func (s *StoreServerGRPC) ListPreorders(ctx context.Context, _ *merchapi.ListPreordersRequest) (*merchapi.ListPreordersResponse, error) {
+	userID, err := retrieveUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	preorders, held, err := s.preordersCase.ListPreorders(ctx, userID)
+	if err != nil {
+		s.logger.Error("failed to list preorders", "error", err.Error())
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	resp := &merchapi.ListPreordersResponse{
+		Preorders: make([]*merchapi.PreorderInfo, 0, len(preorders)),
+		HeldCoins: held,
+	}
+	for _, preorder := range preorders {
+		resp.Preorders = append(resp.Preorders, &merchapi.PreorderInfo{
+			Id:         int32(preorder.Id),
+			ItemName:   preorder.GoodName,
+			Variant:    preorder.Variant,
+			Price:      preorder.Price,
+			Status:     preorder.Status,
+			CreatedAt:  preorder.CreatedAt.UTC().Format(time.RFC3339),
+			ResolvedAt: formatTimeBound(preorder.ResolvedAt.UTC()),
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *StoreServerGRPC) CancelPreorder(ctx context.Context, req *merchapi.CancelPreorderRequest) (*merchapi.CancelPreorderResponse, error) {
+	userID, err := retrieveUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.preordersCase.CancelPreorder(ctx, userID, int(req.PreorderID))
+	if err != nil {
+		s.logger.Error("failed to cancel preorder", "error", err.Error())
+
+		switch {
+		case errors.Is(err, &domain.PreorderNotFoundError{}):
+			return nil, status.Error(codes.NotFound, "pre-order not found")
+		case errors.Is(err, &domain.PreorderClosedError{}):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &merchapi.CancelPreorderResponse{
 		Success: true,
 	}, nil
 }
 
+// SubscribeEvents streams the caller's notifications until the caller goes away.
+func (s *StoreServerGRPC) SubscribeEvents(_ *merchapi.SubscribeEventsRequest, stream grpc.ServerStreamingServer[merchapi.UserEvent]) error {
+	ctx := stream.Context()
+
+	userID, err := retrieveUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = s.notificationsCase.Subscribe(ctx, userID, func(notification domain.Notification) error {
+		return stream.Send(&merchapi.UserEvent{
+			Type:       notification.Type,
+			Data:       audit.EncodeValues(notification.Data),
+			OccurredAt: notification.OccurredAt.UTC().Format(time.RFC3339Nano),
+		})
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		s.logger.Error("failed to stream events", "error", err.Error())
+		return status.Error(codes.Internal, "internal error")
+	}
+
+	return nil
+}
+
+func wishlistStatusError(err error) error {
+	switch {
+	case errors.Is(err, &domain.GoodNotFoundError{}):
+		return status.Error(codes.NotFound, "item not found")
+	case errors.Is(err, &domain.WishlistItemNotFoundError{}):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, &domain.InvalidArgumentsError{}):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, "internal error")
+	}
+}
+
+func convertToWishlistItems(items []domain.WishlistItem, balance uint32) []*merchapi.WishlistItem {
+	converted := make([]*merchapi.WishlistItem, 0, len(items))
+	for _, item := range items {
+		converted = append(converted, &merchapi.WishlistItem{
+			Name:        item.GoodName,
+			Price:       item.Price,
+			CoinsNeeded: item.CoinsNeeded(balance),
+		})
+	}
+
+	return converted
+}
+
+// transferStatusError maps errors of a coin transfer between users to gRPC statuses.
+func transferStatusError(err error) error {
+	switch {
+	case errors.Is(err, &domain.InvalidArgumentsError{}):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, &domain.UserNotFoundError{}):
+		return status.Error(codes.NotFound, "user not found")
+	case errors.Is(err, &domain.InsufficientBalanceError{}):
+		return status.Error(codes.FailedPrecondition, "insufficient funds")
+	case errors.Is(err, &domain.UserDeactivatedError{}):
+		return status.Error(codes.FailedPrecondition, "recipient is deactivated")
+	case errors.Is(err, &domain.LimitExceededError{}):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, &domain.AccountFrozenError{}):
+		return status.Error(codes.PermissionDenied, err.Error())
+	default:
+		return status.Error(codes.Internal, "internal error")
+	}
+}
+
+func paymentRequestStatusError(err error) error {
+	switch {
+	case errors.Is(err, &domain.PaymentRequestNotFoundError{}):
+		return status.Error(codes.NotFound, "payment request not found")
+	case errors.Is(err, &domain.PaymentRequestClosedError{}):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return transferStatusError(err)
+	}
+}
+
+func listingStatusError(err error) error {
+	switch {
+	case errors.Is(err, &domain.ListingNotFoundError{}):
+		return status.Error(codes.NotFound, "listing not found")
+	case errors.Is(err, &domain.ListingClosedError{}):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, &domain.ItemNotOwnedError{}):
+		return status.Error(codes.FailedPrecondition, "item is no longer available")
+	case errors.Is(err, &domain.PurchaseLimitError{}):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return transferStatusError(err)
+	}
+}
+
 func convertToUserInfoResponse(userInfo domain.TotalUserInfo) *merchapi.GetUserInfoResponse {
 	balance := userInfo.Balance
 	inventory := make([]*merchapi.InventoryItem, 0, len(userInfo.Goods))
 
-	for good, quantity := range userInfo.Goods {
-		inventory = append(inventory, &merchapi.InventoryItem{
-			Name:     good.Name,
-			Quantity: quantity,
+	giftsByGood := make(map[string][]*merchapi.GiftInfo)
+	for _, gift := range userInfo.Gifts {
+		giftsByGood[gift.GoodName] = append(giftsByGood[gift.GoodName], &merchapi.GiftInfo{
+			FromUsername: gift.FromUsername,
+			Message:      gift.Message,
 		})
 	}
 
+	itemsByGood := make(map[string]*merchapi.InventoryItem)
+	for good, quantity := range userInfo.Goods {
+		item, ok := itemsByGood[good.Name]
+		if !ok {
+			item = &merchapi.InventoryItem{
+				Name:  good.Name,
+				Gifts: giftsByGood[good.Name],
+			}
+			itemsByGood[good.Name] = item
+			inventory = append(inventory, item)
+		}
+
+		item.Quantity += quantity
+		if good.Variant != "" {
+			item.Variants = append(item.Variants, &merchapi.InventoryVariant{
+				Sku:      good.Variant,
+				Quantity: quantity,
+			})
+		}
+	}
+
 	transferHistory := &merchapi.CoinHistory{
 		Sent:     make([]*merchapi.SentCoinsInfo, 0, len(userInfo.CoinTransferHistory.OutcomingTransfers)),
 		Received: make([]*merchapi.ReceivedCoinsInfo, 0, len(userInfo.CoinTransferHistory.IncomingTransfers)),
@@ -140,10 +1111,31 @@ func convertToUserInfoResponse(userInfo domain.TotalUserInfo) *merchapi.GetUserI
 		})
 	}
 
+	itemHistory := &merchapi.ItemHistory{
+		Sent:     make([]*merchapi.SentItemInfo, 0, len(userInfo.ItemTransferHistory.OutcomingTransfers)),
+		Received: make([]*merchapi.ReceivedItemInfo, 0, len(userInfo.ItemTransferHistory.IncomingTransfers)),
+	}
+
+	for _, transfer := range userInfo.ItemTransferHistory.OutcomingTransfers {
+		itemHistory.Sent = append(itemHistory.Sent, &merchapi.SentItemInfo{
+			ToUsername: transfer.TargetUsername,
+			ItemName:   transfer.GoodName,
+		})
+	}
+
+	for _, transfer := range userInfo.ItemTransferHistory.IncomingTransfers {
+		itemHistory.Received = append(itemHistory.Received, &merchapi.ReceivedItemInfo{
+			FromUsername: transfer.TargetUsername,
+			ItemName:     transfer.GoodName,
+		})
+	}
+
 	return &merchapi.GetUserInfoResponse{
 		Balance:     balance,
 		Inventory:   inventory,
 		CoinHistory: transferHistory,
+		ItemHistory: itemHistory,
+		Wishlist:    convertToWishlistItems(userInfo.Wishlist, balance),
 	}
 }
 
@@ -0,0 +1,54 @@
+package events
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileSink_PublishEvents(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "events.log")
+	occurredAt := time.Date(2026, 8, 10, 10, 0, 0, 0, time.UTC)
+	sink := NewFileSink(path)
+
+	err := sink.PublishEvents(t.Context(), []domain.DomainEvent{
+		{Id: 38, Offset: 11, Type: domain.EventBalanceCreated, UserIDs: []int{1}, OccurredAt: occurredAt,
+			Data: map[string]any{"userId": float64(1), "balance": float64(1000)}},
+	})
+	require.NoError(t, err)
+
+	err = sink.PublishEvents(t.Context(), []domain.DomainEvent{
+		{Id: 40, Offset: 12, Type: domain.EventCoinsSent, UserIDs: []int{1, 2}, OccurredAt: occurredAt,
+			Data: map[string]any{"fromUserId": float64(1), "toUserId": float64(2), "amount": float64(100)}},
+	})
+	require.NoError(t, err)
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var messages []message
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var msg message
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &msg))
+		messages = append(messages, msg)
+	}
+	require.NoError(t, scanner.Err())
+
+	require.Len(t, messages, 2)
+	assert.Equal(t, int64(38), messages[0].Id)
+	assert.Equal(t, domain.EventBalanceCreated, messages[0].Type)
+	assert.Equal(t, int64(12), messages[1].Offset)
+	assert.Equal(t, []int{1, 2}, messages[1].UserIDs)
+	assert.Equal(t, occurredAt, messages[1].OccurredAt)
+}
@@ -0,0 +1,58 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+)
+
+// HTTPSink posts every batch of events to a URL as {"events": [...]}.
+type HTTPSink struct {
+	url    string
+	client *http.Client
+}
+
+func NewHTTPSink(url string, timeout time.Duration) *HTTPSink {
+	return &HTTPSink{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// PublishEvents fails unless the receiver answers with a 2xx status.
+func (s *HTTPSink) PublishEvents(ctx context.Context, events []domain.DomainEvent) error {
+	messages := make([]message, 0, len(events))
+	for _, event := range events {
+		messages = append(messages, newMessage(event))
+	}
+
+	body, err := json.Marshal(map[string][]message{"events": messages})
+	if err != nil {
+		return fmt.Errorf("failed to encode events: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create events request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post events: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("event receiver responded with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
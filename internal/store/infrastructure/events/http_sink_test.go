@@ -0,0 +1,67 @@
+package events
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPSink_PublishEvents(t *testing.T) {
+	t.Parallel()
+
+	events := []domain.DomainEvent{
+		{Id: 40, Offset: 12, Type: domain.EventCoinsSent, UserIDs: []int{1, 2},
+			Data: map[string]any{"amount": float64(100)}, OccurredAt: time.Date(2026, 8, 10, 10, 0, 0, 0, time.UTC)},
+	}
+
+	type testCase struct {
+		name   string
+		status int
+
+		expectedErr bool
+	}
+
+	testCases := []testCase{
+		{
+			name:   "events accepted",
+			status: http.StatusNoContent,
+		},
+		{
+			name:        "receiver failed",
+			status:      http.StatusInternalServerError,
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var received map[string][]message
+			receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+
+				w.WriteHeader(tt.status)
+			}))
+			defer receiver.Close()
+
+			err := NewHTTPSink(receiver.URL, time.Second).PublishEvents(t.Context(), events)
+
+			if tt.expectedErr {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, map[string][]message{"events": {newMessage(events[0])}}, received)
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package events
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+)
+
+// SubjectPrefix is followed by the event type in the subject an event is published to, e.g. merch.events.CoinsSent.
+const SubjectPrefix = "merch.events."
+
+// NATSSink publishes events to a broker speaking the NATS client protocol. It connects for every batch and waits
+// for the broker to acknowledge the batch with a PONG, which the broker sends after processing every PUB before it.
+type NATSSink struct {
+	address string
+	timeout time.Duration
+}
+
+func NewNATSSink(address string, timeout time.Duration) *NATSSink {
+	return &NATSSink{
+		address: address,
+		timeout: timeout,
+	}
+}
+
+func (s *NATSSink) PublishEvents(ctx context.Context, events []domain.DomainEvent) error {
+	var batch bytes.Buffer
+	batch.WriteString("CONNECT {\"verbose\":false,\"pedantic\":false,\"name\":\"merch-store\"}\r\n")
+	for _, event := range events {
+		payload, err := json.Marshal(newMessage(event))
+		if err != nil {
+			return fmt.Errorf("failed to encode event %d: %w", event.Id, err)
+		}
+
+		fmt.Fprintf(&batch, "PUB %s%s %d\r\n", SubjectPrefix, event.Type, len(payload))
+		batch.Write(payload)
+		batch.WriteString("\r\n")
+	}
+	batch.WriteString("PING\r\n")
+
+	dialer := net.Dialer{Timeout: s.timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", s.address)
+	if err != nil {
+		return fmt.Errorf("failed to connect to event broker: %w", err)
+	}
+	defer conn.Close()
+
+	err = conn.SetDeadline(time.Now().Add(s.timeout))
+	if err != nil {
+		return fmt.Errorf("failed to set event broker deadline: %w", err)
+	}
+
+	reader := bufio.NewReader(conn)
+	line, err := readLine(reader)
+	if err != nil {
+		return fmt.Errorf("failed to read event broker info: %w", err)
+	} else if !strings.HasPrefix(line, "INFO") {
+		return fmt.Errorf("unexpected event broker greeting: %s", line)
+	}
+
+	_, err = conn.Write(batch.Bytes())
+	if err != nil {
+		return fmt.Errorf("failed to publish events: %w", err)
+	}
+
+	for {
+		line, err := readLine(reader)
+		if err != nil {
+			return fmt.Errorf("failed to read event broker reply: %w", err)
+		}
+
+		switch {
+		case line == "PONG":
+			return nil
+		case line == "PING":
+			if _, err := conn.Write([]byte("PONG\r\n")); err != nil {
+				return fmt.Errorf("failed to reply to event broker: %w", err)
+			}
+		case strings.HasPrefix(line, "-ERR"):
+			return fmt.Errorf("event broker rejected events: %s", line)
+		}
+	}
+}
+
+func readLine(reader *bufio.Reader) (string, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimRight(line, "\r\n"), nil
+}
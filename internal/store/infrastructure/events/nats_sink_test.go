@@ -0,0 +1,152 @@
+package events
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type publishedMessage struct {
+	subject string
+	payload []byte
+}
+
+// serveNATS accepts a single connection, records the published messages and answers the PING with reply.
+func serveNATS(t *testing.T, listener net.Listener, reply string) <-chan []publishedMessage {
+	t.Helper()
+
+	published := make(chan []publishedMessage, 1)
+	go func() {
+		defer close(published)
+
+		conn, err := listener.Accept()
+		if !assert.NoError(t, err) {
+			return
+		}
+		defer conn.Close()
+
+		_, err = conn.Write([]byte("INFO {\"server_id\":\"test\"}\r\n"))
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		var messages []publishedMessage
+		reader := bufio.NewReader(conn)
+		for {
+			line, err := readLine(reader)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			switch {
+			case strings.HasPrefix(line, "CONNECT "):
+			case strings.HasPrefix(line, "PUB "):
+				fields := strings.Fields(line)
+				size, err := strconv.Atoi(fields[2])
+				if !assert.NoError(t, err) {
+					return
+				}
+
+				payload := make([]byte, size+2)
+				_, err = io.ReadFull(reader, payload)
+				if !assert.NoError(t, err) {
+					return
+				}
+
+				messages = append(messages, publishedMessage{subject: fields[1], payload: payload[:size]})
+			case line == "PING":
+				_, err = conn.Write([]byte(reply + "\r\n"))
+				assert.NoError(t, err)
+				published <- messages
+				return
+			default:
+				t.Errorf("unexpected line: %s", line)
+				return
+			}
+		}
+	}()
+
+	return published
+}
+
+func TestNATSSink_PublishEvents(t *testing.T) {
+	t.Parallel()
+
+	events := []domain.DomainEvent{
+		{Id: 38, Offset: 11, Type: domain.EventBalanceCreated, UserIDs: []int{2},
+			Data: map[string]any{"userId": float64(2)}, OccurredAt: time.Date(2026, 8, 10, 10, 0, 0, 0, time.UTC)},
+		{Id: 40, Offset: 12, Type: domain.EventCoinsSent, UserIDs: []int{1, 2},
+			Data: map[string]any{"amount": float64(100)}, OccurredAt: time.Date(2026, 8, 10, 10, 0, 0, 0, time.UTC)},
+	}
+
+	type testCase struct {
+		name  string
+		reply string
+
+		expectedErr bool
+	}
+
+	testCases := []testCase{
+		{
+			name:  "events acknowledged",
+			reply: "PONG",
+		},
+		{
+			name:        "events rejected",
+			reply:       "-ERR 'Permissions Violation for Publish'",
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			require.NoError(t, err)
+			defer listener.Close()
+
+			published := serveNATS(t, listener, tt.reply)
+
+			err = NewNATSSink(listener.Addr().String(), time.Second).PublishEvents(t.Context(), events)
+
+			if tt.expectedErr {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			messages := <-published
+			require.Len(t, messages, 2)
+			assert.Equal(t, "merch.events.BalanceCreated", messages[0].subject)
+			assert.Equal(t, "merch.events.CoinsSent", messages[1].subject)
+
+			var msg message
+			require.NoError(t, json.Unmarshal(messages[1].payload, &msg))
+			assert.Equal(t, newMessage(events[1]), msg)
+		})
+	}
+}
+
+func TestNATSSink_PublishEvents_Unreachable(t *testing.T) {
+	t.Parallel()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	err = NewNATSSink(address, time.Second).PublishEvents(t.Context(), []domain.DomainEvent{{Id: 1, Type: domain.EventCoinsSent}})
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,60 @@
+package events
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+)
+
+const (
+	SinkFile = "file"
+	SinkHTTP = "http"
+	SinkNATS = "nats"
+
+	sinkTimeout = 10 * time.Second
+)
+
+// message is the JSON form of a published event.
+type message struct {
+	Id         int64          `json:"id"`
+	Offset     int64          `json:"offset"`
+	Type       string         `json:"type"`
+	UserIDs    []int          `json:"userIds"`
+	Data       map[string]any `json:"data"`
+	OccurredAt time.Time      `json:"occurredAt"`
+}
+
+func newMessage(event domain.DomainEvent) message {
+	return message{
+		Id:         event.Id,
+		Offset:     event.Offset,
+		Type:       event.Type,
+		UserIDs:    event.UserIDs,
+		Data:       event.Data,
+		OccurredAt: event.OccurredAt,
+	}
+}
+
+// NewSink creates the sink of the given kind publishing to target: a file path for SinkFile, a URL for SinkHTTP
+// and the host:port of the broker for SinkNATS. An empty kind means no sink and returns nil.
+func NewSink(kind, target string) (domain.EventSink, error) {
+	if kind == "" {
+		return nil, nil
+	}
+
+	if target == "" {
+		return nil, fmt.Errorf("%s event sink requires a target", kind)
+	}
+
+	switch kind {
+	case SinkFile:
+		return NewFileSink(target), nil
+	case SinkHTTP:
+		return NewHTTPSink(target, sinkTimeout), nil
+	case SinkNATS:
+		return NewNATSSink(target, sinkTimeout), nil
+	default:
+		return nil, fmt.Errorf("unknown event sink: %s", kind)
+	}
+}
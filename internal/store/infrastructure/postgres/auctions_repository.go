@@ -0,0 +1,241 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+const auctionColumns = `a.id, a.good_id, g.name, a.reserve_price, a.starts_at, a.ends_at, a.status,
+	COALESCE(b.id, 0), COALESCE(b.user_id, 0), COALESCE(b.amount, 0)`
+
+const auctionTables = `auctions a
+	JOIN goods g ON a.good_id = g.id
+	LEFT JOIN auction_bids b ON b.auction_id = a.id AND b.status = 'held'`
+
+type AuctionsRepository struct {
+	queryExecuter database.QueryExecuter
+}
+
+func NewAuctionsRepository(queryExecuter database.QueryExecuter) *AuctionsRepository {
+	return &AuctionsRepository{
+		queryExecuter: queryExecuter,
+	}
+}
+
+func (ar *AuctionsRepository) CreateAuction(ctx context.Context, querier database.Querier, auction domain.Auction) (int, error) {
+	insertSQL := `INSERT INTO auctions (good_id, reserve_price, starts_at, ends_at) VALUES ($1, $2, $3, $4) RETURNING id`
+
+	var auctionID int
+	err := querier.QueryRow(ctx, insertSQL, auction.GoodID, auction.ReservePrice, auction.StartsAt,
+		auction.EndsAt).Scan(&auctionID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create auction: %w", err)
+	}
+
+	return auctionID, nil
+}
+
+// ListOpenAuctions returns the running and upcoming auctions, the ones closing soonest first.
+func (ar *AuctionsRepository) ListOpenAuctions(ctx context.Context) ([]domain.Auction, error) {
+	listSQL := `SELECT ` + auctionColumns + ` FROM ` + auctionTables + `
+		WHERE a.status = $1 AND a.ends_at > NOW()
+		ORDER BY a.ends_at, a.id`
+
+	rows, err := ar.queryExecuter.Query(ctx, listSQL, domain.AuctionStatusOpen)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list auctions: %w", err)
+	}
+	defer rows.Close()
+
+	auctions := make([]domain.Auction, 0)
+	for rows.Next() {
+		auction, err := scanAuction(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan auction: %w", err)
+		}
+
+		auctions = append(auctions, auction)
+	}
+
+	return auctions, rows.Err()
+}
+
+func (ar *AuctionsRepository) LockAndGetAuction(ctx context.Context, querier database.Querier, auctionID int) (domain.Auction, error) {
+	lockSQL := `SELECT ` + auctionColumns + ` FROM ` + auctionTables + ` WHERE a.id = $1 FOR UPDATE OF a`
+
+	auction, err := scanAuction(querier.QueryRow(ctx, lockSQL, auctionID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Auction{}, &domain.AuctionNotFoundError{Msg: fmt.Sprintf("auction %d not found", auctionID)}
+		}
+
+		return domain.Auction{}, fmt.Errorf("failed to lock auction: %w", err)
+	}
+
+	return auction, nil
+}
+
+// PlaceBid moves amount coins of the bidder into escrow under a new held bid.
+func (ar *AuctionsRepository) PlaceBid(ctx context.Context, executor database.Executor, auctionID, bidderID int, amount uint32) error {
+	updateBalanceSQL := `UPDATE balances SET balance = balance - $1 WHERE user_id = $2 AND balance >= $1`
+	tag, err := executor.Exec(ctx, updateBalanceSQL, amount, bidderID)
+	if err != nil {
+		return fmt.Errorf("failed to update balance for user: %w", err)
+	} else if tag.RowsAffected() == 0 {
+		return &domain.InsufficientBalanceError{}
+	}
+
+	insertSQL := `INSERT INTO auction_bids (auction_id, user_id, amount) VALUES ($1, $2, $3)`
+	_, err = executor.Exec(ctx, insertSQL, auctionID, bidderID, amount)
+	if err != nil {
+		return fmt.Errorf("failed to insert bid: %w", err)
+	}
+
+	return nil
+}
+
+// RefundBid releases a held bid and gives its coins back to the bidder.
+func (ar *AuctionsRepository) RefundBid(ctx context.Context, executor database.Executor, bid domain.AuctionBid) error {
+	updateBidSQL := `UPDATE auction_bids SET status = $2 WHERE id = $1 AND status = $3`
+	tag, err := executor.Exec(ctx, updateBidSQL, bid.Id, domain.BidStatusRefunded, domain.BidStatusHeld)
+	if err != nil {
+		return fmt.Errorf("failed to update bid: %w", err)
+	} else if tag.RowsAffected() == 0 {
+		return fmt.Errorf("bid %d is not held", bid.Id)
+	}
+
+	updateBalanceSQL := `UPDATE balances SET balance = balance + $1 WHERE user_id = $2`
+	_, err = executor.Exec(ctx, updateBalanceSQL, bid.Amount, bid.BidderID)
+	if err != nil {
+		return fmt.Errorf("failed to update balance for user: %w", err)
+	}
+
+	return nil
+}
+
+// RefundUserBids locks the auctions first, the order bids and settlements lock them in before the balances.
+func (ar *AuctionsRepository) RefundUserBids(ctx context.Context, executor database.QueryExecuter, userID int) ([]domain.AuctionBid, error) {
+	lockSQL := `SELECT a.id FROM auctions a JOIN auction_bids b ON b.auction_id = a.id
+		WHERE b.user_id = $1 AND b.status = $2
+		ORDER BY a.id
+		FOR UPDATE OF a`
+
+	rows, err := executor.Query(ctx, lockSQL, userID, domain.BidStatusHeld)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock auctions: %w", err)
+	}
+	rows.Close()
+
+	updateBidsSQL := `UPDATE auction_bids SET status = $2 WHERE user_id = $1 AND status = $3 RETURNING id, user_id, amount`
+
+	rows, err = executor.Query(ctx, updateBidsSQL, userID, domain.BidStatusRefunded, domain.BidStatusHeld)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update bids: %w", err)
+	}
+	defer rows.Close()
+
+	bids := make([]domain.AuctionBid, 0)
+	var refunded int64
+	for rows.Next() {
+		var bid domain.AuctionBid
+		if err := rows.Scan(&bid.Id, &bid.BidderID, &bid.Amount); err != nil {
+			return nil, fmt.Errorf("failed to scan bid: %w", err)
+		}
+
+		bids = append(bids, bid)
+		refunded += int64(bid.Amount)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to update bids: %w", err)
+	}
+
+	if len(bids) == 0 {
+		return bids, nil
+	}
+
+	updateBalanceSQL := `UPDATE balances SET balance = balance + $1 WHERE user_id = $2`
+	_, err = executor.Exec(ctx, updateBalanceSQL, refunded, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update balance for user: %w", err)
+	}
+
+	return bids, nil
+}
+
+func (ar *AuctionsRepository) FetchDueAuctions(ctx context.Context, now time.Time, limit int) ([]int, error) {
+	dueSQL := `SELECT id FROM auctions
+		WHERE status = $1 AND ends_at <= $2
+		ORDER BY ends_at
+		LIMIT $3`
+
+	rows, err := ar.queryExecuter.Query(ctx, dueSQL, domain.AuctionStatusOpen, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch due auctions: %w", err)
+	}
+	defer rows.Close()
+
+	auctionIDs := make([]int, 0)
+	for rows.Next() {
+		var auctionID int
+		if err := rows.Scan(&auctionID); err != nil {
+			return nil, fmt.Errorf("failed to scan auction id: %w", err)
+		}
+
+		auctionIDs = append(auctionIDs, auctionID)
+	}
+
+	return auctionIDs, rows.Err()
+}
+
+// AwardAuction sells the item to the top bidder. The escrowed coins of the winning bid are spent on it.
+func (ar *AuctionsRepository) AwardAuction(ctx context.Context, executor database.Executor, auction domain.Auction) error {
+	updateBidSQL := `UPDATE auction_bids SET status = $2 WHERE id = $1`
+	_, err := executor.Exec(ctx, updateBidSQL, auction.TopBid.Id, domain.BidStatusWon)
+	if err != nil {
+		return fmt.Errorf("failed to update bid: %w", err)
+	}
+
+	insertPurchaseSQL := `INSERT INTO purchases (user_id, acquired_by, good_id) VALUES ($1, $1, $2)`
+	_, err = executor.Exec(ctx, insertPurchaseSQL, auction.TopBid.BidderID, auction.GoodID)
+	if err != nil {
+		return fmt.Errorf("failed to insert purchase: %w", err)
+	}
+
+	updateAuctionSQL := `UPDATE auctions SET status = $2, winner_id = $3, final_price = $4, settled_at = NOW() WHERE id = $1`
+	_, err = executor.Exec(ctx, updateAuctionSQL, auction.Id, domain.AuctionStatusSold, auction.TopBid.BidderID,
+		auction.TopBid.Amount)
+	if err != nil {
+		return fmt.Errorf("failed to update auction: %w", err)
+	}
+
+	return nil
+}
+
+func (ar *AuctionsRepository) CloseUnsoldAuction(ctx context.Context, executor database.Executor, auctionID int) error {
+	updateSQL := `UPDATE auctions SET status = $2, settled_at = NOW() WHERE id = $1`
+
+	tag, err := executor.Exec(ctx, updateSQL, auctionID, domain.AuctionStatusUnsold)
+	if err != nil {
+		return fmt.Errorf("failed to update auction: %w", err)
+	} else if tag.RowsAffected() == 0 {
+		return &domain.AuctionNotFoundError{Msg: fmt.Sprintf("auction %d not found", auctionID)}
+	}
+
+	return nil
+}
+
+func scanAuction(row pgx.Row) (domain.Auction, error) {
+	var auction domain.Auction
+
+	err := row.Scan(&auction.Id, &auction.GoodID, &auction.GoodName, &auction.ReservePrice, &auction.StartsAt,
+		&auction.EndsAt, &auction.Status, &auction.TopBid.Id, &auction.TopBid.BidderID, &auction.TopBid.Amount)
+
+	return auction, err
+}
@@ -0,0 +1,344 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var auctionColumnNames = []string{"id", "good_id", "name", "reserve_price", "starts_at", "ends_at", "status",
+	"bid_id", "bidder_id", "amount"}
+
+func TestAuctionsRepository_LockAndGetAuction(t *testing.T) {
+	t.Parallel()
+
+	startsAt := time.Date(2026, 5, 24, 9, 0, 0, 0, time.UTC)
+	endsAt := startsAt.Add(24 * time.Hour)
+
+	type testCase struct {
+		name      string
+		auctionID int
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+
+		expectedAuction domain.Auction
+		expectedErr     error
+	}
+
+	testCases := []testCase{
+		{
+			name:      "auction with a top bid",
+			auctionID: 4,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				rows := pgxmock.NewRows(auctionColumnNames).
+					AddRow(4, 10, "pink-hoody", uint32(300), startsAt, endsAt, domain.AuctionStatusOpen, 8, 2, uint32(350))
+				mock.ExpectQuery("SELECT a.id").
+					WithArgs(4).
+					WillReturnRows(rows)
+			},
+			expectedAuction: domain.Auction{Id: 4, GoodID: 10, GoodName: "pink-hoody", ReservePrice: 300,
+				StartsAt: startsAt, EndsAt: endsAt, Status: domain.AuctionStatusOpen,
+				TopBid: domain.AuctionBid{Id: 8, BidderID: 2, Amount: 350}},
+		},
+		{
+			name:      "auction without bids",
+			auctionID: 4,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				rows := pgxmock.NewRows(auctionColumnNames).
+					AddRow(4, 10, "pink-hoody", uint32(300), startsAt, endsAt, domain.AuctionStatusOpen, 0, 0, uint32(0))
+				mock.ExpectQuery("SELECT a.id").
+					WithArgs(4).
+					WillReturnRows(rows)
+			},
+			expectedAuction: domain.Auction{Id: 4, GoodID: 10, GoodName: "pink-hoody", ReservePrice: 300,
+				StartsAt: startsAt, EndsAt: endsAt, Status: domain.AuctionStatusOpen},
+		},
+		{
+			name:      "auction not found",
+			auctionID: 42,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("SELECT a.id").
+					WithArgs(42).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			expectedErr: &domain.AuctionNotFoundError{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			repo := NewAuctionsRepository(mock)
+			auction, err := repo.LockAndGetAuction(t.Context(), mock, tt.auctionID)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedAuction, auction)
+			}
+		})
+	}
+}
+
+func TestAuctionsRepository_PlaceBid(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name string
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name: "bid escrowed",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("UPDATE balances").
+					WithArgs(uint32(150), 1).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectExec("INSERT INTO auction_bids").
+					WithArgs(4, 1, uint32(150)).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+			},
+		},
+		{
+			name: "insufficient balance",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("UPDATE balances").
+					WithArgs(uint32(150), 1).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+			},
+			expectedErr: &domain.InsufficientBalanceError{},
+		},
+		{
+			name: "failed to insert bid",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("UPDATE balances").
+					WithArgs(uint32(150), 1).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectExec("INSERT INTO auction_bids").
+					WithArgs(4, 1, uint32(150)).
+					WillReturnError(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			repo := NewAuctionsRepository(mock)
+			err = repo.PlaceBid(t.Context(), mock, 4, 1, 150)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestAuctionsRepository_RefundBid(t *testing.T) {
+	t.Parallel()
+
+	bid := domain.AuctionBid{Id: 8, BidderID: 2, Amount: 100}
+
+	type testCase struct {
+		name string
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+
+		wantErr bool
+	}
+
+	testCases := []testCase{
+		{
+			name: "bid refunded",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("UPDATE auction_bids").
+					WithArgs(8, domain.BidStatusRefunded, domain.BidStatusHeld).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectExec("UPDATE balances").
+					WithArgs(uint32(100), 2).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+			},
+		},
+		{
+			name: "bid no longer held",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("UPDATE auction_bids").
+					WithArgs(8, domain.BidStatusRefunded, domain.BidStatusHeld).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			repo := NewAuctionsRepository(mock)
+			err = repo.RefundBid(t.Context(), mock, bid)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestAuctionsRepository_AwardAuction(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	auction := domain.Auction{Id: 4, GoodID: 10, TopBid: domain.AuctionBid{Id: 8, BidderID: 2, Amount: 350}}
+
+	mock.ExpectExec("UPDATE auction_bids").
+		WithArgs(8, domain.BidStatusWon).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	mock.ExpectExec("INSERT INTO purchases").
+		WithArgs(2, 10).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectExec("UPDATE auctions").
+		WithArgs(4, domain.AuctionStatusSold, 2, uint32(350)).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+	repo := NewAuctionsRepository(mock)
+	err = repo.AwardAuction(t.Context(), mock, auction)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAuctionsRepository_FetchDueAuctions(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	now := time.Date(2026, 5, 25, 9, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery("SELECT id FROM auctions").
+		WithArgs(domain.AuctionStatusOpen, now, 100).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(4).AddRow(5))
+
+	repo := NewAuctionsRepository(mock)
+	auctionIDs, err := repo.FetchDueAuctions(t.Context(), now, 100)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int{4, 5}, auctionIDs)
+}
+
+func TestAuctionsRepository_RefundUserBids(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name string
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+
+		expectedBids []domain.AuctionBid
+	}
+
+	testCases := []testCase{
+		{
+			name: "held bids refunded",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("SELECT a.id FROM auctions a").
+					WithArgs(2, domain.BidStatusHeld).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(3).AddRow(5))
+				mock.ExpectQuery("UPDATE auction_bids").
+					WithArgs(2, domain.BidStatusRefunded, domain.BidStatusHeld).
+					WillReturnRows(pgxmock.NewRows([]string{"id", "user_id", "amount"}).
+						AddRow(8, 2, uint32(100)).
+						AddRow(11, 2, uint32(40)))
+				mock.ExpectExec("UPDATE balances").
+					WithArgs(int64(140), 2).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+			},
+			expectedBids: []domain.AuctionBid{
+				{Id: 8, BidderID: 2, Amount: 100},
+				{Id: 11, BidderID: 2, Amount: 40},
+			},
+		},
+		{
+			name: "no held bids",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("SELECT a.id FROM auctions a").
+					WithArgs(2, domain.BidStatusHeld).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}))
+				mock.ExpectQuery("UPDATE auction_bids").
+					WithArgs(2, domain.BidStatusRefunded, domain.BidStatusHeld).
+					WillReturnRows(pgxmock.NewRows([]string{"id", "user_id", "amount"}))
+			},
+			expectedBids: []domain.AuctionBid{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			repo := NewAuctionsRepository(mock)
+			bids, err := repo.RefundUserBids(t.Context(), mock, 2)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedBids, bids)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -20,10 +20,16 @@ func NewBalancesRepository(executor database.Executor) *BalancesRepository {
 	}
 }
 
+// EnsureBalanceCreated creates the balance unless the user already has one. The balance and its BalanceCreated
+// event are inserted by a single statement, so the event is written only when the balance is created.
 func (br *BalancesRepository) EnsureBalanceCreated(ctx context.Context, userId int, startValue uint32) error {
-	sql := `INSERT INTO balances (user_id, balance) VALUES ($1, $2) ON CONFLICT (user_id) DO NOTHING`
+	sql := `WITH created AS (
+			INSERT INTO balances (user_id, balance) VALUES ($1, $2) ON CONFLICT (user_id) DO NOTHING RETURNING user_id
+		)
+		INSERT INTO outbox_events (event_type, user_ids, data) SELECT $3, $4, $5 FROM created`
 
-	_, err := br.executor.Exec(ctx, sql, userId, startValue)
+	event := domain.BalanceCreatedEvent(userId, startValue)
+	_, err := br.executor.Exec(ctx, sql, userId, startValue, event.Type, event.UserIDs, event.Data)
 	return err
 }
 
@@ -43,3 +49,85 @@ func (br *BalancesRepository) LockAndGetUserBalance(ctx context.Context, querier
 
 	return balance, nil
 }
+
+func (br *BalancesRepository) IsBalanceActive(ctx context.Context, querier database.Querier, userId int) (bool, error) {
+	statusSQL := `SELECT is_active FROM balances WHERE user_id = $1 FOR SHARE`
+
+	var isActive bool
+	err := querier.QueryRow(ctx, statusSQL, userId).Scan(&isActive)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, &domain.UserNotFoundError{Msg: fmt.Sprintf("user with id %d not found", userId)}
+		}
+
+		return false, fmt.Errorf("failed to get balance status: %w", err)
+	}
+
+	return isActive, nil
+}
+
+func (br *BalancesRepository) DeactivateBalance(ctx context.Context, executor database.Executor, userId int) error {
+	deactivateSQL := `UPDATE balances SET is_active = FALSE WHERE user_id = $1`
+
+	tag, err := executor.Exec(ctx, deactivateSQL, userId)
+	if err != nil {
+		return fmt.Errorf("failed to deactivate balance: %w", err)
+	} else if tag.RowsAffected() == 0 {
+		return &domain.UserNotFoundError{Msg: fmt.Sprintf("user with id %d not found", userId)}
+	}
+
+	return nil
+}
+
+func (br *BalancesRepository) IsBalanceFrozen(ctx context.Context, querier database.Querier, userId int) (bool, error) {
+	frozenSQL := `SELECT is_frozen FROM balances WHERE user_id = $1 FOR SHARE`
+
+	var isFrozen bool
+	err := querier.QueryRow(ctx, frozenSQL, userId).Scan(&isFrozen)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, &domain.UserNotFoundError{Msg: fmt.Sprintf("user with id %d not found", userId)}
+		}
+
+		return false, fmt.Errorf("failed to get balance freeze status: %w", err)
+	}
+
+	return isFrozen, nil
+}
+
+func (br *BalancesRepository) FreezeBalance(ctx context.Context, executor database.Executor, userId int) error {
+	freezeSQL := `UPDATE balances SET is_frozen = TRUE WHERE user_id = $1`
+
+	tag, err := executor.Exec(ctx, freezeSQL, userId)
+	if err != nil {
+		return fmt.Errorf("failed to freeze balance: %w", err)
+	} else if tag.RowsAffected() == 0 {
+		return &domain.UserNotFoundError{Msg: fmt.Sprintf("user with id %d not found", userId)}
+	}
+
+	return nil
+}
+
+func (br *BalancesRepository) UnfreezeBalance(ctx context.Context, executor database.Executor, userId int) error {
+	unfreezeSQL := `UPDATE balances SET is_frozen = FALSE WHERE user_id = $1`
+
+	tag, err := executor.Exec(ctx, unfreezeSQL, userId)
+	if err != nil {
+		return fmt.Errorf("failed to unfreeze balance: %w", err)
+	} else if tag.RowsAffected() == 0 {
+		return &domain.UserNotFoundError{Msg: fmt.Sprintf("user with id %d not found", userId)}
+	}
+
+	return nil
+}
+
+func (br *BalancesRepository) RecordFreezeChange(ctx context.Context, executor database.Executor, change domain.FreezeChange) error {
+	logSQL := `INSERT INTO balance_freeze_log (user_id, admin_id, frozen, reason) VALUES ($1, $2, $3, $4)`
+
+	_, err := executor.Exec(ctx, logSQL, change.UserID, change.AdminID, change.Frozen, change.Reason)
+	if err != nil {
+		return fmt.Errorf("failed to record freeze change: %w", err)
+	}
+
+	return nil
+}
@@ -13,6 +13,8 @@ import (
 func TestBalancesRepository_EnsureBalanceCreated(t *testing.T) {
 	t.Parallel()
 
+	event := domain.BalanceCreatedEvent(1, 1000)
+
 	type testCase struct {
 		name       string
 		userId     int
@@ -30,8 +32,8 @@ func TestBalancesRepository_EnsureBalanceCreated(t *testing.T) {
 			startValue: 1000,
 			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
 				t.Helper()
-				mock.ExpectExec("INSERT").
-					WithArgs(1, uint32(1000)).
+				mock.ExpectExec("INSERT INTO balances .+ INSERT INTO outbox_events").
+					WithArgs(1, uint32(1000), domain.EventBalanceCreated, event.UserIDs, event.Data).
 					WillReturnResult(pgxmock.NewResult("INSERT", 1))
 			},
 			expectedErr: nil,
@@ -42,8 +44,8 @@ func TestBalancesRepository_EnsureBalanceCreated(t *testing.T) {
 			startValue: 1000,
 			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
 				t.Helper()
-				mock.ExpectExec("INSERT").
-					WithArgs(1, uint32(1000)).
+				mock.ExpectExec("INSERT INTO balances .+ INSERT INTO outbox_events").
+					WithArgs(1, uint32(1000), domain.EventBalanceCreated, event.UserIDs, event.Data).
 					WillReturnResult(pgxmock.NewResult("INSERT", 0))
 			},
 			expectedErr: nil,
@@ -54,8 +56,8 @@ func TestBalancesRepository_EnsureBalanceCreated(t *testing.T) {
 			startValue: 1000,
 			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
 				t.Helper()
-				mock.ExpectExec("INSERT").
-					WithArgs(1, uint32(1000)).
+				mock.ExpectExec("INSERT INTO balances .+ INSERT INTO outbox_events").
+					WithArgs(1, uint32(1000), domain.EventBalanceCreated, event.UserIDs, event.Data).
 					WillReturnError(assert.AnError)
 			},
 			expectedErr: assert.AnError,
@@ -160,3 +162,293 @@ func TestBalancesRepository_LockAndGetUserBalance(t *testing.T) {
 		})
 	}
 }
+
+func TestBalancesRepository_IsBalanceActive(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name   string
+		userId int
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+
+		expectedActive bool
+		expectedErr    error
+	}
+
+	testCases := []testCase{
+		{
+			name:   "active balance",
+			userId: 1,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("SELECT is_active").
+					WithArgs(1).
+					WillReturnRows(pgxmock.NewRows([]string{"is_active"}).AddRow(true))
+			},
+			expectedActive: true,
+		},
+		{
+			name:   "deactivated balance",
+			userId: 2,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("SELECT is_active").
+					WithArgs(2).
+					WillReturnRows(pgxmock.NewRows([]string{"is_active"}).AddRow(false))
+			},
+			expectedActive: false,
+		},
+		{
+			name:   "user not found",
+			userId: 999,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("SELECT is_active").
+					WithArgs(999).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			expectedErr: &domain.UserNotFoundError{},
+		},
+		{
+			name:   "database error",
+			userId: 1,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("SELECT is_active").
+					WithArgs(1).
+					WillReturnError(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			repo := NewBalancesRepository(mock)
+			isActive, err := repo.IsBalanceActive(t.Context(), mock, tt.userId)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedActive, isActive)
+			}
+		})
+	}
+}
+
+func TestBalancesRepository_DeactivateBalance(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name   string
+		userId int
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name:   "balance deactivated",
+			userId: 1,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("UPDATE balances").
+					WithArgs(1).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+			},
+			expectedErr: nil,
+		},
+		{
+			name:   "user not found",
+			userId: 999,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("UPDATE balances").
+					WithArgs(999).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+			},
+			expectedErr: &domain.UserNotFoundError{},
+		},
+		{
+			name:   "database error",
+			userId: 1,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("UPDATE balances").
+					WithArgs(1).
+					WillReturnError(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			repo := NewBalancesRepository(mock)
+			err = repo.DeactivateBalance(t.Context(), mock, tt.userId)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBalancesRepository_FreezeBalance(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name   string
+		userId int
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name:   "balance frozen",
+			userId: 1,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("UPDATE balances").
+					WithArgs(1).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+			},
+		},
+		{
+			name:   "user not found",
+			userId: 999,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("UPDATE balances").
+					WithArgs(999).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+			},
+			expectedErr: &domain.UserNotFoundError{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			repo := NewBalancesRepository(mock)
+			err = repo.FreezeBalance(t.Context(), mock, tt.userId)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBalancesRepository_IsBalanceFrozen(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name   string
+		userId int
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+
+		expectedFrozen bool
+		expectedErr    error
+	}
+
+	testCases := []testCase{
+		{
+			name:   "frozen balance",
+			userId: 1,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("SELECT is_frozen").
+					WithArgs(1).
+					WillReturnRows(pgxmock.NewRows([]string{"is_frozen"}).AddRow(true))
+			},
+			expectedFrozen: true,
+		},
+		{
+			name:   "user not found",
+			userId: 999,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("SELECT is_frozen").
+					WithArgs(999).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			expectedErr: &domain.UserNotFoundError{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			repo := NewBalancesRepository(mock)
+			isFrozen, err := repo.IsBalanceFrozen(t.Context(), mock, tt.userId)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedFrozen, isFrozen)
+			}
+		})
+	}
+}
+
+func TestBalancesRepository_RecordFreezeChange(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	mock.ExpectExec("INSERT INTO balance_freeze_log").
+		WithArgs(2, 1, false, "investigation closed").
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+	repo := NewBalancesRepository(mock)
+	err = repo.RecordFreezeChange(t.Context(), mock, domain.FreezeChange{UserID: 2, AdminID: 1, Frozen: false, Reason: "investigation closed"})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
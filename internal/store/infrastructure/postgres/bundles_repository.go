@@ -0,0 +1,65 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+type BundlesRepository struct {
+	querier database.Querier
+}
+
+func NewBundlesRepository(querier database.Querier) *BundlesRepository {
+	return &BundlesRepository{
+		querier: querier,
+	}
+}
+
+func (br *BundlesRepository) ListBundleComponents(ctx context.Context, bundleID int) ([]domain.BundleComponent, error) {
+	listSQL := `SELECT bc.bundle_id, bc.good_id, g.name, bc.quantity FROM bundle_components bc
+		JOIN goods g ON g.id = bc.good_id
+		WHERE bc.bundle_id = $1
+		ORDER BY g.name`
+
+	rows, err := br.querier.Query(ctx, listSQL, bundleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list bundle components: %w", err)
+	}
+
+	return scanBundleComponents(rows)
+}
+
+// ListComponents returns the components of all bundles, grouped by bundle.
+func (br *BundlesRepository) ListComponents(ctx context.Context) ([]domain.BundleComponent, error) {
+	listSQL := `SELECT bc.bundle_id, bc.good_id, g.name, bc.quantity FROM bundle_components bc
+		JOIN goods g ON g.id = bc.good_id
+		ORDER BY bc.bundle_id, g.name`
+
+	rows, err := br.querier.Query(ctx, listSQL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list bundle components: %w", err)
+	}
+
+	return scanBundleComponents(rows)
+}
+
+func scanBundleComponents(rows pgx.Rows) ([]domain.BundleComponent, error) {
+	defer rows.Close()
+
+	components := make([]domain.BundleComponent, 0)
+	for rows.Next() {
+		var component domain.BundleComponent
+		err := rows.Scan(&component.BundleID, &component.GoodID, &component.GoodName, &component.Quantity)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan bundle component: %w", err)
+		}
+
+		components = append(components, component)
+	}
+
+	return components, rows.Err()
+}
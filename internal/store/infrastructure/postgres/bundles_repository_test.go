@@ -0,0 +1,34 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBundlesRepository_ListBundleComponents(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	rows := pgxmock.NewRows([]string{"bundle_id", "good_id", "name", "quantity"}).
+		AddRow(11, 2, "cup", uint32(1)).
+		AddRow(11, 5, "pen", uint32(2))
+	mock.ExpectQuery("SELECT bc.bundle_id, bc.good_id, g.name, bc.quantity FROM bundle_components").
+		WithArgs(11).
+		WillReturnRows(rows)
+
+	repo := NewBundlesRepository(mock)
+	components, err := repo.ListBundleComponents(t.Context(), 11)
+
+	require.NoError(t, err)
+	assert.Equal(t, []domain.BundleComponent{
+		{BundleID: 11, GoodID: 2, GoodName: "cup", Quantity: 1},
+		{BundleID: 11, GoodID: 5, GoodName: "pen", Quantity: 2},
+	}, components)
+}
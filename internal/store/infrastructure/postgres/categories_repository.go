@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+type CategoriesRepository struct {
+	queryExecuter database.QueryExecuter
+}
+
+func NewCategoriesRepository(queryExecuter database.QueryExecuter) *CategoriesRepository {
+	return &CategoriesRepository{
+		queryExecuter: queryExecuter,
+	}
+}
+
+func (cr *CategoriesRepository) CreateCategory(ctx context.Context, querier database.Querier, category domain.Category) (int, error) {
+	insertSQL := `INSERT INTO categories (slug, name) VALUES ($1, $2)
+		ON CONFLICT (slug) DO NOTHING RETURNING id`
+
+	var categoryID int
+	err := querier.QueryRow(ctx, insertSQL, category.Slug, category.Name).Scan(&categoryID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, &domain.CategoryExistingError{Msg: fmt.Sprintf("category %s already exists", category.Slug)}
+		}
+
+		return 0, fmt.Errorf("failed to create category: %w", err)
+	}
+
+	return categoryID, nil
+}
+
+func (cr *CategoriesRepository) GetCategory(ctx context.Context, slug string) (domain.Category, error) {
+	selectSQL := `SELECT id, slug, name FROM categories WHERE slug = $1`
+
+	var category domain.Category
+	err := cr.queryExecuter.QueryRow(ctx, selectSQL, slug).Scan(&category.Id, &category.Slug, &category.Name)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Category{}, &domain.CategoryNotFoundError{Msg: fmt.Sprintf("category %s not found", slug)}
+		}
+
+		return domain.Category{}, fmt.Errorf("failed to get category: %w", err)
+	}
+
+	return category, nil
+}
+
+func (cr *CategoriesRepository) ListCategories(ctx context.Context) ([]domain.Category, error) {
+	listSQL := `SELECT id, slug, name FROM categories ORDER BY name`
+
+	rows, err := cr.queryExecuter.Query(ctx, listSQL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+	defer rows.Close()
+
+	categories := make([]domain.Category, 0)
+	for rows.Next() {
+		var category domain.Category
+		if err := rows.Scan(&category.Id, &category.Slug, &category.Name); err != nil {
+			return nil, fmt.Errorf("failed to scan category: %w", err)
+		}
+
+		categories = append(categories, category)
+	}
+
+	return categories, rows.Err()
+}
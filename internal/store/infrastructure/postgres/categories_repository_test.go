@@ -0,0 +1,109 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCategoriesRepository_CreateCategory(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name string
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+
+		expectedID  int
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name: "new category",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("INSERT INTO categories").
+					WithArgs("home-office", "Home office").
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(5))
+			},
+			expectedID: 5,
+		},
+		{
+			name: "existing slug",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("INSERT INTO categories").
+					WithArgs("home-office", "Home office").
+					WillReturnError(pgx.ErrNoRows)
+			},
+			expectedErr: &domain.CategoryExistingError{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			repo := NewCategoriesRepository(mock)
+			categoryID, err := repo.CreateCategory(t.Context(), mock, domain.Category{Slug: "home-office", Name: "Home office"})
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedID, categoryID)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCategoriesRepository_GetCategory(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	mock.ExpectQuery("SELECT id, slug, name FROM categories").
+		WithArgs("toys").
+		WillReturnError(pgx.ErrNoRows)
+
+	repo := NewCategoriesRepository(mock)
+	_, err = repo.GetCategory(t.Context(), "toys")
+
+	assert.ErrorIs(t, err, &domain.CategoryNotFoundError{})
+}
+
+func TestCategoriesRepository_ListCategories(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	rows := pgxmock.NewRows([]string{"id", "slug", "name"}).
+		AddRow(4, "accessories", "Accessories").
+		AddRow(1, "apparel", "Apparel")
+	mock.ExpectQuery("SELECT id, slug, name FROM categories").WillReturnRows(rows)
+
+	repo := NewCategoriesRepository(mock)
+	categories, err := repo.ListCategories(t.Context())
+
+	require.NoError(t, err)
+	assert.Equal(t, []domain.Category{
+		{Id: 4, Slug: "accessories", Name: "Accessories"},
+		{Id: 1, Slug: "apparel", Name: "Apparel"},
+	}, categories)
+}
@@ -0,0 +1,39 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+)
+
+type CompanyPoolRepository struct{}
+
+func NewCompanyPoolRepository() *CompanyPoolRepository {
+	return &CompanyPoolRepository{}
+}
+
+func (cp *CompanyPoolRepository) TransferToPool(ctx context.Context, executor database.Executor, fromUserID int, amount uint32, reason string) error {
+	updateBalanceSQL := `UPDATE balances SET balance = balance - $1 WHERE user_id = $2 AND balance >= $1`
+	tag, err := executor.Exec(ctx, updateBalanceSQL, amount, fromUserID)
+	if err != nil {
+		return fmt.Errorf("failed to update balance for user: %w", err)
+	} else if tag.RowsAffected() == 0 {
+		return &domain.InsufficientBalanceError{}
+	}
+
+	updatePoolSQL := `UPDATE company_pool SET balance = balance + $1 WHERE id = 1`
+	_, err = executor.Exec(ctx, updatePoolSQL, amount)
+	if err != nil {
+		return fmt.Errorf("failed to update company pool: %w", err)
+	}
+
+	insertLedgerSQL := `INSERT INTO pool_ledger (user_id, amount, reason) VALUES ($1, $2, $3)`
+	_, err = executor.Exec(ctx, insertLedgerSQL, fromUserID, amount, reason)
+	if err != nil {
+		return fmt.Errorf("failed to insert pool ledger record: %w", err)
+	}
+
+	return nil
+}
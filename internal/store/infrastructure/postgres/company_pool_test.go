@@ -0,0 +1,117 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompanyPoolRepository_TransferToPool(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name       string
+		fromUserID int
+		amount     uint32
+		reason     string
+
+		expectedErr error
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+	}
+
+	tests := []testCase{
+		{
+			name:       "successful transfer to pool",
+			fromUserID: 1,
+			amount:     300,
+			reason:     "deactivation sweep",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("UPDATE balances").
+					WithArgs(uint32(300), 1).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectExec("UPDATE company_pool").
+					WithArgs(uint32(300)).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectExec("INSERT INTO pool_ledger").
+					WithArgs(1, uint32(300), "deactivation sweep").
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+			},
+			expectedErr: nil,
+		},
+		{
+			name:       "insufficient balance",
+			fromUserID: 1,
+			amount:     300,
+			reason:     "deactivation sweep",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("UPDATE balances").
+					WithArgs(uint32(300), 1).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+			},
+			expectedErr: &domain.InsufficientBalanceError{},
+		},
+		{
+			name:       "failed to update pool",
+			fromUserID: 1,
+			amount:     300,
+			reason:     "deactivation sweep",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("UPDATE balances").
+					WithArgs(uint32(300), 1).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectExec("UPDATE company_pool").
+					WithArgs(uint32(300)).
+					WillReturnError(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+		{
+			name:       "failed to insert ledger record",
+			fromUserID: 1,
+			amount:     300,
+			reason:     "deactivation sweep",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("UPDATE balances").
+					WithArgs(uint32(300), 1).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectExec("UPDATE company_pool").
+					WithArgs(uint32(300)).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectExec("INSERT INTO pool_ledger").
+					WithArgs(1, uint32(300), "deactivation sweep").
+					WillReturnError(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			pool := NewCompanyPoolRepository()
+			err = pool.TransferToPool(t.Context(), mock, tt.fromUserID, tt.amount, tt.reason)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
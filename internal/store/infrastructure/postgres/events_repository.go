@@ -0,0 +1,153 @@
+package postgres
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+const domainEventColumns = `event_offset, event_id, event_type, user_ids, data, occurred_at`
+
+type EventsRepository struct {
+	queryExecuter database.QueryExecuter
+}
+
+func NewEventsRepository(queryExecuter database.QueryExecuter) *EventsRepository {
+	return &EventsRepository{
+		queryExecuter: queryExecuter,
+	}
+}
+
+func (er *EventsRepository) AppendEvent(ctx context.Context, executor database.Executor, event domain.DomainEvent) error {
+	insertSQL := `INSERT INTO outbox_events (event_type, user_ids, data) VALUES ($1, $2, $3)`
+
+	_, err := executor.Exec(ctx, insertSQL, event.Type, event.UserIDs, event.Data)
+	if err != nil {
+		return fmt.Errorf("failed to append %s event: %w", event.Type, err)
+	}
+
+	return nil
+}
+
+// TryLockRelay takes a transaction level advisory lock, released on commit or rollback.
+func (er *EventsRepository) TryLockRelay(ctx context.Context, querier database.Querier) (bool, error) {
+	lockSQL := `SELECT pg_try_advisory_xact_lock(hashtext('merch-store.event-relay'))`
+
+	var locked bool
+	err := querier.QueryRow(ctx, lockSQL).Scan(&locked)
+	if err != nil {
+		return false, fmt.Errorf("failed to lock event relay: %w", err)
+	}
+
+	return locked, nil
+}
+
+// MoveOutboxEvents deletes the oldest outbox events and inserts them into the log with a single statement. The
+// offsets are assigned in the order of the outbox ids.
+func (er *EventsRepository) MoveOutboxEvents(ctx context.Context, querier database.Querier, limit int) ([]domain.DomainEvent, error) {
+	moveSQL := `WITH moved AS (
+			DELETE FROM outbox_events
+			WHERE id IN (SELECT id FROM outbox_events ORDER BY id LIMIT $1)
+			RETURNING id, event_type, user_ids, data, created_at
+		)
+		INSERT INTO domain_events (event_id, event_type, user_ids, data, occurred_at)
+		SELECT id, event_type, user_ids, data, created_at FROM moved ORDER BY id
+		RETURNING ` + domainEventColumns
+
+	rows, err := querier.Query(ctx, moveSQL, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to move outbox events: %w", err)
+	}
+
+	events, err := scanDomainEvents(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(events, func(a, b domain.DomainEvent) int {
+		return cmp.Compare(a.Offset, b.Offset)
+	})
+
+	return events, nil
+}
+
+func (er *EventsRepository) FetchEvents(ctx context.Context, afterOffset int64, limit int) ([]domain.DomainEvent, error) {
+	fetchSQL := `SELECT ` + domainEventColumns + `
+		FROM domain_events
+		WHERE event_offset > $1
+		ORDER BY event_offset
+		LIMIT $2`
+
+	rows, err := er.queryExecuter.Query(ctx, fetchSQL, afterOffset, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch events: %w", err)
+	}
+
+	return scanDomainEvents(rows)
+}
+
+// GetLastEventOffset returns the offset of the latest relayed event, 0 if none was relayed yet.
+func (er *EventsRepository) GetLastEventOffset(ctx context.Context) (int64, error) {
+	lastSQL := `SELECT COALESCE(MAX(event_offset), 0) FROM domain_events`
+
+	var offset int64
+	err := er.queryExecuter.QueryRow(ctx, lastSQL).Scan(&offset)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get last event offset: %w", err)
+	}
+
+	return offset, nil
+}
+
+// GetConsumerOffset returns 0 for consumers that haven't committed an offset yet.
+func (er *EventsRepository) GetConsumerOffset(ctx context.Context, consumer string) (int64, error) {
+	offsetSQL := `SELECT committed_offset FROM event_consumers WHERE name = $1`
+
+	var offset int64
+	err := er.queryExecuter.QueryRow(ctx, offsetSQL, consumer).Scan(&offset)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, nil
+		}
+
+		return 0, fmt.Errorf("failed to get offset of consumer %s: %w", consumer, err)
+	}
+
+	return offset, nil
+}
+
+func (er *EventsRepository) CommitConsumerOffset(ctx context.Context, consumer string, offset int64) error {
+	upsertSQL := `INSERT INTO event_consumers (name, committed_offset) VALUES ($1, $2)
+		ON CONFLICT (name) DO UPDATE SET committed_offset = EXCLUDED.committed_offset, updated_at = now()`
+
+	_, err := er.queryExecuter.Exec(ctx, upsertSQL, consumer, offset)
+	if err != nil {
+		return fmt.Errorf("failed to commit offset of consumer %s: %w", consumer, err)
+	}
+
+	return nil
+}
+
+func scanDomainEvents(rows pgx.Rows) ([]domain.DomainEvent, error) {
+	defer rows.Close()
+
+	events := make([]domain.DomainEvent, 0)
+	for rows.Next() {
+		var event domain.DomainEvent
+
+		err := rows.Scan(&event.Offset, &event.Id, &event.Type, &event.UserIDs, &event.Data, &event.OccurredAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan event: %w", err)
+		}
+
+		events = append(events, event)
+	}
+
+	return events, rows.Err()
+}
@@ -0,0 +1,153 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventsRepository_AppendEvent(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	event := domain.CoinsSentEvent(1, 2, 100)
+	mock.ExpectExec("INSERT INTO outbox_events \\(event_type, user_ids, data\\)").
+		WithArgs(domain.EventCoinsSent, []int{1, 2}, event.Data).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+	repo := NewEventsRepository(mock)
+	err = repo.AppendEvent(t.Context(), mock, event)
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEventsRepository_MoveOutboxEvents(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	occurredAt := time.Date(2026, 8, 10, 10, 0, 0, 0, time.UTC)
+	data := map[string]any{"userId": float64(1)}
+
+	rows := pgxmock.NewRows([]string{"event_offset", "event_id", "event_type", "user_ids", "data", "occurred_at"}).
+		AddRow(int64(12), int64(40), domain.EventItemPurchased, []int{1}, data, occurredAt).
+		AddRow(int64(11), int64(38), domain.EventBalanceCreated, []int{1}, data, occurredAt)
+	mock.ExpectQuery("DELETE FROM outbox_events.+INSERT INTO domain_events").
+		WithArgs(domain.EventRelayBatch).
+		WillReturnRows(rows)
+
+	repo := NewEventsRepository(mock)
+	events, err := repo.MoveOutboxEvents(t.Context(), mock, domain.EventRelayBatch)
+
+	require.NoError(t, err)
+	assert.Equal(t, []domain.DomainEvent{
+		{Id: 38, Offset: 11, Type: domain.EventBalanceCreated, UserIDs: []int{1}, Data: data, OccurredAt: occurredAt},
+		{Id: 40, Offset: 12, Type: domain.EventItemPurchased, UserIDs: []int{1}, Data: data, OccurredAt: occurredAt},
+	}, events)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEventsRepository_GetConsumerOffset(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name string
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+
+		expectedOffset int64
+		expectedErr    bool
+	}
+
+	testCases := []testCase{
+		{
+			name: "committed offset",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("SELECT committed_offset FROM event_consumers").
+					WithArgs("warehouse").
+					WillReturnRows(pgxmock.NewRows([]string{"committed_offset"}).AddRow(int64(42)))
+			},
+			expectedOffset: 42,
+		},
+		{
+			name: "new consumer starts from the beginning",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("SELECT committed_offset FROM event_consumers").
+					WithArgs("warehouse").
+					WillReturnError(pgx.ErrNoRows)
+			},
+		},
+		{
+			name: "database error",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("SELECT committed_offset FROM event_consumers").
+					WithArgs("warehouse").
+					WillReturnError(assert.AnError)
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			repo := NewEventsRepository(mock)
+			offset, err := repo.GetConsumerOffset(t.Context(), "warehouse")
+
+			if tt.expectedErr {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedOffset, offset)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestEventsRepository_FetchEvents(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	occurredAt := time.Date(2026, 8, 10, 10, 0, 0, 0, time.UTC)
+	data := map[string]any{"fromUserId": float64(1), "toUserId": float64(2), "amount": float64(100)}
+
+	rows := pgxmock.NewRows([]string{"event_offset", "event_id", "event_type", "user_ids", "data", "occurred_at"}).
+		AddRow(int64(12), int64(40), domain.EventCoinsSent, []int{1, 2}, data, occurredAt)
+	mock.ExpectQuery("FROM domain_events\\s+WHERE event_offset > \\$1").
+		WithArgs(int64(11), domain.EventRelayBatch).
+		WillReturnRows(rows)
+
+	repo := NewEventsRepository(mock)
+	events, err := repo.FetchEvents(t.Context(), 11, domain.EventRelayBatch)
+
+	require.NoError(t, err)
+	assert.Equal(t, []domain.DomainEvent{
+		{Id: 40, Offset: 12, Type: domain.EventCoinsSent, UserIDs: []int{1, 2}, Data: data, OccurredAt: occurredAt},
+	}, events)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,211 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+const fraudCasesListLimit = 100
+
+type FraudRepository struct {
+	queryExecuter database.QueryExecuter
+}
+
+func NewFraudRepository(queryExecuter database.QueryExecuter) *FraudRepository {
+	return &FraudRepository{
+		queryExecuter: queryExecuter,
+	}
+}
+
+// DetectCircularTransfers finds pairs and triangles of users that sent coins around in a loop.
+func (fr *FraudRepository) DetectCircularTransfers(ctx context.Context, since time.Time, minTransfers int) ([]domain.SuspiciousActivity, error) {
+	circularSQL := `WITH edges AS (
+			SELECT from_user_id AS a, to_user_id AS b, COUNT(*) AS cnt
+			FROM transactions
+			WHERE created_at > $1 AND from_user_id IS NOT NULL AND to_user_id IS NOT NULL AND team_id IS NULL
+			GROUP BY from_user_id, to_user_id
+		)
+		SELECT ARRAY[e1.a, e1.b], e1.cnt + e2.cnt
+		FROM edges e1 JOIN edges e2 ON e2.a = e1.b AND e2.b = e1.a
+		WHERE e1.a < e1.b AND e1.cnt + e2.cnt >= $2
+		UNION ALL
+		SELECT ARRAY[e1.a, e1.b, e2.b], e1.cnt + e2.cnt + e3.cnt
+		FROM edges e1
+			JOIN edges e2 ON e2.a = e1.b
+			JOIN edges e3 ON e3.a = e2.b AND e3.b = e1.a
+		WHERE e1.a < e1.b AND e1.a < e2.b AND e1.b <> e2.b AND e1.cnt + e2.cnt + e3.cnt >= $2`
+
+	rows, err := fr.queryExecuter.Query(ctx, circularSQL, since, minTransfers)
+	if err != nil {
+		return nil, fmt.Errorf("failed to detect circular transfers: %w", err)
+	}
+	defer rows.Close()
+
+	var activities []domain.SuspiciousActivity
+	for rows.Next() {
+		var userIDs []int
+		var transfers int
+
+		if err := rows.Scan(&userIDs, &transfers); err != nil {
+			return nil, fmt.Errorf("failed to scan circular transfers: %w", err)
+		}
+
+		activities = append(activities, domain.SuspiciousActivity{
+			Pattern: domain.FraudPatternCircular,
+			UserIDs: userIDs,
+			Details: fmt.Sprintf("%d users passed coins around in a loop with %d transfers", len(userIDs), transfers),
+		})
+	}
+
+	return activities, rows.Err()
+}
+
+func (fr *FraudRepository) DetectTransferBursts(ctx context.Context, since time.Time, minTransfers int) ([]domain.SuspiciousActivity, error) {
+	burstSQL := `SELECT from_user_id, COUNT(*)
+		FROM transactions
+		WHERE created_at > $1 AND from_user_id IS NOT NULL AND team_id IS NULL
+		GROUP BY from_user_id
+		HAVING COUNT(*) >= $2`
+
+	rows, err := fr.queryExecuter.Query(ctx, burstSQL, since, minTransfers)
+	if err != nil {
+		return nil, fmt.Errorf("failed to detect transfer bursts: %w", err)
+	}
+	defer rows.Close()
+
+	var activities []domain.SuspiciousActivity
+	for rows.Next() {
+		var userID, transfers int
+
+		if err := rows.Scan(&userID, &transfers); err != nil {
+			return nil, fmt.Errorf("failed to scan transfer bursts: %w", err)
+		}
+
+		activities = append(activities, domain.SuspiciousActivity{
+			Pattern: domain.FraudPatternBurst,
+			UserIDs: []int{userID},
+			Details: fmt.Sprintf("user sent %d transfers since %s", transfers, since.UTC().Format(time.RFC3339)),
+		})
+	}
+
+	return activities, rows.Err()
+}
+
+// DetectNewAccountDrains finds users receiving coins from several freshly created accounts.
+// The recipient comes first in UserIDs.
+func (fr *FraudRepository) DetectNewAccountDrains(ctx context.Context, since, createdAfter time.Time, minSenders int) ([]domain.SuspiciousActivity, error) {
+	drainSQL := `SELECT t.to_user_id, array_agg(DISTINCT t.from_user_id), SUM(t.amount)
+		FROM transactions t
+			JOIN balances b ON b.user_id = t.from_user_id
+		WHERE t.created_at > $1 AND b.created_at > $2 AND t.team_id IS NULL
+		GROUP BY t.to_user_id
+		HAVING COUNT(DISTINCT t.from_user_id) >= $3`
+
+	rows, err := fr.queryExecuter.Query(ctx, drainSQL, since, createdAfter, minSenders)
+	if err != nil {
+		return nil, fmt.Errorf("failed to detect new account drains: %w", err)
+	}
+	defer rows.Close()
+
+	var activities []domain.SuspiciousActivity
+	for rows.Next() {
+		var recipientID, amount int
+		var senderIDs []int
+
+		if err := rows.Scan(&recipientID, &senderIDs, &amount); err != nil {
+			return nil, fmt.Errorf("failed to scan new account drains: %w", err)
+		}
+
+		activities = append(activities, domain.SuspiciousActivity{
+			Pattern: domain.FraudPatternDrain,
+			UserIDs: append([]int{recipientID}, senderIDs...),
+			Details: fmt.Sprintf("user received %d coins from %d new accounts", amount, len(senderIDs)),
+		})
+	}
+
+	return activities, rows.Err()
+}
+
+// SaveFraudCases stores new cases and skips the ones already flagged. It returns the number of stored cases.
+func (fr *FraudRepository) SaveFraudCases(ctx context.Context, activities []domain.SuspiciousActivity) (int, error) {
+	insertSQL := `INSERT INTO fraud_cases (pattern, user_ids, details, fingerprint) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (fingerprint) DO NOTHING`
+
+	saved := 0
+	for _, activity := range activities {
+		tag, err := fr.queryExecuter.Exec(ctx, insertSQL, activity.Pattern, activity.UserIDs, activity.Details, activity.Fingerprint())
+		if err != nil {
+			return saved, fmt.Errorf("failed to save fraud case: %w", err)
+		}
+
+		saved += int(tag.RowsAffected())
+	}
+
+	return saved, nil
+}
+
+func (fr *FraudRepository) ListFraudCases(ctx context.Context, status string) ([]domain.FraudCase, error) {
+	listSQL := `SELECT id, pattern, user_ids, details, status, COALESCE(resolution, ''), created_at
+		FROM fraud_cases
+		WHERE $1 = '' OR status = $1
+		ORDER BY created_at DESC
+		LIMIT $2`
+
+	rows, err := fr.queryExecuter.Query(ctx, listSQL, status, fraudCasesListLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list fraud cases: %w", err)
+	}
+	defer rows.Close()
+
+	cases := make([]domain.FraudCase, 0)
+	for rows.Next() {
+		var fraudCase domain.FraudCase
+
+		err := rows.Scan(&fraudCase.Id, &fraudCase.Pattern, &fraudCase.UserIDs, &fraudCase.Details,
+			&fraudCase.Status, &fraudCase.Resolution, &fraudCase.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan fraud case: %w", err)
+		}
+
+		cases = append(cases, fraudCase)
+	}
+
+	return cases, rows.Err()
+}
+
+func (fr *FraudRepository) LockAndGetFraudCase(ctx context.Context, querier database.Querier, caseID int) (domain.FraudCase, error) {
+	lockSQL := `SELECT id, pattern, user_ids, details, status, COALESCE(resolution, ''), created_at
+		FROM fraud_cases WHERE id = $1 FOR UPDATE`
+
+	var fraudCase domain.FraudCase
+	err := querier.QueryRow(ctx, lockSQL, caseID).Scan(&fraudCase.Id, &fraudCase.Pattern, &fraudCase.UserIDs,
+		&fraudCase.Details, &fraudCase.Status, &fraudCase.Resolution, &fraudCase.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.FraudCase{}, &domain.FraudCaseNotFoundError{Msg: fmt.Sprintf("fraud case %d not found", caseID)}
+		}
+
+		return domain.FraudCase{}, fmt.Errorf("failed to lock fraud case: %w", err)
+	}
+
+	return fraudCase, nil
+}
+
+func (fr *FraudRepository) CloseFraudCase(ctx context.Context, executor database.Executor, caseID int, status, resolution string) error {
+	closeSQL := `UPDATE fraud_cases SET status = $2, resolution = $3, resolved_at = now() WHERE id = $1`
+
+	tag, err := executor.Exec(ctx, closeSQL, caseID, status, resolution)
+	if err != nil {
+		return fmt.Errorf("failed to close fraud case: %w", err)
+	} else if tag.RowsAffected() == 0 {
+		return &domain.FraudCaseNotFoundError{Msg: fmt.Sprintf("fraud case %d not found", caseID)}
+	}
+
+	return nil
+}
@@ -0,0 +1,270 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFraudRepository_DetectTransferBursts(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	since := time.Date(2026, 3, 29, 12, 0, 0, 0, time.UTC)
+	mock.ExpectQuery("SELECT from_user_id(.|\n)*team_id IS NULL").
+		WithArgs(since, 15).
+		WillReturnRows(pgxmock.NewRows([]string{"from_user_id", "count"}).AddRow(7, 21))
+
+	repo := NewFraudRepository(mock)
+	activities, err := repo.DetectTransferBursts(t.Context(), since, 15)
+
+	require.NoError(t, err)
+	require.Len(t, activities, 1)
+	assert.Equal(t, domain.FraudPatternBurst, activities[0].Pattern)
+	assert.Equal(t, []int{7}, activities[0].UserIDs)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFraudRepository_DetectCircularTransfers(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	since := time.Date(2026, 3, 29, 12, 0, 0, 0, time.UTC)
+	mock.ExpectQuery("WITH edges AS(.|\n)*team_id IS NULL").
+		WithArgs(since, 6).
+		WillReturnRows(pgxmock.NewRows([]string{"user_ids", "transfers"}).AddRow([]int{3, 5}, 8))
+
+	repo := NewFraudRepository(mock)
+	activities, err := repo.DetectCircularTransfers(t.Context(), since, 6)
+
+	require.NoError(t, err)
+	require.Len(t, activities, 1)
+	assert.Equal(t, domain.FraudPatternCircular, activities[0].Pattern)
+	assert.Equal(t, []int{3, 5}, activities[0].UserIDs)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFraudRepository_DetectNewAccountDrains(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	since := time.Date(2026, 3, 29, 12, 0, 0, 0, time.UTC)
+	createdAfter := since.Add(-24 * time.Hour)
+	mock.ExpectQuery("SELECT t.to_user_id(.|\n)*t.team_id IS NULL").
+		WithArgs(since, createdAfter, 3).
+		WillReturnRows(pgxmock.NewRows([]string{"to_user_id", "senders", "sum"}).AddRow(9, []int{11, 12, 13}, 300))
+
+	repo := NewFraudRepository(mock)
+	activities, err := repo.DetectNewAccountDrains(t.Context(), since, createdAfter, 3)
+
+	require.NoError(t, err)
+	require.Len(t, activities, 1)
+	assert.Equal(t, domain.FraudPatternDrain, activities[0].Pattern)
+	assert.Equal(t, []int{9, 11, 12, 13}, activities[0].UserIDs)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFraudRepository_SaveFraudCases(t *testing.T) {
+	t.Parallel()
+
+	detectedAt := time.Date(2026, 3, 29, 12, 0, 0, 0, time.UTC)
+	activities := []domain.SuspiciousActivity{
+		{Pattern: domain.FraudPatternCircular, UserIDs: []int{1, 2}, Details: "loop", DetectedAt: detectedAt},
+		{Pattern: domain.FraudPatternBurst, UserIDs: []int{3}, Details: "burst", DetectedAt: detectedAt},
+	}
+
+	type testCase struct {
+		name string
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+
+		expectedSaved int
+		expectedErr   error
+	}
+
+	testCases := []testCase{
+		{
+			name: "new and already flagged cases",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("INSERT INTO fraud_cases").
+					WithArgs(domain.FraudPatternCircular, []int{1, 2}, "loop", activities[0].Fingerprint()).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				mock.ExpectExec("INSERT INTO fraud_cases").
+					WithArgs(domain.FraudPatternBurst, []int{3}, "burst", activities[1].Fingerprint()).
+					WillReturnResult(pgxmock.NewResult("INSERT", 0))
+			},
+			expectedSaved: 1,
+		},
+		{
+			name: "database error",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("INSERT INTO fraud_cases").
+					WithArgs(domain.FraudPatternCircular, []int{1, 2}, "loop", activities[0].Fingerprint()).
+					WillReturnError(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			repo := NewFraudRepository(mock)
+			saved, err := repo.SaveFraudCases(t.Context(), activities)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedSaved, saved)
+			}
+		})
+	}
+}
+
+func TestFraudRepository_LockAndGetFraudCase(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2026, 3, 29, 12, 0, 0, 0, time.UTC)
+
+	type testCase struct {
+		name   string
+		caseID int
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+
+		expectedCase domain.FraudCase
+		expectedErr  error
+	}
+
+	testCases := []testCase{
+		{
+			name:   "case found",
+			caseID: 1,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				rows := pgxmock.NewRows([]string{"id", "pattern", "user_ids", "details", "status", "resolution", "created_at"}).
+					AddRow(1, domain.FraudPatternCircular, []int{1, 2}, "loop", domain.FraudCaseStatusOpen, "", createdAt)
+				mock.ExpectQuery("SELECT id, pattern").
+					WithArgs(1).
+					WillReturnRows(rows)
+			},
+			expectedCase: domain.FraudCase{Id: 1, Pattern: domain.FraudPatternCircular, UserIDs: []int{1, 2},
+				Details: "loop", Status: domain.FraudCaseStatusOpen, CreatedAt: createdAt},
+		},
+		{
+			name:   "case not found",
+			caseID: 42,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("SELECT id, pattern").
+					WithArgs(42).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			expectedErr: &domain.FraudCaseNotFoundError{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			repo := NewFraudRepository(mock)
+			fraudCase, err := repo.LockAndGetFraudCase(t.Context(), mock, tt.caseID)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedCase, fraudCase)
+			}
+		})
+	}
+}
+
+func TestFraudRepository_CloseFraudCase(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name   string
+		caseID int
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name:   "case closed",
+			caseID: 1,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("UPDATE fraud_cases").
+					WithArgs(1, domain.FraudCaseStatusResolved, "false positive").
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+			},
+		},
+		{
+			name:   "case not found",
+			caseID: 42,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("UPDATE fraud_cases").
+					WithArgs(42, domain.FraudCaseStatusResolved, "false positive").
+					WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+			},
+			expectedErr: &domain.FraudCaseNotFoundError{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			repo := NewFraudRepository(mock)
+			err = repo.CloseFraudCase(t.Context(), mock, tt.caseID, domain.FraudCaseStatusResolved, "false positive")
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -4,27 +4,38 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/Lexv0lk/merch-store/internal/pkg/database"
 	"github.com/Lexv0lk/merch-store/internal/store/domain"
 	"github.com/jackc/pgx/v5"
 )
 
+// activePriceJoin joins the goods aliased as g with their active price schedule aliased as s, if any.
+// The effective price of a good is COALESCE(s.price, g.price).
+const activePriceJoin = `LEFT JOIN LATERAL (SELECT ps.price, ps.ends_at FROM price_schedules ps
+		WHERE ps.good_id = g.id AND ps.starts_at <= NOW() AND (ps.ends_at IS NULL OR ps.ends_at > NOW())
+		ORDER BY ps.starts_at DESC, ps.id DESC
+		LIMIT 1) s ON TRUE`
+
 type GoodsRepository struct {
-	querier database.Querier
+	queryExecuter database.QueryExecuter
 }
 
-func NewGoodsRepository(querier database.Querier) *GoodsRepository {
+func NewGoodsRepository(queryExecuter database.QueryExecuter) *GoodsRepository {
 	return &GoodsRepository{
-		querier: querier,
+		queryExecuter: queryExecuter,
 	}
 }
 
 func (gr *GoodsRepository) GetGoodInfo(ctx context.Context, name string) (domain.GoodInfo, error) {
-	findGoodSQL := `SELECT id, name, price FROM goods WHERE name = $1`
+	findGoodSQL := `SELECT g.id, g.name, COALESCE(s.price, g.price), g.price,
+		EXISTS (SELECT 1 FROM bundle_components bc WHERE bc.bundle_id = g.id), g.upcoming FROM goods g ` + activePriceJoin + `
+		WHERE g.name = $1`
 
 	var good domain.GoodInfo
-	err := gr.querier.QueryRow(ctx, findGoodSQL, name).Scan(&good.Id, &good.Name, &good.Price)
+	err := gr.queryExecuter.QueryRow(ctx, findGoodSQL, name).Scan(&good.Id, &good.Name, &good.Price, &good.BasePrice,
+		&good.Bundle, &good.Upcoming)
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -36,3 +47,82 @@ func (gr *GoodsRepository) GetGoodInfo(ctx context.Context, name string) (domain
 
 	return good, nil
 }
+
+// goodImage is the JSON form of a good image in goods.images.
+type goodImage struct {
+	URL     string `json:"url"`
+	AltText string `json:"altText"`
+	Width   uint32 `json:"width"`
+	Height  uint32 `json:"height"`
+}
+
+// ListGoods returns the catalog at effective prices, ordered by name. A non-empty category keeps only the goods
+// of the category with that slug.
+func (gr *GoodsRepository) ListGoods(ctx context.Context, category string) ([]domain.CatalogGood, error) {
+	listSQL := `SELECT g.id, g.name, COALESCE(s.price, g.price), g.price, s.ends_at, COALESCE(c.slug, ''),
+		g.description, g.images, g.upcoming FROM goods g
+		LEFT JOIN categories c ON g.category_id = c.id
+		` + activePriceJoin + `
+		WHERE $1 = '' OR c.slug = $1
+		ORDER BY g.name`
+
+	rows, err := gr.queryExecuter.Query(ctx, listSQL, category)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list goods: %w", err)
+	}
+	defer rows.Close()
+
+	goods := make([]domain.CatalogGood, 0)
+	for rows.Next() {
+		var good domain.CatalogGood
+		var saleEndsAt *time.Time
+		var images []goodImage
+		err := rows.Scan(&good.Id, &good.Name, &good.Price, &good.BasePrice, &saleEndsAt, &good.Category,
+			&good.Description, &images, &good.Upcoming)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan good: %w", err)
+		}
+
+		if saleEndsAt != nil {
+			good.SaleEndsAt = *saleEndsAt
+		}
+
+		good.Images = make([]domain.GoodImage, 0, len(images))
+		for _, image := range images {
+			good.Images = append(good.Images, domain.GoodImage(image))
+		}
+
+		goods = append(goods, good)
+	}
+
+	return goods, rows.Err()
+}
+
+func (gr *GoodsRepository) UpdateGoodDetails(ctx context.Context, executor database.Executor, goodID int,
+	details domain.GoodDetails) error {
+	images := make([]goodImage, 0, len(details.Images))
+	for _, image := range details.Images {
+		images = append(images, goodImage(image))
+	}
+
+	updateSQL := `UPDATE goods SET category_id = NULLIF($2, 0), description = $3, images = $4 WHERE id = $1`
+	_, err := executor.Exec(ctx, updateSQL, goodID, details.CategoryID, details.Description, images)
+	if err != nil {
+		return fmt.Errorf("failed to update good details: %w", err)
+	}
+
+	return nil
+}
+
+func (gr *GoodsRepository) SetGoodUpcoming(ctx context.Context, executor database.Executor, goodID int, upcoming bool) error {
+	updateSQL := `UPDATE goods SET upcoming = $2 WHERE id = $1`
+
+	tag, err := executor.Exec(ctx, updateSQL, goodID, upcoming)
+	if err != nil {
+		return fmt.Errorf("failed to update good availability: %w", err)
+	} else if tag.RowsAffected() == 0 {
+		return &domain.GoodNotFoundError{Msg: fmt.Sprintf("good with id %d not found", goodID)}
+	}
+
+	return nil
+}
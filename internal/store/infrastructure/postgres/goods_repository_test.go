@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"testing"
+	"time"
 
 	"github.com/Lexv0lk/merch-store/internal/store/domain"
 	"github.com/jackc/pgx/v5"
@@ -29,13 +30,41 @@ func TestGoodsRepository_GetGoodInfo(t *testing.T) {
 			goodName: "cup",
 			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
 				t.Helper()
-				rows := pgxmock.NewRows([]string{"id", "name", "price"}).
-					AddRow(10, "cup", 20)
+				rows := pgxmock.NewRows([]string{"id", "name", "price", "base_price", "bundle", "upcoming"}).
+					AddRow(10, "cup", 20, 20, false, false)
 				mock.ExpectQuery("SELECT").
 					WithArgs("cup").
 					WillReturnRows(rows)
 			},
-			expectedRes: domain.GoodInfo{Id: 10, Name: "cup", Price: 20},
+			expectedRes: domain.GoodInfo{Id: 10, Name: "cup", Price: 20, BasePrice: 20},
+			expectedErr: nil,
+		},
+		{
+			name:     "good on sale",
+			goodName: "cup",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				rows := pgxmock.NewRows([]string{"id", "name", "price", "base_price", "bundle", "upcoming"}).
+					AddRow(10, "cup", 14, 20, false, false)
+				mock.ExpectQuery("LEFT JOIN LATERAL").
+					WithArgs("cup").
+					WillReturnRows(rows)
+			},
+			expectedRes: domain.GoodInfo{Id: 10, Name: "cup", Price: 14, BasePrice: 20},
+			expectedErr: nil,
+		},
+		{
+			name:     "upcoming good",
+			goodName: "umbrella",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				rows := pgxmock.NewRows([]string{"id", "name", "price", "base_price", "bundle", "upcoming"}).
+					AddRow(12, "umbrella", 200, 200, false, true)
+				mock.ExpectQuery("SELECT").
+					WithArgs("umbrella").
+					WillReturnRows(rows)
+			},
+			expectedRes: domain.GoodInfo{Id: 12, Name: "umbrella", Price: 200, BasePrice: 200, Upcoming: true},
 			expectedErr: nil,
 		},
 		{
@@ -87,3 +116,111 @@ func TestGoodsRepository_GetGoodInfo(t *testing.T) {
 		})
 	}
 }
+
+func TestGoodsRepository_ListGoods(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	saleEndsAt := time.Date(2026, 12, 31, 23, 59, 0, 0, time.UTC)
+	front := goodImage{URL: "https://cdn.example.com/hoody-front.png", AltText: "Hoody, front", Width: 800, Height: 800}
+	rows := pgxmock.NewRows([]string{"id", "name", "price", "base_price", "ends_at", "slug", "description", "images", "upcoming"}).
+		AddRow(2, "cup", uint32(20), uint32(20), nil, "", "", []goodImage{}, false).
+		AddRow(6, "hoody", uint32(210), uint32(300), &saleEndsAt, "apparel", "Warm hoody", []goodImage{front}, false).
+		AddRow(12, "umbrella", uint32(200), uint32(200), nil, "", "", []goodImage{}, true)
+	mock.ExpectQuery("SELECT g.id, g.name").WithArgs("").WillReturnRows(rows)
+
+	repo := NewGoodsRepository(mock)
+	goods, err := repo.ListGoods(t.Context(), "")
+
+	require.NoError(t, err)
+	assert.Equal(t, []domain.CatalogGood{
+		{Id: 2, Name: "cup", Price: 20, BasePrice: 20, Images: []domain.GoodImage{}},
+		{
+			Id: 6, Name: "hoody", Price: 210, BasePrice: 300, SaleEndsAt: saleEndsAt,
+			Category: "apparel", Description: "Warm hoody", Images: []domain.GoodImage{domain.GoodImage(front)},
+		},
+		{Id: 12, Name: "umbrella", Price: 200, BasePrice: 200, Images: []domain.GoodImage{}, Upcoming: true},
+	}, goods)
+}
+
+func TestGoodsRepository_UpdateGoodDetails(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	front := domain.GoodImage{URL: "https://cdn.example.com/hoody-front.png", AltText: "Hoody, front", Width: 800, Height: 800}
+	mock.ExpectExec("UPDATE goods SET category_id").
+		WithArgs(7, 1, "Warm hoody", []goodImage{goodImage(front)}).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+	repo := NewGoodsRepository(mock)
+	err = repo.UpdateGoodDetails(t.Context(), mock, 7, domain.GoodDetails{
+		CategoryID:  1,
+		Description: "Warm hoody",
+		Images:      []domain.GoodImage{front},
+	})
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGoodsRepository_SetGoodUpcoming(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name        string
+		expectedErr error
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+	}
+
+	tests := []testCase{
+		{
+			name: "good updated",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("UPDATE goods SET upcoming").
+					WithArgs(12, true).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+			},
+		},
+		{
+			name: "good not found",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("UPDATE goods SET upcoming").
+					WithArgs(12, true).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+			},
+			expectedErr: &domain.GoodNotFoundError{},
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			repo := NewGoodsRepository(mock)
+			err = repo.SetGoodUpcoming(t.Context(), mock, 12, true)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+)
+
+type InventoryRepository struct{}
+
+func NewInventoryRepository() *InventoryRepository {
+	return &InventoryRepository{}
+}
+
+// CountOwnedItems counts the units of the good in the given variant the user owns, a zero variantID counting
+// the units without a variant.
+func (ir *InventoryRepository) CountOwnedItems(ctx context.Context, querier database.Querier, userID, goodID, variantID int) (int, error) {
+	var variant *int
+	if variantID != 0 {
+		variant = &variantID
+	}
+
+	countSQL := `SELECT COUNT(*) FROM purchases WHERE user_id = $1 AND good_id = $2 AND variant_id IS NOT DISTINCT FROM $3`
+
+	var count int
+	err := querier.QueryRow(ctx, countSQL, userID, goodID, variant).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count owned items: %w", err)
+	}
+
+	return count, nil
+}
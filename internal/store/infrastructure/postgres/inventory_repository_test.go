@@ -0,0 +1,89 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInventoryRepository_CountOwnedItems(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name      string
+		userID    int
+		goodID    int
+		variantID int
+
+		expectedCount int
+		expectedErr   error
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+	}
+
+	tests := []testCase{
+		{
+			name:   "owned items counted",
+			userID: 1,
+			goodID: 10,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("SELECT COUNT").
+					WithArgs(1, 10, (*int)(nil)).
+					WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(3))
+			},
+			expectedCount: 3,
+		},
+		{
+			name:      "owned variant counted",
+			userID:    1,
+			goodID:    12,
+			variantID: 4,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				variantID := 4
+				mock.ExpectQuery("SELECT COUNT").
+					WithArgs(1, 12, &variantID).
+					WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(1))
+			},
+			expectedCount: 1,
+		},
+		{
+			name:   "failed to count items",
+			userID: 1,
+			goodID: 10,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("SELECT COUNT").
+					WithArgs(1, 10, (*int)(nil)).
+					WillReturnError(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			inventoryRepository := NewInventoryRepository()
+			count, err := inventoryRepository.CountOwnedItems(t.Context(), mock, tt.userID, tt.goodID, tt.variantID)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedCount, count)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+)
+
+type ItemTransferProceeder struct{}
+
+func NewItemTransferProceeder() *ItemTransferProceeder {
+	return &ItemTransferProceeder{}
+}
+
+// ProceedItemTransfer hands one unit of the good in the given variant over to the recipient, a zero variantID
+// meaning a unit without a variant. Units the sender bought themselves are passed on before the ones they got
+// as gifts; a passed on gift loses its sender and message.
+func (ip *ItemTransferProceeder) ProceedItemTransfer(ctx context.Context, executor database.Executor,
+	fromUserID, toUserID, goodID, variantID int) error {
+	var variant *int
+	if variantID != 0 {
+		variant = &variantID
+	}
+
+	moveItemSQL := `WITH item AS (
+			SELECT id FROM purchases
+			WHERE user_id = $1 AND good_id = $3 AND variant_id IS NOT DISTINCT FROM $4
+			ORDER BY gifted_by IS NOT NULL, id DESC
+			LIMIT 1
+			FOR UPDATE
+		)
+		UPDATE purchases p SET user_id = $2, gifted_by = NULL, gift_message = ''
+		FROM item WHERE p.id = item.id`
+	tag, err := executor.Exec(ctx, moveItemSQL, fromUserID, toUserID, goodID, variant)
+	if err != nil {
+		return fmt.Errorf("failed to move item: %w", err)
+	} else if tag.RowsAffected() == 0 {
+		return &domain.ItemNotOwnedError{Msg: "item is not in the inventory"}
+	}
+
+	insertTransferSQL := `INSERT INTO item_transfers (from_user_id, to_user_id, good_id, variant_id) VALUES ($1, $2, $3, $4)`
+	_, err = executor.Exec(ctx, insertTransferSQL, fromUserID, toUserID, goodID, variant)
+	if err != nil {
+		return fmt.Errorf("failed to insert item transfer record: %w", err)
+	}
+
+	return nil
+}
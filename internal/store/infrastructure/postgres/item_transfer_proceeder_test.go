@@ -0,0 +1,126 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestItemTransferProceeder_ProceedItemTransfer(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name       string
+		fromUserID int
+		toUserID   int
+		goodID     int
+		variantID  int
+
+		expectedErr error
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+	}
+
+	tests := []testCase{
+		{
+			name:       "successful item transfer",
+			fromUserID: 1,
+			toUserID:   2,
+			goodID:     10,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("UPDATE purchases").
+					WithArgs(1, 2, 10, (*int)(nil)).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectExec("INSERT INTO item_transfers").
+					WithArgs(1, 2, 10, (*int)(nil)).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+			},
+			expectedErr: nil,
+		},
+		{
+			name:       "variant transferred",
+			fromUserID: 1,
+			toUserID:   2,
+			goodID:     12,
+			variantID:  4,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				variantID := 4
+				mock.ExpectExec("UPDATE purchases").
+					WithArgs(1, 2, 12, &variantID).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectExec("INSERT INTO item_transfers").
+					WithArgs(1, 2, 12, &variantID).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+			},
+		},
+		{
+			name:       "item not owned",
+			fromUserID: 1,
+			toUserID:   2,
+			goodID:     10,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("UPDATE purchases").
+					WithArgs(1, 2, 10, (*int)(nil)).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+			},
+			expectedErr: &domain.ItemNotOwnedError{},
+		},
+		{
+			name:       "failed to move item",
+			fromUserID: 1,
+			toUserID:   2,
+			goodID:     10,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("UPDATE purchases").
+					WithArgs(1, 2, 10, (*int)(nil)).
+					WillReturnError(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+		{
+			name:       "failed to insert item transfer",
+			fromUserID: 1,
+			toUserID:   2,
+			goodID:     10,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("UPDATE purchases").
+					WithArgs(1, 2, 10, (*int)(nil)).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectExec("INSERT INTO item_transfers").
+					WithArgs(1, 2, 10, (*int)(nil)).
+					WillReturnError(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			proceeder := NewItemTransferProceeder()
+			err = proceeder.ProceedItemTransfer(t.Context(), mock, tt.fromUserID, tt.toUserID, tt.goodID, tt.variantID)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
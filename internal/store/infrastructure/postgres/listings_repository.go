@@ -0,0 +1,186 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+const listingColumns = `l.id, l.seller_id, l.good_id, g.name, COALESCE(l.variant_id, 0), COALESCE(v.sku, ''), l.price,
+	l.status, l.created_at`
+
+type ListingsRepository struct {
+	queryExecuter database.QueryExecuter
+}
+
+func NewListingsRepository(queryExecuter database.QueryExecuter) *ListingsRepository {
+	return &ListingsRepository{
+		queryExecuter: queryExecuter,
+	}
+}
+
+func (lr *ListingsRepository) CreateListing(ctx context.Context, querier database.Querier, listing domain.Listing) (int, error) {
+	var variantID *int
+	if listing.VariantID != 0 {
+		variantID = &listing.VariantID
+	}
+
+	insertSQL := `INSERT INTO listings (seller_id, good_id, variant_id, price) VALUES ($1, $2, $3, $4) RETURNING id`
+
+	var listingID int
+	err := querier.QueryRow(ctx, insertSQL, listing.SellerID, listing.GoodID, variantID, listing.Price).Scan(&listingID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create listing: %w", err)
+	}
+
+	return listingID, nil
+}
+
+// CountActiveListings counts the active listings of the good in the given variant, a zero variantID counting
+// the listings of units without a variant.
+func (lr *ListingsRepository) CountActiveListings(ctx context.Context, querier database.Querier, sellerID, goodID, variantID int) (int, error) {
+	var variant *int
+	if variantID != 0 {
+		variant = &variantID
+	}
+
+	countSQL := `SELECT COUNT(*) FROM listings
+		WHERE seller_id = $1 AND good_id = $2 AND variant_id IS NOT DISTINCT FROM $3 AND status = $4`
+
+	var count int
+	err := querier.QueryRow(ctx, countSQL, sellerID, goodID, variant, domain.ListingStatusActive).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count listings: %w", err)
+	}
+
+	return count, nil
+}
+
+// UpdateListingPrice changes the price of an active listing of the seller. Listings of other users are reported
+// as missing.
+func (lr *ListingsRepository) UpdateListingPrice(ctx context.Context, sellerID, listingID int, price uint32) error {
+	updateSQL := `UPDATE listings SET price = $3 WHERE id = $1 AND seller_id = $2 AND status = $4`
+
+	tag, err := lr.queryExecuter.Exec(ctx, updateSQL, listingID, sellerID, price, domain.ListingStatusActive)
+	if err != nil {
+		return fmt.Errorf("failed to update listing: %w", err)
+	} else if tag.RowsAffected() == 0 {
+		return &domain.ListingNotFoundError{Msg: fmt.Sprintf("listing %d not found", listingID)}
+	}
+
+	return nil
+}
+
+// CancelListing withdraws an active listing of the seller. Listings of other users are reported as missing.
+func (lr *ListingsRepository) CancelListing(ctx context.Context, sellerID, listingID int) error {
+	cancelSQL := `UPDATE listings SET status = $3, closed_at = NOW() WHERE id = $1 AND seller_id = $2 AND status = $4`
+
+	tag, err := lr.queryExecuter.Exec(ctx, cancelSQL, listingID, sellerID, domain.ListingStatusCancelled,
+		domain.ListingStatusActive)
+	if err != nil {
+		return fmt.Errorf("failed to cancel listing: %w", err)
+	} else if tag.RowsAffected() == 0 {
+		return &domain.ListingNotFoundError{Msg: fmt.Sprintf("listing %d not found", listingID)}
+	}
+
+	return nil
+}
+
+// SearchListings returns the active listings matching the filter, cheapest first.
+func (lr *ListingsRepository) SearchListings(ctx context.Context, filter domain.ListingFilter) ([]domain.Listing, error) {
+	searchSQL := `SELECT ` + listingColumns + `
+		FROM listings l
+		JOIN goods g ON l.good_id = g.id
+		LEFT JOIN good_variants v ON l.variant_id = v.id
+		WHERE l.status = $1
+			AND ($2 = '' OR g.name ILIKE '%' || $2 || '%')
+			AND ($3 = 0 OR l.seller_id = $3)
+			AND ($4 = 0 OR l.price <= $4)
+		ORDER BY l.price, l.id
+		LIMIT $5 OFFSET $6`
+
+	rows, err := lr.queryExecuter.Query(ctx, searchSQL, domain.ListingStatusActive, filter.Query, filter.SellerID,
+		filter.MaxPrice, filter.EffectiveLimit(), filter.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search listings: %w", err)
+	}
+	defer rows.Close()
+
+	listings := make([]domain.Listing, 0)
+	for rows.Next() {
+		listing, err := scanListing(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan listing: %w", err)
+		}
+
+		listings = append(listings, listing)
+	}
+
+	return listings, rows.Err()
+}
+
+func (lr *ListingsRepository) LockAndGetListing(ctx context.Context, querier database.Querier, listingID int) (domain.Listing, error) {
+	lockSQL := `SELECT ` + listingColumns + `
+		FROM listings l
+		JOIN goods g ON l.good_id = g.id
+		LEFT JOIN good_variants v ON l.variant_id = v.id
+		WHERE l.id = $1
+		FOR UPDATE OF l`
+
+	listing, err := scanListing(querier.QueryRow(ctx, lockSQL, listingID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Listing{}, &domain.ListingNotFoundError{Msg: fmt.Sprintf("listing %d not found", listingID)}
+		}
+
+		return domain.Listing{}, fmt.Errorf("failed to lock listing: %w", err)
+	}
+
+	return listing, nil
+}
+
+func (lr *ListingsRepository) CloseListing(ctx context.Context, executor database.Executor, listingID int, status string) error {
+	closeSQL := `UPDATE listings SET status = $2, closed_at = NOW() WHERE id = $1`
+
+	_, err := executor.Exec(ctx, closeSQL, listingID, status)
+	if err != nil {
+		return fmt.Errorf("failed to close listing: %w", err)
+	}
+
+	return nil
+}
+
+func (lr *ListingsRepository) CancelSellerListings(ctx context.Context, executor database.Executor, sellerID int) (int, error) {
+	cancelSQL := `UPDATE listings SET status = $2, closed_at = NOW() WHERE seller_id = $1 AND status = $3`
+
+	tag, err := executor.Exec(ctx, cancelSQL, sellerID, domain.ListingStatusCancelled, domain.ListingStatusActive)
+	if err != nil {
+		return 0, fmt.Errorf("failed to cancel listings: %w", err)
+	}
+
+	return int(tag.RowsAffected()), nil
+}
+
+func (lr *ListingsRepository) MarkListingSold(ctx context.Context, executor database.Executor, listingID, buyerID int, fee uint32) error {
+	soldSQL := `UPDATE listings SET status = $2, buyer_id = $3, fee = $4, closed_at = NOW() WHERE id = $1`
+
+	_, err := executor.Exec(ctx, soldSQL, listingID, domain.ListingStatusSold, buyerID, fee)
+	if err != nil {
+		return fmt.Errorf("failed to mark listing as sold: %w", err)
+	}
+
+	return nil
+}
+
+func scanListing(row pgx.Row) (domain.Listing, error) {
+	var listing domain.Listing
+
+	err := row.Scan(&listing.Id, &listing.SellerID, &listing.GoodID, &listing.GoodName, &listing.VariantID,
+		&listing.Variant, &listing.Price, &listing.Status, &listing.CreatedAt)
+
+	return listing, err
+}
@@ -0,0 +1,293 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var listingColumnNames = []string{"id", "seller_id", "good_id", "name", "variant_id", "sku", "price", "status", "created_at"}
+
+func TestListingsRepository_CreateListing(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	variantID := 4
+	mock.ExpectQuery("INSERT INTO listings").
+		WithArgs(1, 10, (*int)(nil), uint32(150)).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(7))
+	mock.ExpectQuery("INSERT INTO listings").
+		WithArgs(1, 12, &variantID, uint32(40)).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(8))
+
+	repo := NewListingsRepository(mock)
+	listingID, err := repo.CreateListing(t.Context(), mock, domain.Listing{SellerID: 1, GoodID: 10, Price: 150})
+
+	require.NoError(t, err)
+	assert.Equal(t, 7, listingID)
+
+	listingID, err = repo.CreateListing(t.Context(), mock,
+		domain.Listing{SellerID: 1, GoodID: 12, VariantID: variantID, Price: 40})
+
+	require.NoError(t, err)
+	assert.Equal(t, 8, listingID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListingsRepository_CountActiveListings(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	variantID := 4
+	mock.ExpectQuery("SELECT COUNT").
+		WithArgs(1, 10, (*int)(nil), domain.ListingStatusActive).
+		WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(2))
+	mock.ExpectQuery("SELECT COUNT").
+		WithArgs(1, 12, &variantID, domain.ListingStatusActive).
+		WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(1))
+
+	repo := NewListingsRepository(mock)
+	count, err := repo.CountActiveListings(t.Context(), mock, 1, 10, 0)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	count, err = repo.CountActiveListings(t.Context(), mock, 1, 12, variantID)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListingsRepository_UpdateListingPrice(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name         string
+		rowsAffected int64
+
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name:         "price updated",
+			rowsAffected: 1,
+		},
+		{
+			name:         "listing not found or not active",
+			rowsAffected: 0,
+			expectedErr:  &domain.ListingNotFoundError{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			mock.ExpectExec("UPDATE listings").
+				WithArgs(7, 1, uint32(120), domain.ListingStatusActive).
+				WillReturnResult(pgxmock.NewResult("UPDATE", tt.rowsAffected))
+
+			repo := NewListingsRepository(mock)
+			err = repo.UpdateListingPrice(t.Context(), 1, 7, 120)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestListingsRepository_CancelListing(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name         string
+		rowsAffected int64
+
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name:         "listing cancelled",
+			rowsAffected: 1,
+		},
+		{
+			name:         "listing not found or not active",
+			rowsAffected: 0,
+			expectedErr:  &domain.ListingNotFoundError{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			mock.ExpectExec("UPDATE listings").
+				WithArgs(7, 1, domain.ListingStatusCancelled, domain.ListingStatusActive).
+				WillReturnResult(pgxmock.NewResult("UPDATE", tt.rowsAffected))
+
+			repo := NewListingsRepository(mock)
+			err = repo.CancelListing(t.Context(), 1, 7)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestListingsRepository_SearchListings(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	createdAt := time.Date(2026, 5, 18, 9, 0, 0, 0, time.UTC)
+	rows := pgxmock.NewRows(listingColumnNames).
+		AddRow(7, 1, 10, "cup", 0, "", uint32(15), domain.ListingStatusActive, createdAt).
+		AddRow(8, 2, 12, "hoody", 4, "hoody-m", uint32(18), domain.ListingStatusActive, createdAt)
+	mock.ExpectQuery("SELECT l.id").
+		WithArgs(domain.ListingStatusActive, "cup", 0, uint32(20), domain.DefaultListingsLimit, 0).
+		WillReturnRows(rows)
+
+	repo := NewListingsRepository(mock)
+	listings, err := repo.SearchListings(t.Context(), domain.ListingFilter{Query: "cup", MaxPrice: 20})
+
+	require.NoError(t, err)
+	assert.Equal(t, []domain.Listing{
+		{Id: 7, SellerID: 1, GoodID: 10, GoodName: "cup", Price: 15, Status: domain.ListingStatusActive, CreatedAt: createdAt},
+		{Id: 8, SellerID: 2, GoodID: 12, GoodName: "hoody", VariantID: 4, Variant: "hoody-m", Price: 18,
+			Status: domain.ListingStatusActive, CreatedAt: createdAt},
+	}, listings)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListingsRepository_LockAndGetListing(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2026, 5, 18, 9, 0, 0, 0, time.UTC)
+
+	type testCase struct {
+		name      string
+		listingID int
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+
+		expectedListing domain.Listing
+		expectedErr     error
+	}
+
+	testCases := []testCase{
+		{
+			name:      "listing found",
+			listingID: 7,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				rows := pgxmock.NewRows(listingColumnNames).
+					AddRow(7, 1, 10, "cup", 0, "", uint32(15), domain.ListingStatusActive, createdAt)
+				mock.ExpectQuery("SELECT l.id").
+					WithArgs(7).
+					WillReturnRows(rows)
+			},
+			expectedListing: domain.Listing{Id: 7, SellerID: 1, GoodID: 10, GoodName: "cup", Price: 15,
+				Status: domain.ListingStatusActive, CreatedAt: createdAt},
+		},
+		{
+			name:      "listing not found",
+			listingID: 42,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("SELECT l.id").
+					WithArgs(42).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			expectedErr: &domain.ListingNotFoundError{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			repo := NewListingsRepository(mock)
+			listing, err := repo.LockAndGetListing(t.Context(), mock, tt.listingID)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedListing, listing)
+			}
+		})
+	}
+}
+
+func TestListingsRepository_MarkListingSold(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	mock.ExpectExec("UPDATE listings").
+		WithArgs(7, domain.ListingStatusSold, 2, uint32(3)).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+	repo := NewListingsRepository(mock)
+	err = repo.MarkListingSold(t.Context(), mock, 7, 2, 3)
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListingsRepository_CancelSellerListings(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	mock.ExpectExec("UPDATE listings").
+		WithArgs(2, domain.ListingStatusCancelled, domain.ListingStatusActive).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 3))
+
+	repo := NewListingsRepository(mock)
+	cancelled, err := repo.CancelSellerListings(t.Context(), mock, 2)
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, cancelled)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+const paymentRequestsListLimit = 100
+
+type PaymentRequestsRepository struct {
+	queryExecuter database.QueryExecuter
+}
+
+func NewPaymentRequestsRepository(queryExecuter database.QueryExecuter) *PaymentRequestsRepository {
+	return &PaymentRequestsRepository{
+		queryExecuter: queryExecuter,
+	}
+}
+
+func (pr *PaymentRequestsRepository) CreatePaymentRequest(ctx context.Context, request domain.PaymentRequest) (int, error) {
+	insertSQL := `INSERT INTO payment_requests (requester_id, payer_id, amount, note, expires_at)
+		VALUES ($1, $2, $3, $4, $5) RETURNING id`
+
+	var requestID int
+	err := pr.queryExecuter.QueryRow(ctx, insertSQL, request.RequesterID, request.PayerID, request.Amount,
+		request.Note, request.ExpiresAt).Scan(&requestID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create payment request: %w", err)
+	}
+
+	return requestID, nil
+}
+
+// ListPendingPaymentRequests returns the requests still waiting for the payer's answer, skipping expired ones.
+func (pr *PaymentRequestsRepository) ListPendingPaymentRequests(ctx context.Context, payerID int, now time.Time) ([]domain.PaymentRequest, error) {
+	listSQL := `SELECT id, requester_id, payer_id, amount, note, status, created_at, expires_at
+		FROM payment_requests
+		WHERE payer_id = $1 AND status = $2 AND expires_at > $3
+		ORDER BY created_at DESC
+		LIMIT $4`
+
+	rows, err := pr.queryExecuter.Query(ctx, listSQL, payerID, domain.PaymentRequestStatusPending, now, paymentRequestsListLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list payment requests: %w", err)
+	}
+	defer rows.Close()
+
+	requests := make([]domain.PaymentRequest, 0)
+	for rows.Next() {
+		var request domain.PaymentRequest
+
+		err := rows.Scan(&request.Id, &request.RequesterID, &request.PayerID, &request.Amount, &request.Note,
+			&request.Status, &request.CreatedAt, &request.ExpiresAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan payment request: %w", err)
+		}
+
+		requests = append(requests, request)
+	}
+
+	return requests, rows.Err()
+}
+
+func (pr *PaymentRequestsRepository) LockAndGetPaymentRequest(ctx context.Context, querier database.Querier, requestID int) (domain.PaymentRequest, error) {
+	lockSQL := `SELECT id, requester_id, payer_id, amount, note, status, created_at, expires_at
+		FROM payment_requests WHERE id = $1 FOR UPDATE`
+
+	var request domain.PaymentRequest
+	err := querier.QueryRow(ctx, lockSQL, requestID).Scan(&request.Id, &request.RequesterID, &request.PayerID,
+		&request.Amount, &request.Note, &request.Status, &request.CreatedAt, &request.ExpiresAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.PaymentRequest{}, &domain.PaymentRequestNotFoundError{Msg: fmt.Sprintf("payment request %d not found", requestID)}
+		}
+
+		return domain.PaymentRequest{}, fmt.Errorf("failed to lock payment request: %w", err)
+	}
+
+	return request, nil
+}
+
+func (pr *PaymentRequestsRepository) ClosePaymentRequest(ctx context.Context, executor database.Executor, requestID int, status string) error {
+	closeSQL := `UPDATE payment_requests SET status = $2, resolved_at = now() WHERE id = $1`
+
+	tag, err := executor.Exec(ctx, closeSQL, requestID, status)
+	if err != nil {
+		return fmt.Errorf("failed to close payment request: %w", err)
+	} else if tag.RowsAffected() == 0 {
+		return &domain.PaymentRequestNotFoundError{Msg: fmt.Sprintf("payment request %d not found", requestID)}
+	}
+
+	return nil
+}
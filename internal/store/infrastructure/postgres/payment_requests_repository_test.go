@@ -0,0 +1,189 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaymentRequestsRepository_CreatePaymentRequest(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	expiresAt := time.Date(2026, 4, 26, 12, 0, 0, 0, time.UTC)
+	mock.ExpectQuery("INSERT INTO payment_requests").
+		WithArgs(1, 2, uint32(150), "team lunch", expiresAt).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(5))
+
+	repo := NewPaymentRequestsRepository(mock)
+	requestID, err := repo.CreatePaymentRequest(t.Context(), domain.PaymentRequest{
+		RequesterID: 1,
+		PayerID:     2,
+		Amount:      150,
+		Note:        "team lunch",
+		ExpiresAt:   expiresAt,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 5, requestID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPaymentRequestsRepository_ListPendingPaymentRequests(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	now := time.Date(2026, 4, 20, 12, 0, 0, 0, time.UTC)
+	createdAt := now.Add(-time.Hour)
+	expiresAt := createdAt.Add(7 * 24 * time.Hour)
+
+	rows := pgxmock.NewRows([]string{"id", "requester_id", "payer_id", "amount", "note", "status", "created_at", "expires_at"}).
+		AddRow(5, 1, 2, uint32(150), "team lunch", domain.PaymentRequestStatusPending, createdAt, expiresAt)
+	mock.ExpectQuery("SELECT id, requester_id").
+		WithArgs(2, domain.PaymentRequestStatusPending, now, paymentRequestsListLimit).
+		WillReturnRows(rows)
+
+	repo := NewPaymentRequestsRepository(mock)
+	requests, err := repo.ListPendingPaymentRequests(t.Context(), 2, now)
+
+	require.NoError(t, err)
+	assert.Equal(t, []domain.PaymentRequest{{Id: 5, RequesterID: 1, PayerID: 2, Amount: 150, Note: "team lunch",
+		Status: domain.PaymentRequestStatusPending, CreatedAt: createdAt, ExpiresAt: expiresAt}}, requests)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPaymentRequestsRepository_LockAndGetPaymentRequest(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2026, 4, 19, 12, 0, 0, 0, time.UTC)
+	expiresAt := createdAt.Add(7 * 24 * time.Hour)
+
+	type testCase struct {
+		name      string
+		requestID int
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+
+		expectedRequest domain.PaymentRequest
+		expectedErr     error
+	}
+
+	testCases := []testCase{
+		{
+			name:      "request found",
+			requestID: 5,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				rows := pgxmock.NewRows([]string{"id", "requester_id", "payer_id", "amount", "note", "status", "created_at", "expires_at"}).
+					AddRow(5, 1, 2, uint32(150), "", domain.PaymentRequestStatusPending, createdAt, expiresAt)
+				mock.ExpectQuery("SELECT id, requester_id").
+					WithArgs(5).
+					WillReturnRows(rows)
+			},
+			expectedRequest: domain.PaymentRequest{Id: 5, RequesterID: 1, PayerID: 2, Amount: 150,
+				Status: domain.PaymentRequestStatusPending, CreatedAt: createdAt, ExpiresAt: expiresAt},
+		},
+		{
+			name:      "request not found",
+			requestID: 42,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("SELECT id, requester_id").
+					WithArgs(42).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			expectedErr: &domain.PaymentRequestNotFoundError{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			repo := NewPaymentRequestsRepository(mock)
+			request, err := repo.LockAndGetPaymentRequest(t.Context(), mock, tt.requestID)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedRequest, request)
+			}
+		})
+	}
+}
+
+func TestPaymentRequestsRepository_ClosePaymentRequest(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name      string
+		requestID int
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name:      "request closed",
+			requestID: 5,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("UPDATE payment_requests").
+					WithArgs(5, domain.PaymentRequestStatusAccepted).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+			},
+		},
+		{
+			name:      "request not found",
+			requestID: 42,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("UPDATE payment_requests").
+					WithArgs(42, domain.PaymentRequestStatusAccepted).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+			},
+			expectedErr: &domain.PaymentRequestNotFoundError{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			repo := NewPaymentRequestsRepository(mock)
+			err = repo.ClosePaymentRequest(t.Context(), mock, tt.requestID, domain.PaymentRequestStatusAccepted)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
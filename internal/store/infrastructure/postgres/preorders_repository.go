@@ -0,0 +1,258 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+const preorderColumns = `p.id, p.user_id, p.good_id, g.name, COALESCE(p.variant_id, 0), COALESCE(v.sku, ''), p.price,
+	p.status, p.created_at, p.resolved_at`
+
+const preorderTables = `preorders p JOIN goods g ON p.good_id = g.id
+	LEFT JOIN good_variants v ON p.variant_id = v.id`
+
+type PreordersRepository struct {
+	queryExecuter database.QueryExecuter
+}
+
+func NewPreordersRepository(queryExecuter database.QueryExecuter) *PreordersRepository {
+	return &PreordersRepository{
+		queryExecuter: queryExecuter,
+	}
+}
+
+// ListUserPreorders returns the pre-orders of the user, the latest first.
+func (pr *PreordersRepository) ListUserPreorders(ctx context.Context, userID int) ([]domain.Preorder, error) {
+	listSQL := `SELECT ` + preorderColumns + ` FROM ` + preorderTables + `
+		WHERE p.user_id = $1
+		ORDER BY p.created_at DESC, p.id DESC`
+
+	rows, err := pr.queryExecuter.Query(ctx, listSQL, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list preorders: %w", err)
+	}
+	defer rows.Close()
+
+	preorders := make([]domain.Preorder, 0)
+	for rows.Next() {
+		preorder, err := scanPreorder(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan preorder: %w", err)
+		}
+
+		preorders = append(preorders, preorder)
+	}
+
+	return preorders, rows.Err()
+}
+
+func (pr *PreordersRepository) FetchHeldCoins(ctx context.Context, userID int) (uint32, error) {
+	heldSQL := `SELECT held FROM balances WHERE user_id = $1`
+
+	var held uint32
+	err := pr.queryExecuter.QueryRow(ctx, heldSQL, userID).Scan(&held)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, &domain.BalanceNotFoundError{Msg: fmt.Sprintf("balance for user with id %d not found", userID)}
+		}
+
+		return 0, fmt.Errorf("failed to fetch held coins: %w", err)
+	}
+
+	return held, nil
+}
+
+// LockUpcomingGood reports whether the good is still upcoming and keeps it from being marked as arrived until
+// the transaction ends, so a pre-order placed in it is seen by the arrival.
+func (pr *PreordersRepository) LockUpcomingGood(ctx context.Context, querier database.Querier, goodID int) (bool, error) {
+	lockSQL := `SELECT upcoming FROM goods WHERE id = $1 FOR SHARE`
+
+	var upcoming bool
+	err := querier.QueryRow(ctx, lockSQL, goodID).Scan(&upcoming)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, &domain.GoodNotFoundError{Msg: fmt.Sprintf("good with id %d not found", goodID)}
+		}
+
+		return false, fmt.Errorf("failed to lock good: %w", err)
+	}
+
+	return upcoming, nil
+}
+
+// PlacePreorder records a pending pre-order and moves its price from the user's balance to the held coins.
+// A user can have a single pending pre-order of a good.
+func (pr *PreordersRepository) PlacePreorder(ctx context.Context, executor database.QueryExecuter, preorder domain.Preorder) (int, error) {
+	var variantID *int
+	if preorder.VariantID != 0 {
+		variantID = &preorder.VariantID
+	}
+
+	insertSQL := `INSERT INTO preorders (user_id, good_id, variant_id, price) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (user_id, good_id) WHERE status = 'pending' DO NOTHING RETURNING id`
+
+	var preorderID int
+	err := executor.QueryRow(ctx, insertSQL, preorder.UserID, preorder.GoodID, variantID, preorder.Price).Scan(&preorderID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, &domain.PreorderExistingError{Msg: "item is already pre-ordered"}
+		}
+
+		return 0, fmt.Errorf("failed to create preorder: %w", err)
+	}
+
+	holdSQL := `UPDATE balances SET balance = balance - $1, held = held + $1 WHERE user_id = $2`
+	_, err = executor.Exec(ctx, holdSQL, preorder.Price, preorder.UserID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to hold user balance: %w", err)
+	}
+
+	return preorderID, nil
+}
+
+func (pr *PreordersRepository) LockAndGetPreorder(ctx context.Context, querier database.Querier, preorderID int) (domain.Preorder, error) {
+	lockSQL := `SELECT ` + preorderColumns + ` FROM ` + preorderTables + ` WHERE p.id = $1 FOR UPDATE OF p`
+
+	preorder, err := scanPreorder(querier.QueryRow(ctx, lockSQL, preorderID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Preorder{}, &domain.PreorderNotFoundError{Msg: fmt.Sprintf("pre-order %d not found", preorderID)}
+		}
+
+		return domain.Preorder{}, fmt.Errorf("failed to get preorder: %w", err)
+	}
+
+	return preorder, nil
+}
+
+// LockPendingPreorders returns the pending pre-orders of the good in the order they were placed.
+func (pr *PreordersRepository) LockPendingPreorders(ctx context.Context, querier database.Querier, goodID int) ([]domain.Preorder, error) {
+	lockSQL := `SELECT ` + preorderColumns + ` FROM ` + preorderTables + `
+		WHERE p.good_id = $1 AND p.status = $2
+		ORDER BY p.id
+		FOR UPDATE OF p`
+
+	return lockPreorders(ctx, querier, lockSQL, goodID)
+}
+
+// LockUserPendingPreorders returns the pending pre-orders of the user in the order they were placed.
+func (pr *PreordersRepository) LockUserPendingPreorders(ctx context.Context, querier database.Querier, userID int) ([]domain.Preorder, error) {
+	lockSQL := `SELECT ` + preorderColumns + ` FROM ` + preorderTables + `
+		WHERE p.user_id = $1 AND p.status = $2
+		ORDER BY p.id
+		FOR UPDATE OF p`
+
+	return lockPreorders(ctx, querier, lockSQL, userID)
+}
+
+// LockPreorderBalances locks the balances of the users with pending pre-orders of the good up front, in user id
+// order, and reports which of the users can receive the good: active and not frozen.
+func (pr *PreordersRepository) LockPreorderBalances(ctx context.Context, querier database.Querier, goodID int) (map[int]bool, error) {
+	lockSQL := `SELECT user_id, is_active AND NOT is_frozen FROM balances
+		WHERE user_id IN (SELECT user_id FROM preorders WHERE good_id = $1 AND status = $2)
+		ORDER BY user_id
+		FOR UPDATE`
+
+	rows, err := querier.Query(ctx, lockSQL, goodID, domain.PreorderStatusPending)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock preorder balances: %w", err)
+	}
+	defer rows.Close()
+
+	receivable := make(map[int]bool)
+	for rows.Next() {
+		var userID int
+		var canReceive bool
+		err = rows.Scan(&userID, &canReceive)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan balance: %w", err)
+		}
+
+		receivable[userID] = canReceive
+	}
+
+	return receivable, rows.Err()
+}
+
+// CancelPreorder returns the held price of the pre-order to the user's balance.
+func (pr *PreordersRepository) CancelPreorder(ctx context.Context, executor database.Executor, preorder domain.Preorder) error {
+	releaseSQL := `UPDATE balances SET balance = balance + $1, held = held - $1 WHERE user_id = $2`
+	_, err := executor.Exec(ctx, releaseSQL, preorder.Price, preorder.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to release held coins: %w", err)
+	}
+
+	return closePreorder(ctx, executor, preorder.Id, domain.PreorderStatusCancelled)
+}
+
+// FulfillPreorder spends the held price of the pre-order and puts the good, with the ordered variant if any,
+// into the user's inventory.
+func (pr *PreordersRepository) FulfillPreorder(ctx context.Context, executor database.Executor, preorder domain.Preorder) error {
+	spendSQL := `UPDATE balances SET held = held - $1 WHERE user_id = $2`
+	_, err := executor.Exec(ctx, spendSQL, preorder.Price, preorder.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to spend held coins: %w", err)
+	}
+
+	var variantID *int
+	if preorder.VariantID != 0 {
+		variantID = &preorder.VariantID
+	}
+
+	insertPurchaseSQL := `INSERT INTO purchases (user_id, acquired_by, good_id, variant_id) VALUES ($1, $1, $2, $3)`
+	_, err = executor.Exec(ctx, insertPurchaseSQL, preorder.UserID, preorder.GoodID, variantID)
+	if err != nil {
+		return fmt.Errorf("failed to insert purchase record: %w", err)
+	}
+
+	return closePreorder(ctx, executor, preorder.Id, domain.PreorderStatusFulfilled)
+}
+
+func closePreorder(ctx context.Context, executor database.Executor, preorderID int, status string) error {
+	closeSQL := `UPDATE preorders SET status = $2, resolved_at = now() WHERE id = $1`
+	_, err := executor.Exec(ctx, closeSQL, preorderID, status)
+	if err != nil {
+		return fmt.Errorf("failed to close preorder: %w", err)
+	}
+
+	return nil
+}
+
+func lockPreorders(ctx context.Context, querier database.Querier, lockSQL string, id int) ([]domain.Preorder, error) {
+	rows, err := querier.Query(ctx, lockSQL, id, domain.PreorderStatusPending)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock pending preorders: %w", err)
+	}
+	defer rows.Close()
+
+	preorders := make([]domain.Preorder, 0)
+	for rows.Next() {
+		preorder, err := scanPreorder(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan preorder: %w", err)
+		}
+
+		preorders = append(preorders, preorder)
+	}
+
+	return preorders, rows.Err()
+}
+
+func scanPreorder(row pgx.Row) (domain.Preorder, error) {
+	var preorder domain.Preorder
+	var resolvedAt *time.Time
+
+	err := row.Scan(&preorder.Id, &preorder.UserID, &preorder.GoodID, &preorder.GoodName, &preorder.VariantID,
+		&preorder.Variant, &preorder.Price, &preorder.Status, &preorder.CreatedAt, &resolvedAt)
+	if resolvedAt != nil {
+		preorder.ResolvedAt = *resolvedAt
+	}
+
+	return preorder, err
+}
@@ -0,0 +1,258 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var preorderColumnNames = []string{"id", "user_id", "good_id", "name", "variant_id", "sku", "price", "status",
+	"created_at", "resolved_at"}
+
+func TestPreordersRepository_PlacePreorder(t *testing.T) {
+	t.Parallel()
+
+	variantID := 4
+
+	type testCase struct {
+		name     string
+		preorder domain.Preorder
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+
+		expectedID  int
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name:     "preorder placed",
+			preorder: domain.Preorder{UserID: 1, GoodID: 12, Price: 200},
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("INSERT INTO preorders").
+					WithArgs(1, 12, (*int)(nil), uint32(200)).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(3))
+				mock.ExpectExec("UPDATE balances SET balance = balance - \\$1, held = held \\+ \\$1").
+					WithArgs(uint32(200), 1).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+			},
+			expectedID: 3,
+		},
+		{
+			name:     "variant preorder placed",
+			preorder: domain.Preorder{UserID: 1, GoodID: 12, VariantID: variantID, Price: 220},
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("INSERT INTO preorders").
+					WithArgs(1, 12, &variantID, uint32(220)).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(3))
+				mock.ExpectExec("UPDATE balances").
+					WithArgs(uint32(220), 1).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+			},
+			expectedID: 3,
+		},
+		{
+			name:     "pending preorder exists",
+			preorder: domain.Preorder{UserID: 1, GoodID: 12, Price: 200},
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("INSERT INTO preorders").
+					WithArgs(1, 12, (*int)(nil), uint32(200)).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			expectedErr: &domain.PreorderExistingError{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			repo := NewPreordersRepository(mock)
+			preorderID, err := repo.PlacePreorder(t.Context(), mock, tt.preorder)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedID, preorderID)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPreordersRepository_LockAndGetPreorder(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2026, 7, 27, 10, 0, 0, 0, time.UTC)
+
+	type testCase struct {
+		name       string
+		preorderID int
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+
+		expectedPreorder domain.Preorder
+		expectedErr      error
+	}
+
+	testCases := []testCase{
+		{
+			name:       "preorder found",
+			preorderID: 3,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				rows := pgxmock.NewRows(preorderColumnNames).
+					AddRow(3, 1, 12, "umbrella", 4, "umbrella-red", uint32(220), domain.PreorderStatusPending,
+						createdAt, nil)
+				mock.ExpectQuery("SELECT p.id").
+					WithArgs(3).
+					WillReturnRows(rows)
+			},
+			expectedPreorder: domain.Preorder{Id: 3, UserID: 1, GoodID: 12, GoodName: "umbrella", VariantID: 4,
+				Variant: "umbrella-red", Price: 220, Status: domain.PreorderStatusPending, CreatedAt: createdAt},
+		},
+		{
+			name:       "preorder not found",
+			preorderID: 42,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("SELECT p.id").
+					WithArgs(42).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			expectedErr: &domain.PreorderNotFoundError{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			repo := NewPreordersRepository(mock)
+			preorder, err := repo.LockAndGetPreorder(t.Context(), mock, tt.preorderID)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedPreorder, preorder)
+			}
+		})
+	}
+}
+
+func TestPreordersRepository_ListUserPreorders(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	createdAt := time.Date(2026, 7, 27, 10, 0, 0, 0, time.UTC)
+	resolvedAt := time.Date(2026, 8, 3, 9, 0, 0, 0, time.UTC)
+	rows := pgxmock.NewRows(preorderColumnNames).
+		AddRow(5, 1, 13, "scarf", 0, "", uint32(150), domain.PreorderStatusPending, createdAt, nil).
+		AddRow(3, 1, 12, "umbrella", 0, "", uint32(200), domain.PreorderStatusFulfilled, createdAt, &resolvedAt)
+	mock.ExpectQuery("SELECT p.id").
+		WithArgs(1).
+		WillReturnRows(rows)
+
+	repo := NewPreordersRepository(mock)
+	preorders, err := repo.ListUserPreorders(t.Context(), 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, []domain.Preorder{
+		{Id: 5, UserID: 1, GoodID: 13, GoodName: "scarf", Price: 150, Status: domain.PreorderStatusPending,
+			CreatedAt: createdAt},
+		{Id: 3, UserID: 1, GoodID: 12, GoodName: "umbrella", Price: 200, Status: domain.PreorderStatusFulfilled,
+			CreatedAt: createdAt, ResolvedAt: resolvedAt},
+	}, preorders)
+}
+
+func TestPreordersRepository_CancelPreorder(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	mock.ExpectExec("UPDATE balances SET balance = balance \\+ \\$1, held = held - \\$1").
+		WithArgs(uint32(200), 1).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	mock.ExpectExec("UPDATE preorders SET status").
+		WithArgs(3, domain.PreorderStatusCancelled).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+	repo := NewPreordersRepository(mock)
+	err = repo.CancelPreorder(t.Context(), mock, domain.Preorder{Id: 3, UserID: 1, GoodID: 12, Price: 200})
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPreordersRepository_FulfillPreorder(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	variantID := 4
+	mock.ExpectExec("UPDATE balances SET held = held - \\$1").
+		WithArgs(uint32(220), 1).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	mock.ExpectExec("INSERT INTO purchases").
+		WithArgs(1, 12, &variantID).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectExec("UPDATE preorders SET status").
+		WithArgs(3, domain.PreorderStatusFulfilled).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+	repo := NewPreordersRepository(mock)
+	err = repo.FulfillPreorder(t.Context(), mock, domain.Preorder{Id: 3, UserID: 1, GoodID: 12, VariantID: variantID, Price: 220})
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPreordersRepository_LockPreorderBalances(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	mock.ExpectQuery("SELECT user_id, is_active AND NOT is_frozen FROM balances").
+		WithArgs(12, domain.PreorderStatusPending).
+		WillReturnRows(pgxmock.NewRows([]string{"user_id", "can_receive"}).
+			AddRow(1, true).
+			AddRow(2, false))
+
+	repo := NewPreordersRepository(mock)
+	receivable, err := repo.LockPreorderBalances(t.Context(), mock, 12)
+
+	require.NoError(t, err)
+	assert.Equal(t, map[int]bool{1: true, 2: false}, receivable)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
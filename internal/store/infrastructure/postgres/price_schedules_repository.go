@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+)
+
+type PriceSchedulesRepository struct {
+	queryExecuter database.QueryExecuter
+}
+
+func NewPriceSchedulesRepository(queryExecuter database.QueryExecuter) *PriceSchedulesRepository {
+	return &PriceSchedulesRepository{
+		queryExecuter: queryExecuter,
+	}
+}
+
+func (pr *PriceSchedulesRepository) CreatePriceSchedule(ctx context.Context, querier database.Querier,
+	schedule domain.PriceSchedule) (int, error) {
+	insertSQL := `INSERT INTO price_schedules (good_id, price, starts_at, ends_at) VALUES ($1, $2, $3, $4) RETURNING id`
+
+	var endsAt *time.Time
+	if !schedule.EndsAt.IsZero() {
+		endsAt = &schedule.EndsAt
+	}
+
+	var scheduleID int
+	err := querier.QueryRow(ctx, insertSQL, schedule.GoodID, schedule.Price, schedule.StartsAt, endsAt).Scan(&scheduleID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create price schedule: %w", err)
+	}
+
+	return scheduleID, nil
+}
+
+// ListPriceSchedules returns every schedule of the good, past and upcoming ones included, in the order they start.
+func (pr *PriceSchedulesRepository) ListPriceSchedules(ctx context.Context, goodID int) ([]domain.PriceSchedule, error) {
+	listSQL := `SELECT id, good_id, price, starts_at, ends_at FROM price_schedules
+		WHERE good_id = $1
+		ORDER BY starts_at, id`
+
+	rows, err := pr.queryExecuter.Query(ctx, listSQL, goodID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list price schedules: %w", err)
+	}
+	defer rows.Close()
+
+	schedules := make([]domain.PriceSchedule, 0)
+	for rows.Next() {
+		var schedule domain.PriceSchedule
+		var endsAt *time.Time
+		if err := rows.Scan(&schedule.Id, &schedule.GoodID, &schedule.Price, &schedule.StartsAt, &endsAt); err != nil {
+			return nil, fmt.Errorf("failed to scan price schedule: %w", err)
+		}
+
+		if endsAt != nil {
+			schedule.EndsAt = *endsAt
+		}
+
+		schedules = append(schedules, schedule)
+	}
+
+	return schedules, rows.Err()
+}
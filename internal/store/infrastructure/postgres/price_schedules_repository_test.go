@@ -0,0 +1,93 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPriceSchedulesRepository_CreatePriceSchedule(t *testing.T) {
+	t.Parallel()
+
+	startsAt := time.Date(2026, 12, 20, 0, 0, 0, 0, time.UTC)
+	endsAt := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type testCase struct {
+		name     string
+		schedule domain.PriceSchedule
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+	}
+
+	testCases := []testCase{
+		{
+			name:     "time-boxed schedule",
+			schedule: domain.PriceSchedule{GoodID: 7, Price: 210, StartsAt: startsAt, EndsAt: endsAt},
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("INSERT INTO price_schedules").
+					WithArgs(7, uint32(210), startsAt, &endsAt).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(2))
+			},
+		},
+		{
+			name:     "open-ended schedule",
+			schedule: domain.PriceSchedule{GoodID: 7, Price: 250, StartsAt: startsAt},
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("INSERT INTO price_schedules").
+					WithArgs(7, uint32(250), startsAt, (*time.Time)(nil)).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(2))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			repo := NewPriceSchedulesRepository(mock)
+			scheduleID, err := repo.CreatePriceSchedule(t.Context(), mock, tt.schedule)
+
+			require.NoError(t, err)
+			assert.Equal(t, 2, scheduleID)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPriceSchedulesRepository_ListPriceSchedules(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	startsAt := time.Date(2026, 12, 20, 0, 0, 0, 0, time.UTC)
+	endsAt := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+	rows := pgxmock.NewRows([]string{"id", "good_id", "price", "starts_at", "ends_at"}).
+		AddRow(2, 7, uint32(210), startsAt, &endsAt).
+		AddRow(3, 7, uint32(250), endsAt, nil)
+	mock.ExpectQuery("SELECT id, good_id, price, starts_at, ends_at FROM price_schedules").
+		WithArgs(7).
+		WillReturnRows(rows)
+
+	repo := NewPriceSchedulesRepository(mock)
+	schedules, err := repo.ListPriceSchedules(t.Context(), 7)
+
+	require.NoError(t, err)
+	assert.Equal(t, []domain.PriceSchedule{
+		{Id: 2, GoodID: 7, Price: 210, StartsAt: startsAt, EndsAt: endsAt},
+		{Id: 3, GoodID: 7, Price: 250, StartsAt: endsAt},
+	}, schedules)
+}
@@ -0,0 +1,94 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+const promoCodeColumns = `p.id, p.code, p.kind, p.value, COALESCE(p.good_id, 0), p.max_uses, p.max_uses_per_user,
+	p.valid_from, p.valid_until, (SELECT COUNT(*) FROM promo_redemptions r WHERE r.promo_id = p.id)`
+
+type PromoCodesRepository struct {
+	queryExecuter database.QueryExecuter
+}
+
+func NewPromoCodesRepository(queryExecuter database.QueryExecuter) *PromoCodesRepository {
+	return &PromoCodesRepository{
+		queryExecuter: queryExecuter,
+	}
+}
+
+func (pr *PromoCodesRepository) CreatePromoCode(ctx context.Context, querier database.Querier, promo domain.PromoCode) (int, error) {
+	insertSQL := `INSERT INTO promo_codes (code, kind, value, good_id, max_uses, max_uses_per_user, valid_from, valid_until)
+		VALUES ($1, $2, $3, NULLIF($4, 0), $5, $6, $7, $8)
+		ON CONFLICT (code) DO NOTHING RETURNING id`
+
+	var promoID int
+	err := querier.QueryRow(ctx, insertSQL, promo.Code, promo.Kind, promo.Value, promo.GoodID, promo.MaxUses,
+		promo.MaxUsesPerUser, promo.ValidFrom, promo.ValidUntil).Scan(&promoID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, &domain.PromoCodeExistingError{Msg: fmt.Sprintf("promo code %s already exists", promo.Code)}
+		}
+
+		return 0, fmt.Errorf("failed to create promo code: %w", err)
+	}
+
+	return promoID, nil
+}
+
+func (pr *PromoCodesRepository) GetPromoCode(ctx context.Context, code string) (domain.PromoCode, error) {
+	getSQL := `SELECT ` + promoCodeColumns + ` FROM promo_codes p WHERE p.code = $1`
+
+	return getPromoCode(pr.queryExecuter.QueryRow(ctx, getSQL, code), code)
+}
+
+func (pr *PromoCodesRepository) LockAndGetPromoCode(ctx context.Context, querier database.Querier, code string) (domain.PromoCode, error) {
+	lockSQL := `SELECT ` + promoCodeColumns + ` FROM promo_codes p WHERE p.code = $1 FOR UPDATE`
+
+	return getPromoCode(querier.QueryRow(ctx, lockSQL, code), code)
+}
+
+func (pr *PromoCodesRepository) CountUserRedemptions(ctx context.Context, querier database.Querier, promoID, userID int) (uint32, error) {
+	countSQL := `SELECT COUNT(*) FROM promo_redemptions WHERE promo_id = $1 AND user_id = $2`
+
+	var count uint32
+	err := querier.QueryRow(ctx, countSQL, promoID, userID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count promo redemptions: %w", err)
+	}
+
+	return count, nil
+}
+
+func (pr *PromoCodesRepository) RecordRedemption(ctx context.Context, executor database.Executor, promoID, userID, goodID int, discount uint32) error {
+	insertSQL := `INSERT INTO promo_redemptions (promo_id, user_id, good_id, discount) VALUES ($1, $2, $3, $4)`
+
+	_, err := executor.Exec(ctx, insertSQL, promoID, userID, goodID, discount)
+	if err != nil {
+		return fmt.Errorf("failed to record promo redemption: %w", err)
+	}
+
+	return nil
+}
+
+func getPromoCode(row pgx.Row, code string) (domain.PromoCode, error) {
+	var promo domain.PromoCode
+
+	err := row.Scan(&promo.Id, &promo.Code, &promo.Kind, &promo.Value, &promo.GoodID, &promo.MaxUses,
+		&promo.MaxUsesPerUser, &promo.ValidFrom, &promo.ValidUntil, &promo.Uses)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.PromoCode{}, &domain.PromoCodeNotFoundError{Msg: fmt.Sprintf("promo code %s not found", code)}
+		}
+
+		return domain.PromoCode{}, fmt.Errorf("failed to get promo code: %w", err)
+	}
+
+	return promo, nil
+}
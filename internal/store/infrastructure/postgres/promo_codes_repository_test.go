@@ -0,0 +1,146 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var promoCodeColumnNames = []string{"id", "code", "kind", "value", "good_id", "max_uses", "max_uses_per_user",
+	"valid_from", "valid_until", "uses"}
+
+func TestPromoCodesRepository_CreatePromoCode(t *testing.T) {
+	t.Parallel()
+
+	validFrom := time.Date(2026, 6, 14, 12, 0, 0, 0, time.UTC)
+	validUntil := validFrom.Add(7 * 24 * time.Hour)
+	promo := domain.PromoCode{Code: "SPRING10", Kind: domain.PromoKindPercent, Value: 10, MaxUsesPerUser: 1,
+		ValidFrom: validFrom, ValidUntil: validUntil}
+
+	type testCase struct {
+		name string
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+
+		expectedID  int
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name: "code created",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("INSERT INTO promo_codes").
+					WithArgs("SPRING10", domain.PromoKindPercent, uint32(10), 0, uint32(0), uint32(1), validFrom, validUntil).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(4))
+			},
+			expectedID: 4,
+		},
+		{
+			name: "code already exists",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("INSERT INTO promo_codes").
+					WithArgs("SPRING10", domain.PromoKindPercent, uint32(10), 0, uint32(0), uint32(1), validFrom, validUntil).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			expectedErr: &domain.PromoCodeExistingError{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			repo := NewPromoCodesRepository(mock)
+			promoID, err := repo.CreatePromoCode(t.Context(), mock, promo)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedID, promoID)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPromoCodesRepository_LockAndGetPromoCode(t *testing.T) {
+	t.Parallel()
+
+	validFrom := time.Date(2026, 6, 14, 12, 0, 0, 0, time.UTC)
+	validUntil := validFrom.Add(7 * 24 * time.Hour)
+
+	type testCase struct {
+		name string
+		code string
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+
+		expectedPromo domain.PromoCode
+		expectedErr   error
+	}
+
+	testCases := []testCase{
+		{
+			name: "code found",
+			code: "CUP5",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				rows := pgxmock.NewRows(promoCodeColumnNames).
+					AddRow(5, "CUP5", domain.PromoKindFixed, uint32(5), 10, uint32(100), uint32(0), validFrom, validUntil, uint32(7))
+				mock.ExpectQuery("SELECT p.id").
+					WithArgs("CUP5").
+					WillReturnRows(rows)
+			},
+			expectedPromo: domain.PromoCode{Id: 5, Code: "CUP5", Kind: domain.PromoKindFixed, Value: 5, GoodID: 10,
+				MaxUses: 100, ValidFrom: validFrom, ValidUntil: validUntil, Uses: 7},
+		},
+		{
+			name: "code not found",
+			code: "NOPE",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("SELECT p.id").
+					WithArgs("NOPE").
+					WillReturnError(pgx.ErrNoRows)
+			},
+			expectedErr: &domain.PromoCodeNotFoundError{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			repo := NewPromoCodesRepository(mock)
+			promo, err := repo.LockAndGetPromoCode(t.Context(), mock, tt.code)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedPromo, promo)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+)
+
+type PurchaseLimitsRepository struct{}
+
+func NewPurchaseLimitsRepository() *PurchaseLimitsRepository {
+	return &PurchaseLimitsRepository{}
+}
+
+func (pr *PurchaseLimitsRepository) SetPurchaseLimit(ctx context.Context, executor database.Executor, limit domain.PurchaseLimit) error {
+	upsertSQL := `INSERT INTO purchase_limits (good_id, max_quantity, period_days) VALUES ($1, $2, $3)
+		ON CONFLICT (good_id) DO UPDATE SET max_quantity = EXCLUDED.max_quantity, period_days = EXCLUDED.period_days`
+
+	_, err := executor.Exec(ctx, upsertSQL, limit.GoodID, limit.MaxQuantity, int(limit.Period.Hours()/24))
+	if err != nil {
+		return fmt.Errorf("failed to set purchase limit: %w", err)
+	}
+
+	return nil
+}
+
+func (pr *PurchaseLimitsRepository) RemovePurchaseLimit(ctx context.Context, executor database.Executor, goodID int) error {
+	deleteSQL := `DELETE FROM purchase_limits WHERE good_id = $1`
+
+	_, err := executor.Exec(ctx, deleteSQL, goodID)
+	if err != nil {
+		return fmt.Errorf("failed to remove purchase limit: %w", err)
+	}
+
+	return nil
+}
+
+func (pr *PurchaseLimitsRepository) ListPurchaseLimits(ctx context.Context, querier database.Querier, goodIDs []int) ([]domain.PurchaseLimit, error) {
+	listSQL := `SELECT pl.good_id, g.name, pl.max_quantity, pl.period_days FROM purchase_limits pl
+		JOIN goods g ON g.id = pl.good_id
+		WHERE pl.good_id = ANY($1)
+		ORDER BY pl.good_id`
+
+	rows, err := querier.Query(ctx, listSQL, goodIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list purchase limits: %w", err)
+	}
+	defer rows.Close()
+
+	limits := make([]domain.PurchaseLimit, 0)
+	for rows.Next() {
+		var limit domain.PurchaseLimit
+		var periodDays int
+		if err := rows.Scan(&limit.GoodID, &limit.GoodName, &limit.MaxQuantity, &periodDays); err != nil {
+			return nil, fmt.Errorf("failed to scan purchase limit: %w", err)
+		}
+
+		limit.Period = time.Duration(periodDays) * 24 * time.Hour
+		limits = append(limits, limit)
+	}
+
+	return limits, rows.Err()
+}
+
+// CountUserAcquisitions counts the units of the good the user got since the given time: the ones bought by or
+// for them, won or got by a fulfilled pre-order, and the ones transferred or sold to them. Units the user passed on
+// still count. Pending pre-orders don't count until they are fulfilled.
+func (pr *PurchaseLimitsRepository) CountUserAcquisitions(ctx context.Context, querier database.Querier, userID, goodID int,
+	since time.Time) (uint32, error) {
+	countSQL := `SELECT
+		(SELECT COUNT(*) FROM purchases WHERE acquired_by = $1 AND good_id = $2 AND purchased_at >= $3) +
+		(SELECT COUNT(*) FROM item_transfers WHERE to_user_id = $1 AND good_id = $2 AND created_at >= $3)`
+
+	var count uint32
+	err := querier.QueryRow(ctx, countSQL, userID, goodID, since).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count user acquisitions: %w", err)
+	}
+
+	return count, nil
+}
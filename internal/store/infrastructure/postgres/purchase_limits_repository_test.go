@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPurchaseLimitsRepository_SetPurchaseLimit(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	mock.ExpectExec("INSERT INTO purchase_limits").
+		WithArgs(2, uint32(3), 90).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+	repo := NewPurchaseLimitsRepository()
+	err = repo.SetPurchaseLimit(t.Context(), mock, domain.PurchaseLimit{GoodID: 2, MaxQuantity: 3, Period: 90 * 24 * time.Hour})
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPurchaseLimitsRepository_ListPurchaseLimits(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	rows := pgxmock.NewRows([]string{"good_id", "name", "max_quantity", "period_days"}).
+		AddRow(2, "cup", uint32(3), 90).
+		AddRow(8, "pink-hoody", uint32(1), 0)
+	mock.ExpectQuery("SELECT pl.good_id, g.name, pl.max_quantity, pl.period_days FROM purchase_limits").
+		WithArgs([]int{2, 8, 10}).
+		WillReturnRows(rows)
+
+	repo := NewPurchaseLimitsRepository()
+	limits, err := repo.ListPurchaseLimits(t.Context(), mock, []int{2, 8, 10})
+
+	require.NoError(t, err)
+	assert.Equal(t, []domain.PurchaseLimit{
+		{GoodID: 2, GoodName: "cup", MaxQuantity: 3, Period: 90 * 24 * time.Hour},
+		{GoodID: 8, GoodName: "pink-hoody", MaxQuantity: 1},
+	}, limits)
+}
+
+func TestPurchaseLimitsRepository_CountUserAcquisitions(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	since := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+	mock.ExpectQuery("SELECT COUNT").
+		WithArgs(1, 2, since).
+		WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(uint32(2)))
+
+	repo := NewPurchaseLimitsRepository()
+	count, err := repo.CountUserAcquisitions(t.Context(), mock, 1, 2, since)
+
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), count)
+}
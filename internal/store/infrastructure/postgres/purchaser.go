@@ -14,6 +14,7 @@ func NewPurchaseHandler() *PurchaseHandler {
 	return &PurchaseHandler{}
 }
 
+// ProcessPurchase charges the user and puts the good, with the picked variant if any, into the user's inventory.
 func (ph *PurchaseHandler) ProcessPurchase(ctx context.Context, executor database.Executor, userId int, good domain.GoodInfo) error {
 	updateBalanceSQL := `UPDATE balances SET balance = balance - $1 WHERE user_id = $2`
 	_, err := executor.Exec(ctx, updateBalanceSQL, good.Price, userId)
@@ -21,11 +22,66 @@ func (ph *PurchaseHandler) ProcessPurchase(ctx context.Context, executor databas
 		return fmt.Errorf("failed to update user balance: %w", err)
 	}
 
-	insertPurchaseSQL := `INSERT INTO purchases (user_id, good_id) VALUES ($1, $2)`
-	_, err = executor.Exec(ctx, insertPurchaseSQL, userId, good.Id)
+	var variantID *int
+	if good.VariantID != 0 {
+		variantID = &good.VariantID
+	}
+
+	insertPurchaseSQL := `INSERT INTO purchases (user_id, acquired_by, good_id, variant_id) VALUES ($1, $1, $2, $3)`
+	_, err = executor.Exec(ctx, insertPurchaseSQL, userId, good.Id, variantID)
 	if err != nil {
 		return fmt.Errorf("failed to insert purchase record: %w", err)
 	}
 
 	return nil
 }
+
+// ProcessBundlePurchase charges the user the bundle price once and puts every unit of the components into the
+// user's inventory, marked as coming from the bundle.
+func (ph *PurchaseHandler) ProcessBundlePurchase(ctx context.Context, executor database.Executor, userID int,
+	bundle domain.GoodInfo, components []domain.BundleComponent) error {
+	updateBalanceSQL := `UPDATE balances SET balance = balance - $1 WHERE user_id = $2`
+	_, err := executor.Exec(ctx, updateBalanceSQL, bundle.Price, userID)
+	if err != nil {
+		return fmt.Errorf("failed to update user balance: %w", err)
+	}
+
+	insertPurchasesSQL := `INSERT INTO purchases (user_id, acquired_by, good_id, variant_id, bundle_id)
+		SELECT $1, $1, $2, $3, $4 FROM generate_series(1, $5)`
+	for _, component := range components {
+		var variantID *int
+		if component.VariantID != 0 {
+			variantID = &component.VariantID
+		}
+
+		_, err = executor.Exec(ctx, insertPurchasesSQL, userID, component.GoodID, variantID, bundle.Id, component.Quantity)
+		if err != nil {
+			return fmt.Errorf("failed to insert bundle purchase records: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// ProcessGift charges the buyer and puts the good, with the picked variant if any, into the recipient's inventory.
+func (ph *PurchaseHandler) ProcessGift(ctx context.Context, executor database.Executor, buyerID, recipientID int, good domain.GoodInfo, message string) error {
+	updateBalanceSQL := `UPDATE balances SET balance = balance - $1 WHERE user_id = $2`
+	_, err := executor.Exec(ctx, updateBalanceSQL, good.Price, buyerID)
+	if err != nil {
+		return fmt.Errorf("failed to update user balance: %w", err)
+	}
+
+	var variantID *int
+	if good.VariantID != 0 {
+		variantID = &good.VariantID
+	}
+
+	insertPurchaseSQL := `INSERT INTO purchases (user_id, acquired_by, good_id, variant_id, gifted_by, gift_message)
+		VALUES ($1, $1, $2, $3, $4, $5)`
+	_, err = executor.Exec(ctx, insertPurchaseSQL, recipientID, good.Id, variantID, buyerID, message)
+	if err != nil {
+		return fmt.Errorf("failed to insert gift record: %w", err)
+	}
+
+	return nil
+}
@@ -33,7 +33,23 @@ func TestPurchaseHandler_ProcessPurchase(t *testing.T) {
 					WithArgs(uint32(20), 1).
 					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
 				mock.ExpectExec("INSERT").
-					WithArgs(1, 10).
+					WithArgs(1, 10, (*int)(nil)).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+			},
+			expectedErr: nil,
+		},
+		{
+			name:   "successful purchase of a variant",
+			userId: 1,
+			good:   domain.GoodInfo{Id: 1, Name: "t-shirt", Price: 80, VariantID: 2},
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				variantID := 2
+				mock.ExpectExec("UPDATE").
+					WithArgs(uint32(80), 1).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectExec("INSERT").
+					WithArgs(1, 1, &variantID).
 					WillReturnResult(pgxmock.NewResult("INSERT", 1))
 			},
 			expectedErr: nil,
@@ -60,7 +76,7 @@ func TestPurchaseHandler_ProcessPurchase(t *testing.T) {
 					WithArgs(uint32(20), 1).
 					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
 				mock.ExpectExec("INSERT").
-					WithArgs(1, 10).
+					WithArgs(1, 10, (*int)(nil)).
 					WillReturnError(assert.AnError)
 			},
 			expectedErr: assert.AnError,
@@ -89,3 +105,138 @@ func TestPurchaseHandler_ProcessPurchase(t *testing.T) {
 		})
 	}
 }
+
+func TestPurchaseHandler_ProcessGift(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name        string
+		buyerID     int
+		recipientID int
+		good        domain.GoodInfo
+		message     string
+
+		expectedErr error
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+	}
+
+	variantID := 3
+
+	tests := []testCase{
+		{
+			name:        "successful gift",
+			buyerID:     1,
+			recipientID: 2,
+			good:        domain.GoodInfo{Id: 10, Name: "cup", Price: 20},
+			message:     "thanks",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("UPDATE").
+					WithArgs(uint32(20), 1).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectExec("INSERT").
+					WithArgs(2, 10, (*int)(nil), 1, "thanks").
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+			},
+			expectedErr: nil,
+		},
+		{
+			name:        "successful variant gift",
+			buyerID:     1,
+			recipientID: 2,
+			good:        domain.GoodInfo{Id: 10, Name: "cup", Price: 25, VariantID: variantID, Variant: "cup-large"},
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("UPDATE").
+					WithArgs(uint32(25), 1).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectExec("INSERT INTO purchases").
+					WithArgs(2, 10, &variantID, 1, "").
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+			},
+			expectedErr: nil,
+		},
+		{
+			name:        "failed to update balance",
+			buyerID:     1,
+			recipientID: 2,
+			good:        domain.GoodInfo{Id: 10, Name: "cup", Price: 20},
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("UPDATE").
+					WithArgs(uint32(20), 1).
+					WillReturnError(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+		{
+			name:        "failed to insert gift",
+			buyerID:     1,
+			recipientID: 2,
+			good:        domain.GoodInfo{Id: 10, Name: "cup", Price: 20},
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("UPDATE").
+					WithArgs(uint32(20), 1).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectExec("INSERT").
+					WithArgs(2, 10, (*int)(nil), 1, "").
+					WillReturnError(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			purchaseHandler := NewPurchaseHandler()
+			err = purchaseHandler.ProcessGift(t.Context(), mock, tt.buyerID, tt.recipientID, tt.good, tt.message)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPurchaseHandler_ProcessBundlePurchase(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	variantID := 4
+	mock.ExpectExec("UPDATE balances").
+		WithArgs(uint32(90), 1).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	mock.ExpectExec("INSERT INTO purchases").
+		WithArgs(1, 2, (*int)(nil), 30, uint32(2)).
+		WillReturnResult(pgxmock.NewResult("INSERT", 2))
+	mock.ExpectExec("INSERT INTO purchases").
+		WithArgs(1, 10, &variantID, 30, uint32(1)).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+	purchaseHandler := NewPurchaseHandler()
+	err = purchaseHandler.ProcessBundlePurchase(t.Context(), mock, 1,
+		domain.GoodInfo{Id: 30, Name: "onboarding-kit", Price: 90, Bundle: true},
+		[]domain.BundleComponent{
+			{BundleID: 30, GoodID: 2, GoodName: "cup", Quantity: 2},
+			{BundleID: 30, GoodID: 10, GoodName: "t-shirt", Quantity: 1, VariantID: 4},
+		})
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
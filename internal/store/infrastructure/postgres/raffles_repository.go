@@ -0,0 +1,251 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+const raffleColumns = `r.id, r.good_id, g.name, r.ticket_price, r.draw_at, r.status,
+	(SELECT COUNT(*) FROM raffle_tickets t WHERE t.raffle_id = r.id)`
+
+const raffleTables = `raffles r JOIN goods g ON r.good_id = g.id`
+
+type RafflesRepository struct {
+	queryExecuter database.QueryExecuter
+}
+
+func NewRafflesRepository(queryExecuter database.QueryExecuter) *RafflesRepository {
+	return &RafflesRepository{
+		queryExecuter: queryExecuter,
+	}
+}
+
+func (rr *RafflesRepository) CreateRaffle(ctx context.Context, querier database.Querier, raffle domain.Raffle) (int, error) {
+	insertSQL := `INSERT INTO raffles (good_id, ticket_price, draw_at) VALUES ($1, $2, $3) RETURNING id`
+
+	var raffleID int
+	err := querier.QueryRow(ctx, insertSQL, raffle.GoodID, raffle.TicketPrice, raffle.DrawAt).Scan(&raffleID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create raffle: %w", err)
+	}
+
+	return raffleID, nil
+}
+
+func (rr *RafflesRepository) GetRaffle(ctx context.Context, raffleID int) (domain.Raffle, error) {
+	getSQL := `SELECT ` + raffleColumns + ` FROM ` + raffleTables + ` WHERE r.id = $1`
+
+	return getRaffle(rr.queryExecuter.QueryRow(ctx, getSQL, raffleID), raffleID)
+}
+
+// ListOpenRaffles returns the raffles still selling tickets, the ones drawn soonest first.
+func (rr *RafflesRepository) ListOpenRaffles(ctx context.Context) ([]domain.Raffle, error) {
+	listSQL := `SELECT ` + raffleColumns + ` FROM ` + raffleTables + `
+		WHERE r.status = $1 AND r.draw_at > NOW()
+		ORDER BY r.draw_at, r.id`
+
+	rows, err := rr.queryExecuter.Query(ctx, listSQL, domain.RaffleStatusOpen)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list raffles: %w", err)
+	}
+	defer rows.Close()
+
+	raffles := make([]domain.Raffle, 0)
+	for rows.Next() {
+		raffle, err := scanRaffle(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan raffle: %w", err)
+		}
+
+		raffles = append(raffles, raffle)
+	}
+
+	return raffles, rows.Err()
+}
+
+func (rr *RafflesRepository) LockAndGetRaffle(ctx context.Context, querier database.Querier, raffleID int) (domain.Raffle, error) {
+	lockSQL := `SELECT ` + raffleColumns + ` FROM ` + raffleTables + ` WHERE r.id = $1 FOR UPDATE OF r`
+
+	return getRaffle(querier.QueryRow(ctx, lockSQL, raffleID), raffleID)
+}
+
+// IssueTickets charges the user totalPrice and adds count tickets in their name.
+func (rr *RafflesRepository) IssueTickets(ctx context.Context, executor database.Executor, raffleID, userID int, count, totalPrice uint32) error {
+	updateBalanceSQL := `UPDATE balances SET balance = balance - $1 WHERE user_id = $2`
+	_, err := executor.Exec(ctx, updateBalanceSQL, totalPrice, userID)
+	if err != nil {
+		return fmt.Errorf("failed to update user balance: %w", err)
+	}
+
+	insertSQL := `INSERT INTO raffle_tickets (raffle_id, user_id) SELECT $1, $2 FROM generate_series(1, $3)`
+	_, err = executor.Exec(ctx, insertSQL, raffleID, userID, count)
+	if err != nil {
+		return fmt.Errorf("failed to insert tickets: %w", err)
+	}
+
+	return nil
+}
+
+func (rr *RafflesRepository) RefundUserTickets(ctx context.Context, executor database.QueryExecuter, userID int) (uint32, error) {
+	lockSQL := `SELECT r.id FROM raffles r
+		WHERE r.status = $2 AND EXISTS (SELECT 1 FROM raffle_tickets t WHERE t.raffle_id = r.id AND t.user_id = $1)
+		ORDER BY r.id
+		FOR UPDATE`
+
+	rows, err := executor.Query(ctx, lockSQL, userID, domain.RaffleStatusOpen)
+	if err != nil {
+		return 0, fmt.Errorf("failed to lock raffles: %w", err)
+	}
+	rows.Close()
+
+	deleteSQL := `WITH refunded AS (
+			DELETE FROM raffle_tickets t USING raffles r
+			WHERE t.raffle_id = r.id AND t.user_id = $1 AND r.status = $2
+			RETURNING r.ticket_price
+		)
+		SELECT COALESCE(SUM(ticket_price), 0) FROM refunded`
+
+	var refunded int64
+	err = executor.QueryRow(ctx, deleteSQL, userID, domain.RaffleStatusOpen).Scan(&refunded)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete tickets: %w", err)
+	}
+
+	if refunded == 0 {
+		return 0, nil
+	}
+
+	updateBalanceSQL := `UPDATE balances SET balance = balance + $1 WHERE user_id = $2`
+	_, err = executor.Exec(ctx, updateBalanceSQL, refunded, userID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to update user balance: %w", err)
+	}
+
+	return uint32(min(refunded, math.MaxUint32)), nil
+}
+
+func (rr *RafflesRepository) FetchDueRaffles(ctx context.Context, now time.Time, limit int) ([]int, error) {
+	dueSQL := `SELECT id FROM raffles
+		WHERE status = $1 AND draw_at <= $2
+		ORDER BY draw_at
+		LIMIT $3`
+
+	rows, err := rr.queryExecuter.Query(ctx, dueSQL, domain.RaffleStatusOpen, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch due raffles: %w", err)
+	}
+	defer rows.Close()
+
+	raffleIDs := make([]int, 0)
+	for rows.Next() {
+		var raffleID int
+		if err := rows.Scan(&raffleID); err != nil {
+			return nil, fmt.Errorf("failed to scan raffle id: %w", err)
+		}
+
+		raffleIDs = append(raffleIDs, raffleID)
+	}
+
+	return raffleIDs, rows.Err()
+}
+
+// FetchTickets returns the tickets of a raffle ordered by id, the order the winning index is taken from.
+func (rr *RafflesRepository) FetchTickets(ctx context.Context, querier database.Querier, raffleID int) ([]domain.RaffleTicket, error) {
+	ticketsSQL := `SELECT id, user_id FROM raffle_tickets WHERE raffle_id = $1 ORDER BY id`
+
+	rows, err := querier.Query(ctx, ticketsSQL, raffleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch tickets: %w", err)
+	}
+	defer rows.Close()
+
+	tickets := make([]domain.RaffleTicket, 0)
+	for rows.Next() {
+		var ticket domain.RaffleTicket
+		if err := rows.Scan(&ticket.Id, &ticket.UserID); err != nil {
+			return nil, fmt.Errorf("failed to scan ticket: %w", err)
+		}
+
+		tickets = append(tickets, ticket)
+	}
+
+	return tickets, rows.Err()
+}
+
+// CompleteDraw puts the item into the winner's inventory and stores the draw with the raffle.
+func (rr *RafflesRepository) CompleteDraw(ctx context.Context, executor database.Executor, raffle domain.Raffle, draw domain.RaffleDraw) error {
+	insertPurchaseSQL := `INSERT INTO purchases (user_id, acquired_by, good_id) VALUES ($1, $1, $2)`
+	_, err := executor.Exec(ctx, insertPurchaseSQL, draw.WinnerID, raffle.GoodID)
+	if err != nil {
+		return fmt.Errorf("failed to insert purchase: %w", err)
+	}
+
+	updateRaffleSQL := `UPDATE raffles SET status = $2, seed = $3, winning_ticket_id = $4, winner_id = $5, drawn_at = NOW()
+		WHERE id = $1`
+	_, err = executor.Exec(ctx, updateRaffleSQL, raffle.Id, domain.RaffleStatusDrawn, draw.Seed, draw.TicketID,
+		draw.WinnerID)
+	if err != nil {
+		return fmt.Errorf("failed to update raffle: %w", err)
+	}
+
+	return nil
+}
+
+// RefundTickets deletes the tickets, so the winning index of the draw is taken from the tickets left.
+func (rr *RafflesRepository) RefundTickets(ctx context.Context, executor database.Executor, raffle domain.Raffle, userID int) error {
+	deleteSQL := `DELETE FROM raffle_tickets WHERE raffle_id = $1 AND user_id = $2`
+	tag, err := executor.Exec(ctx, deleteSQL, raffle.Id, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete tickets: %w", err)
+	}
+
+	updateBalanceSQL := `UPDATE balances SET balance = balance + $1 WHERE user_id = $2`
+	_, err = executor.Exec(ctx, updateBalanceSQL, int64(raffle.TicketPrice)*tag.RowsAffected(), userID)
+	if err != nil {
+		return fmt.Errorf("failed to update user balance: %w", err)
+	}
+
+	return nil
+}
+
+func (rr *RafflesRepository) CloseVoidRaffle(ctx context.Context, executor database.Executor, raffleID int) error {
+	updateSQL := `UPDATE raffles SET status = $2, drawn_at = NOW() WHERE id = $1`
+
+	tag, err := executor.Exec(ctx, updateSQL, raffleID, domain.RaffleStatusVoid)
+	if err != nil {
+		return fmt.Errorf("failed to update raffle: %w", err)
+	} else if tag.RowsAffected() == 0 {
+		return &domain.RaffleNotFoundError{Msg: fmt.Sprintf("raffle %d not found", raffleID)}
+	}
+
+	return nil
+}
+
+func getRaffle(row pgx.Row, raffleID int) (domain.Raffle, error) {
+	raffle, err := scanRaffle(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Raffle{}, &domain.RaffleNotFoundError{Msg: fmt.Sprintf("raffle %d not found", raffleID)}
+		}
+
+		return domain.Raffle{}, fmt.Errorf("failed to get raffle: %w", err)
+	}
+
+	return raffle, nil
+}
+
+func scanRaffle(row pgx.Row) (domain.Raffle, error) {
+	var raffle domain.Raffle
+
+	err := row.Scan(&raffle.Id, &raffle.GoodID, &raffle.GoodName, &raffle.TicketPrice, &raffle.DrawAt, &raffle.Status,
+		&raffle.TicketsSold)
+
+	return raffle, err
+}
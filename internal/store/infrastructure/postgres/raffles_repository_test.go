@@ -0,0 +1,278 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var raffleColumnNames = []string{"id", "good_id", "name", "ticket_price", "draw_at", "status", "tickets_sold"}
+
+func TestRafflesRepository_LockAndGetRaffle(t *testing.T) {
+	t.Parallel()
+
+	drawAt := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	type testCase struct {
+		name     string
+		raffleID int
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+
+		expectedRaffle domain.Raffle
+		expectedErr    error
+	}
+
+	testCases := []testCase{
+		{
+			name:     "raffle found",
+			raffleID: 6,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				rows := pgxmock.NewRows(raffleColumnNames).
+					AddRow(6, 10, "pink-hoody", uint32(5), drawAt, domain.RaffleStatusOpen, uint32(12))
+				mock.ExpectQuery("SELECT r.id").
+					WithArgs(6).
+					WillReturnRows(rows)
+			},
+			expectedRaffle: domain.Raffle{Id: 6, GoodID: 10, GoodName: "pink-hoody", TicketPrice: 5, DrawAt: drawAt,
+				Status: domain.RaffleStatusOpen, TicketsSold: 12},
+		},
+		{
+			name:     "raffle not found",
+			raffleID: 42,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("SELECT r.id").
+					WithArgs(42).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			expectedErr: &domain.RaffleNotFoundError{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			repo := NewRafflesRepository(mock)
+			raffle, err := repo.LockAndGetRaffle(t.Context(), mock, tt.raffleID)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedRaffle, raffle)
+			}
+		})
+	}
+}
+
+func TestRafflesRepository_IssueTickets(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name string
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name: "tickets issued",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("UPDATE balances").
+					WithArgs(uint32(15), 1).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectExec("INSERT INTO raffle_tickets").
+					WithArgs(6, 1, uint32(3)).
+					WillReturnResult(pgxmock.NewResult("INSERT", 3))
+			},
+		},
+		{
+			name: "failed to update balance",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("UPDATE balances").
+					WithArgs(uint32(15), 1).
+					WillReturnError(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+		{
+			name: "failed to insert tickets",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("UPDATE balances").
+					WithArgs(uint32(15), 1).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectExec("INSERT INTO raffle_tickets").
+					WithArgs(6, 1, uint32(3)).
+					WillReturnError(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			repo := NewRafflesRepository(mock)
+			err = repo.IssueTickets(t.Context(), mock, 6, 1, 3, 15)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRafflesRepository_FetchTickets(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	mock.ExpectQuery("SELECT id, user_id FROM raffle_tickets").
+		WithArgs(6).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "user_id"}).AddRow(11, 2).AddRow(12, 3))
+
+	repo := NewRafflesRepository(mock)
+	tickets, err := repo.FetchTickets(t.Context(), mock, 6)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.RaffleTicket{{Id: 11, UserID: 2}, {Id: 12, UserID: 3}}, tickets)
+}
+
+func TestRafflesRepository_CompleteDraw(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	raffle := domain.Raffle{Id: 6, GoodID: 10}
+	draw := domain.RaffleDraw{Seed: "ab12", TicketID: 12, WinnerID: 3}
+
+	mock.ExpectExec("INSERT INTO purchases").
+		WithArgs(3, 10).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectExec("UPDATE raffles").
+		WithArgs(6, domain.RaffleStatusDrawn, "ab12", 12, 3).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+	repo := NewRafflesRepository(mock)
+	err = repo.CompleteDraw(t.Context(), mock, raffle, draw)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRafflesRepository_RefundTickets(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	raffle := domain.Raffle{Id: 6, GoodID: 10, TicketPrice: 5}
+
+	mock.ExpectExec("DELETE FROM raffle_tickets").
+		WithArgs(6, 2).
+		WillReturnResult(pgxmock.NewResult("DELETE", 3))
+	mock.ExpectExec("UPDATE balances SET balance = balance \\+ \\$1").
+		WithArgs(int64(15), 2).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+	repo := NewRafflesRepository(mock)
+	err = repo.RefundTickets(t.Context(), mock, raffle, 2)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRafflesRepository_RefundUserTickets(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name string
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+
+		expectedRefunded uint32
+	}
+
+	testCases := []testCase{
+		{
+			name: "tickets refunded",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("SELECT r.id FROM raffles r").
+					WithArgs(2, domain.RaffleStatusOpen).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(6).AddRow(9))
+				mock.ExpectQuery("DELETE FROM raffle_tickets").
+					WithArgs(2, domain.RaffleStatusOpen).
+					WillReturnRows(pgxmock.NewRows([]string{"sum"}).AddRow(int64(25)))
+				mock.ExpectExec("UPDATE balances SET balance = balance \\+ \\$1").
+					WithArgs(int64(25), 2).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+			},
+			expectedRefunded: 25,
+		},
+		{
+			name: "no tickets in open raffles",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("SELECT r.id FROM raffles r").
+					WithArgs(2, domain.RaffleStatusOpen).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}))
+				mock.ExpectQuery("DELETE FROM raffle_tickets").
+					WithArgs(2, domain.RaffleStatusOpen).
+					WillReturnRows(pgxmock.NewRows([]string{"sum"}).AddRow(int64(0)))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			repo := NewRafflesRepository(mock)
+			refunded, err := repo.RefundUserTickets(t.Context(), mock, 2)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedRefunded, refunded)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
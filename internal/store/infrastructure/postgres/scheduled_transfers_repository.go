@@ -0,0 +1,172 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+const scheduledTransferColumns = `id, sender_id, recipient_id, amount, recurrence, status, starts_at, next_run_at, next_attempt_at,
+	attempts, last_error, created_at`
+
+type ScheduledTransfersRepository struct {
+	queryExecuter database.QueryExecuter
+}
+
+func NewScheduledTransfersRepository(queryExecuter database.QueryExecuter) *ScheduledTransfersRepository {
+	return &ScheduledTransfersRepository{
+		queryExecuter: queryExecuter,
+	}
+}
+
+func (sr *ScheduledTransfersRepository) CreateScheduledTransfer(ctx context.Context, transfer domain.ScheduledTransfer) (int, error) {
+	insertSQL := `INSERT INTO scheduled_transfers (sender_id, recipient_id, amount, recurrence, starts_at, next_run_at,
+		next_attempt_at) VALUES ($1, $2, $3, $4, $5, $5, $5) RETURNING id`
+
+	var transferID int
+	err := sr.queryExecuter.QueryRow(ctx, insertSQL, transfer.SenderID, transfer.RecipientID, transfer.Amount,
+		transfer.Recurrence, transfer.NextRunAt).Scan(&transferID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create scheduled transfer: %w", err)
+	}
+
+	return transferID, nil
+}
+
+func (sr *ScheduledTransfersRepository) CountActiveScheduledTransfers(ctx context.Context, senderID int) (int, error) {
+	countSQL := `SELECT COUNT(*) FROM scheduled_transfers WHERE sender_id = $1 AND status = $2`
+
+	var count int
+	err := sr.queryExecuter.QueryRow(ctx, countSQL, senderID, domain.ScheduledTransferStatusActive).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count scheduled transfers: %w", err)
+	}
+
+	return count, nil
+}
+
+// ListScheduledTransfers returns the sender's active transfers and the one-off transfers that ran out of retries.
+func (sr *ScheduledTransfersRepository) ListScheduledTransfers(ctx context.Context, senderID int) ([]domain.ScheduledTransfer, error) {
+	listSQL := `SELECT ` + scheduledTransferColumns + `
+		FROM scheduled_transfers
+		WHERE sender_id = $1 AND status IN ($2, $3)
+		ORDER BY next_run_at`
+
+	rows, err := sr.queryExecuter.Query(ctx, listSQL, senderID, domain.ScheduledTransferStatusActive,
+		domain.ScheduledTransferStatusFailed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scheduled transfers: %w", err)
+	}
+	defer rows.Close()
+
+	transfers := make([]domain.ScheduledTransfer, 0)
+	for rows.Next() {
+		transfer, err := scanScheduledTransfer(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan scheduled transfer: %w", err)
+		}
+
+		transfers = append(transfers, transfer)
+	}
+
+	return transfers, rows.Err()
+}
+
+// CancelScheduledTransfer cancels an active transfer of the sender. Transfers of other users are reported as missing.
+func (sr *ScheduledTransfersRepository) CancelScheduledTransfer(ctx context.Context, senderID, transferID int) error {
+	cancelSQL := `UPDATE scheduled_transfers SET status = $3 WHERE id = $1 AND sender_id = $2 AND status = $4`
+
+	tag, err := sr.queryExecuter.Exec(ctx, cancelSQL, transferID, senderID, domain.ScheduledTransferStatusCancelled,
+		domain.ScheduledTransferStatusActive)
+	if err != nil {
+		return fmt.Errorf("failed to cancel scheduled transfer: %w", err)
+	} else if tag.RowsAffected() == 0 {
+		return &domain.ScheduledTransferNotFoundError{Msg: fmt.Sprintf("scheduled transfer %d not found", transferID)}
+	}
+
+	return nil
+}
+
+func (sr *ScheduledTransfersRepository) CancelSenderScheduledTransfers(ctx context.Context, executor database.Executor, senderID int) (int, error) {
+	cancelSQL := `UPDATE scheduled_transfers SET status = $2 WHERE sender_id = $1 AND status = $3`
+
+	tag, err := executor.Exec(ctx, cancelSQL, senderID, domain.ScheduledTransferStatusCancelled,
+		domain.ScheduledTransferStatusActive)
+	if err != nil {
+		return 0, fmt.Errorf("failed to cancel scheduled transfers: %w", err)
+	}
+
+	return int(tag.RowsAffected()), nil
+}
+
+func (sr *ScheduledTransfersRepository) FetchDueScheduledTransfers(ctx context.Context, now time.Time, limit int) ([]int, error) {
+	dueSQL := `SELECT id FROM scheduled_transfers
+		WHERE status = $1 AND next_attempt_at <= $2
+		ORDER BY next_attempt_at
+		LIMIT $3`
+
+	rows, err := sr.queryExecuter.Query(ctx, dueSQL, domain.ScheduledTransferStatusActive, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch due scheduled transfers: %w", err)
+	}
+	defer rows.Close()
+
+	transferIDs := make([]int, 0)
+	for rows.Next() {
+		var transferID int
+		if err := rows.Scan(&transferID); err != nil {
+			return nil, fmt.Errorf("failed to scan scheduled transfer id: %w", err)
+		}
+
+		transferIDs = append(transferIDs, transferID)
+	}
+
+	return transferIDs, rows.Err()
+}
+
+func (sr *ScheduledTransfersRepository) LockAndGetScheduledTransfer(ctx context.Context, querier database.Querier, transferID int) (domain.ScheduledTransfer, error) {
+	lockSQL := `SELECT ` + scheduledTransferColumns + ` FROM scheduled_transfers WHERE id = $1 FOR UPDATE`
+
+	transfer, err := scanScheduledTransfer(querier.QueryRow(ctx, lockSQL, transferID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ScheduledTransfer{}, &domain.ScheduledTransferNotFoundError{Msg: fmt.Sprintf("scheduled transfer %d not found", transferID)}
+		}
+
+		return domain.ScheduledTransfer{}, fmt.Errorf("failed to lock scheduled transfer: %w", err)
+	}
+
+	return transfer, nil
+}
+
+// UpdateScheduledTransfer stores the outcome of a run: the status, the next run and the retry state.
+func (sr *ScheduledTransfersRepository) UpdateScheduledTransfer(ctx context.Context, executor database.Executor, transfer domain.ScheduledTransfer) error {
+	updateSQL := `UPDATE scheduled_transfers
+		SET status = $2, next_run_at = $3, next_attempt_at = $4, attempts = $5, last_error = $6
+		WHERE id = $1`
+
+	tag, err := executor.Exec(ctx, updateSQL, transfer.Id, transfer.Status, transfer.NextRunAt, transfer.NextAttemptAt,
+		transfer.Attempts, transfer.LastError)
+	if err != nil {
+		return fmt.Errorf("failed to update scheduled transfer: %w", err)
+	} else if tag.RowsAffected() == 0 {
+		return &domain.ScheduledTransferNotFoundError{Msg: fmt.Sprintf("scheduled transfer %d not found", transfer.Id)}
+	}
+
+	return nil
+}
+
+func scanScheduledTransfer(row pgx.Row) (domain.ScheduledTransfer, error) {
+	var transfer domain.ScheduledTransfer
+
+	err := row.Scan(&transfer.Id, &transfer.SenderID, &transfer.RecipientID, &transfer.Amount, &transfer.Recurrence,
+		&transfer.Status, &transfer.StartsAt, &transfer.NextRunAt, &transfer.NextAttemptAt, &transfer.Attempts, &transfer.LastError,
+		&transfer.CreatedAt)
+
+	return transfer, err
+}
@@ -0,0 +1,247 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var scheduledTransferColumnNames = []string{"id", "sender_id", "recipient_id", "amount", "recurrence", "status",
+	"starts_at", "next_run_at", "next_attempt_at", "attempts", "last_error", "created_at"}
+
+func TestScheduledTransfersRepository_CreateScheduledTransfer(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	runAt := time.Date(2026, 4, 27, 9, 0, 0, 0, time.UTC)
+	mock.ExpectQuery("INSERT INTO scheduled_transfers").
+		WithArgs(1, 2, uint32(50), domain.RecurrenceWeekly, runAt).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(3))
+
+	repo := NewScheduledTransfersRepository(mock)
+	transferID, err := repo.CreateScheduledTransfer(t.Context(), domain.ScheduledTransfer{
+		SenderID:    1,
+		RecipientID: 2,
+		Amount:      50,
+		Recurrence:  domain.RecurrenceWeekly,
+		NextRunAt:   runAt,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, transferID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestScheduledTransfersRepository_ListScheduledTransfers(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	runAt := time.Date(2026, 4, 27, 9, 0, 0, 0, time.UTC)
+	createdAt := runAt.Add(-24 * time.Hour)
+
+	rows := pgxmock.NewRows(scheduledTransferColumnNames).
+		AddRow(3, 1, 2, uint32(50), domain.RecurrenceWeekly, domain.ScheduledTransferStatusActive, runAt, runAt, runAt, 0, "",
+			createdAt)
+	mock.ExpectQuery("SELECT id, sender_id").
+		WithArgs(1, domain.ScheduledTransferStatusActive, domain.ScheduledTransferStatusFailed).
+		WillReturnRows(rows)
+
+	repo := NewScheduledTransfersRepository(mock)
+	transfers, err := repo.ListScheduledTransfers(t.Context(), 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, []domain.ScheduledTransfer{{Id: 3, SenderID: 1, RecipientID: 2, Amount: 50,
+		Recurrence: domain.RecurrenceWeekly, Status: domain.ScheduledTransferStatusActive, StartsAt: runAt,
+		NextRunAt: runAt, NextAttemptAt: runAt, CreatedAt: createdAt}}, transfers)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestScheduledTransfersRepository_CancelScheduledTransfer(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name         string
+		rowsAffected int64
+
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name:         "transfer cancelled",
+			rowsAffected: 1,
+		},
+		{
+			name:         "transfer not found or not active",
+			rowsAffected: 0,
+			expectedErr:  &domain.ScheduledTransferNotFoundError{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			mock.ExpectExec("UPDATE scheduled_transfers").
+				WithArgs(3, 1, domain.ScheduledTransferStatusCancelled, domain.ScheduledTransferStatusActive).
+				WillReturnResult(pgxmock.NewResult("UPDATE", tt.rowsAffected))
+
+			repo := NewScheduledTransfersRepository(mock)
+			err = repo.CancelScheduledTransfer(t.Context(), 1, 3)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestScheduledTransfersRepository_FetchDueScheduledTransfers(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	now := time.Date(2026, 4, 27, 9, 0, 0, 0, time.UTC)
+	mock.ExpectQuery("SELECT id FROM scheduled_transfers").
+		WithArgs(domain.ScheduledTransferStatusActive, now, 10).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(3).AddRow(4))
+
+	repo := NewScheduledTransfersRepository(mock)
+	transferIDs, err := repo.FetchDueScheduledTransfers(t.Context(), now, 10)
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{3, 4}, transferIDs)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestScheduledTransfersRepository_LockAndGetScheduledTransfer(t *testing.T) {
+	t.Parallel()
+
+	runAt := time.Date(2026, 4, 27, 9, 0, 0, 0, time.UTC)
+
+	type testCase struct {
+		name       string
+		transferID int
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+
+		expectedTransfer domain.ScheduledTransfer
+		expectedErr      error
+	}
+
+	testCases := []testCase{
+		{
+			name:       "transfer found",
+			transferID: 3,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				rows := pgxmock.NewRows(scheduledTransferColumnNames).
+					AddRow(3, 1, 2, uint32(50), domain.RecurrenceOnce, domain.ScheduledTransferStatusActive, runAt,
+						runAt, runAt.Add(time.Hour), 1, "insufficient balance", runAt)
+				mock.ExpectQuery("SELECT id, sender_id").
+					WithArgs(3).
+					WillReturnRows(rows)
+			},
+			expectedTransfer: domain.ScheduledTransfer{Id: 3, SenderID: 1, RecipientID: 2, Amount: 50,
+				Recurrence: domain.RecurrenceOnce, Status: domain.ScheduledTransferStatusActive, StartsAt: runAt,
+				NextRunAt: runAt, NextAttemptAt: runAt.Add(time.Hour), Attempts: 1, LastError: "insufficient balance", CreatedAt: runAt},
+		},
+		{
+			name:       "transfer not found",
+			transferID: 42,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("SELECT id, sender_id").
+					WithArgs(42).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			expectedErr: &domain.ScheduledTransferNotFoundError{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			repo := NewScheduledTransfersRepository(mock)
+			transfer, err := repo.LockAndGetScheduledTransfer(t.Context(), mock, tt.transferID)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedTransfer, transfer)
+			}
+		})
+	}
+}
+
+func TestScheduledTransfersRepository_UpdateScheduledTransfer(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	runAt := time.Date(2026, 4, 27, 9, 0, 0, 0, time.UTC)
+	mock.ExpectExec("UPDATE scheduled_transfers").
+		WithArgs(3, domain.ScheduledTransferStatusActive, runAt, runAt.Add(time.Hour), 1, "insufficient balance").
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+	repo := NewScheduledTransfersRepository(mock)
+	err = repo.UpdateScheduledTransfer(t.Context(), mock, domain.ScheduledTransfer{
+		Id:            3,
+		Status:        domain.ScheduledTransferStatusActive,
+		NextRunAt:     runAt,
+		NextAttemptAt: runAt.Add(time.Hour),
+		Attempts:      1,
+		LastError:     "insufficient balance",
+	})
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestScheduledTransfersRepository_CancelSenderScheduledTransfers(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	mock.ExpectExec("UPDATE scheduled_transfers").
+		WithArgs(2, domain.ScheduledTransferStatusCancelled, domain.ScheduledTransferStatusActive).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 2))
+
+	repo := NewScheduledTransfersRepository(mock)
+	cancelled, err := repo.CancelSenderScheduledTransfers(t.Context(), mock, 2)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, cancelled)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
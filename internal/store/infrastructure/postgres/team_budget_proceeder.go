@@ -0,0 +1,47 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+)
+
+const teamRewardReason = "reward"
+
+type TeamBudgetProceeder struct{}
+
+func NewTeamBudgetProceeder() *TeamBudgetProceeder {
+	return &TeamBudgetProceeder{}
+}
+
+func (tp *TeamBudgetProceeder) ProceedTeamTransfer(ctx context.Context, executor database.Executor, teamID int, amount uint32, managerID, toUserID int) error {
+	updateBudgetSQL := `UPDATE teams SET budget = budget - $1 WHERE id = $2 AND budget >= $1`
+	tag, err := executor.Exec(ctx, updateBudgetSQL, amount, teamID)
+	if err != nil {
+		return fmt.Errorf("failed to update team budget: %w", err)
+	} else if tag.RowsAffected() == 0 {
+		return &domain.InsufficientBalanceError{}
+	}
+
+	updateBalanceSQL := `UPDATE balances SET balance = balance + $1 WHERE user_id = $2`
+	_, err = executor.Exec(ctx, updateBalanceSQL, amount, toUserID)
+	if err != nil {
+		return fmt.Errorf("failed to update balance for toUser: %w", err)
+	}
+
+	insertLedgerSQL := `INSERT INTO team_budget_ledger (team_id, user_id, amount, reason) VALUES ($1, $2, $3, $4)`
+	_, err = executor.Exec(ctx, insertLedgerSQL, teamID, toUserID, amount, teamRewardReason)
+	if err != nil {
+		return fmt.Errorf("failed to insert team budget ledger record: %w", err)
+	}
+
+	insertTransactionSQL := `INSERT INTO transactions (from_user_id, to_user_id, amount, team_id) VALUES ($1, $2, $3, $4)`
+	_, err = executor.Exec(ctx, insertTransactionSQL, managerID, toUserID, amount, teamID)
+	if err != nil {
+		return fmt.Errorf("failed to insert transaction record: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeamBudgetProceeder_ProceedTeamTransfer(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name      string
+		teamID    int
+		amount    uint32
+		managerID int
+		toUserID  int
+
+		expectedErr error
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+	}
+
+	tests := []testCase{
+		{
+			name:      "successful team transfer",
+			teamID:    7,
+			amount:    100,
+			managerID: 5,
+			toUserID:  2,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("UPDATE teams").
+					WithArgs(uint32(100), 7).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectExec("UPDATE balances").
+					WithArgs(uint32(100), 2).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectExec("INSERT INTO team_budget_ledger").
+					WithArgs(7, 2, uint32(100), teamRewardReason).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				mock.ExpectExec("INSERT INTO transactions").
+					WithArgs(5, 2, uint32(100), 7).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+			},
+		},
+		{
+			name:      "insufficient team budget",
+			teamID:    7,
+			amount:    100,
+			managerID: 5,
+			toUserID:  2,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("UPDATE teams").
+					WithArgs(uint32(100), 7).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+			},
+			expectedErr: &domain.InsufficientBalanceError{},
+		},
+		{
+			name:      "failed to credit recipient",
+			teamID:    7,
+			amount:    100,
+			managerID: 5,
+			toUserID:  2,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("UPDATE teams").
+					WithArgs(uint32(100), 7).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectExec("UPDATE balances").
+					WithArgs(uint32(100), 2).
+					WillReturnError(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+		{
+			name:      "failed to insert ledger record",
+			teamID:    7,
+			amount:    100,
+			managerID: 5,
+			toUserID:  2,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("UPDATE teams").
+					WithArgs(uint32(100), 7).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectExec("UPDATE balances").
+					WithArgs(uint32(100), 2).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectExec("INSERT INTO team_budget_ledger").
+					WithArgs(7, 2, uint32(100), teamRewardReason).
+					WillReturnError(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+		{
+			name:      "failed to insert transaction record",
+			teamID:    7,
+			amount:    100,
+			managerID: 5,
+			toUserID:  2,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("UPDATE teams").
+					WithArgs(uint32(100), 7).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectExec("UPDATE balances").
+					WithArgs(uint32(100), 2).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+				mock.ExpectExec("INSERT INTO team_budget_ledger").
+					WithArgs(7, 2, uint32(100), teamRewardReason).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+				mock.ExpectExec("INSERT INTO transactions").
+					WithArgs(5, 2, uint32(100), 7).
+					WillReturnError(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range tests {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			proceeder := NewTeamBudgetProceeder()
+			err = proceeder.ProceedTeamTransfer(t.Context(), mock, tt.teamID, tt.amount, tt.managerID, tt.toUserID)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
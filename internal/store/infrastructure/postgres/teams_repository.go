@@ -0,0 +1,149 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+const teamTopUpReason = "top-up"
+
+type TeamsRepository struct {
+	queryExecuter database.QueryExecuter
+}
+
+func NewTeamsRepository(queryExecuter database.QueryExecuter) *TeamsRepository {
+	return &TeamsRepository{
+		queryExecuter: queryExecuter,
+	}
+}
+
+func (tr *TeamsRepository) CreateTeam(ctx context.Context, querier database.Querier, name string, managerID int) (int, error) {
+	createTeamSQL := `INSERT INTO teams (name, manager_id) VALUES ($1, $2) ON CONFLICT (name) DO NOTHING RETURNING id`
+
+	var teamID int
+	err := querier.QueryRow(ctx, createTeamSQL, name, managerID).Scan(&teamID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, &domain.TeamExistingError{Msg: fmt.Sprintf("team %s already exists", name)}
+		}
+
+		return 0, fmt.Errorf("failed to create team: %w", err)
+	}
+
+	return teamID, nil
+}
+
+func (tr *TeamsRepository) AddTeamMember(ctx context.Context, executor database.QueryExecuter, teamName string, userID int) error {
+	teamID, err := tr.getTeamID(ctx, executor, teamName)
+	if err != nil {
+		return err
+	}
+
+	addMemberSQL := `INSERT INTO team_members (team_id, user_id) VALUES ($1, $2) ON CONFLICT DO NOTHING`
+	_, err = executor.Exec(ctx, addMemberSQL, teamID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to add team member: %w", err)
+	}
+
+	return nil
+}
+
+func (tr *TeamsRepository) SetBudgetTopUp(ctx context.Context, executor database.Executor, teamName string, amount uint32,
+	period time.Duration) error {
+	setTopUpSQL := `UPDATE teams SET topup_amount = $2, topup_period = $3::bigint * INTERVAL '1 second', next_topup_at = now()
+		WHERE name = $1`
+
+	tag, err := executor.Exec(ctx, setTopUpSQL, teamName, amount, int64(period.Seconds()))
+	if err != nil {
+		return fmt.Errorf("failed to set team budget top-up: %w", err)
+	} else if tag.RowsAffected() == 0 {
+		return &domain.TeamNotFoundError{Msg: fmt.Sprintf("team %s not found", teamName)}
+	}
+
+	return nil
+}
+
+func (tr *TeamsRepository) LockAndGetTeam(ctx context.Context, querier database.Querier, teamName string) (domain.TeamInfo, error) {
+	lockTeamSQL := `SELECT id, name, manager_id, budget FROM teams WHERE name = $1 FOR UPDATE`
+
+	var team domain.TeamInfo
+	err := querier.QueryRow(ctx, lockTeamSQL, teamName).Scan(&team.Id, &team.Name, &team.ManagerID, &team.Budget)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.TeamInfo{}, &domain.TeamNotFoundError{Msg: fmt.Sprintf("team %s not found", teamName)}
+		}
+
+		return domain.TeamInfo{}, fmt.Errorf("failed to lock team row: %w", err)
+	}
+
+	return team, nil
+}
+
+func (tr *TeamsRepository) IsTeamMember(ctx context.Context, querier database.Querier, teamID, userID int) (bool, error) {
+	isMemberSQL := `SELECT EXISTS (SELECT 1 FROM team_members WHERE team_id = $1 AND user_id = $2)`
+
+	var isMember bool
+	err := querier.QueryRow(ctx, isMemberSQL, teamID, userID).Scan(&isMember)
+	if err != nil {
+		return false, fmt.Errorf("failed to check team membership: %w", err)
+	}
+
+	return isMember, nil
+}
+
+// TopUpDueBudgets credits every team whose top-up is due and schedules the next one.
+// Missed periods are caught up one per call.
+func (tr *TeamsRepository) TopUpDueBudgets(ctx context.Context, querier database.Querier) ([]domain.TeamTopUp, error) {
+	topUpSQL := `WITH due AS (
+			UPDATE teams SET budget = budget + topup_amount, next_topup_at = next_topup_at + topup_period
+			WHERE topup_amount > 0 AND topup_period IS NOT NULL AND next_topup_at <= now()
+			RETURNING id, name, topup_amount
+		), ledger AS (
+			INSERT INTO team_budget_ledger (team_id, amount, reason) SELECT id, topup_amount, $1 FROM due
+		)
+		SELECT name, topup_amount FROM due ORDER BY name`
+
+	rows, err := querier.Query(ctx, topUpSQL, teamTopUpReason)
+	if err != nil {
+		return nil, fmt.Errorf("failed to top up team budgets: %w", err)
+	}
+	defer rows.Close()
+
+	topUps := make([]domain.TeamTopUp, 0)
+	for rows.Next() {
+		var topUp domain.TeamTopUp
+		if err := rows.Scan(&topUp.TeamName, &topUp.Amount); err != nil {
+			return nil, fmt.Errorf("failed to scan top-up: %w", err)
+		}
+
+		topUps = append(topUps, topUp)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to top up team budgets: %w", err)
+	}
+
+	return topUps, nil
+}
+
+func (tr *TeamsRepository) getTeamID(ctx context.Context, querier database.Querier, teamName string) (int, error) {
+	getTeamSQL := `SELECT id FROM teams WHERE name = $1`
+
+	var teamID int
+	err := querier.QueryRow(ctx, getTeamSQL, teamName).Scan(&teamID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, &domain.TeamNotFoundError{Msg: fmt.Sprintf("team %s not found", teamName)}
+		}
+
+		return 0, fmt.Errorf("failed to get team: %w", err)
+	}
+
+	return teamID, nil
+}
@@ -0,0 +1,367 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeamsRepository_CreateTeam(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name      string
+		teamName  string
+		managerID int
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+
+		expectedID  int
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name:      "team created",
+			teamName:  "platform",
+			managerID: 1,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("INSERT INTO teams").
+					WithArgs("platform", 1).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(7))
+			},
+			expectedID: 7,
+		},
+		{
+			name:      "team already exists",
+			teamName:  "platform",
+			managerID: 1,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("INSERT INTO teams").
+					WithArgs("platform", 1).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			expectedErr: &domain.TeamExistingError{},
+		},
+		{
+			name:      "database error",
+			teamName:  "platform",
+			managerID: 1,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("INSERT INTO teams").
+					WithArgs("platform", 1).
+					WillReturnError(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			repo := NewTeamsRepository(mock)
+			teamID, err := repo.CreateTeam(t.Context(), mock, tt.teamName, tt.managerID)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedID, teamID)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTeamsRepository_AddTeamMember(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name     string
+		teamName string
+		userID   int
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name:     "member added",
+			teamName: "platform",
+			userID:   2,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("SELECT id FROM teams").
+					WithArgs("platform").
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(7))
+				mock.ExpectExec("INSERT INTO team_members").
+					WithArgs(7, 2).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+			},
+		},
+		{
+			name:     "team not found",
+			teamName: "unknown",
+			userID:   2,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("SELECT id FROM teams").
+					WithArgs("unknown").
+					WillReturnError(pgx.ErrNoRows)
+			},
+			expectedErr: &domain.TeamNotFoundError{},
+		},
+		{
+			name:     "insert error",
+			teamName: "platform",
+			userID:   2,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("SELECT id FROM teams").
+					WithArgs("platform").
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(7))
+				mock.ExpectExec("INSERT INTO team_members").
+					WithArgs(7, 2).
+					WillReturnError(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			repo := NewTeamsRepository(mock)
+			err = repo.AddTeamMember(t.Context(), mock, tt.teamName, tt.userID)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTeamsRepository_SetBudgetTopUp(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name     string
+		teamName string
+		amount   uint32
+		period   time.Duration
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name:     "top-up configured",
+			teamName: "platform",
+			amount:   500,
+			period:   24 * time.Hour,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("UPDATE teams").
+					WithArgs("platform", uint32(500), int64(86400)).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+			},
+		},
+		{
+			name:     "team not found",
+			teamName: "unknown",
+			amount:   500,
+			period:   24 * time.Hour,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("UPDATE teams").
+					WithArgs("unknown", uint32(500), int64(86400)).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+			},
+			expectedErr: &domain.TeamNotFoundError{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			repo := NewTeamsRepository(mock)
+			err = repo.SetBudgetTopUp(t.Context(), mock, tt.teamName, tt.amount, tt.period)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTeamsRepository_LockAndGetTeam(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name     string
+		teamName string
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+
+		expectedTeam domain.TeamInfo
+		expectedErr  error
+	}
+
+	testCases := []testCase{
+		{
+			name:     "team found",
+			teamName: "platform",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("SELECT id, name, manager_id, budget FROM teams").
+					WithArgs("platform").
+					WillReturnRows(pgxmock.NewRows([]string{"id", "name", "manager_id", "budget"}).
+						AddRow(7, "platform", 1, uint32(500)))
+			},
+			expectedTeam: domain.TeamInfo{Id: 7, Name: "platform", ManagerID: 1, Budget: 500},
+		},
+		{
+			name:     "team not found",
+			teamName: "unknown",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("SELECT id, name, manager_id, budget FROM teams").
+					WithArgs("unknown").
+					WillReturnError(pgx.ErrNoRows)
+			},
+			expectedErr: &domain.TeamNotFoundError{},
+		},
+		{
+			name:     "database error",
+			teamName: "platform",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("SELECT id, name, manager_id, budget FROM teams").
+					WithArgs("platform").
+					WillReturnError(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			repo := NewTeamsRepository(mock)
+			team, err := repo.LockAndGetTeam(t.Context(), mock, tt.teamName)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedTeam, team)
+			}
+		})
+	}
+}
+
+func TestTeamsRepository_TopUpDueBudgets(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name string
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+
+		expectedTopUps []domain.TeamTopUp
+		expectedErr    error
+	}
+
+	testCases := []testCase{
+		{
+			name: "two teams topped up",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("WITH due AS").
+					WithArgs(teamTopUpReason).
+					WillReturnRows(pgxmock.NewRows([]string{"name", "topup_amount"}).
+						AddRow("design", uint32(200)).
+						AddRow("platform", uint32(500)))
+			},
+			expectedTopUps: []domain.TeamTopUp{{TeamName: "design", Amount: 200}, {TeamName: "platform", Amount: 500}},
+		},
+		{
+			name: "database error",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("WITH due AS").
+					WithArgs(teamTopUpReason).
+					WillReturnError(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			repo := NewTeamsRepository(mock)
+			topUps, err := repo.TopUpDueBudgets(t.Context(), mock)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedTopUps, topUps)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+)
+
+type TransferLimitsRepository struct{}
+
+func NewTransferLimitsRepository() *TransferLimitsRepository {
+	return &TransferLimitsRepository{}
+}
+
+// GetTransferLimits returns the user's override if there is one and the default limits otherwise.
+func (tr *TransferLimitsRepository) GetTransferLimits(ctx context.Context, querier database.Querier, userId int) (domain.TransferLimits, error) {
+	limitsSQL := `SELECT max_single_transfer, daily_outgoing_cap, max_transfers_per_hour, max_received_from_sender_per_day
+		FROM transfer_limits WHERE user_id = $1 OR user_id IS NULL
+		ORDER BY user_id NULLS LAST LIMIT 1`
+
+	var limits domain.TransferLimits
+	err := querier.QueryRow(ctx, limitsSQL, userId).Scan(
+		&limits.MaxSingleTransfer,
+		&limits.DailyOutgoingCap,
+		&limits.MaxTransfersPerHour,
+		&limits.MaxReceivedFromSenderPerDay,
+	)
+	if err != nil {
+		return domain.TransferLimits{}, fmt.Errorf("failed to get transfer limits: %w", err)
+	}
+
+	return limits, nil
+}
+
+func (tr *TransferLimitsRepository) FetchTransferStats(ctx context.Context, querier database.Querier, fromUserID, toUserID int) (domain.TransferStats, error) {
+	statsSQL := `SELECT
+			COALESCE(SUM(amount), 0),
+			COUNT(*) FILTER (WHERE created_at > now() - INTERVAL '1 hour'),
+			COALESCE(SUM(amount) FILTER (WHERE to_user_id = $2), 0)
+		FROM transactions
+		WHERE from_user_id = $1 AND team_id IS NULL AND created_at > now() - INTERVAL '1 day'`
+
+	var stats domain.TransferStats
+	err := querier.QueryRow(ctx, statsSQL, fromUserID, toUserID).Scan(
+		&stats.SentLastDay,
+		&stats.TransfersLastHour,
+		&stats.ReceivedFromSenderLastDay,
+	)
+	if err != nil {
+		return domain.TransferStats{}, fmt.Errorf("failed to fetch transfer stats: %w", err)
+	}
+
+	return stats, nil
+}
+
+func (tr *TransferLimitsRepository) SetDefaultTransferLimits(ctx context.Context, executor database.Executor, limits domain.TransferLimits) error {
+	updateSQL := `UPDATE transfer_limits SET max_single_transfer = $1, daily_outgoing_cap = $2,
+		max_transfers_per_hour = $3, max_received_from_sender_per_day = $4
+		WHERE user_id IS NULL`
+
+	_, err := executor.Exec(ctx, updateSQL, limits.MaxSingleTransfer, limits.DailyOutgoingCap,
+		limits.MaxTransfersPerHour, limits.MaxReceivedFromSenderPerDay)
+	if err != nil {
+		return fmt.Errorf("failed to set default transfer limits: %w", err)
+	}
+
+	return nil
+}
+
+func (tr *TransferLimitsRepository) SetUserTransferLimits(ctx context.Context, executor database.Executor, userId int,
+	limits domain.TransferLimits) error {
+	upsertSQL := `INSERT INTO transfer_limits (user_id, max_single_transfer, daily_outgoing_cap, max_transfers_per_hour, max_received_from_sender_per_day)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id) DO UPDATE SET max_single_transfer = EXCLUDED.max_single_transfer,
+			daily_outgoing_cap = EXCLUDED.daily_outgoing_cap,
+			max_transfers_per_hour = EXCLUDED.max_transfers_per_hour,
+			max_received_from_sender_per_day = EXCLUDED.max_received_from_sender_per_day`
+
+	_, err := executor.Exec(ctx, upsertSQL, userId, limits.MaxSingleTransfer, limits.DailyOutgoingCap,
+		limits.MaxTransfersPerHour, limits.MaxReceivedFromSenderPerDay)
+	if err != nil {
+		return fmt.Errorf("failed to set transfer limits for user %d: %w", userId, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,213 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransferLimitsRepository_GetTransferLimits(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name   string
+		userId int
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+
+		expectedLimits domain.TransferLimits
+		expectedErr    error
+	}
+
+	testCases := []testCase{
+		{
+			name:   "limits found",
+			userId: 1,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("SELECT max_single_transfer").
+					WithArgs(1).
+					WillReturnRows(pgxmock.NewRows([]string{"max_single_transfer", "daily_outgoing_cap",
+						"max_transfers_per_hour", "max_received_from_sender_per_day"}).
+						AddRow(uint32(500), uint32(1000), uint32(20), uint32(500)))
+			},
+			expectedLimits: domain.TransferLimits{
+				MaxSingleTransfer:           500,
+				DailyOutgoingCap:            1000,
+				MaxTransfersPerHour:         20,
+				MaxReceivedFromSenderPerDay: 500,
+			},
+		},
+		{
+			name:   "database error",
+			userId: 1,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("SELECT max_single_transfer").
+					WithArgs(1).
+					WillReturnError(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			repo := NewTransferLimitsRepository()
+			limits, err := repo.GetTransferLimits(t.Context(), mock, tt.userId)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedLimits, limits)
+			}
+		})
+	}
+}
+
+func TestTransferLimitsRepository_FetchTransferStats(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name       string
+		fromUserID int
+		toUserID   int
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+
+		expectedStats domain.TransferStats
+		expectedErr   error
+	}
+
+	testCases := []testCase{
+		{
+			name:       "stats fetched",
+			fromUserID: 1,
+			toUserID:   2,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("FROM transactions").
+					WithArgs(1, 2).
+					WillReturnRows(pgxmock.NewRows([]string{"sum", "count", "sum"}).
+						AddRow(uint32(300), uint32(2), uint32(100)))
+			},
+			expectedStats: domain.TransferStats{
+				SentLastDay:               300,
+				TransfersLastHour:         2,
+				ReceivedFromSenderLastDay: 100,
+			},
+		},
+		{
+			name:       "database error",
+			fromUserID: 1,
+			toUserID:   2,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("FROM transactions").
+					WithArgs(1, 2).
+					WillReturnError(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			repo := NewTransferLimitsRepository()
+			stats, err := repo.FetchTransferStats(t.Context(), mock, tt.fromUserID, tt.toUserID)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStats, stats)
+			}
+		})
+	}
+}
+
+func TestTransferLimitsRepository_SetUserTransferLimits(t *testing.T) {
+	t.Parallel()
+
+	limits := domain.TransferLimits{
+		MaxSingleTransfer:           100,
+		DailyOutgoingCap:            200,
+		MaxTransfersPerHour:         5,
+		MaxReceivedFromSenderPerDay: 100,
+	}
+
+	type testCase struct {
+		name   string
+		userId int
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name:   "override saved",
+			userId: 1,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("INSERT INTO transfer_limits").
+					WithArgs(1, uint32(100), uint32(200), uint32(5), uint32(100)).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+			},
+		},
+		{
+			name:   "database error",
+			userId: 1,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("INSERT INTO transfer_limits").
+					WithArgs(1, uint32(100), uint32(200), uint32(5), uint32(100)).
+					WillReturnError(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			repo := NewTransferLimitsRepository()
+			err = repo.SetUserTransferLimits(t.Context(), mock, tt.userId, limits)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -45,11 +45,13 @@ func (uif *UserInfoRepository) FetchUserBalance(ctx context.Context, userId int)
 	return balance, nil
 }
 
+// FetchUserPurchases counts the owned goods by good and variant.
 func (uif *UserInfoRepository) FetchUserPurchases(ctx context.Context, userId int) (map[domain.Good]uint32, error) {
-	sql := `SELECT g.name, COUNT(*) FROM purchases p
+	sql := `SELECT g.name, COALESCE(v.sku, ''), COUNT(*) FROM purchases p
 			JOIN goods g ON p.good_id = g.id
+			LEFT JOIN good_variants v ON p.variant_id = v.id
 			WHERE p.user_id = $1
-			GROUP BY g.name`
+			GROUP BY g.name, v.sku`
 	rows, err := uif.queryExecuter.Query(ctx, sql, userId)
 	if err != nil {
 		return nil, err
@@ -60,7 +62,7 @@ func (uif *UserInfoRepository) FetchUserPurchases(ctx context.Context, userId in
 	for rows.Next() {
 		var good domain.Good
 		var count int
-		if err := rows.Scan(&good.Name, &count); err != nil {
+		if err := rows.Scan(&good.Name, &good.Variant, &count); err != nil {
 			return nil, err
 		}
 
@@ -70,6 +72,30 @@ func (uif *UserInfoRepository) FetchUserPurchases(ctx context.Context, userId in
 	return goods, nil
 }
 
+func (uif *UserInfoRepository) FetchUserGifts(ctx context.Context, userId int) ([]domain.Gift, error) {
+	sql := `SELECT g.name, p.gifted_by, p.gift_message FROM purchases p
+			JOIN goods g ON p.good_id = g.id
+			WHERE p.user_id = $1 AND p.gifted_by IS NOT NULL
+			ORDER BY p.id`
+	rows, err := uif.queryExecuter.Query(ctx, sql, userId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	gifts := make([]domain.Gift, 0)
+	for rows.Next() {
+		var gift domain.Gift
+		if err := rows.Scan(&gift.GoodName, &gift.FromUserID, &gift.Message); err != nil {
+			return nil, err
+		}
+
+		gifts = append(gifts, gift)
+	}
+
+	return gifts, rows.Err()
+}
+
 func (uif *UserInfoRepository) FetchUserCoinTransfers(ctx context.Context, userId int) (domain.TransferHistory, error) {
 	fromUserSQL := `SELECT from_user_id, to_user_id, amount FROM transactions WHERE from_user_id = $1`
 	outcomingRows, err := uif.queryExecuter.Query(ctx, fromUserSQL, userId)
@@ -100,6 +126,70 @@ func (uif *UserInfoRepository) FetchUserCoinTransfers(ctx context.Context, userI
 	return transferHistory, nil
 }
 
+// FetchUserItemTransfers returns the items the user passed on to or received from other users.
+func (uif *UserInfoRepository) FetchUserItemTransfers(ctx context.Context, userId int) (domain.ItemTransferHistory, error) {
+	sql := `SELECT t.from_user_id, t.to_user_id, g.name FROM item_transfers t
+			JOIN goods g ON t.good_id = g.id
+			WHERE t.from_user_id = $1 OR t.to_user_id = $1
+			ORDER BY t.id`
+	rows, err := uif.queryExecuter.Query(ctx, sql, userId)
+	if err != nil {
+		return domain.ItemTransferHistory{}, err
+	}
+	defer rows.Close()
+
+	history := domain.ItemTransferHistory{
+		IncomingTransfers:  make([]domain.ItemTransfer, 0),
+		OutcomingTransfers: make([]domain.ItemTransfer, 0),
+	}
+
+	for rows.Next() {
+		var fromUserID, toUserID int
+		var goodName string
+		if err := rows.Scan(&fromUserID, &toUserID, &goodName); err != nil {
+			return domain.ItemTransferHistory{}, err
+		}
+
+		if fromUserID == userId {
+			history.OutcomingTransfers = append(history.OutcomingTransfers, domain.ItemTransfer{TargetID: toUserID, GoodName: goodName})
+		} else {
+			history.IncomingTransfers = append(history.IncomingTransfers, domain.ItemTransfer{TargetID: fromUserID, GoodName: goodName})
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return domain.ItemTransferHistory{}, err
+	}
+
+	return history, nil
+}
+
+// FetchUserWishlist returns the wished goods at their current price, the most recently added first.
+func (uif *UserInfoRepository) FetchUserWishlist(ctx context.Context, userId int) ([]domain.WishlistItem, error) {
+	sql := `SELECT g.name, COALESCE(s.price, g.price), w.added_at FROM wishlist_items w
+			JOIN goods g ON w.good_id = g.id
+			` + activePriceJoin + `
+			WHERE w.user_id = $1
+			ORDER BY w.added_at DESC, g.name`
+	rows, err := uif.queryExecuter.Query(ctx, sql, userId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	wishlist := make([]domain.WishlistItem, 0)
+	for rows.Next() {
+		var item domain.WishlistItem
+		if err := rows.Scan(&item.GoodName, &item.Price, &item.AddedAt); err != nil {
+			return nil, err
+		}
+
+		wishlist = append(wishlist, item)
+	}
+
+	return wishlist, rows.Err()
+}
+
 func processRows(rows pgx.Rows, getTargetIDFn func(tr transaction) int) ([]domain.DirectTransfer, error) {
 	result := make([]domain.DirectTransfer, 0)
 
@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"testing"
+	"time"
 
 	"github.com/Lexv0lk/merch-store/internal/store/domain"
 	"github.com/jackc/pgx/v5"
@@ -106,16 +107,18 @@ func TestUserInfoRepository_FetchUserPurchases(t *testing.T) {
 			userId: 1,
 			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
 				t.Helper()
-				rows := pgxmock.NewRows([]string{"name", "count"}).
-					AddRow("t-shirt", 2).
-					AddRow("cup", 1)
+				rows := pgxmock.NewRows([]string{"name", "sku", "count"}).
+					AddRow("t-shirt", "t-shirt-m", 2).
+					AddRow("t-shirt", "", 1).
+					AddRow("cup", "", 1)
 				mock.ExpectQuery("SELECT").
 					WithArgs(1).
 					WillReturnRows(rows)
 			},
 			expectedPurchases: map[domain.Good]uint32{
-				{Name: "t-shirt"}: 2,
-				{Name: "cup"}:     1,
+				{Name: "t-shirt", Variant: "t-shirt-m"}: 2,
+				{Name: "t-shirt"}:                       1,
+				{Name: "cup"}:                           1,
 			},
 			expectedErr: nil,
 		},
@@ -124,7 +127,7 @@ func TestUserInfoRepository_FetchUserPurchases(t *testing.T) {
 			userId: 1,
 			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
 				t.Helper()
-				rows := pgxmock.NewRows([]string{"name", "count"})
+				rows := pgxmock.NewRows([]string{"name", "sku", "count"})
 				mock.ExpectQuery("SELECT").
 					WithArgs(1).
 					WillReturnRows(rows)
@@ -169,6 +172,176 @@ func TestUserInfoRepository_FetchUserPurchases(t *testing.T) {
 	}
 }
 
+func TestUserInfoRepository_FetchUserGifts(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name   string
+		userId int
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+
+		expectedGifts []domain.Gift
+		expectedErr   error
+	}
+
+	testCases := []testCase{
+		{
+			name:   "gifts found",
+			userId: 1,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				rows := pgxmock.NewRows([]string{"name", "gifted_by", "gift_message"}).
+					AddRow("cup", 2, "happy birthday").
+					AddRow("pen", 3, "")
+				mock.ExpectQuery("SELECT").
+					WithArgs(1).
+					WillReturnRows(rows)
+			},
+			expectedGifts: []domain.Gift{
+				{GoodName: "cup", FromUserID: 2, Message: "happy birthday"},
+				{GoodName: "pen", FromUserID: 3, Message: ""},
+			},
+			expectedErr: nil,
+		},
+		{
+			name:   "no gifts",
+			userId: 1,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				rows := pgxmock.NewRows([]string{"name", "gifted_by", "gift_message"})
+				mock.ExpectQuery("SELECT").
+					WithArgs(1).
+					WillReturnRows(rows)
+			},
+			expectedGifts: []domain.Gift{},
+			expectedErr:   nil,
+		},
+		{
+			name:   "database error",
+			userId: 1,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("SELECT").
+					WithArgs(1).
+					WillReturnError(assert.AnError)
+			},
+			expectedGifts: nil,
+			expectedErr:   assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			fetcher := NewUserInfoRepository(mock, nil)
+			gifts, err := fetcher.FetchUserGifts(t.Context(), tt.userId)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedGifts, gifts)
+			}
+		})
+	}
+}
+
+func TestUserInfoRepository_FetchUserItemTransfers(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name   string
+		userId int
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+
+		expectedHistory domain.ItemTransferHistory
+		expectedErr     error
+	}
+
+	testCases := []testCase{
+		{
+			name:   "incoming and outgoing items",
+			userId: 1,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				rows := pgxmock.NewRows([]string{"from_user_id", "to_user_id", "name"}).
+					AddRow(1, 2, "cup").
+					AddRow(3, 1, "pen")
+				mock.ExpectQuery("SELECT").
+					WithArgs(1).
+					WillReturnRows(rows)
+			},
+			expectedHistory: domain.ItemTransferHistory{
+				IncomingTransfers: []domain.ItemTransfer{
+					{TargetID: 3, GoodName: "pen"},
+				},
+				OutcomingTransfers: []domain.ItemTransfer{
+					{TargetID: 2, GoodName: "cup"},
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name:   "no item transfers",
+			userId: 1,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				rows := pgxmock.NewRows([]string{"from_user_id", "to_user_id", "name"})
+				mock.ExpectQuery("SELECT").
+					WithArgs(1).
+					WillReturnRows(rows)
+			},
+			expectedHistory: domain.ItemTransferHistory{
+				IncomingTransfers:  []domain.ItemTransfer{},
+				OutcomingTransfers: []domain.ItemTransfer{},
+			},
+			expectedErr: nil,
+		},
+		{
+			name:   "database error",
+			userId: 1,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("SELECT").
+					WithArgs(1).
+					WillReturnError(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			fetcher := NewUserInfoRepository(mock, nil)
+			history, err := fetcher.FetchUserItemTransfers(t.Context(), tt.userId)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedHistory, history)
+			}
+		})
+	}
+}
+
 func TestUserInfoRepository_FetchUserCoinTransfers(t *testing.T) {
 	t.Parallel()
 
@@ -310,3 +483,83 @@ func TestUserInfoRepository_FetchUserCoinTransfers(t *testing.T) {
 		})
 	}
 }
+
+func TestUserInfoRepository_FetchUserWishlist(t *testing.T) {
+	t.Parallel()
+
+	addedAt := time.Date(2026, 6, 7, 12, 0, 0, 0, time.UTC)
+
+	type testCase struct {
+		name   string
+		userId int
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+
+		expectedWishlist []domain.WishlistItem
+		expectedErr      error
+	}
+
+	testCases := []testCase{
+		{
+			name:   "wishlist found",
+			userId: 1,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				rows := pgxmock.NewRows([]string{"name", "price", "added_at"}).
+					AddRow("powerbank", uint32(200), addedAt).
+					AddRow("cup", uint32(20), addedAt.Add(-time.Hour))
+				mock.ExpectQuery("SELECT g.name").
+					WithArgs(1).
+					WillReturnRows(rows)
+			},
+			expectedWishlist: []domain.WishlistItem{
+				{GoodName: "powerbank", Price: 200, AddedAt: addedAt},
+				{GoodName: "cup", Price: 20, AddedAt: addedAt.Add(-time.Hour)},
+			},
+		},
+		{
+			name:   "empty wishlist",
+			userId: 1,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("SELECT g.name").
+					WithArgs(1).
+					WillReturnRows(pgxmock.NewRows([]string{"name", "price", "added_at"}))
+			},
+			expectedWishlist: []domain.WishlistItem{},
+		},
+		{
+			name:   "database error",
+			userId: 1,
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("SELECT g.name").
+					WithArgs(1).
+					WillReturnError(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			fetcher := NewUserInfoRepository(mock, nil)
+			wishlist, err := fetcher.FetchUserWishlist(t.Context(), tt.userId)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedWishlist, wishlist)
+			}
+		})
+	}
+}
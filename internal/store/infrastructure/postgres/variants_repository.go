@@ -0,0 +1,77 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+type VariantsRepository struct {
+	querier database.Querier
+}
+
+func NewVariantsRepository(querier database.Querier) *VariantsRepository {
+	return &VariantsRepository{
+		querier: querier,
+	}
+}
+
+func (vr *VariantsRepository) ListGoodVariants(ctx context.Context, goodID int) ([]domain.Variant, error) {
+	listSQL := `SELECT id, good_id, sku, attributes, price_delta, stock FROM good_variants
+		WHERE good_id = $1
+		ORDER BY id`
+
+	rows, err := vr.querier.Query(ctx, listSQL, goodID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list good variants: %w", err)
+	}
+
+	return scanVariants(rows)
+}
+
+// ListVariants returns the variants of all goods, grouped by good.
+func (vr *VariantsRepository) ListVariants(ctx context.Context) ([]domain.Variant, error) {
+	listSQL := `SELECT id, good_id, sku, attributes, price_delta, stock FROM good_variants
+		ORDER BY good_id, id`
+
+	rows, err := vr.querier.Query(ctx, listSQL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list variants: %w", err)
+	}
+
+	return scanVariants(rows)
+}
+
+func (vr *VariantsRepository) TakeFromStock(ctx context.Context, executor database.Executor, variantID int, quantity uint32) error {
+	updateSQL := `UPDATE good_variants SET stock = stock - $2
+		WHERE id = $1 AND (stock IS NULL OR stock >= $2)`
+
+	tag, err := executor.Exec(ctx, updateSQL, variantID, quantity)
+	if err != nil {
+		return fmt.Errorf("failed to take variant from stock: %w", err)
+	} else if tag.RowsAffected() == 0 {
+		return &domain.OutOfStockError{Msg: "variant is out of stock"}
+	}
+
+	return nil
+}
+
+func scanVariants(rows pgx.Rows) ([]domain.Variant, error) {
+	defer rows.Close()
+
+	variants := make([]domain.Variant, 0)
+	for rows.Next() {
+		var variant domain.Variant
+		err := rows.Scan(&variant.Id, &variant.GoodID, &variant.SKU, &variant.Attributes, &variant.PriceDelta, &variant.Stock)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan variant: %w", err)
+		}
+
+		variants = append(variants, variant)
+	}
+
+	return variants, rows.Err()
+}
@@ -0,0 +1,101 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVariantsRepository_ListGoodVariants(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	stock := uint32(5)
+	rows := pgxmock.NewRows([]string{"id", "good_id", "sku", "attributes", "price_delta", "stock"}).
+		AddRow(1, 6, "hoody-s", map[string]string{"size": "S"}, int32(0), &stock).
+		AddRow(4, 6, "hoody-xl", map[string]string{"size": "XL"}, int32(20), nil)
+	mock.ExpectQuery("SELECT id, good_id, sku, attributes, price_delta, stock FROM good_variants").
+		WithArgs(6).
+		WillReturnRows(rows)
+
+	repo := NewVariantsRepository(mock)
+	variants, err := repo.ListGoodVariants(t.Context(), 6)
+
+	require.NoError(t, err)
+	assert.Equal(t, []domain.Variant{
+		{Id: 1, GoodID: 6, SKU: "hoody-s", Attributes: map[string]string{"size": "S"}, Stock: &stock},
+		{Id: 4, GoodID: 6, SKU: "hoody-xl", Attributes: map[string]string{"size": "XL"}, PriceDelta: 20},
+	}, variants)
+}
+
+func TestVariantsRepository_TakeFromStock(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name string
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name: "variant in stock",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("UPDATE good_variants SET stock = stock - ").
+					WithArgs(4, uint32(1)).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+			},
+		},
+		{
+			name: "variant sold out",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("UPDATE good_variants SET stock = stock - ").
+					WithArgs(4, uint32(1)).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+			},
+			expectedErr: &domain.OutOfStockError{},
+		},
+		{
+			name: "database error",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("UPDATE good_variants SET stock = stock - ").
+					WithArgs(4, uint32(1)).
+					WillReturnError(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			repo := NewVariantsRepository(mock)
+			err = repo.TakeFromStock(t.Context(), mock, 4, 1)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+const webhookDeliveryColumns = `d.id, d.endpoint_id, e.url, e.secret, d.event, d.data, d.attempts, d.next_attempt_at,
+	d.last_error, d.created_at`
+
+type WebhooksRepository struct {
+	queryExecuter database.QueryExecuter
+}
+
+func NewWebhooksRepository(queryExecuter database.QueryExecuter) *WebhooksRepository {
+	return &WebhooksRepository{
+		queryExecuter: queryExecuter,
+	}
+}
+
+func (wr *WebhooksRepository) CreateWebhookEndpoint(ctx context.Context, querier database.Querier, endpoint domain.WebhookEndpoint) (int, error) {
+	insertSQL := `INSERT INTO webhook_endpoints (url, secret, events) VALUES ($1, $2, $3) RETURNING id`
+
+	var endpointID int
+	err := querier.QueryRow(ctx, insertSQL, endpoint.URL, endpoint.Secret, endpoint.Events).Scan(&endpointID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create webhook endpoint: %w", err)
+	}
+
+	return endpointID, nil
+}
+
+func (wr *WebhooksRepository) CountWebhookEndpoints(ctx context.Context) (int, error) {
+	countSQL := `SELECT COUNT(*) FROM webhook_endpoints`
+
+	var count int
+	err := wr.queryExecuter.QueryRow(ctx, countSQL).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count webhook endpoints: %w", err)
+	}
+
+	return count, nil
+}
+
+// ListWebhookEndpoints returns the endpoints without their secrets.
+func (wr *WebhooksRepository) ListWebhookEndpoints(ctx context.Context) ([]domain.WebhookEndpoint, error) {
+	listSQL := `SELECT id, url, events, created_at FROM webhook_endpoints ORDER BY id`
+
+	rows, err := wr.queryExecuter.Query(ctx, listSQL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook endpoints: %w", err)
+	}
+	defer rows.Close()
+
+	endpoints := make([]domain.WebhookEndpoint, 0)
+	for rows.Next() {
+		var endpoint domain.WebhookEndpoint
+
+		err := rows.Scan(&endpoint.Id, &endpoint.URL, &endpoint.Events, &endpoint.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook endpoint: %w", err)
+		}
+
+		endpoints = append(endpoints, endpoint)
+	}
+
+	return endpoints, rows.Err()
+}
+
+// DeleteWebhookEndpoint removes the endpoint together with its queued deliveries. Its dead letters are kept.
+func (wr *WebhooksRepository) DeleteWebhookEndpoint(ctx context.Context, executor database.Executor, endpointID int) error {
+	deleteSQL := `DELETE FROM webhook_endpoints WHERE id = $1`
+
+	tag, err := executor.Exec(ctx, deleteSQL, endpointID)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook endpoint: %w", err)
+	} else if tag.RowsAffected() == 0 {
+		return &domain.WebhookEndpointNotFoundError{Msg: fmt.Sprintf("webhook endpoint %d not found", endpointID)}
+	}
+
+	return nil
+}
+
+// ListWebhookDeadLetters returns the latest deliveries that ran out of attempts.
+func (wr *WebhooksRepository) ListWebhookDeadLetters(ctx context.Context, limit int) ([]domain.WebhookDelivery, error) {
+	listSQL := `SELECT id, endpoint_id, url, event, data, attempts, last_error, created_at, failed_at
+		FROM webhook_dead_letters
+		ORDER BY failed_at DESC, id DESC
+		LIMIT $1`
+
+	rows, err := wr.queryExecuter.Query(ctx, listSQL, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook dead letters: %w", err)
+	}
+	defer rows.Close()
+
+	deliveries := make([]domain.WebhookDelivery, 0)
+	for rows.Next() {
+		var delivery domain.WebhookDelivery
+
+		err := rows.Scan(&delivery.Id, &delivery.EndpointID, &delivery.URL, &delivery.Event, &delivery.Data,
+			&delivery.Attempts, &delivery.LastError, &delivery.CreatedAt, &delivery.FailedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook dead letter: %w", err)
+		}
+
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, rows.Err()
+}
+
+// PublishWebhook queues a delivery of the event for every endpoint subscribed to its type.
+func (wr *WebhooksRepository) PublishWebhook(ctx context.Context, executor database.Executor, event domain.WebhookEvent) error {
+	insertSQL := `INSERT INTO webhook_deliveries (endpoint_id, event, data)
+		SELECT id, $1, $2 FROM webhook_endpoints WHERE $1 = ANY(events)`
+
+	_, err := executor.Exec(ctx, insertSQL, event.Type, event.Data)
+	if err != nil {
+		return fmt.Errorf("failed to publish %s webhook: %w", event.Type, err)
+	}
+
+	return nil
+}
+
+func (wr *WebhooksRepository) FetchDueWebhookDeliveries(ctx context.Context, now time.Time, limit int) ([]int, error) {
+	dueSQL := `SELECT id FROM webhook_deliveries
+		WHERE next_attempt_at <= $1
+		ORDER BY next_attempt_at, id
+		LIMIT $2`
+
+	rows, err := wr.queryExecuter.Query(ctx, dueSQL, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch due webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	deliveryIDs := make([]int, 0)
+	for rows.Next() {
+		var deliveryID int
+		if err := rows.Scan(&deliveryID); err != nil {
+			return nil, fmt.Errorf("failed to scan webhook delivery id: %w", err)
+		}
+
+		deliveryIDs = append(deliveryIDs, deliveryID)
+	}
+
+	return deliveryIDs, rows.Err()
+}
+
+// LockAndGetWebhookDelivery skips deliveries locked by another store instance, which reports them as not found.
+func (wr *WebhooksRepository) LockAndGetWebhookDelivery(ctx context.Context, querier database.Querier, deliveryID int) (domain.WebhookDelivery, error) {
+	lockSQL := `SELECT ` + webhookDeliveryColumns + `
+		FROM webhook_deliveries d JOIN webhook_endpoints e ON d.endpoint_id = e.id
+		WHERE d.id = $1
+		FOR UPDATE OF d SKIP LOCKED`
+
+	var delivery domain.WebhookDelivery
+	err := querier.QueryRow(ctx, lockSQL, deliveryID).Scan(&delivery.Id, &delivery.EndpointID, &delivery.URL,
+		&delivery.Secret, &delivery.Event, &delivery.Data, &delivery.Attempts, &delivery.NextAttemptAt,
+		&delivery.LastError, &delivery.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.WebhookDelivery{}, &domain.WebhookDeliveryNotFoundError{Msg: fmt.Sprintf("webhook delivery %d not found", deliveryID)}
+		}
+
+		return domain.WebhookDelivery{}, fmt.Errorf("failed to lock webhook delivery: %w", err)
+	}
+
+	return delivery, nil
+}
+
+// CompleteWebhookDelivery removes a delivered webhook from the queue.
+func (wr *WebhooksRepository) CompleteWebhookDelivery(ctx context.Context, executor database.Executor, deliveryID int) error {
+	deleteSQL := `DELETE FROM webhook_deliveries WHERE id = $1`
+
+	_, err := executor.Exec(ctx, deleteSQL, deliveryID)
+	if err != nil {
+		return fmt.Errorf("failed to complete webhook delivery: %w", err)
+	}
+
+	return nil
+}
+
+// ScheduleWebhookRetry stores the retry state of a failed delivery.
+func (wr *WebhooksRepository) ScheduleWebhookRetry(ctx context.Context, executor database.Executor, delivery domain.WebhookDelivery) error {
+	updateSQL := `UPDATE webhook_deliveries SET attempts = $2, next_attempt_at = $3, last_error = $4 WHERE id = $1`
+
+	_, err := executor.Exec(ctx, updateSQL, delivery.Id, delivery.Attempts, delivery.NextAttemptAt, delivery.LastError)
+	if err != nil {
+		return fmt.Errorf("failed to schedule webhook retry: %w", err)
+	}
+
+	return nil
+}
+
+// MoveWebhookToDeadLetters takes a delivery out of the queue into the dead letters, keeping the endpoint url.
+func (wr *WebhooksRepository) MoveWebhookToDeadLetters(ctx context.Context, executor database.Executor, delivery domain.WebhookDelivery) error {
+	insertSQL := `INSERT INTO webhook_dead_letters (id, endpoint_id, url, event, data, attempts, last_error, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	_, err := executor.Exec(ctx, insertSQL, delivery.Id, delivery.EndpointID, delivery.URL, delivery.Event, delivery.Data,
+		delivery.Attempts, delivery.LastError, delivery.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert webhook dead letter: %w", err)
+	}
+
+	return wr.CompleteWebhookDelivery(ctx, executor, delivery.Id)
+}
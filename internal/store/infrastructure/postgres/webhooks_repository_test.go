@@ -0,0 +1,173 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhooksRepository_DeleteWebhookEndpoint(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name         string
+		rowsAffected int64
+
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name:         "endpoint deleted",
+			rowsAffected: 1,
+		},
+		{
+			name:         "endpoint not found",
+			rowsAffected: 0,
+			expectedErr:  &domain.WebhookEndpointNotFoundError{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			mock.ExpectExec("DELETE FROM webhook_endpoints").
+				WithArgs(2).
+				WillReturnResult(pgxmock.NewResult("DELETE", tt.rowsAffected))
+
+			repo := NewWebhooksRepository(mock)
+			err = repo.DeleteWebhookEndpoint(t.Context(), mock, 2)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestWebhooksRepository_PublishWebhook(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	event := domain.PurchaseWebhook(1, 80, domain.WebhookItem{Name: "t-shirt", Variant: "t-shirt-xl", Quantity: 1})
+	mock.ExpectExec("INSERT INTO webhook_deliveries \\(endpoint_id, event, data\\)\\s+SELECT id, \\$1, \\$2 FROM webhook_endpoints").
+		WithArgs(domain.WebhookEventPurchase, event.Data).
+		WillReturnResult(pgxmock.NewResult("INSERT", 2))
+
+	repo := NewWebhooksRepository(mock)
+	err = repo.PublishWebhook(t.Context(), mock, event)
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestWebhooksRepository_LockAndGetWebhookDelivery(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2026, 8, 3, 10, 0, 0, 0, time.UTC)
+	data := map[string]any{"userId": float64(1)}
+
+	type testCase struct {
+		name string
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+
+		expectedDelivery domain.WebhookDelivery
+		expectedErr      error
+	}
+
+	testCases := []testCase{
+		{
+			name: "delivery locked",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				rows := pgxmock.NewRows([]string{"id", "endpoint_id", "url", "secret", "event", "data", "attempts",
+					"next_attempt_at", "last_error", "created_at"}).
+					AddRow(7, 2, "https://warehouse.example.com/hooks", "secret", domain.WebhookEventPurchase, data, 1,
+						createdAt.Add(time.Minute), "connection refused", createdAt)
+				mock.ExpectQuery("SELECT d.id, d.endpoint_id, e.url, e.secret.+FOR UPDATE OF d SKIP LOCKED").
+					WithArgs(7).
+					WillReturnRows(rows)
+			},
+			expectedDelivery: domain.WebhookDelivery{Id: 7, EndpointID: 2, URL: "https://warehouse.example.com/hooks",
+				Secret: "secret", Event: domain.WebhookEventPurchase, Data: data, Attempts: 1,
+				NextAttemptAt: createdAt.Add(time.Minute), LastError: "connection refused", CreatedAt: createdAt},
+		},
+		{
+			name: "delivery gone or locked by another instance",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectQuery("SELECT d.id").
+					WithArgs(7).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			expectedErr: &domain.WebhookDeliveryNotFoundError{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			repo := NewWebhooksRepository(mock)
+			delivery, err := repo.LockAndGetWebhookDelivery(t.Context(), mock, 7)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedDelivery, delivery)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestWebhooksRepository_MoveWebhookToDeadLetters(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	createdAt := time.Date(2026, 8, 3, 10, 0, 0, 0, time.UTC)
+	delivery := domain.WebhookDelivery{Id: 7, EndpointID: 2, URL: "https://warehouse.example.com/hooks",
+		Event: domain.WebhookEventGift, Data: map[string]any{"userId": float64(2)}, Attempts: domain.MaxWebhookAttempts,
+		LastError: "endpoint responded with status 503", CreatedAt: createdAt}
+
+	mock.ExpectExec("INSERT INTO webhook_dead_letters").
+		WithArgs(7, 2, "https://warehouse.example.com/hooks", domain.WebhookEventGift, delivery.Data,
+			domain.MaxWebhookAttempts, "endpoint responded with status 503", createdAt).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectExec("DELETE FROM webhook_deliveries").
+		WithArgs(7).
+		WillReturnResult(pgxmock.NewResult("DELETE", 1))
+
+	repo := NewWebhooksRepository(mock)
+	err = repo.MoveWebhookToDeadLetters(t.Context(), mock, delivery)
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
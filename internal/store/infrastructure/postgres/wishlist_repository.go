@@ -0,0 +1,153 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Lexv0lk/merch-store/internal/pkg/database"
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+)
+
+type WishlistRepository struct {
+	queryExecuter database.QueryExecuter
+}
+
+func NewWishlistRepository(queryExecuter database.QueryExecuter) *WishlistRepository {
+	return &WishlistRepository{
+		queryExecuter: queryExecuter,
+	}
+}
+
+// AddWishlistItem wishes a good at its current price. Adding a good twice keeps the original entry.
+func (wr *WishlistRepository) AddWishlistItem(ctx context.Context, userID int, good domain.GoodInfo) error {
+	insertSQL := `INSERT INTO wishlist_items (user_id, good_id, seen_price) VALUES ($1, $2, $3)
+		ON CONFLICT (user_id, good_id) DO NOTHING`
+
+	_, err := wr.queryExecuter.Exec(ctx, insertSQL, userID, good.Id, good.Price)
+	if err != nil {
+		return fmt.Errorf("failed to add wishlist item: %w", err)
+	}
+
+	return nil
+}
+
+func (wr *WishlistRepository) RemoveWishlistItem(ctx context.Context, userID, goodID int) error {
+	deleteSQL := `DELETE FROM wishlist_items WHERE user_id = $1 AND good_id = $2`
+
+	tag, err := wr.queryExecuter.Exec(ctx, deleteSQL, userID, goodID)
+	if err != nil {
+		return fmt.Errorf("failed to remove wishlist item: %w", err)
+	} else if tag.RowsAffected() == 0 {
+		return &domain.WishlistItemNotFoundError{Msg: "item is not on the wishlist"}
+	}
+
+	return nil
+}
+
+func (wr *WishlistRepository) CountWishlistItems(ctx context.Context, userID int) (int, error) {
+	countSQL := `SELECT COUNT(*) FROM wishlist_items WHERE user_id = $1`
+
+	var count int
+	err := wr.queryExecuter.QueryRow(ctx, countSQL, userID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count wishlist items: %w", err)
+	}
+
+	return count, nil
+}
+
+// ListWishlistEvents returns the latest events of the user's wishlist, newest first.
+func (wr *WishlistRepository) ListWishlistEvents(ctx context.Context, userID, limit int) ([]domain.WishlistEvent, error) {
+	listSQL := `SELECT e.kind, g.name, e.old_price, e.new_price, e.created_at FROM wishlist_events e
+		JOIN goods g ON e.good_id = g.id
+		WHERE e.user_id = $1
+		ORDER BY e.created_at DESC, e.id DESC
+		LIMIT $2`
+
+	rows, err := wr.queryExecuter.Query(ctx, listSQL, userID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list wishlist events: %w", err)
+	}
+	defer rows.Close()
+
+	events := make([]domain.WishlistEvent, 0)
+	for rows.Next() {
+		var event domain.WishlistEvent
+		if err := rows.Scan(&event.Kind, &event.GoodName, &event.OldPrice, &event.NewPrice, &event.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan wishlist event: %w", err)
+		}
+
+		events = append(events, event)
+	}
+
+	return events, rows.Err()
+}
+
+// FetchPriceChanges locks wishlist entries whose good no longer costs the price they were last seen at.
+// Scheduled prices count, so a sale starting raises a price drop. Entries locked by another store instance are skipped.
+func (wr *WishlistRepository) FetchPriceChanges(ctx context.Context, querier database.Querier, limit int) ([]domain.WishlistPriceChange, error) {
+	changesSQL := `SELECT w.user_id, w.good_id, w.seen_price, COALESCE(s.price, g.price) FROM wishlist_items w
+		JOIN goods g ON w.good_id = g.id
+		` + activePriceJoin + `
+		WHERE w.seen_price <> COALESCE(s.price, g.price)
+		ORDER BY w.good_id, w.user_id
+		LIMIT $1
+		FOR UPDATE OF w SKIP LOCKED`
+
+	rows, err := querier.Query(ctx, changesSQL, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch wishlist price changes: %w", err)
+	}
+	defer rows.Close()
+
+	changes := make([]domain.WishlistPriceChange, 0)
+	for rows.Next() {
+		var change domain.WishlistPriceChange
+		if err := rows.Scan(&change.UserID, &change.GoodID, &change.OldPrice, &change.NewPrice); err != nil {
+			return nil, fmt.Errorf("failed to scan wishlist price change: %w", err)
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes, rows.Err()
+}
+
+func (wr *WishlistRepository) RecordWishlistEvent(ctx context.Context, executor database.Executor,
+	change domain.WishlistPriceChange, kind string) error {
+	insertSQL := `INSERT INTO wishlist_events (user_id, good_id, kind, old_price, new_price) VALUES ($1, $2, $3, $4, $5)`
+
+	_, err := executor.Exec(ctx, insertSQL, change.UserID, change.GoodID, kind, change.OldPrice, change.NewPrice)
+	if err != nil {
+		return fmt.Errorf("failed to insert wishlist event: %w", err)
+	}
+
+	return nil
+}
+
+// RecordBackInStock records a back-in-stock event for every wishlist entry of the good, at its current price.
+func (wr *WishlistRepository) RecordBackInStock(ctx context.Context, executor database.Executor, goodID int) error {
+	insertSQL := `INSERT INTO wishlist_events (user_id, good_id, kind, old_price, new_price)
+		SELECT w.user_id, w.good_id, $2, w.seen_price, COALESCE(s.price, g.price) FROM wishlist_items w
+		JOIN goods g ON w.good_id = g.id
+		` + activePriceJoin + `
+		WHERE w.good_id = $1`
+
+	_, err := executor.Exec(ctx, insertSQL, goodID, domain.WishlistEventBackInStock)
+	if err != nil {
+		return fmt.Errorf("failed to insert back-in-stock events: %w", err)
+	}
+
+	return nil
+}
+
+func (wr *WishlistRepository) UpdateSeenPrice(ctx context.Context, executor database.Executor, change domain.WishlistPriceChange) error {
+	updateSQL := `UPDATE wishlist_items SET seen_price = $3 WHERE user_id = $1 AND good_id = $2`
+
+	_, err := executor.Exec(ctx, updateSQL, change.UserID, change.GoodID, change.NewPrice)
+	if err != nil {
+		return fmt.Errorf("failed to update seen price: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWishlistRepository_RemoveWishlistItem(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name string
+
+		prepareFn func(t *testing.T, mock pgxmock.PgxConnIface)
+
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name: "item removed",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("DELETE FROM wishlist_items").
+					WithArgs(1, 10).
+					WillReturnResult(pgxmock.NewResult("DELETE", 1))
+			},
+		},
+		{
+			name: "item not on the wishlist",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("DELETE FROM wishlist_items").
+					WithArgs(1, 10).
+					WillReturnResult(pgxmock.NewResult("DELETE", 0))
+			},
+			expectedErr: &domain.WishlistItemNotFoundError{},
+		},
+		{
+			name: "database error",
+			prepareFn: func(t *testing.T, mock pgxmock.PgxConnIface) {
+				t.Helper()
+				mock.ExpectExec("DELETE FROM wishlist_items").
+					WithArgs(1, 10).
+					WillReturnError(assert.AnError)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mock, err := pgxmock.NewConn()
+			require.NoError(t, err)
+			defer mock.Close(t.Context())
+
+			tt.prepareFn(t, mock)
+
+			repo := NewWishlistRepository(mock)
+			err = repo.RemoveWishlistItem(t.Context(), 1, 10)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestWishlistRepository_FetchPriceChanges(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	mock.ExpectQuery("SELECT w.user_id, w.good_id, w.seen_price").
+		WithArgs(500).
+		WillReturnRows(pgxmock.NewRows([]string{"user_id", "good_id", "seen_price", "price"}).
+			AddRow(1, 10, uint32(200), uint32(150)).
+			AddRow(2, 10, uint32(100), uint32(150)))
+
+	repo := NewWishlistRepository(mock)
+	changes, err := repo.FetchPriceChanges(t.Context(), mock, 500)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.WishlistPriceChange{
+		{UserID: 1, GoodID: 10, OldPrice: 200, NewPrice: 150},
+		{UserID: 2, GoodID: 10, OldPrice: 100, NewPrice: 150},
+	}, changes)
+}
+
+func TestWishlistRepository_RecordWishlistEvent(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	change := domain.WishlistPriceChange{UserID: 1, GoodID: 10, OldPrice: 200, NewPrice: 150}
+
+	mock.ExpectExec("INSERT INTO wishlist_events").
+		WithArgs(1, 10, domain.WishlistEventPriceDrop, uint32(200), uint32(150)).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+	repo := NewWishlistRepository(mock)
+	err = repo.RecordWishlistEvent(t.Context(), mock, change, domain.WishlistEventPriceDrop)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestWishlistRepository_RecordBackInStock(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	require.NoError(t, err)
+	defer mock.Close(t.Context())
+
+	mock.ExpectExec("INSERT INTO wishlist_events").
+		WithArgs(12, domain.WishlistEventBackInStock).
+		WillReturnResult(pgxmock.NewResult("INSERT", 3))
+
+	repo := NewWishlistRepository(mock)
+	err = repo.RecordBackInStock(t.Context(), mock, 12)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
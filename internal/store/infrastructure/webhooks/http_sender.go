@@ -0,0 +1,80 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+)
+
+const (
+	EventHeader     = "X-Merch-Event"
+	DeliveryHeader  = "X-Merch-Delivery"
+	TimestampHeader = "X-Merch-Timestamp"
+	SignatureHeader = "X-Merch-Signature"
+
+	signaturePrefix = "sha256="
+)
+
+// payload is the JSON body of a webhook request. Id stays the same across the retries of a delivery, so
+// receivers can drop duplicates.
+type payload struct {
+	Id         int            `json:"id"`
+	Event      string         `json:"event"`
+	OccurredAt time.Time      `json:"occurredAt"`
+	Data       map[string]any `json:"data"`
+}
+
+// HTTPSender posts deliveries as JSON signed with the endpoint secret, see domain.SignWebhook.
+type HTTPSender struct {
+	client *http.Client
+}
+
+func NewHTTPSender(timeout time.Duration) *HTTPSender {
+	return &HTTPSender{
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+func (s *HTTPSender) SendWebhook(ctx context.Context, delivery domain.WebhookDelivery) error {
+	body, err := json.Marshal(payload{
+		Id:         delivery.Id,
+		Event:      delivery.Event,
+		OccurredAt: delivery.CreatedAt,
+		Data:       delivery.Data,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode webhook payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, delivery.Event)
+	req.Header.Set(DeliveryHeader, strconv.Itoa(delivery.Id))
+	req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(SignatureHeader, signaturePrefix+domain.SignWebhook(delivery.Secret, timestamp, body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("endpoint responded with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/Lexv0lk/merch-store/internal/store/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPSender_SendWebhook(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2026, 8, 3, 10, 0, 0, 0, time.UTC)
+	delivery := domain.WebhookDelivery{
+		Id:        7,
+		Secret:    "secret",
+		Event:     domain.WebhookEventPurchase,
+		Data:      map[string]any{"userId": float64(1)},
+		CreatedAt: createdAt,
+	}
+
+	type testCase struct {
+		name   string
+		status int
+
+		expectedErr bool
+	}
+
+	testCases := []testCase{
+		{
+			name:   "delivered",
+			status: http.StatusOK,
+		},
+		{
+			name:   "accepted",
+			status: http.StatusAccepted,
+		},
+		{
+			name:        "receiver failed",
+			status:      http.StatusServiceUnavailable,
+			expectedErr: true,
+		},
+		{
+			name:        "non-2xx status",
+			status:      http.StatusNotModified,
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tt := tc
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var received payload
+			receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				assert.NoError(t, err)
+
+				timestamp, err := strconv.ParseInt(r.Header.Get(TimestampHeader), 10, 64)
+				assert.NoError(t, err)
+				assert.Equal(t, signaturePrefix+domain.SignWebhook("secret", timestamp, body), r.Header.Get(SignatureHeader))
+				assert.Equal(t, domain.WebhookEventPurchase, r.Header.Get(EventHeader))
+				assert.Equal(t, "7", r.Header.Get(DeliveryHeader))
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				assert.NoError(t, json.Unmarshal(body, &received))
+
+				w.WriteHeader(tt.status)
+			}))
+			defer receiver.Close()
+
+			sent := delivery
+			sent.URL = receiver.URL
+
+			err := NewHTTPSender(time.Second).SendWebhook(t.Context(), sent)
+
+			if tt.expectedErr {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, payload{Id: 7, Event: domain.WebhookEventPurchase, OccurredAt: createdAt,
+				Data: map[string]any{"userId": float64(1)}}, received)
+		})
+	}
+}
+
+func TestHTTPSender_SendWebhook_Unreachable(t *testing.T) {
+	t.Parallel()
+
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	receiver.Close()
+
+	err := NewHTTPSender(time.Second).SendWebhook(t.Context(), domain.WebhookDelivery{Id: 7, URL: receiver.URL})
+
+	assert.Error(t, err)
+}
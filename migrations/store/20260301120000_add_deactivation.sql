@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE balances ADD COLUMN is_active BOOLEAN NOT NULL DEFAULT TRUE;
+
+CREATE TABLE company_pool (
+    id INTEGER PRIMARY KEY DEFAULT 1 CHECK ( id = 1 ),
+    balance INTEGER NOT NULL DEFAULT 0 CHECK ( balance >= 0 )
+);
+
+INSERT INTO company_pool (id, balance) VALUES (1, 0);
+
+CREATE TABLE pool_ledger (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER REFERENCES balances(user_id),
+    amount INTEGER NOT NULL,
+    reason TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS pool_ledger;
+DROP TABLE IF EXISTS company_pool;
+ALTER TABLE balances DROP COLUMN IF EXISTS is_active;
+-- +goose StatementEnd
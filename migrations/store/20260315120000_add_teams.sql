@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE teams (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE,
+    manager_id INTEGER NOT NULL REFERENCES balances(user_id),
+    budget INTEGER NOT NULL DEFAULT 0 CHECK ( budget >= 0 ),
+    topup_amount INTEGER NOT NULL DEFAULT 0 CHECK ( topup_amount >= 0 ),
+    topup_period INTERVAL,
+    next_topup_at TIMESTAMPTZ
+);
+
+CREATE TABLE team_members (
+    team_id INTEGER NOT NULL REFERENCES teams(id),
+    user_id INTEGER NOT NULL REFERENCES balances(user_id),
+    PRIMARY KEY (team_id, user_id)
+);
+
+CREATE TABLE team_budget_ledger (
+    id SERIAL PRIMARY KEY,
+    team_id INTEGER NOT NULL REFERENCES teams(id),
+    user_id INTEGER REFERENCES balances(user_id),
+    amount INTEGER NOT NULL,
+    reason TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+ALTER TABLE transactions ADD COLUMN team_id INTEGER REFERENCES teams(id);
+
+CREATE INDEX idx_teams_next_topup_at ON teams(next_topup_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_teams_next_topup_at;
+ALTER TABLE transactions DROP COLUMN IF EXISTS team_id;
+DROP TABLE IF EXISTS team_budget_ledger;
+DROP TABLE IF EXISTS team_members;
+DROP TABLE IF EXISTS teams;
+-- +goose StatementEnd
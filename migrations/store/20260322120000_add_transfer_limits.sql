@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE transactions ADD COLUMN created_at TIMESTAMPTZ NOT NULL DEFAULT now();
+
+CREATE INDEX idx_transactions_from_user_id_created_at ON transactions(from_user_id, created_at);
+
+CREATE TABLE transfer_limits (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER UNIQUE REFERENCES balances(user_id),
+    max_single_transfer INTEGER NOT NULL DEFAULT 0 CHECK ( max_single_transfer >= 0 ),
+    daily_outgoing_cap INTEGER NOT NULL DEFAULT 0 CHECK ( daily_outgoing_cap >= 0 ),
+    max_transfers_per_hour INTEGER NOT NULL DEFAULT 0 CHECK ( max_transfers_per_hour >= 0 ),
+    max_received_from_sender_per_day INTEGER NOT NULL DEFAULT 0 CHECK ( max_received_from_sender_per_day >= 0 )
+);
+
+CREATE UNIQUE INDEX idx_transfer_limits_default ON transfer_limits((user_id IS NULL)) WHERE user_id IS NULL;
+
+INSERT INTO transfer_limits (user_id, max_single_transfer, daily_outgoing_cap, max_transfers_per_hour, max_received_from_sender_per_day)
+VALUES (NULL, 500, 1000, 20, 500);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS transfer_limits;
+DROP INDEX IF EXISTS idx_transactions_from_user_id_created_at;
+ALTER TABLE transactions DROP COLUMN IF EXISTS created_at;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE balances ADD COLUMN created_at TIMESTAMPTZ;
+ALTER TABLE balances ALTER COLUMN created_at SET DEFAULT now();
+
+CREATE INDEX idx_transactions_created_at ON transactions(created_at);
+
+CREATE TABLE fraud_cases (
+    id SERIAL PRIMARY KEY,
+    pattern TEXT NOT NULL,
+    user_ids INTEGER[] NOT NULL,
+    details TEXT NOT NULL,
+    fingerprint TEXT NOT NULL UNIQUE,
+    status VARCHAR(20) NOT NULL DEFAULT 'open',
+    resolution TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    resolved_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_fraud_cases_status ON fraud_cases(status);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS fraud_cases;
+DROP INDEX IF EXISTS idx_transactions_created_at;
+ALTER TABLE balances DROP COLUMN IF EXISTS created_at;
+-- +goose StatementEnd
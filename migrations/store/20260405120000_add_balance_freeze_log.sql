@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE balances ADD COLUMN is_frozen BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE balance_freeze_log (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL,
+    admin_id INTEGER NOT NULL,
+    frozen BOOLEAN NOT NULL,
+    reason TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_balance_freeze_log_user_id ON balance_freeze_log(user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS balance_freeze_log;
+ALTER TABLE balances DROP COLUMN IF EXISTS is_frozen;
+-- +goose StatementEnd
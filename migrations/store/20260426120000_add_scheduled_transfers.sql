@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE scheduled_transfers (
+    id SERIAL PRIMARY KEY,
+    sender_id INTEGER NOT NULL REFERENCES balances(user_id),
+    recipient_id INTEGER NOT NULL REFERENCES balances(user_id),
+    amount INTEGER NOT NULL CHECK (amount > 0),
+    recurrence VARCHAR(20) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'active',
+    starts_at TIMESTAMPTZ NOT NULL,
+    next_run_at TIMESTAMPTZ NOT NULL,
+    next_attempt_at TIMESTAMPTZ NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CHECK (sender_id <> recipient_id)
+);
+
+CREATE INDEX idx_scheduled_transfers_sender_status ON scheduled_transfers(sender_id, status);
+CREATE INDEX idx_scheduled_transfers_due ON scheduled_transfers(next_attempt_at) WHERE status = 'active';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS scheduled_transfers;
+-- +goose StatementEnd
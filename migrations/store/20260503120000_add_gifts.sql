@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE purchases ADD COLUMN gifted_by INTEGER REFERENCES balances(user_id);
+ALTER TABLE purchases ADD COLUMN gift_message TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX idx_purchases_gifts ON purchases(user_id) WHERE gifted_by IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_purchases_gifts;
+ALTER TABLE purchases DROP COLUMN IF EXISTS gift_message;
+ALTER TABLE purchases DROP COLUMN IF EXISTS gifted_by;
+-- +goose StatementEnd
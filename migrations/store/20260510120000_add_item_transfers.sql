@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE item_transfers (
+    id SERIAL PRIMARY KEY,
+    from_user_id INTEGER NOT NULL REFERENCES balances(user_id),
+    to_user_id INTEGER NOT NULL REFERENCES balances(user_id),
+    good_id INTEGER NOT NULL REFERENCES goods(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_item_transfers_from_user ON item_transfers(from_user_id);
+CREATE INDEX idx_item_transfers_to_user ON item_transfers(to_user_id);
+CREATE INDEX idx_purchases_user_good ON purchases(user_id, good_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_purchases_user_good;
+DROP TABLE IF EXISTS item_transfers;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE listings (
+    id SERIAL PRIMARY KEY,
+    seller_id INTEGER NOT NULL REFERENCES balances(user_id),
+    good_id INTEGER NOT NULL REFERENCES goods(id),
+    price INTEGER NOT NULL CHECK ( price > 0 ),
+    status TEXT NOT NULL DEFAULT 'active',
+    buyer_id INTEGER REFERENCES balances(user_id),
+    fee INTEGER NOT NULL DEFAULT 0 CHECK ( fee >= 0 ),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    closed_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_listings_active ON listings(good_id, price) WHERE status = 'active';
+CREATE INDEX idx_listings_seller ON listings(seller_id, good_id) WHERE status = 'active';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS listings;
+-- +goose StatementEnd
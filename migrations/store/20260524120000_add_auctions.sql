@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE auctions (
+    id SERIAL PRIMARY KEY,
+    good_id INTEGER NOT NULL REFERENCES goods(id),
+    reserve_price INTEGER NOT NULL DEFAULT 0 CHECK (reserve_price >= 0),
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'open',
+    winner_id INTEGER REFERENCES balances(user_id),
+    final_price INTEGER,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    settled_at TIMESTAMPTZ,
+    CHECK (ends_at > starts_at)
+);
+
+CREATE INDEX idx_auctions_open ON auctions(ends_at) WHERE status = 'open';
+
+CREATE TABLE auction_bids (
+    id SERIAL PRIMARY KEY,
+    auction_id INTEGER NOT NULL REFERENCES auctions(id),
+    user_id INTEGER NOT NULL REFERENCES balances(user_id),
+    amount INTEGER NOT NULL CHECK (amount > 0),
+    status VARCHAR(20) NOT NULL DEFAULT 'held',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- Only the highest bid of an auction keeps its coins in escrow.
+CREATE UNIQUE INDEX idx_auction_bids_held ON auction_bids(auction_id) WHERE status = 'held';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS auction_bids;
+DROP TABLE IF EXISTS auctions;
+-- +goose StatementEnd
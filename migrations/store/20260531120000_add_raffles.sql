@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE raffles (
+    id SERIAL PRIMARY KEY,
+    good_id INTEGER NOT NULL REFERENCES goods(id),
+    ticket_price INTEGER NOT NULL CHECK (ticket_price > 0),
+    draw_at TIMESTAMPTZ NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'open',
+    seed VARCHAR(64),
+    winning_ticket_id INTEGER,
+    winner_id INTEGER REFERENCES balances(user_id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    drawn_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_raffles_open ON raffles(draw_at) WHERE status = 'open';
+
+CREATE TABLE raffle_tickets (
+    id SERIAL PRIMARY KEY,
+    raffle_id INTEGER NOT NULL REFERENCES raffles(id),
+    user_id INTEGER NOT NULL REFERENCES balances(user_id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_raffle_tickets_raffle_id ON raffle_tickets(raffle_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS raffle_tickets;
+DROP TABLE IF EXISTS raffles;
+-- +goose StatementEnd
@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE wishlist_items (
+    user_id INTEGER NOT NULL REFERENCES balances(user_id),
+    good_id INTEGER NOT NULL REFERENCES goods(id),
+    -- Price the user was last told about, a drop below it raises a wishlist event.
+    seen_price INTEGER NOT NULL,
+    added_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, good_id)
+);
+
+CREATE INDEX idx_wishlist_items_good_id ON wishlist_items(good_id);
+
+CREATE TABLE wishlist_events (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES balances(user_id),
+    good_id INTEGER NOT NULL REFERENCES goods(id),
+    kind VARCHAR(20) NOT NULL,
+    old_price INTEGER NOT NULL,
+    new_price INTEGER NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_wishlist_events_user_id ON wishlist_events(user_id, created_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS wishlist_events;
+DROP TABLE IF EXISTS wishlist_items;
+-- +goose StatementEnd
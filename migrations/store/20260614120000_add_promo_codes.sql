@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE promo_codes (
+    id SERIAL PRIMARY KEY,
+    code VARCHAR(32) NOT NULL UNIQUE,
+    kind VARCHAR(10) NOT NULL CHECK (kind IN ('percent', 'fixed')),
+    value INTEGER NOT NULL CHECK (value > 0),
+    good_id INTEGER REFERENCES goods(id),
+    max_uses INTEGER NOT NULL DEFAULT 0 CHECK (max_uses >= 0),
+    max_uses_per_user INTEGER NOT NULL DEFAULT 0 CHECK (max_uses_per_user >= 0),
+    valid_from TIMESTAMPTZ NOT NULL,
+    valid_until TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CHECK (valid_until > valid_from)
+);
+
+CREATE TABLE promo_redemptions (
+    id SERIAL PRIMARY KEY,
+    promo_id INTEGER NOT NULL REFERENCES promo_codes(id),
+    user_id INTEGER NOT NULL REFERENCES balances(user_id),
+    good_id INTEGER NOT NULL REFERENCES goods(id),
+    discount INTEGER NOT NULL CHECK (discount >= 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_promo_redemptions_promo_user ON promo_redemptions(promo_id, user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS promo_redemptions;
+DROP TABLE IF EXISTS promo_codes;
+-- +goose StatementEnd
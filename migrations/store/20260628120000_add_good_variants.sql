@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE good_variants (
+    id SERIAL PRIMARY KEY,
+    good_id INTEGER NOT NULL REFERENCES goods(id),
+    sku TEXT NOT NULL UNIQUE,
+    attributes JSONB NOT NULL DEFAULT '{}',
+    price_delta INTEGER NOT NULL DEFAULT 0,
+    stock INTEGER CHECK (stock >= 0)
+);
+
+CREATE INDEX idx_good_variants_good_id ON good_variants(good_id);
+
+ALTER TABLE purchases ADD COLUMN variant_id INTEGER REFERENCES good_variants(id);
+
+INSERT INTO good_variants (good_id, sku, attributes)
+SELECT g.id, g.name || '-' || lower(s.size), jsonb_build_object('size', s.size)
+FROM goods g
+CROSS JOIN (VALUES (1, 'S'), (2, 'M'), (3, 'L'), (4, 'XL')) AS s(ord, size)
+WHERE g.name IN ('t-shirt', 'hoody')
+ORDER BY g.name, s.ord
+ON CONFLICT (sku) DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE purchases DROP COLUMN IF EXISTS variant_id;
+DROP TABLE IF EXISTS good_variants;
+-- +goose StatementEnd
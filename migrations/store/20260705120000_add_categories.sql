@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE categories (
+    id SERIAL PRIMARY KEY,
+    slug TEXT NOT NULL UNIQUE,
+    name TEXT NOT NULL
+);
+
+ALTER TABLE goods
+    ADD COLUMN category_id INTEGER REFERENCES categories(id),
+    ADD COLUMN description TEXT NOT NULL DEFAULT '',
+    ADD COLUMN images JSONB NOT NULL DEFAULT '[]';
+
+CREATE INDEX idx_goods_category_id ON goods(category_id);
+
+INSERT INTO categories (slug, name) VALUES
+('apparel', 'Apparel'),
+('stationery', 'Stationery'),
+('electronics', 'Electronics'),
+('accessories', 'Accessories')
+ON CONFLICT (slug) DO NOTHING;
+
+UPDATE goods g SET category_id = c.id
+FROM categories c
+WHERE (c.slug = 'apparel' AND g.name IN ('t-shirt', 'hoody', 'pink-hoody', 'socks'))
+   OR (c.slug = 'stationery' AND g.name IN ('book', 'pen'))
+   OR (c.slug = 'electronics' AND g.name IN ('powerbank'))
+   OR (c.slug = 'accessories' AND g.name IN ('cup', 'umbrella', 'wallet'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE goods
+    DROP COLUMN IF EXISTS images,
+    DROP COLUMN IF EXISTS description,
+    DROP COLUMN IF EXISTS category_id;
+
+DROP TABLE IF EXISTS categories;
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE bundle_components (
+    bundle_id INTEGER NOT NULL REFERENCES goods(id),
+    good_id INTEGER NOT NULL REFERENCES goods(id),
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    PRIMARY KEY (bundle_id, good_id),
+    CHECK (bundle_id <> good_id)
+);
+
+ALTER TABLE purchases ADD COLUMN bundle_id INTEGER REFERENCES goods(id);
+
+INSERT INTO goods (name, price, category_id, description)
+SELECT 'onboarding-kit', 90, c.id, 'A cup, a pen and a t-shirt for the first day'
+FROM categories c
+WHERE c.slug = 'accessories'
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO bundle_components (bundle_id, good_id, quantity)
+SELECT b.id, g.id, 1
+FROM goods b
+JOIN goods g ON g.name IN ('cup', 'pen', 't-shirt')
+WHERE b.name = 'onboarding-kit'
+ON CONFLICT (bundle_id, good_id) DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE purchases DROP COLUMN IF EXISTS bundle_id;
+DROP TABLE IF EXISTS bundle_components;
+DELETE FROM goods WHERE name = 'onboarding-kit';
+-- +goose StatementEnd
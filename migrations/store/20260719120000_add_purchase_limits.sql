@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE purchases ADD COLUMN purchased_at TIMESTAMPTZ NOT NULL DEFAULT now();
+
+CREATE INDEX idx_purchases_user_good_purchased_at ON purchases(user_id, good_id, purchased_at);
+
+CREATE TABLE purchase_limits (
+    good_id INTEGER PRIMARY KEY REFERENCES goods(id),
+    max_quantity INTEGER NOT NULL CHECK ( max_quantity > 0 ),
+    period_days INTEGER NOT NULL DEFAULT 0 CHECK ( period_days >= 0 )
+);
+
+INSERT INTO purchase_limits (good_id, max_quantity)
+SELECT id, 1 FROM goods WHERE name = 'pink-hoody'
+ON CONFLICT (good_id) DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS purchase_limits;
+DROP INDEX IF EXISTS idx_purchases_user_good_purchased_at;
+ALTER TABLE purchases DROP COLUMN IF EXISTS purchased_at;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE balances ADD COLUMN held INTEGER NOT NULL DEFAULT 0 CHECK ( held >= 0 );
+
+ALTER TABLE goods ADD COLUMN upcoming BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE preorders (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES balances(user_id),
+    good_id INTEGER NOT NULL REFERENCES goods(id),
+    variant_id INTEGER REFERENCES good_variants(id),
+    price INTEGER NOT NULL CHECK ( price >= 0 ),
+    status VARCHAR(16) NOT NULL DEFAULT 'pending',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    resolved_at TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX idx_preorders_pending_user_good ON preorders(user_id, good_id) WHERE status = 'pending';
+CREATE INDEX idx_preorders_good_status ON preorders(good_id, status);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS preorders;
+ALTER TABLE goods DROP COLUMN IF EXISTS upcoming;
+ALTER TABLE balances DROP COLUMN IF EXISTS held;
+-- +goose StatementEnd
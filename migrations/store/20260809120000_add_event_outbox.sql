@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE outbox_events (
+    id BIGSERIAL PRIMARY KEY,
+    event_type VARCHAR(32) NOT NULL,
+    user_ids INTEGER[] NOT NULL,
+    data JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE domain_events (
+    event_offset BIGSERIAL PRIMARY KEY,
+    event_id BIGINT NOT NULL UNIQUE,
+    event_type VARCHAR(32) NOT NULL,
+    user_ids INTEGER[] NOT NULL,
+    data JSONB NOT NULL,
+    occurred_at TIMESTAMPTZ NOT NULL,
+    relayed_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_domain_events_user_ids ON domain_events USING GIN (user_ids);
+
+CREATE TABLE event_consumers (
+    name VARCHAR(64) PRIMARY KEY,
+    committed_offset BIGINT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS event_consumers;
+DROP TABLE IF EXISTS domain_events;
+DROP TABLE IF EXISTS outbox_events;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE listings ADD COLUMN variant_id INTEGER REFERENCES good_variants(id);
+ALTER TABLE item_transfers ADD COLUMN variant_id INTEGER REFERENCES good_variants(id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE item_transfers DROP COLUMN IF EXISTS variant_id;
+ALTER TABLE listings DROP COLUMN IF EXISTS variant_id;
+-- +goose StatementEnd
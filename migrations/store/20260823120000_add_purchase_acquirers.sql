@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE purchases ADD COLUMN acquired_by INTEGER REFERENCES balances(user_id);
+UPDATE purchases SET acquired_by = user_id;
+ALTER TABLE purchases ALTER COLUMN acquired_by SET NOT NULL;
+
+DROP INDEX IF EXISTS idx_purchases_user_good_purchased_at;
+CREATE INDEX idx_purchases_acquired_by_good_purchased_at ON purchases(acquired_by, good_id, purchased_at);
+CREATE INDEX idx_item_transfers_to_user_good_created_at ON item_transfers(to_user_id, good_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_item_transfers_to_user_good_created_at;
+DROP INDEX IF EXISTS idx_purchases_acquired_by_good_purchased_at;
+CREATE INDEX idx_purchases_user_good_purchased_at ON purchases(user_id, good_id, purchased_at);
+ALTER TABLE purchases DROP COLUMN IF EXISTS acquired_by;
+-- +goose StatementEnd